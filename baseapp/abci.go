@@ -185,6 +185,8 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 		res.Events = sdk.MarkEventsToIndex(res.Events, app.indexEvents)
 	}
 
+	app.listenBeginBlock(req, res)
+
 	return res
 }
 
@@ -205,6 +207,8 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 		res.ConsensusParamUpdates = cp
 	}
 
+	app.listenEndBlock(req, res)
+
 	return res
 }
 
@@ -252,6 +256,7 @@ func (app *BaseApp) CheckTxAsync(req abci.RequestCheckTx, callback abci.CheckTxC
 		recheck:  req.Type == abci.CheckTxType_Recheck,
 		callback: callback,
 		prepare:  waitGroup1(),
+		received: time.Now(),
 	}
 	app.chCheckTx <- reqCheckTx
 
@@ -262,6 +267,11 @@ func (app *BaseApp) CheckTxAsync(req abci.RequestCheckTx, callback abci.CheckTxC
 func (app *BaseApp) BeginRecheckTx(req abci.RequestBeginRecheckTx) abci.ResponseBeginRecheckTx {
 	// NOTE: This is safe because Ostracon holds a lock on the mempool for Rechecking.
 	app.setCheckState(req.Header)
+
+	if app.beginRecheckTx != nil {
+		app.beginRecheckTx(app.checkState.ctx, req)
+	}
+
 	return abci.ResponseBeginRecheckTx{Code: abci.CodeTypeOK}
 }
 
@@ -275,10 +285,22 @@ func (app *BaseApp) EndRecheckTx(req abci.RequestEndRecheckTx) abci.ResponseEndR
 // Otherwise, the ResponseDeliverTx will contain releveant error information.
 // Regardless of tx execution outcome, the ResponseDeliverTx will contain relevant
 // gas execution context.
-func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) (res abci.ResponseDeliverTx) {
 	defer telemetry.MeasureSince(time.Now(), "abci", "deliver_tx")
+	defer func() { app.listenDeliverTx(req, res) }()
+
+	tx, err := app.txDecoder(req.Tx)
+	if err != nil {
+		return app.deliverTxResponse(sdk.GasInfo{}, nil, err)
+	}
 
-	gInfo := sdk.GasInfo{}
+	gInfo, result, err := app.runTx(req.Tx, tx, false)
+	return app.deliverTxResponse(gInfo, result, err)
+}
+
+// deliverTxResponse records the telemetry of a delivered tx and returns its
+// ResponseDeliverTx.
+func (app *BaseApp) deliverTxResponse(gInfo sdk.GasInfo, result *sdk.Result, err error) abci.ResponseDeliverTx {
 	resultStr := "successful"
 
 	defer func() {
@@ -288,12 +310,6 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx
 		telemetry.SetGauge(float32(gInfo.GasWanted), "tx", "gas", "wanted")
 	}()
 
-	tx, err := app.txDecoder(req.Tx)
-	if err != nil {
-		return sdkerrors.ResponseDeliverTx(err, 0, 0, app.trace)
-	}
-
-	gInfo, result, err := app.runTx(req.Tx, tx, false)
 	if err != nil {
 		resultStr = "failed"
 		return sdkerrors.ResponseDeliverTx(err, gInfo.GasWanted, gInfo.GasUsed, app.trace)
@@ -327,6 +343,16 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	commitID := app.cms.Commit()
 	app.logger.Info("commit synced", "commit", fmt.Sprintf("%X", commitID))
 
+	res = abci.ResponseCommit{
+		Data:         commitID.Hash,
+		RetainHeight: retainHeight,
+	}
+	app.listenCommit(res)
+
+	if app.commitHandler != nil {
+		app.commitHandler(app.deliverState.ctx, res)
+	}
+
 	// empty/reset the deliver state
 	app.deliverState = nil
 
@@ -352,10 +378,7 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 		go app.snapshot(header.Height)
 	}
 
-	return abci.ResponseCommit{
-		Data:         commitID.Hash,
-		RetainHeight: retainHeight,
-	}
+	return res
 }
 
 // halt attempts to gracefully shutdown the node via SIGINT and SIGTERM falling
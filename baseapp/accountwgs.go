@@ -63,6 +63,13 @@ func getUniqSigners(tx sdk.Tx) []string {
 			}
 		}
 	}
+	// the fee granter's allowance and balance are modified as well, so its txs
+	// must be ordered just like the signers' ones
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		if granter := feeTx.FeeGranter(); !granter.Empty() && !seen[granter.String()] {
+			signers = append(signers, string(granter))
+		}
+	}
 	return signers
 }
 
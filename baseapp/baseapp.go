@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	abci "github.com/line/ostracon/abci/types"
@@ -49,13 +50,15 @@ type BaseApp struct { // nolint: maligned
 	interfaceRegistry types.InterfaceRegistry
 	txDecoder         sdk.TxDecoder // unmarshal []byte into sdk.Tx
 
-	anteHandler    sdk.AnteHandler  // ante handler for fee and auth
-	initChainer    sdk.InitChainer  // initialize state with validators and state blob
-	beginBlocker   sdk.BeginBlocker // logic to run before any txs
-	endBlocker     sdk.EndBlocker   // logic to run after all txs, and to determine valset changes
-	addrPeerFilter sdk.PeerFilter   // filter peers by address and port
-	idPeerFilter   sdk.PeerFilter   // filter peers by node ID
-	fauxMerkleMode bool             // if true, IAVL MountStores uses MountStoresDB for simulation speed.
+	anteHandler    sdk.AnteHandler           // ante handler for fee and auth
+	initChainer    sdk.InitChainer           // initialize state with validators and state blob
+	beginBlocker   sdk.BeginBlocker          // logic to run before any txs
+	endBlocker     sdk.EndBlocker            // logic to run after all txs, and to determine valset changes
+	beginRecheckTx sdk.BeginRecheckTxHandler // logic to run before the mempool txs are rechecked
+	commitHandler  sdk.CommitHandler         // logic to run after a block is committed
+	addrPeerFilter sdk.PeerFilter            // filter peers by address and port
+	idPeerFilter   sdk.PeerFilter            // filter peers by node ID
+	fauxMerkleMode bool                      // if true, IAVL MountStores uses MountStoresDB for simulation speed.
 
 	// manages snapshots, i.e. dumps of app state at certain intervals
 	snapshotManager    *snapshots.Manager
@@ -74,8 +77,21 @@ type BaseApp struct { // nolint: maligned
 	checkAccountWGs *AccountWGs
 	chCheckTx       chan *RequestCheckTxAsync
 
+	// CheckTx scheduling of the reactor; see SetCheckTxWorkers,
+	// SetCheckTxMaxPendingPerSigner and SetCheckTxPriorityDenom
+	checkTxWorkers             int
+	checkTxMaxPendingPerSigner int
+	checkTxPriorityFeeDenom    string
+	checkTxQueue               *checkTxQueue
+	checkTxPending             *pendingTxs
+
+	// number of txs DeliverTxs executes in parallel
+	deliverTxWorkers int
+
 	// an inter-block write-through cache provided to the context during deliverState
 	interBlockCache sdk.MultiStorePersistentCache
+	// whether the inter-block cache is filled from the latest snapshot at startup
+	interBlockCacheWarmUp bool
 
 	// absent validators from begin block
 	voteInfos []abci.VoteInfo
@@ -124,6 +140,10 @@ type BaseApp struct { // nolint: maligned
 	// indexEvents defines the set of events in the form {eventType}.{attributeKey},
 	// which informs Tendermint what to index. If empty, all events will be indexed.
 	indexEvents map[string]struct{}
+
+	// abciListeners for hooking into the ABCI message processing of the BaseApp
+	// and exposing the requests and responses to external consumers
+	abciListeners []ABCIListener
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
@@ -268,6 +288,17 @@ func (app *BaseApp) LastBlockHeight() int64 {
 	return app.cms.LastCommitID().Version
 }
 
+// CommitMultiStore returns the root multi-store of the app.
+func (app *BaseApp) CommitMultiStore() sdk.CommitMultiStore {
+	return app.cms
+}
+
+// SnapshotManager returns the snapshot manager of the app, nil if no snapshot
+// store is set.
+func (app *BaseApp) SnapshotManager() *snapshots.Manager {
+	return app.snapshotManager
+}
+
 func (app *BaseApp) init() error {
 	if app.sealed {
 		panic("cannot call initFromMainStore: baseapp already sealed")
@@ -291,6 +322,34 @@ func (app *BaseApp) init() error {
 		}
 	}
 
+	if app.interBlockCacheWarmUp {
+		// a cold cache is not worth failing the startup
+		if err := app.warmUpInterBlockCache(); err != nil {
+			app.logger.Error("failed to warm up the inter-block cache", "err", err)
+		}
+	}
+
+	return nil
+}
+
+// warmUpInterBlockCache fills the inter-block cache with the entries of the
+// latest snapshot, if any.
+func (app *BaseApp) warmUpInterBlockCache() error {
+	rms, ok := app.cms.(*rootmulti.Store)
+	if !ok || app.interBlockCache == nil || app.snapshotManager == nil {
+		return nil
+	}
+
+	snapshot, chunks, err := app.snapshotManager.LoadLatest()
+	if snapshot == nil || err != nil {
+		return err
+	}
+
+	start := time.Now()
+	if err := rms.WarmUpInterBlockCache(snapshot.Height, snapshot.Format, chunks); err != nil {
+		return err
+	}
+	app.logger.Info("warmed up the inter-block cache", "snapshot", snapshot.Height, "duration", time.Since(start))
 	return nil
 }
 
@@ -314,6 +373,26 @@ func (app *BaseApp) setInterBlockCache(cache sdk.MultiStorePersistentCache) {
 	app.interBlockCache = cache
 }
 
+func (app *BaseApp) setInterBlockCacheWarmUp(warmUp bool) {
+	app.interBlockCacheWarmUp = warmUp
+}
+
+func (app *BaseApp) setCheckTxWorkers(workers int) {
+	app.checkTxWorkers = workers
+}
+
+func (app *BaseApp) setCheckTxMaxPendingPerSigner(limit int) {
+	app.checkTxMaxPendingPerSigner = limit
+}
+
+func (app *BaseApp) setCheckTxPriorityDenom(denom string) {
+	app.checkTxPriorityFeeDenom = denom
+}
+
+func (app *BaseApp) setDeliverTxWorkers(workers int) {
+	app.deliverTxWorkers = workers
+}
+
 func (app *BaseApp) setTrace(trace bool) {
 	app.trace = trace
 }
@@ -614,7 +693,12 @@ func (app *BaseApp) anteTx(ctx sdk.Context, txBytes []byte, tx sdk.Tx, simulate
 // returned if the tx does not run out of gas and if all the messages are valid
 // and execute successfully. An error is returned otherwise.
 func (app *BaseApp) runTx(txBytes []byte, tx sdk.Tx, simulate bool) (gInfo sdk.GasInfo, result *sdk.Result, err error) {
-	ctx := app.getRunContextForTx(txBytes, simulate)
+	return app.runTxWithContext(app.getRunContextForTx(txBytes, simulate), txBytes, tx, simulate)
+}
+
+// runTxWithContext processes a transaction like runTx does, on the given
+// context instead of the one of the execution mode.
+func (app *BaseApp) runTxWithContext(ctx sdk.Context, txBytes []byte, tx sdk.Tx, simulate bool) (gInfo sdk.GasInfo, result *sdk.Result, err error) {
 	ms := ctx.MultiStore()
 
 	// only run the tx if there is block gas remaining
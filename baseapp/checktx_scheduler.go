@@ -0,0 +1,156 @@
+package baseapp
+
+import (
+	"container/heap"
+	"sync"
+
+	"github.com/line/lbm-sdk/telemetry"
+	sdk "github.com/line/lbm-sdk/types"
+)
+
+// pendingTxs counts, for every signer, the txs between the CheckTx reactor and
+// the end of their check.
+type pendingTxs struct {
+	mtx    sync.Mutex
+	limit  int // 0 for no limit
+	counts map[string]int
+}
+
+func newPendingTxs(limit int) *pendingTxs {
+	return &pendingTxs{
+		limit:  limit,
+		counts: make(map[string]int),
+	}
+}
+
+// add counts a tx of the signers. If enforce is set, the tx is not counted and
+// false is returned when one of the signers has reached the limit.
+func (p *pendingTxs) add(signers []string, enforce bool) bool {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if enforce && p.limit > 0 {
+		for _, signer := range signers {
+			if p.counts[signer] >= p.limit {
+				return false
+			}
+		}
+	}
+
+	for _, signer := range signers {
+		p.counts[signer]++
+	}
+	return true
+}
+
+func (p *pendingTxs) remove(signers []string) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	for _, signer := range signers {
+		if p.counts[signer] <= 1 {
+			delete(p.counts, signer)
+		} else {
+			p.counts[signer]--
+		}
+	}
+}
+
+// checkTxQueue hands the CheckTx requests whose previous txs of the same
+// signers are checked already to the CheckTx workers, highest fee per gas
+// first and in arrival order on a tie.
+type checkTxQueue struct {
+	mtx   sync.Mutex
+	cond  *sync.Cond
+	items checkTxHeap
+	seq   uint64
+}
+
+func newCheckTxQueue() *checkTxQueue {
+	q := &checkTxQueue{}
+	q.cond = sync.NewCond(&q.mtx)
+	return q
+}
+
+func (q *checkTxQueue) push(req *RequestCheckTxAsync) {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	req.seq = q.seq
+	q.seq++
+	heap.Push(&q.items, req)
+	telemetry.SetGauge(float32(q.items.Len()), "check_tx", "queue_depth")
+
+	q.cond.Signal()
+}
+
+// pop blocks until a request is available and returns the one with the
+// highest priority.
+func (q *checkTxQueue) pop() *RequestCheckTxAsync {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	for q.items.Len() == 0 {
+		q.cond.Wait()
+	}
+
+	req := heap.Pop(&q.items).(*RequestCheckTxAsync)
+	telemetry.SetGauge(float32(q.items.Len()), "check_tx", "queue_depth")
+	return req
+}
+
+// checkTxHeap implements heap.Interface.
+type checkTxHeap []*RequestCheckTxAsync
+
+func (h checkTxHeap) Len() int { return len(h) }
+
+func (h checkTxHeap) Less(i, j int) bool {
+	if !h[i].priority.Equal(h[j].priority) {
+		return h[i].priority.GT(h[j].priority)
+	}
+	return h[i].seq < h[j].seq
+}
+
+func (h checkTxHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *checkTxHeap) Push(x interface{}) {
+	*h = append(*h, x.(*RequestCheckTxAsync))
+}
+
+func (h *checkTxHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return item
+}
+
+// feePerGas returns the fee of the tx in the given denomination divided by its
+// gas limit. The amounts of the other denominations are ignored, as they can
+// not be compared with it, so that a tx paying in a worthless denomination
+// does not outrank the others. Txs without a fee in the denomination or
+// without a gas limit get zero.
+func feePerGas(tx sdk.Tx, denom string) sdk.Dec {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || feeTx.GetGas() == 0 || denom == "" {
+		return sdk.ZeroDec()
+	}
+
+	amount := feeTx.GetFee().AmountOf(denom)
+	return amount.ToDec().Quo(sdk.NewIntFromUint64(feeTx.GetGas()).ToDec())
+}
+
+// checkTxPriorityDenom returns the denomination the CheckTx priority is
+// computed in: the one set by SetCheckTxPriorityDenom or, when not set, the
+// first one of the minimum gas prices, which are sorted by denomination. It is
+// empty if neither is set, in which case the txs are checked in arrival order.
+func (app *BaseApp) checkTxPriorityDenom() string {
+	if app.checkTxPriorityFeeDenom != "" {
+		return app.checkTxPriorityFeeDenom
+	}
+	if len(app.minGasPrices) > 0 {
+		return app.minGasPrices[0].Denom
+	}
+	return ""
+}
@@ -0,0 +1,130 @@
+package baseapp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/auth/legacy/legacytx"
+)
+
+func TestPendingTxsLimit(t *testing.T) {
+	pending := newPendingTxs(2)
+
+	require.True(t, pending.add([]string{"a", "b"}, true))
+	require.True(t, pending.add([]string{"a"}, true))
+	// "a" reached the limit
+	require.False(t, pending.add([]string{"a", "c"}, true))
+	require.Equal(t, 0, pending.counts["c"])
+	// rechecked txs are counted regardless of the limit
+	require.True(t, pending.add([]string{"a"}, false))
+	require.Equal(t, 3, pending.counts["a"])
+
+	pending.remove([]string{"a"})
+	require.False(t, pending.add([]string{"a"}, true))
+	pending.remove([]string{"a", "b"})
+	require.True(t, pending.add([]string{"a"}, true))
+
+	pending.remove([]string{"a"})
+	pending.remove([]string{"a"})
+	require.Empty(t, pending.counts)
+
+	unlimited := newPendingTxs(0)
+	for i := 0; i < 100; i++ {
+		require.True(t, unlimited.add([]string{"a"}, true))
+	}
+}
+
+func TestCheckTxQueueOrder(t *testing.T) {
+	queue := newCheckTxQueue()
+
+	reqs := []*RequestCheckTxAsync{
+		{priority: sdk.NewDec(1)},
+		{priority: sdk.NewDec(3)},
+		{priority: sdk.NewDec(2)},
+		{priority: sdk.NewDec(3)},
+		{priority: sdk.ZeroDec()},
+	}
+	for _, req := range reqs {
+		queue.push(req)
+	}
+
+	// highest priority first, in arrival order on a tie
+	for _, i := range []int{1, 3, 2, 0, 4} {
+		require.Equal(t, reqs[i], queue.pop())
+	}
+}
+
+func TestFeePerGas(t *testing.T) {
+	testCases := map[string]struct {
+		tx       sdk.Tx
+		denom    string
+		expected sdk.Dec
+	}{
+		"no fee tx": {
+			tx:       txTest{},
+			denom:    "stake",
+			expected: sdk.ZeroDec(),
+		},
+		"no gas": {
+			tx:       legacytx.NewStdTx(nil, legacytx.NewStdFee(0, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))), nil, 0, ""),
+			denom:    "stake",
+			expected: sdk.ZeroDec(),
+		},
+		"single denom": {
+			tx:       legacytx.NewStdTx(nil, legacytx.NewStdFee(4, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))), nil, 0, ""),
+			denom:    "stake",
+			expected: sdk.NewDecWithPrec(25, 1),
+		},
+		"several denoms": {
+			tx:       legacytx.NewStdTx(nil, legacytx.NewStdFee(4, sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("atom", 2))), nil, 0, ""),
+			denom:    "stake",
+			expected: sdk.NewDecWithPrec(25, 1),
+		},
+		"other denom only": {
+			tx:       legacytx.NewStdTx(nil, legacytx.NewStdFee(4, sdk.NewCoins(sdk.NewInt64Coin("worthless", 1000000))), nil, 0, ""),
+			denom:    "stake",
+			expected: sdk.ZeroDec(),
+		},
+		"no denom": {
+			tx:       legacytx.NewStdTx(nil, legacytx.NewStdFee(4, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))), nil, 0, ""),
+			denom:    "",
+			expected: sdk.ZeroDec(),
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			require.True(t, tc.expected.Equal(feePerGas(tc.tx, tc.denom)), feePerGas(tc.tx, tc.denom).String())
+		})
+	}
+}
+
+func TestFeePerGasMixedDenoms(t *testing.T) {
+	paying := legacytx.NewStdTx(nil, legacytx.NewStdFee(4, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))), nil, 0, "")
+	gaming := legacytx.NewStdTx(nil, legacytx.NewStdFee(4, sdk.NewCoins(sdk.NewInt64Coin("stake", 1), sdk.NewInt64Coin("worthless", 1000000))), nil, 0, "")
+
+	// a huge amount of a worthless denom does not outrank a higher fee in the
+	// priority denom
+	queue := newCheckTxQueue()
+	queue.push(&RequestCheckTxAsync{priority: feePerGas(gaming, "stake")})
+	queue.push(&RequestCheckTxAsync{priority: feePerGas(paying, "stake")})
+	require.True(t, sdk.NewDecWithPrec(25, 1).Equal(queue.pop().priority))
+	require.True(t, sdk.NewDecWithPrec(25, 2).Equal(queue.pop().priority))
+}
+
+func TestCheckTxPriorityDenom(t *testing.T) {
+	app := newBaseApp(t.Name())
+	require.Equal(t, "", app.checkTxPriorityDenom())
+
+	// the alphabetically first denom of the minimum gas prices by default, not
+	// the first one configured
+	app = newBaseApp(t.Name(), SetMinGasPrices("0.1stake,0.01atom"))
+	require.Equal(t, "atom", app.checkTxPriorityDenom())
+
+	// the configured denom otherwise
+	app = newBaseApp(t.Name(), SetMinGasPrices("0.1stake,0.01atom"), SetCheckTxPriorityDenom("stake"))
+	require.Equal(t, "stake", app.checkTxPriorityDenom())
+}
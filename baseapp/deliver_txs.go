@@ -0,0 +1,350 @@
+package baseapp
+
+import (
+	"sync"
+	"time"
+
+	abci "github.com/line/ostracon/abci/types"
+
+	"github.com/line/lbm-sdk/store/cachekv"
+	"github.com/line/lbm-sdk/telemetry"
+	sdk "github.com/line/lbm-sdk/types"
+)
+
+// accessTrackingMultiStore is a multi-store which can be branched with the
+// accesses made through the branch recorded, i.e. cachemulti.Store.
+type accessTrackingMultiStore interface {
+	CacheMultiStoreWithAccessSets() (sdk.CacheMultiStore, map[sdk.StoreKey]*cachekv.AccessSet)
+}
+
+// deliverTxTask is a tx of the block being delivered by DeliverTxs.
+type deliverTxTask struct {
+	txBytes []byte
+	tx      sdk.Tx // nil if the tx could not be decoded
+
+	signals  []*AccountWG
+	executed chan struct{}
+
+	// number of txs merged into the block state before the execution started
+	since      int
+	ms         sdk.CacheMultiStore
+	accessSets map[sdk.StoreKey]*cachekv.AccessSet
+
+	// fees to credit to the fee collector when the task is merged
+	deferredFees *sdk.DeferredFees
+
+	gInfo  sdk.GasInfo
+	result *sdk.Result
+	err    error
+}
+
+// DeliverTxs delivers the txs of a block in order and returns their responses.
+//
+// With more than one deliver tx worker (see SetDeliverTxWorkers), the txs are
+// executed in parallel, each on its own branch of the block state recording
+// the keys it reads, iterates over and writes. Txs sharing a signer or a fee
+// granter are still executed one after the other using AccountWGs. The
+// branches are then merged into the block state in block order. A tx which
+// read a key written by a tx merged after its execution started, or whose gas
+// does not fit in the block gas meter any more, is executed again on the
+// merged state instead. The resulting state and responses are thus the same as
+// delivering the txs one by one with DeliverTx.
+//
+// As every tx paying fees would otherwise write the balance of the fee
+// collector, the txs defer the credit of their fees (see sdk.DeferredFees),
+// which is applied when they are merged and charged to their gas.
+//
+// NOTE: Parallel execution requires the keepers of the app to keep their whole
+// state in the stores; in-memory state shared between txs is not tracked.
+func (app *BaseApp) DeliverTxs(reqs []abci.RequestDeliverTx) []abci.ResponseDeliverTx {
+	ms, ok := app.deliverState.ms.(accessTrackingMultiStore)
+	if app.deliverTxWorkers <= 1 || app.anteHandler == nil || !ok {
+		responses := make([]abci.ResponseDeliverTx, len(reqs))
+		for i, req := range reqs {
+			responses[i] = app.DeliverTx(req)
+		}
+		return responses
+	}
+
+	defer telemetry.MeasureSince(time.Now(), "abci", "deliver_txs")
+
+	tasks := make([]*deliverTxTask, len(reqs))
+	for i, req := range reqs {
+		tasks[i] = &deliverTxTask{
+			txBytes:  req.Tx,
+			executed: make(chan struct{}),
+		}
+	}
+
+	accountWGs := NewAccountWGs()
+	merger := newTxMerger()
+
+	go app.scheduleDeliverTxs(ms, tasks, accountWGs, merger)
+
+	responses := make([]abci.ResponseDeliverTx, len(tasks))
+	for i, task := range tasks {
+		<-task.executed
+		responses[i] = app.mergeDeliverTx(ms, task, merger)
+		app.listenDeliverTx(reqs[i], responses[i])
+		accountWGs.Done(task.signals)
+	}
+
+	return responses
+}
+
+// scheduleDeliverTxs executes the tasks with at most deliverTxWorkers of them
+// running at the same time. The tasks acquire a worker in block order, so the
+// lowest task not merged yet is always able to run.
+func (app *BaseApp) scheduleDeliverTxs(ms accessTrackingMultiStore, tasks []*deliverTxTask, accountWGs *AccountWGs, merger *txMerger) {
+	workers := make(chan struct{}, app.deliverTxWorkers)
+
+	for _, task := range tasks {
+		task.tx, task.err = app.txDecoder(task.txBytes)
+		if task.err != nil {
+			close(task.executed)
+			continue
+		}
+
+		// a tx waits for the previous txs of its signers to be merged
+		var waits []*sync.WaitGroup
+		waits, task.signals = accountWGs.Register(task.tx)
+
+		workers <- struct{}{}
+		go func(task *deliverTxTask, waits []*sync.WaitGroup) {
+			defer func() {
+				<-workers
+				close(task.executed)
+			}()
+
+			accountWGs.Wait(waits)
+			app.executeDeliverTx(ms, task, merger.mergedTxs())
+		}(task, waits)
+	}
+}
+
+// executeDeliverTx runs the tx of the task on a new branch of the block state.
+// The tx gets its own gas meters, as the ones of the block state are only
+// updated when the task is merged.
+func (app *BaseApp) executeDeliverTx(ms accessTrackingMultiStore, task *deliverTxTask, since int) {
+	task.since = since
+	task.ms, task.accessSets = ms.CacheMultiStoreWithAccessSets()
+
+	task.deferredFees = new(sdk.DeferredFees)
+
+	ctx := app.getContextForTx(app.deliverState, task.txBytes).
+		WithMultiStore(task.ms).
+		WithGasMeter(sdk.NewInfiniteGasMeter()).
+		WithBlockGasMeter(sdk.NewInfiniteGasMeter()).
+		WithEventManager(sdk.NewEventManager()).
+		WithDeferredFees(task.deferredFees)
+
+	task.gInfo, task.result, task.err = app.runTxWithContext(ctx, task.txBytes, task.tx, false)
+}
+
+// mergeDeliverTx writes the branch of the task to the block state, executing
+// the tx again beforehand if its execution may differ from the serial one.
+func (app *BaseApp) mergeDeliverTx(ms accessTrackingMultiStore, task *deliverTxTask, merger *txMerger) abci.ResponseDeliverTx {
+	if task.tx == nil {
+		return app.deliverTxResponse(sdk.GasInfo{}, nil, task.err)
+	}
+
+	blockGasMeter := app.deliverState.ctx.BlockGasMeter()
+
+	// GasWanted is zero when the ante handler did not set up the gas meter of
+	// the tx, in which case the tx consumed gas of the block state gas meter.
+	reexecute := task.gInfo.GasWanted == 0 || merger.conflicts(task)
+
+	var creditMs sdk.CacheMultiStore
+	var creditAccessSets map[sdk.StoreKey]*cachekv.AccessSet
+	if !reexecute && !task.deferredFees.Empty() {
+		var ok bool
+		creditMs, creditAccessSets, ok = app.creditDeferredFees(ms, task)
+		reexecute = !ok
+	}
+
+	gas := task.gInfo.GasUsed
+	if gas > task.gInfo.GasWanted {
+		gas = task.gInfo.GasWanted
+	}
+	if reexecute || !fitsBlockGas(blockGasMeter, gas) {
+		telemetry.IncrCounter(1, "tx", "reexecuted")
+
+		creditMs, creditAccessSets = nil, nil
+		task.ms, task.accessSets = ms.CacheMultiStoreWithAccessSets()
+		ctx := app.getContextForTx(app.deliverState, task.txBytes).WithMultiStore(task.ms)
+		task.gInfo, task.result, task.err = app.runTxWithContext(ctx, task.txBytes, task.tx, false)
+	} else {
+		blockGasMeter.ConsumeGas(gas, "block gas meter")
+	}
+
+	task.ms.Write()
+	if creditMs != nil {
+		creditMs.Write()
+	}
+	merger.merge(task.accessSets, creditAccessSets)
+
+	return app.deliverTxResponse(task.gInfo, task.result, task.err)
+}
+
+// creditDeferredFees applies the fees deferred by the task on a new branch of
+// the block state, charging the gas consumed to the tx. It returns false if
+// the tx has to be executed again instead: a serial execution credits the fees
+// before the tx goes on, so the tx must have succeeded within its gas limit
+// with the credit, and must not have accessed the balances it updates.
+func (app *BaseApp) creditDeferredFees(ms accessTrackingMultiStore, task *deliverTxTask) (sdk.CacheMultiStore, map[sdk.StoreKey]*cachekv.AccessSet, bool) {
+	if task.err != nil {
+		return nil, nil, false
+	}
+
+	creditMs, accessSets := ms.CacheMultiStoreWithAccessSets()
+	gasMeter := sdk.NewGasMeter(task.gInfo.GasWanted)
+	gasMeter.ConsumeGas(task.gInfo.GasUsed, "deferred fees")
+
+	ctx := app.deliverState.ctx.
+		WithMultiStore(creditMs).
+		WithGasMeter(gasMeter).
+		WithEventManager(sdk.NewEventManager())
+	if !creditWithRecovery(ctx, task.deferredFees) || accessesWrites(task.accessSets, accessSets) {
+		return nil, nil, false
+	}
+
+	task.gInfo.GasUsed = gasMeter.GasConsumed()
+	return creditMs, accessSets, true
+}
+
+// creditWithRecovery credits the fees, returning false if it failed, including
+// by running out of gas.
+func creditWithRecovery(ctx sdk.Context, fees *sdk.DeferredFees) (ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			ok = false
+		}
+	}()
+
+	return fees.Credit(ctx) == nil
+}
+
+// accessesWrites returns whether the accesses recorded in accessSets read,
+// iterated over or wrote a key written in writeSets.
+func accessesWrites(accessSets, writeSets map[sdk.StoreKey]*cachekv.AccessSet) bool {
+	for storeKey, writeSet := range writeSets {
+		accessSet, ok := accessSets[storeKey]
+		if !ok {
+			continue
+		}
+
+		writes := writeSet.Writes()
+		if len(writes) == 0 {
+			continue
+		}
+
+		accessed := make(map[string]struct{})
+		for _, key := range accessSet.Reads() {
+			accessed[string(key)] = struct{}{}
+		}
+		for _, key := range accessSet.Writes() {
+			accessed[string(key)] = struct{}{}
+		}
+		ranges := accessSet.Ranges()
+
+		for _, key := range writes {
+			if _, ok := accessed[string(key)]; ok {
+				return true
+			}
+			for _, r := range ranges {
+				if cachekv.IsKeyInDomain(key, r.Start, r.End) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// fitsBlockGas returns whether consuming gas succeeds on the block gas meter.
+func fitsBlockGas(meter sdk.GasMeter, gas sdk.Gas) bool {
+	consumed := meter.GasConsumed()
+	if meter.IsOutOfGas() || consumed+gas < consumed {
+		return false
+	}
+
+	limit := meter.Limit()
+	return limit == 0 || consumed+gas <= limit
+}
+
+// txMerger tracks the keys written to the block state by the merged txs.
+type txMerger struct {
+	mtx    sync.Mutex
+	merged int
+
+	// the number of txs merged before the last tx writing the key
+	versions map[sdk.StoreKey]map[string]int
+}
+
+func newTxMerger() *txMerger {
+	return &txMerger{
+		versions: make(map[sdk.StoreKey]map[string]int),
+	}
+}
+
+// mergedTxs returns the number of txs merged so far.
+func (m *txMerger) mergedTxs() int {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	return m.merged
+}
+
+// conflicts returns whether the task read or iterated over a key written by a
+// tx merged after its execution started.
+func (m *txMerger) conflicts(task *deliverTxTask) bool {
+	for storeKey, accessSet := range task.accessSets {
+		versions := m.versions[storeKey]
+		if len(versions) == 0 {
+			continue
+		}
+
+		for _, key := range accessSet.Reads() {
+			if version, ok := versions[string(key)]; ok && version >= task.since {
+				return true
+			}
+		}
+
+		for _, r := range accessSet.Ranges() {
+			for key, version := range versions {
+				if version >= task.since && cachekv.IsKeyInDomain([]byte(key), r.Start, r.End) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// merge records the keys written by a task, given the access sets of the
+// branches it wrote to the block state already.
+func (m *txMerger) merge(accessSets ...map[sdk.StoreKey]*cachekv.AccessSet) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	for _, sets := range accessSets {
+		for storeKey, accessSet := range sets {
+			writes := accessSet.Writes()
+			if len(writes) == 0 {
+				continue
+			}
+
+			versions := m.versions[storeKey]
+			if versions == nil {
+				versions = make(map[string]int)
+				m.versions[storeKey] = versions
+			}
+			for _, key := range writes {
+				versions[string(key)] = m.merged
+			}
+		}
+	}
+
+	m.merged++
+}
@@ -0,0 +1,136 @@
+package baseapp
+
+import (
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	abci "github.com/line/ostracon/abci/types"
+	ocproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/codec"
+	sdk "github.com/line/lbm-sdk/types"
+)
+
+// setupDeliverTxsApp returns an app whose msgKeyValue handler appends the value
+// to the one stored under the key, or stores the number of keys when the key
+// is "count". The number of executed messages is counted in executions.
+func setupDeliverTxsApp(t *testing.T, workers int, maxGas int64, executions *int64) *BaseApp {
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			return ctx.WithGasMeter(sdk.NewGasMeter(100000)), nil
+		})
+	}
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgKeyValue, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			atomic.AddInt64(executions, 1)
+
+			kv := msg.(*msgKeyValue)
+			store := ctx.KVStore(capKey2)
+			if string(kv.Key) == "count" {
+				iter := store.Iterator(nil, nil)
+				defer iter.Close()
+
+				count := 0
+				for ; iter.Valid(); iter.Next() {
+					count++
+				}
+				store.Set(kv.Key, []byte(fmt.Sprintf("%d", count)))
+				return &sdk.Result{}, nil
+			}
+
+			store.Set(kv.Key, append(store.Get(kv.Key), kv.Value...))
+			return &sdk.Result{Data: store.Get(kv.Key)}, nil
+		}))
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt, SetDeliverTxWorkers(workers))
+	app.InitChain(abci.RequestInitChain{
+		ConsensusParams: &abci.ConsensusParams{
+			Block: &abci.BlockParams{
+				MaxGas: maxGas,
+			},
+		},
+	})
+
+	return app
+}
+
+func deliverTxsBlock(app *BaseApp, height int64, reqs []abci.RequestDeliverTx) ([]abci.ResponseDeliverTx, []byte) {
+	app.BeginBlock(abci.RequestBeginBlock{Header: ocproto.Header{Height: height}})
+	responses := app.DeliverTxs(reqs)
+	app.EndBlock(abci.RequestEndBlock{})
+	res := app.Commit()
+
+	return responses, res.Data
+}
+
+func newKeyValueTxs(t *testing.T, kvs ...string) []abci.RequestDeliverTx {
+	cdc := codec.NewLegacyAmino()
+	registerTestCodec(cdc)
+
+	reqs := make([]abci.RequestDeliverTx, 0, len(kvs)/2)
+	for i := 0; i < len(kvs); i += 2 {
+		tx := &txTest{Msgs: []sdk.Msg{msgKeyValue{Key: []byte(kvs[i]), Value: []byte(kvs[i+1])}}}
+		txBytes, err := cdc.MarshalBinaryBare(tx)
+		require.NoError(t, err)
+		reqs = append(reqs, abci.RequestDeliverTx{Tx: txBytes})
+	}
+
+	return reqs
+}
+
+func TestDeliverTxsIndependent(t *testing.T) {
+	var executions int64
+	app := setupDeliverTxsApp(t, 4, 0, &executions)
+
+	var kvs []string
+	for i := 0; i < 20; i++ {
+		kvs = append(kvs, fmt.Sprintf("key%d", i), "value")
+	}
+	responses, _ := deliverTxsBlock(app, 1, newKeyValueTxs(t, kvs...))
+
+	require.Len(t, responses, 20)
+	for _, res := range responses {
+		require.True(t, res.IsOK(), res.Log)
+	}
+	// none of the txs needed to be executed again
+	require.Equal(t, int64(20), executions)
+}
+
+func TestDeliverTxsMatchesDeliverTx(t *testing.T) {
+	testCases := map[string]struct {
+		maxGas int64
+		kvs    []string
+	}{
+		"conflicting writes": {
+			kvs: []string{"a", "1", "b", "1", "a", "2", "c", "1", "a", "3", "b", "2"},
+		},
+		"iteration": {
+			kvs: []string{"a", "1", "count", "", "b", "1", "count", "", "c", "1"},
+		},
+		"block gas limit": {
+			maxGas: 10000,
+			kvs:    []string{"a", "1", "b", "1", "a", "2", "c", "1", "d", "1", "e", "1", "f", "1", "g", "1"},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			var serialExecutions, parallelExecutions int64
+			serial := setupDeliverTxsApp(t, 0, tc.maxGas, &serialExecutions)
+			parallel := setupDeliverTxsApp(t, 4, tc.maxGas, &parallelExecutions)
+
+			reqs := newKeyValueTxs(t, tc.kvs...)
+			for height := int64(1); height <= 3; height++ {
+				expected, expectedHash := deliverTxsBlock(serial, height, reqs)
+				actual, hash := deliverTxsBlock(parallel, height, reqs)
+
+				require.Equal(t, expected, actual)
+				require.Equal(t, expectedHash, hash)
+			}
+		})
+	}
+}
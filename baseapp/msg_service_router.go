@@ -17,6 +17,7 @@ import (
 type MsgServiceRouter struct {
 	interfaceRegistry codectypes.InterfaceRegistry
 	routes            map[string]MsgServiceHandler
+	typeURLRoutes     map[string]string
 }
 
 var _ gogogrpc.Server = &MsgServiceRouter{}
@@ -24,7 +25,8 @@ var _ gogogrpc.Server = &MsgServiceRouter{}
 // NewMsgServiceRouter creates a new MsgServiceRouter.
 func NewMsgServiceRouter() *MsgServiceRouter {
 	return &MsgServiceRouter{
-		routes: map[string]MsgServiceHandler{},
+		routes:        map[string]MsgServiceHandler{},
+		typeURLRoutes: map[string]string{},
 	}
 }
 
@@ -37,6 +39,17 @@ func (msr *MsgServiceRouter) Handler(methodName string) MsgServiceHandler {
 	return msr.routes[methodName]
 }
 
+// HandlerByTypeURL returns the MsgServiceHandler for the service method whose
+// request type has the given type URL (ex. `/lbm.bank.v1beta1.MsgSend`), or nil
+// if not found. It lets plain sdk.Msgs be routed to their Msg service.
+func (msr *MsgServiceRouter) HandlerByTypeURL(typeURL string) MsgServiceHandler {
+	methodName, found := msr.typeURLRoutes[typeURL]
+	if !found {
+		return nil
+	}
+	return msr.routes[methodName]
+}
+
 // RegisterService implements the gRPC Server.RegisterService method. sd is a gRPC
 // service description, handler is an object which implements that gRPC service.
 //
@@ -83,6 +96,19 @@ func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler inter
 			)
 		}
 
+		// We use a no-op interceptor to avoid actually calling into the handler
+		// itself, we only want to know the concrete request type of the method.
+		var requestTypeName string
+		_, _ = methodHandler(nil, context.Background(), func(i interface{}) error {
+			msg, ok := i.(proto.Message)
+			if !ok {
+				panic(fmt.Errorf("can't register request type %T for service method %s", i, fqMethod))
+			}
+			requestTypeName = proto.MessageName(msg)
+			return nil
+		}, noopInterceptor)
+		msr.typeURLRoutes["/"+requestTypeName] = fqMethod
+
 		msr.routes[fqMethod] = func(ctx sdk.Context, req sdk.MsgRequest) (*sdk.Result, error) {
 			ctx = ctx.WithEventManager(sdk.NewEventManager())
 			interceptor := func(goCtx context.Context, _ interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
@@ -112,3 +138,7 @@ func (msr *MsgServiceRouter) SetInterfaceRegistry(interfaceRegistry codectypes.I
 }
 
 func noopDecoder(_ interface{}) error { return nil }
+
+func noopInterceptor(_ context.Context, _ interface{}, _ *grpc.UnaryServerInfo, _ grpc.UnaryHandler) (interface{}, error) {
+	return nil, nil
+}
@@ -119,3 +119,19 @@ func TestMsgService(t *testing.T) {
 	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
 	require.Equal(t, abci.CodeTypeOK, res.Code, "res=%+v", res)
 }
+
+func TestMsgServiceHandlerByTypeURL(t *testing.T) {
+	db := memdb.NewDB()
+	encCfg := simapp.MakeTestEncodingConfig()
+	app := baseapp.NewBaseApp("test", log.NewOCLogger(log.NewSyncWriter(os.Stdout)), db, encCfg.TxConfig.TxDecoder())
+	app.SetInterfaceRegistry(encCfg.InterfaceRegistry)
+	testdata.RegisterInterfaces(encCfg.InterfaceRegistry)
+	testdata.RegisterMsgServer(
+		app.MsgServiceRouter(),
+		testdata.MsgServerImpl{},
+	)
+
+	require.NotNil(t, app.MsgServiceRouter().HandlerByTypeURL("/testdata.MsgCreateDog"))
+	require.Nil(t, app.MsgServiceRouter().HandlerByTypeURL("/testdata.Msg/CreateDog"))
+	require.Nil(t, app.MsgServiceRouter().HandlerByTypeURL("/testdata.Unknown"))
+}
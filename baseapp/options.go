@@ -54,6 +54,37 @@ func SetTrace(trace bool) func(*BaseApp) {
 	return func(app *BaseApp) { app.setTrace(trace) }
 }
 
+// SetCheckTxWorkers returns a BaseApp option function that sets the number of
+// txs checked at the same time by CheckTxAsync. The txs ready to be checked are
+// then taken by fee per gas. A value of 0 checks every tx as soon as the
+// previous txs of its signers are checked.
+func SetCheckTxWorkers(workers int) func(*BaseApp) {
+	return func(app *BaseApp) { app.setCheckTxWorkers(workers) }
+}
+
+// SetCheckTxMaxPendingPerSigner returns a BaseApp option function that sets the
+// maximum number of txs of a signer waiting to be checked by CheckTxAsync. New
+// txs beyond the limit are rejected. A value of 0 sets no limit.
+func SetCheckTxMaxPendingPerSigner(limit int) func(*BaseApp) {
+	return func(app *BaseApp) { app.setCheckTxMaxPendingPerSigner(limit) }
+}
+
+// SetCheckTxPriorityDenom returns a BaseApp option function that sets the fee
+// denomination the txs waiting to be checked by CheckTxAsync are taken by: the
+// fee per gas in that denomination. Fees in other denominations are ignored.
+// An empty denomination uses the alphabetically first one of the minimum gas
+// prices.
+func SetCheckTxPriorityDenom(denom string) func(*BaseApp) {
+	return func(app *BaseApp) { app.setCheckTxPriorityDenom(denom) }
+}
+
+// SetDeliverTxWorkers returns a BaseApp option function that sets the number of
+// txs DeliverTxs executes in parallel. A value of 0 or 1 delivers the txs one
+// by one.
+func SetDeliverTxWorkers(workers int) func(*BaseApp) {
+	return func(app *BaseApp) { app.setDeliverTxWorkers(workers) }
+}
+
 // SetIndexEvents provides a BaseApp option function that sets the events to index.
 func SetIndexEvents(ie []string) func(*BaseApp) {
 	return func(app *BaseApp) { app.setIndexEvents(ie) }
@@ -65,6 +96,12 @@ func SetInterBlockCache(cache sdk.MultiStorePersistentCache) func(*BaseApp) {
 	return func(app *BaseApp) { app.setInterBlockCache(cache) }
 }
 
+// SetInterBlockCacheWarmUp provides a BaseApp option function that makes the
+// inter-block cache be filled from the latest snapshot at startup.
+func SetInterBlockCacheWarmUp(warmUp bool) func(*BaseApp) {
+	return func(app *BaseApp) { app.setInterBlockCacheWarmUp(warmUp) }
+}
+
 // SetIAVLCacheManager provides a BaseApp option function that sets the iavl CacheManager
 func SetIAVLCacheManager(size int, provider iavl.MetricsProvider) func(*BaseApp) {
 	return func(app *BaseApp) {
@@ -76,6 +113,16 @@ func SetIAVLCacheManager(size int, provider iavl.MetricsProvider) func(*BaseApp)
 	}
 }
 
+// SetStateArchive provides a BaseApp option function that makes the
+// multistore archive every committed state in the db. A nil db disables it.
+func SetStateArchive(db tmdb.DB) func(*BaseApp) {
+	return func(app *BaseApp) {
+		if db != nil {
+			app.cms.SetStateArchive(db)
+		}
+	}
+}
+
 // SetSnapshotInterval sets the snapshot interval.
 func SetSnapshotInterval(interval uint64) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetSnapshotInterval(interval) }
@@ -157,6 +204,22 @@ func (app *BaseApp) SetEndBlocker(endBlocker sdk.EndBlocker) {
 	app.endBlocker = endBlocker
 }
 
+func (app *BaseApp) SetBeginRecheckTxHandler(beginRecheckTx sdk.BeginRecheckTxHandler) {
+	if app.sealed {
+		panic("SetBeginRecheckTxHandler() on sealed BaseApp")
+	}
+
+	app.beginRecheckTx = beginRecheckTx
+}
+
+func (app *BaseApp) SetCommitHandler(commitHandler sdk.CommitHandler) {
+	if app.sealed {
+		panic("SetCommitHandler() on sealed BaseApp")
+	}
+
+	app.commitHandler = commitHandler
+}
+
 func (app *BaseApp) SetAnteHandler(ah sdk.AnteHandler) {
 	if app.sealed {
 		panic("SetAnteHandler() on sealed BaseApp")
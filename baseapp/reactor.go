@@ -2,14 +2,25 @@ package baseapp
 
 import (
 	"sync"
+	"time"
 
 	abci "github.com/line/ostracon/abci/types"
 
+	"github.com/line/lbm-sdk/telemetry"
 	sdk "github.com/line/lbm-sdk/types"
 	sdkerrors "github.com/line/lbm-sdk/types/errors"
 )
 
 func (app *BaseApp) startReactors() {
+	app.checkTxPending = newPendingTxs(app.checkTxMaxPendingPerSigner)
+
+	if app.checkTxWorkers > 0 {
+		app.checkTxQueue = newCheckTxQueue()
+		for i := 0; i < app.checkTxWorkers; i++ {
+			go app.checkTxWorker()
+		}
+	}
+
 	go app.checkTxAsyncReactor()
 }
 
@@ -20,6 +31,12 @@ type RequestCheckTxAsync struct {
 	prepare  *sync.WaitGroup
 	tx       sdk.Tx
 	err      error
+
+	received time.Time
+	signers  []string
+	signals  []*AccountWG
+	priority sdk.Dec
+	seq      uint64
 }
 
 func (app *BaseApp) checkTxAsyncReactor() {
@@ -30,20 +47,55 @@ func (app *BaseApp) checkTxAsyncReactor() {
 			continue
 		}
 
+		// rechecked txs are in the mempool already, so only new txs are limited
+		req.signers = getUniqSigners(req.tx)
+		if !app.checkTxPending.add(req.signers, !req.recheck) {
+			telemetry.IncrCounter(1, "check_tx", "rejected")
+			err := sdkerrors.Wrapf(sdkerrors.ErrMempoolIsFull,
+				"a signer of the tx has %d pending txs already", app.checkTxMaxPendingPerSigner)
+			req.callback(sdkerrors.ResponseCheckTx(err, 0, 0, app.trace))
+			continue
+		}
+
 		waits, signals := app.checkAccountWGs.Register(req.tx)
 
-		go app.checkTxAsync(req, waits, signals)
+		if app.checkTxQueue == nil {
+			go app.checkTxAsync(req, waits, signals)
+		} else {
+			go app.enqueueCheckTx(req, waits, signals)
+		}
 	}
 }
 
 func (app *BaseApp) prepareCheckTx(req *RequestCheckTxAsync) {
 	defer req.prepare.Done()
 	req.tx, req.err = app.preCheckTx(req.txBytes)
+	if req.err == nil {
+		req.priority = feePerGas(req.tx, app.checkTxPriorityDenom())
+	}
+}
+
+// enqueueCheckTx queues the request for the CheckTx workers once the previous
+// txs of its signers are checked.
+func (app *BaseApp) enqueueCheckTx(req *RequestCheckTxAsync, waits []*sync.WaitGroup, signals []*AccountWG) {
+	app.checkAccountWGs.Wait(waits)
+	req.signals = signals
+	app.checkTxQueue.push(req)
+}
+
+func (app *BaseApp) checkTxWorker() {
+	for {
+		req := app.checkTxQueue.pop()
+		app.checkTxAsync(req, nil, req.signals)
+	}
 }
 
 func (app *BaseApp) checkTxAsync(req *RequestCheckTxAsync, waits []*sync.WaitGroup, signals []*AccountWG) {
 	app.checkAccountWGs.Wait(waits)
 	defer app.checkAccountWGs.Done(signals)
+	defer app.checkTxPending.remove(req.signers)
+
+	telemetry.MeasureSince(req.received, "check_tx", "wait_time")
 
 	gInfo, err := app.checkTx(req.txBytes, req.tx, req.recheck)
 
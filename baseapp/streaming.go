@@ -0,0 +1,83 @@
+package baseapp
+
+import (
+	"io"
+	"sync"
+
+	abci "github.com/line/ostracon/abci/types"
+
+	store "github.com/line/lbm-sdk/store/types"
+	sdk "github.com/line/lbm-sdk/types"
+)
+
+// ABCIListener interface used to hook into the ABCI message processing of the BaseApp
+type ABCIListener interface {
+	// ListenBeginBlock updates the streaming service with the latest BeginBlock messages
+	ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error
+	// ListenEndBlock updates the streaming service with the latest EndBlock messages
+	ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error
+	// ListenDeliverTx updates the streaming service with the latest DeliverTx messages
+	ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error
+	// ListenCommit updates the streaming service with the latest Commit message.
+	// The state changes of the block have all been passed to the WriteListeners
+	// when it is called.
+	ListenCommit(ctx sdk.Context, res abci.ResponseCommit) error
+}
+
+// StreamingService interface for registering WriteListeners with the BaseApp and updating the service with the ABCI messages using the hooks
+type StreamingService interface {
+	// Stream is the streaming service loop, awaits kv pairs and writes them to some destination stream or file
+	Stream(wg *sync.WaitGroup) error
+	// Listeners returns the streaming service's listeners for the BaseApp to register
+	Listeners() map[store.StoreKey][]store.WriteListener
+	// ABCIListener interface for hooking into the ABCI messages from inside the BaseApp
+	ABCIListener
+	// Closer interface
+	io.Closer
+}
+
+// SetStreamingService is used to set a streaming service into the BaseApp hooks and load the listeners into the multistore
+func (app *BaseApp) SetStreamingService(s StreamingService) {
+	// add the listeners for each StoreKey
+	for key, lis := range s.Listeners() {
+		app.cms.AddListeners(key, lis)
+	}
+	// register the StreamingService within the BaseApp
+	// BaseApp will pass BeginBlock, DeliverTx, EndBlock and Commit requests and responses to the streaming services to update their ABCI context
+	app.abciListeners = append(app.abciListeners, s)
+}
+
+// The listeners failing are only logged: streaming is not worth halting the
+// chain for.
+
+func (app *BaseApp) listenBeginBlock(req abci.RequestBeginBlock, res abci.ResponseBeginBlock) {
+	for _, listener := range app.abciListeners {
+		if err := listener.ListenBeginBlock(app.deliverState.ctx, req, res); err != nil {
+			app.logger.Error("BeginBlock listening hook failed", "height", req.Header.Height, "err", err)
+		}
+	}
+}
+
+func (app *BaseApp) listenEndBlock(req abci.RequestEndBlock, res abci.ResponseEndBlock) {
+	for _, listener := range app.abciListeners {
+		if err := listener.ListenEndBlock(app.deliverState.ctx, req, res); err != nil {
+			app.logger.Error("EndBlock listening hook failed", "height", req.Height, "err", err)
+		}
+	}
+}
+
+func (app *BaseApp) listenDeliverTx(req abci.RequestDeliverTx, res abci.ResponseDeliverTx) {
+	for _, listener := range app.abciListeners {
+		if err := listener.ListenDeliverTx(app.deliverState.ctx, req, res); err != nil {
+			app.logger.Error("DeliverTx listening hook failed", "err", err)
+		}
+	}
+}
+
+func (app *BaseApp) listenCommit(res abci.ResponseCommit) {
+	for _, listener := range app.abciListeners {
+		if err := listener.ListenCommit(app.deliverState.ctx, res); err != nil {
+			app.logger.Error("Commit listening hook failed", "height", app.deliverState.ctx.BlockHeight(), "err", err)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package baseapp
+
+import (
+	"sync"
+	"testing"
+
+	abci "github.com/line/ostracon/abci/types"
+	ocproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/codec"
+	store "github.com/line/lbm-sdk/store/types"
+	sdk "github.com/line/lbm-sdk/types"
+)
+
+type mockStreamingService struct {
+	beginBlocks, deliverTxs, endBlocks, commits int
+	writes                                      []store.StoreKVPair
+}
+
+func (m *mockStreamingService) ListenBeginBlock(sdk.Context, abci.RequestBeginBlock, abci.ResponseBeginBlock) error {
+	m.beginBlocks++
+	return nil
+}
+
+func (m *mockStreamingService) ListenEndBlock(sdk.Context, abci.RequestEndBlock, abci.ResponseEndBlock) error {
+	m.endBlocks++
+	return nil
+}
+
+func (m *mockStreamingService) ListenDeliverTx(sdk.Context, abci.RequestDeliverTx, abci.ResponseDeliverTx) error {
+	m.deliverTxs++
+	return nil
+}
+
+func (m *mockStreamingService) ListenCommit(sdk.Context, abci.ResponseCommit) error {
+	m.commits++
+	return nil
+}
+
+func (m *mockStreamingService) OnWrite(storeKey store.StoreKey, key []byte, value []byte, delete bool) error {
+	m.writes = append(m.writes, store.StoreKVPair{StoreKey: storeKey.Name(), Delete: delete, Key: key, Value: value})
+	return nil
+}
+
+func (m *mockStreamingService) Listeners() map[store.StoreKey][]store.WriteListener {
+	return map[store.StoreKey][]store.WriteListener{capKey1: {m}}
+}
+
+func (m *mockStreamingService) Stream(*sync.WaitGroup) error { return nil }
+
+func (m *mockStreamingService) Close() error { return nil }
+
+func TestStreamingService(t *testing.T) {
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+	deliverKey := []byte("deliver-key")
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey)))
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt)
+	service := &mockStreamingService{}
+	app.SetStreamingService(service)
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+
+	nBlocks := 2
+	txPerHeight := 3
+
+	for blockN := 0; blockN < nBlocks; blockN++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: ocproto.Header{Height: int64(blockN) + 1}})
+
+		for i := 0; i < txPerHeight; i++ {
+			counter := int64(blockN*txPerHeight + i)
+			txBytes, err := codec.MarshalBinaryBare(newTxCounter(counter, counter))
+			require.NoError(t, err)
+
+			res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+			require.True(t, res.IsOK())
+		}
+
+		app.EndBlock(abci.RequestEndBlock{})
+		require.Empty(t, service.writes, "the state changes are streamed on commit")
+		app.Commit()
+
+		// the ante and msg handlers both write their counter
+		require.Len(t, service.writes, 2)
+		service.writes = nil
+	}
+
+	require.Equal(t, nBlocks, service.beginBlocks)
+	require.Equal(t, nBlocks*txPerHeight, service.deliverTxs)
+	require.Equal(t, nBlocks, service.endBlocks)
+	require.Equal(t, nBlocks, service.commits)
+}
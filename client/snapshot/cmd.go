@@ -0,0 +1,251 @@
+package snapshot
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/line/lbm-sdk/client"
+	"github.com/line/lbm-sdk/server"
+	servertypes "github.com/line/lbm-sdk/server/types"
+	"github.com/line/lbm-sdk/snapshots"
+	sdk "github.com/line/lbm-sdk/types"
+)
+
+const (
+	FlagHeight = "height"
+	FlagOutput = "output"
+)
+
+// Cmd returns the snapshots command, managing the state sync snapshots of a
+// node home directory. The node must not be running.
+func Cmd(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshots",
+		Short: "Manage local state sync snapshots",
+		Long: `Manage the state sync snapshots of the node home directory. Snapshots can be
+dumped to archives and imported by other nodes, which can restore their application
+state from them instead of using p2p state sync. The node must not be running.`,
+		RunE: client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		ListCmd(),
+		ExportCmd(appCreator),
+		DumpCmd(),
+		ImportCmd(),
+		RestoreCmd(appCreator),
+		DeleteCmd(),
+	)
+
+	return cmd
+}
+
+// ListCmd returns the command listing the local snapshots.
+func ListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List local snapshots",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store, err := openSnapshotStore(cmd)
+			if err != nil {
+				return err
+			}
+			snapshots, err := store.List()
+			if err != nil {
+				return err
+			}
+			for _, s := range snapshots {
+				cmd.Printf("height: %d format: %d chunks: %d hash: %X\n", s.Height, s.Format, s.Chunks, s.Hash)
+			}
+			return nil
+		},
+	}
+}
+
+// ExportCmd returns the command taking a snapshot of the application state.
+func ExportCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Take a snapshot of the application state at a height",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, err := newApp(cmd, appCreator)
+			if err != nil {
+				return err
+			}
+			height, _ := cmd.Flags().GetInt64(FlagHeight)
+			if height == 0 {
+				height = app.LastBlockHeight()
+			}
+
+			snapshot, err := app.SnapshotManager().Create(uint64(height))
+			if err != nil {
+				return err
+			}
+			cmd.Printf("snapshot created: height: %d format: %d chunks: %d\n", snapshot.Height, snapshot.Format, snapshot.Chunks)
+			return nil
+		},
+	}
+	cmd.Flags().Int64(FlagHeight, 0, "Height to take the snapshot at (0 for the latest height)")
+
+	return cmd
+}
+
+// DumpCmd returns the command archiving a local snapshot.
+func DumpCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dump <height> <format>",
+		Short: "Dump a local snapshot to an archive file",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseSnapshotID(args)
+			if err != nil {
+				return err
+			}
+			store, err := openSnapshotStore(cmd)
+			if err != nil {
+				return err
+			}
+
+			output, _ := cmd.Flags().GetString(FlagOutput)
+			if output == "" {
+				output = fmt.Sprintf("%d-%d.tar", height, format)
+			}
+			f, err := os.Create(output)
+			if err != nil {
+				return err
+			}
+			if err := store.Dump(height, format, f); err != nil {
+				f.Close()
+				os.Remove(output)
+				return err
+			}
+			if err := f.Close(); err != nil {
+				return err
+			}
+			cmd.Printf("snapshot dumped to %s\n", output)
+			return nil
+		},
+	}
+	cmd.Flags().StringP(FlagOutput, "o", "", "Archive file to write (defaults to <height>-<format>.tar)")
+
+	return cmd
+}
+
+// ImportCmd returns the command loading an archived snapshot into the local
+// snapshots.
+func ImportCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "import <archive-file>",
+		Short: "Import a snapshot archive into the local snapshots",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store, err := openSnapshotStore(cmd)
+			if err != nil {
+				return err
+			}
+			f, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			snapshot, err := store.Import(f)
+			if err != nil {
+				return err
+			}
+			cmd.Printf("snapshot imported: height: %d format: %d chunks: %d\n", snapshot.Height, snapshot.Format, snapshot.Chunks)
+			return nil
+		},
+	}
+}
+
+// RestoreCmd returns the command restoring the application state from a local
+// snapshot.
+func RestoreCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	return &cobra.Command{
+		Use:   "restore <height> <format>",
+		Short: "Restore the application state from a local snapshot",
+		Long: `Restore the application state from a local snapshot. Only the application state is
+restored: the consensus state must be bootstrapped at the same height for the node to start.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseSnapshotID(args)
+			if err != nil {
+				return err
+			}
+			app, err := newApp(cmd, appCreator)
+			if err != nil {
+				return err
+			}
+
+			if err := app.SnapshotManager().RestoreLocalSnapshot(height, format); err != nil {
+				return err
+			}
+			cmd.Printf("application state restored at height %d\n", height)
+			return nil
+		},
+	}
+}
+
+// DeleteCmd returns the command deleting a local snapshot.
+func DeleteCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <height> <format>",
+		Short: "Delete a local snapshot",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseSnapshotID(args)
+			if err != nil {
+				return err
+			}
+			store, err := openSnapshotStore(cmd)
+			if err != nil {
+				return err
+			}
+			return store.Delete(height, format)
+		},
+	}
+}
+
+// openSnapshotStore opens the snapshot store of the node home directory.
+func openSnapshotStore(cmd *cobra.Command) (*snapshots.Store, error) {
+	snapshotDir := filepath.Join(server.GetServerContextFromCmd(cmd).Config.RootDir, "data", "snapshots")
+	db, err := sdk.NewLevelDB("metadata", snapshotDir)
+	if err != nil {
+		return nil, err
+	}
+	return snapshots.NewStore(db, snapshotDir)
+}
+
+// newApp creates the application of the node home directory, on its state.
+func newApp(cmd *cobra.Command, appCreator servertypes.AppCreator) (servertypes.Application, error) {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	db, err := sdk.NewLevelDB("application", filepath.Join(serverCtx.Config.RootDir, "data"))
+	if err != nil {
+		return nil, err
+	}
+
+	app := appCreator(serverCtx.Logger, db, nil, serverCtx.Viper)
+	if app.SnapshotManager() == nil {
+		return nil, fmt.Errorf("snapshots are not enabled in the application")
+	}
+	return app, nil
+}
+
+func parseSnapshotID(args []string) (height uint64, format uint32, err error) {
+	height, err = strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid height %q: %w", args[0], err)
+	}
+	f, err := strconv.ParseUint(args[1], 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid format %q: %w", args[1], err)
+	}
+	return height, uint32(f), nil
+}
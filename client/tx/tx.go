@@ -64,6 +64,8 @@ func GenerateTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 		return err
 	}
 
+	tx.SetFeeGranter(clientCtx.GetFeeGranterAddress())
+
 	json, err := clientCtx.TxConfig.TxJSONEncoder()(tx.GetTx())
 	if err != nil {
 		return err
@@ -100,6 +102,8 @@ func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 		return err
 	}
 
+	tx.SetFeeGranter(clientCtx.GetFeeGranterAddress())
+
 	if !clientCtx.SkipConfirm {
 		out, err := clientCtx.TxConfig.TxJSONEncoder()(tx.GetTx())
 		if err != nil {
@@ -4,6 +4,7 @@ import (
 	bip39 "github.com/cosmos/go-bip39"
 
 	"github.com/line/lbm-sdk/crypto/keys/secp256k1"
+	"github.com/line/lbm-sdk/crypto/keys/secp256r1"
 	"github.com/line/lbm-sdk/crypto/types"
 )
 
@@ -15,6 +16,8 @@ const (
 	MultiType = PubKeyType("multi")
 	// Secp256k1Type uses the Bitcoin secp256k1 ECDSA parameters.
 	Secp256k1Type = PubKeyType("secp256k1")
+	// Secp256r1Type uses the NIST P-256 ECDSA parameters.
+	Secp256r1Type = PubKeyType("secp256r1")
 	// Ed25519Type represents the Ed25519Type signature system.
 	// It is currently not supported for end-user keys (wallets/ledgers).
 	Ed25519Type = PubKeyType("ed25519")
@@ -25,6 +28,8 @@ const (
 var (
 	// Secp256k1 uses the Bitcoin secp256k1 ECDSA parameters.
 	Secp256k1 = secp256k1Algo{}
+	// Secp256r1 uses the NIST P-256 ECDSA parameters.
+	Secp256r1 = secp256r1Algo{}
 )
 
 type DeriveFn func(mnemonic string, bip39Passphrase, hdPath string) ([]byte, error)
@@ -69,3 +74,25 @@ func (s secp256k1Algo) Generate() GenerateFn {
 		return &secp256k1.PrivKey{Key: bzArr}
 	}
 }
+
+type secp256r1Algo struct {
+}
+
+func (s secp256r1Algo) Name() PubKeyType {
+	return Secp256r1Type
+}
+
+// Derive derives and returns the secret for the given seed and HD path.
+// It follows the same BIP32 derivation as secp256k1.
+func (s secp256r1Algo) Derive() DeriveFn {
+	return Secp256k1.Derive()
+}
+
+// Generate generates a secp256r1 private key from the given bytes.
+// The bytes are hashed into a valid P-256 scalar, as not every 32 byte
+// value derived for secp256k1 is one.
+func (s secp256r1Algo) Generate() GenerateFn {
+	return func(bz []byte) types.PrivKey {
+		return secp256r1.GenPrivKeyFromSecret(bz)
+	}
+}
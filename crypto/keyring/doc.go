@@ -43,4 +43,9 @@
 // 			be unlocked and it should be use only for testing purposes.
 // 	memory	Same instance as returned by NewInMemory. This backend uses a transient storage. Keys
 // 			are discarded when the process terminates or the type instance is garbage collected.
+// 	remote	Same instance as returned by NewRemote. This backend stores no key: it discovers the
+// 			keys held by an external signer process and sends every signing request to it
+// 			over gRPC secured by mutual TLS, so that the private keys never live on the client
+// 			host. The signer is reached as configured in keyring-remote/config.toml in the
+// 			keyring directory, unless Options.RemoteSigner is set.
 package keyring
@@ -10,4 +10,8 @@ var (
 	// ErrUnsupportedLanguage is raised when the caller tries to use a
 	// different language than english for creating a mnemonic sentence.
 	ErrUnsupportedLanguage = errors.New("unsupported language: only english is supported")
+
+	// ErrUnsupportedByRemote is raised when the caller tries an operation on
+	// the remote backend which needs the private keys or a local key store.
+	ErrUnsupportedByRemote = errors.New("operation not supported by the remote keyring backend")
 )
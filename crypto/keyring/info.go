@@ -32,6 +32,7 @@ var (
 	_ Info = &ledgerInfo{}
 	_ Info = &offlineInfo{}
 	_ Info = &multiInfo{}
+	_ Info = &remoteInfo{}
 )
 
 // localInfo is the public information about a locally stored key
@@ -245,6 +246,49 @@ func (i multiInfo) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return codectypes.UnpackInterfaces(multiPK, unpacker)
 }
 
+// remoteInfo is the public information about a key held by a remote signer
+type remoteInfo struct {
+	Name   string             `json:"name"`
+	PubKey cryptotypes.PubKey `json:"pubkey"`
+}
+
+func newRemoteInfo(name string, pub cryptotypes.PubKey) Info {
+	return &remoteInfo{
+		Name:   name,
+		PubKey: pub,
+	}
+}
+
+// GetType implements Info interface
+func (i remoteInfo) GetType() KeyType {
+	return TypeRemote
+}
+
+// GetName implements Info interface
+func (i remoteInfo) GetName() string {
+	return i.Name
+}
+
+// GetPubKey implements Info interface
+func (i remoteInfo) GetPubKey() cryptotypes.PubKey {
+	return i.PubKey
+}
+
+// GetAddress implements Info interface
+func (i remoteInfo) GetAddress() types.AccAddress {
+	return types.BytesToAccAddress(i.PubKey.Address())
+}
+
+// GetAlgo implements Info interface
+func (i remoteInfo) GetAlgo() hd.PubKeyType {
+	return hd.PubKeyType(i.PubKey.Type())
+}
+
+// GetPath implements Info interface
+func (i remoteInfo) GetPath() (*hd.BIP44Params, error) {
+	return nil, fmt.Errorf("BIP44 Paths are not available for this type")
+}
+
 // encoding info
 func marshalInfo(i Info) []byte {
 	return legacy.Cdc.MustMarshalBinaryLengthPrefixed(i)
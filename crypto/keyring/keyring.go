@@ -21,6 +21,7 @@ import (
 	"github.com/line/lbm-sdk/codec/legacy"
 	"github.com/line/lbm-sdk/crypto"
 	"github.com/line/lbm-sdk/crypto/hd"
+	"github.com/line/lbm-sdk/crypto/keyring/remote"
 	"github.com/line/lbm-sdk/crypto/ledger"
 	"github.com/line/lbm-sdk/crypto/types"
 	sdk "github.com/line/lbm-sdk/types"
@@ -35,6 +36,7 @@ const (
 	BackendPass    = "pass"
 	BackendTest    = "test"
 	BackendMemory  = "memory"
+	BackendRemote  = "remote"
 )
 
 const (
@@ -148,6 +150,9 @@ type Options struct {
 	SupportedAlgos SigningAlgoList
 	// supported signing algorithms for Ledger
 	SupportedAlgosLedger SigningAlgoList
+	// remote signer of the remote backend; it is read from the
+	// keyring-remote/config.toml file in the keyring directory if nil
+	RemoteSigner *remote.Config
 }
 
 // NewInMemory creates a transient keyring useful for testing
@@ -159,7 +164,7 @@ func NewInMemory(opts ...Option) Keyring {
 
 // New creates a new instance of a keyring.
 // Keyring ptions can be applied when generating the new instance.
-// Available backends are "os", "file", "kwallet", "memory", "pass", "test".
+// Available backends are "os", "file", "kwallet", "memory", "pass", "test", "remote".
 func New(
 	appName, backend, rootDir string, userInput io.Reader, opts ...Option,
 ) (Keyring, error) {
@@ -171,6 +176,8 @@ func New(
 	switch backend {
 	case BackendMemory:
 		return NewInMemory(opts...), err
+	case BackendRemote:
+		return newRemoteKeyringFromConfig(rootDir, opts...)
 	case BackendTest:
 		db, err = keyring.Open(newTestBackendKeyringConfig(appName, rootDir))
 	case BackendFile:
@@ -200,9 +207,13 @@ type keystore struct {
 func infoKey(name string) []byte { return []byte(fmt.Sprintf("%s.%s", name, infoSuffix)) }
 
 func newKeystore(kr keyring.Keyring, opts ...Option) keystore {
+	return keystore{kr, newOptions(opts...)}
+}
+
+func newOptions(opts ...Option) Options {
 	// Default options for keybase
 	options := Options{
-		SupportedAlgos:       SigningAlgoList{hd.Secp256k1},
+		SupportedAlgos:       SigningAlgoList{hd.Secp256k1, hd.Secp256r1},
 		SupportedAlgosLedger: SigningAlgoList{hd.Secp256k1},
 	}
 
@@ -210,7 +221,7 @@ func newKeystore(kr keyring.Keyring, opts ...Option) keystore {
 		optionFn(&options)
 	}
 
-	return keystore{kr, options}
+	return options
 }
 
 func (ks keystore) ExportPubKeyArmor(uid string) (string, error) {
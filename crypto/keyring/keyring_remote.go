@@ -0,0 +1,171 @@
+package keyring
+
+import (
+	"github.com/line/lbm-sdk/codec/legacy"
+	"github.com/line/lbm-sdk/crypto"
+	"github.com/line/lbm-sdk/crypto/hd"
+	"github.com/line/lbm-sdk/crypto/keyring/remote"
+	"github.com/line/lbm-sdk/crypto/types"
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+var _ Keyring = remoteKeystore{}
+
+// remoteKeystore is a keyring whose keys are held by a remote signer. It
+// stores nothing locally: the keys and their public keys are discovered from
+// the signer, and every signature is made by the signer. The operations which
+// need the private keys or a local key store return ErrUnsupportedByRemote.
+type remoteKeystore struct {
+	client  *remote.Client
+	options Options
+}
+
+// NewRemote creates a keyring backed by the remote signer of the given client.
+func NewRemote(client *remote.Client, opts ...Option) Keyring {
+	return remoteKeystore{client, newOptions(opts...)}
+}
+
+func newRemoteKeyringFromConfig(rootDir string, opts ...Option) (Keyring, error) {
+	options := newOptions(opts...)
+
+	cfg := options.RemoteSigner
+	if cfg == nil {
+		readCfg, err := remote.ReadConfig(rootDir)
+		if err != nil {
+			return nil, err
+		}
+		cfg = &readCfg
+	}
+
+	client, err := remote.Dial(*cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return remoteKeystore{client, options}, nil
+}
+
+func (ks remoteKeystore) List() ([]Info, error) {
+	names, err := ks.client.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]Info, 0, len(names))
+	for _, name := range names {
+		info, err := ks.Key(name)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, info)
+	}
+
+	return res, nil
+}
+
+// SupportedAlgorithms returns the keystore Options' supported signing algorithm.
+// for the keyring and Ledger.
+func (ks remoteKeystore) SupportedAlgorithms() (SigningAlgoList, SigningAlgoList) {
+	return ks.options.SupportedAlgos, ks.options.SupportedAlgosLedger
+}
+
+func (ks remoteKeystore) Key(uid string) (Info, error) {
+	pubKey, err := ks.client.PubKey(uid)
+	if err != nil {
+		return nil, err
+	}
+
+	return newRemoteInfo(uid, pubKey), nil
+}
+
+func (ks remoteKeystore) KeyByAddress(address sdk.Address) (Info, error) {
+	infos, err := ks.List()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, info := range infos {
+		if info.GetAddress().Equals(address) {
+			return info, nil
+		}
+	}
+
+	return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, address.String())
+}
+
+func (ks remoteKeystore) Sign(uid string, msg []byte) ([]byte, types.PubKey, error) {
+	return ks.client.Sign(uid, msg)
+}
+
+func (ks remoteKeystore) SignByAddress(address sdk.Address, msg []byte) ([]byte, types.PubKey, error) {
+	info, err := ks.KeyByAddress(address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ks.Sign(info.GetName(), msg)
+}
+
+func (ks remoteKeystore) ExportPubKeyArmor(uid string) (string, error) {
+	info, err := ks.Key(uid)
+	if err != nil {
+		return "", err
+	}
+
+	return crypto.ArmorPubKeyBytes(legacy.Cdc.MustMarshalBinaryBare(info.GetPubKey()), string(info.GetAlgo())), nil
+}
+
+func (ks remoteKeystore) ExportPubKeyArmorByAddress(address sdk.Address) (string, error) {
+	info, err := ks.KeyByAddress(address)
+	if err != nil {
+		return "", err
+	}
+
+	return ks.ExportPubKeyArmor(info.GetName())
+}
+
+func (ks remoteKeystore) Delete(string) error {
+	return ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) DeleteByAddress(sdk.Address) error {
+	return ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) NewMnemonic(string, Language, string, SignatureAlgo) (Info, string, error) {
+	return nil, "", ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) NewAccount(string, string, string, string, SignatureAlgo) (Info, error) {
+	return nil, ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) SaveLedgerKey(string, SignatureAlgo, string, uint32, uint32, uint32) (Info, error) {
+	return nil, ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) SavePubKey(string, types.PubKey, hd.PubKeyType) (Info, error) {
+	return nil, ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) SaveMultisig(string, types.PubKey) (Info, error) {
+	return nil, ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) ImportPrivKey(string, string, string) error {
+	return ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) ImportPubKey(string, string) error {
+	return ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) ExportPrivKeyArmor(string, string) (string, error) {
+	return "", ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) ExportPrivKeyArmorByAddress(sdk.Address, string) (string, error) {
+	return "", ErrUnsupportedByRemote
+}
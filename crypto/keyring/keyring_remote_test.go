@@ -0,0 +1,132 @@
+package keyring
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/crypto/hd"
+	"github.com/line/lbm-sdk/crypto/keyring/remote"
+	"github.com/line/lbm-sdk/crypto/keyring/remote/testutil"
+	"github.com/line/lbm-sdk/crypto/keys/secp256k1"
+	"github.com/line/lbm-sdk/crypto/keys/secp256r1"
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+func startRemoteSigner(t *testing.T) (*remote.InProcessSigner, remote.Config) {
+	files, err := testutil.WriteTLSFiles(t.TempDir())
+	require.NoError(t, err)
+
+	tlsCfg, err := remote.NewServerTLSConfig(files.ServerCertFile, files.ServerKeyFile, files.CACertFile)
+	require.NoError(t, err)
+
+	signer := remote.NewInProcessSigner()
+	addr, err := signer.Start(tlsCfg)
+	require.NoError(t, err)
+	t.Cleanup(signer.Stop)
+
+	return signer, remote.Config{
+		Address:     addr,
+		TLSCertFile: files.ClientCertFile,
+		TLSKeyFile:  files.ClientKeyFile,
+		TLSCAFile:   files.CACertFile,
+	}
+}
+
+func TestRemoteKeyring(t *testing.T) {
+	signer, cfg := startRemoteSigner(t)
+
+	k1 := secp256k1.GenPrivKey()
+	r1 := secp256r1.GenPrivKey()
+	signer.AddKey("k1", k1)
+	signer.AddKey("r1", r1)
+
+	kr, err := New("keybasename", BackendRemote, t.TempDir(), nil, func(options *Options) {
+		options.RemoteSigner = &cfg
+	})
+	require.NoError(t, err)
+
+	// keys are discovered from the signer
+	list, err := kr.List()
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	require.Equal(t, "k1", list[0].GetName())
+	require.Equal(t, TypeRemote, list[0].GetType())
+	require.Equal(t, hd.Secp256k1Type, list[0].GetAlgo())
+	require.True(t, k1.PubKey().Equals(list[0].GetPubKey()))
+	require.Equal(t, "r1", list[1].GetName())
+	require.Equal(t, hd.Secp256r1Type, list[1].GetAlgo())
+	require.True(t, r1.PubKey().Equals(list[1].GetPubKey()))
+
+	info, err := kr.Key("r1")
+	require.NoError(t, err)
+	require.Equal(t, sdk.BytesToAccAddress(r1.PubKey().Address()), info.GetAddress())
+	_, err = info.GetPath()
+	require.Error(t, err)
+
+	info, err = kr.KeyByAddress(sdk.BytesToAccAddress(k1.PubKey().Address()))
+	require.NoError(t, err)
+	require.Equal(t, "k1", info.GetName())
+
+	_, err = kr.Key("unknown")
+	require.True(t, sdkerrors.ErrKeyNotFound.Is(err))
+	_, err = kr.KeyByAddress(sdk.BytesToAccAddress(secp256k1.GenPrivKey().PubKey().Address()))
+	require.True(t, sdkerrors.ErrKeyNotFound.Is(err))
+
+	// signatures are made by the signer
+	msg := []byte("to be signed")
+	sig, pub, err := kr.Sign("k1", msg)
+	require.NoError(t, err)
+	require.True(t, k1.PubKey().Equals(pub))
+	require.True(t, pub.VerifySignature(msg, sig))
+
+	sig, pub, err = kr.SignByAddress(sdk.BytesToAccAddress(r1.PubKey().Address()), msg)
+	require.NoError(t, err)
+	require.True(t, r1.PubKey().Equals(pub))
+	require.True(t, pub.VerifySignature(msg, sig))
+
+	// public keys are exported, and can be imported into a local keyring
+	armor, err := kr.ExportPubKeyArmor("r1")
+	require.NoError(t, err)
+	local := NewInMemory()
+	require.NoError(t, local.ImportPubKey("r1", armor))
+	info, err = local.Key("r1")
+	require.NoError(t, err)
+	require.True(t, r1.PubKey().Equals(info.GetPubKey()))
+
+	// the private keys never leave the signer
+	_, err = kr.ExportPrivKeyArmor("k1", "passphrase")
+	require.Equal(t, ErrUnsupportedByRemote, err)
+	_, _, err = kr.NewMnemonic("new", English, sdk.FullFundraiserPath, hd.Secp256k1)
+	require.Equal(t, ErrUnsupportedByRemote, err)
+	require.Equal(t, ErrUnsupportedByRemote, kr.Delete("k1"))
+	require.Equal(t, ErrUnsupportedByRemote, kr.ImportPrivKey("imported", "armor", "passphrase"))
+}
+
+func TestRemoteKeyringConfigFile(t *testing.T) {
+	signer, cfg := startRemoteSigner(t)
+	signer.AddKey("k1", secp256k1.GenPrivKey())
+
+	dir := t.TempDir()
+
+	// the config file is required
+	_, err := New("keybasename", BackendRemote, dir, nil)
+	require.Error(t, err)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, remote.ConfigDirName), 0700))
+	content := fmt.Sprintf("address = %q\ntls-cert-file = %q\ntls-key-file = %q\ntls-ca-file = %q\n",
+		cfg.Address, cfg.TLSCertFile, cfg.TLSKeyFile, cfg.TLSCAFile)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, remote.ConfigDirName, remote.ConfigFileName), []byte(content), 0600))
+
+	kr, err := New("keybasename", BackendRemote, dir, nil)
+	require.NoError(t, err)
+
+	info, err := kr.Key("k1")
+	require.NoError(t, err)
+	require.Equal(t, TypeRemote, info.GetType())
+}
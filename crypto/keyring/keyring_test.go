@@ -15,6 +15,7 @@ import (
 	"github.com/line/lbm-sdk/crypto/keys/ed25519"
 	"github.com/line/lbm-sdk/crypto/keys/multisig"
 	"github.com/line/lbm-sdk/crypto/keys/secp256k1"
+	"github.com/line/lbm-sdk/crypto/keys/secp256r1"
 	"github.com/line/lbm-sdk/crypto/types"
 	sdk "github.com/line/lbm-sdk/types"
 )
@@ -982,6 +983,47 @@ func TestAltKeyring_Sign(t *testing.T) {
 	require.True(t, key.VerifySignature(msg, sign))
 }
 
+func TestAltKeyring_Secp256r1(t *testing.T) {
+	keyring, err := New(t.Name(), BackendTest, t.TempDir(), nil)
+	require.NoError(t, err)
+
+	uid := "jack"
+	info, mnemonic, err := keyring.NewMnemonic(uid, English, sdk.FullFundraiserPath, hd.Secp256r1)
+	require.NoError(t, err)
+	require.Equal(t, hd.Secp256r1Type, info.GetAlgo())
+	require.IsType(t, &secp256r1.PubKey{}, info.GetPubKey())
+
+	msg := []byte("some message")
+
+	sign, key, err := keyring.Sign(uid, msg)
+	require.NoError(t, err)
+	require.True(t, key.VerifySignature(msg, sign))
+
+	// the same mnemonic derives the same key
+	info2, err := NewInMemory().NewAccount(uid, mnemonic, DefaultBIP39Passphrase, sdk.FullFundraiserPath, hd.Secp256r1)
+	require.NoError(t, err)
+	require.Equal(t, info.GetPubKey(), info2.GetPubKey())
+
+	// the key survives an export and import
+	armor, err := keyring.ExportPrivKeyArmor(uid, "somePass")
+	require.NoError(t, err)
+	require.NoError(t, keyring.Delete(uid))
+	require.NoError(t, keyring.ImportPrivKey(uid, armor, "somePass"))
+
+	imported, err := keyring.Key(uid)
+	require.NoError(t, err)
+	require.Equal(t, info.GetPubKey(), imported.GetPubKey())
+	require.Equal(t, hd.Secp256r1Type, imported.GetAlgo())
+
+	// secp256r1 and secp256k1 keys can be combined in a multisig
+	other, _, err := keyring.NewMnemonic("other", English, sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+	pub := multisig.NewLegacyAminoPubKey(2, []types.PubKey{info.GetPubKey(), other.GetPubKey()})
+	multiInfo, err := keyring.SaveMultisig("multi", pub)
+	require.NoError(t, err)
+	require.Equal(t, pub, multiInfo.GetPubKey())
+}
+
 func TestAltKeyring_SignByAddress(t *testing.T) {
 	keyring, err := New(t.Name(), BackendTest, t.TempDir(), nil)
 	require.NoError(t, err)
@@ -0,0 +1,181 @@
+package remote
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+
+	codectypes "github.com/line/lbm-sdk/codec/types"
+	cryptocodec "github.com/line/lbm-sdk/crypto/codec"
+	cryptotypes "github.com/line/lbm-sdk/crypto/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+// interfaceRegistry unpacks the public keys sent by the signer.
+var interfaceRegistry = codectypes.NewInterfaceRegistry()
+
+func init() {
+	cryptocodec.RegisterInterfaces(interfaceRegistry)
+}
+
+// Client is a client of a remote signer. It remembers the public key
+// discovered for each key name, and rejects the signatures made with another
+// key under that name.
+type Client struct {
+	conn    *grpc.ClientConn
+	signer  SignerClient
+	timeout time.Duration
+
+	mtx     sync.Mutex
+	pubKeys map[string]cryptotypes.PubKey
+}
+
+// Dial connects to the remote signer of the given config over mutual TLS.
+func Dial(cfg Config) (*Client, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	tlsCfg, err := cfg.TLSConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := grpc.Dial(cfg.Address, grpc.WithTransportCredentials(credentials.NewTLS(tlsCfg)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial the remote signer: %w", err)
+	}
+
+	return NewClient(conn, cfg.Timeout), nil
+}
+
+// NewClient returns a client of the remote signer on the given connection.
+// DefaultTimeout is used if timeout is zero.
+func NewClient(conn *grpc.ClientConn, timeout time.Duration) *Client {
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+
+	return &Client{
+		conn:    conn,
+		signer:  NewSignerClient(conn),
+		timeout: timeout,
+		pubKeys: make(map[string]cryptotypes.PubKey),
+	}
+}
+
+// Keys returns the names of all the keys held by the signer.
+func (c *Client) Keys() ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	res, err := c.signer.Keys(ctx, &KeysRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the remote signer keys: %w", err)
+	}
+
+	return res.Names, nil
+}
+
+// PubKey returns the public key of the key with the given name.
+func (c *Client) PubKey(name string) (cryptotypes.PubKey, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	res, err := c.signer.PubKey(ctx, &PubKeyRequest{Name: name})
+	if err != nil {
+		return nil, wrapError(err, name)
+	}
+
+	pubKey, err := unpackPubKey(res.PubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mtx.Lock()
+	c.pubKeys[name] = pubKey
+	c.mtx.Unlock()
+
+	return pubKey, nil
+}
+
+// Sign signs msg with the key with the given name. The signature returned by
+// the signer must be made with the public key discovered for the name, which is
+// queried first if unknown, and is verified before it is handed to the caller.
+func (c *Client) Sign(name string, msg []byte) ([]byte, cryptotypes.PubKey, error) {
+	expected, err := c.discoveredPubKey(name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	res, err := c.signer.Sign(ctx, &SignRequest{Name: name, Msg: msg})
+	if err != nil {
+		return nil, nil, wrapError(err, name)
+	}
+
+	pubKey, err := unpackPubKey(res.PubKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !pubKey.Equals(expected) {
+		return nil, nil, fmt.Errorf("remote signer signed with another public key than the one of key %s", name)
+	}
+
+	if !pubKey.VerifySignature(msg, res.Signature) {
+		return nil, nil, fmt.Errorf("remote signer returned an invalid signature for key %s", name)
+	}
+
+	return res.Signature, pubKey, nil
+}
+
+// discoveredPubKey returns the public key discovered for the name, querying
+// the signer if it is not known yet.
+func (c *Client) discoveredPubKey(name string) (cryptotypes.PubKey, error) {
+	c.mtx.Lock()
+	pubKey, ok := c.pubKeys[name]
+	c.mtx.Unlock()
+
+	if ok {
+		return pubKey, nil
+	}
+
+	return c.PubKey(name)
+}
+
+// Close closes the connection to the signer.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+func unpackPubKey(any *codectypes.Any) (cryptotypes.PubKey, error) {
+	if any == nil {
+		return nil, fmt.Errorf("remote signer returned no public key")
+	}
+
+	var pubKey cryptotypes.PubKey
+	if err := interfaceRegistry.UnpackAny(any, &pubKey); err != nil {
+		return nil, fmt.Errorf("remote signer returned an invalid public key: %w", err)
+	}
+
+	return pubKey, nil
+}
+
+// wrapError maps the NotFound status of the signer to ErrKeyNotFound so that
+// callers can tell a missing key apart from a failing signer.
+func wrapError(err error, name string) error {
+	if status.Code(err) == codes.NotFound {
+		return sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, name)
+	}
+
+	return fmt.Errorf("remote signer call for key %s failed: %w", name, err)
+}
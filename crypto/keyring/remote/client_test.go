@@ -0,0 +1,211 @@
+package remote_test
+
+import (
+	"crypto/tls"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/line/lbm-sdk/crypto/keyring/remote"
+	"github.com/line/lbm-sdk/crypto/keyring/remote/testutil"
+	"github.com/line/lbm-sdk/crypto/keys/secp256k1"
+	"github.com/line/lbm-sdk/crypto/keys/secp256r1"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+func startSigner(t *testing.T) (*remote.InProcessSigner, remote.Config) {
+	files, err := testutil.WriteTLSFiles(t.TempDir())
+	require.NoError(t, err)
+
+	tlsCfg, err := remote.NewServerTLSConfig(files.ServerCertFile, files.ServerKeyFile, files.CACertFile)
+	require.NoError(t, err)
+
+	signer := remote.NewInProcessSigner()
+	addr, err := signer.Start(tlsCfg)
+	require.NoError(t, err)
+	t.Cleanup(signer.Stop)
+
+	return signer, remote.Config{
+		Address:     addr,
+		TLSCertFile: files.ClientCertFile,
+		TLSKeyFile:  files.ClientKeyFile,
+		TLSCAFile:   files.CACertFile,
+	}
+}
+
+func TestClient(t *testing.T) {
+	signer, cfg := startSigner(t)
+
+	k1 := secp256k1.GenPrivKey()
+	r1 := secp256r1.GenPrivKey()
+	signer.AddKey("k1", k1)
+	signer.AddKey("r1", r1)
+
+	client, err := remote.Dial(cfg)
+	require.NoError(t, err)
+	defer client.Close()
+
+	names, err := client.Keys()
+	require.NoError(t, err)
+	require.Equal(t, []string{"k1", "r1"}, names)
+
+	pubKey, err := client.PubKey("r1")
+	require.NoError(t, err)
+	require.True(t, r1.PubKey().Equals(pubKey))
+
+	msg := []byte("to be signed")
+	sig, pubKey, err := client.Sign("k1", msg)
+	require.NoError(t, err)
+	require.True(t, k1.PubKey().Equals(pubKey))
+	require.True(t, k1.PubKey().VerifySignature(msg, sig))
+
+	_, err = client.PubKey("unknown")
+	require.True(t, sdkerrors.ErrKeyNotFound.Is(err))
+	_, _, err = client.Sign("unknown", msg)
+	require.True(t, sdkerrors.ErrKeyNotFound.Is(err))
+}
+
+func TestClientRejectsSignatureOfAnotherKey(t *testing.T) {
+	signer, cfg := startSigner(t)
+	signer.AddKey("k1", secp256k1.GenPrivKey())
+
+	client, err := remote.Dial(cfg)
+	require.NoError(t, err)
+	defer client.Close()
+
+	pubKey, err := client.PubKey("k1")
+	require.NoError(t, err)
+
+	// the signer now signs with a key other than the one it reported
+	signer.AddKey("k1", secp256k1.GenPrivKey())
+
+	_, _, err = client.Sign("k1", []byte("to be signed"))
+	require.Error(t, err)
+
+	// a key signed with before its discovery is discovered first
+	r1 := secp256r1.GenPrivKey()
+	signer.AddKey("r1", r1)
+	_, signedBy, err := client.Sign("r1", []byte("to be signed"))
+	require.NoError(t, err)
+	require.True(t, r1.PubKey().Equals(signedBy))
+	require.False(t, pubKey.Equals(signedBy))
+}
+
+func TestClientRequiresMutualTLS(t *testing.T) {
+	signer, cfg := startSigner(t)
+	signer.AddKey("k1", secp256k1.GenPrivKey())
+
+	// a client trusting the signer but presenting no certificate is rejected
+	tlsCfg, err := cfg.TLSConfig()
+	require.NoError(t, err)
+	tlsCfg.Certificates = nil
+	conn, err := grpc.Dial(cfg.Address, grpc.WithTransportCredentials(credentials.NewTLS(tlsCfg)))
+	require.NoError(t, err)
+	client := remote.NewClient(conn, time.Second)
+	defer client.Close()
+
+	_, err = client.Keys()
+	require.Error(t, err)
+
+	// a client not trusting the signer is rejected
+	otherFiles, err := testutil.WriteTLSFiles(t.TempDir())
+	require.NoError(t, err)
+	cfg.TLSCAFile = otherFiles.CACertFile
+	cfg.Timeout = time.Second
+	client, err = remote.Dial(cfg)
+	require.NoError(t, err)
+	defer client.Close()
+
+	_, err = client.Keys()
+	require.Error(t, err)
+}
+
+func TestConfigValidate(t *testing.T) {
+	valid := remote.Config{
+		Address:     "127.0.0.1:26659",
+		TLSCertFile: "client.pem",
+		TLSKeyFile:  "client-key.pem",
+		TLSCAFile:   "ca.pem",
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func(cfg *remote.Config)
+		expErr   bool
+	}{
+		{"valid", func(*remote.Config) {}, false},
+		{"no address", func(cfg *remote.Config) { cfg.Address = "" }, true},
+		{"no certificate", func(cfg *remote.Config) { cfg.TLSCertFile = "" }, true},
+		{"no key", func(cfg *remote.Config) { cfg.TLSKeyFile = "" }, true},
+		{"no CA", func(cfg *remote.Config) { cfg.TLSCAFile = "" }, true},
+		{"negative timeout", func(cfg *remote.Config) { cfg.Timeout = -time.Second }, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := valid
+			tc.malleate(&cfg)
+			err := cfg.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestReadConfig(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := remote.ReadConfig(dir)
+	require.Error(t, err)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, remote.ConfigDirName), 0700))
+	content := `address = "127.0.0.1:26659"
+tls-cert-file = "client.pem"
+tls-key-file = "client-key.pem"
+tls-ca-file = "ca.pem"
+tls-server-name = "signer.local"
+timeout = "3s"
+`
+	file := filepath.Join(dir, remote.ConfigDirName, remote.ConfigFileName)
+	require.NoError(t, ioutil.WriteFile(file, []byte(content), 0600))
+
+	cfg, err := remote.ReadConfig(dir)
+	require.NoError(t, err)
+	require.Equal(t, remote.Config{
+		Address:       "127.0.0.1:26659",
+		TLSCertFile:   "client.pem",
+		TLSKeyFile:    "client-key.pem",
+		TLSCAFile:     "ca.pem",
+		TLSServerName: "signer.local",
+		Timeout:       3 * time.Second,
+	}, cfg)
+
+	// the TLS files are required
+	require.NoError(t, ioutil.WriteFile(file, []byte(`address = "127.0.0.1:26659"`), 0600))
+	_, err = remote.ReadConfig(dir)
+	require.Error(t, err)
+}
+
+func TestNewServerTLSConfig(t *testing.T) {
+	files, err := testutil.WriteTLSFiles(t.TempDir())
+	require.NoError(t, err)
+
+	tlsCfg, err := remote.NewServerTLSConfig(files.ServerCertFile, files.ServerKeyFile, files.CACertFile)
+	require.NoError(t, err)
+	require.Equal(t, tls.RequireAndVerifyClientCert, tlsCfg.ClientAuth)
+
+	_, err = remote.NewServerTLSConfig(files.ServerCertFile, files.ServerKeyFile, files.ServerKeyFile)
+	require.Error(t, err)
+	_, err = remote.NewServerTLSConfig(files.ServerCertFile, files.ClientKeyFile, files.CACertFile)
+	require.Error(t, err)
+}
@@ -0,0 +1,133 @@
+package remote
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	// ConfigDirName is the name of the directory, inside the keyring directory,
+	// holding the configuration file of the remote keyring backend.
+	ConfigDirName = "keyring-remote"
+	// ConfigFileName is the name of the configuration file of the remote
+	// keyring backend.
+	ConfigFileName = "config.toml"
+
+	// DefaultTimeout is the default timeout of a call to the signer.
+	DefaultTimeout = 10 * time.Second
+)
+
+// Config defines how to reach a remote signer. The connection to the signer
+// is always secured by mutual TLS: the client presents its own certificate
+// and verifies the certificate of the signer against the given CA.
+type Config struct {
+	// Address is the host:port the signer listens on.
+	Address string `mapstructure:"address"`
+	// TLSCertFile and TLSKeyFile are the paths to the PEM encoded certificate
+	// and private key the client authenticates itself with.
+	TLSCertFile string `mapstructure:"tls-cert-file"`
+	TLSKeyFile  string `mapstructure:"tls-key-file"`
+	// TLSCAFile is the path to the PEM encoded CA certificates the certificate
+	// of the signer is verified against.
+	TLSCAFile string `mapstructure:"tls-ca-file"`
+	// TLSServerName overrides the host name the certificate of the signer is
+	// verified against. The host of Address is used if it is empty.
+	TLSServerName string `mapstructure:"tls-server-name"`
+	// Timeout is the timeout of a call to the signer. DefaultTimeout is used
+	// if it is zero.
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+// ReadConfig reads the configuration of the remote keyring backend from the
+// keyring-remote/config.toml file in the given keyring directory.
+func ReadConfig(dir string) (Config, error) {
+	v := viper.New()
+	v.SetConfigFile(filepath.Join(dir, ConfigDirName, ConfigFileName))
+	if err := v.ReadInConfig(); err != nil {
+		return Config{}, fmt.Errorf("failed to read the remote signer config: %w", err)
+	}
+
+	var cfg Config
+	if err := v.Unmarshal(&cfg); err != nil {
+		return Config{}, fmt.Errorf("failed to parse the remote signer config: %w", err)
+	}
+
+	return cfg, cfg.Validate()
+}
+
+// Validate performs a basic validation of the config.
+func (cfg Config) Validate() error {
+	if cfg.Address == "" {
+		return fmt.Errorf("remote signer address must be set")
+	}
+	if cfg.TLSCertFile == "" || cfg.TLSKeyFile == "" || cfg.TLSCAFile == "" {
+		return fmt.Errorf("remote signer TLS certificate, key and CA files must be set")
+	}
+	if cfg.Timeout < 0 {
+		return fmt.Errorf("remote signer timeout must not be negative: %s", cfg.Timeout)
+	}
+
+	return nil
+}
+
+// TLSConfig returns the client side TLS configuration of the config.
+func (cfg Config) TLSConfig() (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(cfg.TLSCertFile, cfg.TLSKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the remote signer client certificate: %w", err)
+	}
+
+	rootCAs, err := loadCertPool(cfg.TLSCAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      rootCAs,
+		ServerName:   cfg.TLSServerName,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// NewServerTLSConfig returns the TLS configuration of a signer serving with the
+// given certificate and accepting only clients whose certificate is issued by
+// one of the CA certificates in clientCAFile.
+func NewServerTLSConfig(certFile, keyFile, clientCAFile string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the remote signer certificate: %w", err)
+	}
+
+	clientCAs, err := loadCertPool(clientCAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    clientCAs,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+func loadCertPool(file string) (*x509.CertPool, error) {
+	bz, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the CA certificates: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(bz) {
+		return nil, fmt.Errorf("no valid CA certificate in %s", file)
+	}
+
+	return pool, nil
+}
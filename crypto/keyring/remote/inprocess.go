@@ -0,0 +1,128 @@
+package remote
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"sort"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+
+	codectypes "github.com/line/lbm-sdk/codec/types"
+	cryptotypes "github.com/line/lbm-sdk/crypto/types"
+)
+
+var _ SignerServer = &InProcessSigner{}
+
+// InProcessSigner is a Signer service holding its private keys in memory.
+// It stands in for an external signer process in tests.
+type InProcessSigner struct {
+	mtx  sync.RWMutex
+	keys map[string]cryptotypes.PrivKey
+
+	server *grpc.Server
+}
+
+// NewInProcessSigner returns a signer holding no keys.
+func NewInProcessSigner() *InProcessSigner {
+	return &InProcessSigner{keys: make(map[string]cryptotypes.PrivKey)}
+}
+
+// AddKey stores the private key under the given name, replacing any key
+// already stored under it.
+func (s *InProcessSigner) AddKey(name string, privKey cryptotypes.PrivKey) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.keys[name] = privKey
+}
+
+// Start serves the signer on a random local port with the given server side
+// TLS configuration and returns the address it listens on.
+func (s *InProcessSigner) Start(tlsCfg *tls.Config) (string, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", err
+	}
+
+	s.server = grpc.NewServer(grpc.Creds(credentials.NewTLS(tlsCfg)))
+	RegisterSignerServer(s.server, s)
+
+	go func() {
+		_ = s.server.Serve(listener)
+	}()
+
+	return listener.Addr().String(), nil
+}
+
+// Stop stops serving the signer.
+func (s *InProcessSigner) Stop() {
+	if s.server != nil {
+		s.server.Stop()
+	}
+}
+
+// Keys implements the Signer/Keys RPC method.
+func (s *InProcessSigner) Keys(_ context.Context, _ *KeysRequest) (*KeysResponse, error) {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	names := make([]string, 0, len(s.keys))
+	for name := range s.keys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return &KeysResponse{Names: names}, nil
+}
+
+// PubKey implements the Signer/PubKey RPC method.
+func (s *InProcessSigner) PubKey(_ context.Context, req *PubKeyRequest) (*PubKeyResponse, error) {
+	privKey, err := s.key(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	any, err := codectypes.NewAnyWithValue(privKey.PubKey())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &PubKeyResponse{PubKey: any}, nil
+}
+
+// Sign implements the Signer/Sign RPC method.
+func (s *InProcessSigner) Sign(_ context.Context, req *SignRequest) (*SignResponse, error) {
+	privKey, err := s.key(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := privKey.Sign(req.Msg)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	any, err := codectypes.NewAnyWithValue(privKey.PubKey())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &SignResponse{Signature: sig, PubKey: any}, nil
+}
+
+func (s *InProcessSigner) key(name string) (cryptotypes.PrivKey, error) {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	privKey, ok := s.keys[name]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "key %s not found", name)
+	}
+
+	return privKey, nil
+}
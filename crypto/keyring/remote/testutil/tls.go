@@ -0,0 +1,108 @@
+package testutil
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"path/filepath"
+	"time"
+)
+
+// TLSFiles are the paths to the PEM encoded files of a throwaway CA and of a
+// signer and a client certificate issued by it.
+type TLSFiles struct {
+	CACertFile     string
+	ServerCertFile string
+	ServerKeyFile  string
+	ClientCertFile string
+	ClientKeyFile  string
+}
+
+// WriteTLSFiles generates a CA together with a signer certificate valid for
+// 127.0.0.1 and a client certificate, and writes them in the given directory.
+func WriteTLSFiles(dir string) (TLSFiles, error) {
+	files := TLSFiles{
+		CACertFile:     filepath.Join(dir, "ca.pem"),
+		ServerCertFile: filepath.Join(dir, "server.pem"),
+		ServerKeyFile:  filepath.Join(dir, "server-key.pem"),
+		ClientCertFile: filepath.Join(dir, "client.pem"),
+		ClientKeyFile:  filepath.Join(dir, "client-key.pem"),
+	}
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return TLSFiles{}, err
+	}
+	caTemplate := newTemplate(1, "remote signer test CA")
+	caTemplate.IsCA = true
+	caTemplate.KeyUsage = x509.KeyUsageCertSign
+	caTemplate.BasicConstraintsValid = true
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		return TLSFiles{}, err
+	}
+	caCert, err := x509.ParseCertificate(caDER)
+	if err != nil {
+		return TLSFiles{}, err
+	}
+	if err := writePEM(files.CACertFile, "CERTIFICATE", caDER); err != nil {
+		return TLSFiles{}, err
+	}
+
+	serverTemplate := newTemplate(2, "remote signer")
+	serverTemplate.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+	serverTemplate.IPAddresses = []net.IP{net.ParseIP("127.0.0.1")}
+	if err := writeCert(files.ServerCertFile, files.ServerKeyFile, serverTemplate, caCert, caKey); err != nil {
+		return TLSFiles{}, err
+	}
+
+	clientTemplate := newTemplate(3, "remote signer client")
+	clientTemplate.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+	if err := writeCert(files.ClientCertFile, files.ClientKeyFile, clientTemplate, caCert, caKey); err != nil {
+		return TLSFiles{}, err
+	}
+
+	return files, nil
+}
+
+func newTemplate(serial int64, commonName string) *x509.Certificate {
+	return &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+}
+
+func writeCert(certFile, keyFile string, template, ca *x509.Certificate, caKey *ecdsa.PrivateKey) error {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+	if err != nil {
+		return err
+	}
+	if err := writePEM(certFile, "CERTIFICATE", der); err != nil {
+		return err
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return err
+	}
+
+	return writePEM(keyFile, "EC PRIVATE KEY", keyDER)
+}
+
+func writePEM(file, blockType string, bz []byte) error {
+	return ioutil.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: bz}), 0600)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/line/lbm-sdk/codec/types"
 	kmultisig "github.com/line/lbm-sdk/crypto/keys/multisig"
 	"github.com/line/lbm-sdk/crypto/keys/secp256k1"
+	"github.com/line/lbm-sdk/crypto/keys/secp256r1"
 	cryptotypes "github.com/line/lbm-sdk/crypto/types"
 	"github.com/line/lbm-sdk/crypto/types/multisig"
 	"github.com/line/lbm-sdk/types/tx/signing"
@@ -261,6 +262,35 @@ func TestPubKeyMultisigThresholdAminoToIface(t *testing.T) {
 	require.Equal(t, multisigKey.Equals(&pubKey), true)
 }
 
+func TestMultisigSecp256r1(t *testing.T) {
+	msg := []byte{1, 2, 3, 4}
+	signBytesFn := func(mode signing.SignMode) ([]byte, error) { return msg, nil }
+
+	privKeys := []cryptotypes.PrivKey{secp256k1.GenPrivKey(), secp256r1.GenPrivKey(), secp256r1.GenPrivKey()}
+	pubKeys := make([]cryptotypes.PubKey, len(privKeys))
+	for i, privKey := range privKeys {
+		pubKeys[i] = privKey.PubKey()
+	}
+	multisigKey := kmultisig.NewLegacyAminoPubKey(2, pubKeys)
+
+	// the secp256k1 and secp256r1 signatures satisfy the threshold together
+	multisignature := multisig.NewMultisig(len(pubKeys))
+	for _, i := range []int{0, 2} {
+		sig, err := privKeys[i].Sign(msg)
+		require.NoError(t, err)
+		require.NoError(t, multisig.AddSignatureFromPubKey(multisignature, &signing.SingleSignatureData{Signature: sig}, pubKeys[i], pubKeys))
+	}
+	require.NoError(t, multisigKey.VerifyMultisignature(signBytesFn, multisignature))
+
+	// the amino encoding keeps the secp256r1 keys
+	ab, err := kmultisig.AminoCdc.MarshalBinaryLengthPrefixed(multisigKey)
+	require.NoError(t, err)
+	var pubKey kmultisig.LegacyAminoPubKey
+	require.NoError(t, kmultisig.AminoCdc.UnmarshalBinaryLengthPrefixed(ab, &pubKey))
+	require.True(t, multisigKey.Equals(&pubKey))
+	require.Equal(t, multisigKey.Address(), pubKey.Address())
+}
+
 func generatePubKeysAndSignatures(n int, msg []byte) (pubKeys []cryptotypes.PubKey, signatures []signing.SignatureData) {
 	pubKeys = make([]cryptotypes.PubKey, n)
 	signatures = make([]signing.SignatureData, n)
@@ -0,0 +1,259 @@
+package secp256r1
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/line/ostracon/crypto"
+	"github.com/line/ostracon/crypto/tmhash"
+
+	"github.com/line/lbm-sdk/codec"
+	cryptotypes "github.com/line/lbm-sdk/crypto/types"
+	"github.com/line/lbm-sdk/types/errors"
+)
+
+var _ cryptotypes.PrivKey = &PrivKey{}
+var _ codec.AminoMarshaler = &PrivKey{}
+
+const (
+	// PrivKeySize is the size, in bytes, of the private scalar.
+	PrivKeySize = 32
+	// SignatureSize is the size, in bytes, of a signature of the form R || S.
+	SignatureSize = 64
+
+	keyType     = "secp256r1"
+	PrivKeyName = "lbm/PrivKeySecp256r1"
+	PubKeyName  = "lbm/PubKeySecp256r1"
+)
+
+var (
+	curve = elliptic.P256()
+
+	// used to reject malleable signatures
+	secp256r1halfN = new(big.Int).Rsh(curve.Params().N, 1)
+)
+
+// Bytes returns the byte representation of the Private Key.
+func (privKey *PrivKey) Bytes() []byte {
+	return privKey.Key
+}
+
+// PubKey performs the point-scalar multiplication from the privKey on the
+// generator point to get the pubkey.
+func (privKey *PrivKey) PubKey() cryptotypes.PubKey {
+	x, y := curve.ScalarBaseMult(privKey.Key)
+	return &PubKey{Key: elliptic.MarshalCompressed(curve, x, y)}
+}
+
+// Sign creates an ECDSA signature on curve P-256, using SHA256 on the msg.
+// The returned signature will be of the form R || S (in lower-S form).
+func (privKey *PrivKey) Sign(msg []byte) ([]byte, error) {
+	if !isValidScalar(privKey.Key) {
+		return nil, fmt.Errorf("invalid privkey")
+	}
+
+	x, y := curve.ScalarBaseMult(privKey.Key)
+	priv := &ecdsa.PrivateKey{
+		PublicKey: ecdsa.PublicKey{Curve: curve, X: x, Y: y},
+		D:         new(big.Int).SetBytes(privKey.Key),
+	}
+
+	r, s, err := ecdsa.Sign(crypto.CReader(), priv, crypto.Sha256(msg))
+	if err != nil {
+		return nil, err
+	}
+
+	// normalize to lower-S form, which is the only form VerifySignature accepts
+	if s.Cmp(secp256r1halfN) > 0 {
+		s.Sub(curve.Params().N, s)
+	}
+
+	return serializeSig(r, s), nil
+}
+
+// Equals - you probably don't need to use this.
+// Runs in constant time based on length of the keys.
+func (privKey *PrivKey) Equals(other cryptotypes.LedgerPrivKey) bool {
+	return privKey.Type() == other.Type() && subtle.ConstantTimeCompare(privKey.Bytes(), other.Bytes()) == 1
+}
+
+func (privKey *PrivKey) Type() string {
+	return keyType
+}
+
+// MarshalAmino overrides Amino binary marshalling.
+func (privKey PrivKey) MarshalAmino() ([]byte, error) {
+	return privKey.Key, nil
+}
+
+// UnmarshalAmino overrides Amino binary marshalling.
+func (privKey *PrivKey) UnmarshalAmino(bz []byte) error {
+	if !isValidScalar(bz) {
+		return fmt.Errorf("invalid privkey")
+	}
+	privKey.Key = bz
+
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshalling.
+func (privKey PrivKey) MarshalAminoJSON() ([]byte, error) {
+	// When we marshal to Amino JSON, we don't marshal the "key" field itself,
+	// just its contents (i.e. the key bytes).
+	return privKey.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshalling.
+func (privKey *PrivKey) UnmarshalAminoJSON(bz []byte) error {
+	return privKey.UnmarshalAmino(bz)
+}
+
+// GenPrivKey generates a new ECDSA private key on curve P-256.
+// It uses OS randomness to generate the private key.
+func GenPrivKey() *PrivKey {
+	return &PrivKey{Key: genPrivKey(crypto.CReader())}
+}
+
+// genPrivKey generates a new secp256r1 private key using the provided reader.
+func genPrivKey(rand io.Reader) []byte {
+	priv, err := ecdsa.GenerateKey(curve, rand)
+	if err != nil {
+		panic(err)
+	}
+
+	return priv.D.FillBytes(make([]byte, PrivKeySize))
+}
+
+var one = new(big.Int).SetInt64(1)
+
+// GenPrivKeyFromSecret hashes the secret with SHA2, and uses
+// that 32 byte output to create the private key.
+//
+// It makes sure the private key is a valid field element by setting:
+//
+// c = sha256(secret)
+// k = (c mod (n − 1)) + 1, where n = curve order.
+//
+// NOTE: secret should be the output of a KDF like bcrypt,
+// if it's derived from user input.
+func GenPrivKeyFromSecret(secret []byte) *PrivKey {
+	secHash := sha256.Sum256(secret)
+	fe := new(big.Int).SetBytes(secHash[:])
+	n := new(big.Int).Sub(curve.Params().N, one)
+	fe.Mod(fe, n)
+	fe.Add(fe, one)
+
+	return &PrivKey{Key: fe.FillBytes(make([]byte, PrivKeySize))}
+}
+
+// isValidScalar returns true if bz is a 32 byte scalar in the range [1, n - 1].
+func isValidScalar(bz []byte) bool {
+	if len(bz) != PrivKeySize {
+		return false
+	}
+
+	d := new(big.Int).SetBytes(bz)
+	return d.Sign() > 0 && d.Cmp(curve.Params().N) < 0
+}
+
+//-------------------------------------
+
+var _ cryptotypes.PubKey = &PubKey{}
+var _ codec.AminoMarshaler = &PubKey{}
+
+// PubKeySize is comprised of 32 bytes for one field element
+// (the x-coordinate), plus one byte for the parity of the y-coordinate.
+const PubKeySize = 33
+
+// Address is the SHA256-20 of the raw pubkey bytes.
+func (pubKey *PubKey) Address() crypto.Address {
+	if len(pubKey.Key) != PubKeySize {
+		panic("length of pubkey is incorrect")
+	}
+
+	return crypto.Address(tmhash.SumTruncated(pubKey.Key))
+}
+
+// Bytes returns the pubkey byte format.
+func (pubKey *PubKey) Bytes() []byte {
+	return pubKey.Key
+}
+
+// VerifySignature verifies a signature of the form R || S.
+// It rejects signatures which are not in lower-S form.
+func (pubKey *PubKey) VerifySignature(msg []byte, sigStr []byte) bool {
+	if len(sigStr) != SignatureSize {
+		return false
+	}
+
+	x, y := elliptic.UnmarshalCompressed(curve, pubKey.Key)
+	if x == nil {
+		return false
+	}
+
+	r := new(big.Int).SetBytes(sigStr[:32])
+	s := new(big.Int).SetBytes(sigStr[32:])
+	// reject malleable signatures
+	if s.Cmp(secp256r1halfN) > 0 {
+		return false
+	}
+
+	return ecdsa.Verify(&ecdsa.PublicKey{Curve: curve, X: x, Y: y}, crypto.Sha256(msg), r, s)
+}
+
+func (pubKey *PubKey) String() string {
+	return fmt.Sprintf("PubKeySecp256r1{%X}", pubKey.Key)
+}
+
+func (pubKey *PubKey) Type() string {
+	return keyType
+}
+
+func (pubKey *PubKey) Equals(other cryptotypes.PubKey) bool {
+	return pubKey.Type() == other.Type() && bytes.Equal(pubKey.Bytes(), other.Bytes())
+}
+
+// MarshalAmino overrides Amino binary marshalling.
+func (pubKey PubKey) MarshalAmino() ([]byte, error) {
+	return pubKey.Key, nil
+}
+
+// UnmarshalAmino overrides Amino binary marshalling.
+func (pubKey *PubKey) UnmarshalAmino(bz []byte) error {
+	if len(bz) != PubKeySize {
+		return errors.Wrap(errors.ErrInvalidPubKey, "invalid pubkey size")
+	}
+	if x, _ := elliptic.UnmarshalCompressed(curve, bz); x == nil {
+		return errors.Wrap(errors.ErrInvalidPubKey, "invalid pubkey point")
+	}
+	pubKey.Key = bz
+
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshalling.
+func (pubKey PubKey) MarshalAminoJSON() ([]byte, error) {
+	// When we marshal to Amino JSON, we don't marshal the "key" field itself,
+	// just its contents (i.e. the key bytes).
+	return pubKey.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshalling.
+func (pubKey *PubKey) UnmarshalAminoJSON(bz []byte) error {
+	return pubKey.UnmarshalAmino(bz)
+}
+
+// serializeSig serializes the signature to R || S.
+// R, S are padded to 32 bytes respectively.
+func serializeSig(r, s *big.Int) []byte {
+	sigBytes := make([]byte, SignatureSize)
+	r.FillBytes(sigBytes[:32])
+	s.FillBytes(sigBytes[32:])
+	return sigBytes
+}
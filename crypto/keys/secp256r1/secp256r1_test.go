@@ -0,0 +1,258 @@
+package secp256r1_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"math/big"
+	"testing"
+
+	"github.com/line/ostracon/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/codec"
+	"github.com/line/lbm-sdk/crypto/keys/ed25519"
+	"github.com/line/lbm-sdk/crypto/keys/secp256k1"
+	"github.com/line/lbm-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/line/lbm-sdk/crypto/types"
+)
+
+func TestSignAndValidateSecp256r1(t *testing.T) {
+	privKey := secp256r1.GenPrivKey()
+	pubKey := privKey.PubKey()
+
+	msg := crypto.CRandBytes(1000)
+	sig, err := privKey.Sign(msg)
+	require.Nil(t, err)
+	require.Len(t, sig, secp256r1.SignatureSize)
+	require.True(t, pubKey.VerifySignature(msg, sig))
+
+	// the signature is in lower-S form; its high-S counterpart is rejected
+	n := elliptic.P256().Params().N
+	s := new(big.Int).SetBytes(sig[32:])
+	require.True(t, s.Cmp(new(big.Int).Rsh(n, 1)) <= 0)
+	malleable := make([]byte, secp256r1.SignatureSize)
+	copy(malleable[:32], sig[:32])
+	new(big.Int).Sub(n, s).FillBytes(malleable[32:])
+	require.False(t, pubKey.VerifySignature(msg, malleable))
+
+	// mutate the signature, just one bit.
+	sig[7] ^= byte(0x01)
+	require.False(t, pubKey.VerifySignature(msg, sig))
+
+	// a wrong sized signature is rejected
+	require.False(t, pubKey.VerifySignature(msg, sig[:63]))
+}
+
+func TestStdlibCompatibility(t *testing.T) {
+	stdPriv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	privKey := &secp256r1.PrivKey{Key: stdPriv.D.FillBytes(make([]byte, secp256r1.PrivKeySize))}
+	pubKey := privKey.PubKey().(*secp256r1.PubKey)
+	require.Len(t, pubKey.Key, secp256r1.PubKeySize)
+	require.Equal(t, elliptic.MarshalCompressed(elliptic.P256(), stdPriv.X, stdPriv.Y), pubKey.Key)
+
+	msg := crypto.CRandBytes(100)
+
+	// signatures are verifiable by the standard library
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	r, s := new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:])
+	require.True(t, ecdsa.Verify(&stdPriv.PublicKey, crypto.Sha256(msg), r, s))
+
+	// lower-S signatures of the standard library are verifiable
+	r, s, err = ecdsa.Sign(rand.Reader, stdPriv, crypto.Sha256(msg))
+	require.NoError(t, err)
+	n := elliptic.P256().Params().N
+	if s.Cmp(new(big.Int).Rsh(n, 1)) > 0 {
+		s.Sub(n, s)
+	}
+	sig = make([]byte, secp256r1.SignatureSize)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:])
+	require.True(t, pubKey.VerifySignature(msg, sig))
+}
+
+func TestGenPrivKeyFromSecret(t *testing.T) {
+	tests := []struct {
+		name   string
+		secret []byte
+	}{
+		{"empty secret", []byte{}},
+		{"some long secret", []byte("frequently, lorem ipsum is used for testing text and layout")},
+		{"another seed used in cosmos tests #1", []byte{0}},
+		{"another seed used in cosmos tests #2", []byte("mySecret")},
+		{"another seed used in cosmos tests #3", []byte("")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			gotPrivKey := secp256r1.GenPrivKeyFromSecret(tt.secret)
+			require.NotNil(t, gotPrivKey)
+			require.Len(t, gotPrivKey.Key, secp256r1.PrivKeySize)
+			require.Equal(t, gotPrivKey, secp256r1.GenPrivKeyFromSecret(tt.secret))
+
+			msg := []byte("hello")
+			sig, err := gotPrivKey.Sign(msg)
+			require.NoError(t, err)
+			require.True(t, gotPrivKey.PubKey().VerifySignature(msg, sig))
+		})
+	}
+}
+
+func TestPubKeyEquals(t *testing.T) {
+	secp256R1PubKey := secp256r1.GenPrivKey().PubKey().(*secp256r1.PubKey)
+
+	testCases := []struct {
+		msg      string
+		pubKey   cryptotypes.PubKey
+		other    cryptotypes.PubKey
+		expectEq bool
+	}{
+		{
+			"different bytes",
+			secp256R1PubKey,
+			secp256r1.GenPrivKey().PubKey(),
+			false,
+		},
+		{
+			"equals",
+			secp256R1PubKey,
+			&secp256r1.PubKey{
+				Key: secp256R1PubKey.Key,
+			},
+			true,
+		},
+		{
+			"different types",
+			secp256R1PubKey,
+			ed25519.GenPrivKey().PubKey(),
+			false,
+		},
+		{
+			"same bytes, different types",
+			secp256R1PubKey,
+			&secp256k1.PubKey{
+				Key: secp256R1PubKey.Key,
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			eq := tc.pubKey.Equals(tc.other)
+			require.Equal(t, eq, tc.expectEq)
+		})
+	}
+}
+
+func TestPrivKeyEquals(t *testing.T) {
+	secp256R1PrivKey := secp256r1.GenPrivKey()
+
+	testCases := []struct {
+		msg      string
+		privKey  cryptotypes.PrivKey
+		other    cryptotypes.PrivKey
+		expectEq bool
+	}{
+		{
+			"different bytes",
+			secp256R1PrivKey,
+			secp256r1.GenPrivKey(),
+			false,
+		},
+		{
+			"equals",
+			secp256R1PrivKey,
+			&secp256r1.PrivKey{
+				Key: secp256R1PrivKey.Key,
+			},
+			true,
+		},
+		{
+			"different types",
+			secp256R1PrivKey,
+			ed25519.GenPrivKey(),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			eq := tc.privKey.Equals(tc.other)
+			require.Equal(t, eq, tc.expectEq)
+		})
+	}
+}
+
+func TestMarshalAmino(t *testing.T) {
+	aminoCdc := codec.NewLegacyAmino()
+	privKey := secp256r1.GenPrivKey()
+	pubKey := privKey.PubKey().(*secp256r1.PubKey)
+
+	testCases := []struct {
+		desc      string
+		msg       codec.AminoMarshaler
+		typ       interface{}
+		expBinary []byte
+		expJSON   string
+	}{
+		{
+			"secp256r1 private key",
+			privKey,
+			&secp256r1.PrivKey{},
+			append([]byte{32}, privKey.Bytes()...), // Length-prefixed.
+			"\"" + base64.StdEncoding.EncodeToString(privKey.Bytes()) + "\"",
+		},
+		{
+			"secp256r1 public key",
+			pubKey,
+			&secp256r1.PubKey{},
+			append([]byte{33}, pubKey.Bytes()...), // Length-prefixed.
+			"\"" + base64.StdEncoding.EncodeToString(pubKey.Bytes()) + "\"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			// Do a round trip of encoding/decoding binary.
+			bz, err := aminoCdc.MarshalBinaryBare(tc.msg)
+			require.NoError(t, err)
+			require.Equal(t, tc.expBinary, bz)
+
+			err = aminoCdc.UnmarshalBinaryBare(bz, tc.typ)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.msg, tc.typ)
+
+			// Do a round trip of encoding/decoding JSON.
+			bz, err = aminoCdc.MarshalJSON(tc.msg)
+			require.NoError(t, err)
+			require.Equal(t, tc.expJSON, string(bz))
+
+			err = aminoCdc.UnmarshalJSON(bz, tc.typ)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.msg, tc.typ)
+		})
+	}
+}
+
+func TestUnmarshalAminoInvalid(t *testing.T) {
+	pubKey := secp256r1.GenPrivKey().PubKey().(*secp256r1.PubKey)
+
+	// not a point on the curve
+	invalidPoint := make([]byte, secp256r1.PubKeySize)
+	copy(invalidPoint, pubKey.Key)
+	invalidPoint[0] = 0x04
+	require.Error(t, new(secp256r1.PubKey).UnmarshalAmino(invalidPoint))
+	require.Error(t, new(secp256r1.PubKey).UnmarshalAmino(pubKey.Key[:32]))
+
+	// zero and out of range scalars
+	require.Error(t, new(secp256r1.PrivKey).UnmarshalAmino(make([]byte, secp256r1.PrivKeySize)))
+	require.Error(t, new(secp256r1.PrivKey).UnmarshalAmino(elliptic.P256().Params().N.Bytes()))
+	require.Error(t, new(secp256r1.PrivKey).UnmarshalAmino([]byte{1}))
+}
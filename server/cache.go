@@ -0,0 +1,35 @@
+package server
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cast"
+
+	"github.com/line/lbm-sdk/server/types"
+	"github.com/line/lbm-sdk/store/cache"
+)
+
+// GetInterBlockCacheOptionsFromFlags parses command flags and returns the
+// options of the inter-block cache: the stores given a cache of their own, in
+// the form {storeName}={bytesSize}.
+func GetInterBlockCacheOptionsFromFlags(appOpts types.AppOptions) ([]cache.Option, error) {
+	var opts []cache.Option
+
+	for _, storeSize := range cast.ToStringSlice(appOpts.Get(FlagInterBlockCacheStoreSizes)) {
+		parts := strings.Split(storeSize, "=")
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid inter-block cache store size %q, expected {storeName}={bytesSize}", storeSize)
+		}
+
+		size, err := strconv.Atoi(parts[1])
+		if err != nil || size <= 0 {
+			return nil, fmt.Errorf("invalid inter-block cache size of store %s: %q", parts[0], parts[1])
+		}
+
+		opts = append(opts, cache.WithStoreCacheSize(parts[0], size))
+	}
+
+	return opts, nil
+}
@@ -0,0 +1,42 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetInterBlockCacheOptionsFromFlags(t *testing.T) {
+	tests := []struct {
+		name       string
+		storeSizes []string
+		expected   int
+		wantErr    bool
+	}{
+		{name: "no options"},
+		{name: "store sizes", storeSizes: []string{"wasm=209715200", "bank=104857600"}, expected: 2},
+		{name: "missing size", storeSizes: []string{"wasm"}, wantErr: true},
+		{name: "missing store name", storeSizes: []string{"=100"}, wantErr: true},
+		{name: "invalid size", storeSizes: []string{"wasm=big"}, wantErr: true},
+		{name: "zero size", storeSizes: []string{"wasm=0"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(j *testing.T) {
+			v := viper.New()
+			v.Set(FlagInterBlockCacheStoreSizes, tt.storeSizes)
+
+			opts, err := GetInterBlockCacheOptionsFromFlags(v)
+			if tt.wantErr {
+				require.Error(j, err)
+				return
+			}
+
+			require.NoError(j, err)
+			require.Len(j, opts, tt.expected)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"math"
 	"strings"
 
 	"github.com/line/lbm-sdk/store/cache"
@@ -18,6 +19,21 @@ const (
 
 	// DefaultGRPCAddress is the default address the gRPC server binds to.
 	DefaultGRPCAddress = "0.0.0.0:9090"
+
+	// DefaultGRPCWebAddress is the default address the gRPC-Web server binds to.
+	DefaultGRPCWebAddress = "0.0.0.0:9091"
+
+	// DefaultGRPCMaxRecvMsgSize defines the default gRPC max message size in
+	// bytes the server can receive.
+	DefaultGRPCMaxRecvMsgSize = 1024 * 1024 * 10
+
+	// DefaultGRPCMaxSendMsgSize defines the default gRPC max message size in
+	// bytes the server can send.
+	DefaultGRPCMaxSendMsgSize = math.MaxInt32
+
+	// DefaultStreamingGRPCAddress is the default address the state streaming
+	// gRPC server binds to.
+	DefaultStreamingGRPCAddress = "0.0.0.0:9092"
 )
 
 // BaseConfig defines the server's basic configuration
@@ -71,12 +87,47 @@ type BaseConfig struct {
 	// Interblock cache size; bytes size unit
 	InterBlockCacheSize int `mapstructure:"inter-block-cache-size"`
 
+	// InterBlockCacheStoreSizes gives the stores an inter-block cache of their
+	// own, in the form {storeName}={bytesSize}, instead of a share of the one
+	// of inter-block-cache-size.
+	InterBlockCacheStoreSizes []string `mapstructure:"inter-block-cache-store-sizes"`
+
+	// InterBlockCacheWarmUp fills the inter-block cache from the latest state
+	// sync snapshot at startup.
+	InterBlockCacheWarmUp bool `mapstructure:"inter-block-cache-warm-up"`
+
 	// IAVL cache size; bytes size unit
 	IAVLCacheSize int `mapstructure:"iavl-cache-size"`
 
 	// Bech32CacheSize is the maximum bytes size of bech32 cache (Default : 1GB)
 	Bech32CacheSize int `mapstructure:"bech32-cache-size"`
 
+	// CheckTxWorkers is the number of txs checked at the same time, taken by
+	// fee per gas. 0 checks every tx as soon as possible.
+	CheckTxWorkers int `mapstructure:"check-tx-workers"`
+
+	// CheckTxMaxPendingPerSigner is the maximum number of txs of a signer
+	// waiting to be checked. 0 sets no limit.
+	CheckTxMaxPendingPerSigner int `mapstructure:"check-tx-max-pending-per-signer"`
+
+	// CheckTxPriorityDenom is the fee denomination the txs waiting to be
+	// checked are taken by. Empty uses the alphabetically first denomination
+	// of the minimum gas prices.
+	CheckTxPriorityDenom string `mapstructure:"check-tx-priority-denom"`
+
+	// DeliverTxWorkers is the number of txs of a block executed in parallel. 0
+	// or 1 executes the txs one by one.
+	DeliverTxWorkers int `mapstructure:"deliver-tx-workers"`
+
+	// TxReplacementBumpPercent is the minimum fee increase, in percent, for a
+	// tx to replace the pending tx of the same signer and sequence. 0 disables
+	// tx replacement.
+	TxReplacementBumpPercent uint64 `mapstructure:"tx-replacement-bump-percent"`
+
+	// StateArchive archives every committed state so that the heights pruned
+	// from the stores can still be queried, without proofs.
+	StateArchive bool `mapstructure:"state-archive"`
+
 	// When true, Prometheus metrics are served under /metrics on prometheus_listen_addr in config.toml.
 	// It works when tendermint's prometheus option (config.toml) is set to true.
 	Prometheus bool `mapstructure:"prometheus"`
@@ -123,6 +174,36 @@ type GRPCConfig struct {
 
 	// Address defines the API server to listen on
 	Address string `mapstructure:"address"`
+
+	// TLSCertFile and TLSKeyFile are the paths to the PEM encoded certificate
+	// and private key the server uses for TLS. TLS is disabled if they are
+	// empty.
+	TLSCertFile string `mapstructure:"tls-cert-file"`
+	TLSKeyFile  string `mapstructure:"tls-key-file"`
+
+	// MaxRecvMsgSize defines the max message size in bytes the server can
+	// receive.
+	MaxRecvMsgSize int `mapstructure:"max-recv-msg-size"`
+
+	// MaxSendMsgSize defines the max message size in bytes the server can send.
+	MaxSendMsgSize int `mapstructure:"max-send-msg-size"`
+
+	// RateLimits limits the number of requests per second of gRPC methods, in
+	// the form {fullMethodName}={requestsPerSecond}. The method * sets the
+	// limit of each method without a limit of its own.
+	RateLimits []string `mapstructure:"rate-limits"`
+}
+
+// GRPCWebConfig defines configuration for the gRPC-Web server.
+type GRPCWebConfig struct {
+	// Enable defines if the gRPC-Web server should be enabled.
+	Enable bool `mapstructure:"enable"`
+
+	// Address defines the gRPC-Web server address to bind to.
+	Address string `mapstructure:"address"`
+
+	// EnableUnsafeCORS defines if CORS should be enabled (unsafe - use it at your own risk)
+	EnableUnsafeCORS bool `mapstructure:"enable-unsafe-cors"`
 }
 
 // StateSyncConfig defines the state sync snapshot configuration.
@@ -136,6 +217,37 @@ type StateSyncConfig struct {
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
 }
 
+// StreamingConfig defines the state streaming configuration.
+type StreamingConfig struct {
+	// Streamers lists the enabled streaming services: file and/or grpc.
+	Streamers []string `mapstructure:"streamers"`
+
+	File FileStreamingConfig `mapstructure:"file"`
+	GRPC GRPCStreamingConfig `mapstructure:"grpc"`
+}
+
+// FileStreamingConfig defines the configuration of the file streaming service.
+type FileStreamingConfig struct {
+	// Keys are the names of the stores to stream the changes of, * for all.
+	Keys []string `mapstructure:"keys"`
+
+	// WriteDir is the directory the block files are written to. It defaults
+	// to <home>/data/streaming.
+	WriteDir string `mapstructure:"write-dir"`
+
+	// Prefix is prepended to the names of the block files.
+	Prefix string `mapstructure:"prefix"`
+}
+
+// GRPCStreamingConfig defines the configuration of the gRPC streaming service.
+type GRPCStreamingConfig struct {
+	// Keys are the names of the stores to stream the changes of, * for all.
+	Keys []string `mapstructure:"keys"`
+
+	// Address defines the address the streaming server listens on.
+	Address string `mapstructure:"address"`
+}
+
 // Config defines the server's top level configuration
 type Config struct {
 	BaseConfig `mapstructure:",squash"`
@@ -144,7 +256,9 @@ type Config struct {
 	Telemetry telemetry.Config `mapstructure:"telemetry"`
 	API       APIConfig        `mapstructure:"api"`
 	GRPC      GRPCConfig       `mapstructure:"grpc"`
+	GRPCWeb   GRPCWebConfig    `mapstructure:"grpc-web"`
 	StateSync StateSyncConfig  `mapstructure:"state-sync"`
+	Streaming StreamingConfig  `mapstructure:"streaming"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -189,6 +303,8 @@ func DefaultConfig() *Config {
 			PruningInterval:     "0",
 			MinRetainBlocks:     0,
 			IndexEvents:         make([]string, 0),
+
+			InterBlockCacheStoreSizes: make([]string, 0),
 		},
 		Telemetry: telemetry.Config{
 			Enabled:      false,
@@ -205,13 +321,30 @@ func DefaultConfig() *Config {
 			RPCMaxBodyBytes:    1000000,
 		},
 		GRPC: GRPCConfig{
-			Enable:  true,
-			Address: DefaultGRPCAddress,
+			Enable:         true,
+			Address:        DefaultGRPCAddress,
+			MaxRecvMsgSize: DefaultGRPCMaxRecvMsgSize,
+			MaxSendMsgSize: DefaultGRPCMaxSendMsgSize,
+			RateLimits:     make([]string, 0),
+		},
+		GRPCWeb: GRPCWebConfig{
+			Enable:  false,
+			Address: DefaultGRPCWebAddress,
 		},
 		StateSync: StateSyncConfig{
 			SnapshotInterval:   0,
 			SnapshotKeepRecent: 2,
 		},
+		Streaming: StreamingConfig{
+			Streamers: make([]string, 0),
+			File: FileStreamingConfig{
+				Keys: []string{"*"},
+			},
+			GRPC: GRPCStreamingConfig{
+				Keys:    []string{"*"},
+				Address: DefaultStreamingGRPCAddress,
+			},
+		},
 	}
 }
 
@@ -238,6 +371,17 @@ func GetConfig(v *viper.Viper) Config {
 			HaltTime:          v.GetUint64("halt-time"),
 			IndexEvents:       v.GetStringSlice("index-events"),
 			MinRetainBlocks:   v.GetUint64("min-retain-blocks"),
+
+			InterBlockCacheStoreSizes: v.GetStringSlice("inter-block-cache-store-sizes"),
+			InterBlockCacheWarmUp:     v.GetBool("inter-block-cache-warm-up"),
+
+			CheckTxWorkers:             v.GetInt("check-tx-workers"),
+			CheckTxMaxPendingPerSigner: v.GetInt("check-tx-max-pending-per-signer"),
+			CheckTxPriorityDenom:       v.GetString("check-tx-priority-denom"),
+			DeliverTxWorkers:           v.GetInt("deliver-tx-workers"),
+			TxReplacementBumpPercent:   v.GetUint64("tx-replacement-bump-percent"),
+
+			StateArchive: v.GetBool("state-archive"),
 		},
 		Telemetry: telemetry.Config{
 			ServiceName:             v.GetString("telemetry.service-name"),
@@ -260,12 +404,34 @@ func GetConfig(v *viper.Viper) Config {
 			EnableUnsafeCORS:   v.GetBool("api.enabled-unsafe-cors"),
 		},
 		GRPC: GRPCConfig{
-			Enable:  v.GetBool("grpc.enable"),
-			Address: v.GetString("grpc.address"),
+			Enable:         v.GetBool("grpc.enable"),
+			Address:        v.GetString("grpc.address"),
+			TLSCertFile:    v.GetString("grpc.tls-cert-file"),
+			TLSKeyFile:     v.GetString("grpc.tls-key-file"),
+			MaxRecvMsgSize: v.GetInt("grpc.max-recv-msg-size"),
+			MaxSendMsgSize: v.GetInt("grpc.max-send-msg-size"),
+			RateLimits:     v.GetStringSlice("grpc.rate-limits"),
+		},
+		GRPCWeb: GRPCWebConfig{
+			Enable:           v.GetBool("grpc-web.enable"),
+			Address:          v.GetString("grpc-web.address"),
+			EnableUnsafeCORS: v.GetBool("grpc-web.enable-unsafe-cors"),
 		},
 		StateSync: StateSyncConfig{
 			SnapshotInterval:   v.GetUint64("state-sync.snapshot-interval"),
 			SnapshotKeepRecent: v.GetUint32("state-sync.snapshot-keep-recent"),
 		},
+		Streaming: StreamingConfig{
+			Streamers: v.GetStringSlice("streaming.streamers"),
+			File: FileStreamingConfig{
+				Keys:     v.GetStringSlice("streaming.file.keys"),
+				WriteDir: v.GetString("streaming.file.write-dir"),
+				Prefix:   v.GetString("streaming.file.prefix"),
+			},
+			GRPC: GRPCStreamingConfig{
+				Keys:    v.GetStringSlice("streaming.grpc.keys"),
+				Address: v.GetString("streaming.grpc.address"),
+			},
+		},
 	}
 }
@@ -66,12 +66,52 @@ inter-block-cache = {{ .BaseConfig.InterBlockCache }}
 # InterBlockCacheSize is the maximum bytes size of the inter-block cache.
 inter-block-cache-size = {{ .BaseConfig.InterBlockCacheSize }}
 
+# InterBlockCacheStoreSizes gives the stores an inter-block cache of their own,
+# in the form {storeName}={bytesSize}, instead of a share of the one above.
+# A cache holds at least 32 MB.
+#
+# Example:
+# ["wasm=209715200", "bank=104857600"]
+inter-block-cache-store-sizes = [{{ range .BaseConfig.InterBlockCacheStoreSizes }}{{ printf "%q, " . }}{{end}}]
+
+# InterBlockCacheWarmUp fills the inter-block cache from the latest state sync
+# snapshot at startup.
+inter-block-cache-warm-up = {{ .BaseConfig.InterBlockCacheWarmUp }}
+
 # IAVLCacheSize is the maximum bytes size of iavl node cache
 iavl-cache-size = {{ .BaseConfig.IAVLCacheSize }}
 
 # Bech32CacheSize is the maximum bytes size of bech32 cache (Default : 1GB)
 bech32-cache-size = {{ .BaseConfig.Bech32CacheSize }}
 
+# CheckTxWorkers is the number of txs checked at the same time, taken by fee
+# per gas. 0 checks every tx as soon as the previous txs of its signers are.
+check-tx-workers = {{ .BaseConfig.CheckTxWorkers }}
+
+# CheckTxMaxPendingPerSigner is the maximum number of txs of a signer waiting
+# to be checked. New txs beyond the limit are rejected. 0 sets no limit.
+check-tx-max-pending-per-signer = {{ .BaseConfig.CheckTxMaxPendingPerSigner }}
+
+# CheckTxPriorityDenom is the fee denomination the txs waiting to be checked
+# are taken by. Fees in other denominations give no priority. Empty uses the
+# alphabetically first denomination of minimum-gas-prices, e.g. atom for
+# "0.1stake,0.01atom", whatever their order in the setting.
+check-tx-priority-denom = "{{ .BaseConfig.CheckTxPriorityDenom }}"
+
+# DeliverTxWorkers is the number of txs of a block executed in parallel. Txs
+# conflicting with the previous ones are executed again, so the resulting state
+# is the same as with serial execution. 0 or 1 executes the txs one by one.
+deliver-tx-workers = {{ .BaseConfig.DeliverTxWorkers }}
+
+# TxReplacementBumpPercent is the minimum fee increase, in percent, for a tx to
+# replace the pending tx of the same signer and sequence. 0 disables tx
+# replacement.
+tx-replacement-bump-percent = {{ .BaseConfig.TxReplacementBumpPercent }}
+
+# StateArchive archives every committed state in data/archive.db, so that the
+# heights pruned from the stores can still be queried, without proofs.
+state-archive = {{ .BaseConfig.StateArchive }}
+
 # IndexEvents defines the set of events in the form {eventType}.{attributeKey},
 # which informs Tendermint what to index. If empty, all events will be indexed.
 #
@@ -163,6 +203,37 @@ enable = {{ .GRPC.Enable }}
 # Address defines the gRPC server address to bind to.
 address = "{{ .GRPC.Address }}"
 
+# TLS is enabled if the paths to the PEM encoded certificate and private key of the server are set.
+tls-cert-file = "{{ .GRPC.TLSCertFile }}"
+tls-key-file = "{{ .GRPC.TLSKeyFile }}"
+
+# MaxRecvMsgSize defines the max message size in bytes the server can receive.
+max-recv-msg-size = {{ .GRPC.MaxRecvMsgSize }}
+
+# MaxSendMsgSize defines the max message size in bytes the server can send.
+max-send-msg-size = {{ .GRPC.MaxSendMsgSize }}
+
+# RateLimits limits the number of requests per second of gRPC methods, in the form
+# "{fullMethodName}={requestsPerSecond}", e.g. "/cosmos.bank.v1beta1.Query/AllBalances=10".
+# The method "*" sets the limit of each method without a limit of its own.
+rate-limits = [{{ range .GRPC.RateLimits }}{{ printf "%q, " . }}{{end}}]
+
+###############################################################################
+###                        gRPC Web Configuration                           ###
+###############################################################################
+
+[grpc-web]
+
+# Enable defines if the gRPC-Web server should be enabled, so that browser clients can query
+# the gRPC server without a proxy. It uses the TLS configuration of the gRPC server.
+enable = {{ .GRPCWeb.Enable }}
+
+# Address defines the gRPC-Web server address to bind to.
+address = "{{ .GRPCWeb.Address }}"
+
+# EnableUnsafeCORS defines if CORS should be enabled (unsafe - use it at your own risk).
+enable-unsafe-cors = {{ .GRPCWeb.EnableUnsafeCORS }}
+
 ###############################################################################
 ###                        State Sync Configuration                         ###
 ###############################################################################
@@ -177,6 +248,36 @@ snapshot-interval = {{ .StateSync.SnapshotInterval }}
 
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
+
+###############################################################################
+###                        Streaming Configuration                          ###
+###############################################################################
+
+# Streaming exports the data of the committed blocks, the ABCI requests and responses along with
+# the state changes, to external consumers.
+[streaming]
+
+# streamers lists the enabled streaming services: "file" and/or "grpc".
+streamers = [{{ range .Streaming.Streamers }}{{ printf "%q, " . }}{{end}}]
+
+[streaming.file]
+
+# keys lists the names of the stores whose changes are streamed ("*" for all).
+keys = [{{ range .Streaming.File.Keys }}{{ printf "%q, " . }}{{end}}]
+
+# write-dir is the directory the block files are written to (defaults to <home>/data/streaming).
+write-dir = "{{ .Streaming.File.WriteDir }}"
+
+# prefix is prepended to the names of the block files.
+prefix = "{{ .Streaming.File.Prefix }}"
+
+[streaming.grpc]
+
+# keys lists the names of the stores whose changes are streamed ("*" for all).
+keys = [{{ range .Streaming.GRPC.Keys }}{{ printf "%q, " . }}{{end}}]
+
+# address defines the address the streaming gRPC server binds to.
+address = "{{ .Streaming.GRPC.Address }}"
 `
 
 var configTemplate *template.Template
@@ -0,0 +1,136 @@
+package server
+
+import (
+	abcicli "github.com/line/ostracon/abci/client"
+	abci "github.com/line/ostracon/abci/types"
+	tmsync "github.com/line/ostracon/libs/sync"
+	"github.com/line/ostracon/proxy"
+)
+
+// deliverTxsApplication is an ABCI application able to deliver the txs of a
+// block all at once, e.g. baseapp.BaseApp.
+type deliverTxsApplication interface {
+	abci.Application
+
+	DeliverTxs(reqs []abci.RequestDeliverTx) []abci.ResponseDeliverTx
+}
+
+// deliverTxsClientCreator creates local ABCI clients delivering the txs of a
+// block with DeliverTxs instead of one by one. As Ostracon sends every tx of
+// a block with DeliverTxAsync before ending the block, the clients keep the
+// txs until the next request and then deliver them together.
+type deliverTxsClientCreator struct {
+	mtx *tmsync.Mutex
+	app deliverTxsApplication
+}
+
+var _ proxy.ClientCreator = (*deliverTxsClientCreator)(nil)
+
+func newDeliverTxsClientCreator(app deliverTxsApplication) proxy.ClientCreator {
+	return &deliverTxsClientCreator{
+		mtx: new(tmsync.Mutex),
+		app: app,
+	}
+}
+
+func (c *deliverTxsClientCreator) NewABCIClient() (abcicli.Client, error) {
+	return &deliverTxsClient{
+		Client: abcicli.NewLocalClient(c.mtx, c.app),
+		mtx:    c.mtx,
+		app:    c.app,
+	}, nil
+}
+
+// deliverTxsClient is a local ABCI client whose DeliverTxAsync only queues the
+// tx. The queued txs are delivered before any request which may depend on them
+// is handled.
+type deliverTxsClient struct {
+	abcicli.Client
+
+	// mtx is the mutex of the local client, also guarding pending
+	mtx     *tmsync.Mutex
+	app     deliverTxsApplication
+	pending []*abcicli.ReqRes
+}
+
+func (cli *deliverTxsClient) DeliverTxAsync(req abci.RequestDeliverTx, cb abcicli.ResponseCallback) *abcicli.ReqRes {
+	cli.mtx.Lock()
+	defer cli.mtx.Unlock()
+
+	reqRes := abcicli.NewReqRes(abci.ToRequestDeliverTx(req), cb)
+	cli.pending = append(cli.pending, reqRes)
+	return reqRes
+}
+
+// deliverPending delivers the queued txs and completes their requests in
+// order.
+func (cli *deliverTxsClient) deliverPending() {
+	cli.mtx.Lock()
+	defer cli.mtx.Unlock()
+
+	if len(cli.pending) == 0 {
+		return
+	}
+
+	reqs := make([]abci.RequestDeliverTx, len(cli.pending))
+	for i, reqRes := range cli.pending {
+		reqs[i] = *reqRes.Request.GetDeliverTx()
+	}
+
+	responses := cli.app.DeliverTxs(reqs)
+
+	globalCb := cli.GetGlobalCallback()
+	for i, reqRes := range cli.pending {
+		res := abci.ToResponseDeliverTx(responses[i])
+		if reqRes.SetDone(res) && globalCb != nil {
+			globalCb(reqRes.Request, res)
+		}
+	}
+
+	cli.pending = nil
+}
+
+func (cli *deliverTxsClient) FlushAsync(cb abcicli.ResponseCallback) *abcicli.ReqRes {
+	cli.deliverPending()
+	return cli.Client.FlushAsync(cb)
+}
+
+func (cli *deliverTxsClient) FlushSync() (*abci.ResponseFlush, error) {
+	cli.deliverPending()
+	return cli.Client.FlushSync()
+}
+
+func (cli *deliverTxsClient) DeliverTxSync(req abci.RequestDeliverTx) (*abci.ResponseDeliverTx, error) {
+	cli.deliverPending()
+	return cli.Client.DeliverTxSync(req)
+}
+
+func (cli *deliverTxsClient) BeginBlockAsync(req abci.RequestBeginBlock, cb abcicli.ResponseCallback) *abcicli.ReqRes {
+	cli.deliverPending()
+	return cli.Client.BeginBlockAsync(req, cb)
+}
+
+func (cli *deliverTxsClient) BeginBlockSync(req abci.RequestBeginBlock) (*abci.ResponseBeginBlock, error) {
+	cli.deliverPending()
+	return cli.Client.BeginBlockSync(req)
+}
+
+func (cli *deliverTxsClient) EndBlockAsync(req abci.RequestEndBlock, cb abcicli.ResponseCallback) *abcicli.ReqRes {
+	cli.deliverPending()
+	return cli.Client.EndBlockAsync(req, cb)
+}
+
+func (cli *deliverTxsClient) EndBlockSync(req abci.RequestEndBlock) (*abci.ResponseEndBlock, error) {
+	cli.deliverPending()
+	return cli.Client.EndBlockSync(req)
+}
+
+func (cli *deliverTxsClient) CommitAsync(cb abcicli.ResponseCallback) *abcicli.ReqRes {
+	cli.deliverPending()
+	return cli.Client.CommitAsync(cb)
+}
+
+func (cli *deliverTxsClient) CommitSync() (*abci.ResponseCommit, error) {
+	cli.deliverPending()
+	return cli.Client.CommitSync()
+}
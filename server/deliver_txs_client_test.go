@@ -0,0 +1,63 @@
+package server
+
+import (
+	"testing"
+
+	abci "github.com/line/ostracon/abci/types"
+	"github.com/stretchr/testify/require"
+)
+
+// deliverTxsApp records the txs delivered by DeliverTxs, answering each with
+// its index in the block as code.
+type deliverTxsApp struct {
+	abci.BaseApplication
+
+	blocks [][]string
+}
+
+func (app *deliverTxsApp) DeliverTxs(reqs []abci.RequestDeliverTx) []abci.ResponseDeliverTx {
+	txs := make([]string, len(reqs))
+	responses := make([]abci.ResponseDeliverTx, len(reqs))
+	for i, req := range reqs {
+		txs[i] = string(req.Tx)
+		responses[i] = abci.ResponseDeliverTx{Code: uint32(i)}
+	}
+	app.blocks = append(app.blocks, txs)
+	return responses
+}
+
+func TestDeliverTxsClient(t *testing.T) {
+	app := &deliverTxsApp{}
+	cli, err := newDeliverTxsClientCreator(app).NewABCIClient()
+	require.NoError(t, err)
+
+	var delivered []string
+	cli.SetGlobalCallback(func(req *abci.Request, res *abci.Response) {
+		if r, ok := res.Value.(*abci.Response_DeliverTx); ok {
+			require.Equal(t, uint32(len(delivered)), r.DeliverTx.Code)
+			delivered = append(delivered, string(req.GetDeliverTx().Tx))
+		}
+	})
+
+	_, err = cli.BeginBlockSync(abci.RequestBeginBlock{})
+	require.NoError(t, err)
+
+	reqRes := cli.DeliverTxAsync(abci.RequestDeliverTx{Tx: []byte("a")}, nil)
+	cli.DeliverTxAsync(abci.RequestDeliverTx{Tx: []byte("b")}, nil)
+	require.Empty(t, app.blocks)
+	require.Empty(t, delivered)
+
+	// the txs are delivered together before the block ends
+	_, err = cli.EndBlockSync(abci.RequestEndBlock{})
+	require.NoError(t, err)
+	require.Equal(t, [][]string{{"a", "b"}}, app.blocks)
+	require.Equal(t, []string{"a", "b"}, delivered)
+
+	reqRes.Wait()
+	require.Equal(t, uint32(0), reqRes.Response.GetDeliverTx().Code)
+
+	// nothing is left to deliver
+	_, err = cli.CommitSync()
+	require.NoError(t, err)
+	require.Len(t, app.blocks, 1)
+}
@@ -0,0 +1,239 @@
+package grpc
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/line/lbm-sdk/server/config"
+)
+
+const (
+	grpcContentType        = "application/grpc"
+	grpcWebContentType     = "application/grpc-web"
+	grpcWebTextContentType = "application/grpc-web-text"
+
+	// grpcWebTrailerFlag marks the frame of a gRPC-Web response body which holds
+	// the trailers.
+	grpcWebTrailerFlag = 0x80
+)
+
+// StartGRPCWeb starts a gRPC-Web server serving the calls of browser clients with
+// the given gRPC server. It uses the TLS configuration of the gRPC server.
+func StartGRPCWeb(grpcSrv *grpc.Server, cfg config.Config) (*http.Server, error) {
+	tlsEnabled, err := isTLSEnabled(cfg.GRPC)
+	if err != nil {
+		return nil, err
+	}
+
+	grpcWebSrv := &http.Server{
+		Addr:              cfg.GRPCWeb.Address,
+		Handler:           NewGRPCWebHandler(grpcSrv, cfg.GRPCWeb.EnableUnsafeCORS),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	errCh := make(chan error)
+	go func() {
+		if tlsEnabled {
+			err = grpcWebSrv.ListenAndServeTLS(cfg.GRPC.TLSCertFile, cfg.GRPC.TLSKeyFile)
+		} else {
+			err = grpcWebSrv.ListenAndServe()
+		}
+		if err != nil && err != http.ErrServerClosed {
+			errCh <- fmt.Errorf("failed to serve: %w", err)
+		}
+	}()
+
+	select {
+	case err := <-errCh:
+		return nil, err
+	case <-time.After(5 * time.Second): // assume server started successfully
+		return grpcWebSrv, nil
+	}
+}
+
+// NewGRPCWebHandler returns an http.Handler translating the gRPC-Web calls of
+// browser clients into gRPC calls served by the given gRPC server. Both the
+// binary (application/grpc-web) and the base64 (application/grpc-web-text)
+// formats are supported. Only unary and server streaming calls can be made over
+// gRPC-Web.
+func NewGRPCWebHandler(grpcSrv *grpc.Server, enableUnsafeCORS bool) http.Handler {
+	return grpcWebHandler{
+		grpcSrv:          grpcSrv,
+		enableUnsafeCORS: enableUnsafeCORS,
+	}
+}
+
+type grpcWebHandler struct {
+	grpcSrv          *grpc.Server
+	enableUnsafeCORS bool
+}
+
+func (h grpcWebHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if h.enableUnsafeCORS {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			origin = "*"
+		}
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+		w.Header().Set("Access-Control-Expose-Headers", "grpc-status, grpc-message, grpc-status-details-bin")
+
+		if r.Method == http.MethodOptions {
+			w.Header().Set("Access-Control-Allow-Methods", http.MethodPost)
+			w.Header().Set("Access-Control-Allow-Headers", r.Header.Get("Access-Control-Request-Headers"))
+			w.Header().Set("Access-Control-Max-Age", "600")
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+	}
+
+	contentType := r.Header.Get("Content-Type")
+	if r.Method != http.MethodPost || !strings.HasPrefix(contentType, grpcWebContentType) {
+		http.Error(w, "expected a gRPC-Web request", http.StatusUnsupportedMediaType)
+		return
+	}
+
+	text := strings.HasPrefix(contentType, grpcWebTextContentType)
+	subtype := strings.TrimPrefix(contentType, grpcWebContentType)
+	if text {
+		subtype = strings.TrimPrefix(contentType, grpcWebTextContentType)
+	}
+
+	// the gRPC server only serves HTTP/2 requests of the gRPC content type
+	req := r.Clone(r.Context())
+	req.ProtoMajor, req.ProtoMinor, req.Proto = 2, 0, "HTTP/2.0"
+	req.Header.Set("Content-Type", grpcContentType+subtype)
+	req.Header.Del("Content-Length")
+	req.ContentLength = -1
+	if text {
+		req.Body = ioutil.NopCloser(base64.NewDecoder(base64.StdEncoding, r.Body))
+	}
+
+	resp := newGRPCWebResponse(w, text)
+	h.grpcSrv.ServeHTTP(resp, req)
+	resp.finish()
+}
+
+// grpcWebResponse translates the gRPC response written by the gRPC server into a
+// gRPC-Web response, which carries the trailers in the last frame of its body.
+type grpcWebResponse struct {
+	w           http.ResponseWriter
+	header      http.Header
+	text        bool
+	wroteHeader bool
+}
+
+var _ http.Flusher = &grpcWebResponse{}
+
+func newGRPCWebResponse(w http.ResponseWriter, text bool) *grpcWebResponse {
+	return &grpcWebResponse{
+		w:      w,
+		header: make(http.Header),
+		text:   text,
+	}
+}
+
+// Header implements http.ResponseWriter. The headers set after WriteHeader are
+// the trailers.
+func (r *grpcWebResponse) Header() http.Header {
+	return r.header
+}
+
+// WriteHeader implements http.ResponseWriter
+func (r *grpcWebResponse) WriteHeader(code int) {
+	if r.wroteHeader {
+		return
+	}
+	r.wroteHeader = true
+
+	header := r.w.Header()
+	for k, vv := range r.header {
+		if k == "Trailer" || strings.HasPrefix(k, http.TrailerPrefix) {
+			continue
+		}
+		header[k] = vv
+	}
+
+	contentType := grpcWebContentType
+	if r.text {
+		contentType = grpcWebTextContentType
+	}
+	header.Set("Content-Type", contentType+strings.TrimPrefix(r.header.Get("Content-Type"), grpcContentType))
+	header.Del("Content-Length")
+	r.w.WriteHeader(code)
+}
+
+// Write implements http.ResponseWriter
+func (r *grpcWebResponse) Write(b []byte) (int, error) {
+	if !r.wroteHeader {
+		r.WriteHeader(http.StatusOK)
+	}
+	if !r.text {
+		return r.w.Write(b)
+	}
+
+	// each write is encoded on its own, as the frames of the response may be read
+	// before the response ends
+	if _, err := io.WriteString(r.w, base64.StdEncoding.EncodeToString(b)); err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
+
+// Flush implements http.Flusher
+func (r *grpcWebResponse) Flush() {
+	if !r.wroteHeader {
+		r.WriteHeader(http.StatusOK)
+	}
+	if f, ok := r.w.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// finish writes the trailers set by the gRPC server into the trailer frame.
+func (r *grpcWebResponse) finish() {
+	if !r.wroteHeader {
+		r.WriteHeader(http.StatusOK)
+	}
+
+	trailers := make(http.Header)
+	for _, k := range r.header["Trailer"] {
+		k = http.CanonicalHeaderKey(k)
+		if vv, ok := r.header[k]; ok {
+			trailers[k] = vv
+		}
+	}
+	for k, vv := range r.header {
+		if strings.HasPrefix(k, http.TrailerPrefix) {
+			trailers[http.CanonicalHeaderKey(strings.TrimPrefix(k, http.TrailerPrefix))] = vv
+		}
+	}
+
+	keys := make([]string, 0, len(trailers))
+	for k := range trailers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var buf bytes.Buffer
+	for _, k := range keys {
+		for _, v := range trailers[k] {
+			fmt.Fprintf(&buf, "%s: %s\r\n", strings.ToLower(k), v)
+		}
+	}
+
+	frame := make([]byte, 5, 5+buf.Len())
+	frame[0] = grpcWebTrailerFlag
+	binary.BigEndian.PutUint32(frame[1:], uint32(buf.Len()))
+	_, _ = r.Write(append(frame, buf.Bytes()...))
+	r.Flush()
+}
@@ -0,0 +1,172 @@
+package grpc
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/line/ostracon/libs/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/server/config"
+	"github.com/line/lbm-sdk/testutil/testdata"
+)
+
+func newTestGRPCWebServer(t *testing.T, cfg config.GRPCConfig, enableUnsafeCORS bool) *httptest.Server {
+	grpcSrv, err := NewGRPCServer(cfg, log.NewNopLogger())
+	require.NoError(t, err)
+	testdata.RegisterQueryServer(grpcSrv, testdata.QueryImpl{})
+
+	srv := httptest.NewServer(NewGRPCWebHandler(grpcSrv, enableUnsafeCORS))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// frame returns a gRPC-Web data frame holding the message.
+func frame(msg []byte) []byte {
+	bz := make([]byte, 5, 5+len(msg))
+	binary.BigEndian.PutUint32(bz[1:], uint32(len(msg)))
+	return append(bz, msg...)
+}
+
+// readFrames splits a gRPC-Web response body into the data frames and the trailers.
+func readFrames(t *testing.T, body []byte) (messages [][]byte, trailers string) {
+	for len(body) > 0 {
+		require.GreaterOrEqual(t, len(body), 5)
+		length := binary.BigEndian.Uint32(body[1:5])
+		payload := body[5 : 5+length]
+		if body[0]&grpcWebTrailerFlag != 0 {
+			trailers = string(payload)
+		} else {
+			messages = append(messages, payload)
+		}
+		body = body[5+length:]
+	}
+	return messages, trailers
+}
+
+func postGRPCWeb(t *testing.T, url, contentType string, body []byte) *http.Response {
+	req, err := http.NewRequest(http.MethodPost, url+"/testdata.Query/Echo", bytes.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Origin", "https://example.com")
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	return resp
+}
+
+func TestGRPCWeb(t *testing.T) {
+	srv := newTestGRPCWebServer(t, config.DefaultConfig().GRPC, false)
+	reqBz, err := (&testdata.EchoRequest{Message: "hello"}).Marshal()
+	require.NoError(t, err)
+
+	resp := postGRPCWeb(t, srv.URL, "application/grpc-web+proto", frame(reqBz))
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "application/grpc-web+proto", resp.Header.Get("Content-Type"))
+	require.Empty(t, resp.Header.Get("Access-Control-Allow-Origin"))
+
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	messages, trailers := readFrames(t, body)
+	require.Len(t, messages, 1)
+	var res testdata.EchoResponse
+	require.NoError(t, res.Unmarshal(messages[0]))
+	require.Equal(t, "hello", res.Message)
+	require.Contains(t, trailers, "grpc-status: 0\r\n")
+}
+
+func TestGRPCWebText(t *testing.T) {
+	srv := newTestGRPCWebServer(t, config.DefaultConfig().GRPC, true)
+	reqBz, err := (&testdata.EchoRequest{Message: "hello"}).Marshal()
+	require.NoError(t, err)
+
+	resp := postGRPCWeb(t, srv.URL, "application/grpc-web-text", []byte(base64.StdEncoding.EncodeToString(frame(reqBz))))
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "application/grpc-web-text", resp.Header.Get("Content-Type"))
+	require.Equal(t, "https://example.com", resp.Header.Get("Access-Control-Allow-Origin"))
+
+	// each write of the response is encoded on its own
+	encoded, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	var body []byte
+	for len(encoded) > 0 {
+		n := bytes.IndexByte(encoded, '=')
+		end := len(encoded)
+		if n >= 0 {
+			end = n
+			for end < len(encoded) && encoded[end] == '=' {
+				end++
+			}
+		}
+		bz, err := base64.StdEncoding.DecodeString(string(encoded[:end]))
+		require.NoError(t, err)
+		body = append(body, bz...)
+		encoded = encoded[end:]
+	}
+
+	messages, trailers := readFrames(t, body)
+	require.Len(t, messages, 1)
+	var res testdata.EchoResponse
+	require.NoError(t, res.Unmarshal(messages[0]))
+	require.Equal(t, "hello", res.Message)
+	require.Contains(t, trailers, "grpc-status: 0\r\n")
+}
+
+func TestGRPCWebError(t *testing.T) {
+	cfg := config.DefaultConfig().GRPC
+	cfg.RateLimits = []string{"/testdata.Query/Echo=1"}
+	srv := newTestGRPCWebServer(t, cfg, false)
+	reqBz, err := (&testdata.EchoRequest{Message: "hello"}).Marshal()
+	require.NoError(t, err)
+
+	resp := postGRPCWeb(t, srv.URL, "application/grpc-web+proto", frame(reqBz))
+	resp.Body.Close()
+	resp = postGRPCWeb(t, srv.URL, "application/grpc-web+proto", frame(reqBz))
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	messages, trailers := readFrames(t, body)
+	require.Empty(t, messages)
+	require.Contains(t, trailers, "grpc-status: 8\r\n")
+	require.Contains(t, trailers, "grpc-message: rate limit of /testdata.Query/Echo exceeded\r\n")
+}
+
+func TestGRPCWebCORS(t *testing.T) {
+	srv := newTestGRPCWebServer(t, config.DefaultConfig().GRPC, true)
+
+	req, err := http.NewRequest(http.MethodOptions, srv.URL+"/testdata.Query/Echo", nil)
+	require.NoError(t, err)
+	req.Header.Set("Origin", "https://example.com")
+	req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+	req.Header.Set("Access-Control-Request-Headers", "content-type,x-grpc-web")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+	require.Equal(t, "https://example.com", resp.Header.Get("Access-Control-Allow-Origin"))
+	require.Equal(t, http.MethodPost, resp.Header.Get("Access-Control-Allow-Methods"))
+	require.Equal(t, "content-type,x-grpc-web", resp.Header.Get("Access-Control-Allow-Headers"))
+}
+
+func TestGRPCWebInvalidRequest(t *testing.T) {
+	srv := newTestGRPCWebServer(t, config.DefaultConfig().GRPC, false)
+
+	resp, err := http.Get(srv.URL + "/testdata.Query/Echo")
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusUnsupportedMediaType, resp.StatusCode)
+
+	resp = postGRPCWeb(t, srv.URL, "application/json", []byte("{}"))
+	resp.Body.Close()
+	require.Equal(t, http.StatusUnsupportedMediaType, resp.StatusCode)
+}
@@ -0,0 +1,79 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	metrics "github.com/armon/go-metrics"
+	"github.com/line/ostracon/libs/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/line/lbm-sdk/telemetry"
+)
+
+// Metric keys of the gRPC server. They are exported through the telemetry
+// sinks, e.g. as grpc_server_handled and grpc_server_handling_time by
+// Prometheus.
+var (
+	metricKeyHandled      = []string{"grpc", "server", "handled"}
+	metricKeyHandlingTime = []string{"grpc", "server", "handling_time"}
+)
+
+// unaryMonitoringInterceptor records the metrics of the served unary calls and
+// logs them.
+func unaryMonitoringInterceptor(logger log.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		observe(logger, info.FullMethod, "unary", start, err)
+		return resp, err
+	}
+}
+
+// streamMonitoringInterceptor records the metrics of the served streams and
+// logs them.
+func streamMonitoringInterceptor(logger log.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		observe(logger, info.FullMethod, "stream", start, err)
+		return err
+	}
+}
+
+func observe(logger log.Logger, method, callType string, start time.Time, err error) {
+	code := status.Code(err)
+	labels := []metrics.Label{
+		telemetry.NewLabel("method", method),
+		telemetry.NewLabel("type", callType),
+	}
+
+	telemetry.MeasureSinceWithLabels(metricKeyHandlingTime, start, labels)
+	telemetry.IncrCounterWithLabels(metricKeyHandled, 1, append(labels, telemetry.NewLabel("code", code.String())))
+
+	logger.Debug("served gRPC request", "method", method, "code", code.String(), "duration", time.Since(start))
+}
+
+// unaryRateLimitInterceptor rejects the unary calls exceeding the rate limit of
+// their method.
+func unaryRateLimitInterceptor(limiter *methodRateLimiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !limiter.Allow(info.FullMethod) {
+			return nil, status.Errorf(codes.ResourceExhausted, "rate limit of %s exceeded", info.FullMethod)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// streamRateLimitInterceptor rejects the streams exceeding the rate limit of
+// their method.
+func streamRateLimitInterceptor(limiter *methodRateLimiter) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !limiter.Allow(info.FullMethod) {
+			return status.Errorf(codes.ResourceExhausted, "rate limit of %s exceeded", info.FullMethod)
+		}
+		return handler(srv, ss)
+	}
+}
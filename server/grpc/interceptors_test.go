@@ -0,0 +1,213 @@
+package grpc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/line/ostracon/libs/log"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+
+	"github.com/line/lbm-sdk/server/config"
+	"github.com/line/lbm-sdk/testutil/testdata"
+)
+
+func TestNewMethodRateLimiter(t *testing.T) {
+	testCases := []struct {
+		name    string
+		entries []string
+		expPass bool
+	}{
+		{"no limits", nil, true},
+		{"valid limits", []string{"/testdata.Query/Echo=10", "*=0.5"}, true},
+		{"missing limit", []string{"/testdata.Query/Echo"}, false},
+		{"not a full method name", []string{"testdata.Query/Echo=10"}, false},
+		{"invalid limit", []string{"/testdata.Query/Echo=ten"}, false},
+		{"zero limit", []string{"/testdata.Query/Echo=0"}, false},
+		{"duplicate limit", []string{"/testdata.Query/Echo=10", "/testdata.Query/Echo=20"}, false},
+	}
+
+	for _, tc := range testCases {
+		_, err := newMethodRateLimiter(tc.entries)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMethodRateLimiterAllow(t *testing.T) {
+	limiter, err := newMethodRateLimiter([]string{"/testdata.Query/Echo=2", "*=0.5"})
+	require.NoError(t, err)
+
+	now := time.Now()
+	limiter.now = func() time.Time { return now }
+
+	// the bucket holds a second of requests
+	require.True(t, limiter.Allow("/testdata.Query/Echo"))
+	require.True(t, limiter.Allow("/testdata.Query/Echo"))
+	require.False(t, limiter.Allow("/testdata.Query/Echo"))
+
+	// the default limit applies to each other method on its own
+	require.True(t, limiter.Allow("/testdata.Query/SayHello"))
+	require.False(t, limiter.Allow("/testdata.Query/SayHello"))
+	require.True(t, limiter.Allow("/testdata.Query/TestAny"))
+
+	now = now.Add(500 * time.Millisecond)
+	require.True(t, limiter.Allow("/testdata.Query/Echo"))
+	require.False(t, limiter.Allow("/testdata.Query/Echo"))
+	require.False(t, limiter.Allow("/testdata.Query/SayHello"))
+
+	now = now.Add(2 * time.Second)
+	require.True(t, limiter.Allow("/testdata.Query/SayHello"))
+
+	// methods are not limited without a default limit
+	limiter, err = newMethodRateLimiter([]string{"/testdata.Query/Echo=1"})
+	require.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		require.True(t, limiter.Allow("/testdata.Query/SayHello"))
+	}
+}
+
+// startTestServer serves the testdata query service with a gRPC server created from
+// the given config, and returns its address.
+func startTestServer(t *testing.T, cfg config.GRPCConfig) string {
+	grpcSrv, err := NewGRPCServer(cfg, log.NewNopLogger())
+	require.NoError(t, err)
+	testdata.RegisterQueryServer(grpcSrv, testdata.QueryImpl{})
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() { _ = grpcSrv.Serve(listener) }()
+	t.Cleanup(grpcSrv.Stop)
+
+	return listener.Addr().String()
+}
+
+func dial(t *testing.T, address string, opts ...grpc.DialOption) testdata.QueryClient {
+	if len(opts) == 0 {
+		opts = append(opts, grpc.WithInsecure())
+	}
+	conn, err := grpc.Dial(address, opts...)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return testdata.NewQueryClient(conn)
+}
+
+func TestGRPCServerRateLimits(t *testing.T) {
+	cfg := config.DefaultConfig().GRPC
+	cfg.RateLimits = []string{"/testdata.Query/Echo=1"}
+	client := dial(t, startTestServer(t, cfg))
+
+	_, err := client.Echo(context.Background(), &testdata.EchoRequest{Message: "hello"})
+	require.NoError(t, err)
+	_, err = client.Echo(context.Background(), &testdata.EchoRequest{Message: "hello"})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	_, err = client.SayHello(context.Background(), &testdata.SayHelloRequest{Name: "foo"})
+	require.NoError(t, err)
+
+	cfg.RateLimits = []string{"invalid"}
+	_, err = NewGRPCServer(cfg, log.NewNopLogger())
+	require.Error(t, err)
+}
+
+func TestGRPCServerMaxMsgSize(t *testing.T) {
+	cfg := config.DefaultConfig().GRPC
+	cfg.MaxRecvMsgSize = 100
+	cfg.MaxSendMsgSize = 200
+	client := dial(t, startTestServer(t, cfg))
+
+	_, err := client.Echo(context.Background(), &testdata.EchoRequest{Message: strings.Repeat("a", 50)})
+	require.NoError(t, err)
+	_, err = client.Echo(context.Background(), &testdata.EchoRequest{Message: strings.Repeat("a", 150)})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// the response is too large to be sent
+	_, err = client.SayHello(context.Background(), &testdata.SayHelloRequest{Name: strings.Repeat("a", 90)})
+	require.NoError(t, err)
+	cfg.MaxSendMsgSize = 50
+	client = dial(t, startTestServer(t, cfg))
+	_, err = client.SayHello(context.Background(), &testdata.SayHelloRequest{Name: strings.Repeat("a", 90)})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+// writeTestCertificate writes a self-signed certificate of localhost and its key
+// into the given directory.
+func writeTestCertificate(t *testing.T, dir string) (certFile, keyFile string, pool *x509.CertPool) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "localhost"},
+		DNSNames:              []string{"localhost"},
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile = filepath.Join(dir, "cert.pem")
+	keyFile = filepath.Join(dir, "key.pem")
+	require.NoError(t, ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600))
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	pool = x509.NewCertPool()
+	pool.AddCert(cert)
+	return certFile, keyFile, pool
+}
+
+func TestGRPCServerTLS(t *testing.T) {
+	certFile, keyFile, pool := writeTestCertificate(t, t.TempDir())
+
+	cfg := config.DefaultConfig().GRPC
+	cfg.TLSCertFile, cfg.TLSKeyFile = certFile, keyFile
+	address := startTestServer(t, cfg)
+
+	creds := credentials.NewTLS(&tls.Config{RootCAs: pool, ServerName: "localhost"})
+	client := dial(t, address, grpc.WithTransportCredentials(creds))
+	res, err := client.Echo(context.Background(), &testdata.EchoRequest{Message: "hello"})
+	require.NoError(t, err)
+	require.Equal(t, "hello", res.Message)
+
+	// the server does not serve plaintext clients
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	_, err = dial(t, address).Echo(ctx, &testdata.EchoRequest{Message: "hello"})
+	require.Error(t, err)
+
+	cfg.TLSKeyFile = ""
+	_, err = NewGRPCServer(cfg, log.NewNopLogger())
+	require.Error(t, err)
+
+	cfg.TLSKeyFile = filepath.Join(t.TempDir(), "missing.pem")
+	_, err = NewGRPCServer(cfg, log.NewNopLogger())
+	require.Error(t, err)
+}
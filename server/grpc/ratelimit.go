@@ -0,0 +1,108 @@
+package grpc
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// wildcardMethod sets the rate limit of each method without a limit of its own.
+const wildcardMethod = "*"
+
+// methodRateLimiter limits the number of requests per second of each gRPC method
+// with a token bucket. A bucket holds up to one second of requests, so that
+// short bursts are allowed.
+type methodRateLimiter struct {
+	mtx sync.Mutex
+
+	limits       map[string]float64
+	defaultLimit float64
+	buckets      map[string]*tokenBucket
+
+	now func() time.Time
+}
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// newMethodRateLimiter creates a rate limiter from limits in the form
+// {fullMethodName}={requestsPerSecond}. It returns nil if there are no limits.
+func newMethodRateLimiter(entries []string) (*methodRateLimiter, error) {
+	if len(entries) == 0 {
+		return nil, nil
+	}
+
+	l := &methodRateLimiter{
+		limits:  make(map[string]float64, len(entries)),
+		buckets: make(map[string]*tokenBucket),
+		now:     time.Now,
+	}
+	for _, entry := range entries {
+		parts := strings.Split(entry, "=")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid gRPC rate limit %q, expected {fullMethodName}={requestsPerSecond}", entry)
+		}
+
+		method := strings.TrimSpace(parts[0])
+		if method != wildcardMethod && !strings.HasPrefix(method, "/") {
+			return nil, fmt.Errorf("invalid gRPC rate limit %q, expected a full method name such as /package.Service/Method", entry)
+		}
+		limit, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+		if err != nil || limit <= 0 {
+			return nil, fmt.Errorf("invalid gRPC rate limit %q, expected a positive number of requests per second", entry)
+		}
+
+		if method == wildcardMethod {
+			l.defaultLimit = limit
+			continue
+		}
+		if _, ok := l.limits[method]; ok {
+			return nil, fmt.Errorf("duplicate gRPC rate limit of %s", method)
+		}
+		l.limits[method] = limit
+	}
+	return l, nil
+}
+
+// Allow reports whether a request of the method can be served, and takes a
+// token from the bucket of the method if so.
+func (l *methodRateLimiter) Allow(method string) bool {
+	limit, ok := l.limits[method]
+	if !ok {
+		limit = l.defaultLimit
+	}
+	if limit == 0 {
+		return true
+	}
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	now := l.now()
+	capacity := limit
+	if capacity < 1 {
+		capacity = 1
+	}
+
+	bucket, ok := l.buckets[method]
+	if !ok {
+		bucket = &tokenBucket{tokens: capacity, last: now}
+		l.buckets[method] = bucket
+	}
+
+	bucket.tokens += now.Sub(bucket.last).Seconds() * limit
+	if bucket.tokens > capacity {
+		bucket.tokens = capacity
+	}
+	bucket.last = now
+
+	if bucket.tokens < 1 {
+		return false
+	}
+	bucket.tokens--
+	return true
+}
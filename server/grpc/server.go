@@ -5,23 +5,71 @@ import (
 	"net"
 	"time"
 
+	"github.com/line/ostracon/libs/log"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/reflection"
 
 	"github.com/line/lbm-sdk/client"
+	"github.com/line/lbm-sdk/server/config"
 	"github.com/line/lbm-sdk/server/types"
 )
 
-// StartGRPCServer starts a gRPC server on the given address.
-func StartGRPCServer(clientCtx client.Context, app types.Application, address string) (*grpc.Server, error) {
-	grpcSrv := grpc.NewServer()
+// NewGRPCServer creates a gRPC server with the TLS credentials, the message size
+// limits and the rate limits of the given config. The served calls are logged and
+// recorded in the telemetry metrics.
+func NewGRPCServer(cfg config.GRPCConfig, logger log.Logger) (*grpc.Server, error) {
+	limiter, err := newMethodRateLimiter(cfg.RateLimits)
+	if err != nil {
+		return nil, err
+	}
+
+	unaryInterceptors := []grpc.UnaryServerInterceptor{unaryMonitoringInterceptor(logger)}
+	streamInterceptors := []grpc.StreamServerInterceptor{streamMonitoringInterceptor(logger)}
+	if limiter != nil {
+		unaryInterceptors = append(unaryInterceptors, unaryRateLimitInterceptor(limiter))
+		streamInterceptors = append(streamInterceptors, streamRateLimitInterceptor(limiter))
+	}
+
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	}
+	if cfg.MaxRecvMsgSize > 0 {
+		opts = append(opts, grpc.MaxRecvMsgSize(cfg.MaxRecvMsgSize))
+	}
+	if cfg.MaxSendMsgSize > 0 {
+		opts = append(opts, grpc.MaxSendMsgSize(cfg.MaxSendMsgSize))
+	}
+
+	tlsEnabled, err := isTLSEnabled(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if tlsEnabled {
+		creds, err := credentials.NewServerTLSFromFile(cfg.TLSCertFile, cfg.TLSKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load gRPC TLS credentials: %w", err)
+		}
+		opts = append(opts, grpc.Creds(creds))
+	}
+
+	return grpc.NewServer(opts...), nil
+}
+
+// StartGRPCServer starts a gRPC server on the address of the given config.
+func StartGRPCServer(clientCtx client.Context, app types.Application, cfg config.GRPCConfig, logger log.Logger) (*grpc.Server, error) {
+	grpcSrv, err := NewGRPCServer(cfg, logger)
+	if err != nil {
+		return nil, err
+	}
 	app.RegisterGRPCServer(clientCtx, grpcSrv)
 
 	// Reflection allows external clients to see what services and methods
 	// the gRPC server exposes.
 	reflection.Register(grpcSrv)
 
-	listener, err := net.Listen("tcp", address)
+	listener, err := net.Listen("tcp", cfg.Address)
 	if err != nil {
 		return nil, err
 	}
@@ -41,3 +89,13 @@ func StartGRPCServer(clientCtx client.Context, app types.Application, address st
 		return grpcSrv, nil
 	}
 }
+
+func isTLSEnabled(cfg config.GRPCConfig) (bool, error) {
+	if cfg.TLSCertFile == "" && cfg.TLSKeyFile == "" {
+		return false, nil
+	}
+	if cfg.TLSCertFile == "" || cfg.TLSKeyFile == "" {
+		return false, fmt.Errorf("both the gRPC TLS certificate and key files must be set")
+	}
+	return true, nil
+}
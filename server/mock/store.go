@@ -3,8 +3,10 @@ package mock
 import (
 	"io"
 
+	protoio "github.com/gogo/protobuf/io"
 	tmdb "github.com/line/tm-db/v2"
 
+	snapshottypes "github.com/line/lbm-sdk/snapshots/types"
 	store "github.com/line/lbm-sdk/store/types"
 	sdk "github.com/line/lbm-sdk/types"
 )
@@ -107,13 +109,13 @@ func (ms multiStore) SetInitialVersion(version int64) error {
 	panic("not implemented")
 }
 
-func (ms multiStore) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, error) {
+func (ms multiStore) Snapshot(height uint64, protoWriter protoio.Writer) error {
 	panic("not implemented")
 }
 
 func (ms multiStore) Restore(
-	height uint64, format uint32, chunks <-chan io.ReadCloser, ready chan<- struct{},
-) error {
+	height uint64, format uint32, protoReader protoio.Reader,
+) (snapshottypes.SnapshotItem, error) {
 	panic("not implemented")
 }
 
@@ -121,6 +123,18 @@ func (ms multiStore) SetIAVLCacheManager(cacheManager store.CacheManager) {
 	panic("not implemented")
 }
 
+func (ms multiStore) SetStateArchive(_ tmdb.DB) {
+	panic("not implemented")
+}
+
+func (ms multiStore) AddListeners(_ store.StoreKey, _ []store.WriteListener) {
+	panic("not implemented")
+}
+
+func (ms multiStore) ListeningEnabled(_ store.StoreKey) bool {
+	panic("not implemented")
+}
+
 var _ sdk.KVStore = kvStore{}
 
 type kvStore struct {
@@ -4,6 +4,7 @@ package server
 
 import (
 	"fmt"
+	"net/http"
 	"os"
 	"runtime/pprof"
 	"time"
@@ -49,6 +50,17 @@ const (
 	FlagInvCheckPeriod      = "inv-check-period"
 	FlagPrometheus          = "prometheus"
 
+	FlagCheckTxWorkers             = "check-tx-workers"
+	FlagCheckTxMaxPendingPerSigner = "check-tx-max-pending-per-signer"
+	FlagCheckTxPriorityDenom       = "check-tx-priority-denom"
+	FlagTxReplacementBumpPercent   = "tx-replacement-bump-percent"
+	FlagDeliverTxWorkers           = "deliver-tx-workers"
+
+	FlagInterBlockCacheStoreSizes = "inter-block-cache-store-sizes"
+	FlagInterBlockCacheWarmUp     = "inter-block-cache-warm-up"
+
+	FlagStateArchive = "state-archive"
+
 	FlagPruning           = "pruning"
 	FlagPruningKeepRecent = "pruning-keep-recent"
 	FlagPruningKeepEvery  = "pruning-keep-every"
@@ -59,8 +71,10 @@ const (
 
 // GRPC-related flags.
 const (
-	flagGRPCEnable  = "grpc.enable"
-	flagGRPCAddress = "grpc.address"
+	flagGRPCEnable     = "grpc.enable"
+	flagGRPCAddress    = "grpc.address"
+	flagGRPCWebEnable  = "grpc-web.enable"
+	flagGRPCWebAddress = "grpc-web.address"
 )
 
 // State sync-related flags.
@@ -145,9 +159,17 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Uint64(FlagHaltTime, 0, "Minimum block time (in Unix seconds) at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Bool(FlagInterBlockCache, true, "Enable inter-block caching")
 	cmd.Flags().Int(FlagInterBlockCacheSize, cache.DefaultCommitKVStoreCacheSize, "The maximum bytes size of the inter-block cache")
+	cmd.Flags().StringSlice(FlagInterBlockCacheStoreSizes, []string{}, "Stores given an inter-block cache of their own, with its maximum bytes size (e.g. wasm=209715200,bank=104857600)")
+	cmd.Flags().Bool(FlagInterBlockCacheWarmUp, false, "Fill the inter-block cache from the latest state sync snapshot at startup")
 	cmd.Flags().Int(FlagIAVLCacheSize, iavl.DefaultIAVLCacheSize, "The maximum bytes size of the iavl node cache")
+	cmd.Flags().Bool(FlagStateArchive, false, "Archive every committed state so that the heights pruned from the stores can still be queried, without proofs")
 	cmd.Flags().String(flagCPUProfile, "", "Enable CPU profiling and write to the provided file")
 	cmd.Flags().Bool(FlagTrace, false, "Provide full stack traces for errors in ABCI Log")
+	cmd.Flags().Int(FlagCheckTxWorkers, 0, "Number of txs checked at the same time, taken by fee per gas (0 checks every tx as soon as possible)")
+	cmd.Flags().Int(FlagCheckTxMaxPendingPerSigner, 0, "Maximum number of txs of a signer waiting to be checked (0 for no limit)")
+	cmd.Flags().String(FlagCheckTxPriorityDenom, "", "Fee denomination the txs waiting to be checked are taken by (empty uses the alphabetically first denomination of the minimum gas prices)")
+	cmd.Flags().Int(FlagDeliverTxWorkers, 0, "Number of txs of a block executed in parallel (0 or 1 executes the txs one by one)")
+	cmd.Flags().Uint64(FlagTxReplacementBumpPercent, 0, "Minimum fee increase, in percent, for a tx to replace the pending tx of the same signer and sequence (0 disables tx replacement)")
 	cmd.Flags().String(FlagPruning, storetypes.PruningOptionDefault, "Pruning strategy (default|nothing|everything|custom)")
 	cmd.Flags().Uint64(FlagPruningKeepRecent, 0, "Number of recent heights to keep on disk (ignored if pruning is not 'custom')")
 	cmd.Flags().Uint64(FlagPruningKeepEvery, 0, "Offset heights to keep on disk after 'keep-every' (ignored if pruning is not 'custom')")
@@ -157,6 +179,8 @@ which accepts a path for the resulting pprof file.
 
 	cmd.Flags().Bool(flagGRPCEnable, true, "Define if the gRPC server should be enabled")
 	cmd.Flags().String(flagGRPCAddress, config.DefaultGRPCAddress, "the gRPC server address to listen on")
+	cmd.Flags().Bool(flagGRPCWebEnable, false, "Define if the gRPC-Web server should be enabled (requires the gRPC server)")
+	cmd.Flags().String(flagGRPCWebAddress, config.DefaultGRPCWebAddress, "the gRPC-Web server address to listen on")
 
 	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
@@ -255,11 +279,16 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 	if err2 != nil {
 		return err2
 	}
+	clientCreator := proxy.NewLocalClientCreator(app)
+	if deliverTxsApp, ok := app.(deliverTxsApplication); ok && ctx.Viper.GetInt(FlagDeliverTxWorkers) > 1 {
+		clientCreator = newDeliverTxsClientCreator(deliverTxsApp)
+	}
+
 	ocNode, err := node.NewNode(
 		cfg,
 		pv,
 		nodeKey,
-		proxy.NewLocalClientCreator(app),
+		clientCreator,
 		genDocProvider,
 		node.DefaultDBProvider,
 		node.DefaultMetricsProvider(cfg.Instrumentation),
@@ -316,12 +345,22 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 		}
 	}
 
-	var grpcSrv *grpc.Server
+	var (
+		grpcSrv    *grpc.Server
+		grpcWebSrv *http.Server
+	)
 	if config.GRPC.Enable {
-		grpcSrv, err = servergrpc.StartGRPCServer(clientCtx, app, config.GRPC.Address)
+		grpcSrv, err = servergrpc.StartGRPCServer(clientCtx, app, config.GRPC, ctx.Logger.With("module", "grpc-server"))
 		if err != nil {
 			return err
 		}
+
+		if config.GRPCWeb.Enable {
+			grpcWebSrv, err = servergrpc.StartGRPCWeb(grpcSrv, config)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	defer func() {
@@ -339,6 +378,9 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 
 		if grpcSrv != nil {
 			grpcSrv.Stop()
+			if grpcWebSrv != nil {
+				_ = grpcWebSrv.Close()
+			}
 		}
 
 		ctx.Logger.Info("exiting...")
@@ -14,6 +14,7 @@ import (
 	"github.com/line/lbm-sdk/client"
 	"github.com/line/lbm-sdk/server/api"
 	"github.com/line/lbm-sdk/server/config"
+	"github.com/line/lbm-sdk/snapshots"
 )
 
 type (
@@ -46,6 +47,13 @@ type (
 
 		// RegisterTendermintService registers the gRPC Query service for ostracon queries.
 		RegisterTendermintService(clientCtx client.Context)
+
+		// LastBlockHeight returns the height of the last committed block.
+		LastBlockHeight() int64
+
+		// SnapshotManager returns the snapshot manager of the app, nil if
+		// snapshots are disabled.
+		SnapshotManager() *snapshots.Manager
 	}
 
 	// AppCreator is a function that allows us to lazily initialize an
@@ -10,12 +10,20 @@ const (
 	DefaultWeightMsgFundCommunityPool           int = 50
 	DefaultWeightMsgDeposit                     int = 100
 	DefaultWeightMsgVote                        int = 67
+	DefaultWeightMsgVoteWeighted                int = 33
 	DefaultWeightMsgUnjail                      int = 100
 	DefaultWeightMsgCreateValidator             int = 100
 	DefaultWeightMsgEditValidator               int = 5
 	DefaultWeightMsgDelegate                    int = 100
 	DefaultWeightMsgUndelegate                  int = 100
+	DefaultWeightMsgCancelUnbondingDelegation   int = 100
 	DefaultWeightMsgBeginRedelegate             int = 100
+	DefaultWeightMsgTransferDelegation          int = 50
+	DefaultWeightGrantAllowance                 int = 100
+	DefaultWeightRevokeAllowance                int = 100
+	DefaultWeightMsgGrant                       int = 100
+	DefaultWeightMsgRevoke                      int = 90
+	DefaultWeightMsgExec                        int = 90
 
 	DefaultWeightCommunitySpendProposal int = 5
 	DefaultWeightTextProposal           int = 5
@@ -19,6 +19,7 @@ import (
 	"github.com/line/lbm-sdk/client/flags"
 	"github.com/line/lbm-sdk/client/keys"
 	"github.com/line/lbm-sdk/client/rpc"
+	"github.com/line/lbm-sdk/client/snapshot"
 	"github.com/line/lbm-sdk/server"
 	servertypes "github.com/line/lbm-sdk/server/types"
 	"github.com/line/lbm-sdk/simapp"
@@ -82,6 +83,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 
 	a := appCreator{encodingConfig}
 	server.AddCommands(rootCmd, simapp.DefaultNodeHome, a.newApp, a.appExport, addModuleInitFlags)
+	rootCmd.AddCommand(snapshot.Cmd(a.newApp))
 
 	// add keybase, auxiliary RPC, query, and tx child commands
 	rootCmd.AddCommand(
@@ -160,8 +162,12 @@ func (a appCreator) newApp(logger log.Logger, db tmdb.DB, traceStore io.Writer,
 	ibCacheMetricsProvider, iavlCacheMetricsProvider :=
 		baseapp.MetricsProvider(cast.ToBool(viper.GetBool(server.FlagPrometheus)))
 	if cast.ToBool(appOpts.Get(server.FlagInterBlockCache)) {
+		cacheOpts, err := server.GetInterBlockCacheOptionsFromFlags(appOpts)
+		if err != nil {
+			panic(err)
+		}
 		cache = store.NewCommitKVStoreCacheManager(
-			cast.ToInt(appOpts.Get(server.FlagInterBlockCacheSize)), ibCacheMetricsProvider)
+			cast.ToInt(appOpts.Get(server.FlagInterBlockCacheSize)), ibCacheMetricsProvider, cacheOpts...)
 	}
 
 	bech32CacheSize := cast.ToInt(appOpts.Get(server.FlagBech32CacheSize))
@@ -189,6 +195,14 @@ func (a appCreator) newApp(logger log.Logger, db tmdb.DB, traceStore io.Writer,
 		panic(err)
 	}
 
+	var stateArchiveDB tmdb.DB
+	if cast.ToBool(appOpts.Get(server.FlagStateArchive)) {
+		stateArchiveDB, err = sdk.NewLevelDB("archive", filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data"))
+		if err != nil {
+			panic(err)
+		}
+	}
+
 	return simapp.NewSimApp(
 		logger, db, traceStore, true, skipUpgradeHeights,
 		cast.ToString(appOpts.Get(flags.FlagHome)),
@@ -201,8 +215,14 @@ func (a appCreator) newApp(logger log.Logger, db tmdb.DB, traceStore io.Writer,
 		baseapp.SetHaltTime(cast.ToUint64(appOpts.Get(server.FlagHaltTime))),
 		baseapp.SetMinRetainBlocks(cast.ToUint64(appOpts.Get(server.FlagMinRetainBlocks))),
 		baseapp.SetInterBlockCache(cache),
+		baseapp.SetInterBlockCacheWarmUp(cast.ToBool(appOpts.Get(server.FlagInterBlockCacheWarmUp))),
 		baseapp.SetIAVLCacheManager(cast.ToInt(appOpts.Get(server.FlagIAVLCacheSize)), iavlCacheMetricsProvider),
+		baseapp.SetStateArchive(stateArchiveDB),
 		baseapp.SetTrace(cast.ToBool(appOpts.Get(server.FlagTrace))),
+		baseapp.SetCheckTxWorkers(cast.ToInt(appOpts.Get(server.FlagCheckTxWorkers))),
+		baseapp.SetCheckTxMaxPendingPerSigner(cast.ToInt(appOpts.Get(server.FlagCheckTxMaxPendingPerSigner))),
+		baseapp.SetCheckTxPriorityDenom(cast.ToString(appOpts.Get(server.FlagCheckTxPriorityDenom))),
+		baseapp.SetDeliverTxWorkers(cast.ToInt(appOpts.Get(server.FlagDeliverTxWorkers))),
 		baseapp.SetIndexEvents(cast.ToStringSlice(appOpts.Get(server.FlagIndexEvents))),
 		baseapp.SetSnapshotStore(snapshotStore),
 		baseapp.SetSnapshotInterval(cast.ToUint64(appOpts.Get(server.FlagStateSyncSnapshotInterval))),
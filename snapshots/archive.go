@@ -0,0 +1,159 @@
+package snapshots
+
+import (
+	"archive/tar"
+	"bytes"
+	"crypto/sha256"
+	"io"
+	"io/ioutil"
+	"strconv"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/line/lbm-sdk/snapshots/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+const (
+	// archiveMetadata is the name of the archive entry holding the snapshot metadata. The
+	// chunks follow it in order, named by their index.
+	archiveMetadata = "metadata"
+
+	// archiveMaxMetadataSize bounds the size of the metadata entry read from an archive.
+	archiveMaxMetadataSize = 1 << 24
+)
+
+// Dump writes a snapshot to the writer as a tar archive, to be loaded into another store with
+// Import.
+func (s *Store) Dump(height uint64, format uint32, w io.Writer) error {
+	snapshot, chunks, err := s.Load(height, format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return sdkerrors.Wrapf(types.ErrSnapshotNotFound, "height %v format %v", height, format)
+	}
+	defer DrainChunks(chunks)
+
+	metadata, err := proto.Marshal(snapshot)
+	if err != nil {
+		return sdkerrors.Wrap(err, "failed to encode snapshot metadata")
+	}
+	tw := tar.NewWriter(w)
+	err = writeArchiveEntry(tw, archiveMetadata, metadata)
+	if err != nil {
+		return err
+	}
+
+	index := uint32(0)
+	for chunk := range chunks {
+		data, err := ioutil.ReadAll(chunk)
+		chunk.Close()
+		if err != nil {
+			return sdkerrors.Wrapf(err, "failed to read snapshot chunk %v", index)
+		}
+		err = writeArchiveEntry(tw, strconv.FormatUint(uint64(index), 10), data)
+		if err != nil {
+			return err
+		}
+		index++
+	}
+	return tw.Close()
+}
+
+// Import loads a snapshot archived with Dump into the store, returning it. The chunks are
+// checked against the hashes of the metadata, and the checksums of their own in FormatV2.
+func (s *Store) Import(r io.Reader) (*types.Snapshot, error) {
+	tr := tar.NewReader(r)
+	header, err := tr.Next()
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to read snapshot archive")
+	}
+	if header.Name != archiveMetadata {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidMetadata, "unexpected archive entry %q", header.Name)
+	}
+	metadata, err := ioutil.ReadAll(io.LimitReader(tr, archiveMaxMetadataSize))
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to read snapshot metadata")
+	}
+	expected := &types.Snapshot{}
+	err = proto.Unmarshal(metadata, expected)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to decode snapshot metadata")
+	}
+	if uint32(len(expected.Metadata.ChunkHashes)) != expected.Chunks {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidMetadata, "snapshot has %v chunk hashes, but %v chunks",
+			len(expected.Metadata.ChunkHashes), expected.Chunks)
+	}
+
+	chunks := make(chan io.ReadCloser)
+	chErr := make(chan error, 1)
+	go func() {
+		defer close(chunks)
+		chErr <- readArchiveChunks(tr, expected, chunks)
+	}()
+
+	snapshot, err := s.Save(expected.Height, expected.Format, chunks)
+	if archiveErr := <-chErr; archiveErr != nil {
+		err = archiveErr
+	}
+	if err == nil && !bytes.Equal(snapshot.Hash, expected.Hash) {
+		err = sdkerrors.Wrapf(types.ErrChunkHashMismatch, "snapshot hash %x, expected %x", snapshot.Hash, expected.Hash)
+	}
+	if err != nil {
+		if snapshot != nil {
+			_ = s.Delete(snapshot.Height, snapshot.Format)
+		}
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// readArchiveChunks passes the chunks of an archive to the channel, checking them against the
+// snapshot metadata.
+func readArchiveChunks(tr *tar.Reader, snapshot *types.Snapshot, chunks chan<- io.ReadCloser) error {
+	for index := uint32(0); ; index++ {
+		header, err := tr.Next()
+		if err == io.EOF {
+			if index != snapshot.Chunks {
+				return sdkerrors.Wrapf(types.ErrInvalidMetadata, "archive has %v chunks, expected %v", index, snapshot.Chunks)
+			}
+			return nil
+		}
+		if err != nil {
+			return sdkerrors.Wrap(err, "failed to read snapshot archive")
+		}
+		if index >= snapshot.Chunks || header.Name != strconv.FormatUint(uint64(index), 10) {
+			return sdkerrors.Wrapf(types.ErrInvalidMetadata, "unexpected archive entry %q", header.Name)
+		}
+
+		data, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "failed to read snapshot chunk %v", index)
+		}
+		hash := sha256.Sum256(data)
+		if !bytes.Equal(hash[:], snapshot.Metadata.ChunkHashes[index]) {
+			return sdkerrors.Wrapf(types.ErrChunkHashMismatch,
+				"chunk %v: expected %x, got %x", index, snapshot.Metadata.ChunkHashes[index], hash)
+		}
+		if snapshot.Format == types.FormatV2 {
+			if _, err := DecompressChunk(data); err != nil {
+				return sdkerrors.Wrapf(err, "chunk %v", index)
+			}
+		}
+		chunks <- ioutil.NopCloser(bytes.NewReader(data))
+	}
+}
+
+func writeArchiveEntry(tw *tar.Writer, name string, data []byte) error {
+	err := tw.WriteHeader(&tar.Header{
+		Name: name,
+		Mode: 0644,
+		Size: int64(len(data)),
+	})
+	if err != nil {
+		return sdkerrors.Wrapf(err, "failed to write archive entry %q", name)
+	}
+	_, err = tw.Write(data)
+	return sdkerrors.Wrapf(err, "failed to write archive entry %q", name)
+}
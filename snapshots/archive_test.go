@@ -0,0 +1,116 @@
+package snapshots_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/tm-db/v2/memdb"
+
+	"github.com/line/lbm-sdk/snapshots"
+	"github.com/line/lbm-sdk/snapshots/types"
+)
+
+func newEmptyStore(t *testing.T) *snapshots.Store {
+	store, err := snapshots.NewStore(memdb.NewDB(), t.TempDir())
+	require.NoError(t, err)
+	return store
+}
+
+func TestStoreDumpImport(t *testing.T) {
+	source := setupStore(t)
+	var archive bytes.Buffer
+	require.NoError(t, source.Dump(2, 1, &archive))
+
+	target := newEmptyStore(t)
+	snapshot, err := target.Import(&archive)
+	require.NoError(t, err)
+
+	expected, err := source.Get(2, 1)
+	require.NoError(t, err)
+	require.Equal(t, expected, snapshot)
+	_, chunks, err := target.Load(2, 1)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{{2, 1, 0}, {2, 1, 1}}, readChunks(chunks))
+
+	// importing it again conflicts
+	archive.Reset()
+	require.NoError(t, source.Dump(2, 1, &archive))
+	_, err = target.Import(&archive)
+	require.Error(t, err)
+}
+
+func TestStoreDumpImport_FormatV2(t *testing.T) {
+	source := newEmptyStore(t)
+	chunks := readChunks(snapshots.CompressChunks(makeChunks([][]byte{{1, 2, 3}, {4, 5, 6}})))
+	_, err := source.Save(5, types.FormatV2, makeChunks(chunks))
+	require.NoError(t, err)
+
+	var archive bytes.Buffer
+	require.NoError(t, source.Dump(5, types.FormatV2, &archive))
+	target := newEmptyStore(t)
+	_, err = target.Import(&archive)
+	require.NoError(t, err)
+
+	// a chunk not matching its checksum is rejected, even with matching hashes
+	corrupted := newEmptyStore(t)
+	chunks[1][len(chunks[1])-1]++
+	_, err = corrupted.Save(5, types.FormatV2, makeChunks(chunks))
+	require.NoError(t, err)
+	archive.Reset()
+	require.NoError(t, corrupted.Dump(5, types.FormatV2, &archive))
+
+	target = newEmptyStore(t)
+	_, err = target.Import(&archive)
+	require.True(t, errors.Is(err, types.ErrChunkChecksumMismatch), err)
+	snapshot, err := target.Get(5, types.FormatV2)
+	require.NoError(t, err)
+	require.Nil(t, snapshot)
+}
+
+func TestStoreDump_NotFound(t *testing.T) {
+	store := setupStore(t)
+	err := store.Dump(9, 1, ioutil.Discard)
+	require.True(t, errors.Is(err, types.ErrSnapshotNotFound), err)
+}
+
+func TestStoreImport_Errors(t *testing.T) {
+	source := setupStore(t)
+	var archive bytes.Buffer
+	require.NoError(t, source.Dump(2, 1, &archive))
+
+	// rewrite the archive, altering the last chunk
+	var tampered bytes.Buffer
+	tr, tw := tar.NewReader(bytes.NewReader(archive.Bytes())), tar.NewWriter(&tampered)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		data, err := ioutil.ReadAll(tr)
+		require.NoError(t, err)
+		if header.Name == "1" {
+			data[0]++
+		}
+		require.NoError(t, tw.WriteHeader(header))
+		_, err = tw.Write(data)
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+
+	target := newEmptyStore(t)
+	_, err := target.Import(&tampered)
+	require.True(t, errors.Is(err, types.ErrChunkHashMismatch), err)
+	snapshot, err := target.Get(2, 1)
+	require.NoError(t, err)
+	require.Nil(t, snapshot)
+
+	_, err = target.Import(bytes.NewReader([]byte("not an archive")))
+	require.Error(t, err)
+}
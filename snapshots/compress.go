@@ -0,0 +1,97 @@
+package snapshots
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+
+	"github.com/DataDog/zstd"
+
+	"github.com/line/lbm-sdk/snapshots/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+const (
+	// chunkChecksumSize is the size of the checksum trailing a compressed chunk.
+	chunkChecksumSize = 4
+
+	// chunkCompressionLevel is the zstd compression level of the chunks.
+	chunkCompressionLevel = 7
+)
+
+var crc32c = crc32.MakeTable(crc32.Castagnoli)
+
+// CompressChunk compresses a chunk of the FormatV2 snapshot stream with zstd, followed by the
+// CRC-32C checksum of its content.
+func CompressChunk(chunk []byte) ([]byte, error) {
+	compressed, err := zstd.CompressLevel(nil, chunk, chunkCompressionLevel)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "zstd failure")
+	}
+	checksum := make([]byte, chunkChecksumSize)
+	binary.BigEndian.PutUint32(checksum, crc32.Checksum(chunk, crc32c))
+	return append(compressed, checksum...), nil
+}
+
+// DecompressChunk decompresses a FormatV2 chunk, returning ErrChunkChecksumMismatch if its
+// content does not match its checksum.
+func DecompressChunk(chunk []byte) ([]byte, error) {
+	if len(chunk) < chunkChecksumSize {
+		return nil, sdkerrors.Wrapf(types.ErrChunkChecksumMismatch, "chunk of %v bytes has no checksum", len(chunk))
+	}
+	compressed, checksum := chunk[:len(chunk)-chunkChecksumSize], chunk[len(chunk)-chunkChecksumSize:]
+	content, err := zstd.Decompress(nil, compressed)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrChunkChecksumMismatch, err.Error())
+	}
+	if expected, actual := binary.BigEndian.Uint32(checksum), crc32.Checksum(content, crc32c); expected != actual {
+		return nil, sdkerrors.Wrapf(types.ErrChunkChecksumMismatch, "expected %08x, got %08x", expected, actual)
+	}
+	return content, nil
+}
+
+// CompressChunks compresses every chunk of the channel with CompressChunk. The chunks must be
+// consumed and closed.
+func CompressChunks(chunks <-chan io.ReadCloser) <-chan io.ReadCloser {
+	return mapChunks(chunks, CompressChunk)
+}
+
+// DecompressChunks decompresses every chunk of the channel with DecompressChunk. The chunks
+// must be consumed and closed.
+func DecompressChunks(chunks <-chan io.ReadCloser) <-chan io.ReadCloser {
+	return mapChunks(chunks, DecompressChunk)
+}
+
+// mapChunks applies the transformation to every chunk of the channel. A chunk failing passes
+// the error to its reader, and ends the chunks.
+func mapChunks(chunks <-chan io.ReadCloser, transform func([]byte) ([]byte, error)) <-chan io.ReadCloser {
+	ch := make(chan io.ReadCloser)
+	go mapChunksTo(chunks, ch, transform)
+	return ch
+}
+
+// mapChunksTo applies the transformation to every chunk of the channel like mapChunks, passing
+// the transformed chunks to the output channel, which is closed at the end.
+func mapChunksTo(chunks <-chan io.ReadCloser, ch chan<- io.ReadCloser, transform func([]byte) ([]byte, error)) {
+	defer close(ch)
+	defer DrainChunks(chunks)
+
+	for chunk := range chunks {
+		data, err := ioutil.ReadAll(chunk)
+		if closeErr := chunk.Close(); err == nil {
+			err = closeErr
+		}
+		if err == nil {
+			data, err = transform(data)
+		}
+		if err != nil {
+			pr, pw := io.Pipe()
+			ch <- pr
+			pw.CloseWithError(err)
+			return
+		}
+		ch <- ioutil.NopCloser(bytes.NewReader(data))
+	}
+}
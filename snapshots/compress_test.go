@@ -0,0 +1,75 @@
+package snapshots_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/snapshots"
+	"github.com/line/lbm-sdk/snapshots/types"
+)
+
+func TestCompressChunk(t *testing.T) {
+	content := bytes.Repeat([]byte("snapshot chunk"), 1000)
+
+	compressed, err := snapshots.CompressChunk(content)
+	require.NoError(t, err)
+	require.Less(t, len(compressed), len(content))
+
+	decompressed, err := snapshots.DecompressChunk(compressed)
+	require.NoError(t, err)
+	require.Equal(t, content, decompressed)
+
+	// an empty chunk is still checksummed
+	compressed, err = snapshots.CompressChunk(nil)
+	require.NoError(t, err)
+	decompressed, err = snapshots.DecompressChunk(compressed)
+	require.NoError(t, err)
+	require.Empty(t, decompressed)
+}
+
+func TestDecompressChunk_Corrupted(t *testing.T) {
+	compressed, err := snapshots.CompressChunk([]byte("snapshot chunk"))
+	require.NoError(t, err)
+
+	testcases := map[string][]byte{
+		"no checksum":    compressed[:2],
+		"wrong checksum": append(append([]byte{}, compressed[:len(compressed)-1]...), compressed[len(compressed)-1]+1),
+		"not zstd":       append([]byte("not zstd"), compressed[len(compressed)-4:]...),
+	}
+	for name, chunk := range testcases {
+		chunk := chunk
+		t.Run(name, func(t *testing.T) {
+			_, err := snapshots.DecompressChunk(chunk)
+			require.True(t, errors.Is(err, types.ErrChunkChecksumMismatch), err)
+		})
+	}
+}
+
+func TestCompressChunks(t *testing.T) {
+	chunks := [][]byte{{1, 2, 3}, {4, 5, 6}, {}}
+
+	compressed := readChunks(snapshots.CompressChunks(makeChunks(chunks)))
+	require.Len(t, compressed, len(chunks))
+	require.Equal(t, chunks, readChunks(snapshots.DecompressChunks(makeChunks(compressed))))
+}
+
+func TestDecompressChunks_Corrupted(t *testing.T) {
+	compressed := readChunks(snapshots.CompressChunks(makeChunks([][]byte{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}})))
+	compressed[1][len(compressed[1])-1]++
+
+	ch := snapshots.DecompressChunks(makeChunks(compressed))
+	chunk, err := ioutil.ReadAll(<-ch)
+	require.NoError(t, err)
+	require.Equal(t, []byte{1, 2, 3}, chunk)
+
+	_, err = ioutil.ReadAll(<-ch)
+	require.True(t, errors.Is(err, types.ErrChunkChecksumMismatch), err)
+
+	// the chunks end with the failing one
+	_, ok := <-ch
+	require.False(t, ok)
+}
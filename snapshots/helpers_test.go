@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	protoio "github.com/gogo/protobuf/io"
 	"github.com/stretchr/testify/require"
 
 	"github.com/line/tm-db/v2/memdb"
@@ -58,45 +59,133 @@ func readChunks(chunks <-chan io.ReadCloser) [][]byte {
 	return bodies
 }
 
+// snapshotItems returns the chunks of a snapshot holding the items of the mock snapshotter,
+// followed by the payloads of the extension.
+func snapshotItems(items [][]byte, ext *extSnapshotter) [][]byte {
+	ch := make(chan io.ReadCloser)
+	streamWriter, err := snapshots.NewStreamWriter(ch, types.CurrentFormat)
+	if err != nil {
+		panic(err)
+	}
+	go func() {
+		for _, item := range items {
+			if err := types.WriteExtensionItem(streamWriter, item); err != nil {
+				panic(err)
+			}
+		}
+		if ext != nil {
+			err := streamWriter.WriteMsg(&types.SnapshotItem{
+				Item: &types.SnapshotItem_Extension{
+					Extension: &types.SnapshotExtensionMeta{
+						Name:   ext.SnapshotName(),
+						Format: ext.SnapshotFormat(),
+					},
+				},
+			})
+			if err != nil {
+				panic(err)
+			}
+			for _, payload := range ext.payloads {
+				if err := types.WriteExtensionItem(streamWriter, payload); err != nil {
+					panic(err)
+				}
+			}
+		}
+		if err := streamWriter.Close(); err != nil {
+			panic(err)
+		}
+	}()
+	return readChunks(ch)
+}
+
+// mockSnapshotter snapshots its items as extension payloads.
 type mockSnapshotter struct {
-	chunks [][]byte
+	items [][]byte
 }
 
 func (m *mockSnapshotter) Restore(
-	height uint64, format uint32, chunks <-chan io.ReadCloser, ready chan<- struct{},
-) error {
+	height uint64, format uint32, protoReader protoio.Reader,
+) (types.SnapshotItem, error) {
 	if format == 0 {
-		return types.ErrUnknownFormat
+		return types.SnapshotItem{}, types.ErrUnknownFormat
 	}
-	if m.chunks != nil {
-		return errors.New("already has contents")
+	if m.items != nil {
+		return types.SnapshotItem{}, errors.New("already has contents")
 	}
-	if ready != nil {
-		close(ready)
+
+	m.items = [][]byte{}
+	for {
+		item := types.SnapshotItem{}
+		err := protoReader.ReadMsg(&item)
+		if err == io.EOF {
+			return types.SnapshotItem{}, nil
+		} else if err != nil {
+			return types.SnapshotItem{}, err
+		}
+		payload := item.GetExtensionPayload()
+		if payload == nil {
+			return item, nil
+		}
+		m.items = append(m.items, payload.Payload)
 	}
+}
 
-	m.chunks = [][]byte{}
-	for reader := range chunks {
-		chunk, err := ioutil.ReadAll(reader)
-		if err != nil {
+func (m *mockSnapshotter) Snapshot(height uint64, protoWriter protoio.Writer) error {
+	for _, item := range m.items {
+		if err := types.WriteExtensionItem(protoWriter, item); err != nil {
 			return err
 		}
-		m.chunks = append(m.chunks, chunk)
 	}
-
 	return nil
 }
 
-func (m *mockSnapshotter) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, error) {
-	if format == 0 {
-		return nil, types.ErrUnknownFormat
+// extSnapshotter is a snapshot extension snapshotting its payloads.
+type extSnapshotter struct {
+	payloads [][]byte
+}
+
+var _ types.ExtensionSnapshotter = (*extSnapshotter)(nil)
+
+func (s *extSnapshotter) SnapshotName() string {
+	return "mock"
+}
+
+func (s *extSnapshotter) SnapshotFormat() uint32 {
+	return 1
+}
+
+func (s *extSnapshotter) SupportedFormats() []uint32 {
+	return []uint32{1}
+}
+
+func (s *extSnapshotter) SnapshotExtension(height uint64, payloadWriter types.ExtensionPayloadWriter) error {
+	for _, payload := range s.payloads {
+		if err := payloadWriter(payload); err != nil {
+			return err
+		}
 	}
-	ch := make(chan io.ReadCloser, len(m.chunks))
-	for _, chunk := range m.chunks {
-		ch <- ioutil.NopCloser(bytes.NewReader(chunk))
+	return nil
+}
+
+func (s *extSnapshotter) RestoreExtension(height uint64, format uint32, payloadReader types.ExtensionPayloadReader) error {
+	for {
+		payload, err := payloadReader()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		s.payloads = append(s.payloads, payload)
 	}
-	close(ch)
-	return ch, nil
+}
+
+// failingExtSnapshotter is a snapshot extension failing to snapshot.
+type failingExtSnapshotter struct {
+	extSnapshotter
+}
+
+func (s *failingExtSnapshotter) SnapshotExtension(uint64, types.ExtensionPayloadWriter) error {
+	return errors.New("failed")
 }
 
 // setupBusyManager creates a manager with an empty store that is busy creating a snapshot at height 1.
@@ -136,15 +225,13 @@ func (m *hungSnapshotter) Close() {
 	close(m.ch)
 }
 
-func (m *hungSnapshotter) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, error) {
+func (m *hungSnapshotter) Snapshot(height uint64, protoWriter protoio.Writer) error {
 	<-m.ch
-	ch := make(chan io.ReadCloser, 1)
-	ch <- ioutil.NopCloser(bytes.NewReader([]byte{}))
-	return ch, nil
+	return nil
 }
 
 func (m *hungSnapshotter) Restore(
-	height uint64, format uint32, chunks <-chan io.ReadCloser, ready chan<- struct{},
-) error {
+	height uint64, format uint32, protoReader protoio.Reader,
+) (types.SnapshotItem, error) {
 	panic("not implemented")
 }
@@ -5,8 +5,12 @@ import (
 	"crypto/sha256"
 	"io"
 	"io/ioutil"
+	"sort"
 	"sync"
 
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/gogo/protobuf/proto"
+
 	"github.com/line/lbm-sdk/snapshots/types"
 	sdkerrors "github.com/line/lbm-sdk/types/errors"
 )
@@ -42,9 +46,13 @@ type restoreDone struct {
 //
 // 2) io.ReadCloser streams automatically propagate IO errors, and can pass arbitrary
 //    errors via io.Pipe.CloseWithError().
+//
+// The manager serializes the snapshot items of the target, followed by those of the registered
+// extensions in the order of their names, into the snapshot chunks.
 type Manager struct {
-	store  *Store
-	target types.Snapshotter
+	store      *Store
+	target     types.Snapshotter
+	extensions map[string]types.ExtensionSnapshotter
 
 	mtx                sync.Mutex
 	operation          operation
@@ -57,9 +65,33 @@ type Manager struct {
 // NewManager creates a new manager.
 func NewManager(store *Store, target types.Snapshotter) *Manager {
 	return &Manager{
-		store:  store,
-		target: target,
+		store:      store,
+		target:     target,
+		extensions: make(map[string]types.ExtensionSnapshotter),
+	}
+}
+
+// RegisterExtensions registers extension snapshotters to the manager. It must be called before
+// any snapshot is created or restored.
+func (m *Manager) RegisterExtensions(extensions ...types.ExtensionSnapshotter) error {
+	for _, extension := range extensions {
+		name := extension.SnapshotName()
+		if _, ok := m.extensions[name]; ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "duplicated snapshot extension: %s", name)
+		}
+		m.extensions[name] = extension
+	}
+	return nil
+}
+
+// sortedExtensionNames returns the names of the extensions in the order of their snapshots.
+func (m *Manager) sortedExtensionNames() []string {
+	names := make([]string, 0, len(m.extensions))
+	for name := range m.extensions {
+		names = append(names, name)
 	}
+	sort.Strings(names)
+	return names
 }
 
 // begin starts an operation, or errors if one is in progress. It manages the mutex itself.
@@ -120,11 +152,48 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 			"a more recent snapshot already exists at height %v", latest.Height)
 	}
 
-	chunks, err := m.target.Snapshot(height, types.CurrentFormat)
+	ch := make(chan io.ReadCloser)
+	streamWriter, err := NewStreamWriter(ch, types.CurrentFormat)
 	if err != nil {
 		return nil, err
 	}
-	return m.store.Save(height, types.CurrentFormat, chunks)
+	go m.createSnapshot(height, streamWriter)
+
+	return m.store.Save(height, types.CurrentFormat, ch)
+}
+
+// createSnapshot writes the snapshot items of the target and of the extensions into the stream,
+// passing any error to the reader of its chunks.
+func (m *Manager) createSnapshot(height uint64, streamWriter *StreamWriter) {
+	if err := m.target.Snapshot(height, streamWriter); err != nil {
+		streamWriter.CloseWithError(err)
+		return
+	}
+	for _, name := range m.sortedExtensionNames() {
+		extension := m.extensions[name]
+		// write the extension metadata, followed by its payloads
+		err := streamWriter.WriteMsg(&types.SnapshotItem{
+			Item: &types.SnapshotItem_Extension{
+				Extension: &types.SnapshotExtensionMeta{
+					Name:   name,
+					Format: extension.SnapshotFormat(),
+				},
+			},
+		})
+		if err != nil {
+			streamWriter.CloseWithError(err)
+			return
+		}
+		payloadWriter := func(payload []byte) error {
+			return types.WriteExtensionItem(streamWriter, payload)
+		}
+		if err := extension.SnapshotExtension(height, payloadWriter); err != nil {
+			streamWriter.CloseWithError(sdkerrors.Wrapf(err, "snapshot extension %s", name))
+			return
+		}
+	}
+	// errors are passed to the reader by Close itself
+	_ = streamWriter.Close()
 }
 
 // List lists snapshots, mirroring ABCI ListSnapshots. It can be concurrent with other operations.
@@ -132,6 +201,16 @@ func (m *Manager) List() ([]*types.Snapshot, error) {
 	return m.store.List()
 }
 
+// LoadLatest loads the latest snapshot, if any. The chunks must be consumed and
+// closed.
+func (m *Manager) LoadLatest() (*types.Snapshot, <-chan io.ReadCloser, error) {
+	snapshot, err := m.store.GetLatest()
+	if snapshot == nil || err != nil {
+		return nil, nil, err
+	}
+	return m.store.Load(snapshot.Height, snapshot.Format)
+}
+
 // LoadChunk loads a chunk into a byte slice, mirroring ABCI LoadChunk. It can be called
 // concurrently with other operations. If the chunk does not exist, nil is returned.
 func (m *Manager) LoadChunk(height uint64, format uint32, chunk uint32) ([]byte, error) {
@@ -160,6 +239,9 @@ func (m *Manager) Prune(retain uint32) (uint64, error) {
 // Restore begins an async snapshot restoration, mirroring ABCI OfferSnapshot. Chunks must be fed
 // via RestoreChunk() until the restore is complete or a chunk fails.
 func (m *Manager) Restore(snapshot types.Snapshot) error {
+	if !types.IsSupportedFormat(snapshot.Format) {
+		return sdkerrors.Wrapf(types.ErrUnknownFormat, "format %v", snapshot.Format)
+	}
 	if snapshot.Chunks == 0 {
 		return sdkerrors.Wrap(types.ErrInvalidMetadata, "no chunks")
 	}
@@ -180,7 +262,7 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	chReady := make(chan struct{}, 1)
 	chDone := make(chan restoreDone, 1)
 	go func() {
-		err := m.target.Restore(snapshot.Height, snapshot.Format, chChunks, chReady)
+		err := m.doRestoreSnapshot(snapshot, chChunks, chReady)
 		chDone <- restoreDone{
 			complete: err == nil,
 			err:      err,
@@ -257,3 +339,130 @@ func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
 	}
 	return false, nil
 }
+
+// RestoreLocalSnapshot restores the app state from a snapshot of the store, mirroring a state
+// sync from it. It blocks until the restore is complete.
+func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
+	snapshot, chunks, err := m.store.Load(height, format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return sdkerrors.Wrapf(types.ErrSnapshotNotFound, "height %v format %v", height, format)
+	}
+	defer DrainChunks(chunks)
+
+	err = m.begin(opRestore)
+	if err != nil {
+		return err
+	}
+	defer m.end()
+
+	return m.doRestoreSnapshot(*snapshot, verifyChunkHashes(chunks, snapshot.Metadata.ChunkHashes), nil)
+}
+
+// doRestoreSnapshot restores the target and the extensions from the snapshot items read from the
+// chunks of the snapshot. If the ready channel is non-nil, it is closed once the target is ready
+// to accept chunks.
+func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chunks <-chan io.ReadCloser, ready chan<- struct{}) error {
+	streamReader := &readyStreamReader{chunks: chunks, format: snapshot.Format, ready: ready}
+	defer streamReader.Close()
+
+	nextItem, err := m.target.Restore(snapshot.Height, snapshot.Format, streamReader)
+	if err != nil {
+		return sdkerrors.Wrap(err, "multistore restore")
+	}
+	for nextItem.Item != nil {
+		metadata := nextItem.GetExtension()
+		if metadata == nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "unknown snapshot item %T", nextItem.Item)
+		}
+		extension, ok := m.extensions[metadata.Name]
+		if !ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "unknown snapshot extension %s", metadata.Name)
+		}
+		if !types.IsFormatSupported(extension, metadata.Format) {
+			return sdkerrors.Wrapf(types.ErrUnknownFormat, "format %v for extension %s", metadata.Format, metadata.Name)
+		}
+
+		// the payload reader stops at the first item not being a payload, which is kept as the
+		// next item
+		nextItem = types.SnapshotItem{}
+		payloadReader := func() ([]byte, error) {
+			nextItem.Reset()
+			if err := streamReader.ReadMsg(&nextItem); err != nil {
+				if err != io.EOF {
+					err = sdkerrors.Wrap(err, "invalid protobuf message")
+				}
+				return nil, err
+			}
+			payload := nextItem.GetExtensionPayload()
+			if payload == nil {
+				return nil, io.EOF
+			}
+			return payload.Payload, nil
+		}
+		if err := extension.RestoreExtension(snapshot.Height, metadata.Format, payloadReader); err != nil {
+			return sdkerrors.Wrapf(err, "extension %s restore", metadata.Name)
+		}
+		if nextItem.GetExtensionPayload() != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "extension %s did not read all of its payloads", metadata.Name)
+		}
+	}
+	return nil
+}
+
+// verifyChunkHashes checks the chunks of the channel against their SHA-256 hashes. A chunk
+// failing passes ErrChunkHashMismatch to its reader, and ends the chunks.
+func verifyChunkHashes(chunks <-chan io.ReadCloser, hashes [][]byte) <-chan io.ReadCloser {
+	index := 0
+	return mapChunks(chunks, func(chunk []byte) ([]byte, error) {
+		if index >= len(hashes) {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidMetadata, "snapshot has only %v chunk hashes", len(hashes))
+		}
+		hash := sha256.Sum256(chunk)
+		if !bytes.Equal(hash[:], hashes[index]) {
+			return nil, sdkerrors.Wrapf(types.ErrChunkHashMismatch,
+				"chunk %v: expected %x, got %x", index, hashes[index], hash)
+		}
+		index++
+		return chunk, nil
+	})
+}
+
+// readyStreamReader is a StreamReader signaling readiness and setting up the stream pipeline
+// on its first read, once the target has validated the snapshot. It must not be set up before
+// readiness, since the zlib reader reads from the chunks on initialization, potentially causing
+// deadlocks.
+type readyStreamReader struct {
+	chunks <-chan io.ReadCloser
+	format uint32
+	ready  chan<- struct{}
+	reader *StreamReader
+}
+
+var _ protoio.Reader = (*readyStreamReader)(nil)
+
+// ReadMsg implements protoio.Reader.
+func (r *readyStreamReader) ReadMsg(msg proto.Message) error {
+	if r.reader == nil {
+		if r.ready != nil {
+			close(r.ready)
+			r.ready = nil
+		}
+		reader, err := NewStreamReader(r.chunks, r.format)
+		if err != nil {
+			return err
+		}
+		r.reader = reader
+	}
+	return r.reader.ReadMsg(msg)
+}
+
+// Close implements io.Closer. The chunks are left alone if they have not been read.
+func (r *readyStreamReader) Close() error {
+	if r.reader == nil {
+		return nil
+	}
+	return r.reader.Close()
+}
@@ -53,14 +53,15 @@ func TestManager_LoadChunk(t *testing.T) {
 
 func TestManager_Take(t *testing.T) {
 	store := setupStore(t)
-	snapshotter := &mockSnapshotter{
-		chunks: [][]byte{
-			{1, 2, 3},
-			{4, 5, 6},
-			{7, 8, 9},
-		},
+	items := [][]byte{
+		{1, 2, 3},
+		{4, 5, 6},
+		{7, 8, 9},
 	}
+	snapshotter := &mockSnapshotter{items: items}
+	extension := &extSnapshotter{payloads: [][]byte{{10}, {11, 12}}}
 	manager := snapshots.NewManager(store, snapshotter)
+	require.NoError(t, manager.RegisterExtensions(extension))
 
 	// nil manager should return error
 	_, err := (*snapshots.Manager)(nil).Create(1)
@@ -71,23 +72,23 @@ func TestManager_Take(t *testing.T) {
 	require.Error(t, err)
 
 	// creating a snapshot at a higher height should be fine, and should return it
+	expectChunks := snapshotItems(items, extension)
 	snapshot, err := manager.Create(5)
 	require.NoError(t, err)
 	assert.Equal(t, &types.Snapshot{
 		Height: 5,
 		Format: types.CurrentFormat,
-		Chunks: 3,
-		Hash:   []uint8{0x47, 0xe4, 0xee, 0x7f, 0x21, 0x1f, 0x73, 0x26, 0x5d, 0xd1, 0x76, 0x58, 0xf6, 0xe2, 0x1c, 0x13, 0x18, 0xbd, 0x6c, 0x81, 0xf3, 0x75, 0x98, 0xe2, 0xa, 0x27, 0x56, 0x29, 0x95, 0x42, 0xef, 0xcf},
+		Chunks: uint32(len(expectChunks)),
+		Hash:   hash(expectChunks),
 		Metadata: types.Metadata{
-			ChunkHashes: checksums([][]byte{
-				{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}),
+			ChunkHashes: checksums(expectChunks),
 		},
 	}, snapshot)
 
 	storeSnapshot, chunks, err := store.Load(snapshot.Height, snapshot.Format)
 	require.NoError(t, err)
 	assert.Equal(t, snapshot, storeSnapshot)
-	assert.Equal(t, [][]byte{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}, readChunks(chunks))
+	assert.Equal(t, expectChunks, readChunks(chunks))
 
 	// creating a snapshot while a different snapshot is being created should error
 	manager = setupBusyManager(t)
@@ -95,6 +96,27 @@ func TestManager_Take(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestManager_Take_ExtensionError(t *testing.T) {
+	store := setupStore(t)
+	manager := snapshots.NewManager(store, &mockSnapshotter{items: [][]byte{{1, 2, 3}}})
+	require.NoError(t, manager.RegisterExtensions(&failingExtSnapshotter{}))
+
+	// the snapshot fails, and is not saved
+	_, err := manager.Create(5)
+	require.Error(t, err)
+	snapshot, err := store.Get(5, types.CurrentFormat)
+	require.NoError(t, err)
+	assert.Nil(t, snapshot)
+}
+
+func TestManager_RegisterExtensions(t *testing.T) {
+	manager := snapshots.NewManager(setupStore(t), &mockSnapshotter{})
+	require.NoError(t, manager.RegisterExtensions(&extSnapshotter{}))
+
+	// extension names must be unique
+	require.Error(t, manager.RegisterExtensions(&extSnapshotter{}))
+}
+
 func TestManager_Prune(t *testing.T) {
 	store := setupStore(t)
 	manager := snapshots.NewManager(store, nil)
@@ -116,13 +138,17 @@ func TestManager_Prune(t *testing.T) {
 func TestManager_Restore(t *testing.T) {
 	store := setupStore(t)
 	target := &mockSnapshotter{}
+	extension := &extSnapshotter{}
 	manager := snapshots.NewManager(store, target)
+	require.NoError(t, manager.RegisterExtensions(extension))
 
-	chunks := [][]byte{
+	expectItems := [][]byte{
 		{1, 2, 3},
 		{4, 5, 6},
 		{7, 8, 9},
 	}
+	expectPayloads := [][]byte{{10}, {11, 12}}
+	chunks := snapshotItems(expectItems, &extSnapshotter{payloads: expectPayloads})
 
 	// Restore errors on invalid format
 	err := manager.Restore(types.Snapshot{
@@ -133,18 +159,18 @@ func TestManager_Restore(t *testing.T) {
 		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
 	})
 	require.Error(t, err)
-	require.Equal(t, types.ErrUnknownFormat, err)
+	require.True(t, errors.Is(err, types.ErrUnknownFormat))
 
 	// Restore errors on no chunks
-	err = manager.Restore(types.Snapshot{Height: 3, Format: 1, Hash: []byte{1, 2, 3}})
+	err = manager.Restore(types.Snapshot{Height: 3, Format: types.CurrentFormat, Hash: []byte{1, 2, 3}})
 	require.Error(t, err)
 
 	// Restore errors on chunk and chunkhashes mismatch
 	err = manager.Restore(types.Snapshot{
 		Height:   3,
-		Format:   1,
+		Format:   types.CurrentFormat,
 		Hash:     []byte{1, 2, 3},
-		Chunks:   4,
+		Chunks:   uint32(len(chunks)) + 1,
 		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
 	})
 	require.Error(t, err)
@@ -152,9 +178,9 @@ func TestManager_Restore(t *testing.T) {
 	// Starting a restore works
 	err = manager.Restore(types.Snapshot{
 		Height:   3,
-		Format:   1,
+		Format:   types.CurrentFormat,
 		Hash:     []byte{1, 2, 3},
-		Chunks:   3,
+		Chunks:   uint32(len(chunks)),
 		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
 	})
 	require.NoError(t, err)
@@ -182,14 +208,15 @@ func TestManager_Restore(t *testing.T) {
 		}
 	}
 
-	assert.Equal(t, chunks, target.chunks)
+	assert.Equal(t, expectItems, target.items)
+	assert.Equal(t, expectPayloads, extension.payloads)
 
 	// Starting a new restore should fail now, because the target already has contents.
 	err = manager.Restore(types.Snapshot{
 		Height:   3,
-		Format:   1,
+		Format:   types.CurrentFormat,
 		Hash:     []byte{1, 2, 3},
-		Chunks:   3,
+		Chunks:   uint32(len(chunks)),
 		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
 	})
 	require.Error(t, err)
@@ -197,13 +224,61 @@ func TestManager_Restore(t *testing.T) {
 	// But if we clear out the target we should be able to start a new restore. This time we'll
 	// fail it with a checksum error. That error should stop the operation, so that we can do
 	// a prune operation right after.
-	target.chunks = nil
+	target.items = nil
 	err = manager.Restore(types.Snapshot{
 		Height:   3,
-		Format:   1,
+		Format:   types.CurrentFormat,
+		Hash:     []byte{1, 2, 3},
+		Chunks:   uint32(len(chunks)),
+		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
+	})
+	require.NoError(t, err)
+}
+
+func TestManager_Restore_UnknownExtension(t *testing.T) {
+	store := setupStore(t)
+	manager := snapshots.NewManager(store, &mockSnapshotter{})
+
+	chunks := snapshotItems([][]byte{{1, 2, 3}}, &extSnapshotter{payloads: [][]byte{{10}}})
+	err := manager.Restore(types.Snapshot{
+		Height:   3,
+		Format:   types.CurrentFormat,
 		Hash:     []byte{1, 2, 3},
-		Chunks:   3,
+		Chunks:   uint32(len(chunks)),
 		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
 	})
 	require.NoError(t, err)
+
+	for i, chunk := range chunks {
+		_, err = manager.RestoreChunk(chunk)
+		if i < len(chunks)-1 {
+			require.NoError(t, err)
+		}
+	}
+	require.Error(t, err)
+}
+
+func TestManager_RestoreLocalSnapshot(t *testing.T) {
+	store := setupStore(t)
+	items := [][]byte{{1, 2, 3}, {4, 5, 6}}
+	payloads := [][]byte{{10}}
+	_, err := store.Save(4, types.CurrentFormat, makeChunks(snapshotItems(items, &extSnapshotter{payloads: payloads})))
+	require.NoError(t, err)
+
+	target := &mockSnapshotter{}
+	extension := &extSnapshotter{}
+	manager := snapshots.NewManager(store, target)
+	require.NoError(t, manager.RegisterExtensions(extension))
+
+	err = manager.RestoreLocalSnapshot(9, 1)
+	require.True(t, errors.Is(err, types.ErrSnapshotNotFound), err)
+
+	err = manager.RestoreLocalSnapshot(4, types.CurrentFormat)
+	require.NoError(t, err)
+	assert.Equal(t, items, target.items)
+	assert.Equal(t, payloads, extension.payloads)
+
+	// the manager is free again
+	_, err = manager.Create(5)
+	require.NoError(t, err)
 }
@@ -0,0 +1,142 @@
+package snapshots
+
+import (
+	"bufio"
+	"compress/zlib"
+	"io"
+
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/line/lbm-sdk/snapshots/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+const (
+	// Do not change chunk size without new snapshot format (must be uniform across nodes)
+	snapshotChunkSize   = uint64(10e6)
+	snapshotBufferSize  = int(snapshotChunkSize)
+	snapshotMaxItemSize = int(64e6) // SDK has no key/value size limit, so we set an arbitrary limit
+)
+
+// StreamWriter serializes the SnapshotItems of a snapshot into its chunks.
+type StreamWriter struct {
+	chunkWriter *ChunkWriter
+	bufWriter   *bufio.Writer
+	zWriter     *zlib.Writer
+	protoWriter protoio.WriteCloser
+}
+
+var _ protoio.Writer = (*StreamWriter)(nil)
+
+// NewStreamWriter sets up the stream pipeline writing the SnapshotItems of a snapshot of the
+// format to the chunks of the channel:
+// FormatV1: SnapshotItem -> delimited Protobuf -> zlib -> buffer -> chunkWriter -> chan io.ReadCloser
+// FormatV2: SnapshotItem -> delimited Protobuf -> buffer -> chunkWriter -> compression -> chan io.ReadCloser
+// The channel is closed along with the writer.
+func NewStreamWriter(ch chan<- io.ReadCloser, format uint32) (*StreamWriter, error) {
+	if !types.IsSupportedFormat(format) {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownFormat, "format %v", format)
+	}
+	if format == types.FormatV2 {
+		chunks := make(chan io.ReadCloser)
+		go mapChunksTo(chunks, ch, CompressChunk)
+		ch = chunks
+	}
+	chunkWriter := NewChunkWriter(ch, snapshotChunkSize)
+	writer := &StreamWriter{
+		chunkWriter: chunkWriter,
+		bufWriter:   bufio.NewWriterSize(chunkWriter, snapshotBufferSize),
+	}
+
+	var itemWriter io.Writer = writer.bufWriter
+	if format == types.FormatV1 {
+		zWriter, err := zlib.NewWriterLevel(writer.bufWriter, 7)
+		if err != nil {
+			chunkWriter.Close()
+			return nil, sdkerrors.Wrap(err, "zlib failure")
+		}
+		writer.zWriter = zWriter
+		itemWriter = zWriter
+	}
+	writer.protoWriter = protoio.NewDelimitedWriter(itemWriter)
+	return writer, nil
+}
+
+// WriteMsg implements protoio.Writer.
+func (w *StreamWriter) WriteMsg(msg proto.Message) error {
+	return w.protoWriter.WriteMsg(msg)
+}
+
+// Close flushes the pipeline and closes the chunks.
+func (w *StreamWriter) Close() error {
+	if err := w.protoWriter.Close(); err != nil {
+		w.chunkWriter.CloseWithError(err)
+		return err
+	}
+	if w.zWriter != nil {
+		if err := w.zWriter.Close(); err != nil {
+			w.chunkWriter.CloseWithError(err)
+			return err
+		}
+	}
+	if err := w.bufWriter.Flush(); err != nil {
+		w.chunkWriter.CloseWithError(err)
+		return err
+	}
+	return w.chunkWriter.Close()
+}
+
+// CloseWithError closes the chunks, passing the error to their reader.
+func (w *StreamWriter) CloseWithError(err error) {
+	w.chunkWriter.CloseWithError(err)
+}
+
+// StreamReader deserializes the SnapshotItems of a snapshot from its chunks.
+type StreamReader struct {
+	protoio.ReadCloser
+	closers []io.Closer
+}
+
+var _ protoio.Reader = (*StreamReader)(nil)
+
+// NewStreamReader sets up the stream pipeline reading the SnapshotItems of a snapshot of the
+// format from its chunks:
+// FormatV1: chan io.ReadCloser -> chunkReader -> zlib -> delimited Protobuf -> SnapshotItem
+// FormatV2: chan io.ReadCloser -> decompression -> chunkReader -> delimited Protobuf -> SnapshotItem
+// The chunks are consumed and closed along with the reader.
+func NewStreamReader(chunks <-chan io.ReadCloser, format uint32) (*StreamReader, error) {
+	if !types.IsSupportedFormat(format) {
+		DrainChunks(chunks)
+		return nil, sdkerrors.Wrapf(types.ErrUnknownFormat, "format %v", format)
+	}
+	if format == types.FormatV2 {
+		chunks = DecompressChunks(chunks)
+	}
+	chunkReader := NewChunkReader(chunks)
+	reader := &StreamReader{closers: []io.Closer{chunkReader}}
+
+	var itemReader io.Reader = chunkReader
+	if format == types.FormatV1 {
+		zReader, err := zlib.NewReader(chunkReader)
+		if err != nil {
+			chunkReader.Close()
+			return nil, sdkerrors.Wrap(err, "zlib failure")
+		}
+		reader.closers = append(reader.closers, zReader)
+		itemReader = zReader
+	}
+	reader.ReadCloser = protoio.NewDelimitedReader(itemReader, snapshotMaxItemSize)
+	return reader, nil
+}
+
+// Close closes the pipeline.
+func (r *StreamReader) Close() error {
+	err := r.ReadCloser.Close()
+	for i := len(r.closers) - 1; i >= 0; i-- {
+		if e := r.closers[i].Close(); e != nil && err == nil {
+			err = e
+		}
+	}
+	return err
+}
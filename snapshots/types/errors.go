@@ -11,6 +11,12 @@ var (
 	// ErrChunkHashMismatch is returned when chunk hash verification failed.
 	ErrChunkHashMismatch = errors.New("chunk hash verification failed")
 
+	// ErrChunkChecksumMismatch is returned when the checksum of a chunk does not match its content.
+	ErrChunkChecksumMismatch = errors.New("chunk checksum verification failed")
+
+	// ErrSnapshotNotFound is returned when a snapshot does not exist.
+	ErrSnapshotNotFound = errors.New("snapshot not found")
+
 	// ErrInvalidMetadata is returned when the snapshot metadata is invalid.
 	ErrInvalidMetadata = errors.New("invalid snapshot metadata")
 )
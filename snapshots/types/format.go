@@ -1,6 +1,21 @@
 package types
 
+const (
+	// FormatV1 is a zlib compressed stream of SnapshotItems split into fixed-size chunks.
+	FormatV1 uint32 = 1
+
+	// FormatV2 splits the stream of SnapshotItems into fixed-size chunks and compresses each
+	// chunk on its own with zstd, followed by the CRC-32C checksum of its uncompressed content.
+	// Every chunk can thus be checked and decompressed without the others.
+	FormatV2 uint32 = 2
+)
+
 // CurrentFormat is the currently used format for snapshots. Snapshots using the same format
 // must be identical across all nodes for a given height, so this must be bumped when the binary
 // snapshot output changes.
-const CurrentFormat uint32 = 1
+const CurrentFormat = FormatV2
+
+// IsSupportedFormat returns whether snapshots of the format can be created and restored.
+func IsSupportedFormat(format uint32) bool {
+	return format == FormatV1 || format == FormatV2
+}
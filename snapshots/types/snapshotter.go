@@ -1,16 +1,67 @@
 package types
 
-import "io"
+import (
+	protoio "github.com/gogo/protobuf/io"
+)
 
-// Snapshotter is something that can create and restore snapshots, consisting of streamed binary
-// chunks - all of which must be read from the channel and closed. If an unsupported format is
-// given, it must return ErrUnknownFormat (possibly wrapped with fmt.Errorf).
+// Snapshotter is something that can create and restore snapshots, consisting of a stream of
+// SnapshotItems. If an unsupported format is given, it must return ErrUnknownFormat (possibly
+// wrapped with fmt.Errorf).
 type Snapshotter interface {
-	// Snapshot creates a state snapshot, returning a channel of snapshot chunk readers.
-	Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, error)
+	// Snapshot writes the snapshot items of the state at the height into the protobuf writer.
+	Snapshot(height uint64, protoWriter protoio.Writer) error
 
-	// Restore restores a state snapshot, taking snapshot chunk readers as input.
-	// If the ready channel is non-nil, it returns a ready signal (by being closed) once the
-	// restorer is ready to accept chunks.
-	Restore(height uint64, format uint32, chunks <-chan io.ReadCloser, ready chan<- struct{}) error
+	// Restore restores the state from the snapshot items read from the protobuf reader. It
+	// returns the first item it does not own, to be passed to the extensions, or an empty item
+	// at the end of the stream.
+	Restore(height uint64, format uint32, protoReader protoio.Reader) (SnapshotItem, error)
+}
+
+// ExtensionPayloadReader reads the next payload of an extension from the snapshot stream. It
+// returns io.EOF once all the payloads of the extension have been read.
+type ExtensionPayloadReader = func() ([]byte, error)
+
+// ExtensionPayloadWriter writes a payload of an extension into the snapshot stream.
+type ExtensionPayloadWriter = func([]byte) error
+
+// ExtensionSnapshotter is a snapshot extension, adding a named section of payloads to the
+// snapshots after the multistore items. It lets modules snapshot the state they keep out of the
+// multistore.
+type ExtensionSnapshotter interface {
+	// SnapshotName returns the name of the extension, which must be unique in the manager.
+	SnapshotName() string
+
+	// SnapshotFormat returns the format the extension writes its payloads in.
+	SnapshotFormat() uint32
+
+	// SupportedFormats returns the formats of the payloads the extension can restore.
+	SupportedFormats() []uint32
+
+	// SnapshotExtension writes the payloads of the state at the height with the payload writer.
+	SnapshotExtension(height uint64, payloadWriter ExtensionPayloadWriter) error
+
+	// RestoreExtension restores the state at the height from the payloads of the format, read
+	// with the payload reader until io.EOF.
+	RestoreExtension(height uint64, format uint32, payloadReader ExtensionPayloadReader) error
+}
+
+// IsFormatSupported returns whether the extension can restore payloads of the format.
+func IsFormatSupported(extension ExtensionSnapshotter, format uint32) bool {
+	for _, supported := range extension.SupportedFormats() {
+		if supported == format {
+			return true
+		}
+	}
+	return false
+}
+
+// WriteExtensionItem writes an item containing an extension payload into the protobuf writer.
+func WriteExtensionItem(protoWriter protoio.Writer, payload []byte) error {
+	return protoWriter.WriteMsg(&SnapshotItem{
+		Item: &SnapshotItem_ExtensionPayload{
+			ExtensionPayload: &SnapshotExtensionPayload{
+				Payload: payload,
+			},
+		},
+	})
 }
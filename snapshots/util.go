@@ -53,14 +53,16 @@ func (w *ChunkWriter) Close() error {
 	return nil
 }
 
-// CloseWithError closes the writer and sends an error to the reader.
+// CloseWithError closes the writer and sends an error to the reader. If no chunk has been
+// written yet, the error is sent through a chunk of its own.
 func (w *ChunkWriter) CloseWithError(err error) {
 	if !w.closed {
+		if w.pipe == nil {
+			_ = w.chunk()
+		}
 		w.closed = true
 		close(w.ch)
-		if w.pipe != nil {
-			w.pipe.CloseWithError(err)
-		}
+		w.pipe.CloseWithError(err)
 	}
 }
 
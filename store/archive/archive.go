@@ -0,0 +1,351 @@
+package archive
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sync"
+
+	tmdb "github.com/line/tm-db/v2"
+
+	"github.com/line/lbm-sdk/store/types"
+	"github.com/line/lbm-sdk/types/kv"
+)
+
+// The layout of the archive database:
+//
+//	d | uvarint(len(storeName)) | storeName | escape(key) | ^version -> valueFlag | value
+//	c | version -> kv.Pairs of the data keys written at the version
+//	m | earliest, m | latest -> version
+//
+// The keys are escaped so that their encoding keeps their order, and the
+// versions are inverted so that the latest version of a key comes first.
+var (
+	dataPrefix      = []byte{'d'}
+	changelogPrefix = []byte{'c'}
+	earliestKey     = []byte("mearliest")
+	latestKey       = []byte("mlatest")
+)
+
+const (
+	deletedFlag byte = 0
+	setFlag     byte = 1
+
+	// number of writes per batch when the whole archive is rewritten
+	batchSize = 10000
+)
+
+// Store keeps every version of the entries of the stores of a multistore in a
+// flat layout, so that any archived version can be read without the IAVL tree
+// of the version. It is safe for concurrent use.
+type Store struct {
+	db tmdb.DB
+
+	mtx      sync.RWMutex
+	earliest int64
+	latest   int64
+}
+
+// NewStore returns the archive kept in the db.
+func NewStore(db tmdb.DB) (*Store, error) {
+	s := &Store{db: db}
+
+	var err error
+	if s.earliest, err = s.getVersion(earliestKey); err != nil {
+		return nil, err
+	}
+	if s.latest, err = s.getVersion(latestKey); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Versions returns the earliest and the latest archived versions, zero if the
+// archive is empty.
+func (s *Store) Versions() (earliest, latest int64) {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	return s.earliest, s.latest
+}
+
+// HasVersion returns whether the version is archived.
+func (s *Store) HasVersion(version int64) bool {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	return s.earliest > 0 && s.earliest <= version && version <= s.latest
+}
+
+// Commit archives the changes as the version, which must follow the latest
+// archived one unless the archive is empty.
+func (s *Store) Commit(version int64, changes *ChangeSet) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.latest > 0 && version != s.latest+1 {
+		return fmt.Errorf("cannot archive version %d after version %d", version, s.latest)
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	changelog := kv.Pairs{}
+	for _, change := range changes.sorted() {
+		key := dataKey(change.storeName, change.key, version)
+		if err := batch.Set(key, dataValue(change.value, change.deleted)); err != nil {
+			return err
+		}
+		changelog.Pairs = append(changelog.Pairs, kv.Pair{Key: key})
+	}
+
+	bz, err := changelog.Marshal()
+	if err != nil {
+		return err
+	}
+	if err := batch.Set(changelogKey(version), bz); err != nil {
+		return err
+	}
+
+	earliest := s.earliest
+	if earliest == 0 {
+		earliest = version
+	}
+	if err := setVersions(batch, earliest, version); err != nil {
+		return err
+	}
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+
+	s.earliest, s.latest = earliest, version
+	return nil
+}
+
+// Load makes the archive consistent with the stores loaded at the version. The
+// versions archived after it are reverted. If the versions up to it are not
+// archived, the archive is rewritten from the stores, by store name, and only
+// serves the versions from it on.
+func (s *Store) Load(version int64, stores map[string]types.KVStore) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	for s.latest > version && s.latest > s.earliest {
+		reverted, err := s.revert(s.latest)
+		if err != nil {
+			return err
+		}
+		if !reverted {
+			break
+		}
+	}
+
+	if s.latest == version || (s.latest == 0 && version == 0) {
+		return nil
+	}
+
+	return s.rebase(version, stores)
+}
+
+// revert removes the changes of the latest version. It returns false if they
+// are unknown.
+func (s *Store) revert(version int64) (bool, error) {
+	bz, err := s.db.Get(changelogKey(version))
+	if err != nil || bz == nil {
+		return false, err
+	}
+
+	changelog := kv.Pairs{}
+	if err := changelog.Unmarshal(bz); err != nil {
+		return false, err
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	for _, pair := range changelog.Pairs {
+		if err := batch.Delete(pair.Key); err != nil {
+			return false, err
+		}
+	}
+	if err := batch.Delete(changelogKey(version)); err != nil {
+		return false, err
+	}
+	if err := setVersions(batch, s.earliest, version-1); err != nil {
+		return false, err
+	}
+	if err := batch.WriteSync(); err != nil {
+		return false, err
+	}
+
+	s.latest = version - 1
+	return true, nil
+}
+
+// rebase drops the whole archive and archives the current entries of the
+// stores as the version.
+func (s *Store) rebase(version int64, stores map[string]types.KVStore) error {
+	if err := s.deleteAll(); err != nil {
+		return err
+	}
+	s.earliest, s.latest = 0, 0
+	if version == 0 {
+		return nil
+	}
+
+	batch := s.db.NewBatch()
+	defer func() { batch.Close() }()
+
+	writes := 0
+	for name, store := range stores {
+		iter := store.Iterator(nil, nil)
+		for ; iter.Valid(); iter.Next() {
+			if err := batch.Set(dataKey(name, iter.Key(), version), dataValue(iter.Value(), false)); err != nil {
+				iter.Close()
+				return err
+			}
+
+			if writes++; writes%batchSize == 0 {
+				if err := batch.Write(); err != nil {
+					iter.Close()
+					return err
+				}
+				batch.Close()
+				batch = s.db.NewBatch()
+			}
+		}
+		if err := iter.Close(); err != nil {
+			return err
+		}
+	}
+
+	if err := setVersions(batch, version, version); err != nil {
+		return err
+	}
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+
+	s.earliest, s.latest = version, version
+	return nil
+}
+
+func (s *Store) deleteAll() error {
+	for {
+		iter, err := s.db.Iterator(nil, nil)
+		if err != nil {
+			return err
+		}
+
+		var keys [][]byte
+		for ; iter.Valid() && len(keys) < batchSize; iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+		if err := iter.Close(); err != nil {
+			return err
+		}
+		if len(keys) == 0 {
+			return nil
+		}
+
+		batch := s.db.NewBatch()
+		for _, key := range keys {
+			if err := batch.Delete(key); err != nil {
+				batch.Close()
+				return err
+			}
+		}
+		err = batch.Write()
+		batch.Close()
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Store) getVersion(key []byte) (int64, error) {
+	bz, err := s.db.Get(key)
+	if err != nil || bz == nil {
+		return 0, err
+	}
+	return int64(binary.BigEndian.Uint64(bz)), nil
+}
+
+func setVersions(batch tmdb.Batch, earliest, latest int64) error {
+	if err := batch.Set(earliestKey, versionBytes(earliest)); err != nil {
+		return err
+	}
+	return batch.Set(latestKey, versionBytes(latest))
+}
+
+func versionBytes(version int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(version))
+	return bz
+}
+
+func changelogKey(version int64) []byte {
+	return append(append([]byte{}, changelogPrefix...), versionBytes(version)...)
+}
+
+// storePrefix returns the prefix of the data keys of the store.
+func storePrefix(storeName string) []byte {
+	prefix := make([]byte, 0, len(dataPrefix)+binary.MaxVarintLen64+len(storeName))
+	prefix = append(prefix, dataPrefix...)
+	prefix = append(prefix, uvarintBytes(uint64(len(storeName)))...)
+	return append(prefix, storeName...)
+}
+
+// keyPrefix returns the prefix of the data keys of all the versions of the key.
+func keyPrefix(storeName string, key []byte) []byte {
+	return append(append(storePrefix(storeName), escape(key)...), 0, 0)
+}
+
+func dataKey(storeName string, key []byte, version int64) []byte {
+	return append(keyPrefix(storeName, key), versionBytes(math.MaxInt64-version)...)
+}
+
+func dataValue(value []byte, deleted bool) []byte {
+	if deleted {
+		return []byte{deletedFlag}
+	}
+	return append([]byte{setFlag}, value...)
+}
+
+// escape encodes the key keeping its order among the encodings of the other
+// keys when followed by the terminator 0x00 0x00: 0x00 becomes 0x00 0xff.
+func escape(key []byte) []byte {
+	escaped := make([]byte, 0, len(key)+bytes.Count(key, []byte{0}))
+	for _, b := range key {
+		escaped = append(escaped, b)
+		if b == 0 {
+			escaped = append(escaped, 0xff)
+		}
+	}
+	return escaped
+}
+
+// unescape decodes the key from the data key without its store prefix.
+func unescape(escaped []byte) []byte {
+	key := make([]byte, 0, len(escaped))
+	for i := 0; i < len(escaped); i++ {
+		if escaped[i] == 0 {
+			if escaped[i+1] == 0 {
+				break
+			}
+			// skip the escaping 0xff
+			i++
+			key = append(key, 0)
+			continue
+		}
+		key = append(key, escaped[i])
+	}
+	return key
+}
+
+func uvarintBytes(n uint64) []byte {
+	bz := make([]byte, binary.MaxVarintLen64)
+	return bz[:binary.PutUvarint(bz, n)]
+}
@@ -0,0 +1,162 @@
+package archive_test
+
+import (
+	"testing"
+
+	abci "github.com/line/ostracon/abci/types"
+	"github.com/line/tm-db/v2/memdb"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/store/archive"
+	"github.com/line/lbm-sdk/store/dbadapter"
+	"github.com/line/lbm-sdk/store/types"
+	"github.com/line/lbm-sdk/types/kv"
+)
+
+func collect(iter types.Iterator) (pairs [][2]string) {
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		pairs = append(pairs, [2]string{string(iter.Key()), string(iter.Value())})
+	}
+	return pairs
+}
+
+func TestStoreVersions(t *testing.T) {
+	db := memdb.NewDB()
+	store, err := archive.NewStore(db)
+	require.NoError(t, err)
+
+	// version 1: a, b, c\x00 and c\x00\x00 are set in store1
+	changes := archive.NewChangeSet()
+	changes.Set("store1", []byte("a"), []byte("1"))
+	changes.Set("store1", []byte("b"), []byte("1"))
+	changes.Set("store1", []byte("c\x00"), []byte("1"))
+	changes.Set("store1", []byte("c\x00\x00"), []byte("1"))
+	changes.Set("store2", []byte("a"), []byte("other"))
+	require.NoError(t, store.Commit(1, changes))
+
+	// version 2: a is updated, b deleted and c set
+	changes = archive.NewChangeSet()
+	changes.Set("store1", []byte("a"), []byte("2"))
+	changes.Delete("store1", []byte("b"))
+	changes.Set("store1", []byte("c"), []byte("2"))
+	require.Equal(t, 3, changes.Len())
+	require.NoError(t, store.Commit(2, changes))
+
+	// versions can not be skipped
+	require.Error(t, store.Commit(4, archive.NewChangeSet()))
+	require.NoError(t, store.Commit(3, archive.NewChangeSet()))
+
+	earliest, latest := store.Versions()
+	require.Equal(t, int64(1), earliest)
+	require.Equal(t, int64(3), latest)
+
+	view1, err := store.View("store1", 1)
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), view1.Get([]byte("a")))
+	require.True(t, view1.Has([]byte("b")))
+	require.Nil(t, view1.Get([]byte("c")))
+	require.Equal(t, [][2]string{{"a", "1"}, {"b", "1"}, {"c\x00", "1"}, {"c\x00\x00", "1"}}, collect(view1.Iterator(nil, nil)))
+
+	view3, err := store.View("store1", 3)
+	require.NoError(t, err)
+	require.Equal(t, []byte("2"), view3.Get([]byte("a")))
+	require.False(t, view3.Has([]byte("b")))
+	require.Equal(t, [][2]string{{"a", "2"}, {"c", "2"}, {"c\x00", "1"}, {"c\x00\x00", "1"}}, collect(view3.Iterator(nil, nil)))
+	require.Equal(t, [][2]string{{"c\x00\x00", "1"}, {"c\x00", "1"}, {"c", "2"}, {"a", "2"}}, collect(view3.ReverseIterator(nil, nil)))
+	require.Equal(t, [][2]string{{"c", "2"}, {"c\x00", "1"}}, collect(view3.Iterator([]byte("b"), []byte("c\x00\x00"))))
+	require.Equal(t, [][2]string{{"c\x00", "1"}, {"c", "2"}}, collect(view3.ReverseIterator([]byte("c"), []byte("c\x00\x00"))))
+	require.Panics(t, func() { view3.Set([]byte("a"), []byte("3")) })
+
+	_, err = store.View("store1", 4)
+	require.Error(t, err)
+
+	// the store is reopened from the db
+	store, err = archive.NewStore(db)
+	require.NoError(t, err)
+	require.True(t, store.HasVersion(2))
+	require.False(t, store.HasVersion(0))
+}
+
+func TestStoreLoad(t *testing.T) {
+	db := memdb.NewDB()
+	store, err := archive.NewStore(db)
+	require.NoError(t, err)
+
+	for version := int64(1); version <= 3; version++ {
+		changes := archive.NewChangeSet()
+		changes.Set("store1", []byte("a"), []byte{byte(version)})
+		require.NoError(t, store.Commit(version, changes))
+	}
+
+	// the versions after the loaded one are reverted
+	require.NoError(t, store.Load(2, nil))
+	earliest, latest := store.Versions()
+	require.Equal(t, int64(1), earliest)
+	require.Equal(t, int64(2), latest)
+	view, err := store.View("store1", 2)
+	require.NoError(t, err)
+	require.Equal(t, []byte{2}, view.Get([]byte("a")))
+
+	// the archive is rebuilt from the stores if it is behind them
+	state := dbadapter.Store{DB: memdb.NewDB()}
+	state.Set([]byte("b"), []byte("rebased"))
+	require.NoError(t, store.Load(5, map[string]types.KVStore{"store1": state}))
+	earliest, latest = store.Versions()
+	require.Equal(t, int64(5), earliest)
+	require.Equal(t, int64(5), latest)
+	view, err = store.View("store1", 5)
+	require.NoError(t, err)
+	require.Equal(t, [][2]string{{"b", "rebased"}}, collect(view.Iterator(nil, nil)))
+}
+
+func TestRecordingStore(t *testing.T) {
+	changes := archive.NewChangeSet()
+	parent := dbadapter.Store{DB: memdb.NewDB()}
+	store := archive.NewRecordingStore(parent, "store1", changes)
+
+	// writes through a branch are recorded when written
+	cache := store.CacheWrap().(types.CacheKVStore)
+	cache.Set([]byte("a"), []byte("1"))
+	cache.Set([]byte("b"), []byte("1"))
+	require.Equal(t, 0, changes.Len())
+	cache.Write()
+	require.Equal(t, 2, changes.Len())
+	require.Equal(t, []byte("1"), parent.Get([]byte("a")))
+
+	store.Delete([]byte("a"))
+	require.Equal(t, 2, changes.Len())
+	require.Nil(t, parent.Get([]byte("a")))
+
+	changes.Reset()
+	require.Equal(t, 0, changes.Len())
+}
+
+func TestViewQuery(t *testing.T) {
+	store, err := archive.NewStore(memdb.NewDB())
+	require.NoError(t, err)
+
+	changes := archive.NewChangeSet()
+	changes.Set("store1", []byte("ka"), []byte("1"))
+	changes.Set("store1", []byte("kb"), []byte("2"))
+	changes.Set("store1", []byte("z"), []byte("3"))
+	require.NoError(t, store.Commit(1, changes))
+
+	view, err := store.View("store1", 1)
+	require.NoError(t, err)
+
+	res := view.Query(abci.RequestQuery{Path: "/key", Data: []byte("ka")})
+	require.Equal(t, uint32(0), res.Code)
+	require.Equal(t, []byte("1"), res.Value)
+	require.Equal(t, int64(1), res.Height)
+
+	res = view.Query(abci.RequestQuery{Path: "/subspace", Data: []byte("k")})
+	require.Equal(t, uint32(0), res.Code)
+	pairs := kv.Pairs{}
+	require.NoError(t, pairs.Unmarshal(res.Value))
+	require.Len(t, pairs.Pairs, 2)
+
+	// archived versions can not be proven
+	res = view.Query(abci.RequestQuery{Path: "/key", Data: []byte("ka"), Prove: true})
+	require.NotEqual(t, uint32(0), res.Code)
+}
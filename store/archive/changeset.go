@@ -0,0 +1,140 @@
+package archive
+
+import (
+	"bytes"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/line/lbm-sdk/store/cachekv"
+	"github.com/line/lbm-sdk/store/tracekv"
+	"github.com/line/lbm-sdk/store/types"
+)
+
+// ChangeSet collects the writes to the stores of a multistore between two
+// commits. It is safe for concurrent use.
+type ChangeSet struct {
+	mtx     sync.Mutex
+	changes map[string]map[string]change
+}
+
+type change struct {
+	storeName string
+	key       []byte
+	value     []byte
+	deleted   bool
+}
+
+// NewChangeSet returns an empty ChangeSet.
+func NewChangeSet() *ChangeSet {
+	return &ChangeSet{changes: make(map[string]map[string]change)}
+}
+
+// Set records that the key of the store is set to the value.
+func (cs *ChangeSet) Set(storeName string, key, value []byte) {
+	cs.add(change{storeName: storeName, key: key, value: value})
+}
+
+// Delete records that the key of the store is deleted.
+func (cs *ChangeSet) Delete(storeName string, key []byte) {
+	cs.add(change{storeName: storeName, key: key, deleted: true})
+}
+
+func (cs *ChangeSet) add(c change) {
+	// the caller may reuse the slices after the write
+	c.key = append([]byte{}, c.key...)
+	if !c.deleted {
+		c.value = append([]byte{}, c.value...)
+	}
+
+	cs.mtx.Lock()
+	defer cs.mtx.Unlock()
+
+	store, ok := cs.changes[c.storeName]
+	if !ok {
+		store = make(map[string]change)
+		cs.changes[c.storeName] = store
+	}
+	store[string(c.key)] = c
+}
+
+// Len returns the number of keys changed.
+func (cs *ChangeSet) Len() int {
+	cs.mtx.Lock()
+	defer cs.mtx.Unlock()
+
+	n := 0
+	for _, store := range cs.changes {
+		n += len(store)
+	}
+	return n
+}
+
+// Reset drops all the changes.
+func (cs *ChangeSet) Reset() {
+	cs.mtx.Lock()
+	defer cs.mtx.Unlock()
+
+	cs.changes = make(map[string]map[string]change)
+}
+
+// sorted returns the latest change of every key, by store name and key.
+func (cs *ChangeSet) sorted() []change {
+	cs.mtx.Lock()
+	defer cs.mtx.Unlock()
+
+	var changes []change
+	for _, store := range cs.changes {
+		for _, c := range store {
+			changes = append(changes, c)
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].storeName != changes[j].storeName {
+			return changes[i].storeName < changes[j].storeName
+		}
+		return bytes.Compare(changes[i].key, changes[j].key) < 0
+	})
+	return changes
+}
+
+// RecordingStore records the writes to the wrapped KVStore in a ChangeSet.
+type RecordingStore struct {
+	types.KVStore
+	storeName string
+	changes   *ChangeSet
+}
+
+var _ types.KVStore = (*RecordingStore)(nil)
+
+// NewRecordingStore returns the store recording its writes in the ChangeSet
+// under the store name.
+func NewRecordingStore(parent types.KVStore, storeName string, changes *ChangeSet) *RecordingStore {
+	return &RecordingStore{
+		KVStore:   parent,
+		storeName: storeName,
+		changes:   changes,
+	}
+}
+
+// Set implements types.KVStore.
+func (rs *RecordingStore) Set(key, value []byte) {
+	rs.KVStore.Set(key, value)
+	rs.changes.Set(rs.storeName, key, value)
+}
+
+// Delete implements types.KVStore.
+func (rs *RecordingStore) Delete(key []byte) {
+	rs.KVStore.Delete(key)
+	rs.changes.Delete(rs.storeName, key)
+}
+
+// CacheWrap implements the CacheWrapper interface.
+func (rs *RecordingStore) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(rs)
+}
+
+// CacheWrapWithTrace implements the CacheWrapper interface.
+func (rs *RecordingStore) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(rs, w, tc))
+}
@@ -0,0 +1,270 @@
+package archive
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+
+	abci "github.com/line/ostracon/abci/types"
+	tmdb "github.com/line/tm-db/v2"
+
+	"github.com/line/lbm-sdk/store/cachekv"
+	"github.com/line/lbm-sdk/store/tracekv"
+	"github.com/line/lbm-sdk/store/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/types/kv"
+)
+
+// View is a read-only KVStore of a store of the multistore at an archived
+// version.
+type View struct {
+	db        tmdb.DB
+	storeName string
+	prefix    []byte
+	version   int64
+}
+
+var (
+	_ types.KVStore   = (*View)(nil)
+	_ types.Queryable = (*View)(nil)
+)
+
+// View returns the store of the name at the version, which must be archived.
+func (s *Store) View(storeName string, version int64) (*View, error) {
+	if !s.HasVersion(version) {
+		earliest, latest := s.Versions()
+		return nil, fmt.Errorf("version %d is not archived; earliest: %d, latest: %d", version, earliest, latest)
+	}
+
+	return &View{
+		db:        s.db,
+		storeName: storeName,
+		prefix:    storePrefix(storeName),
+		version:   version,
+	}, nil
+}
+
+// GetStoreType implements types.Store.
+func (v *View) GetStoreType() types.StoreType {
+	return types.StoreTypeIAVL
+}
+
+// CacheWrap implements the CacheWrapper interface.
+func (v *View) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(v)
+}
+
+// CacheWrapWithTrace implements the CacheWrapper interface.
+func (v *View) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(v, w, tc))
+}
+
+// Get implements types.KVStore.
+func (v *View) Get(key []byte) []byte {
+	types.AssertValidKey(key)
+
+	prefix := keyPrefix(v.storeName, key)
+	iter, err := v.db.Iterator(dataKey(v.storeName, key, v.version), types.PrefixEndBytes(prefix))
+	if err != nil {
+		panic(err)
+	}
+	defer iter.Close()
+
+	// the first entry is the latest change up to the version
+	if !iter.Valid() {
+		return nil
+	}
+	return valueOf(iter.Value())
+}
+
+// Has implements types.KVStore.
+func (v *View) Has(key []byte) bool {
+	return v.Get(key) != nil
+}
+
+// Set implements types.KVStore. It panics as the store is read-only.
+func (v *View) Set(_, _ []byte) {
+	panic("cannot set on an archived store")
+}
+
+// Delete implements types.KVStore. It panics as the store is read-only.
+func (v *View) Delete(_ []byte) {
+	panic("cannot delete on an archived store")
+}
+
+// Iterator implements types.KVStore.
+func (v *View) Iterator(start, end []byte) types.Iterator {
+	return v.newIterator(start, end, false)
+}
+
+// ReverseIterator implements types.KVStore.
+func (v *View) ReverseIterator(start, end []byte) types.Iterator {
+	return v.newIterator(start, end, true)
+}
+
+func (v *View) newIterator(start, end []byte, reverse bool) types.Iterator {
+	// the encodings of the keys in [start, end) are in [prefix | escape(start),
+	// prefix | escape(end) | 0x00 0x00), whatever their version
+	dbStart := v.prefix
+	if start != nil {
+		dbStart = append(append([]byte{}, v.prefix...), escape(start)...)
+	}
+	dbEnd := types.PrefixEndBytes(v.prefix)
+	if end != nil {
+		dbEnd = keyPrefix(v.storeName, end)
+	}
+
+	var (
+		source tmdb.Iterator
+		err    error
+	)
+	if reverse {
+		source, err = v.db.ReverseIterator(dbStart, dbEnd)
+	} else {
+		source, err = v.db.Iterator(dbStart, dbEnd)
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	iter := &viewIterator{
+		source:    source,
+		prefixLen: len(v.prefix),
+		version:   v.version,
+	}
+	iter.Next()
+	return iter
+}
+
+// Query implements types.Queryable the way the IAVL stores do, without proofs.
+func (v *View) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
+	if len(req.Data) == 0 {
+		return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrTxDecode, "query cannot be zero length"))
+	}
+	if req.Prove {
+		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "no proof of archived version %d", v.version))
+	}
+
+	res.Height = v.version
+
+	switch req.Path {
+	case "/key":
+		res.Key = req.Data
+		res.Value = v.Get(req.Data)
+
+	case "/subspace":
+		pairs := kv.Pairs{
+			Pairs: make([]kv.Pair, 0),
+		}
+
+		res.Key = req.Data
+
+		iterator := types.KVStorePrefixIterator(v, req.Data)
+		for ; iterator.Valid(); iterator.Next() {
+			pairs.Pairs = append(pairs.Pairs, kv.Pair{Key: iterator.Key(), Value: iterator.Value()})
+		}
+		iterator.Close()
+
+		bz, err := pairs.Marshal()
+		if err != nil {
+			panic(fmt.Errorf("failed to marshal KV pairs: %w", err))
+		}
+
+		res.Value = bz
+
+	default:
+		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unexpected query path: %v", req.Path))
+	}
+
+	return res
+}
+
+// viewIterator iterates over the latest changes of the keys up to the version,
+// skipping the deleted ones. The changes of a key are adjacent in the source.
+type viewIterator struct {
+	source    tmdb.Iterator
+	prefixLen int
+	version   int64
+
+	key   []byte
+	value []byte
+	valid bool
+}
+
+var _ types.Iterator = (*viewIterator)(nil)
+
+// Valid implements types.Iterator.
+func (it *viewIterator) Valid() bool {
+	return it.valid
+}
+
+// Next implements types.Iterator. It moves to the next key set at the version.
+func (it *viewIterator) Next() {
+	it.valid = false
+	for !it.valid && it.source.Valid() {
+		dbKey := it.source.Key()
+		group := append([]byte{}, dbKey[:len(dbKey)-8]...)
+
+		// find the latest change up to the version among the changes of the key
+		var (
+			value  []byte
+			latest int64 = -1
+		)
+		for ; it.source.Valid(); it.source.Next() {
+			dbKey := it.source.Key()
+			if !bytes.Equal(dbKey[:len(dbKey)-8], group) {
+				break
+			}
+			if version := versionOf(dbKey); version <= it.version && version > latest {
+				value, latest = append([]byte{}, it.source.Value()...), version
+			}
+		}
+
+		if latest >= 0 && valueOf(value) != nil {
+			it.key = unescape(group[it.prefixLen:])
+			it.value = valueOf(value)
+			it.valid = true
+		}
+	}
+}
+
+// Key implements types.Iterator.
+func (it *viewIterator) Key() []byte {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+	return it.key
+}
+
+// Value implements types.Iterator.
+func (it *viewIterator) Value() []byte {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+	return it.value
+}
+
+// Error implements types.Iterator.
+func (it *viewIterator) Error() error {
+	return it.source.Error()
+}
+
+// Close implements types.Iterator.
+func (it *viewIterator) Close() error {
+	return it.source.Close()
+}
+
+// versionOf returns the version of the data key.
+func versionOf(dbKey []byte) int64 {
+	return math.MaxInt64 - int64(binary.BigEndian.Uint64(dbKey[len(dbKey)-8:]))
+}
+
+// valueOf returns the value of the data value, nil if it is a deletion.
+func valueOf(dbValue []byte) []byte {
+	if dbValue[0] != setFlag {
+		return nil
+	}
+	return dbValue[1:]
+}
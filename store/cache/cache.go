@@ -1,6 +1,7 @@
 package cache
 
 import (
+	"encoding/binary"
 	"sync"
 	"time"
 
@@ -42,13 +43,24 @@ type (
 		caches  map[string]types.CommitKVStore
 		metrics *Metrics
 
-		// All cache stores use the unique prefix that has one byte length
-		// Contract: The number of all cache stores cannot exceed 127(max byte)
+		// stores given a cache of their own instead of sharing the default one
+		storeSizes  map[string]int
+		storeCaches map[string]*fastcache.Cache
+
+		// All stores sharing the default cache use a unique prefix, the uvarint
+		// encoding of their order, so no prefix is a prefix of another one.
 		prefixMap   map[string][]byte
-		prefixOrder byte
+		prefixOrder uint64
 	}
+
+	// Option configures a CommitKVStoreCacheManager.
+	Option func(*CommitKVStoreCacheManager)
 )
 
+// sharedCacheLabel is the store label of the metrics of the default cache
+// shared by the stores without a cache of their own.
+const sharedCacheLabel = "shared"
+
 func NewCommitKVStoreCache(store types.CommitKVStore, prefix []byte, cache *fastcache.Cache,
 	metrics *Metrics) *CommitKVStoreCache {
 	return &CommitKVStoreCache{
@@ -59,7 +71,16 @@ func NewCommitKVStoreCache(store types.CommitKVStore, prefix []byte, cache *fast
 	}
 }
 
-func NewCommitKVStoreCacheManager(cacheSize int, provider MetricsProvider) *CommitKVStoreCacheManager {
+// WithStoreCacheSize gives the store of the name a cache of its own holding at
+// most size bytes, instead of a share of the default cache. Note that a cache
+// holds at least 32 MB.
+func WithStoreCacheSize(name string, size int) Option {
+	return func(cmgr *CommitKVStoreCacheManager) {
+		cmgr.storeSizes[name] = size
+	}
+}
+
+func NewCommitKVStoreCacheManager(cacheSize int, provider MetricsProvider, opts ...Option) *CommitKVStoreCacheManager {
 	if cacheSize <= 0 {
 		// This function was called because it intended to use the inter block cache, creating a cache of minimal size.
 		cacheSize = DefaultCommitKVStoreCacheSize
@@ -68,10 +89,15 @@ func NewCommitKVStoreCacheManager(cacheSize int, provider MetricsProvider) *Comm
 		cache:       fastcache.New(cacheSize),
 		caches:      make(map[string]types.CommitKVStore),
 		metrics:     provider(),
+		storeSizes:  make(map[string]int),
+		storeCaches: make(map[string]*fastcache.Cache),
 		prefixMap:   make(map[string][]byte),
 		prefixOrder: 0,
 	}
-	startCacheMetricUpdator(cm.cache, cm.metrics)
+	for _, opt := range opts {
+		opt(cm)
+	}
+	startCacheMetricUpdator(cm.cache, cm.metrics.WithStore(sharedCacheLabel))
 	return cm
 }
 
@@ -97,12 +123,8 @@ func (cmgr *CommitKVStoreCacheManager) GetStoreCache(key types.StoreKey, store t
 		// After concurrent checkTx, delieverTx becomes to be possible, this should be protected by a mutex
 		cmgr.mutex.Lock()
 		if cmgr.caches[key.Name()] == nil { // recheck after acquiring lock
-			cmgr.prefixMap[key.Name()] = []byte{cmgr.prefixOrder}
-			cmgr.prefixOrder++
-			if cmgr.prefixOrder <= 0 {
-				panic("The number of cache stores exceed the maximum(127)")
-			}
-			cmgr.caches[key.Name()] = NewCommitKVStoreCache(store, cmgr.prefixMap[key.Name()], cmgr.cache, cmgr.metrics)
+			cache, prefix := cmgr.newStoreCache(key.Name())
+			cmgr.caches[key.Name()] = NewCommitKVStoreCache(store, prefix, cache, cmgr.metrics.WithStore(key.Name()))
 		}
 		cmgr.mutex.Unlock()
 	}
@@ -110,6 +132,31 @@ func (cmgr *CommitKVStoreCacheManager) GetStoreCache(key types.StoreKey, store t
 	return cmgr.caches[key.Name()]
 }
 
+// newStoreCache returns the cache and the prefix of the store of the name. The
+// entries left from a previous store of the same name are not visible anymore.
+// It must be called with cmgr.mutex held.
+func (cmgr *CommitKVStoreCacheManager) newStoreCache(name string) (*fastcache.Cache, []byte) {
+	size, ok := cmgr.storeSizes[name]
+	if !ok {
+		prefix := make([]byte, binary.MaxVarintLen64)
+		n := binary.PutUvarint(prefix, cmgr.prefixOrder)
+		// full slice so that appending a key to the prefix never shares its array
+		cmgr.prefixMap[name] = prefix[:n:n]
+		cmgr.prefixOrder++
+		return cmgr.cache, cmgr.prefixMap[name]
+	}
+
+	cache, ok := cmgr.storeCaches[name]
+	if ok {
+		cache.Reset()
+	} else {
+		cache = fastcache.New(size)
+		cmgr.storeCaches[name] = cache
+		startCacheMetricUpdator(cache, cmgr.metrics.WithStore(name))
+	}
+	return cache, nil
+}
+
 // Unwrap returns the underlying CommitKVStore for a given StoreKey.
 func (cmgr *CommitKVStoreCacheManager) Unwrap(key types.StoreKey) types.CommitKVStore {
 	if ckv, ok := cmgr.caches[key.Name()]; ok {
@@ -173,3 +220,10 @@ func (ckv *CommitKVStoreCache) Delete(key []byte) {
 	ckv.cache.Del(prefixedKey)
 	ckv.CommitKVStore.Delete(key)
 }
+
+// Warm caches the value of the key, which must be its current value in the
+// underlying CommitKVStore.
+func (ckv *CommitKVStoreCache) Warm(key, value []byte) {
+	ckv.cache.Set(append(ckv.prefix, key...), value)
+	ckv.metrics.InterBlockCacheWarmedUp.Add(1)
+}
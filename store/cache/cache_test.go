@@ -68,3 +68,52 @@ func TestStoreCache(t *testing.T) {
 		require.Nil(t, store.Get(key))
 	}
 }
+
+func TestStoreCacheSizes(t *testing.T) {
+	db := memdb.NewDB()
+	mngr := cache.NewCommitKVStoreCacheManager(cache.DefaultCommitKVStoreCacheSize, cache.NopMetricsProvider(),
+		cache.WithStoreCacheSize("hot", 64*1024*1024))
+
+	var stores []types.CommitKVStore
+	var kvStores []types.CommitKVStore
+	for _, name := range []string{"hot", "cold1", "cold2"} {
+		tree, err := iavl.NewMutableTree(db, 100)
+		require.NoError(t, err)
+		store := iavlstore.UnsafeNewStore(tree)
+		stores = append(stores, store)
+		kvStores = append(kvStores, mngr.GetStoreCache(types.NewKVStoreKey(name), store))
+	}
+
+	// the same key is cached separately for every store
+	for i, kvStore := range kvStores {
+		kvStore.Set([]byte("key"), []byte{byte(i)})
+	}
+	for i, kvStore := range kvStores {
+		require.Equal(t, []byte{byte(i)}, kvStore.Get([]byte("key")))
+		require.Equal(t, []byte{byte(i)}, stores[i].Get([]byte("key")))
+	}
+
+	// the stores loaded after a reset do not see the entries of the previous ones
+	mngr.Reset()
+	for i, name := range []string{"hot", "cold1", "cold2"} {
+		stores[i].Set([]byte("key"), []byte("new"))
+		kvStore := mngr.GetStoreCache(types.NewKVStoreKey(name), stores[i])
+		require.Equal(t, []byte("new"), kvStore.Get([]byte("key")))
+	}
+}
+
+func TestStoreCacheWarm(t *testing.T) {
+	db := memdb.NewDB()
+	mngr := cache.NewCommitKVStoreCacheManager(cache.DefaultCommitKVStoreCacheSize, cache.NopMetricsProvider())
+
+	sKey := types.NewKVStoreKey("test")
+	tree, err := iavl.NewMutableTree(db, 100)
+	require.NoError(t, err)
+	store := iavlstore.UnsafeNewStore(tree)
+	kvStore := mngr.GetStoreCache(sKey, store).(*cache.CommitKVStoreCache)
+
+	// a warmed up entry is served by the cache without reading the store
+	kvStore.Warm([]byte("key"), []byte("value"))
+	require.Equal(t, []byte("value"), kvStore.Get([]byte("key")))
+	require.Nil(t, store.Get([]byte("key")))
+}
@@ -11,14 +11,19 @@ const (
 	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
 	// package.
 	MetricsSubsystem = "inter_block_cache"
+
+	// storeLabel is the label of the store a metric is about.
+	storeLabel = "store"
 )
 
-// Metrics contains metrics exposed by this package.
+// Metrics contains metrics exposed by this package. All of them are labeled
+// with the store, see WithStore.
 type Metrics struct {
-	InterBlockCacheHits    metrics.Counter
-	InterBlockCacheMisses  metrics.Counter
-	InterBlockCacheEntries metrics.Gauge
-	InterBlockCacheBytes   metrics.Gauge
+	InterBlockCacheHits     metrics.Counter
+	InterBlockCacheMisses   metrics.Counter
+	InterBlockCacheEntries  metrics.Gauge
+	InterBlockCacheBytes    metrics.Gauge
+	InterBlockCacheWarmedUp metrics.Counter
 }
 
 // PrometheusMetrics returns Metrics build using Prometheus client library.
@@ -29,6 +34,7 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 	for i := 0; i < len(labelsAndValues); i += 2 {
 		labels = append(labels, labelsAndValues[i])
 	}
+	labels = append(labels, storeLabel)
 	return &Metrics{
 		InterBlockCacheHits: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
 			Namespace: namespace,
@@ -54,16 +60,35 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "bytes_size",
 			Help:      "Cache bytes size of the inter block cache",
 		}, labels).With(labelsAndValues...),
+		InterBlockCacheWarmedUp: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "warmed_up",
+			Help:      "Entries put into the inter block cache at startup",
+		}, labels).With(labelsAndValues...),
+	}
+}
+
+// WithStore returns the metrics of the store of the name. The entries and bytes
+// of the caches shared by several stores are reported under "shared".
+func (m *Metrics) WithStore(name string) *Metrics {
+	return &Metrics{
+		InterBlockCacheHits:     m.InterBlockCacheHits.With(storeLabel, name),
+		InterBlockCacheMisses:   m.InterBlockCacheMisses.With(storeLabel, name),
+		InterBlockCacheEntries:  m.InterBlockCacheEntries.With(storeLabel, name),
+		InterBlockCacheBytes:    m.InterBlockCacheBytes.With(storeLabel, name),
+		InterBlockCacheWarmedUp: m.InterBlockCacheWarmedUp.With(storeLabel, name),
 	}
 }
 
 // NopMetrics returns no-op Metrics.
 func NopMetrics() *Metrics {
 	return &Metrics{
-		InterBlockCacheHits:    discard.NewCounter(),
-		InterBlockCacheMisses:  discard.NewCounter(),
-		InterBlockCacheEntries: discard.NewGauge(),
-		InterBlockCacheBytes:   discard.NewGauge(),
+		InterBlockCacheHits:     discard.NewCounter(),
+		InterBlockCacheMisses:   discard.NewCounter(),
+		InterBlockCacheEntries:  discard.NewGauge(),
+		InterBlockCacheBytes:    discard.NewGauge(),
+		InterBlockCacheWarmedUp: discard.NewCounter(),
 	}
 }
 
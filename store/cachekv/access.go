@@ -0,0 +1,105 @@
+package cachekv
+
+import (
+	"sync"
+
+	"github.com/line/lbm-sdk/store/types"
+)
+
+// Range is a key domain [Start, End) iterated over through a Store.
+type Range struct {
+	Start, End []byte
+}
+
+// AccessSet records how a Store accessed its parent: the keys it read, the
+// key ranges it iterated over and the keys it wrote. It is safe for concurrent
+// use.
+type AccessSet struct {
+	mtx    sync.Mutex
+	reads  map[string]struct{}
+	writes map[string]struct{}
+	ranges []Range
+}
+
+// NewAccessSet returns an empty AccessSet.
+func NewAccessSet() *AccessSet {
+	return &AccessSet{
+		reads:  make(map[string]struct{}),
+		writes: make(map[string]struct{}),
+	}
+}
+
+// Reads returns the keys read from the parent store.
+func (as *AccessSet) Reads() [][]byte {
+	as.mtx.Lock()
+	defer as.mtx.Unlock()
+	return keysOf(as.reads)
+}
+
+// Writes returns the keys set or deleted.
+func (as *AccessSet) Writes() [][]byte {
+	as.mtx.Lock()
+	defer as.mtx.Unlock()
+	return keysOf(as.writes)
+}
+
+// Ranges returns the key ranges iterated over on the parent store.
+func (as *AccessSet) Ranges() []Range {
+	as.mtx.Lock()
+	defer as.mtx.Unlock()
+	ranges := make([]Range, len(as.ranges))
+	copy(ranges, as.ranges)
+	return ranges
+}
+
+func (as *AccessSet) recordRead(key []byte) {
+	as.mtx.Lock()
+	defer as.mtx.Unlock()
+	as.reads[string(key)] = struct{}{}
+}
+
+func (as *AccessSet) recordWrite(key []byte) {
+	as.mtx.Lock()
+	defer as.mtx.Unlock()
+	as.writes[string(key)] = struct{}{}
+}
+
+func (as *AccessSet) recordRange(start, end []byte) {
+	as.mtx.Lock()
+	defer as.mtx.Unlock()
+	as.ranges = append(as.ranges, Range{Start: copyBytes(start), End: copyBytes(end)})
+}
+
+func keysOf(m map[string]struct{}) [][]byte {
+	keys := make([][]byte, 0, len(m))
+	for key := range m {
+		keys = append(keys, []byte(key))
+	}
+	return keys
+}
+
+func copyBytes(bz []byte) []byte {
+	if bz == nil {
+		return nil
+	}
+	cp := make([]byte, len(bz))
+	copy(cp, bz)
+	return cp
+}
+
+// copyingIterator returns copies of the keys and values of the parent
+// iterator, so that the branch iterating over a parent shared with other
+// branches cannot change them.
+type copyingIterator struct {
+	types.Iterator
+}
+
+// Key implements types.Iterator.
+func (it copyingIterator) Key() []byte {
+	return copyBytes(it.Iterator.Key())
+}
+
+// Value implements types.Iterator.
+func (it copyingIterator) Value() []byte {
+	return copyBytes(it.Iterator.Value())
+}
@@ -32,6 +32,9 @@ type Store struct {
 	unsortedCache sync.Map
 	sortedCache   *list.List // always ascending sorted
 	parent        types.KVStore
+
+	// accessSet, if set, records the accesses made to the parent
+	accessSet *AccessSet
 }
 
 var _ types.CacheKVStore = (*Store)(nil)
@@ -46,6 +49,14 @@ func NewStore(parent types.KVStore) *Store {
 	}
 }
 
+// NewStoreWithAccessSet creates a new Store object which records every read
+// from, iteration over and write to the parent in the given AccessSet.
+func NewStoreWithAccessSet(parent types.KVStore, accessSet *AccessSet) *Store {
+	store := NewStore(parent)
+	store.accessSet = accessSet
+	return store
+}
+
 // GetStoreType implements Store.
 func (store *Store) GetStoreType() types.StoreType {
 	return store.parent.GetStoreType()
@@ -64,6 +75,12 @@ func (store *Store) Get(key []byte) []byte {
 	}
 
 	value := store.parent.Get(key)
+	if store.accessSet != nil {
+		store.accessSet.recordRead(key)
+		// the parent is shared with other branches, which must not see any
+		// change made to the returned value
+		value = copyBytes(value)
+	}
 	store.setCacheValue(key, value, false, false)
 	return value
 }
@@ -77,6 +94,9 @@ func (store *Store) Set(key []byte, value []byte) {
 
 	store.mtx.Lock()
 	defer store.mtx.Unlock()
+	if store.accessSet != nil {
+		store.accessSet.recordWrite(key)
+	}
 	store.setCacheValue(key, value, false, true)
 }
 
@@ -93,6 +113,9 @@ func (store *Store) Delete(key []byte) {
 	types.AssertValidKey(key)
 	store.mtx.Lock()
 	defer store.mtx.Unlock()
+	if store.accessSet != nil {
+		store.accessSet.recordWrite(key)
+	}
 	store.setCacheValue(key, nil, true, true)
 }
 
@@ -165,11 +188,17 @@ func (store *Store) iterator(start, end []byte, ascending bool) types.Iterator {
 
 	var parent, cache types.Iterator
 
+	if store.accessSet != nil {
+		store.accessSet.recordRange(start, end)
+	}
 	if ascending {
 		parent = store.parent.Iterator(start, end)
 	} else {
 		parent = store.parent.ReverseIterator(start, end)
 	}
+	if store.accessSet != nil {
+		parent = copyingIterator{parent}
+	}
 
 	store.dirtyItems(start, end)
 	cache = newMemIterator(start, end, store.sortedCache, ascending)
@@ -572,3 +572,58 @@ func BenchmarkCacheKVStoreGetKeyFound(b *testing.B) {
 		st.Get([]byte{byte((i & 0xFF0000) >> 16), byte((i & 0xFF00) >> 8), byte(i & 0xFF)})
 	}
 }
+
+func TestCacheKVStoreAccessSet(t *testing.T) {
+	mem := dbadapter.Store{DB: memdb.NewDB()}
+	mem.Set(keyFmt(1), valFmt(1))
+
+	accessSet := cachekv.NewAccessSet()
+	st := cachekv.NewStoreWithAccessSet(mem, accessSet)
+
+	// reads are recorded when they reach the parent
+	require.Equal(t, valFmt(1), st.Get(keyFmt(1)))
+	require.Equal(t, valFmt(1), st.Get(keyFmt(1)))
+	st.Set(keyFmt(2), valFmt(2))
+	require.Equal(t, valFmt(2), st.Get(keyFmt(2)))
+	st.Delete(keyFmt(3))
+	require.False(t, st.Has(keyFmt(3)))
+
+	// accesses of nested stores reach the tracked store
+	nested := st.CacheWrap().(types.CacheKVStore)
+	nested.Set(keyFmt(4), valFmt(4))
+	iter := nested.Iterator(keyFmt(0), keyFmt(10))
+	iter.Close()
+	nested.Write()
+
+	require.ElementsMatch(t, [][]byte{keyFmt(1)}, accessSet.Reads())
+	require.ElementsMatch(t, [][]byte{keyFmt(2), keyFmt(3), keyFmt(4)}, accessSet.Writes())
+	require.Equal(t, []cachekv.Range{{Start: keyFmt(0), End: keyFmt(10)}}, accessSet.Ranges())
+}
+
+func TestCacheKVStoreAccessSetCopiesParentValues(t *testing.T) {
+	parent := newCacheKVStore()
+	value := make([]byte, 1, 8)
+	value[0] = '1'
+	parent.Set(keyFmt(1), value)
+
+	// a branch changing the values it got does not change those of the
+	// parent nor those of the other branches
+	branch := cachekv.NewStoreWithAccessSet(parent, cachekv.NewAccessSet())
+	other := cachekv.NewStoreWithAccessSet(parent, cachekv.NewAccessSet())
+
+	got := branch.Get(keyFmt(1))
+	_ = append(got[:1], '2')
+	got[0] = '3'
+
+	iter := branch.Iterator(nil, nil)
+	require.True(t, iter.Valid())
+	iterValue := iter.Value()
+	_ = append(iterValue[:1], '4')
+	iterValue[0] = '5'
+	iter.Close()
+
+	require.Equal(t, []byte("1"), parent.Get(keyFmt(1)))
+	require.Equal(t, []byte("1"), other.Get(keyFmt(1)))
+	// appending did not write past the end of the value held by the parent
+	require.Equal(t, []byte{'1', 0}, value[:2])
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/line/lbm-sdk/store/cachekv"
 	"github.com/line/lbm-sdk/store/dbadapter"
+	"github.com/line/lbm-sdk/store/tracekv"
 	"github.com/line/lbm-sdk/store/types"
 )
 
@@ -74,6 +75,31 @@ func newCacheMultiStoreFromCMS(cms Store) Store {
 	return NewFromKVStore(cms.db, stores, nil, cms.traceWriter, cms.traceContext)
 }
 
+// CacheMultiStoreWithAccessSets branches the multi-store like CacheMultiStore
+// does, and additionally records the accesses made through every branched
+// KVStore in the AccessSet returned for its key.
+func (cms Store) CacheMultiStoreWithAccessSets() (types.CacheMultiStore, map[types.StoreKey]*cachekv.AccessSet) {
+	branch := Store{
+		db:           cachekv.NewStore(cms.db),
+		stores:       make(map[types.StoreKey]types.CacheWrap, len(cms.stores)),
+		traceWriter:  cms.traceWriter,
+		traceContext: cms.traceContext,
+	}
+	accessSets := make(map[types.StoreKey]*cachekv.AccessSet, len(cms.stores))
+
+	for key, store := range cms.stores {
+		var parent types.KVStore = store.(types.KVStore)
+		if branch.TracingEnabled() {
+			parent = tracekv.NewStore(parent, branch.traceWriter, branch.traceContext)
+		}
+
+		accessSets[key] = cachekv.NewAccessSet()
+		branch.stores[key] = cachekv.NewStoreWithAccessSet(parent, accessSets[key])
+	}
+
+	return branch, accessSets
+}
+
 // SetTracer sets the tracer for the MultiStore that the underlying
 // stores will utilize to trace operations. A MultiStore is returned.
 func (cms Store) SetTracer(w io.Writer) types.MultiStore {
@@ -0,0 +1,98 @@
+package listenkv_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/tm-db/v2/memdb"
+
+	"github.com/line/lbm-sdk/store/dbadapter"
+	"github.com/line/lbm-sdk/store/listenkv"
+	"github.com/line/lbm-sdk/store/types"
+)
+
+var testStoreKey = types.NewKVStoreKey("listen_test")
+
+func newListenKVStore(buf *bytes.Buffer) *listenkv.Store {
+	memDB := dbadapter.Store{DB: memdb.NewDB()}
+	listener := types.NewStoreKVPairWriteListener(buf)
+	return listenkv.NewStore(memDB, testStoreKey, []types.WriteListener{listener})
+}
+
+func readKVPairs(t *testing.T, buf *bytes.Buffer) []types.StoreKVPair {
+	reader := protoio.NewDelimitedReader(buf, 1<<20)
+	var pairs []types.StoreKVPair
+	for {
+		var pair types.StoreKVPair
+		err := reader.ReadMsg(&pair)
+		if err == io.EOF {
+			return pairs
+		}
+		require.NoError(t, err)
+		pairs = append(pairs, pair)
+	}
+}
+
+func TestListenKVStoreSetDelete(t *testing.T) {
+	var buf bytes.Buffer
+	store := newListenKVStore(&buf)
+
+	store.Set([]byte("key1"), []byte("value1"))
+	store.Set([]byte("key2"), []byte("value2"))
+	store.Delete([]byte("key1"))
+
+	require.Nil(t, store.Get([]byte("key1")))
+	require.Equal(t, []byte("value2"), store.Get([]byte("key2")))
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: testStoreKey.Name(), Key: []byte("key1"), Value: []byte("value1")},
+		{StoreKey: testStoreKey.Name(), Key: []byte("key2"), Value: []byte("value2")},
+		{StoreKey: testStoreKey.Name(), Delete: true, Key: []byte("key1")},
+	}, readKVPairs(t, &buf))
+}
+
+func TestListenKVStoreReadsAreNotListened(t *testing.T) {
+	var buf bytes.Buffer
+	store := newListenKVStore(&buf)
+	store.Set([]byte("key1"), []byte("value1"))
+	buf.Reset()
+
+	store.Get([]byte("key1"))
+	store.Has([]byte("key1"))
+	iter := store.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+	}
+	iter.Close()
+
+	require.Zero(t, buf.Len())
+}
+
+func TestListenKVStoreCacheWrap(t *testing.T) {
+	var buf bytes.Buffer
+	store := newListenKVStore(&buf)
+
+	cache := store.CacheWrap().(types.CacheKVStore)
+	cache.Set([]byte("key1"), []byte("value1"))
+	require.Zero(t, buf.Len(), "writes are listened to when the branch is written")
+
+	cache.Write()
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: testStoreKey.Name(), Key: []byte("key1"), Value: []byte("value1")},
+	}, readKVPairs(t, &buf))
+}
+
+type failingListener struct{}
+
+func (failingListener) OnWrite(types.StoreKey, []byte, []byte, bool) error {
+	return bytes.ErrTooLarge
+}
+
+func TestListenKVStoreListenerFailure(t *testing.T) {
+	memDB := dbadapter.Store{DB: memdb.NewDB()}
+	store := listenkv.NewStore(memDB, testStoreKey, []types.WriteListener{failingListener{}})
+
+	require.Panics(t, func() { store.Set([]byte("key1"), []byte("value1")) })
+}
@@ -0,0 +1,93 @@
+package rootmulti
+
+import (
+	tmdb "github.com/line/tm-db/v2"
+	"github.com/pkg/errors"
+
+	"github.com/line/lbm-sdk/store/archive"
+	"github.com/line/lbm-sdk/store/iavl"
+	"github.com/line/lbm-sdk/store/types"
+)
+
+// SetStateArchive makes the Store archive every committed version of its IAVL
+// stores in the db, so that the versions pruned from the stores can still be
+// read and queried, without proofs. It must be called before the Store is
+// loaded.
+func (rs *Store) SetStateArchive(db tmdb.DB) {
+	rs.archiveDB = db
+}
+
+// openStateArchive opens the state archive, if any, and starts collecting the
+// changes of the next version.
+func (rs *Store) openStateArchive() error {
+	if rs.archiveDB == nil {
+		return nil
+	}
+
+	if rs.archive == nil {
+		a, err := archive.NewStore(rs.archiveDB)
+		if err != nil {
+			return errors.Wrap(err, "failed to open the state archive")
+		}
+		rs.archive = a
+	}
+	rs.archiveChanges = archive.NewChangeSet()
+
+	return nil
+}
+
+// loadStateArchive makes the state archive consistent with the loaded version.
+func (rs *Store) loadStateArchive(ver int64) error {
+	if rs.archive == nil {
+		return nil
+	}
+
+	stores := make(map[string]types.KVStore)
+	for key, store := range rs.stores {
+		if store.GetStoreType() == types.StoreTypeIAVL {
+			stores[key.Name()] = rs.GetCommitKVStore(key)
+		}
+	}
+
+	return errors.Wrap(rs.archive.Load(ver, stores), "failed to load the state archive")
+}
+
+// commitStateArchive archives the changes made since the last commit as the
+// version. It panics on failure, as the IAVL stores can not be committed.
+func (rs *Store) commitStateArchive(version int64) {
+	if rs.archive == nil {
+		return
+	}
+
+	if err := rs.archive.Commit(version, rs.archiveChanges); err != nil {
+		panic(errors.Wrapf(err, "failed to archive version %d", version))
+	}
+	rs.archiveChanges.Reset()
+}
+
+// recordWrites wraps the IAVL store so that its writes are archived on commit.
+func (rs *Store) recordWrites(name string, store types.KVStore) types.KVStore {
+	if rs.archive == nil || store.GetStoreType() != types.StoreTypeIAVL {
+		return store
+	}
+
+	return archive.NewRecordingStore(store, name, rs.archiveChanges)
+}
+
+// archivedStore returns the store of the key at the version from the state
+// archive if the version is not in the IAVL store anymore.
+func (rs *Store) archivedStore(key types.StoreKey, version int64) (*archive.View, bool) {
+	if rs.archive == nil || version <= 0 {
+		return nil, false
+	}
+	store, ok := rs.GetCommitKVStore(key).(*iavl.Store)
+	if !ok || store.VersionExists(version) || !rs.archive.HasVersion(version) {
+		return nil, false
+	}
+
+	view, err := rs.archive.View(key.Name(), version)
+	if err != nil {
+		return nil, false
+	}
+	return view, true
+}
@@ -0,0 +1,99 @@
+package rootmulti
+
+import (
+	"testing"
+
+	abci "github.com/line/ostracon/abci/types"
+	tmdb "github.com/line/tm-db/v2"
+	"github.com/line/tm-db/v2/memdb"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/store/types"
+)
+
+func newArchivedMultiStore(t *testing.T, db, archiveDB tmdb.DB, ver int64) *Store {
+	ms := newMultiStoreWithMounts(db, types.NewPruningOptions(1, 0, 1))
+	ms.SetStateArchive(archiveDB)
+	require.NoError(t, ms.LoadVersion(ver))
+	return ms
+}
+
+func TestStateArchive(t *testing.T) {
+	db, archiveDB := memdb.NewDB(), memdb.NewDB()
+	ms := newArchivedMultiStore(t, db, archiveDB, 0)
+	key1 := ms.keysByName["store1"]
+
+	k := []byte("wind")
+	for _, v := range []string{"blows", "howls", "stops"} {
+		cms := ms.CacheMultiStore()
+		cms.GetKVStore(key1).Set(k, []byte(v))
+		cms.Write()
+		ms.Commit()
+	}
+
+	// the first version is pruned from the IAVL store, but archived
+	require.False(t, ms.GetCommitKVStore(key1).(interface{ VersionExists(int64) bool }).VersionExists(1))
+	cms, err := ms.CacheMultiStoreWithVersion(1)
+	require.NoError(t, err)
+	require.Equal(t, []byte("blows"), cms.GetKVStore(key1).Get(k))
+
+	res := ms.Query(abci.RequestQuery{Path: "/store1/key", Data: k, Height: 1})
+	require.EqualValues(t, 0, res.Code)
+	require.Equal(t, []byte("blows"), res.Value)
+
+	res = ms.Query(abci.RequestQuery{Path: "/store1/key", Data: k, Height: 1, Prove: true})
+	require.NotEqualValues(t, 0, res.Code)
+
+	// the versions kept are still served with proofs by the IAVL store
+	res = ms.Query(abci.RequestQuery{Path: "/store1/key", Data: k, Height: 2, Prove: true})
+	require.EqualValues(t, 0, res.Code)
+	require.Equal(t, []byte("howls"), res.Value)
+	require.NotNil(t, res.ProofOps)
+
+	// the writes made to the multistore directly are archived too
+	ms.GetKVStore(key1).Delete(k)
+	ms.Commit()
+	cms, err = ms.CacheMultiStoreWithVersion(4)
+	require.NoError(t, err)
+	require.Nil(t, cms.GetKVStore(key1).Get(k))
+
+	// the versions archived after the loaded one are reverted
+	ms = newArchivedMultiStore(t, db, archiveDB, 3)
+	key1 = ms.keysByName["store1"]
+	earliest, latest := ms.archive.Versions()
+	require.Equal(t, int64(1), earliest)
+	require.Equal(t, int64(3), latest)
+
+	// the version is archived again when it is committed again
+	ms.GetKVStore(key1).Delete(k)
+	ms.Commit()
+	_, latest = ms.archive.Versions()
+	require.Equal(t, int64(4), latest)
+
+	res = ms.Query(abci.RequestQuery{Path: "/store1/key", Data: k, Height: 1})
+	require.Equal(t, []byte("blows"), res.Value)
+}
+
+func TestStateArchiveRebase(t *testing.T) {
+	db := memdb.NewDB()
+	ms := newMultiStoreWithMounts(db, types.NewPruningOptions(0, 0, 1))
+	require.NoError(t, ms.LoadLatestVersion())
+
+	k, v := []byte("wind"), []byte("blows")
+	ms.GetKVStore(ms.keysByName["store1"]).Set(k, v)
+	ms.Commit()
+	ms.Commit()
+
+	// an archive enabled later starts with the loaded version
+	archiveDB := memdb.NewDB()
+	ms = newArchivedMultiStore(t, db, archiveDB, 2)
+	earliest, latest := ms.archive.Versions()
+	require.Equal(t, int64(2), earliest)
+	require.Equal(t, int64(2), latest)
+
+	ms.Commit()
+	ms.Commit()
+	res := ms.Query(abci.RequestQuery{Path: "/store1/key", Data: k, Height: 2})
+	require.EqualValues(t, 0, res.Code)
+	require.Equal(t, v, res.Value)
+}
@@ -1,6 +1,7 @@
 package rootmulti
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"encoding/binary"
 	"encoding/hex"
@@ -18,6 +19,7 @@ import (
 	tmdb "github.com/line/tm-db/v2"
 	"github.com/line/tm-db/v2/memdb"
 
+	"github.com/line/lbm-sdk/snapshots"
 	snapshottypes "github.com/line/lbm-sdk/snapshots/types"
 	"github.com/line/lbm-sdk/store/iavl"
 	sdkmaps "github.com/line/lbm-sdk/store/internal/maps"
@@ -561,11 +563,19 @@ func TestMultistoreSnapshot_Checksum(t *testing.T) {
 			"a4a864e6c02c9fca5837ec80dc84f650b25276ed7e4820cf7516ced9f9901b86",
 			"ca2879ac6e7205d257440131ba7e72bef784cd61642e32b847729e543c1928b9",
 		}},
+		{2, []string{
+			"1a8cd974f3cfc81466f4723d64d482072a96b47f6e7f63fab5715571b10a680a",
+			"b61d604cd2c18114e5ecedb2c7333c288c40e4bdaf80b54494039c5a2e318eef",
+			"8293c1b472e1a507b4e3f74092b0a1b9aa669c57cd6b897c718723363e5c5be2",
+			"e167049a8d0563aa1ff46cb4a78337d2bc9e380b09a6bda33dd0dd4fcf1d5a14",
+			"77d2052b4175582a9821bee54487d4951893eba969fc30d1082db0cee8279d3b",
+			"4a6503142cbf87a2b525bb50fcf96eddccb4368e3fb3eff2fc81de69a0113ebf",
+		}},
 	}
 	for _, tc := range testcases {
 		tc := tc
 		t.Run(fmt.Sprintf("Format %v", tc.format), func(t *testing.T) {
-			chunks, err := store.Snapshot(version, tc.format)
+			chunks, err := snapshotChunks(store, version, tc.format)
 			require.NoError(t, err)
 			hashes := []string{}
 			hasher := sha256.New()
@@ -597,7 +607,10 @@ func TestMultistoreSnapshot_Errors(t *testing.T) {
 	for name, tc := range testcases {
 		tc := tc
 		t.Run(name, func(t *testing.T) {
-			_, err := store.Snapshot(tc.height, tc.format)
+			chunks, err := snapshotChunks(store, tc.height, tc.format)
+			if err == nil {
+				_, err = ioutil.ReadAll(snapshots.NewChunkReader(chunks))
+			}
 			require.Error(t, err)
 			if tc.expectType != nil {
 				assert.True(t, errors.Is(err, tc.expectType))
@@ -621,7 +634,7 @@ func TestMultistoreRestore_Errors(t *testing.T) {
 	for name, tc := range testcases {
 		tc := tc
 		t.Run(name, func(t *testing.T) {
-			err := store.Restore(tc.height, tc.format, nil, nil)
+			_, err := store.Restore(tc.height, tc.format, nil)
 			require.Error(t, err)
 			if tc.expectType != nil {
 				assert.True(t, errors.Is(err, tc.expectType))
@@ -631,23 +644,48 @@ func TestMultistoreRestore_Errors(t *testing.T) {
 }
 
 func TestMultistoreSnapshotRestore(t *testing.T) {
+	for _, format := range []uint32{snapshottypes.FormatV1, snapshottypes.FormatV2} {
+		format := format
+		t.Run(fmt.Sprintf("Format %v", format), func(t *testing.T) {
+			source := newMultiStoreWithMixedMountsAndBasicData(memdb.NewDB())
+			target := newMultiStoreWithMixedMounts(memdb.NewDB())
+			version := uint64(source.LastCommitID().Version)
+			require.EqualValues(t, 3, version)
+
+			chunks, err := snapshotChunks(source, version, format)
+			require.NoError(t, err)
+			err = restoreChunks(target, version, format, chunks)
+			require.NoError(t, err)
+
+			assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+			for key, sourceStore := range source.stores {
+				targetStore := target.getStoreByName(key.Name()).(types.CommitKVStore)
+				assertStoresEqual(t, sourceStore, targetStore, "store %q not equal", key.Name())
+			}
+		})
+	}
+}
+
+func TestMultistoreRestore_CorruptedChunk(t *testing.T) {
 	source := newMultiStoreWithMixedMountsAndBasicData(memdb.NewDB())
 	target := newMultiStoreWithMixedMounts(memdb.NewDB())
 	version := uint64(source.LastCommitID().Version)
-	require.EqualValues(t, 3, version)
 
-	chunks, err := source.Snapshot(version, snapshottypes.CurrentFormat)
+	chunks, err := snapshotChunks(source, version, snapshottypes.FormatV2)
 	require.NoError(t, err)
-	ready := make(chan struct{})
-	err = target.Restore(version, snapshottypes.CurrentFormat, chunks, ready)
-	require.NoError(t, err)
-	assert.EqualValues(t, struct{}{}, <-ready)
+	corrupted := make(chan io.ReadCloser, 1)
+	go func() {
+		defer close(corrupted)
+		for chunk := range chunks {
+			bz, err := ioutil.ReadAll(chunk)
+			require.NoError(t, err)
+			bz[len(bz)-1]++
+			corrupted <- ioutil.NopCloser(bytes.NewReader(bz))
+		}
+	}()
 
-	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
-	for key, sourceStore := range source.stores {
-		targetStore := target.getStoreByName(key.Name()).(types.CommitKVStore)
-		assertStoresEqual(t, sourceStore, targetStore, "store %q not equal", key.Name())
-	}
+	err = restoreChunks(target, version, snapshottypes.FormatV2, corrupted)
+	require.True(t, errors.Is(err, snapshottypes.ErrChunkChecksumMismatch), err)
 }
 
 func TestSetInitialVersion(t *testing.T) {
@@ -700,7 +738,7 @@ func benchmarkMultistoreSnapshot(b *testing.B, stores uint8, storeKeys uint64) {
 		require.NoError(b, err)
 		require.EqualValues(b, 0, target.LastCommitID().Version)
 
-		chunks, err := source.Snapshot(uint64(version), snapshottypes.CurrentFormat)
+		chunks, err := snapshotChunks(source, uint64(version), snapshottypes.CurrentFormat)
 		require.NoError(b, err)
 		for reader := range chunks {
 			_, err := io.Copy(ioutil.Discard, reader)
@@ -727,9 +765,9 @@ func benchmarkMultistoreSnapshotRestore(b *testing.B, stores uint8, storeKeys ui
 		require.NoError(b, err)
 		require.EqualValues(b, 0, target.LastCommitID().Version)
 
-		chunks, err := source.Snapshot(version, snapshottypes.CurrentFormat)
+		chunks, err := snapshotChunks(source, version, snapshottypes.CurrentFormat)
 		require.NoError(b, err)
-		err = target.Restore(version, snapshottypes.CurrentFormat, chunks, nil)
+		err = restoreChunks(target, version, snapshottypes.CurrentFormat, chunks)
 		require.NoError(b, err)
 		require.Equal(b, source.LastCommitID(), target.LastCommitID())
 	}
@@ -738,6 +776,75 @@ func benchmarkMultistoreSnapshotRestore(b *testing.B, stores uint8, storeKeys ui
 //-----------------------------------------------------------------------
 // utils
 
+// snapshotChunks returns the chunks of a snapshot of the store in the format, as created by the
+// snapshot manager.
+func snapshotChunks(store *Store, height uint64, format uint32) (<-chan io.ReadCloser, error) {
+	ch := make(chan io.ReadCloser)
+	streamWriter, err := snapshots.NewStreamWriter(ch, format)
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		if err := store.Snapshot(height, streamWriter); err != nil {
+			streamWriter.CloseWithError(err)
+			return
+		}
+		_ = streamWriter.Close()
+	}()
+	return ch, nil
+}
+
+// restoreChunks restores the store from the chunks of a snapshot, as done by the snapshot
+// manager.
+func restoreChunks(store *Store, height uint64, format uint32, chunks <-chan io.ReadCloser) error {
+	streamReader, err := snapshots.NewStreamReader(chunks, format)
+	if err != nil {
+		return err
+	}
+	defer streamReader.Close()
+	_, err = store.Restore(height, format, streamReader)
+	return err
+}
+
+type recordingListener struct {
+	writes []types.StoreKVPair
+}
+
+func (l *recordingListener) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) error {
+	l.writes = append(l.writes, types.StoreKVPair{StoreKey: storeKey.Name(), Delete: delete, Key: key, Value: value})
+	return nil
+}
+
+func TestMultiStoreListeners(t *testing.T) {
+	db := memdb.NewDB()
+	multi := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, multi.LoadLatestVersion())
+
+	key1, key2 := multi.keysByName["store1"], multi.keysByName["store2"]
+	listener := &recordingListener{}
+	multi.AddListeners(key1, []types.WriteListener{listener})
+	require.True(t, multi.ListeningEnabled(key1))
+	require.False(t, multi.ListeningEnabled(key2))
+
+	cms := multi.CacheMultiStore()
+	cms.GetKVStore(key1).Set([]byte("k1"), []byte("v1"))
+	cms.GetKVStore(key1).Delete([]byte("k0"))
+	cms.GetKVStore(key2).Set([]byte("k2"), []byte("v2"))
+	require.Empty(t, listener.writes, "the writes of a branch are listened to when it is written")
+
+	cms.Write()
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: "store1", Delete: true, Key: []byte("k0")},
+		{StoreKey: "store1", Key: []byte("k1"), Value: []byte("v1")},
+	}, listener.writes)
+
+	listener.writes = nil
+	multi.GetKVStore(key1).Set([]byte("k3"), []byte("v3"))
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: "store1", Key: []byte("k3"), Value: []byte("v3")},
+	}, listener.writes)
+}
+
 func newMultiStoreWithMounts(db tmdb.DB, pruningOpts types.PruningOptions) *Store {
 	store := NewStore(db)
 	store.pruningOpts = pruningOpts
@@ -0,0 +1,80 @@
+package rootmulti
+
+import (
+	"io"
+
+	"github.com/line/lbm-sdk/snapshots"
+	snapshottypes "github.com/line/lbm-sdk/snapshots/types"
+	"github.com/line/lbm-sdk/store/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+// cacheWarmer is implemented by the stores wrapped with an inter-block cache.
+type cacheWarmer interface {
+	types.KVStore
+	Warm(key, value []byte)
+}
+
+// WarmUpInterBlockCache fills the inter-block caches with the entries of the
+// stores in a snapshot. The values are taken from the snapshot if it was taken
+// at the latest version. Otherwise they may be outdated, and only the keys are
+// used: the current values are read through the caches. It must not be called
+// concurrently with writes to the stores.
+func (rs *Store) WarmUpInterBlockCache(height uint64, format uint32, chunks <-chan io.ReadCloser) error {
+	if rs.interBlockCache == nil {
+		snapshots.DrainChunks(chunks)
+		return nil
+	}
+	if !snapshottypes.IsSupportedFormat(format) {
+		snapshots.DrainChunks(chunks)
+		return sdkerrors.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
+	}
+	latest := height == uint64(rs.LastCommitID().Version)
+
+	protoReader, err := snapshots.NewStreamReader(chunks, format)
+	if err != nil {
+		return err
+	}
+	defer protoReader.Close()
+
+	var store cacheWarmer
+	for {
+		item := &snapshottypes.SnapshotItem{}
+		err := protoReader.ReadMsg(item)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return sdkerrors.Wrap(err, "invalid protobuf message")
+		}
+
+		switch item := item.Item.(type) {
+		case *snapshottypes.SnapshotItem_Store:
+			// stores not mounted anymore or not cached are skipped
+			store = nil
+			if key := rs.keysByName[item.Store.Name]; key != nil {
+				store, _ = rs.stores[key].(cacheWarmer)
+			}
+
+		case *snapshottypes.SnapshotItem_IAVL:
+			// only the leaf nodes hold entries
+			if store == nil || item.IAVL.Height != 0 {
+				continue
+			}
+			// Protobuf does not differentiate between []byte{} as nil, see Restore
+			if item.IAVL.Key == nil {
+				item.IAVL.Key = []byte{}
+			}
+			if latest {
+				store.Warm(item.IAVL.Key, item.IAVL.Value)
+			} else {
+				store.Get(item.IAVL.Key)
+			}
+
+		default:
+			// the items of the snapshot extensions follow the stores
+			return nil
+		}
+	}
+
+	return nil
+}
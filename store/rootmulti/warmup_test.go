@@ -0,0 +1,80 @@
+package rootmulti
+
+import (
+	"testing"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/line/tm-db/v2/memdb"
+	"github.com/stretchr/testify/require"
+
+	snapshottypes "github.com/line/lbm-sdk/snapshots/types"
+	"github.com/line/lbm-sdk/store/cache"
+	"github.com/line/lbm-sdk/store/types"
+)
+
+// counter counts the values added regardless of the labels.
+type counter struct{ value float64 }
+
+func (c *counter) With(...string) metrics.Counter { return c }
+func (c *counter) Add(delta float64)              { c.value += delta }
+
+type cacheCounters struct {
+	hits, misses, warmedUp counter
+}
+
+func newMultiStoreWithInterBlockCache(t *testing.T, store *Store, counters *cacheCounters) *Store {
+	cached := NewStore(store.db)
+	cached.SetInterBlockCache(cache.NewCommitKVStoreCacheManager(cache.DefaultCommitKVStoreCacheSize, func() *cache.Metrics {
+		return &cache.Metrics{
+			InterBlockCacheHits:          &counters.hits,
+			InterBlockCacheMisses:        &counters.misses,
+			InterBlockCacheEntries:       discard.NewGauge(),
+			InterBlockCacheBytes:         discard.NewGauge(),
+			InterBlockCacheWarmedUp:      &counters.warmedUp,
+		}
+	}))
+	for key := range store.stores {
+		cached.MountStoreWithDB(key, types.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, cached.LoadLatestVersion())
+	return cached
+}
+
+func TestWarmUpInterBlockCache(t *testing.T) {
+	source := newMultiStoreWithMixedMountsAndBasicData(memdb.NewDB())
+	version := uint64(source.LastCommitID().Version)
+
+	// the snapshot of the latest version provides the values
+	var counters cacheCounters
+	target := newMultiStoreWithInterBlockCache(t, source, &counters)
+	chunks, err := snapshotChunks(source, version, snapshottypes.CurrentFormat)
+	require.NoError(t, err)
+	require.NoError(t, target.WarmUpInterBlockCache(version, snapshottypes.CurrentFormat, chunks))
+	require.Equal(t, float64(6), counters.warmedUp.value)
+	require.Zero(t, counters.misses.value)
+
+	require.Equal(t, []byte{2}, target.GetKVStore(target.keysByName["iavl1"]).Get([]byte("b")))
+	require.Equal(t, float64(1), counters.hits.value)
+
+	// the values of an older snapshot are read from the stores
+	target.GetKVStore(target.keysByName["iavl1"]).Set([]byte("b"), []byte{9})
+	target.Commit()
+
+	counters = cacheCounters{}
+	target = newMultiStoreWithInterBlockCache(t, target, &counters)
+	chunks, err = snapshotChunks(source, version, snapshottypes.CurrentFormat)
+	require.NoError(t, err)
+	require.NoError(t, target.WarmUpInterBlockCache(version, snapshottypes.CurrentFormat, chunks))
+	require.Zero(t, counters.warmedUp.value)
+	require.Equal(t, float64(6), counters.misses.value)
+
+	require.Equal(t, []byte{9}, target.GetKVStore(target.keysByName["iavl1"]).Get([]byte("b")))
+	require.Equal(t, float64(1), counters.hits.value)
+
+	// other formats are not supported
+	chunks, err = snapshotChunks(source, version, snapshottypes.CurrentFormat)
+	require.NoError(t, err)
+	err = target.WarmUpInterBlockCache(version, 9, chunks)
+	require.ErrorIs(t, err, snapshottypes.ErrUnknownFormat)
+}
@@ -0,0 +1,89 @@
+package streaming
+
+import (
+	"sync"
+
+	abci "github.com/line/ostracon/abci/types"
+
+	"github.com/line/lbm-sdk/store/types"
+	sdk "github.com/line/lbm-sdk/types"
+)
+
+// blockCollector assembles the BlockData of the block being executed from the
+// ABCI messages and the state changes passed to the hooks of the BaseApp. It
+// is safe for concurrent use.
+type blockCollector struct {
+	mtx   sync.Mutex
+	block *types.BlockData
+}
+
+var _ types.WriteListener = (*blockCollector)(nil)
+
+func newBlockCollector() *blockCollector {
+	return &blockCollector{block: &types.BlockData{}}
+}
+
+// ListenBeginBlock implements baseapp.ABCIListener.
+func (c *blockCollector) ListenBeginBlock(_ sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.block.Height = req.Header.Height
+	c.block.RequestBeginBlock = &req
+	c.block.ResponseBeginBlock = &res
+	return nil
+}
+
+// ListenDeliverTx implements baseapp.ABCIListener.
+func (c *blockCollector) ListenDeliverTx(_ sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.block.DeliverTxs = append(c.block.DeliverTxs, &types.DeliverTxData{Request: req, Response: res})
+	return nil
+}
+
+// ListenEndBlock implements baseapp.ABCIListener.
+func (c *blockCollector) ListenEndBlock(_ sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.block.RequestEndBlock = &req
+	c.block.ResponseEndBlock = &res
+	return nil
+}
+
+// OnWrite implements types.WriteListener.
+func (c *blockCollector) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.block.StateChanges = append(c.block.StateChanges, &types.StoreKVPair{
+		StoreKey: storeKey.Name(),
+		Delete:   delete,
+		Key:      append([]byte{}, key...),
+		Value:    append([]byte{}, value...),
+	})
+	return nil
+}
+
+// commit returns the data of the committed block and starts collecting the
+// data of the next one.
+func (c *blockCollector) commit(res abci.ResponseCommit) *types.BlockData {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	block := c.block
+	block.ResponseCommit = &res
+	c.block = &types.BlockData{}
+	return block
+}
+
+// listeners returns the collector as the listener of the stores of the keys.
+func (c *blockCollector) listeners(keys []types.StoreKey) map[types.StoreKey][]types.WriteListener {
+	listeners := make(map[types.StoreKey][]types.WriteListener, len(keys))
+	for _, key := range keys {
+		listeners[key] = []types.WriteListener{c}
+	}
+	return listeners
+}
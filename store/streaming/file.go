@@ -0,0 +1,87 @@
+package streaming
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	protoio "github.com/gogo/protobuf/io"
+	abci "github.com/line/ostracon/abci/types"
+
+	"github.com/line/lbm-sdk/baseapp"
+	"github.com/line/lbm-sdk/store/types"
+	sdk "github.com/line/lbm-sdk/types"
+)
+
+var _ baseapp.StreamingService = (*FileStreamingService)(nil)
+
+// FileStreamingService writes the data of each committed block to a file of
+// its own in the write directory, as a length-prefixed protobuf BlockData.
+type FileStreamingService struct {
+	*blockCollector
+
+	keys     []types.StoreKey
+	writeDir string
+	prefix   string
+}
+
+// NewFileStreamingService creates a FileStreamingService streaming the
+// changes of the stores of the keys. The files are named
+// <prefix>block-<height>.
+func NewFileStreamingService(writeDir, prefix string, keys []types.StoreKey) (*FileStreamingService, error) {
+	if err := os.MkdirAll(writeDir, 0755); err != nil {
+		return nil, err
+	}
+	// the check guards against the directory being read-only
+	f, err := ioutil.TempFile(writeDir, ".streaming")
+	if err != nil {
+		return nil, fmt.Errorf("write directory %s is not writable: %w", writeDir, err)
+	}
+	f.Close()
+	os.Remove(f.Name())
+
+	return &FileStreamingService{
+		blockCollector: newBlockCollector(),
+		keys:           keys,
+		writeDir:       writeDir,
+		prefix:         prefix,
+	}, nil
+}
+
+// Listeners implements baseapp.StreamingService.
+func (fss *FileStreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	return fss.listeners(fss.keys)
+}
+
+// ListenCommit implements baseapp.ABCIListener. It writes the data of the
+// committed block to its file.
+func (fss *FileStreamingService) ListenCommit(_ sdk.Context, res abci.ResponseCommit) error {
+	block := fss.commit(res)
+
+	f, err := os.OpenFile(fss.blockFile(block.Height), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	if err := protoio.NewDelimitedWriter(f).WriteMsg(block); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// Stream implements baseapp.StreamingService. The blocks are written as they
+// are committed, there is nothing to run.
+func (fss *FileStreamingService) Stream(_ *sync.WaitGroup) error {
+	return nil
+}
+
+// Close implements io.Closer.
+func (fss *FileStreamingService) Close() error {
+	return nil
+}
+
+func (fss *FileStreamingService) blockFile(height int64) string {
+	return filepath.Join(fss.writeDir, fmt.Sprintf("%sblock-%d", fss.prefix, height))
+}
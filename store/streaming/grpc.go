@@ -0,0 +1,135 @@
+package streaming
+
+import (
+	"net"
+	"sync"
+
+	abci "github.com/line/ostracon/abci/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/line/lbm-sdk/baseapp"
+	"github.com/line/lbm-sdk/store/types"
+	sdk "github.com/line/lbm-sdk/types"
+)
+
+// subscriberBuffer is the number of blocks buffered for a gRPC subscriber. A
+// subscriber falling further behind is disconnected so that it never holds
+// up the chain.
+const subscriberBuffer = 100
+
+var errSubscriberTooSlow = status.Error(codes.ResourceExhausted, "subscriber fell too far behind the chain")
+
+var (
+	_ baseapp.StreamingService   = (*GRPCStreamingService)(nil)
+	_ types.StateStreamingServer = (*GRPCStreamingService)(nil)
+)
+
+// GRPCStreamingService streams the data of each committed block to the
+// subscribers of its StateStreaming gRPC service.
+type GRPCStreamingService struct {
+	*blockCollector
+
+	keys    []types.StoreKey
+	address string
+	server  *grpc.Server
+
+	mtx         sync.Mutex
+	subscribers map[chan *types.BlockData]struct{}
+}
+
+// NewGRPCStreamingService creates a GRPCStreamingService streaming the
+// changes of the stores of the keys. Its server listens on the address once
+// Stream is called.
+func NewGRPCStreamingService(address string, keys []types.StoreKey) *GRPCStreamingService {
+	gss := &GRPCStreamingService{
+		blockCollector: newBlockCollector(),
+		keys:           keys,
+		address:        address,
+		server:         grpc.NewServer(),
+		subscribers:    make(map[chan *types.BlockData]struct{}),
+	}
+	types.RegisterStateStreamingServer(gss.server, gss)
+	return gss
+}
+
+// Listeners implements baseapp.StreamingService.
+func (gss *GRPCStreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	return gss.listeners(gss.keys)
+}
+
+// ListenCommit implements baseapp.ABCIListener. It sends the data of the
+// committed block to the subscribers.
+func (gss *GRPCStreamingService) ListenCommit(_ sdk.Context, res abci.ResponseCommit) error {
+	block := gss.commit(res)
+
+	gss.mtx.Lock()
+	defer gss.mtx.Unlock()
+
+	for ch := range gss.subscribers {
+		select {
+		case ch <- block:
+		default:
+			// the subscriber is too slow
+			delete(gss.subscribers, ch)
+			close(ch)
+		}
+	}
+	return nil
+}
+
+// Blocks implements types.StateStreamingServer.
+func (gss *GRPCStreamingService) Blocks(_ *types.BlocksRequest, stream types.StateStreaming_BlocksServer) error {
+	ch := make(chan *types.BlockData, subscriberBuffer)
+	gss.mtx.Lock()
+	gss.subscribers[ch] = struct{}{}
+	gss.mtx.Unlock()
+
+	defer func() {
+		gss.mtx.Lock()
+		defer gss.mtx.Unlock()
+		if _, ok := gss.subscribers[ch]; ok {
+			delete(gss.subscribers, ch)
+			close(ch)
+		}
+	}()
+
+	for {
+		select {
+		case block, ok := <-ch:
+			if !ok {
+				return errSubscriberTooSlow
+			}
+			if err := stream.Send(block); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+// Stream implements baseapp.StreamingService. It starts serving the
+// subscribers in the background.
+func (gss *GRPCStreamingService) Stream(wg *sync.WaitGroup) error {
+	lis, err := net.Listen("tcp", gss.address)
+	if err != nil {
+		return err
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		// the error returned once the server is stopped is of no interest
+		_ = gss.server.Serve(lis)
+	}()
+	return nil
+}
+
+// Close implements io.Closer. It stops the server, disconnecting the
+// subscribers.
+func (gss *GRPCStreamingService) Close() error {
+	gss.server.Stop()
+	return nil
+}
@@ -0,0 +1,112 @@
+package streaming
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cast"
+
+	"github.com/line/lbm-sdk/baseapp"
+	"github.com/line/lbm-sdk/client/flags"
+	"github.com/line/lbm-sdk/server/config"
+	servertypes "github.com/line/lbm-sdk/server/types"
+	"github.com/line/lbm-sdk/store/types"
+)
+
+// The streaming services.
+const (
+	FileStreamer = "file"
+	GRPCStreamer = "grpc"
+)
+
+// The options configuring the streaming services.
+const (
+	OptStreamers      = "streaming.streamers"
+	OptFileKeys       = "streaming.file.keys"
+	OptFileWriteDir   = "streaming.file.write-dir"
+	OptFilePrefix     = "streaming.file.prefix"
+	OptGRPCKeys       = "streaming.grpc.keys"
+	OptGRPCAddress    = "streaming.grpc.address"
+	allStoresWildcard = "*"
+)
+
+// LoadStreamingServices creates the streaming services enabled in the app
+// options, registers them with the BaseApp and starts them. The wait group
+// tracks their background routines. No service is returned when streaming is
+// not enabled.
+func LoadStreamingServices(
+	bApp *baseapp.BaseApp, appOpts servertypes.AppOptions, keys map[string]*types.KVStoreKey,
+) ([]baseapp.StreamingService, *sync.WaitGroup, error) {
+	var (
+		services []baseapp.StreamingService
+		wg       = new(sync.WaitGroup)
+	)
+
+	for _, streamer := range cast.ToStringSlice(appOpts.Get(OptStreamers)) {
+		var service baseapp.StreamingService
+
+		switch strings.ToLower(streamer) {
+		case FileStreamer:
+			storeKeys, err := selectStoreKeys(keys, cast.ToStringSlice(appOpts.Get(OptFileKeys)))
+			if err != nil {
+				return nil, nil, err
+			}
+			writeDir := cast.ToString(appOpts.Get(OptFileWriteDir))
+			if writeDir == "" {
+				writeDir = filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data", "streaming")
+			}
+			service, err = NewFileStreamingService(writeDir, cast.ToString(appOpts.Get(OptFilePrefix)), storeKeys)
+			if err != nil {
+				return nil, nil, err
+			}
+
+		case GRPCStreamer:
+			storeKeys, err := selectStoreKeys(keys, cast.ToStringSlice(appOpts.Get(OptGRPCKeys)))
+			if err != nil {
+				return nil, nil, err
+			}
+			address := cast.ToString(appOpts.Get(OptGRPCAddress))
+			if address == "" {
+				address = config.DefaultStreamingGRPCAddress
+			}
+			service = NewGRPCStreamingService(address, storeKeys)
+
+		default:
+			return nil, nil, fmt.Errorf("unknown streaming service %q", streamer)
+		}
+
+		bApp.SetStreamingService(service)
+		if err := service.Stream(wg); err != nil {
+			return nil, nil, err
+		}
+		services = append(services, service)
+	}
+
+	return services, wg, nil
+}
+
+// selectStoreKeys returns the keys of the named stores, all of them for the
+// wildcard.
+func selectStoreKeys(keys map[string]*types.KVStoreKey, names []string) ([]types.StoreKey, error) {
+	var selected []types.StoreKey
+	for _, name := range names {
+		if name == allStoresWildcard {
+			selected = selected[:0]
+			for _, key := range keys {
+				selected = append(selected, key)
+			}
+			break
+		}
+		key, ok := keys[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown store %q", name)
+		}
+		selected = append(selected, key)
+	}
+	// the order of the keys of a map is random
+	sort.Slice(selected, func(i, j int) bool { return selected[i].Name() < selected[j].Name() })
+	return selected, nil
+}
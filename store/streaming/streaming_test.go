@@ -0,0 +1,148 @@
+package streaming
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	protoio "github.com/gogo/protobuf/io"
+	abci "github.com/line/ostracon/abci/types"
+	ocproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/line/lbm-sdk/store/types"
+	sdk "github.com/line/lbm-sdk/types"
+)
+
+var (
+	testKey1 = types.NewKVStoreKey("store1")
+	testKey2 = types.NewKVStoreKey("store2")
+)
+
+// listenBlock passes the data of a block with a tx to the listener and
+// returns it.
+func listenBlock(t *testing.T, service interface {
+	ListenBeginBlock(sdk.Context, abci.RequestBeginBlock, abci.ResponseBeginBlock) error
+	ListenDeliverTx(sdk.Context, abci.RequestDeliverTx, abci.ResponseDeliverTx) error
+	ListenEndBlock(sdk.Context, abci.RequestEndBlock, abci.ResponseEndBlock) error
+	ListenCommit(sdk.Context, abci.ResponseCommit) error
+	Listeners() map[types.StoreKey][]types.WriteListener
+}, height int64) *types.BlockData {
+	ctx := sdk.Context{}
+	reqBegin := abci.RequestBeginBlock{Header: ocproto.Header{Height: height}}
+	reqTx := abci.RequestDeliverTx{Tx: []byte("tx")}
+	resTx := abci.ResponseDeliverTx{Code: 0, Data: []byte("data")}
+	reqEnd := abci.RequestEndBlock{Height: height}
+	resCommit := abci.ResponseCommit{Data: []byte("apphash")}
+
+	require.NoError(t, service.ListenBeginBlock(ctx, reqBegin, abci.ResponseBeginBlock{}))
+	require.NoError(t, service.ListenDeliverTx(ctx, reqTx, resTx))
+	for _, listener := range service.Listeners()[testKey1] {
+		require.NoError(t, listener.OnWrite(testKey1, []byte("key"), []byte("value"), false))
+		require.NoError(t, listener.OnWrite(testKey1, []byte("old"), nil, true))
+	}
+	require.NoError(t, service.ListenEndBlock(ctx, reqEnd, abci.ResponseEndBlock{}))
+	require.NoError(t, service.ListenCommit(ctx, resCommit))
+
+	return &types.BlockData{
+		Height:             height,
+		RequestBeginBlock:  &reqBegin,
+		ResponseBeginBlock: &abci.ResponseBeginBlock{},
+		DeliverTxs:         []*types.DeliverTxData{{Request: reqTx, Response: resTx}},
+		RequestEndBlock:    &reqEnd,
+		ResponseEndBlock:   &abci.ResponseEndBlock{},
+		ResponseCommit:     &resCommit,
+		StateChanges: []*types.StoreKVPair{
+			{StoreKey: testKey1.Name(), Key: []byte("key"), Value: []byte("value")},
+			{StoreKey: testKey1.Name(), Delete: true, Key: []byte("old")},
+		},
+	}
+}
+
+// requireBlockData checks that the block read back is the one expected.
+func requireBlockData(t *testing.T, expected, actual *types.BlockData) {
+	expectedBz, err := expected.Marshal()
+	require.NoError(t, err)
+	actualBz, err := actual.Marshal()
+	require.NoError(t, err)
+	require.Equal(t, expectedBz, actualBz)
+}
+
+func TestFileStreamingService(t *testing.T) {
+	dir := t.TempDir()
+	service, err := NewFileStreamingService(dir, "test-", []types.StoreKey{testKey1})
+	require.NoError(t, err)
+	require.Len(t, service.Listeners(), 1)
+	require.NoError(t, service.Stream(new(sync.WaitGroup)))
+
+	for height := int64(1); height <= 2; height++ {
+		expected := listenBlock(t, service, height)
+
+		f, err := os.Open(filepath.Join(dir, fmt.Sprintf("test-block-%d", height)))
+		require.NoError(t, err)
+		var block types.BlockData
+		require.NoError(t, protoio.NewDelimitedReader(f, 1<<20).ReadMsg(&block))
+		require.NoError(t, f.Close())
+		requireBlockData(t, expected, &block)
+	}
+	require.NoError(t, service.Close())
+}
+
+func TestGRPCStreamingService(t *testing.T) {
+	// reserve a free port for the server
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := lis.Addr().String()
+	require.NoError(t, lis.Close())
+
+	service := NewGRPCStreamingService(address, []types.StoreKey{testKey1, testKey2})
+	require.Len(t, service.Listeners(), 2)
+	wg := new(sync.WaitGroup)
+	require.NoError(t, service.Stream(wg))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, address, grpc.WithInsecure(), grpc.WithBlock())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	stream, err := types.NewStateStreamingClient(conn).Blocks(ctx, &types.BlocksRequest{})
+	require.NoError(t, err)
+	// the block is only sent to the subscribers known when it is committed
+	require.Eventually(t, func() bool {
+		service.mtx.Lock()
+		defer service.mtx.Unlock()
+		return len(service.subscribers) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	for height := int64(1); height <= 2; height++ {
+		expected := listenBlock(t, service, height)
+		block, err := stream.Recv()
+		require.NoError(t, err)
+		requireBlockData(t, expected, block)
+	}
+
+	require.NoError(t, service.Close())
+	wg.Wait()
+}
+
+func TestSelectStoreKeys(t *testing.T) {
+	keys := map[string]*types.KVStoreKey{"store1": testKey1, "store2": testKey2}
+
+	selected, err := selectStoreKeys(keys, []string{"*"})
+	require.NoError(t, err)
+	require.Equal(t, []types.StoreKey{testKey1, testKey2}, selected)
+
+	selected, err = selectStoreKeys(keys, []string{"store2"})
+	require.NoError(t, err)
+	require.Equal(t, []types.StoreKey{testKey2}, selected)
+
+	_, err = selectStoreKeys(keys, []string{"store3"})
+	require.Error(t, err)
+}
@@ -0,0 +1,1534 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lbm/base/store/v1beta1/listening.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/line/ostracon/abci/types"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// StoreKVPair is a KVStore KVPair used for listening to state changes (Sets and Deletes).
+// It includes the name of the StoreKey of the originating KVStore and a flag to distinguish
+// between Sets and Deletes.
+type StoreKVPair struct {
+	StoreKey string `protobuf:"bytes,1,opt,name=store_key,json=storeKey,proto3" json:"store_key,omitempty"`
+	Delete   bool   `protobuf:"varint,2,opt,name=delete,proto3" json:"delete,omitempty"`
+	Key      []byte `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Value    []byte `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *StoreKVPair) Reset()         { *m = StoreKVPair{} }
+func (m *StoreKVPair) String() string { return proto.CompactTextString(m) }
+func (*StoreKVPair) ProtoMessage()    {}
+func (*StoreKVPair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4b0b1fd35645b36, []int{0}
+}
+func (m *StoreKVPair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoreKVPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoreKVPair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StoreKVPair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreKVPair.Merge(m, src)
+}
+func (m *StoreKVPair) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoreKVPair) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreKVPair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreKVPair proto.InternalMessageInfo
+
+func (m *StoreKVPair) GetStoreKey() string {
+	if m != nil {
+		return m.StoreKey
+	}
+	return ""
+}
+
+func (m *StoreKVPair) GetDelete() bool {
+	if m != nil {
+		return m.Delete
+	}
+	return false
+}
+
+func (m *StoreKVPair) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *StoreKVPair) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+// BlockData is the data of a committed block streamed to the listeners: the ABCI requests
+// and responses of the block and the state changes it committed.
+type BlockData struct {
+	Height             int64                     `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	RequestBeginBlock  *types.RequestBeginBlock  `protobuf:"bytes,2,opt,name=request_begin_block,json=requestBeginBlock,proto3" json:"request_begin_block,omitempty"`
+	ResponseBeginBlock *types.ResponseBeginBlock `protobuf:"bytes,3,opt,name=response_begin_block,json=responseBeginBlock,proto3" json:"response_begin_block,omitempty"`
+	DeliverTxs         []*DeliverTxData          `protobuf:"bytes,4,rep,name=deliver_txs,json=deliverTxs,proto3" json:"deliver_txs,omitempty"`
+	RequestEndBlock    *types.RequestEndBlock    `protobuf:"bytes,5,opt,name=request_end_block,json=requestEndBlock,proto3" json:"request_end_block,omitempty"`
+	ResponseEndBlock   *types.ResponseEndBlock   `protobuf:"bytes,6,opt,name=response_end_block,json=responseEndBlock,proto3" json:"response_end_block,omitempty"`
+	ResponseCommit     *types.ResponseCommit     `protobuf:"bytes,7,opt,name=response_commit,json=responseCommit,proto3" json:"response_commit,omitempty"`
+	StateChanges       []*StoreKVPair            `protobuf:"bytes,8,rep,name=state_changes,json=stateChanges,proto3" json:"state_changes,omitempty"`
+}
+
+func (m *BlockData) Reset()         { *m = BlockData{} }
+func (m *BlockData) String() string { return proto.CompactTextString(m) }
+func (*BlockData) ProtoMessage()    {}
+func (*BlockData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4b0b1fd35645b36, []int{1}
+}
+func (m *BlockData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockData.Merge(m, src)
+}
+func (m *BlockData) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockData) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockData proto.InternalMessageInfo
+
+func (m *BlockData) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockData) GetRequestBeginBlock() *types.RequestBeginBlock {
+	if m != nil {
+		return m.RequestBeginBlock
+	}
+	return nil
+}
+
+func (m *BlockData) GetResponseBeginBlock() *types.ResponseBeginBlock {
+	if m != nil {
+		return m.ResponseBeginBlock
+	}
+	return nil
+}
+
+func (m *BlockData) GetDeliverTxs() []*DeliverTxData {
+	if m != nil {
+		return m.DeliverTxs
+	}
+	return nil
+}
+
+func (m *BlockData) GetRequestEndBlock() *types.RequestEndBlock {
+	if m != nil {
+		return m.RequestEndBlock
+	}
+	return nil
+}
+
+func (m *BlockData) GetResponseEndBlock() *types.ResponseEndBlock {
+	if m != nil {
+		return m.ResponseEndBlock
+	}
+	return nil
+}
+
+func (m *BlockData) GetResponseCommit() *types.ResponseCommit {
+	if m != nil {
+		return m.ResponseCommit
+	}
+	return nil
+}
+
+func (m *BlockData) GetStateChanges() []*StoreKVPair {
+	if m != nil {
+		return m.StateChanges
+	}
+	return nil
+}
+
+// DeliverTxData is the ABCI request and response of a tx of a block.
+type DeliverTxData struct {
+	Request  types.RequestDeliverTx  `protobuf:"bytes,1,opt,name=request,proto3" json:"request"`
+	Response types.ResponseDeliverTx `protobuf:"bytes,2,opt,name=response,proto3" json:"response"`
+}
+
+func (m *DeliverTxData) Reset()         { *m = DeliverTxData{} }
+func (m *DeliverTxData) String() string { return proto.CompactTextString(m) }
+func (*DeliverTxData) ProtoMessage()    {}
+func (*DeliverTxData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4b0b1fd35645b36, []int{2}
+}
+func (m *DeliverTxData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeliverTxData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeliverTxData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeliverTxData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeliverTxData.Merge(m, src)
+}
+func (m *DeliverTxData) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeliverTxData) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeliverTxData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeliverTxData proto.InternalMessageInfo
+
+func (m *DeliverTxData) GetRequest() types.RequestDeliverTx {
+	if m != nil {
+		return m.Request
+	}
+	return types.RequestDeliverTx{}
+}
+
+func (m *DeliverTxData) GetResponse() types.ResponseDeliverTx {
+	if m != nil {
+		return m.Response
+	}
+	return types.ResponseDeliverTx{}
+}
+
+// BlocksRequest is the request type for the StateStreaming/Blocks RPC method.
+type BlocksRequest struct {
+}
+
+func (m *BlocksRequest) Reset()         { *m = BlocksRequest{} }
+func (m *BlocksRequest) String() string { return proto.CompactTextString(m) }
+func (*BlocksRequest) ProtoMessage()    {}
+func (*BlocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4b0b1fd35645b36, []int{3}
+}
+func (m *BlocksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlocksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlocksRequest.Merge(m, src)
+}
+func (m *BlocksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlocksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlocksRequest proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*StoreKVPair)(nil), "lbm.base.store.v1beta1.StoreKVPair")
+	proto.RegisterType((*BlockData)(nil), "lbm.base.store.v1beta1.BlockData")
+	proto.RegisterType((*DeliverTxData)(nil), "lbm.base.store.v1beta1.DeliverTxData")
+	proto.RegisterType((*BlocksRequest)(nil), "lbm.base.store.v1beta1.BlocksRequest")
+}
+
+func init() {
+	proto.RegisterFile("lbm/base/store/v1beta1/listening.proto", fileDescriptor_f4b0b1fd35645b36)
+}
+
+var fileDescriptor_f4b0b1fd35645b36 = []byte{
+	// 564 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0x63, 0x92, 0xa6, 0xc9, 0xa6, 0x69, 0xca, 0x12, 0x55, 0x26, 0x08, 0x37, 0x4d, 0x05,
+	0xca, 0x05, 0x9b, 0x86, 0x33, 0x42, 0x4a, 0x4b, 0x85, 0xa8, 0x90, 0x2a, 0xa7, 0xe2, 0xc0, 0xc5,
+	0xf2, 0x9f, 0xc1, 0x59, 0x62, 0x7b, 0xc3, 0xee, 0x26, 0x6a, 0xde, 0x82, 0x03, 0xef, 0xc3, 0xb5,
+	0xc7, 0x1e, 0x39, 0x21, 0x94, 0xbc, 0x08, 0xda, 0xb5, 0x13, 0x25, 0x69, 0xcd, 0xcd, 0x33, 0xfb,
+	0xcd, 0x6f, 0xe7, 0x1b, 0xaf, 0x06, 0xbd, 0x8c, 0xbc, 0xd8, 0xf2, 0x5c, 0x0e, 0x16, 0x17, 0x94,
+	0x81, 0x35, 0x3d, 0xf5, 0x40, 0xb8, 0xa7, 0x56, 0x44, 0xb8, 0x80, 0x84, 0x24, 0xa1, 0x39, 0x66,
+	0x54, 0x50, 0x7c, 0x18, 0x79, 0xb1, 0x29, 0x75, 0xa6, 0xd2, 0x99, 0x99, 0xae, 0xd5, 0x0c, 0x69,
+	0x48, 0x95, 0xc4, 0x92, 0x5f, 0xa9, 0xba, 0xf5, 0x94, 0x72, 0xc1, 0x5c, 0x9f, 0x26, 0x96, 0xeb,
+	0xf9, 0xc4, 0x12, 0xb3, 0x31, 0xf0, 0xf4, 0xa8, 0xf3, 0x0d, 0xd5, 0x06, 0x92, 0x70, 0xf9, 0xf9,
+	0xca, 0x25, 0x0c, 0x3f, 0x43, 0x55, 0x05, 0x74, 0x46, 0x30, 0xd3, 0xb5, 0xb6, 0xd6, 0xad, 0xda,
+	0x15, 0x95, 0xb8, 0x84, 0x19, 0x3e, 0x44, 0xe5, 0x00, 0x22, 0x10, 0xa0, 0x3f, 0x6a, 0x6b, 0xdd,
+	0x8a, 0x9d, 0x45, 0xf8, 0x00, 0x15, 0xa5, 0xbc, 0xd8, 0xd6, 0xba, 0x7b, 0xb6, 0xfc, 0xc4, 0x4d,
+	0xb4, 0x33, 0x75, 0xa3, 0x09, 0xe8, 0x25, 0x95, 0x4b, 0x83, 0xce, 0xaf, 0x12, 0xaa, 0xf6, 0x23,
+	0xea, 0x8f, 0xce, 0x5d, 0xe1, 0x4a, 0xda, 0x10, 0x48, 0x38, 0x14, 0xea, 0x9e, 0xa2, 0x9d, 0x45,
+	0xf8, 0x0a, 0x3d, 0x61, 0xf0, 0x7d, 0x02, 0x5c, 0x38, 0x1e, 0x84, 0x24, 0x71, 0x3c, 0x59, 0xa2,
+	0xae, 0xac, 0xf5, 0xda, 0xe6, 0xd2, 0x8a, 0x29, 0xad, 0x98, 0x76, 0xaa, 0xec, 0x4b, 0xa1, 0x42,
+	0xdb, 0x8f, 0xd9, 0x76, 0x0a, 0x0f, 0x50, 0x93, 0x01, 0x1f, 0xd3, 0x84, 0xc3, 0x06, 0xb2, 0xa8,
+	0x90, 0xc7, 0xf7, 0x90, 0xa9, 0x74, 0x8d, 0x89, 0xd9, 0xbd, 0x1c, 0xbe, 0x40, 0xb5, 0x00, 0x22,
+	0x32, 0x05, 0xe6, 0x88, 0x1b, 0xae, 0x97, 0xda, 0xc5, 0x6e, 0xad, 0xf7, 0xc2, 0x7c, 0xf8, 0xbf,
+	0x98, 0xe7, 0xa9, 0xf4, 0xfa, 0x46, 0x5a, 0xb7, 0x51, 0xb0, 0x0c, 0x39, 0xfe, 0x88, 0x96, 0x1d,
+	0x3b, 0x90, 0x04, 0x59, 0x67, 0x3b, 0xaa, 0x33, 0xe3, 0x61, 0xb3, 0xef, 0x93, 0x20, 0x6d, 0xab,
+	0xc1, 0x36, 0x13, 0xf8, 0x13, 0x5a, 0x75, 0xba, 0x06, 0x2b, 0x2b, 0xd8, 0x51, 0x8e, 0xcd, 0x15,
+	0xed, 0x80, 0x6d, 0x65, 0xf0, 0x05, 0x6a, 0xac, 0x70, 0x3e, 0x8d, 0x63, 0x22, 0xf4, 0x5d, 0xc5,
+	0x7a, 0x9e, 0xc3, 0x3a, 0x53, 0x22, 0x7b, 0x9f, 0x6d, 0xc4, 0xf8, 0x03, 0xaa, 0x73, 0xe1, 0x0a,
+	0x70, 0xfc, 0xa1, 0x9b, 0x84, 0xc0, 0xf5, 0x8a, 0x1a, 0xd6, 0x49, 0xde, 0xb0, 0xd6, 0x1e, 0xa4,
+	0xbd, 0xa7, 0x2a, 0xcf, 0xd2, 0xc2, 0xce, 0x4f, 0x0d, 0xd5, 0x37, 0x46, 0x89, 0xdf, 0xa1, 0xdd,
+	0x6c, 0x0a, 0xba, 0x96, 0xe3, 0x53, 0x9d, 0xae, 0xaa, 0xfa, 0xa5, 0xdb, 0x3f, 0x47, 0x05, 0x7b,
+	0x59, 0x85, 0xfb, 0xa8, 0xb2, 0x6c, 0x37, 0xf7, 0x8d, 0xa5, 0xc7, 0xdb, 0x88, 0x55, 0x5d, 0xa7,
+	0x81, 0xea, 0x6a, 0x62, 0x3c, 0xbb, 0xac, 0xf7, 0x15, 0xed, 0x0f, 0x64, 0xdf, 0x03, 0xc1, 0xc0,
+	0x8d, 0x49, 0x12, 0xe2, 0x6b, 0x54, 0x4e, 0x25, 0x38, 0xf7, 0x8d, 0x6c, 0x20, 0x5a, 0xc7, 0xff,
+	0x95, 0x49, 0xef, 0xaf, 0xb5, 0xfe, 0xdb, 0xdb, 0xb9, 0xa1, 0xdd, 0xcd, 0x0d, 0xed, 0xef, 0xdc,
+	0xd0, 0x7e, 0x2c, 0x8c, 0xc2, 0xdd, 0xc2, 0x28, 0xfc, 0x5e, 0x18, 0x85, 0x2f, 0x27, 0x21, 0x11,
+	0xc3, 0x89, 0x67, 0xfa, 0x34, 0xb6, 0x22, 0x92, 0x80, 0x15, 0x79, 0xf1, 0x2b, 0x1e, 0x8c, 0xb2,
+	0xbd, 0xa2, 0x56, 0x80, 0x57, 0x56, 0x3b, 0xe0, 0xcd, 0xbf, 0x01, 0x00, 0x3e, 0xb4, 0x31, 0xed,
+	0x76, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// StateStreamingClient is the client API for StateStreaming service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type StateStreamingClient interface {
+	// Blocks streams the data of the blocks committed after the request.
+	Blocks(ctx context.Context, in *BlocksRequest, opts ...grpc.CallOption) (StateStreaming_BlocksClient, error)
+}
+
+type stateStreamingClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewStateStreamingClient(cc grpc1.ClientConn) StateStreamingClient {
+	return &stateStreamingClient{cc}
+}
+
+func (c *stateStreamingClient) Blocks(ctx context.Context, in *BlocksRequest, opts ...grpc.CallOption) (StateStreaming_BlocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_StateStreaming_serviceDesc.Streams[0], "/lbm.base.store.v1beta1.StateStreaming/Blocks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &stateStreamingBlocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StateStreaming_BlocksClient interface {
+	Recv() (*BlockData, error)
+	grpc.ClientStream
+}
+
+type stateStreamingBlocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *stateStreamingBlocksClient) Recv() (*BlockData, error) {
+	m := new(BlockData)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// StateStreamingServer is the server API for StateStreaming service.
+type StateStreamingServer interface {
+	// Blocks streams the data of the blocks committed after the request.
+	Blocks(*BlocksRequest, StateStreaming_BlocksServer) error
+}
+
+// UnimplementedStateStreamingServer can be embedded to have forward compatible implementations.
+type UnimplementedStateStreamingServer struct {
+}
+
+func (*UnimplementedStateStreamingServer) Blocks(req *BlocksRequest, srv StateStreaming_BlocksServer) error {
+	return status.Errorf(codes.Unimplemented, "method Blocks not implemented")
+}
+
+func RegisterStateStreamingServer(s grpc1.Server, srv StateStreamingServer) {
+	s.RegisterService(&_StateStreaming_serviceDesc, srv)
+}
+
+func _StateStreaming_Blocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BlocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StateStreamingServer).Blocks(m, &stateStreamingBlocksServer{stream})
+}
+
+type StateStreaming_BlocksServer interface {
+	Send(*BlockData) error
+	grpc.ServerStream
+}
+
+type stateStreamingBlocksServer struct {
+	grpc.ServerStream
+}
+
+func (x *stateStreamingBlocksServer) Send(m *BlockData) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _StateStreaming_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lbm.base.store.v1beta1.StateStreaming",
+	HandlerType: (*StateStreamingServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Blocks",
+			Handler:       _StateStreaming_Blocks_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "lbm/base/store/v1beta1/listening.proto",
+}
+
+func (m *StoreKVPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreKVPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreKVPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintListening(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintListening(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Delete {
+		i--
+		if m.Delete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StoreKey) > 0 {
+		i -= len(m.StoreKey)
+		copy(dAtA[i:], m.StoreKey)
+		i = encodeVarintListening(dAtA, i, uint64(len(m.StoreKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StateChanges) > 0 {
+		for iNdEx := len(m.StateChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StateChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintListening(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.ResponseCommit != nil {
+		{
+			size, err := m.ResponseCommit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintListening(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ResponseEndBlock != nil {
+		{
+			size, err := m.ResponseEndBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintListening(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.RequestEndBlock != nil {
+		{
+			size, err := m.RequestEndBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintListening(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DeliverTxs) > 0 {
+		for iNdEx := len(m.DeliverTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeliverTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintListening(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ResponseBeginBlock != nil {
+		{
+			size, err := m.ResponseBeginBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintListening(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RequestBeginBlock != nil {
+		{
+			size, err := m.RequestBeginBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintListening(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintListening(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeliverTxData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeliverTxData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeliverTxData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintListening(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintListening(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BlocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintListening(dAtA []byte, offset int, v uint64) int {
+	offset -= sovListening(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StoreKVPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StoreKey)
+	if l > 0 {
+		n += 1 + l + sovListening(uint64(l))
+	}
+	if m.Delete {
+		n += 2
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovListening(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovListening(uint64(l))
+	}
+	return n
+}
+
+func (m *BlockData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovListening(uint64(m.Height))
+	}
+	if m.RequestBeginBlock != nil {
+		l = m.RequestBeginBlock.Size()
+		n += 1 + l + sovListening(uint64(l))
+	}
+	if m.ResponseBeginBlock != nil {
+		l = m.ResponseBeginBlock.Size()
+		n += 1 + l + sovListening(uint64(l))
+	}
+	if len(m.DeliverTxs) > 0 {
+		for _, e := range m.DeliverTxs {
+			l = e.Size()
+			n += 1 + l + sovListening(uint64(l))
+		}
+	}
+	if m.RequestEndBlock != nil {
+		l = m.RequestEndBlock.Size()
+		n += 1 + l + sovListening(uint64(l))
+	}
+	if m.ResponseEndBlock != nil {
+		l = m.ResponseEndBlock.Size()
+		n += 1 + l + sovListening(uint64(l))
+	}
+	if m.ResponseCommit != nil {
+		l = m.ResponseCommit.Size()
+		n += 1 + l + sovListening(uint64(l))
+	}
+	if len(m.StateChanges) > 0 {
+		for _, e := range m.StateChanges {
+			l = e.Size()
+			n += 1 + l + sovListening(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeliverTxData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Request.Size()
+	n += 1 + l + sovListening(uint64(l))
+	l = m.Response.Size()
+	n += 1 + l + sovListening(uint64(l))
+	return n
+}
+
+func (m *BlocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovListening(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozListening(x uint64) (n int) {
+	return sovListening(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StoreKVPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowListening
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoreKVPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoreKVPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowListening
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthListening
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthListening
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowListening
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Delete = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowListening
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthListening
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthListening
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowListening
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthListening
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthListening
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipListening(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthListening
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowListening
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowListening
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestBeginBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowListening
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthListening
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthListening
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RequestBeginBlock == nil {
+				m.RequestBeginBlock = &types.RequestBeginBlock{}
+			}
+			if err := m.RequestBeginBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseBeginBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowListening
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthListening
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthListening
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResponseBeginBlock == nil {
+				m.ResponseBeginBlock = &types.ResponseBeginBlock{}
+			}
+			if err := m.ResponseBeginBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliverTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowListening
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthListening
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthListening
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeliverTxs = append(m.DeliverTxs, &DeliverTxData{})
+			if err := m.DeliverTxs[len(m.DeliverTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestEndBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowListening
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthListening
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthListening
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RequestEndBlock == nil {
+				m.RequestEndBlock = &types.RequestEndBlock{}
+			}
+			if err := m.RequestEndBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseEndBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowListening
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthListening
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthListening
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResponseEndBlock == nil {
+				m.ResponseEndBlock = &types.ResponseEndBlock{}
+			}
+			if err := m.ResponseEndBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowListening
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthListening
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthListening
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResponseCommit == nil {
+				m.ResponseCommit = &types.ResponseCommit{}
+			}
+			if err := m.ResponseCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowListening
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthListening
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthListening
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateChanges = append(m.StateChanges, &StoreKVPair{})
+			if err := m.StateChanges[len(m.StateChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipListening(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthListening
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeliverTxData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowListening
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeliverTxData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeliverTxData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowListening
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthListening
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthListening
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowListening
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthListening
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthListening
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipListening(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthListening
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlocksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowListening
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlocksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipListening(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthListening
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipListening(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowListening
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowListening
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowListening
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthListening
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupListening
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthListening
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthListening        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowListening          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupListening = fmt.Errorf("proto: unexpected end of group")
+)
@@ -190,6 +190,17 @@ type CommitMultiStore interface {
 	// SetIAVLCacheManager sets the CacheManager that is holding nodedb cache of IAVL tree
 	// If a cacheManager is not set, then IAVL tree does not use cache
 	SetIAVLCacheManager(cacheManager CacheManager)
+
+	// SetStateArchive sets the db archiving every committed version of the IAVL
+	// stores, so that the pruned versions can still be queried without proofs.
+	SetStateArchive(db tmdb.DB)
+
+	// AddListeners adds WriteListeners for the KVStore belonging to the provided StoreKey.
+	// It appends the listeners to the current set, if one already exists.
+	AddListeners(key StoreKey, listeners []WriteListener)
+
+	// ListeningEnabled returns if listening is enabled for the KVStore belonging to the provided StoreKey.
+	ListeningEnabled(key StoreKey) bool
 }
 
 //---------subsp-------------------------------
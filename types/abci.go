@@ -17,5 +17,12 @@ type BeginBlocker func(ctx Context, req abci.RequestBeginBlock) abci.ResponseBeg
 // e.g. BFT timestamps rather than block height for any periodic EndBlock logic
 type EndBlocker func(ctx Context, req abci.RequestEndBlock) abci.ResponseEndBlock
 
+// BeginRecheckTxHandler runs code before the txs in the mempool are checked again
+// after a block is committed
+type BeginRecheckTxHandler func(ctx Context, req abci.RequestBeginRecheckTx)
+
+// CommitHandler runs code after the state of a block is committed
+type CommitHandler func(ctx Context, res abci.ResponseCommit)
+
 // PeerFilter responds to p2p filtering queries from Tendermint
 type PeerFilter func(info string) abci.ResponseQuery
@@ -36,6 +36,7 @@ type Context struct {
 	minGasPrice   DecCoins
 	consParams    *abci.ConsensusParams
 	eventManager  *EventManager
+	deferredFees  *DeferredFees
 }
 
 // Proposed rename, not done to avoid API breakage
@@ -56,6 +57,7 @@ func (c Context) IsCheckTx() bool             { return c.checkTx }
 func (c Context) IsReCheckTx() bool           { return c.recheckTx }
 func (c Context) MinGasPrices() DecCoins      { return c.minGasPrice }
 func (c Context) EventManager() *EventManager { return c.eventManager }
+func (c Context) DeferredFees() *DeferredFees { return c.deferredFees }
 
 // clone the header before returning
 func (c Context) BlockHeader() ocproto.Header {
@@ -196,6 +198,13 @@ func (c Context) WithEventManager(em *EventManager) Context {
 	return c
 }
 
+// WithDeferredFees returns a Context deferring the credit of the fees paid by
+// the tx to fees. A nil value credits the fees right away.
+func (c Context) WithDeferredFees(fees *DeferredFees) Context {
+	c.deferredFees = fees
+	return c
+}
+
 // TODO: remove???
 func (c Context) IsZero() bool {
 	return c.ms == nil
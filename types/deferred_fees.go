@@ -0,0 +1,34 @@
+package types
+
+// DeferredFees collects the credits of the fees paid by a tx to the fee
+// collector when they are not applied by the tx itself but later, on the state
+// the tx is merged into. Txs executed in parallel would otherwise all read and
+// write the balance of the fee collector.
+type DeferredFees struct {
+	credits []func(ctx Context) error
+}
+
+// Defer records a fee credit to be applied by Credit.
+func (f *DeferredFees) Defer(credit func(ctx Context) error) {
+	f.credits = append(f.credits, credit)
+}
+
+// Empty returns whether no fee credit was deferred.
+func (f *DeferredFees) Empty() bool {
+	return f == nil || len(f.credits) == 0
+}
+
+// Credit applies the deferred fee credits in the order they were deferred.
+func (f *DeferredFees) Credit(ctx Context) error {
+	if f == nil {
+		return nil
+	}
+
+	for _, credit := range f.credits {
+		if err := credit(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
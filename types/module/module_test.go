@@ -10,6 +10,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/gorilla/mux"
 	abci "github.com/line/ostracon/abci/types"
+	"github.com/line/ostracon/libs/log"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
 
@@ -179,7 +180,7 @@ func TestManager_RegisterQueryServices(t *testing.T) {
 
 	msgRouter := mocks.NewMockServer(mockCtrl)
 	queryRouter := mocks.NewMockServer(mockCtrl)
-	cfg := module.NewConfigurator(msgRouter, queryRouter)
+	cfg := module.NewConfigurator(nil, msgRouter, queryRouter)
 	mockAppModule1.EXPECT().RegisterServices(cfg).Times(1)
 	mockAppModule2.EXPECT().RegisterServices(cfg).Times(1)
 
@@ -282,3 +283,75 @@ func TestManager_EndBlock(t *testing.T) {
 	mockAppModule2.EXPECT().EndBlock(gomock.Any(), gomock.Eq(req)).Times(1).Return([]abci.ValidatorUpdate{{}})
 	require.Panics(t, func() { mm.EndBlock(sdk.Context{}, req) })
 }
+
+func TestConfigurator_RegisterMigration(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	cfg := module.NewConfigurator(nil, mocks.NewMockServer(mockCtrl), mocks.NewMockServer(mockCtrl))
+	noop := func(sdk.Context) error { return nil }
+
+	require.Error(t, cfg.RegisterMigration("module1", 0, noop))
+	require.NoError(t, cfg.RegisterMigration("module1", 1, noop))
+	require.Error(t, cfg.RegisterMigration("module1", 1, noop))
+	require.NoError(t, cfg.RegisterMigration("module1", 2, noop))
+	require.NoError(t, cfg.RegisterMigration("module2", 1, noop))
+}
+
+func TestManager_RunMigrations(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	mockAppModule1 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule2 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule1.EXPECT().Name().AnyTimes().Return("module1")
+	mockAppModule2.EXPECT().Name().AnyTimes().Return("module2")
+	mockAppModule1.EXPECT().ConsensusVersion().AnyTimes().Return(uint64(3))
+	mockAppModule2.EXPECT().ConsensusVersion().AnyTimes().Return(uint64(1))
+	mm := module.NewManager(mockAppModule1, mockAppModule2)
+	require.Equal(t, module.VersionMap{"module1": 3, "module2": 1}, mm.GetVersionMap())
+
+	ctx := sdk.Context{}.WithLogger(log.NewNopLogger())
+	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
+	cfg := module.NewConfigurator(cdc, mocks.NewMockServer(mockCtrl), mocks.NewMockServer(mockCtrl))
+
+	// a configurator of another type is rejected
+	_, err := mm.RunMigrations(ctx, struct{ module.Configurator }{cfg}, module.VersionMap{})
+	require.Error(t, err)
+
+	// the migration from version 2 to 3 of module1 is missing
+	var migrated []uint64
+	require.NoError(t, cfg.RegisterMigration("module1", 1, func(sdk.Context) error {
+		migrated = append(migrated, 1)
+		return nil
+	}))
+	_, err = mm.RunMigrations(ctx, cfg, module.VersionMap{"module1": 1, "module2": 1})
+	require.Error(t, err)
+
+	require.NoError(t, cfg.RegisterMigration("module1", 2, func(sdk.Context) error {
+		migrated = append(migrated, 2)
+		return nil
+	}))
+
+	// module2 is new, its genesis is initialized
+	genesis := json.RawMessage(`{"key": "value"}`)
+	mockAppModule2.EXPECT().DefaultGenesis(gomock.Eq(cdc)).Times(1).Return(genesis)
+	mockAppModule2.EXPECT().InitGenesis(gomock.Eq(ctx), gomock.Eq(cdc), gomock.Eq(genesis)).Times(1).Return(nil)
+
+	migrated = nil
+	vm, err := mm.RunMigrations(ctx, cfg, module.VersionMap{"module1": 1})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2}, migrated)
+	require.Equal(t, module.VersionMap{"module1": 3, "module2": 1}, vm)
+
+	// up to date modules are not migrated
+	migrated = nil
+	vm, err = mm.RunMigrations(ctx, cfg, vm)
+	require.NoError(t, err)
+	require.Empty(t, migrated)
+	require.Equal(t, module.VersionMap{"module1": 3, "module2": 1}, vm)
+
+	// modules cannot be downgraded
+	_, err = mm.RunMigrations(ctx, cfg, module.VersionMap{"module1": 4, "module2": 1})
+	require.Error(t, err)
+}
@@ -2,19 +2,53 @@ package ante
 
 import (
 	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
 	"github.com/line/lbm-sdk/x/auth/signing"
 	"github.com/line/lbm-sdk/x/auth/types"
 )
 
+// HandlerOptions are the options required for constructing a default SDK AnteHandler.
+type HandlerOptions struct {
+	AccountKeeper   AccountKeeper
+	BankKeeper      types.BankKeeper
+	SignModeHandler signing.SignModeHandler
+
+	// FeegrantKeeper may be nil for chains that do not support fee grants.
+	FeegrantKeeper FeegrantKeeper
+	// TxReplacements may be nil for chains that do not let a pending tx be
+	// replaced by a tx paying a higher fee.
+	TxReplacements *TxReplacements
+	// SigVerificationCache keeps the txs verified in CheckTx and should be
+	// pruned by setting its Commit as the commit handler of the app; if nil, a
+	// cache only bounded by its size is used.
+	SigVerificationCache *SigVerificationCache
+	// SigGasConsumer defaults to DefaultSigVerificationGasConsumer if nil.
+	SigGasConsumer SignatureVerificationGasConsumer
+}
+
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
 // numbers, checks signatures & sig block height, and deducts fees from the first
-// signer.
-func NewAnteHandler(
-	ak AccountKeeper, bankKeeper types.BankKeeper,
-	sigGasConsumer SignatureVerificationGasConsumer,
-	signModeHandler signing.SignModeHandler,
-) sdk.AnteHandler {
-	return sdk.ChainAnteDecorators(
+// signer or, if set, from the fee granter.
+func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
+	if options.AccountKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "account keeper is required for ante builder")
+	}
+
+	if options.BankKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "bank keeper is required for ante builder")
+	}
+
+	if options.SignModeHandler == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
+	}
+
+	sigGasConsumer := options.SigGasConsumer
+	if sigGasConsumer == nil {
+		sigGasConsumer = DefaultSigVerificationGasConsumer
+	}
+
+	ak := options.AccountKeeper
+	anteDecorators := []sdk.AnteDecorator{
 		NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		NewRejectExtensionOptionsDecorator(),
 		NewMempoolFeeDecorator(),
@@ -23,14 +57,16 @@ func NewAnteHandler(
 		TxTimeoutHeightDecorator{},
 		NewValidateMemoDecorator(ak),
 		NewConsumeGasForTxSizeDecorator(ak),
-		NewRejectFeeGranterDecorator(),
 		// The above handlers should not call `GetAccount` or `GetSignerAcc` for signer
 		NewSetPubKeyDecorator(ak), // SetPubKeyDecorator must be called before all signature verification decorators
 		// The handlers below may call `GetAccount` or `GetSignerAcc` for signer
 		NewValidateSigCountDecorator(ak),
-		NewDeductFeeDecorator(ak, bankKeeper),
+		NewTxReplacementDecorator(ak, options.BankKeeper, options.TxReplacements), // TxReplacementDecorator must be called before DeductFeeDecorator
+		NewDeductFeeDecorator(ak, options.BankKeeper, options.FeegrantKeeper),
 		NewSigGasConsumeDecorator(ak, sigGasConsumer),
-		NewSigVerificationDecorator(ak, signModeHandler),
+		newSigVerificationDecorator(ak, options.SignModeHandler, options.SigVerificationCache),
 		NewIncrementSequenceDecorator(ak),
-	)
+	}
+
+	return sdk.ChainAnteDecorators(anteDecorators...), nil
 }
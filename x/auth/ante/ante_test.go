@@ -12,6 +12,7 @@ import (
 	"github.com/line/lbm-sdk/crypto/keys/ed25519"
 	kmultisig "github.com/line/lbm-sdk/crypto/keys/multisig"
 	"github.com/line/lbm-sdk/crypto/keys/secp256k1"
+	"github.com/line/lbm-sdk/crypto/keys/secp256r1"
 	cryptotypes "github.com/line/lbm-sdk/crypto/types"
 	"github.com/line/lbm-sdk/testutil/testdata"
 	sdk "github.com/line/lbm-sdk/types"
@@ -21,6 +22,33 @@ import (
 	"github.com/line/lbm-sdk/x/auth/types"
 )
 
+func (suite *AnteTestSuite) TestNewAnteHandlerRequiredOptions() {
+	suite.SetupTest(true) // reset
+
+	options := ante.HandlerOptions{
+		AccountKeeper:   suite.app.AccountKeeper,
+		BankKeeper:      suite.app.BankKeeper,
+		SignModeHandler: suite.clientCtx.TxConfig.SignModeHandler(),
+	}
+	_, err := ante.NewAnteHandler(options)
+	suite.Require().NoError(err)
+
+	noAccountKeeper := options
+	noAccountKeeper.AccountKeeper = nil
+	_, err = ante.NewAnteHandler(noAccountKeeper)
+	suite.Require().True(sdkerrors.ErrLogic.Is(err), err)
+
+	noBankKeeper := options
+	noBankKeeper.BankKeeper = nil
+	_, err = ante.NewAnteHandler(noBankKeeper)
+	suite.Require().True(sdkerrors.ErrLogic.Is(err), err)
+
+	noSignModeHandler := options
+	noSignModeHandler.SignModeHandler = nil
+	_, err = ante.NewAnteHandler(noSignModeHandler)
+	suite.Require().True(sdkerrors.ErrLogic.Is(err), err)
+}
+
 // Test that simulate transaction accurately estimates gas cost
 func (suite *AnteTestSuite) TestSimulateGasCost() {
 	suite.SetupTest(true) // reset
@@ -583,6 +611,46 @@ func (suite *AnteTestSuite) TestAnteHandlerMemoGas() {
 	}
 }
 
+func (suite *AnteTestSuite) TestAnteHandlerSecp256r1() {
+	suite.SetupTest(false) // setup
+
+	priv := secp256r1.GenPrivKey()
+	addr := sdk.BytesToAccAddress(priv.PubKey().Address())
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+	suite.app.BankKeeper.SetBalances(suite.ctx, addr, sdk.Coins{
+		sdk.NewInt64Coin("atom", 10000000),
+	})
+
+	msg := testdata.NewTestMsg(addr)
+	feeAmount := testdata.NewTestFeeAmount()
+	gasLimit := testdata.NewTestGasLimit()
+
+	testCases := []TestCase{
+		{
+			"secp256r1 signature",
+			func() {},
+			false,
+			true,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.desc), func() {
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			tc.malleate()
+
+			suite.RunTestCase([]cryptotypes.PrivKey{priv}, []sdk.Msg{msg}, feeAmount, gasLimit, []uint64{1}, []uint64{0}, suite.ctx.ChainID(), tc)
+		})
+	}
+
+	// the pubkey is set on the account
+	pk, err := suite.app.AccountKeeper.GetPubKey(suite.ctx, addr)
+	suite.Require().NoError(err)
+	suite.Require().Equal(priv.PubKey(), pk)
+}
+
 func (suite *AnteTestSuite) TestAnteHandlerMultiSigner() {
 	suite.SetupTest(false) // setup
 
@@ -903,7 +971,11 @@ func generatePubKeysAndSignatures(n int, msg []byte, _ bool) (pubkeys []cryptoty
 	signatures = make([][]byte, n)
 	for i := 0; i < n; i++ {
 		var privkey cryptotypes.PrivKey
-		privkey = secp256k1.GenPrivKey()
+		if i%2 == 0 {
+			privkey = secp256k1.GenPrivKey()
+		} else {
+			privkey = secp256r1.GenPrivKey()
+		}
 
 		// TODO: also generate ed25519 keys as below when ed25519 keys are
 		//  actually supported, https://github.com/cosmos/cosmos-sdk/issues/4789
@@ -929,6 +1001,8 @@ func expectedGasCostByKeys(pubkeys []cryptotypes.PubKey) uint64 {
 			cost += types.DefaultParams().SigVerifyCostED25519
 		case strings.Contains(pubkeyType, "secp256k1"):
 			cost += types.DefaultParams().SigVerifyCostSecp256k1
+		case strings.Contains(pubkeyType, "secp256r1"):
+			cost += types.DefaultParams().SigVerifyCostSecp256r1()
 		default:
 			panic("unexpected key type")
 		}
@@ -1010,15 +1084,25 @@ func (suite *AnteTestSuite) TestCustomSignatureVerificationGasConsumer() {
 	suite.SetupTest(true) // setup
 
 	// setup an ante handler that only accepts PubKeyEd25519
-	suite.anteHandler = ante.NewAnteHandler(suite.app.AccountKeeper, suite.app.BankKeeper, func(meter sdk.GasMeter, sig signing.SignatureV2, params types.Params) error {
-		switch pubkey := sig.PubKey.(type) {
-		case *ed25519.PubKey:
-			meter.ConsumeGas(params.SigVerifyCostED25519, "ante verify: ed25519")
-			return nil
-		default:
-			return sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "unrecognized public key type: %T", pubkey)
-		}
-	}, suite.clientCtx.TxConfig.SignModeHandler())
+	anteHandler, err := ante.NewAnteHandler(
+		ante.HandlerOptions{
+			AccountKeeper:   suite.app.AccountKeeper,
+			BankKeeper:      suite.app.BankKeeper,
+			FeegrantKeeper:  suite.app.FeeGrantKeeper,
+			SignModeHandler: suite.clientCtx.TxConfig.SignModeHandler(),
+			SigGasConsumer: func(meter sdk.GasMeter, sig signing.SignatureV2, params types.Params) error {
+				switch pubkey := sig.PubKey.(type) {
+				case *ed25519.PubKey:
+					meter.ConsumeGas(params.SigVerifyCostED25519, "ante verify: ed25519")
+					return nil
+				default:
+					return sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "unrecognized public key type: %T", pubkey)
+				}
+			},
+		},
+	)
+	suite.Require().NoError(err)
+	suite.anteHandler = anteHandler
 
 	// Same data for every test cases
 	accounts := suite.CreateTestAccounts(1)
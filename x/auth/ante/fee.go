@@ -57,16 +57,22 @@ func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 // DeductFeeDecorator deducts fees from the first signer of the tx
 // If the first signer does not have the funds to pay for the fees, return with InsufficientFunds error
 // Call next AnteHandler if fees successfully deducted
+// If the tx sets a fee granter, the fee is deducted from the granter instead,
+// provided the granter has given the fee payer an allowance through the feegrant keeper.
 // CONTRACT: Tx must implement FeeTx interface to use DeductFeeDecorator
 type DeductFeeDecorator struct {
-	ak         AccountKeeper
-	bankKeeper types.BankKeeper
+	ak             AccountKeeper
+	bankKeeper     types.BankKeeper
+	feegrantKeeper FeegrantKeeper
 }
 
-func NewDeductFeeDecorator(ak AccountKeeper, bk types.BankKeeper) DeductFeeDecorator {
+// NewDeductFeeDecorator returns a new DeductFeeDecorator. fk may be nil, in
+// which case transactions setting a fee granter are rejected.
+func NewDeductFeeDecorator(ak AccountKeeper, bk types.BankKeeper, fk FeegrantKeeper) DeductFeeDecorator {
 	return DeductFeeDecorator{
-		ak:         ak,
-		bankKeeper: bk,
+		ak:             ak,
+		bankKeeper:     bk,
+		feegrantKeeper: fk,
 	}
 }
 
@@ -80,12 +86,32 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 		panic(fmt.Sprintf("%s module account has not been set", types.FeeCollectorName))
 	}
 
+	fee := feeTx.GetFee()
 	feePayer := feeTx.FeePayer()
-	feePayerAcc := dfd.ak.NewAccountWithAddress(ctx, feePayer)
+	feeGranter := feeTx.FeeGranter()
+
+	deductFeesFrom := feePayer
+
+	// if feegranter set deduct fee from feegranter account.
+	// this works with only when feegrant enabled.
+	if !feeGranter.Empty() {
+		if dfd.feegrantKeeper == nil {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "fee grants are not enabled")
+		} else if !feeGranter.Equals(feePayer) {
+			err := dfd.feegrantKeeper.UseGrantedFees(ctx, feeGranter, feePayer, fee, tx.GetMsgs())
+			if err != nil {
+				return ctx, sdkerrors.Wrapf(err, "%s not allowed to pay fees from %s", feeGranter, feePayer)
+			}
+		}
+
+		deductFeesFrom = feeGranter
+	}
+
+	deductFeesFromAcc := dfd.ak.NewAccountWithAddress(ctx, deductFeesFrom)
 
 	// deduct the fees
-	if !feeTx.GetFee().IsZero() {
-		err = DeductFees(dfd.bankKeeper, ctx, feePayerAcc, feeTx.GetFee())
+	if !fee.IsZero() {
+		err = DeductFees(dfd.bankKeeper, ctx, deductFeesFromAcc, fee)
 		if err != nil {
 			return ctx, err
 		}
@@ -94,13 +120,26 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 	return next(ctx, tx, simulate)
 }
 
-// DeductFees deducts fees from the given account.
+// deferringBankKeeper is a BankKeeper able to leave the credit of the fee
+// collector to the DeferredFees of the context.
+type deferringBankKeeper interface {
+	SendCoinsFromAccountToModuleDeferred(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins, deferred *sdk.DeferredFees) error
+}
+
+// DeductFees deducts fees from the given account. If the context defers fees
+// and the bank keeper supports it, the fee collector is credited only when the
+// deferred fees are.
 func DeductFees(bankKeeper types.BankKeeper, ctx sdk.Context, acc types.AccountI, fees sdk.Coins) error {
 	if !fees.IsValid() {
 		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "invalid fee amount: %s", fees)
 	}
 
-	err := bankKeeper.SendCoinsFromAccountToModule(ctx, acc.GetAddress(), types.FeeCollectorName, fees)
+	var err error
+	if bk, ok := bankKeeper.(deferringBankKeeper); ok && ctx.DeferredFees() != nil {
+		err = bk.SendCoinsFromAccountToModuleDeferred(ctx, acc.GetAddress(), types.FeeCollectorName, fees, ctx.DeferredFees())
+	} else {
+		err = bankKeeper.SendCoinsFromAccountToModule(ctx, acc.GetAddress(), types.FeeCollectorName, fees)
+	}
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
 	}
@@ -1,32 +1,99 @@
 package ante_test
 
 import (
-	"github.com/line/lbm-sdk/codec"
-	"github.com/line/lbm-sdk/codec/types"
+	"time"
+
+	cryptotypes "github.com/line/lbm-sdk/crypto/types"
 	"github.com/line/lbm-sdk/testutil/testdata"
 	sdk "github.com/line/lbm-sdk/types"
 	"github.com/line/lbm-sdk/x/auth/ante"
-	"github.com/line/lbm-sdk/x/auth/tx"
+	feegranttypes "github.com/line/lbm-sdk/x/feegrant/types"
 )
 
 type setFeeGranter interface {
 	SetFeeGranter(feeGranter sdk.AccAddress)
 }
 
-func (suite *AnteTestSuite) TestRejectFeeGranter() {
+func (suite *AnteTestSuite) TestRejectFeeGranterWithoutFeegrantKeeper() {
 	suite.SetupTest(true) // setup
-	txConfig := tx.NewTxConfig(codec.NewProtoCodec(types.NewInterfaceRegistry()), tx.DefaultSignModes)
-	txBuilder := txConfig.NewTxBuilder()
-	d := ante.NewRejectFeeGranterDecorator()
-	antehandler := sdk.ChainAnteDecorators(d)
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, granter := testdata.KeyTestPubAddr()
 
-	_, err := antehandler(suite.ctx, txBuilder.GetTx(), false)
+	suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	suite.txBuilder.(setFeeGranter).SetFeeGranter(granter)
+
+	tx, err := suite.CreateTestTx([]cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}, suite.ctx.ChainID())
 	suite.Require().NoError(err)
 
-	setGranterTx := txBuilder.(setFeeGranter)
-	_, _, addr := testdata.KeyTestPubAddr()
-	setGranterTx.SetFeeGranter(addr)
+	suite.app.BankKeeper.SetBalances(suite.ctx, granter, sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)))
+
+	dfd := ante.NewDeductFeeDecorator(suite.app.AccountKeeper, suite.app.BankKeeper, nil)
+	antehandler := sdk.ChainAnteDecorators(dfd)
 
-	_, err = antehandler(suite.ctx, txBuilder.GetTx(), false)
+	_, err = antehandler(suite.ctx, tx, false)
 	suite.Require().Error(err)
 }
+
+func (suite *AnteTestSuite) TestDeductFeesFromGranter() {
+	suite.SetupTest(false) // setup
+
+	priv1, _, grantee := testdata.KeyTestPubAddr()
+	_, _, granter := testdata.KeyTestPubAddr()
+	_, _, stranger := testdata.KeyTestPubAddr()
+
+	feeAmount := testdata.NewTestFeeAmount()
+	suite.app.BankKeeper.SetBalances(suite.ctx, granter, sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)))
+	suite.app.BankKeeper.SetBalances(suite.ctx, stranger, sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)))
+
+	oneHour := suite.ctx.BlockTime().Add(time.Hour)
+	err := suite.app.FeeGrantKeeper.GrantAllowance(suite.ctx, granter, grantee, &feegranttypes.BasicAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 200)),
+		Expiration: &oneHour,
+	})
+	suite.Require().NoError(err)
+
+	dfd := ante.NewDeductFeeDecorator(suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.FeeGrantKeeper)
+	antehandler := sdk.ChainAnteDecorators(dfd)
+
+	testCases := []struct {
+		desc    string
+		granter sdk.AccAddress
+		fee     sdk.Coins
+		expPass bool
+	}{
+		{"granter pays the fee", granter, feeAmount, true},
+		{"no allowance from stranger", stranger, feeAmount, false},
+		{"fee exceeds the remaining allowance", granter, sdk.NewCoins(sdk.NewInt64Coin("atom", 100)), false},
+		{"fee uses up the remaining allowance", granter, sdk.NewCoins(sdk.NewInt64Coin("atom", 50)), true},
+		{"used up allowance is removed", granter, sdk.NewCoins(sdk.NewInt64Coin("atom", 1)), false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.desc, func() {
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(grantee)))
+			suite.txBuilder.SetFeeAmount(tc.fee)
+			suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+			suite.txBuilder.(setFeeGranter).SetFeeGranter(tc.granter)
+
+			tx, err := suite.CreateTestTx([]cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}, suite.ctx.ChainID())
+			suite.Require().NoError(err)
+
+			before := suite.app.BankKeeper.GetAllBalances(suite.ctx, tc.granter)
+			_, err = antehandler(suite.ctx, tx, false)
+			after := suite.app.BankKeeper.GetAllBalances(suite.ctx, tc.granter)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(before.Sub(tc.fee), after)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(before, after)
+			}
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package ante
+
+import (
+	"crypto/sha256"
+	"sync"
+
+	abci "github.com/line/ostracon/abci/types"
+
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	authsigning "github.com/line/lbm-sdk/x/auth/signing"
+	"github.com/line/lbm-sdk/x/auth/types"
+)
+
+// TxReplacements keeps track of the latest tx of every signer accepted into the
+// mempool, so that it can be replaced by a tx of the same signer and sequence
+// paying a higher fee. It is shared by the TxReplacementDecorator and the
+// BeginRecheckTx handler of the app, and is safe for concurrent use.
+//
+// A replaced tx is removed from the mempool by the following recheck. If it is
+// included in a block before that, the replacing tx fails on recheck instead.
+type TxReplacements struct {
+	mtx         sync.Mutex
+	bumpPercent uint64
+
+	// the latest pending tx by signer
+	pending map[string]pendingTx
+	// hashes of the replaced txs, rejected by the next recheck
+	replaced  map[[32]byte]struct{}
+	rejecting map[[32]byte]struct{}
+}
+
+type pendingTx struct {
+	seq    uint64
+	fee    sdk.Coins
+	txHash [32]byte
+}
+
+// NewTxReplacements returns a TxReplacements accepting a replacing tx if its
+// fee is at least bumpPercent percent higher than the fee of the replaced tx.
+func NewTxReplacements(bumpPercent uint64) *TxReplacements {
+	return &TxReplacements{
+		bumpPercent: bumpPercent,
+		pending:     make(map[string]pendingTx),
+		replaced:    make(map[[32]byte]struct{}),
+		rejecting:   make(map[[32]byte]struct{}),
+	}
+}
+
+// BeginRecheckTx implements sdk.BeginRecheckTxHandler. The pending txs are
+// recorded again as they are rechecked, and the txs replaced since the last
+// recheck are rejected during this one.
+func (r *TxReplacements) BeginRecheckTx(_ sdk.Context, _ abci.RequestBeginRecheckTx) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	r.pending = make(map[string]pendingTx)
+	r.rejecting = r.replaced
+	r.replaced = make(map[[32]byte]struct{})
+}
+
+// MinReplacementFee returns the minimum fee of a tx replacing a tx paying fee.
+func (r *TxReplacements) MinReplacementFee(fee sdk.Coins) sdk.Coins {
+	minFee := make(sdk.Coins, len(fee))
+	for i, coin := range fee {
+		// amount * (100 + bumpPercent) / 100, rounded up
+		amount := coin.Amount.Mul(sdk.NewIntFromUint64(100 + r.bumpPercent)).AddRaw(99).QuoRaw(100)
+		minFee[i] = sdk.NewCoin(coin.Denom, amount)
+	}
+	return minFee
+}
+
+func (r *TxReplacements) get(signer string) (pendingTx, bool) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	tx, ok := r.pending[signer]
+	return tx, ok
+}
+
+// add records the tx as the latest pending tx of the signer and, if it
+// replaced another one, the hash of the replaced tx.
+func (r *TxReplacements) add(signer string, tx pendingTx, replaced *[32]byte) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	r.pending[signer] = tx
+	if replaced != nil {
+		r.replaced[*replaced] = struct{}{}
+	}
+}
+
+// isReplaced returns whether the tx has been replaced, in which case it is
+// rejected on recheck.
+func (r *TxReplacements) isReplaced(txHash [32]byte) bool {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	_, ok := r.rejecting[txHash]
+	delete(r.rejecting, txHash)
+	return ok
+}
+
+// delivered drops the pending tx of the signer if its sequence is delivered.
+func (r *TxReplacements) delivered(signer string, seq uint64) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if tx, ok := r.pending[signer]; ok && tx.seq <= seq {
+		delete(r.pending, signer)
+	}
+}
+
+// TxReplacementDecorator lets a tx replace the pending tx of the same signer
+// and sequence in CheckTx, if its fee is high enough: the sequence of the
+// signer is set back and the fee of the replaced tx refunded before the rest of
+// the ante handler runs. Only txs with a single signer, no fee granter and a
+// signature carrying the sequence can replace or be replaced.
+//
+// On recheck, the replaced txs are rejected. If replacements is nil, the
+// decorator does nothing.
+// CONTRACT: Tx must implement FeeTx and SigVerifiableTx interfaces, and the
+// decorator must run before the fee deduction and signature verification.
+type TxReplacementDecorator struct {
+	ak           AccountKeeper
+	bankKeeper   types.BankKeeper
+	replacements *TxReplacements
+}
+
+func NewTxReplacementDecorator(ak AccountKeeper, bk types.BankKeeper, replacements *TxReplacements) TxReplacementDecorator {
+	return TxReplacementDecorator{
+		ak:           ak,
+		bankKeeper:   bk,
+		replacements: replacements,
+	}
+}
+
+func (trd TxReplacementDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if trd.replacements == nil || simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	txHash := sha256.Sum256(ctx.TxBytes())
+	if ctx.IsReCheckTx() && trd.replacements.isReplaced(txHash) {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrConflict, "tx replaced by a tx paying a higher fee")
+	}
+
+	signers := sigTx.GetSigners()
+	if len(signers) != 1 || !feeTx.FeeGranter().Empty() {
+		return next(ctx, tx, simulate)
+	}
+
+	acc := trd.ak.GetAccount(ctx, signers[0])
+	if acc == nil {
+		return next(ctx, tx, simulate)
+	}
+	signer := signers[0].String()
+	seq := acc.GetSequence()
+
+	if !ctx.IsCheckTx() {
+		trd.replacements.delivered(signer, seq)
+		return next(ctx, tx, simulate)
+	}
+
+	var replaced *[32]byte
+	if !ctx.IsReCheckTx() && seq > 0 {
+		sigs, err := sigTx.GetSignaturesV2()
+		if err != nil {
+			return ctx, err
+		}
+
+		pending, ok := trd.replacements.get(signer)
+		if ok && len(sigs) == 1 && !OnlyLegacyAminoSigners(sigs[0].Data) &&
+			sigs[0].Sequence == pending.seq && pending.seq == seq-1 && pending.txHash != txHash {
+			minFee := trd.replacements.MinReplacementFee(pending.fee)
+			if !feeTx.GetFee().IsAllGTE(minFee) {
+				return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee,
+					"replacement fee too low; got: %s required: %s", feeTx.GetFee(), minFee)
+			}
+
+			// undo the replaced tx, the rest of the ante handler checks the new one in its place
+			if !pending.fee.IsZero() {
+				err = trd.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.FeeCollectorName, acc.GetAddress(), pending.fee)
+				if err != nil {
+					return ctx, err
+				}
+			}
+			if err := acc.SetSequence(pending.seq); err != nil {
+				panic(err)
+			}
+			trd.ak.SetAccount(ctx, acc)
+
+			seq = pending.seq
+			replaced = &pending.txHash
+		}
+	}
+
+	newCtx, err = next(ctx, tx, simulate)
+	if err != nil {
+		return newCtx, err
+	}
+
+	trd.replacements.add(signer, pendingTx{seq: seq, fee: feeTx.GetFee(), txHash: txHash}, replaced)
+	return newCtx, nil
+}
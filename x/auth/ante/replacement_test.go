@@ -0,0 +1,105 @@
+package ante_test
+
+import (
+	abci "github.com/line/ostracon/abci/types"
+
+	cryptotypes "github.com/line/lbm-sdk/crypto/types"
+	"github.com/line/lbm-sdk/testutil/testdata"
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/x/auth/ante"
+)
+
+func (suite *AnteTestSuite) TestTxReplacement() {
+	suite.SetupTest(true) // setup
+	accounts := suite.CreateTestAccounts(1)
+	addr := accounts[0].acc.GetAddress()
+
+	replacements := ante.NewTxReplacements(10)
+	anteHandler, err := ante.NewAnteHandler(
+		ante.HandlerOptions{
+			AccountKeeper:   suite.app.AccountKeeper,
+			BankKeeper:      suite.app.BankKeeper,
+			FeegrantKeeper:  suite.app.FeeGrantKeeper,
+			TxReplacements:  replacements,
+			SignModeHandler: suite.clientCtx.TxConfig.SignModeHandler(),
+			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+		},
+	)
+	suite.Require().NoError(err)
+
+	createTx := func(fee int64, seq uint64) sdk.Tx {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+		suite.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", fee)))
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+		tx, err := suite.CreateTestTx([]cryptotypes.PrivKey{accounts[0].priv}, []uint64{0}, []uint64{seq}, suite.ctx.ChainID())
+		suite.Require().NoError(err)
+		return tx
+	}
+	// runTx runs the ante handler the way BaseApp does, writing the state on success
+	runTx := func(ctx sdk.Context, tx sdk.Tx) error {
+		txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+		suite.Require().NoError(err)
+
+		cacheCtx, write := ctx.WithTxBytes(txBytes).CacheContext()
+		if _, err := anteHandler(cacheCtx, tx, false); err != nil {
+			return err
+		}
+		write()
+		return nil
+	}
+	balance := func(ctx sdk.Context) int64 {
+		return suite.app.BankKeeper.GetBalance(ctx, addr, "atom").Amount.Int64()
+	}
+	sequence := func(ctx sdk.Context) uint64 {
+		return suite.app.AccountKeeper.GetAccount(ctx, addr).GetSequence()
+	}
+
+	tx1, tx2, tx3 := createTx(100, 0), createTx(109, 0), createTx(110, 0)
+
+	checkCtx, _ := suite.ctx.CacheContext()
+	suite.Require().NoError(runTx(checkCtx, tx1))
+	suite.Require().Equal(int64(10000000-100), balance(checkCtx))
+
+	// the fee bump is too low
+	err = runTx(checkCtx, tx2)
+	suite.Require().True(sdkerrors.ErrInsufficientFee.Is(err), err)
+
+	// tx3 replaces tx1: only its own fee is deducted
+	suite.Require().NoError(runTx(checkCtx, tx3))
+	suite.Require().Equal(int64(10000000-110), balance(checkCtx))
+	suite.Require().Equal(uint64(1), sequence(checkCtx))
+
+	// checking the same tx again does not replace it
+	err = runTx(checkCtx, tx3)
+	suite.Require().True(sdkerrors.ErrWrongSequence.Is(err), err)
+
+	// the replaced tx is rejected on recheck, the replacing one is recorded again
+	recheckCtx, _ := suite.ctx.WithIsReCheckTx(true).CacheContext()
+	replacements.BeginRecheckTx(recheckCtx, abci.RequestBeginRecheckTx{})
+	err = runTx(recheckCtx, tx1)
+	suite.Require().True(sdkerrors.ErrConflict.Is(err), err)
+	suite.Require().NoError(runTx(recheckCtx, tx3))
+
+	checkCtx = recheckCtx.WithIsReCheckTx(false)
+	suite.Require().NoError(runTx(checkCtx, createTx(121, 0)))
+	suite.Require().Equal(int64(10000000-121), balance(checkCtx))
+	suite.Require().Equal(uint64(1), sequence(checkCtx))
+
+	// a delivered sequence can not be replaced
+	deliverCtx, _ := suite.ctx.WithIsCheckTx(false).CacheContext()
+	suite.Require().NoError(runTx(deliverCtx, tx3))
+	err = runTx(deliverCtx.WithIsCheckTx(true), createTx(200, 0))
+	suite.Require().True(sdkerrors.ErrWrongSequence.Is(err), err)
+}
+
+func (suite *AnteTestSuite) TestMinReplacementFee() {
+	replacements := ante.NewTxReplacements(10)
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 100), sdk.NewInt64Coin("stake", 11))
+	expected := sdk.NewCoins(sdk.NewInt64Coin("atom", 110), sdk.NewInt64Coin("stake", 13))
+	suite.Require().Equal(expected, replacements.MinReplacementFee(fee))
+	suite.Require().Empty(replacements.MinReplacementFee(sdk.NewCoins()))
+}
@@ -1,15 +1,14 @@
 package ante
 
 import (
-	"bytes"
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
-	"sync"
 
 	"github.com/line/lbm-sdk/crypto/keys/ed25519"
 	kmultisig "github.com/line/lbm-sdk/crypto/keys/multisig"
 	"github.com/line/lbm-sdk/crypto/keys/secp256k1"
+	"github.com/line/lbm-sdk/crypto/keys/secp256r1"
 	cryptotypes "github.com/line/lbm-sdk/crypto/types"
 	"github.com/line/lbm-sdk/crypto/types/multisig"
 	sdk "github.com/line/lbm-sdk/types"
@@ -170,15 +169,37 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 type SigVerificationDecorator struct {
 	ak              AccountKeeper
 	signModeHandler authsigning.SignModeHandler
-	txHashCache     sync.Map
+	txHashCache     *SigVerificationCache
 }
 
 func NewSigVerificationDecorator(ak AccountKeeper, signModeHandler authsigning.SignModeHandler) *SigVerificationDecorator {
+	return NewSigVerificationDecoratorWithCache(ak, signModeHandler, NewSigVerificationCache(DefaultSigVerificationCacheSize))
+}
+
+// NewSigVerificationDecoratorWithCache returns a SigVerificationDecorator
+// using the given cache, which may be shared with other decorators.
+func NewSigVerificationDecoratorWithCache(
+	ak AccountKeeper, signModeHandler authsigning.SignModeHandler, cache *SigVerificationCache,
+) *SigVerificationDecorator {
 	return &SigVerificationDecorator{
 		ak:              ak,
 		signModeHandler: signModeHandler,
-		txHashCache:     sync.Map{},
+		txHashCache:     cache,
+	}
+}
+
+func newSigVerificationDecorator(
+	ak AccountKeeper, signModeHandler authsigning.SignModeHandler, cache *SigVerificationCache,
+) *SigVerificationDecorator {
+	if cache == nil {
+		return NewSigVerificationDecorator(ak, signModeHandler)
 	}
+	return NewSigVerificationDecoratorWithCache(ak, signModeHandler, cache)
+}
+
+// Cache returns the signature verification cache of the decorator.
+func (svd *SigVerificationDecorator) Cache() *SigVerificationCache {
+	return svd.txHashCache
 }
 
 // OnlyLegacyAminoSigners checks SignatureData to see if all
@@ -232,7 +253,7 @@ func (svd *SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simu
 		// remove txHashCache if got an error
 		if err != nil {
 			for _, sigKey := range newSigKeys {
-				svd.txHashCache.Delete(sigKey)
+				svd.txHashCache.Remove(sigKey)
 			}
 		}
 	}()
@@ -284,7 +305,7 @@ func (svd *SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simu
 			txHash := sha256.Sum256(ctx.TxBytes())
 			stored := false
 
-			stored, err = svd.verifySignatureWithCache(ctx, pubKey, signerData, sig.Data, tx, sigKey, txHash[:])
+			stored, err = svd.verifySignatureWithCache(ctx, pubKey, signerData, sig.Data, tx, acc.GetAddress().String(), sigKey, txHash[:])
 
 			if stored {
 				newSigKeys = append(newSigKeys, sigKey)
@@ -315,6 +336,7 @@ func (svd *SigVerificationDecorator) verifySignatureWithCache(
 	signerData authsigning.SignerData,
 	sigData signing.SignatureData,
 	tx sdk.Tx,
+	signer string,
 	sigKey string,
 	txHash []byte,
 ) (stored bool, err error) {
@@ -322,23 +344,24 @@ func (svd *SigVerificationDecorator) verifySignatureWithCache(
 	case ctx.IsCheckTx() && !ctx.IsReCheckTx(): // CheckTx
 		err = authsigning.VerifySignature(pubKey, signerData, sigData, svd.signModeHandler, tx)
 		if err == nil {
-			svd.txHashCache.Store(sigKey, txHash)
+			svd.txHashCache.Add(sigKey, signer, signerData.Sequence, txHash)
 			stored = true
 		}
 
 	case ctx.IsReCheckTx(): // ReCheckTx
-		verified, exist := svd.checkCache(sigKey, txHash)
+		verified, exist := svd.txHashCache.Verified(sigKey, txHash)
 		if !verified {
 			if exist {
-				svd.txHashCache.Delete(sigKey)
+				svd.txHashCache.Remove(sigKey)
 			}
 			err = fmt.Errorf("unable to verify signature")
 		}
 
 	default: // DeliverTx
-		verified, exist := svd.checkCache(sigKey, txHash)
+		svd.txHashCache.Delivered(signer, signerData.Sequence)
+		verified, exist := svd.txHashCache.Verified(sigKey, txHash)
 		if exist {
-			svd.txHashCache.Delete(sigKey)
+			svd.txHashCache.Remove(sigKey)
 		}
 		if !verified {
 			err = authsigning.VerifySignature(pubKey, signerData, sigData, svd.signModeHandler, tx)
@@ -348,12 +371,6 @@ func (svd *SigVerificationDecorator) verifySignatureWithCache(
 	return stored, err
 }
 
-func (svd *SigVerificationDecorator) checkCache(sigKey string, txHash []byte) (verified, exist bool) {
-	cached, exist := svd.txHashCache.Load(sigKey)
-	verified = exist && bytes.Equal(cached.([]byte), txHash)
-	return verified, exist
-}
-
 // IncrementSequenceDecorator handles incrementing sequences of all signers.
 // Use the IncrementSequenceDecorator decorator to prevent replay attacks. Note,
 // there is no need to execute IncrementSequenceDecorator on RecheckTX since
@@ -443,6 +460,10 @@ func DefaultSigVerificationGasConsumer(
 		meter.ConsumeGas(params.SigVerifyCostSecp256k1, "ante verify: secp256k1")
 		return nil
 
+	case *secp256r1.PubKey:
+		meter.ConsumeGas(params.SigVerifyCostSecp256r1(), "ante verify: secp256r1")
+		return nil
+
 	case multisig.PubKey:
 		multisignature, ok := sig.Data.(*signing.MultiSignatureData)
 		if !ok {
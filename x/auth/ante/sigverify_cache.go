@@ -0,0 +1,160 @@
+package ante
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/hashicorp/golang-lru/simplelru"
+	abci "github.com/line/ostracon/abci/types"
+
+	"github.com/line/lbm-sdk/telemetry"
+	sdk "github.com/line/lbm-sdk/types"
+)
+
+// DefaultSigVerificationCacheSize is the default maximum number of entries of
+// a SigVerificationCache.
+const DefaultSigVerificationCacheSize = 20000
+
+// SigVerificationCache keeps the hashes of the txs whose signatures were
+// verified in CheckTx, keyed by signer, sig block height and sequence, so that
+// DeliverTx does not have to verify them again.
+//
+// The cache is bounded: the least recently used entries are evicted once it is
+// full. Entries whose sequence was delivered in a committed block can not be
+// used anymore and are dropped by Commit, which is to be set as the commit
+// handler of the app. The cache is safe for concurrent use and may be shared
+// by several decorators.
+type SigVerificationCache struct {
+	mtx   sync.Mutex
+	cache *simplelru.LRU
+	// sig keys and their sequence by signer
+	keys map[string]map[string]uint64
+
+	// the highest sequence delivered in the current block by signer
+	delivered map[string]uint64
+
+	hits, misses uint64
+}
+
+type sigCacheEntry struct {
+	signer string
+	seq    uint64
+	txHash []byte
+}
+
+// NewSigVerificationCache returns a SigVerificationCache holding at most size
+// entries.
+func NewSigVerificationCache(size int) *SigVerificationCache {
+	c := &SigVerificationCache{
+		keys:      make(map[string]map[string]uint64),
+		delivered: make(map[string]uint64),
+	}
+
+	cache, err := simplelru.NewLRU(size, c.onEvicted)
+	if err != nil {
+		panic(err)
+	}
+	c.cache = cache
+
+	return c
+}
+
+// Add stores the hash of the tx verified for the signer with the sequence.
+func (c *SigVerificationCache) Add(sigKey, signer string, seq uint64, txHash []byte) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.cache.Add(sigKey, sigCacheEntry{signer: signer, seq: seq, txHash: txHash})
+
+	keys := c.keys[signer]
+	if keys == nil {
+		keys = make(map[string]uint64)
+		c.keys[signer] = keys
+	}
+	keys[sigKey] = seq
+}
+
+// Verified returns whether the tx with the hash has been verified for the key,
+// and whether the key exists at all.
+func (c *SigVerificationCache) Verified(sigKey string, txHash []byte) (verified, exist bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	value, exist := c.cache.Get(sigKey)
+	verified = exist && bytes.Equal(value.(sigCacheEntry).txHash, txHash)
+
+	if verified {
+		c.hits++
+		telemetry.IncrCounter(1, "sigverify_cache", "hit")
+	} else {
+		c.misses++
+		telemetry.IncrCounter(1, "sigverify_cache", "miss")
+	}
+
+	return verified, exist
+}
+
+// Remove drops the entry of the key.
+func (c *SigVerificationCache) Remove(sigKey string) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.cache.Remove(sigKey)
+}
+
+// Delivered records that a tx of the signer with the sequence is being
+// delivered in the current block. The entries of the signer up to the sequence
+// are dropped once the block is committed.
+func (c *SigVerificationCache) Delivered(signer string, seq uint64) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if delivered, ok := c.delivered[signer]; !ok || seq > delivered {
+		c.delivered[signer] = seq
+	}
+}
+
+// Commit implements sdk.CommitHandler. The entries of the signers up to the
+// sequences delivered in the committed block are dropped, as they can not match
+// a tx anymore.
+func (c *SigVerificationCache) Commit(_ sdk.Context, _ abci.ResponseCommit) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	for signer, seq := range c.delivered {
+		for sigKey, keySeq := range c.keys[signer] {
+			if keySeq <= seq {
+				c.cache.Remove(sigKey)
+			}
+		}
+	}
+
+	c.delivered = make(map[string]uint64)
+}
+
+// Len returns the number of entries.
+func (c *SigVerificationCache) Len() int {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	return c.cache.Len()
+}
+
+// Stats returns the number of lookups which found the tx verified and of the
+// ones which did not.
+func (c *SigVerificationCache) Stats() (hits, misses uint64) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	return c.hits, c.misses
+}
+
+// onEvicted keeps the keys by signer in sync with the cache. It is called by
+// the cache with c.mtx held.
+func (c *SigVerificationCache) onEvicted(key, value interface{}) {
+	signer := value.(sigCacheEntry).signer
+	delete(c.keys[signer], key.(string))
+	if len(c.keys[signer]) == 0 {
+		delete(c.keys, signer)
+	}
+}
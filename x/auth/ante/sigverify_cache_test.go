@@ -0,0 +1,88 @@
+package ante_test
+
+import (
+	"fmt"
+	"testing"
+
+	abci "github.com/line/ostracon/abci/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/auth/ante"
+)
+
+func sigKey(signer string, seq uint64) string {
+	return fmt.Sprintf("%s:%d:%d", signer, 0, seq)
+}
+
+func TestSigVerificationCacheLookup(t *testing.T) {
+	cache := ante.NewSigVerificationCache(10)
+	cache.Add(sigKey("a", 0), "a", 0, []byte("hash"))
+
+	verified, exist := cache.Verified(sigKey("a", 0), []byte("hash"))
+	require.True(t, verified)
+	require.True(t, exist)
+
+	verified, exist = cache.Verified(sigKey("a", 0), []byte("other"))
+	require.False(t, verified)
+	require.True(t, exist)
+
+	verified, exist = cache.Verified(sigKey("a", 1), []byte("hash"))
+	require.False(t, verified)
+	require.False(t, exist)
+
+	hits, misses := cache.Stats()
+	require.Equal(t, uint64(1), hits)
+	require.Equal(t, uint64(2), misses)
+
+	cache.Remove(sigKey("a", 0))
+	require.Equal(t, 0, cache.Len())
+}
+
+func TestSigVerificationCacheEviction(t *testing.T) {
+	cache := ante.NewSigVerificationCache(2)
+	cache.Add(sigKey("a", 0), "a", 0, []byte("hash0"))
+	cache.Add(sigKey("a", 1), "a", 1, []byte("hash1"))
+
+	// refresh the first entry, so the second one is the least recently used
+	verified, _ := cache.Verified(sigKey("a", 0), []byte("hash0"))
+	require.True(t, verified)
+
+	cache.Add(sigKey("b", 0), "b", 0, []byte("hash2"))
+	require.Equal(t, 2, cache.Len())
+
+	_, exist := cache.Verified(sigKey("a", 1), []byte("hash1"))
+	require.False(t, exist)
+	_, exist = cache.Verified(sigKey("a", 0), []byte("hash0"))
+	require.True(t, exist)
+}
+
+func TestSigVerificationCacheCommit(t *testing.T) {
+	cache := ante.NewSigVerificationCache(10)
+	for seq := uint64(0); seq < 4; seq++ {
+		cache.Add(sigKey("a", seq), "a", seq, []byte("hash"))
+	}
+	cache.Add(sigKey("b", 0), "b", 0, []byte("hash"))
+
+	// nothing is dropped before the block is committed
+	cache.Delivered("a", 1)
+	cache.Delivered("a", 0)
+	require.Equal(t, 5, cache.Len())
+
+	cache.Commit(sdk.Context{}, abci.ResponseCommit{})
+	require.Equal(t, 3, cache.Len())
+	for seq, expected := range []bool{false, false, true, true} {
+		_, exist := cache.Verified(sigKey("a", uint64(seq)), []byte("hash"))
+		require.Equal(t, expected, exist, "sequence %d", seq)
+	}
+
+	// the delivered sequences are those of the last block only
+	cache.Commit(sdk.Context{}, abci.ResponseCommit{})
+	require.Equal(t, 3, cache.Len())
+
+	// the cache is empty once all its entries are delivered and committed
+	cache.Delivered("a", 3)
+	cache.Delivered("b", 0)
+	cache.Commit(sdk.Context{}, abci.ResponseCommit{})
+	require.Equal(t, 0, cache.Len())
+}
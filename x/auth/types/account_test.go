@@ -10,6 +10,7 @@ import (
 	yaml "gopkg.in/yaml.v2"
 
 	"github.com/line/lbm-sdk/crypto/keys/secp256k1"
+	"github.com/line/lbm-sdk/crypto/keys/secp256r1"
 	"github.com/line/lbm-sdk/testutil/testdata"
 	sdk "github.com/line/lbm-sdk/types"
 	"github.com/line/lbm-sdk/x/auth/types"
@@ -81,6 +82,26 @@ func TestBaseAccountMarshal(t *testing.T) {
 	require.NotNil(t, err)
 }
 
+func TestBaseAccountSecp256r1PubKey(t *testing.T) {
+	pub := secp256r1.GenPrivKey().PubKey()
+	addr := sdk.BytesToAccAddress(pub.Address())
+	acc := types.NewBaseAccount(addr, pub, 7)
+	require.Equal(t, pub, acc.GetPubKey())
+	require.NoError(t, acc.Validate())
+
+	bz, err := app.AccountKeeper.MarshalAccount(acc)
+	require.Nil(t, err)
+
+	acc2, err := app.AccountKeeper.UnmarshalAccount(bz)
+	require.Nil(t, err)
+	require.Equal(t, acc, acc2)
+
+	// switching the key type clears the secp256r1 key
+	err = acc.SetPubKey(secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	require.Nil(t, acc.Secp256R1PubKey)
+}
+
 func TestGenesisAccountValidate(t *testing.T) {
 	pubkey := secp256k1.GenPrivKey().PubKey()
 	addr := sdk.BytesToAccAddress(pubkey.Address())
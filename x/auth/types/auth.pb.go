@@ -10,6 +10,7 @@ import (
 	ed25519 "github.com/line/lbm-sdk/crypto/keys/ed25519"
 	multisig "github.com/line/lbm-sdk/crypto/keys/multisig"
 	secp256k1 "github.com/line/lbm-sdk/crypto/keys/secp256k1"
+	secp256r1 "github.com/line/lbm-sdk/crypto/keys/secp256r1"
 	_ "github.com/regen-network/cosmos-proto"
 	io "io"
 	math "math"
@@ -36,6 +37,7 @@ type BaseAccount struct {
 	Secp256K1PubKey *secp256k1.PubKey           `protobuf:"bytes,3,opt,name=secp256k1_pub_key,json=secp256k1PubKey,proto3" json:"secp256k1_public_key,omitempty" yaml:"secp256k1_public_key"`
 	MultisigPubKey  *multisig.LegacyAminoPubKey `protobuf:"bytes,4,opt,name=multisig_pub_key,json=multisigPubKey,proto3" json:"multisig_public_key,omitempty" yaml:"multisig_public_key"`
 	Sequence        uint64                      `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Secp256R1PubKey *secp256r1.PubKey           `protobuf:"bytes,6,opt,name=secp256r1_pub_key,json=secp256r1PubKey,proto3" json:"secp256r1_public_key,omitempty" yaml:"secp256r1_public_key"`
 }
 
 func (m *BaseAccount) Reset()      { *m = BaseAccount{} }
@@ -202,58 +204,59 @@ func init() {
 func init() { proto.RegisterFile("lbm/auth/v1beta1/auth.proto", fileDescriptor_023b8ec707b0f8f4) }
 
 var fileDescriptor_023b8ec707b0f8f4 = []byte{
-	// 802 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x94, 0xbf, 0x6f, 0xe4, 0x44,
-	0x14, 0xc7, 0xd7, 0xc4, 0x84, 0x64, 0xf6, 0x7e, 0xc5, 0x09, 0xb9, 0xcd, 0x26, 0xf1, 0x2c, 0x16,
-	0x42, 0x29, 0x88, 0xad, 0x04, 0x05, 0xe9, 0x56, 0xe2, 0x50, 0x7c, 0x50, 0x9c, 0xb8, 0x43, 0x91,
-	0x23, 0x5d, 0x81, 0x84, 0xac, 0xb1, 0x77, 0x70, 0x46, 0xeb, 0xd9, 0xf1, 0x79, 0xc6, 0xd1, 0xfa,
-	0x3a, 0x44, 0x83, 0x44, 0x03, 0x1d, 0x65, 0xfe, 0x88, 0x93, 0x68, 0x29, 0x29, 0xa3, 0xab, 0xa8,
-	0x2c, 0xb4, 0x69, 0x50, 0xca, 0xfd, 0x0b, 0x90, 0x67, 0xbc, 0xc6, 0x49, 0xdc, 0xf9, 0xbd, 0xf7,
-	0x7d, 0x6f, 0x3e, 0x33, 0xfe, 0xce, 0x80, 0xed, 0x38, 0xa0, 0x0e, 0xca, 0xc4, 0x99, 0x73, 0x7e,
-	0x10, 0x60, 0x81, 0x0e, 0x64, 0x60, 0x27, 0x29, 0x13, 0xcc, 0x78, 0x14, 0x07, 0xd4, 0x96, 0x71,
-	0x55, 0xec, 0xef, 0x96, 0xf2, 0x30, 0xcd, 0x13, 0xc1, 0x1c, 0x3c, 0x3a, 0x3c, 0x3a, 0x3a, 0x78,
-	0xe2, 0x8c, 0x71, 0xce, 0x55, 0x43, 0xdf, 0x6c, 0x94, 0x69, 0x16, 0x0b, 0xc2, 0x49, 0xd4, 0xac,
-	0xc3, 0x46, 0x9d, 0xe3, 0x30, 0x39, 0x3c, 0xfa, 0x7c, 0x7c, 0xd0, 0x14, 0x6c, 0x85, 0x8c, 0x53,
-	0xc6, 0x7d, 0x19, 0x39, 0x2a, 0xa8, 0x4a, 0x1b, 0x11, 0x8b, 0x98, 0xca, 0x97, 0x5f, 0x2a, 0x6b,
-	0xfd, 0xa4, 0x83, 0xae, 0x8b, 0x38, 0x3e, 0x0e, 0x43, 0x96, 0x4d, 0x84, 0xd1, 0x03, 0x1f, 0xa0,
-	0xd1, 0x28, 0xc5, 0x9c, 0xf7, 0xb4, 0x81, 0xb6, 0xb7, 0xea, 0x2d, 0x42, 0xe3, 0x47, 0x0d, 0x3c,
-	0xac, 0x90, 0xfd, 0x24, 0x0b, 0xfc, 0x31, 0xce, 0x7b, 0xef, 0x0d, 0xb4, 0xbd, 0xee, 0x61, 0xdf,
-	0x2e, 0xf7, 0xa9, 0xb0, 0xec, 0x4a, 0x62, 0x9f, 0x64, 0xc1, 0x37, 0x38, 0x77, 0xbf, 0xb8, 0x2e,
-	0xe0, 0x4e, 0xa3, 0x2d, 0x26, 0x61, 0xd9, 0xf9, 0x29, 0xa3, 0x44, 0x60, 0x9a, 0x88, 0x7c, 0x5e,
-	0xc0, 0xad, 0x1c, 0xd1, 0x78, 0x68, 0xdd, 0x55, 0x59, 0xde, 0xfd, 0x2a, 0xa9, 0xa6, 0x19, 0xbf,
-	0x68, 0x60, 0xad, 0xde, 0x77, 0x4d, 0xb1, 0x24, 0x29, 0x76, 0x9a, 0x14, 0xb5, 0x68, 0xc1, 0x71,
-	0x7c, 0x5d, 0x40, 0xf3, 0x46, 0x6b, 0x1b, 0xc9, 0xb6, 0x22, 0x69, 0xd3, 0x59, 0xde, 0xc3, 0x3a,
-	0x5d, 0xd1, 0xfc, 0xa6, 0x81, 0x47, 0x8b, 0xbf, 0x54, 0xc3, 0xe8, 0x12, 0xe6, 0x93, 0x26, 0xcc,
-	0x42, 0x63, 0xbf, 0xc0, 0x11, 0x0a, 0xf3, 0x63, 0x4a, 0x26, 0xac, 0xc2, 0xfa, 0xf2, 0xba, 0x80,
-	0xbb, 0xcd, 0x19, 0x6d, 0x54, 0x7d, 0x45, 0xd5, 0x22, 0xb3, 0xbc, 0x07, 0x8b, 0x6c, 0xc5, 0xd4,
-	0x07, 0x2b, 0x1c, 0xbf, 0xce, 0xf0, 0x24, 0xc4, 0xbd, 0xf7, 0x07, 0xda, 0x9e, 0xee, 0xd5, 0xf1,
-	0xb0, 0xf7, 0xf3, 0x05, 0xec, 0xfc, 0x7e, 0x01, 0x3b, 0xff, 0x5e, 0xc0, 0xce, 0xbb, 0xb7, 0xfb,
-	0x2b, 0xd5, 0x4f, 0x7f, 0x6e, 0xfd, 0xa9, 0x81, 0xfb, 0x2f, 0xd9, 0x28, 0x8b, 0x6b, 0x1f, 0x7c,
-	0x0f, 0xee, 0x05, 0x88, 0x63, 0x1f, 0xa9, 0x58, 0x9a, 0xa1, 0x7b, 0xb8, 0x6b, 0xdf, 0x76, 0xb4,
-	0xdd, 0x30, 0x8f, 0xbb, 0x7d, 0x59, 0x40, 0x6d, 0x5e, 0xc0, 0x75, 0x05, 0xdc, 0x1c, 0x60, 0x79,
-	0xdd, 0xa0, 0x61, 0x33, 0x03, 0xe8, 0x13, 0x44, 0xb1, 0x34, 0xd0, 0xaa, 0x27, 0xbf, 0x8d, 0x01,
-	0xe8, 0x26, 0x38, 0xa5, 0x84, 0x73, 0xc2, 0x26, 0xbc, 0xb7, 0x34, 0x58, 0xda, 0x5b, 0xf5, 0x9a,
-	0xa9, 0x61, 0x7f, 0xb1, 0x81, 0x77, 0x6f, 0xf7, 0x1f, 0xdc, 0xe0, 0x7d, 0x6e, 0xfd, 0xa1, 0x83,
-	0xe5, 0x13, 0x94, 0x22, 0xca, 0x8d, 0x6f, 0xc1, 0x3a, 0x45, 0x53, 0x9f, 0x62, 0xca, 0xfc, 0xf0,
-	0x0c, 0xa5, 0x28, 0x14, 0x38, 0x55, 0x7e, 0xd6, 0x5d, 0xb3, 0x71, 0xa0, 0x77, 0x45, 0x96, 0xb7,
-	0x46, 0xd1, 0xf4, 0x25, 0xa6, 0xec, 0x59, 0x9d, 0x33, 0x9e, 0x80, 0x7b, 0x62, 0xea, 0x97, 0x27,
-	0x1f, 0x13, 0x4a, 0x84, 0x84, 0xd6, 0xdd, 0xc7, 0xff, 0x6f, 0xb4, 0x59, 0xb5, 0x3c, 0x20, 0xa6,
-	0xa7, 0x24, 0x7a, 0x51, 0x06, 0x86, 0x07, 0x3e, 0x94, 0xc5, 0x37, 0xd8, 0x0f, 0x19, 0x17, 0x7e,
-	0x82, 0x53, 0x3f, 0xc8, 0x05, 0x96, 0x9e, 0xd5, 0xdd, 0xc1, 0xbc, 0x80, 0x3b, 0x8d, 0x19, 0xb7,
-	0x65, 0x96, 0xb7, 0x56, 0x0e, 0x7b, 0x83, 0x9f, 0x31, 0x2e, 0x4e, 0x70, 0xea, 0xe6, 0x02, 0x1b,
-	0xaf, 0xc1, 0xe3, 0x72, 0xb5, 0x73, 0x9c, 0x92, 0x1f, 0x72, 0xa5, 0xaf, 0x6e, 0x89, 0x34, 0x9f,
-	0xee, 0x0e, 0x67, 0x05, 0xdc, 0x38, 0x25, 0xd1, 0x2b, 0xa9, 0x28, 0x5b, 0xbf, 0xfe, 0x4a, 0xd6,
-	0xe7, 0x05, 0x34, 0x2b, 0x87, 0xb7, 0x0f, 0xb0, 0xbc, 0x0d, 0x7e, 0xa3, 0x4f, 0xa5, 0x8d, 0x1c,
-	0x6c, 0xdd, 0xee, 0xa8, 0x2f, 0x83, 0xb2, 0x99, 0xfb, 0x74, 0x56, 0xc0, 0xcd, 0x1b, 0x8b, 0x9e,
-	0x2e, 0x14, 0xf3, 0x02, 0x0e, 0xda, 0x97, 0xad, 0x87, 0x58, 0xde, 0x26, 0x6f, 0xed, 0x35, 0x5e,
-	0x81, 0xcd, 0x73, 0x14, 0x93, 0x91, 0x3c, 0xe1, 0x20, 0x66, 0xe1, 0xb8, 0x3c, 0x1d, 0xc2, 0x46,
-	0xbd, 0x65, 0xb9, 0xee, 0x47, 0xf3, 0x02, 0xee, 0xaa, 0xe9, 0xed, 0x3a, 0xcb, 0x5b, 0x97, 0x85,
-	0x53, 0x12, 0xb9, 0x65, 0xfa, 0x44, 0x66, 0x87, 0x2b, 0xd5, 0x45, 0xd0, 0xdc, 0xa7, 0x7f, 0xcd,
-	0x4c, 0xed, 0x72, 0x66, 0x6a, 0xff, 0xcc, 0x4c, 0xed, 0xd7, 0x2b, 0xb3, 0x73, 0x79, 0x65, 0x76,
-	0xfe, 0xbe, 0x32, 0x3b, 0xdf, 0x7d, 0x1c, 0x11, 0x71, 0x96, 0x05, 0x76, 0xc8, 0xa8, 0x13, 0x93,
-	0x09, 0x76, 0xe2, 0x80, 0xee, 0xf3, 0xd1, 0xd8, 0x99, 0xaa, 0x27, 0x5f, 0xe4, 0x09, 0xe6, 0xc1,
-	0xb2, 0x7c, 0x49, 0x3f, 0xfb, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x24, 0xbf, 0x78, 0x95, 0x0b, 0x06,
-	0x00, 0x00,
+	// 828 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xbf, 0x6f, 0xdb, 0x46,
+	0x14, 0x16, 0x6b, 0xc6, 0xb5, 0x4f, 0xf9, 0x65, 0xda, 0x75, 0x64, 0xd9, 0xe6, 0xa9, 0x44, 0x51,
+	0x78, 0xa8, 0x29, 0xd8, 0x85, 0x0b, 0x44, 0x40, 0x53, 0x98, 0x69, 0x87, 0xa0, 0x49, 0x61, 0xd0,
+	0x40, 0x86, 0x02, 0x05, 0x71, 0xa4, 0xae, 0xf2, 0x41, 0x3c, 0x9d, 0x72, 0x77, 0x34, 0xc4, 0x6c,
+	0xdd, 0x0a, 0x74, 0x69, 0xb6, 0x8e, 0xfe, 0x23, 0x02, 0x74, 0xed, 0xd8, 0xd1, 0xc8, 0xd4, 0x89,
+	0x28, 0xe4, 0xa5, 0xf0, 0xa8, 0xbf, 0xa0, 0xe0, 0x1d, 0xc5, 0x50, 0x0e, 0x8b, 0x66, 0xe3, 0xfb,
+	0xde, 0xf7, 0xde, 0x7d, 0xf7, 0xdd, 0x7b, 0x12, 0xd8, 0x8e, 0x43, 0xda, 0x45, 0x89, 0x3c, 0xeb,
+	0x9e, 0x1f, 0x84, 0x58, 0xa2, 0x03, 0x15, 0xb8, 0x63, 0xce, 0x24, 0xb3, 0xee, 0xc7, 0x21, 0x75,
+	0x55, 0x5c, 0x24, 0xdb, 0xbb, 0x39, 0x3d, 0xe2, 0xe9, 0x58, 0xb2, 0x2e, 0xee, 0x1f, 0x1e, 0x1d,
+	0x1d, 0x3c, 0xec, 0x0e, 0x71, 0x2a, 0x74, 0x41, 0xdb, 0xae, 0xa4, 0x69, 0x12, 0x4b, 0x22, 0xc8,
+	0xa0, 0x9a, 0x87, 0x95, 0xbc, 0xc0, 0xd1, 0xf8, 0xf0, 0xe8, 0x8b, 0xe1, 0xc1, 0xff, 0x10, 0xf8,
+	0x02, 0x61, 0x2b, 0x62, 0x82, 0x32, 0x11, 0xa8, 0xa8, 0xab, 0x83, 0x22, 0xb5, 0x31, 0x60, 0x03,
+	0xa6, 0xf1, 0xfc, 0x4b, 0xa3, 0xce, 0xab, 0x5b, 0xa0, 0xe9, 0x21, 0x81, 0x8f, 0xa3, 0x88, 0x25,
+	0x23, 0x69, 0xb5, 0xc0, 0x87, 0xa8, 0xdf, 0xe7, 0x58, 0x88, 0x96, 0xd1, 0x31, 0xf6, 0x56, 0xfd,
+	0x79, 0x68, 0xfd, 0x64, 0x80, 0x7b, 0xc5, 0x9d, 0x82, 0x71, 0x12, 0x06, 0x43, 0x9c, 0xb6, 0x3e,
+	0xe8, 0x18, 0x7b, 0xcd, 0xc3, 0xb6, 0x9b, 0x1b, 0xa1, 0x65, 0xb9, 0x05, 0xc5, 0x3d, 0x49, 0xc2,
+	0x6f, 0x71, 0xea, 0x7d, 0x79, 0x9d, 0xc1, 0x9d, 0x4a, 0x59, 0x4c, 0xa2, 0xbc, 0xf2, 0x33, 0x46,
+	0x89, 0xc4, 0x74, 0x2c, 0xd3, 0x59, 0x06, 0xb7, 0x52, 0x44, 0xe3, 0x9e, 0xf3, 0x2e, 0xcb, 0xf1,
+	0xef, 0x14, 0xa0, 0xee, 0x66, 0xfd, 0x62, 0x80, 0xb5, 0xd2, 0x98, 0x52, 0xc5, 0x92, 0x52, 0xb1,
+	0x53, 0x55, 0x51, 0x92, 0xe6, 0x3a, 0x8e, 0xaf, 0x33, 0x68, 0x2f, 0x94, 0xd6, 0x29, 0xd9, 0xd6,
+	0x4a, 0xea, 0x78, 0x8e, 0x7f, 0xaf, 0x84, 0x0b, 0x35, 0xaf, 0x0c, 0x70, 0x7f, 0xfe, 0x8c, 0xa5,
+	0x18, 0x53, 0x89, 0xf9, 0xb4, 0x2a, 0x66, 0xce, 0x71, 0x9f, 0xe2, 0x01, 0x8a, 0xd2, 0x63, 0x4a,
+	0x46, 0xac, 0x90, 0xf5, 0xd5, 0x75, 0x06, 0x77, 0xab, 0x3d, 0xea, 0x54, 0xb5, 0xb5, 0xaa, 0x1a,
+	0x9a, 0xe3, 0xdf, 0x9d, 0xa3, 0x85, 0xa6, 0x36, 0x58, 0x11, 0xf8, 0x45, 0x82, 0x47, 0x11, 0x6e,
+	0xdd, 0xea, 0x18, 0x7b, 0xa6, 0x5f, 0xc6, 0x55, 0xf7, 0xf8, 0x5b, 0xf7, 0x96, 0xff, 0xd3, 0x3d,
+	0x5e, 0xeb, 0x1e, 0x7f, 0x4f, 0xf7, 0x78, 0xbd, 0x7b, 0xbc, 0x70, 0xaf, 0xd7, 0xfa, 0xf9, 0x02,
+	0x36, 0x7e, 0xbb, 0x80, 0x8d, 0x7f, 0x2e, 0x60, 0xe3, 0xcd, 0xeb, 0xfd, 0x95, 0x62, 0x04, 0x9f,
+	0x38, 0x7f, 0x18, 0xe0, 0xce, 0x33, 0xd6, 0x4f, 0xe2, 0x72, 0x2a, 0x7f, 0x00, 0xb7, 0x43, 0x24,
+	0x70, 0x80, 0x74, 0xac, 0x46, 0xb3, 0x79, 0xb8, 0xeb, 0xde, 0x5c, 0x40, 0xb7, 0x32, 0xca, 0xde,
+	0xf6, 0x65, 0x06, 0x8d, 0x59, 0x06, 0xd7, 0xb5, 0xac, 0x6a, 0x03, 0xc7, 0x6f, 0x86, 0x95, 0xa1,
+	0xb7, 0x80, 0x39, 0x42, 0x14, 0xab, 0x71, 0x5e, 0xf5, 0xd5, 0xb7, 0xd5, 0x01, 0xcd, 0x31, 0xe6,
+	0x94, 0x08, 0x41, 0xd8, 0x48, 0xb4, 0x96, 0x3a, 0x4b, 0x7b, 0xab, 0x7e, 0x15, 0xea, 0xb5, 0xe7,
+	0x17, 0x78, 0xf3, 0x7a, 0xff, 0xee, 0x82, 0xde, 0x27, 0xce, 0xef, 0x26, 0x58, 0x3e, 0x41, 0x1c,
+	0x51, 0x61, 0x7d, 0x07, 0xd6, 0x29, 0x9a, 0x04, 0x14, 0x53, 0x16, 0x44, 0x67, 0x88, 0xa3, 0x48,
+	0x62, 0xae, 0xb7, 0xcb, 0xf4, 0xec, 0xca, 0xf3, 0xbe, 0x4b, 0x72, 0xfc, 0x35, 0x8a, 0x26, 0xcf,
+	0x30, 0x65, 0x8f, 0x4b, 0xcc, 0x7a, 0x08, 0x6e, 0xcb, 0x49, 0x90, 0xcf, 0x41, 0x4c, 0x28, 0x91,
+	0x4a, 0xb4, 0xe9, 0x3d, 0x78, 0x7b, 0xd1, 0x6a, 0xd6, 0xf1, 0x81, 0x9c, 0x9c, 0x92, 0xc1, 0xd3,
+	0x3c, 0xb0, 0x7c, 0xf0, 0x91, 0x4a, 0xbe, 0xc4, 0x41, 0xc4, 0x84, 0x0c, 0xc6, 0x98, 0x07, 0x61,
+	0x2a, 0xb1, 0xda, 0x20, 0xd3, 0xeb, 0xcc, 0x32, 0xb8, 0x53, 0xe9, 0x71, 0x93, 0xe6, 0xf8, 0x6b,
+	0x79, 0xb3, 0x97, 0xf8, 0x31, 0x13, 0xf2, 0x04, 0x73, 0x2f, 0x95, 0xd8, 0x7a, 0x01, 0x1e, 0xe4,
+	0xa7, 0x9d, 0x63, 0x4e, 0x7e, 0x4c, 0x35, 0xbf, 0xd8, 0x59, 0xb5, 0x0a, 0xa6, 0xd7, 0x9b, 0x66,
+	0x70, 0xe3, 0x94, 0x0c, 0x9e, 0x2b, 0x46, 0x5e, 0xfa, 0xcd, 0xd7, 0x2a, 0x3f, 0xcb, 0xa0, 0x5d,
+	0x4c, 0x4c, 0x7d, 0x03, 0xc7, 0xdf, 0x10, 0x0b, 0x75, 0x1a, 0xb6, 0x52, 0xb0, 0x75, 0xb3, 0xa2,
+	0x5c, 0x4d, 0x3d, 0xf4, 0xde, 0xa3, 0x69, 0x06, 0x37, 0x17, 0x0e, 0x3d, 0x9d, 0x33, 0x66, 0x19,
+	0xec, 0xd4, 0x1f, 0x5b, 0x36, 0x71, 0xfc, 0x4d, 0x51, 0x5b, 0x6b, 0x3d, 0x07, 0x9b, 0xe7, 0x28,
+	0x26, 0x7d, 0xe5, 0x70, 0x18, 0xb3, 0x68, 0x98, 0xbb, 0x43, 0x58, 0x5f, 0xad, 0x91, 0xe9, 0x7d,
+	0x3c, 0xcb, 0xe0, 0xae, 0xee, 0x5e, 0xcf, 0x73, 0xfc, 0x75, 0x95, 0x38, 0x25, 0x03, 0x2f, 0x87,
+	0x4f, 0x14, 0xda, 0x5b, 0x29, 0x16, 0xc1, 0xf0, 0x1e, 0xfd, 0x39, 0xb5, 0x8d, 0xcb, 0xa9, 0x6d,
+	0xfc, 0x3d, 0xb5, 0x8d, 0x5f, 0xaf, 0xec, 0xc6, 0xe5, 0x95, 0xdd, 0xf8, 0xeb, 0xca, 0x6e, 0x7c,
+	0xff, 0xc9, 0x80, 0xc8, 0xb3, 0x24, 0x74, 0x23, 0x46, 0xbb, 0x31, 0x19, 0xe1, 0x6e, 0x1c, 0xd2,
+	0x7d, 0xd1, 0x1f, 0x76, 0x27, 0xfa, 0x1f, 0x4a, 0xa6, 0x63, 0x2c, 0xc2, 0x65, 0xf5, 0xbb, 0xfe,
+	0xf9, 0xbf, 0x03, 0x00, 0x27, 0xc8, 0x14, 0xe9, 0xba, 0x06, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -315,6 +318,18 @@ func (m *BaseAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Secp256R1PubKey != nil {
+		{
+			size, err := m.Secp256R1PubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.Sequence != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.Sequence))
 		i--
@@ -506,6 +521,10 @@ func (m *BaseAccount) Size() (n int) {
 	if m.Sequence != 0 {
 		n += 1 + sovAuth(uint64(m.Sequence))
 	}
+	if m.Secp256R1PubKey != nil {
+		l = m.Secp256R1PubKey.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
 	return n
 }
 
@@ -753,6 +772,42 @@ func (m *BaseAccount) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secp256R1PubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Secp256R1PubKey == nil {
+				m.Secp256R1PubKey = &secp256r1.PubKey{}
+			}
+			if err := m.Secp256R1PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -160,6 +160,13 @@ func validateTxSizeCostPerByte(i interface{}) error {
 	return nil
 }
 
+// SigVerifyCostSecp256r1 returns the cost of verifying a secp256r1 signature.
+// P-256 verification is about twice as expensive as secp256k1 verification,
+// so it is derived from SigVerifyCostSecp256k1.
+func (p Params) SigVerifyCostSecp256r1() uint64 {
+	return p.SigVerifyCostSecp256k1 * 2
+}
+
 // Validate checks that the parameters have valid values.
 func (p Params) Validate() error {
 	if err := validateTxSigLimit(p.TxSigLimit); err != nil {
@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/line/lbm-sdk/client"
+	"github.com/line/lbm-sdk/client/flags"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/version"
+	"github.com/line/lbm-sdk/x/authz/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	authorizationQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the authz module",
+		Long:                       "",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	authorizationQueryCmd.AddCommand(
+		GetCmdQueryGrants(),
+	)
+
+	return authorizationQueryCmd
+}
+
+// GetCmdQueryGrants implements the query authorization command.
+func GetCmdQueryGrants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grants [granter-addr] [grantee-addr] [msg-type-url]?",
+		Args:  cobra.RangeArgs(2, 3),
+		Short: "query grants for a granter-grantee pair and optionally a msg-type-url",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query authorization grants for a granter-grantee pair. If msg-type-url
+is set, it will select grants only for that msg type.
+Examples:
+$ %s query %s grants link1skj.. link1skjwj..
+$ %s query %s grants link1skjw.. link1skjwj.. /lbm.bank.v1beta1.MsgSend
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if err := sdk.ValidateAccAddress(args[0]); err != nil {
+				return err
+			}
+			if err := sdk.ValidateAccAddress(args[1]); err != nil {
+				return err
+			}
+			msgAuthorized := ""
+			if len(args) >= 3 {
+				msgAuthorized = args[2]
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Grants(
+				cmd.Context(),
+				&types.QueryGrantsRequest{
+					Granter:    args[0],
+					Grantee:    args[1],
+					MsgTypeUrl: msgAuthorized,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "grants")
+	return cmd
+}
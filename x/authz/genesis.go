@@ -0,0 +1,50 @@
+package authz
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/authz/keeper"
+	"github.com/line/lbm-sdk/x/authz/types"
+)
+
+// InitGenesis initializes new authz genesis
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data *types.GenesisState) {
+	for _, entry := range data.Authorization {
+		if entry.Expiration.Before(ctx.BlockHeader().Time) {
+			continue
+		}
+
+		grantee := sdk.AccAddress(entry.Grantee)
+		granter := sdk.AccAddress(entry.Granter)
+
+		a, ok := entry.Authorization.GetCachedValue().(types.Authorization)
+		if !ok {
+			panic("expected authorization")
+		}
+
+		err := k.SaveGrant(ctx, grantee, granter, a, entry.Expiration)
+		if err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	var entries []types.GrantAuthorization
+	k.IterateGrants(ctx, func(granter, grantee sdk.AccAddress, grant types.Grant) bool {
+		exp := grant.Expiration
+		if exp.Before(ctx.BlockHeader().Time) {
+			return false
+		}
+
+		entries = append(entries, types.GrantAuthorization{
+			Granter:       granter.String(),
+			Grantee:       grantee.String(),
+			Expiration:    exp,
+			Authorization: grant.Authorization,
+		})
+		return false
+	})
+
+	return types.NewGenesisState(entries)
+}
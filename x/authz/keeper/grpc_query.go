@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	codectypes "github.com/line/lbm-sdk/codec/types"
+	"github.com/line/lbm-sdk/store/prefix"
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/types/query"
+	"github.com/line/lbm-sdk/x/authz/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Grants implements the Query/Grants gRPC method.
+func (k Keeper) Grants(c context.Context, req *types.QueryGrantsRequest) (*types.QueryGrantsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateAccAddress(req.Granter); err != nil {
+		return nil, err
+	}
+	if err := sdk.ValidateAccAddress(req.Grantee); err != nil {
+		return nil, err
+	}
+	granter := sdk.AccAddress(req.Granter)
+	grantee := sdk.AccAddress(req.Grantee)
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req.MsgTypeUrl != "" {
+		authorization, expiration := k.GetCleanAuthorization(ctx, grantee, granter, req.MsgTypeUrl)
+		if authorization == nil {
+			return nil, sdkerrors.Wrapf(types.ErrNoAuthorizationFound, "no authorization found for %s type", req.MsgTypeUrl)
+		}
+		authorizationAny, err := codectypes.NewAnyWithValue(authorization)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+		return &types.QueryGrantsResponse{
+			Grants: []*types.Grant{{
+				Authorization: authorizationAny,
+				Expiration:    expiration,
+			}},
+		}, nil
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	authStore := prefix.NewStore(store, types.GrantStoreKeyPrefix(granter, grantee))
+
+	var authorizations []*types.Grant
+	pageRes, err := query.FilteredPaginate(authStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var grant types.Grant
+		if err := k.cdc.UnmarshalBinaryBare(value, &grant); err != nil {
+			return false, err
+		}
+		auth := grant.GetAuthorization()
+		msg, ok := auth.(proto.Message)
+		if !ok {
+			return false, status.Errorf(codes.Internal, "can't proto marshal %T", msg)
+		}
+
+		authorizationAny, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return false, status.Errorf(codes.Internal, err.Error())
+		}
+		if accumulate {
+			authorizations = append(authorizations, &types.Grant{
+				Authorization: authorizationAny,
+				Expiration:    grant.Expiration,
+			})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGrantsResponse{
+		Grants:     authorizations,
+		Pagination: pageRes,
+	}, nil
+}
@@ -0,0 +1,162 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"time"
+
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/authz/types"
+	banktypes "github.com/line/lbm-sdk/x/bank/types"
+)
+
+func (s *TestSuite) TestGRPCQueryAuthorization() {
+	app, ctx, queryClient, addrs := s.app, s.ctx, s.queryClient, s.addrs
+	var (
+		req              *types.QueryGrantsRequest
+		expAuthorization types.Authorization
+	)
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+		postTest func(res *types.QueryGrantsResponse)
+	}{
+		{
+			"fail invalid granter addr",
+			func() {
+				req = &types.QueryGrantsRequest{}
+			},
+			false,
+			func(res *types.QueryGrantsResponse) {},
+		},
+		{
+			"fail invalid grantee addr",
+			func() {
+				req = &types.QueryGrantsRequest{
+					Granter: addrs[0].String(),
+				}
+			},
+			false,
+			func(res *types.QueryGrantsResponse) {},
+		},
+		{
+			"fail invalid msg-type",
+			func() {
+				req = &types.QueryGrantsRequest{
+					Granter:    addrs[0].String(),
+					Grantee:    addrs[1].String(),
+					MsgTypeUrl: "unknown",
+				}
+			},
+			false,
+			func(res *types.QueryGrantsResponse) {},
+		},
+		{
+			"Success",
+			func() {
+				now := ctx.BlockHeader().Time
+				newCoins := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
+				expAuthorization = &banktypes.SendAuthorization{SpendLimit: newCoins}
+				err := app.AuthzKeeper.SaveGrant(ctx, addrs[1], addrs[0], expAuthorization, now.Add(time.Hour))
+				s.Require().NoError(err)
+				req = &types.QueryGrantsRequest{
+					Granter:    addrs[0].String(),
+					Grantee:    addrs[1].String(),
+					MsgTypeUrl: expAuthorization.MsgTypeURL(),
+				}
+			},
+			true,
+			func(res *types.QueryGrantsResponse) {
+				var auth types.Authorization
+				s.Require().Equal(1, len(res.Grants))
+				err := s.app.InterfaceRegistry().UnpackAny(res.Grants[0].Authorization, &auth)
+				s.Require().NoError(err)
+				s.Require().NotNil(auth)
+				s.Require().Equal(auth.String(), expAuthorization.String())
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		s.Run(testCase.msg, func() {
+			testCase.malleate()
+			result, err := queryClient.Grants(gocontext.Background(), req)
+			if testCase.expPass {
+				s.Require().NoError(err)
+				testCase.postTest(result)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestGRPCQueryAuthorizations() {
+	app, ctx, queryClient, addrs := s.app, s.ctx, s.queryClient, s.addrs
+	var (
+		req              *types.QueryGrantsRequest
+		expAuthorization types.Authorization
+	)
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+		postTest func(res *types.QueryGrantsResponse)
+	}{
+		{
+			"fail invalid granter addr",
+			func() {
+				req = &types.QueryGrantsRequest{}
+			},
+			false,
+			func(res *types.QueryGrantsResponse) {},
+		},
+		{
+			"no values",
+			func() {
+				req = &types.QueryGrantsRequest{
+					Granter: addrs[0].String(),
+					Grantee: addrs[1].String(),
+				}
+			},
+			true,
+			func(res *types.QueryGrantsResponse) {
+				s.Require().Len(res.Grants, 0)
+			},
+		},
+		{
+			"authorizations present",
+			func() {
+				now := ctx.BlockHeader().Time
+				newCoins := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
+				expAuthorization = &banktypes.SendAuthorization{SpendLimit: newCoins}
+				err := app.AuthzKeeper.SaveGrant(ctx, addrs[1], addrs[0], expAuthorization, now.Add(time.Hour))
+				s.Require().NoError(err)
+				req = &types.QueryGrantsRequest{
+					Granter: addrs[0].String(),
+					Grantee: addrs[1].String(),
+				}
+			},
+			true,
+			func(res *types.QueryGrantsResponse) {
+				var auth types.Authorization
+				s.Require().Equal(1, len(res.Grants))
+				err := s.app.InterfaceRegistry().UnpackAny(res.Grants[0].Authorization, &auth)
+				s.Require().NoError(err)
+				s.Require().NotNil(auth)
+				s.Require().Equal(auth.String(), expAuthorization.String())
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		s.Run(testCase.msg, func() {
+			testCase.malleate()
+			result, err := queryClient.Grants(gocontext.Background(), req)
+			if testCase.expPass {
+				s.Require().NoError(err)
+				testCase.postTest(result)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,258 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	ocproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/line/lbm-sdk/baseapp"
+	"github.com/line/lbm-sdk/simapp"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/authz/types"
+	banktypes "github.com/line/lbm-sdk/x/bank/types"
+)
+
+var bankSendAuthMsgType = sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+type TestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	queryClient types.QueryClient
+}
+
+func (s *TestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, ocproto.Header{})
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockHeader(ocproto.Header{Time: now})
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.AuthzKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+	s.queryClient = queryClient
+
+	s.app = app
+	s.ctx = ctx
+	s.queryClient = queryClient
+	s.addrs = simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+}
+
+func (s *TestSuite) TestKeeper() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+
+	granterAddr := addrs[0]
+	granteeAddr := addrs[1]
+	recipientAddr := addrs[2]
+	s.Require().NoError(app.BankKeeper.SetBalances(ctx, granterAddr, sdk.NewCoins(sdk.NewInt64Coin("steak", 10000))))
+	s.Require().Equal(app.BankKeeper.GetBalance(ctx, granterAddr, "steak"), sdk.NewInt64Coin("steak", 10000))
+
+	s.T().Log("verify that no authorization returns nil")
+	authorization, expiration := app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	s.Require().Nil(authorization)
+	s.Require().Equal(expiration, time.Time{})
+	now := s.ctx.BlockHeader().Time
+	s.Require().NotNil(now)
+
+	newCoins := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
+	s.T().Log("verify if expired authorization is rejected")
+	x := &banktypes.SendAuthorization{SpendLimit: newCoins}
+	err := app.AuthzKeeper.SaveGrant(ctx, granterAddr, granteeAddr, x, now.Add(-1*time.Hour))
+	s.Require().NoError(err)
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	s.Require().Nil(authorization)
+
+	s.T().Log("verify if authorization is accepted")
+	x = &banktypes.SendAuthorization{SpendLimit: newCoins}
+	err = app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, x, now.Add(time.Hour))
+	s.Require().NoError(err)
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	s.Require().NotNil(authorization)
+	s.Require().Equal(authorization.MsgTypeURL(), bankSendAuthMsgType)
+
+	s.T().Log("verify fetching authorization with wrong msg type fails")
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, sdk.MsgTypeURL(&banktypes.MsgMultiSend{}))
+	s.Require().Nil(authorization)
+
+	s.T().Log("verify fetching authorization with wrong grantee fails")
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, recipientAddr, granterAddr, bankSendAuthMsgType)
+	s.Require().Nil(authorization)
+
+	s.T().Log("verify revoke fails with wrong information")
+	err = app.AuthzKeeper.DeleteGrant(ctx, recipientAddr, granterAddr, bankSendAuthMsgType)
+	s.Require().Error(err)
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, recipientAddr, granterAddr, bankSendAuthMsgType)
+	s.Require().Nil(authorization)
+
+	s.T().Log("verify revoke executes with correct information")
+	err = app.AuthzKeeper.DeleteGrant(ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	s.Require().NoError(err)
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	s.Require().Nil(authorization)
+}
+
+func (s *TestSuite) TestKeeperIter() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+
+	granterAddr := addrs[0]
+	granteeAddr := addrs[1]
+	granter2Addr := addrs[2]
+	s.Require().NoError(app.BankKeeper.SetBalances(ctx, granterAddr, sdk.NewCoins(sdk.NewInt64Coin("steak", 10000))))
+	s.Require().Equal(app.BankKeeper.GetBalance(ctx, granterAddr, "steak"), sdk.NewInt64Coin("steak", 10000))
+
+	s.T().Log("verify that no authorization returns nil")
+	authorization, expiration := app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, "Abcd")
+	s.Require().Nil(authorization)
+	s.Require().Equal(time.Time{}, expiration)
+	now := s.ctx.BlockHeader().Time.Add(time.Second)
+	s.Require().NotNil(now)
+
+	newCoins := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
+	s.T().Log("verify if expired authorization is rejected")
+	x := &banktypes.SendAuthorization{SpendLimit: newCoins}
+	err := app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, x, now.Add(-1*time.Hour))
+	s.Require().NoError(err)
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, "abcd")
+	s.Require().Nil(authorization)
+
+	err = app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granter2Addr, x, now)
+	s.Require().NoError(err)
+
+	var grantees []sdk.AccAddress
+	app.AuthzKeeper.IterateGrants(ctx, func(granter, grantee sdk.AccAddress, grant types.Grant) bool {
+		s.Require().Equal(granteeAddr, grantee)
+		s.Require().Contains([]sdk.AccAddress{granterAddr, granter2Addr}, granter)
+		grantees = append(grantees, grantee)
+		return false
+	})
+	s.Require().Len(grantees, 2)
+}
+
+func (s *TestSuite) TestKeeperFees() {
+	app, addrs := s.app, s.addrs
+
+	granterAddr := addrs[0]
+	granteeAddr := addrs[1]
+	recipientAddr := addrs[2]
+	s.Require().NoError(app.BankKeeper.SetBalances(s.ctx, granterAddr, sdk.NewCoins(sdk.NewInt64Coin("steak", 10000))))
+	now := s.ctx.BlockHeader().Time
+	s.Require().NotNil(now)
+
+	smallCoin := sdk.NewCoins(sdk.NewInt64Coin("steak", 20))
+	someCoin := sdk.NewCoins(sdk.NewInt64Coin("steak", 123))
+
+	msgs := types.NewMsgExec(granteeAddr, []sdk.Msg{
+		&banktypes.MsgSend{
+			Amount:      sdk.NewCoins(sdk.NewInt64Coin("steak", 2)),
+			FromAddress: granterAddr.String(),
+			ToAddress:   recipientAddr.String(),
+		},
+	})
+
+	s.Require().NoError(msgs.UnpackInterfaces(app.AppCodec()))
+
+	s.T().Log("verify dispatch fails with invalid authorization")
+	executeMsgs, err := msgs.GetMessages()
+	s.Require().NoError(err)
+	result, err := app.AuthzKeeper.DispatchActions(s.ctx, granteeAddr, executeMsgs)
+
+	s.Require().Nil(result)
+	s.Require().NotNil(err)
+
+	s.T().Log("verify dispatch executes with correct information")
+	// grant authorization
+	err = app.AuthzKeeper.SaveGrant(s.ctx, granteeAddr, granterAddr, &banktypes.SendAuthorization{SpendLimit: smallCoin}, now)
+	s.Require().NoError(err)
+	authorization, _ := app.AuthzKeeper.GetCleanAuthorization(s.ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	s.Require().NotNil(authorization)
+
+	s.Require().Equal(authorization.MsgTypeURL(), bankSendAuthMsgType)
+
+	executeMsgs, err = msgs.GetMessages()
+	s.Require().NoError(err)
+
+	result, err = app.AuthzKeeper.DispatchActions(s.ctx, granteeAddr, executeMsgs)
+	s.Require().NoError(err)
+	s.Require().NotNil(result)
+
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(s.ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	s.Require().NotNil(authorization)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("steak", 18)), authorization.(*banktypes.SendAuthorization).SpendLimit)
+	s.Require().Equal(sdk.NewInt64Coin("steak", 9998), app.BankKeeper.GetBalance(s.ctx, granterAddr, "steak"))
+
+	s.T().Log("verify dispatch fails with overlimit")
+	// grant authorization
+
+	msgs = types.NewMsgExec(granteeAddr, []sdk.Msg{
+		&banktypes.MsgSend{
+			Amount:      someCoin,
+			FromAddress: granterAddr.String(),
+			ToAddress:   recipientAddr.String(),
+		},
+	})
+
+	s.Require().NoError(msgs.UnpackInterfaces(app.AppCodec()))
+	executeMsgs, err = msgs.GetMessages()
+	s.Require().NoError(err)
+
+	result, err = app.AuthzKeeper.DispatchActions(s.ctx, granteeAddr, executeMsgs)
+	s.Require().Nil(result)
+	s.Require().NotNil(err)
+
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(s.ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	s.Require().NotNil(authorization)
+}
+
+// Tests that the events of the dispatched msgs are emitted by the authz keeper
+func (s *TestSuite) TestDispatchedEvents() {
+	require := s.Require()
+	app, addrs := s.app, s.addrs
+	granterAddr := addrs[0]
+	granteeAddr := addrs[1]
+	recipientAddr := addrs[2]
+	require.NoError(app.BankKeeper.SetBalances(s.ctx, granterAddr, sdk.NewCoins(sdk.NewInt64Coin("steak", 10000))))
+	expiration := s.ctx.BlockTime().Add(1 * time.Second) // must be in the future
+
+	smallCoin := sdk.NewCoins(sdk.NewInt64Coin("steak", 20))
+	msgs := types.NewMsgExec(granteeAddr, []sdk.Msg{
+		&banktypes.MsgSend{
+			Amount:      sdk.NewCoins(sdk.NewInt64Coin("steak", 2)),
+			FromAddress: granterAddr.String(),
+			ToAddress:   recipientAddr.String(),
+		},
+	})
+
+	// grant authorization
+	err := app.AuthzKeeper.SaveGrant(s.ctx, granteeAddr, granterAddr, &banktypes.SendAuthorization{SpendLimit: smallCoin}, expiration)
+	require.NoError(err)
+	authorization, _ := app.AuthzKeeper.GetCleanAuthorization(s.ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	require.NotNil(authorization)
+	require.Equal(authorization.MsgTypeURL(), bankSendAuthMsgType)
+
+	executeMsgs, err := msgs.GetMessages()
+	require.NoError(err)
+
+	result, err := app.AuthzKeeper.DispatchActions(s.ctx, granteeAddr, executeMsgs)
+	require.NoError(err)
+	require.NotNil(result)
+	events := s.ctx.EventManager().Events()
+	requiredEvents := map[string]bool{
+		banktypes.EventTypeTransfer: false,
+		sdk.EventTypeMessage:        false,
+	}
+	for _, e := range events {
+		if _, ok := requiredEvents[e.Type]; ok {
+			requiredEvents[e.Type] = true
+		}
+	}
+	for k, v := range requiredEvents {
+		require.True(v, "%s event was not emitted", k)
+	}
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
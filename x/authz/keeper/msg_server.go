@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/x/authz/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the authz MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// Grant implements the MsgServer.Grant method to create a new grant.
+func (k msgServer) Grant(goCtx context.Context, msg *types.MsgGrant) (*types.MsgGrantResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	grantee := sdk.AccAddress(msg.Grantee)
+	granter := sdk.AccAddress(msg.Granter)
+
+	authorization := msg.GetAuthorization()
+	if authorization == nil {
+		return nil, sdkerrors.Wrap(types.ErrUnknownAuthorizationType, "failed to get authorization")
+	}
+	if !msg.Grant.Expiration.After(ctx.BlockTime()) {
+		return nil, types.ErrInvalidExpirationTime
+	}
+
+	t := authorization.MsgTypeURL()
+	if k.router.HandlerByTypeURL(t) == nil && k.router.Handler(t) == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "%s doesn't exist.", t)
+	}
+
+	err := k.SaveGrant(ctx, grantee, granter, authorization, msg.Grant.Expiration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgGrantResponse{}, nil
+}
+
+// Revoke implements the MsgServer.Revoke method.
+func (k msgServer) Revoke(goCtx context.Context, msg *types.MsgRevoke) (*types.MsgRevokeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	grantee := sdk.AccAddress(msg.Grantee)
+	granter := sdk.AccAddress(msg.Granter)
+
+	err := k.DeleteGrant(ctx, grantee, granter, msg.MsgTypeUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeResponse{}, nil
+}
+
+// Exec implements the MsgServer.Exec method.
+func (k msgServer) Exec(goCtx context.Context, msg *types.MsgExec) (*types.MsgExecResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	grantee := sdk.AccAddress(msg.Grantee)
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := k.DispatchActions(ctx, grantee, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgExecResponse{Results: results}, nil
+}
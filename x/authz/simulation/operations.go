@@ -0,0 +1,285 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/line/lbm-sdk/baseapp"
+	"github.com/line/lbm-sdk/codec"
+	"github.com/line/lbm-sdk/simapp/helpers"
+	simappparams "github.com/line/lbm-sdk/simapp/params"
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	simtypes "github.com/line/lbm-sdk/types/simulation"
+	"github.com/line/lbm-sdk/x/authz/keeper"
+	"github.com/line/lbm-sdk/x/authz/types"
+	banktypes "github.com/line/lbm-sdk/x/bank/types"
+	"github.com/line/lbm-sdk/x/simulation"
+)
+
+// authz message types
+var (
+	TypeMsgGrant  = sdk.MsgTypeURL(&types.MsgGrant{})
+	TypeMsgRevoke = sdk.MsgTypeURL(&types.MsgRevoke{})
+	TypeMsgExec   = sdk.MsgTypeURL(&types.MsgExec{})
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgGrant = "op_weight_msg_grant"
+	OpWeightRevoke   = "op_weight_msg_revoke"
+	OpWeightExec     = "op_weight_msg_execute"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONMarshaler,
+	ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+
+	var (
+		weightMsgGrant int
+		weightRevoke   int
+		weightExec     int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgGrant, &weightMsgGrant, nil,
+		func(_ *rand.Rand) {
+			weightMsgGrant = simappparams.DefaultWeightMsgGrant
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightRevoke, &weightRevoke, nil,
+		func(_ *rand.Rand) {
+			weightRevoke = simappparams.DefaultWeightMsgRevoke
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightExec, &weightExec, nil,
+		func(_ *rand.Rand) {
+			weightExec = simappparams.DefaultWeightMsgExec
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgGrant,
+			SimulateMsgGrant(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightRevoke,
+			SimulateMsgRevoke(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightExec,
+			SimulateMsgExec(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgGrant generates a MsgGrant with random values.
+func SimulateMsgGrant(ak types.AccountKeeper, bk types.BankKeeper, _ keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		granter, _ := simtypes.RandomAcc(r, accs)
+		grantee, _ := simtypes.RandomAcc(r, accs)
+
+		if granter.Address.Equals(grantee.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgGrant, "granter and grantee are same"), nil, nil
+		}
+
+		granterAcc := ak.GetAccount(ctx, granter.Address)
+		spendableCoins := bk.SpendableCoins(ctx, granter.Address)
+		fees, err := simtypes.RandomFees(r, ctx, spendableCoins)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgGrant, err.Error()), nil, err
+		}
+
+		spendLimit := spendableCoins.Sub(fees)
+		if spendLimit == nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgGrant, "spend limit is nil"), nil, nil
+		}
+
+		expiration := ctx.BlockTime().AddDate(1, 0, 0)
+		msg, err := types.NewMsgGrant(granter.Address, grantee.Address, generateRandomAuthorization(r, spendLimit), expiration)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgGrant, err.Error()), nil, err
+		}
+		txCfg := simappparams.MakeTestEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txCfg,
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{0},
+			[]uint64{granterAcc.GetSequence()},
+			granter.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgGrant, "unable to generate mock tx"), nil, err
+		}
+
+		_, _, err = app.Deliver(txCfg.TxEncoder(), tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to deliver tx"), nil, err
+		}
+		return simtypes.NewOperationMsg(msg, true, ""), nil, err
+	}
+}
+
+func generateRandomAuthorization(r *rand.Rand, spendLimit sdk.Coins) types.Authorization {
+	authorizations := make([]types.Authorization, 2)
+	authorizations[0] = banktypes.NewSendAuthorization(spendLimit)
+	authorizations[1] = types.NewGenericAuthorization(sdk.MsgTypeURL(&banktypes.MsgSend{}))
+
+	return authorizations[r.Intn(len(authorizations))]
+}
+
+// SimulateMsgRevoke generates a MsgRevoke with random values.
+func SimulateMsgRevoke(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var granterAddr, granteeAddr sdk.AccAddress
+		var grant types.Grant
+		hasGrant := false
+
+		k.IterateGrants(ctx, func(granter, grantee sdk.AccAddress, g types.Grant) bool {
+			grant = g
+			granterAddr = granter
+			granteeAddr = grantee
+			hasGrant = true
+			return true
+		})
+
+		if !hasGrant {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgRevoke, "no grants"), nil, nil
+		}
+
+		granterAcc, ok := simtypes.FindAccount(accs, granterAddr)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgRevoke, "account not found"), nil, nil
+		}
+
+		spendableCoins := bk.SpendableCoins(ctx, granterAddr)
+		fees, err := simtypes.RandomFees(r, ctx, spendableCoins)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgRevoke, "fee error"), nil, err
+		}
+
+		a := grant.GetAuthorization()
+		msg := types.NewMsgRevoke(granterAddr, granteeAddr, a.MsgTypeURL())
+		txCfg := simappparams.MakeTestEncodingConfig().TxConfig
+		account := ak.GetAccount(ctx, granterAddr)
+		tx, err := helpers.GenTx(
+			txCfg,
+			[]sdk.Msg{&msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{0},
+			[]uint64{account.GetSequence()},
+			granterAcc.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgRevoke, err.Error()), nil, err
+		}
+
+		_, _, err = app.Deliver(txCfg.TxEncoder(), tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgRevoke, "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(&msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgExec generates a MsgExec with random values.
+func SimulateMsgExec(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		hasGrant := false
+		var targetGrant types.Grant
+		var granterAddr sdk.AccAddress
+		var granteeAddr sdk.AccAddress
+		k.IterateGrants(ctx, func(granter, grantee sdk.AccAddress, grant types.Grant) bool {
+			targetGrant = grant
+			granterAddr = granter
+			granteeAddr = grantee
+			hasGrant = true
+			return true
+		})
+
+		if !hasGrant {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgExec, "no grant found"), nil, nil
+		}
+
+		if targetGrant.Expiration.Before(ctx.BlockHeader().Time) {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgExec, "grant expired"), nil, nil
+		}
+
+		grantee, ok := simtypes.FindAccount(accs, granteeAddr)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgExec, "Account not found"), nil, nil
+		}
+
+		if _, ok := simtypes.FindAccount(accs, granterAddr); !ok {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgExec, "Account not found"), nil, nil
+		}
+
+		granterspendableCoins := bk.SpendableCoins(ctx, granterAddr)
+		coins := simtypes.RandSubsetCoins(r, granterspendableCoins)
+		// Check send_enabled status of each sent coin denom
+		if err := bk.SendEnabledCoins(ctx, coins...); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgExec, err.Error()), nil, nil
+		}
+
+		msg := []sdk.Msg{banktypes.NewMsgSend(granterAddr, granteeAddr, coins)}
+		authorization := targetGrant.GetAuthorization()
+		if authorization.MsgTypeURL() != sdk.MsgTypeURL(msg[0]) {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgExec, "unsupported authorization"), nil, nil
+		}
+
+		sendAuth, ok := authorization.(*banktypes.SendAuthorization)
+		if ok {
+			_, err := sendAuth.Accept(ctx, msg[0])
+			if err != nil {
+				if sdkerrors.ErrInsufficientFunds.Is(err) {
+					return simtypes.NoOpMsg(types.ModuleName, TypeMsgExec, err.Error()), nil, nil
+				}
+				return simtypes.NoOpMsg(types.ModuleName, TypeMsgExec, err.Error()), nil, err
+			}
+		}
+
+		msgExec := types.NewMsgExec(granteeAddr, msg)
+		granteeSpendableCoins := bk.SpendableCoins(ctx, granteeAddr)
+		fees, err := simtypes.RandomFees(r, ctx, granteeSpendableCoins)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgExec, "fee error"), nil, err
+		}
+
+		txCfg := simappparams.MakeTestEncodingConfig().TxConfig
+		granteeAcc := ak.GetAccount(ctx, granteeAddr)
+		tx, err := helpers.GenTx(
+			txCfg,
+			[]sdk.Msg{&msgExec},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{0},
+			[]uint64{granteeAcc.GetSequence()},
+			grantee.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgExec, err.Error()), nil, err
+		}
+
+		_, _, err = app.Deliver(txCfg.TxEncoder(), tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgExec, err.Error()), nil, err
+		}
+		return simtypes.NewOperationMsg(&msgExec, true, ""), nil, nil
+	}
+}
@@ -0,0 +1,84 @@
+package types
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	cdctypes "github.com/line/lbm-sdk/codec/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+var (
+	_ cdctypes.UnpackInterfacesMessage = &Grant{}
+	_ cdctypes.UnpackInterfacesMessage = &GrantAuthorization{}
+)
+
+// NewGrant returns new Grant
+func NewGrant(a Authorization, expiration time.Time) (Grant, error) {
+	g := Grant{
+		Expiration: expiration,
+	}
+	msg, ok := a.(proto.Message)
+	if !ok {
+		return Grant{}, sdkerrors.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", a)
+	}
+
+	any, err := cdctypes.NewAnyWithValue(msg)
+	if err != nil {
+		return Grant{}, err
+	}
+	g.Authorization = any
+
+	return g, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (g Grant) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	var a Authorization
+	return unpacker.UnpackAny(g.Authorization, &a)
+}
+
+// GetAuthorization returns the cached value from the Grant.Authorization if present.
+func (g Grant) GetAuthorization() Authorization {
+	if g.Authorization == nil {
+		return nil
+	}
+	a, ok := g.Authorization.GetCachedValue().(Authorization)
+	if !ok {
+		return nil
+	}
+	return a
+}
+
+// ValidateBasic performs a stateless validation of the grant.
+func (g Grant) ValidateBasic() error {
+	if g.Expiration.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidExpirationTime, "expiration time cannot be empty")
+	}
+
+	av := g.Authorization.GetCachedValue()
+	a, ok := av.(Authorization)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", (Authorization)(nil), av)
+	}
+	return a.ValidateBasic()
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (g GrantAuthorization) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	var a Authorization
+	return unpacker.UnpackAny(g.Authorization, &a)
+}
+
+// GetAuthorization returns the cached value from the GrantAuthorization.Authorization if present.
+func (g GrantAuthorization) GetAuthorization() Authorization {
+	if g.Authorization == nil {
+		return nil
+	}
+	a, ok := g.Authorization.GetCachedValue().(Authorization)
+	if !ok {
+		return nil
+	}
+	return a
+}
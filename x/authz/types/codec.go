@@ -0,0 +1,54 @@
+package types
+
+import (
+	"github.com/line/lbm-sdk/codec"
+	"github.com/line/lbm-sdk/codec/types"
+	cryptocodec "github.com/line/lbm-sdk/crypto/codec"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the necessary x/authz interfaces and concrete types
+// on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterInterface((*Authorization)(nil), nil)
+	cdc.RegisterConcrete(&MsgGrant{}, "lbm-sdk/MsgGrant", nil)
+	cdc.RegisterConcrete(&MsgRevoke{}, "lbm-sdk/MsgRevoke", nil)
+	cdc.RegisterConcrete(&MsgExec{}, "lbm-sdk/MsgExec", nil)
+	cdc.RegisterConcrete(&GenericAuthorization{}, "lbm-sdk/GenericAuthorization", nil)
+}
+
+// RegisterInterfaces registers the interfaces types with the interface registry
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgGrant{},
+		&MsgRevoke{},
+		&MsgExec{},
+	)
+
+	registry.RegisterInterface(
+		"lbm.authz.v1beta1.Authorization",
+		(*Authorization)(nil),
+		&GenericAuthorization{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	amino = codec.NewLegacyAmino()
+
+	// ModuleCdc references the global x/authz module codec. Note, the codec
+	// should ONLY be used in certain instances of tests and for JSON encoding as Amino
+	// is still used for that purpose.
+	//
+	// The actual codec used for serialization should be provided to x/authz and
+	// defined at the application level.
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	amino.Seal()
+}
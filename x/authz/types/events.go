@@ -0,0 +1,13 @@
+package types
+
+// authz module events
+const (
+	EventTypeGrant  = "grant"
+	EventTypeRevoke = "revoke"
+
+	AttributeKeyMsgTypeURL = "msg_type_url"
+	AttributeKeyGranter    = "granter"
+	AttributeKeyGrantee    = "grantee"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,27 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/authz/types"
+	banktypes "github.com/line/lbm-sdk/x/bank/types"
+)
+
+func TestGenericAuthorization(t *testing.T) {
+	t.Log("verify ValidateBasic returns nil for service msg")
+	a := types.NewGenericAuthorization(sdk.MsgTypeURL(&banktypes.MsgSend{}))
+	require.NoError(t, a.ValidateBasic())
+	require.Equal(t, "/lbm.bank.v1beta1.MsgSend", a.Msg)
+
+	resp, err := a.Accept(sdk.Context{}, banktypes.NewMsgSend(granter, grantee, coinsPos))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	require.Nil(t, resp.Updated)
+
+	t.Log("verify ValidateBasic returns error for empty msg type url")
+	require.Error(t, types.NewGenericAuthorization("").ValidateBasic())
+}
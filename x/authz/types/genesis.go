@@ -0,0 +1,59 @@
+package types
+
+import (
+	cdctypes "github.com/line/lbm-sdk/codec/types"
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+var _ cdctypes.UnpackInterfacesMessage = GenesisState{}
+
+// NewGenesisState creates new GenesisState object
+func NewGenesisState(entries []GrantAuthorization) *GenesisState {
+	return &GenesisState{
+		Authorization: entries,
+	}
+}
+
+// DefaultGenesisState - Return a default genesis state
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// ValidateGenesis check the given genesis state has no integrity issues
+func ValidateGenesis(data GenesisState) error {
+	for _, a := range data.Authorization {
+		if err := sdk.ValidateAccAddress(a.Granter); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid granter address (%s)", err)
+		}
+		if err := sdk.ValidateAccAddress(a.Grantee); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid grantee address (%s)", err)
+		}
+		if a.Granter == a.Grantee {
+			return sdkerrors.Wrap(ErrGranteeIsGranter, "granter and grantee cannot be same")
+		}
+		if a.Expiration.IsZero() {
+			return sdkerrors.Wrap(ErrInvalidExpirationTime, "expiration time cannot be empty")
+		}
+
+		authorization := a.GetAuthorization()
+		if authorization == nil {
+			return sdkerrors.Wrap(ErrUnknownAuthorizationType, "failed to get authorization")
+		}
+		if err := authorization.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (data GenesisState) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for _, a := range data.Authorization {
+		err := a.UnpackInterfaces(unpacker)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
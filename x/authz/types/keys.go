@@ -0,0 +1,56 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/line/lbm-sdk/types"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "authz"
+
+	// StoreKey is the store key string for authz
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for authz
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for authz
+	QuerierRoute = ModuleName
+)
+
+var (
+	// GrantKey is the prefix of the kvstore for authorization grants
+	GrantKey = []byte{0x01}
+
+	// AddressDelimiter separates the granter, the grantee and the msg type in a store key.
+	// Contract: Address must not contain this character
+	AddressDelimiter = ","
+)
+
+// GetAuthorizationStoreKey is the canonical key to store a grant from granter
+// to grantee for the given msg type URL.
+func GetAuthorizationStoreKey(grantee sdk.AccAddress, granter sdk.AccAddress, msgType string) []byte {
+	return append(GrantStoreKeyPrefix(granter, grantee), []byte(msgType)...)
+}
+
+// GrantStoreKeyPrefix returns a prefix to scan for all grants from granter to grantee.
+func GrantStoreKeyPrefix(granter sdk.AccAddress, grantee sdk.AccAddress) []byte {
+	key := append(append(GrantKey, granter.Bytes()...), AddressDelimiter...)
+	return append(append(key, grantee.Bytes()...), AddressDelimiter...)
+}
+
+// ParseAuthorizationStoreKey extracts and returns the granter, grantee and
+// msg type URL from the given key.
+func ParseAuthorizationStoreKey(key []byte) (granter, grantee sdk.AccAddress, msgType string) {
+	// key is of format:
+	// 0x01<granterAddress>,<granteeAddress>,<msgType>
+	parts := strings.SplitN(string(key[len(GrantKey):]), AddressDelimiter, 3)
+	if len(parts) != 3 {
+		panic(fmt.Sprintf("unexpected authorization key: %s", key))
+	}
+
+	return sdk.AccAddress(parts[0]), sdk.AccAddress(parts[1]), parts[2]
+}
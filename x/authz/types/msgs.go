@@ -0,0 +1,240 @@
+package types
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	cdctypes "github.com/line/lbm-sdk/codec/types"
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+// authz message types
+const (
+	TypeMsgGrant  = "grant"
+	TypeMsgRevoke = "revoke"
+	TypeMsgExec   = "exec"
+)
+
+var (
+	_ sdk.Msg = &MsgGrant{}
+	_ sdk.Msg = &MsgRevoke{}
+	_ sdk.Msg = &MsgExec{}
+
+	_ cdctypes.UnpackInterfacesMessage = &MsgGrant{}
+	_ cdctypes.UnpackInterfacesMessage = &MsgExec{}
+)
+
+// NewMsgGrant creates a new MsgGrant
+//nolint:interfacer
+func NewMsgGrant(granter sdk.AccAddress, grantee sdk.AccAddress, a Authorization, expiration time.Time) (*MsgGrant, error) {
+	m := &MsgGrant{
+		Granter: granter.String(),
+		Grantee: grantee.String(),
+		Grant:   Grant{Expiration: expiration},
+	}
+	err := m.SetAuthorization(a)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// GetSigners implements Msg
+func (msg MsgGrant) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Granter)}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgGrant) ValidateBasic() error {
+	if err := sdk.ValidateAccAddress(msg.Granter); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid granter address (%s)", err)
+	}
+	if err := sdk.ValidateAccAddress(msg.Grantee); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid grantee address (%s)", err)
+	}
+	if msg.Granter == msg.Grantee {
+		return sdkerrors.Wrap(ErrGranteeIsGranter, "granter and grantee cannot be same")
+	}
+
+	return msg.Grant.ValidateBasic()
+}
+
+// Type implements the sdk.Msg interface.
+func (msg MsgGrant) Type() string {
+	return TypeMsgGrant
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgGrant) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgGrant) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetAuthorization returns the cache value from the MsgGrant.Authorization if present.
+func (msg *MsgGrant) GetAuthorization() Authorization {
+	return msg.Grant.GetAuthorization()
+}
+
+// SetAuthorization converts Authorization to any and adds it to MsgGrant.Authorization.
+func (msg *MsgGrant) SetAuthorization(a Authorization) error {
+	m, ok := a.(proto.Message)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrPackAny, "can't proto marshal %T", m)
+	}
+	any, err := cdctypes.NewAnyWithValue(m)
+	if err != nil {
+		return err
+	}
+	msg.Grant.Authorization = any
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgGrant) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	return msg.Grant.UnpackInterfaces(unpacker)
+}
+
+// NewMsgRevoke creates a new MsgRevoke
+//nolint:interfacer
+func NewMsgRevoke(granter sdk.AccAddress, grantee sdk.AccAddress, msgTypeURL string) MsgRevoke {
+	return MsgRevoke{
+		Granter:    granter.String(),
+		Grantee:    grantee.String(),
+		MsgTypeUrl: msgTypeURL,
+	}
+}
+
+// GetSigners implements Msg
+func (msg MsgRevoke) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Granter)}
+}
+
+// ValidateBasic implements MsgRequest.ValidateBasic
+func (msg MsgRevoke) ValidateBasic() error {
+	if err := sdk.ValidateAccAddress(msg.Granter); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid granter address (%s)", err)
+	}
+	if err := sdk.ValidateAccAddress(msg.Grantee); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid grantee address (%s)", err)
+	}
+	if msg.Granter == msg.Grantee {
+		return sdkerrors.Wrap(ErrGranteeIsGranter, "granter and grantee cannot be same")
+	}
+
+	if msg.MsgTypeUrl == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing msg method name")
+	}
+
+	return nil
+}
+
+// Type implements the sdk.Msg interface.
+func (msg MsgRevoke) Type() string {
+	return TypeMsgRevoke
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgRevoke) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgRevoke) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgExec creates a new MsgExec
+//nolint:interfacer
+func NewMsgExec(grantee sdk.AccAddress, msgs []sdk.Msg) MsgExec {
+	msgsAny := make([]*cdctypes.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := cdctypes.NewAnyWithValue(msg)
+		if err != nil {
+			panic(err)
+		}
+
+		msgsAny[i] = any
+	}
+
+	return MsgExec{
+		Grantee: grantee.String(),
+		Msgs:    msgsAny,
+	}
+}
+
+// GetMessages returns the cache values from the MsgExec.Msgs if present.
+func (msg MsgExec) GetMessages() ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(msg.Msgs))
+	for i, msgAny := range msg.Msgs {
+		msg, ok := msgAny.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "messages contains %T which is not a sdk.Msg", msgAny)
+		}
+		msgs[i] = msg
+	}
+
+	return msgs, nil
+}
+
+// GetSigners implements Msg
+func (msg MsgExec) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Grantee)}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgExec) ValidateBasic() error {
+	if err := sdk.ValidateAccAddress(msg.Grantee); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid grantee address (%s)", err)
+	}
+
+	if len(msg.Msgs) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "messages cannot be empty")
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return err
+	}
+
+	for _, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Type implements the sdk.Msg interface.
+func (msg MsgExec) Type() string {
+	return TypeMsgExec
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgExec) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgExec) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgExec) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for _, x := range msg.Msgs {
+		var msgExecAuthorized sdk.Msg
+		err := unpacker.UnpackAny(x, &msgExecAuthorized)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
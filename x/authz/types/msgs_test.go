@@ -0,0 +1,123 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/crypto/keys/secp256k1"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/authz/types"
+	banktypes "github.com/line/lbm-sdk/x/bank/types"
+)
+
+var (
+	coinsPos   = sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
+	granter    = sdk.BytesToAccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	grantee    = sdk.BytesToAccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msgTypeURL = sdk.MsgTypeURL(&banktypes.MsgSend{})
+)
+
+func TestMsgExecAuthorized(t *testing.T) {
+	tests := []struct {
+		title      string
+		grantee    sdk.AccAddress
+		msgs       []sdk.Msg
+		expectPass bool
+	}{
+		{"nil grantee address", "", []sdk.Msg{}, false},
+		{"zero-messages test: should fail", grantee, []sdk.Msg{}, false},
+		{"valid test: msg type", grantee, []sdk.Msg{
+			banktypes.NewMsgSend(granter, grantee, coinsPos),
+		}, true},
+		{"invalid test: inner msg fails validate basic", grantee, []sdk.Msg{
+			banktypes.NewMsgSend("", grantee, coinsPos),
+		}, false},
+	}
+	for i, tc := range tests {
+		msg := types.NewMsgExec(tc.grantee, tc.msgs)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgRevokeAuthorization(t *testing.T) {
+	tests := []struct {
+		title            string
+		granter, grantee sdk.AccAddress
+		msgType          string
+		expectPass       bool
+	}{
+		{"nil Granter address", "", grantee, msgTypeURL, false},
+		{"nil Grantee address", granter, "", msgTypeURL, false},
+		{"nil Granter and Grantee address", "", "", msgTypeURL, false},
+		{"valid test case", granter, grantee, msgTypeURL, true},
+		{"same granter and grantee", granter, granter, msgTypeURL, false},
+		{"empty msg type", granter, grantee, "", false},
+	}
+	for i, tc := range tests {
+		msg := types.NewMsgRevoke(tc.granter, tc.grantee, tc.msgType)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgGrantAuthorization(t *testing.T) {
+	tests := []struct {
+		title            string
+		granter, grantee sdk.AccAddress
+		authorization    types.Authorization
+		expiration       time.Time
+		expectErr        bool
+		expectPass       bool
+	}{
+		{"nil granter address", "", grantee, &banktypes.SendAuthorization{SpendLimit: coinsPos}, time.Now(), false, false},
+		{"nil grantee address", granter, "", &banktypes.SendAuthorization{SpendLimit: coinsPos}, time.Now(), false, false},
+		{"nil granter and grantee address", "", "", &banktypes.SendAuthorization{SpendLimit: coinsPos}, time.Now(), false, false},
+		{"nil authorization", granter, grantee, nil, time.Now(), true, false},
+		{"valid test case", granter, grantee, &banktypes.SendAuthorization{SpendLimit: coinsPos}, time.Now().AddDate(0, 1, 0), false, true},
+		{"same granter and grantee", granter, granter, &banktypes.SendAuthorization{SpendLimit: coinsPos}, time.Now().AddDate(0, 1, 0), false, false},
+		{"zero expiration", granter, grantee, &banktypes.SendAuthorization{SpendLimit: coinsPos}, time.Time{}, false, false},
+	}
+	for i, tc := range tests {
+		msg, err := types.NewMsgGrant(tc.granter, tc.grantee, tc.authorization, tc.expiration)
+		if tc.expectErr {
+			require.Error(t, err, "test: %v", i)
+			continue
+		}
+		require.NoError(t, err, "test: %v", i)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgGrantGetAuthorization(t *testing.T) {
+	require := require.New(t)
+
+	g := types.GenericAuthorization{Msg: msgTypeURL}
+	msg, err := types.NewMsgGrant(granter, grantee, &g, time.Now())
+	require.NoError(err)
+	require.Equal(msg.GetAuthorization(), &g)
+
+	g = types.GenericAuthorization{Msg: sdk.MsgTypeURL(&banktypes.MsgMultiSend{})}
+	err = msg.SetAuthorization(&g)
+	require.NoError(err)
+	require.Equal(msg.GetAuthorization(), &g)
+}
+
+func TestMsgExecGetSignBytes(t *testing.T) {
+	msg := types.NewMsgExec(grantee, []sdk.Msg{banktypes.NewMsgSend(granter, grantee, coinsPos)})
+	require.NotPanics(t, func() {
+		require.NotEmpty(t, msg.GetSignBytes())
+	})
+}
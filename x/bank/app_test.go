@@ -2,13 +2,18 @@ package bank_test
 
 import (
 	"testing"
+	"time"
 
+	metrics "github.com/armon/go-metrics"
+	abci "github.com/line/ostracon/abci/types"
 	ocproto "github.com/line/ostracon/proto/ostracon/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/line/lbm-sdk/baseapp"
 	"github.com/line/lbm-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/line/lbm-sdk/crypto/types"
 	"github.com/line/lbm-sdk/simapp"
+	"github.com/line/lbm-sdk/simapp/helpers"
 	sdk "github.com/line/lbm-sdk/types"
 	authtypes "github.com/line/lbm-sdk/x/auth/types"
 	"github.com/line/lbm-sdk/x/bank/types"
@@ -340,3 +345,85 @@ func TestMsgMultiSendDependent(t *testing.T) {
 		}
 	}
 }
+
+func TestDeliverTxsIndependentSends(t *testing.T) {
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+	cfg := metrics.DefaultConfig("")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(cfg, sink)
+	require.NoError(t, err)
+	defer metrics.NewGlobal(cfg, &metrics.BlackholeSink{}) // nolint: errcheck
+
+	// every tx is signed by another sender and sends to another recipient
+	const numTxs = 4
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	var genAccs []authtypes.GenesisAccount
+	var balances []types.Balance
+	var privs []cryptotypes.PrivKey
+	var recipients []sdk.AccAddress
+	for i := 0; i < numTxs; i++ {
+		priv := secp256k1.GenPrivKey()
+		sender := sdk.BytesToAccAddress(priv.PubKey().Address())
+		recipient := sdk.BytesToAccAddress(secp256k1.GenPrivKey().PubKey().Address())
+		privs = append(privs, priv)
+		recipients = append(recipients, recipient)
+
+		genAccs = append(genAccs, authtypes.NewBaseAccountWithAddress(sender), authtypes.NewBaseAccountWithAddress(recipient))
+		balances = append(balances, types.Balance{Address: sender.String(), Coins: coins.Add(fee...)})
+	}
+
+	txGen := simapp.MakeTestEncodingConfig().TxConfig
+	reqs := make([]abci.RequestDeliverTx, numTxs)
+	for i, priv := range privs {
+		sender := sdk.BytesToAccAddress(priv.PubKey().Address())
+		tx, err := helpers.GenTx(txGen, []sdk.Msg{types.NewMsgSend(sender, recipients[i], coins)}, fee, helpers.DefaultGenTxGas, "", []uint64{0}, []uint64{0}, priv)
+		require.NoError(t, err)
+		txBytes, err := txGen.TxEncoder()(tx)
+		require.NoError(t, err)
+		reqs[i] = abci.RequestDeliverTx{Tx: txBytes}
+	}
+
+	deliver := func(workers int) (*simapp.SimApp, []abci.ResponseDeliverTx, []byte) {
+		app := simapp.SetupWithGenesisAccounts(genAccs, balances...)
+		baseapp.SetDeliverTxWorkers(workers)(app.BaseApp)
+
+		// the fee collector account is created by the first fee paid
+		ctx := app.BaseApp.NewContext(false, ocproto.Header{})
+		app.AccountKeeper.GetModuleAccount(ctx, authtypes.FeeCollectorName)
+		app.Commit()
+
+		app.BeginBlock(abci.RequestBeginBlock{Header: ocproto.Header{Height: app.LastBlockHeight() + 1}})
+		responses := app.DeliverTxs(reqs)
+		app.EndBlock(abci.RequestEndBlock{})
+		return app, responses, app.Commit().Data
+	}
+
+	app, responses, appHash := deliver(4)
+	for _, res := range responses {
+		require.Equal(t, uint32(0), res.Code, res.Log)
+	}
+	require.Zero(t, reexecutedTxs(sink))
+
+	for _, recipient := range recipients {
+		simapp.CheckBalance(t, app, recipient, coins)
+	}
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	simapp.CheckBalance(t, app, feeCollector, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10*numTxs)))
+
+	// the txs are delivered as they would be one by one
+	_, serialResponses, serialAppHash := deliver(0)
+	require.Equal(t, serialResponses, responses)
+	require.Equal(t, serialAppHash, appHash)
+}
+
+// reexecutedTxs returns the number of txs DeliverTxs executed again.
+func reexecutedTxs(sink *metrics.InmemSink) float64 {
+	var count float64
+	for _, interval := range sink.Data() {
+		if counter, ok := interval.Counters["tx.reexecuted"]; ok {
+			count += counter.Sum
+		}
+	}
+	return count
+}
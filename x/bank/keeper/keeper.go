@@ -284,6 +284,22 @@ func (k BaseKeeper) SendCoinsFromAccountToModule(
 	return k.SendCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt)
 }
 
+// SendCoinsFromAccountToModuleDeferred is SendCoinsFromAccountToModule leaving
+// the credit of the module account to deferred, so that it is applied later on
+// by deferred.Credit. The gas consumed by both adds up to the gas consumed by
+// SendCoinsFromAccountToModule.
+func (k BaseKeeper) SendCoinsFromAccountToModuleDeferred(
+	ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins, deferred *sdk.DeferredFees,
+) error {
+
+	recipientAcc := k.ak.GetModuleAccount(ctx, recipientModule)
+	if recipientAcc == nil {
+		panic(sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", recipientModule))
+	}
+
+	return k.sendCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt, deferred)
+}
+
 // DelegateCoinsFromAccountToModule delegates coins and transfers them from a
 // delegator account to a module account. It will panic if the module account
 // does not exist or is unauthorized.
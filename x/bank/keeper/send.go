@@ -136,6 +136,12 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 // SendCoins transfers amt coins from a sending account to a receiving account.
 // An error is returned upon failure.
 func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	return k.sendCoins(ctx, fromAddr, toAddr, amt, nil)
+}
+
+// sendCoins is SendCoins leaving the credit of the receiving account to
+// deferred, if not nil.
+func (k BaseSendKeeper) sendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins, deferred *sdk.DeferredFees) error {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeTransfer,
@@ -154,9 +160,15 @@ func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAd
 		return err
 	}
 
-	err = k.AddCoins(ctx, toAddr, amt)
-	if err != nil {
-		return err
+	if deferred != nil {
+		deferred.Defer(func(ctx sdk.Context) error {
+			return k.AddCoins(ctx, toAddr, amt)
+		})
+	} else {
+		err = k.AddCoins(ctx, toAddr, amt)
+		if err != nil {
+			return err
+		}
 	}
 
 	// Create account if recipient does not exist.
@@ -6,6 +6,7 @@ import (
 	cryptocodec "github.com/line/lbm-sdk/crypto/codec"
 	sdk "github.com/line/lbm-sdk/types"
 	"github.com/line/lbm-sdk/types/msgservice"
+	authz "github.com/line/lbm-sdk/x/authz/types"
 	"github.com/line/lbm-sdk/x/bank/exported"
 )
 
@@ -16,6 +17,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&Supply{}, "lbm-sdk/Supply", nil)
 	cdc.RegisterConcrete(&MsgSend{}, "lbm-sdk/MsgSend", nil)
 	cdc.RegisterConcrete(&MsgMultiSend{}, "lbm-sdk/MsgMultiSend", nil)
+	cdc.RegisterConcrete(&SendAuthorization{}, "lbm-sdk/SendAuthorization", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -30,6 +32,11 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&Supply{},
 	)
 
+	registry.RegisterImplementations(
+		(*authz.Authorization)(nil),
+		&SendAuthorization{},
+	)
+
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
@@ -626,3 +626,63 @@ func TestCalculateRewardsMultiDelegatorMultWithdraw(t *testing.T) {
 	// commission should be zero
 	require.True(t, app.DistrKeeper.GetValidatorAccumulatedCommission(ctx, valAddrs[0]).Commission.IsZero())
 }
+
+func TestWithdrawDelegationRewardsOnTransferDelegation(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, ocproto.Header{})
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	addr := simapp.AddTestAddrs(app, ctx, 3, sdk.TokensFromConsensusPower(1000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+
+	// set module account coins
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, distrAcc.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(1000)))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// create validator with 50% commission and a second delegation
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	tstaking.DelegateWithPower(addr[1], valAddrs[0], 100)
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	tstaking.Ctx = ctx
+
+	// allocate some rewards
+	initial := sdk.TokensFromConsensusPower(20)
+	tokens := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)}
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+
+	// transfer half of the delegation, the rewards of the sender are withdrawn
+	balance := app.BankKeeper.GetBalance(ctx, addr[1], sdk.DefaultBondDenom)
+	tstaking.TransferDelegation(addr[1], valAddrs[0], addr[2], sdk.TokensFromConsensusPower(50), true)
+	require.Equal(t, balance.Amount.Add(initial.QuoRaw(4)), app.BankKeeper.GetBalance(ctx, addr[1], sdk.DefaultBondDenom).Amount)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some more rewards
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+
+	// end period
+	endingPeriod := app.DistrKeeper.IncrementValidatorPeriod(ctx, val)
+
+	// the sender and the recipient share the rewards of the transferred delegation
+	del1 := app.StakingKeeper.Delegation(ctx, addr[1], valAddrs[0])
+	rewards := app.DistrKeeper.CalculateDelegationRewards(ctx, val, del1, endingPeriod)
+	require.Equal(t, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial.QuoRaw(8))}, rewards)
+
+	del2 := app.StakingKeeper.Delegation(ctx, addr[2], valAddrs[0])
+	rewards = app.DistrKeeper.CalculateDelegationRewards(ctx, val, del2, endingPeriod)
+	require.Equal(t, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial.QuoRaw(8))}, rewards)
+
+	// the validator rewards are not affected by the transfer
+	del0 := app.StakingKeeper.Delegation(ctx, valAddrs[0].ToAccAddress(), valAddrs[0])
+	rewards = app.DistrKeeper.CalculateDelegationRewards(ctx, val, del0, endingPeriod)
+	require.Equal(t, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial.QuoRaw(2))}, rewards)
+}
@@ -0,0 +1,40 @@
+package feegrant
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/feegrant/keeper"
+	"github.com/line/lbm-sdk/x/feegrant/types"
+)
+
+// InitGenesis will initialize the keeper from a *previously validated* GenesisState
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data *types.GenesisState) error {
+	for _, f := range data.Allowances {
+		granter := sdk.AccAddress(f.Granter)
+		grantee := sdk.AccAddress(f.Grantee)
+
+		grant, err := f.GetGrant()
+		if err != nil {
+			return err
+		}
+
+		err = k.GrantAllowance(ctx, granter, grantee, grant)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExportGenesis will dump the contents of the keeper into a serializable GenesisState.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) (*types.GenesisState, error) {
+	var grants []types.Grant
+
+	err := k.IterateAllFeeAllowances(ctx, func(grant types.Grant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+
+	return &types.GenesisState{
+		Allowances: grants,
+	}, err
+}
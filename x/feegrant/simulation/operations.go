@@ -0,0 +1,175 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/line/lbm-sdk/baseapp"
+	"github.com/line/lbm-sdk/codec"
+	"github.com/line/lbm-sdk/simapp/helpers"
+	simappparams "github.com/line/lbm-sdk/simapp/params"
+	sdk "github.com/line/lbm-sdk/types"
+	simtypes "github.com/line/lbm-sdk/types/simulation"
+	"github.com/line/lbm-sdk/x/feegrant/keeper"
+	"github.com/line/lbm-sdk/x/feegrant/types"
+	"github.com/line/lbm-sdk/x/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgGrantAllowance  = "op_weight_msg_grant_fee_allowance"
+	OpWeightMsgRevokeAllowance = "op_weight_msg_grant_revoke_allowance"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONMarshaler,
+	ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+
+	var (
+		weightMsgGrantAllowance  int
+		weightMsgRevokeAllowance int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgGrantAllowance, &weightMsgGrantAllowance, nil,
+		func(_ *rand.Rand) {
+			weightMsgGrantAllowance = simappparams.DefaultWeightGrantAllowance
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgRevokeAllowance, &weightMsgRevokeAllowance, nil,
+		func(_ *rand.Rand) {
+			weightMsgRevokeAllowance = simappparams.DefaultWeightRevokeAllowance
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgGrantAllowance,
+			SimulateMsgGrantAllowance(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRevokeAllowance,
+			SimulateMsgRevokeAllowance(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgGrantAllowance generates MsgGrantAllowance with random values.
+func SimulateMsgGrantAllowance(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		granter, _ := simtypes.RandomAcc(r, accs)
+		grantee, _ := simtypes.RandomAcc(r, accs)
+		if grantee.Address.Equals(granter.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGrantAllowance, "grantee and granter cannot be same"), nil, nil
+		}
+
+		if f, _ := k.GetAllowance(ctx, granter.Address, grantee.Address); f != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGrantAllowance, "fee allowance exists"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, granter.Address)
+
+		spendableCoins := bk.SpendableCoins(ctx, account.GetAddress())
+		fees, err := simtypes.RandomFees(r, ctx, spendableCoins)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGrantAllowance, err.Error()), nil, err
+		}
+
+		spendableCoins = spendableCoins.Sub(fees)
+		if spendableCoins.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGrantAllowance, "unable to grant empty coins as SpendLimit"), nil, nil
+		}
+
+		oneYear := ctx.BlockTime().AddDate(1, 0, 0)
+		msg, err := types.NewMsgGrantAllowance(&types.BasicAllowance{
+			SpendLimit: spendableCoins,
+			Expiration: &oneYear,
+		}, granter.Address, grantee.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGrantAllowance, err.Error()), nil, err
+		}
+
+		txGen := simappparams.MakeTestEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txGen,
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{0},
+			[]uint64{account.GetSequence()},
+			granter.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgGrantAllowance, "unable to generate mock tx"), nil, err
+		}
+
+		_, _, err = app.Deliver(txGen.TxEncoder(), tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, err
+	}
+}
+
+// SimulateMsgRevokeAllowance generates a MsgRevokeAllowance with random values.
+func SimulateMsgRevokeAllowance(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		hasGrant := false
+		var granterAddr sdk.AccAddress
+		var granteeAddr sdk.AccAddress
+		k.IterateAllFeeAllowances(ctx, func(grant types.Grant) bool {
+			granterAddr = sdk.AccAddress(grant.Granter)
+			granteeAddr = sdk.AccAddress(grant.Grantee)
+			hasGrant = true
+			return true
+		})
+
+		if !hasGrant {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRevokeAllowance, "no grants"), nil, nil
+		}
+		granter, ok := simtypes.FindAccount(accs, granterAddr)
+
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRevokeAllowance, "Account not found"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, granter.Address)
+		spendableCoins := bk.SpendableCoins(ctx, account.GetAddress())
+		fees, err := simtypes.RandomFees(r, ctx, spendableCoins)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRevokeAllowance, err.Error()), nil, err
+		}
+
+		msg := types.NewMsgRevokeAllowance(granterAddr, granteeAddr)
+
+		txGen := simappparams.MakeTestEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txGen,
+			[]sdk.Msg{&msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{0},
+			[]uint64{account.GetSequence()},
+			granter.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRevokeAllowance, err.Error()), nil, err
+		}
+
+		_, _, err = app.Deliver(txGen.TxEncoder(), tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(&msg, true, ""), nil, err
+	}
+}
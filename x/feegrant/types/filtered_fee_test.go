@@ -0,0 +1,90 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	ocproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/simapp"
+	sdk "github.com/line/lbm-sdk/types"
+	banktypes "github.com/line/lbm-sdk/x/bank/types"
+	"github.com/line/lbm-sdk/x/feegrant/types"
+)
+
+func TestFilteredFeeValidAllow(t *testing.T) {
+	app := simapp.Setup(false)
+
+	ctx := app.BaseApp.NewContext(false, ocproto.Header{Time: time.Now()})
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 43))
+	leftAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 512))
+	oneHour := ctx.BlockTime().Add(time.Hour)
+
+	sendMsg := &banktypes.MsgSend{}
+	allowedMsgs := []string{sdk.MsgTypeURL(sendMsg)}
+
+	cases := map[string]struct {
+		allowance types.FeeAllowanceI
+		msgs      []sdk.Msg
+		valid     bool
+		accept    bool
+		remains   sdk.Coins
+	}{
+		"allowed message": {
+			allowance: &types.BasicAllowance{SpendLimit: atom, Expiration: &oneHour},
+			msgs:      []sdk.Msg{sendMsg},
+			valid:     true,
+			accept:    true,
+			remains:   leftAtom,
+		},
+		"disallowed message": {
+			allowance: &types.BasicAllowance{SpendLimit: atom, Expiration: &oneHour},
+			msgs:      []sdk.Msg{&banktypes.MsgMultiSend{}},
+			valid:     true,
+			accept:    false,
+		},
+		"one of messages disallowed": {
+			allowance: &types.BasicAllowance{SpendLimit: atom, Expiration: &oneHour},
+			msgs:      []sdk.Msg{sendMsg, &banktypes.MsgMultiSend{}},
+			valid:     true,
+			accept:    false,
+		},
+		"invalid inner allowance": {
+			allowance: &types.BasicAllowance{SpendLimit: sdk.Coins{sdk.Coin{Denom: "atom", Amount: sdk.NewInt(-1)}}},
+			valid:     false,
+		},
+	}
+
+	for name, stc := range cases {
+		tc := stc // to make scopelint happy
+		t.Run(name, func(t *testing.T) {
+			allowance, err := types.NewAllowedMsgAllowance(tc.allowance, allowedMsgs)
+			require.NoError(t, err)
+
+			err = allowance.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			removed, err := allowance.Accept(ctx, smallAtom, tc.msgs)
+			if !tc.accept {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.False(t, removed)
+
+			inner, err := allowance.GetAllowance()
+			require.NoError(t, err)
+			require.Equal(t, tc.remains, inner.(*types.BasicAllowance).SpendLimit)
+		})
+	}
+
+	emptyMsgs, err := types.NewAllowedMsgAllowance(&types.BasicAllowance{}, nil)
+	require.NoError(t, err)
+	require.ErrorIs(t, emptyMsgs.ValidateBasic(), types.ErrNoMessages)
+}
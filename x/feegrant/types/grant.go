@@ -0,0 +1,68 @@
+package types
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/line/lbm-sdk/codec/types"
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+var _ types.UnpackInterfacesMessage = &Grant{}
+
+// NewGrant creates a new FeeAllowanceGrant.
+//nolint:interfacer
+func NewGrant(granter, grantee sdk.AccAddress, feeAllowance FeeAllowanceI) (Grant, error) {
+	msg, ok := feeAllowance.(proto.Message)
+	if !ok {
+		return Grant{}, sdkerrors.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", feeAllowance)
+	}
+
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return Grant{}, err
+	}
+
+	return Grant{
+		Granter:   granter.String(),
+		Grantee:   grantee.String(),
+		Allowance: any,
+	}, nil
+}
+
+// ValidateBasic performs basic validation on
+// FeeAllowanceGrant
+func (a Grant) ValidateBasic() error {
+	if err := sdk.ValidateAccAddress(a.Granter); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid granter address (%s)", err)
+	}
+	if err := sdk.ValidateAccAddress(a.Grantee); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid grantee address (%s)", err)
+	}
+	if a.Grantee == a.Granter {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "cannot self-grant fee authorization")
+	}
+
+	f, err := a.GetGrant()
+	if err != nil {
+		return err
+	}
+
+	return f.ValidateBasic()
+}
+
+// GetGrant unpacks allowance
+func (a Grant) GetGrant() (FeeAllowanceI, error) {
+	allowance, ok := a.Allowance.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil, sdkerrors.Wrap(ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a Grant) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/line/lbm-sdk/types"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "feegrant"
+
+	// StoreKey is the store key string for supply
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for supply
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for supply
+	QuerierRoute = ModuleName
+)
+
+var (
+	// FeeAllowanceKeyPrefix is the set of the kvstore for fee allowance data
+	FeeAllowanceKeyPrefix = []byte{0x00}
+
+	// AddressDelimiter separates the grantee and the granter in a store key.
+	// Contract: Address must not contain this character
+	AddressDelimiter = ","
+)
+
+// FeeAllowanceKey is the canonical key to store a grant from granter to grantee
+// We store by grantee first to allow searching by everyone who granted to you
+func FeeAllowanceKey(granter sdk.AccAddress, grantee sdk.AccAddress) []byte {
+	return append(FeeAllowancePrefixByGrantee(grantee), granter.Bytes()...)
+}
+
+// FeeAllowancePrefixByGrantee returns a prefix to scan for all grants to this given address.
+func FeeAllowancePrefixByGrantee(grantee sdk.AccAddress) []byte {
+	return append(append(FeeAllowanceKeyPrefix, grantee.Bytes()...), AddressDelimiter...)
+}
+
+// ParseAddressesFromFeeAllowanceKey extracts and returns the granter, grantee from the given key.
+func ParseAddressesFromFeeAllowanceKey(key []byte) (granter, grantee sdk.AccAddress) {
+	// key is of format:
+	// 0x00<granteeAddress>,<granterAddress>
+	addrs := strings.Split(string(key[len(FeeAllowanceKeyPrefix):]), AddressDelimiter)
+	if len(addrs) != 2 {
+		panic(fmt.Sprintf("unexpected fee allowance key: %s", key))
+	}
+
+	return sdk.AccAddress(addrs[1]), sdk.AccAddress(addrs[0])
+}
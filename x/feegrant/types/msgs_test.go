@@ -0,0 +1,121 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/codec"
+	"github.com/line/lbm-sdk/codec/types"
+	"github.com/line/lbm-sdk/crypto/keys/secp256k1"
+	sdk "github.com/line/lbm-sdk/types"
+	feegranttypes "github.com/line/lbm-sdk/x/feegrant/types"
+)
+
+func TestMsgGrantAllowance(t *testing.T) {
+	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
+	addr := sdk.BytesToAccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	addr2 := sdk.BytesToAccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 2))
+	basic := &feegranttypes.BasicAllowance{
+		SpendLimit: atom,
+	}
+
+	cases := map[string]struct {
+		grantee sdk.AccAddress
+		granter sdk.AccAddress
+		grant   *feegranttypes.BasicAllowance
+		valid   bool
+	}{
+		"valid": {
+			grantee: addr,
+			granter: addr2,
+			grant:   basic,
+			valid:   true,
+		},
+		"no grantee": {
+			granter: addr2,
+			grantee: "",
+			grant:   basic,
+			valid:   false,
+		},
+		"no granter": {
+			granter: "",
+			grantee: addr,
+			grant:   basic,
+			valid:   false,
+		},
+		"grantee == granter": {
+			grantee: addr,
+			granter: addr,
+			grant:   basic,
+			valid:   false,
+		},
+	}
+
+	for _, tc := range cases {
+		msg, err := feegranttypes.NewMsgGrantAllowance(tc.grant, tc.granter, tc.grantee)
+		require.NoError(t, err)
+		err = msg.ValidateBasic()
+
+		if tc.valid {
+			require.NoError(t, err)
+
+			addrSlice := msg.GetSigners()
+			require.Equal(t, tc.granter, addrSlice[0])
+
+			allowance, err := msg.GetFeeAllowanceI()
+			require.NoError(t, err)
+			require.Equal(t, tc.grant, allowance)
+
+			err = msg.UnpackInterfaces(cdc)
+			require.NoError(t, err)
+		} else {
+			require.Error(t, err)
+		}
+	}
+}
+
+func TestMsgRevokeAllowance(t *testing.T) {
+	addr := sdk.BytesToAccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	addr2 := sdk.BytesToAccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	cases := map[string]struct {
+		grantee sdk.AccAddress
+		granter sdk.AccAddress
+		valid   bool
+	}{
+		"valid": {
+			grantee: addr,
+			granter: addr2,
+			valid:   true,
+		},
+		"no grantee": {
+			granter: addr2,
+			grantee: "",
+			valid:   false,
+		},
+		"no granter": {
+			granter: "",
+			grantee: addr,
+			valid:   false,
+		},
+		"grantee == granter": {
+			grantee: addr,
+			granter: addr,
+			valid:   false,
+		},
+	}
+
+	for _, tc := range cases {
+		msg := feegranttypes.NewMsgRevokeAllowance(tc.granter, tc.grantee)
+		err := msg.ValidateBasic()
+		if tc.valid {
+			require.NoError(t, err)
+			addrSlice := msg.GetSigners()
+			require.Equal(t, tc.granter, addrSlice[0])
+		} else {
+			require.Error(t, err)
+		}
+	}
+}
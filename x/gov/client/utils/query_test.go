@@ -58,6 +58,14 @@ func newTestCodec() *codec.LegacyAmino {
 	return cdc
 }
 
+// newNonSplitVote returns a vote as built from a MsgVote, with the deprecated
+// option populated.
+func newNonSplitVote(proposalID uint64, voter sdk.AccAddress, option types.VoteOption) types.Vote {
+	vote := types.NewVote(proposalID, voter, types.NewNonSplitVoteOption(option))
+	vote.Option = option
+	return vote
+}
+
 func TestGetPaginatedVotes(t *testing.T) {
 	type testCase struct {
 		description string
@@ -89,8 +97,8 @@ func TestGetPaginatedVotes(t *testing.T) {
 				acc2Msgs[:1],
 			},
 			votes: []types.Vote{
-				types.NewVote(0, acc1, types.OptionYes),
-				types.NewVote(0, acc2, types.OptionYes)},
+				newNonSplitVote(0, acc1, types.OptionYes),
+				newNonSplitVote(0, acc2, types.OptionYes)},
 		},
 		{
 			description: "2MsgPerTx1Chunk",
@@ -101,8 +109,8 @@ func TestGetPaginatedVotes(t *testing.T) {
 				acc2Msgs,
 			},
 			votes: []types.Vote{
-				types.NewVote(0, acc1, types.OptionYes),
-				types.NewVote(0, acc1, types.OptionYes)},
+				newNonSplitVote(0, acc1, types.OptionYes),
+				newNonSplitVote(0, acc1, types.OptionYes)},
 		},
 		{
 			description: "2MsgPerTx2Chunk",
@@ -113,8 +121,8 @@ func TestGetPaginatedVotes(t *testing.T) {
 				acc2Msgs,
 			},
 			votes: []types.Vote{
-				types.NewVote(0, acc2, types.OptionYes),
-				types.NewVote(0, acc2, types.OptionYes)},
+				newNonSplitVote(0, acc2, types.OptionYes),
+				newNonSplitVote(0, acc2, types.OptionYes)},
 		},
 		{
 			description: "IncompleteSearchTx",
@@ -123,7 +131,7 @@ func TestGetPaginatedVotes(t *testing.T) {
 			msgs: [][]sdk.Msg{
 				acc1Msgs[:1],
 			},
-			votes: []types.Vote{types.NewVote(0, acc1, types.OptionYes)},
+			votes: []types.Vote{newNonSplitVote(0, acc1, types.OptionYes)},
 		},
 		{
 			description: "InvalidPage",
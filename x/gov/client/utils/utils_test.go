@@ -33,3 +33,21 @@ func TestNormalizeProposalStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestNormalizeWeightedVoteOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		options string
+		want    string
+	}{
+		{"single option", "yes", "VOTE_OPTION_YES=1"},
+		{"single option with weight", "no=1", "VOTE_OPTION_NO=1"},
+		{"split options", "yes=0.6,NoWithVeto=0.4", "VOTE_OPTION_YES=0.6,VOTE_OPTION_NO_WITH_VETO=0.4"},
+		{"unknown option", "maybe=1", "maybe=1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, utils.NormalizeWeightedVoteOptions(tt.options))
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/gov/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2. The votes, which stored a single
+// option, are converted to weighted votes with that option at weight 1.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	var votes types.Votes
+	m.keeper.IterateAllVotes(ctx, func(vote types.Vote) bool {
+		if len(vote.Options) == 0 {
+			votes = append(votes, vote)
+		}
+		return false
+	})
+
+	for _, vote := range votes {
+		vote.Options = types.NewNonSplitVoteOption(vote.Option) //nolint
+		m.keeper.SetVote(ctx, vote)
+	}
+
+	return nil
+}
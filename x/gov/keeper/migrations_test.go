@@ -0,0 +1,39 @@
+package keeper_test
+
+import (
+	"testing"
+
+	ocproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/simapp"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/gov/keeper"
+	"github.com/line/lbm-sdk/x/gov/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, ocproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(30000000))
+
+	// store votes as they were before weighted votes
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	for i, option := range []types.VoteOption{types.OptionYes, types.OptionNoWithVeto} {
+		vote := types.Vote{ProposalId: 1, Voter: addrs[i].String(), Option: option}
+		store.Set(types.VoteKey(1, addrs[i]), app.AppCodec().MustMarshalBinaryBare(&vote))
+	}
+
+	require.NoError(t, keeper.NewMigrator(app.GovKeeper).Migrate1to2(ctx))
+
+	votes := app.GovKeeper.GetVotes(ctx, 1)
+	require.Len(t, votes, 2)
+	require.Equal(t, types.NewNonSplitVoteOption(types.OptionYes), types.WeightedVoteOptions(votes[0].Options))
+	require.Equal(t, types.NewNonSplitVoteOption(types.OptionNoWithVeto), types.WeightedVoteOptions(votes[1].Options))
+
+	// the deprecated option is no longer kept in state
+	var stored types.Vote
+	app.AppCodec().MustUnmarshalBinaryBare(store.Get(types.VoteKey(1, addrs[0])), &stored)
+	require.Equal(t, types.OptionEmpty, stored.Option)
+}
@@ -8,12 +8,12 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/duration"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
 	types1 "github.com/line/lbm-sdk/codec/types"
 	github_com_line_lbm_sdk_types "github.com/line/lbm-sdk/types"
 	types "github.com/line/lbm-sdk/types"
 	_ "github.com/regen-network/cosmos-proto"
-	_ "google.golang.org/protobuf/types/known/durationpb"
-	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -121,6 +121,44 @@ func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_ac6b6490ef95bb56, []int{1}
 }
 
+// WeightedVoteOption defines a unit of vote for vote split.
+type WeightedVoteOption struct {
+	Option VoteOption                        `protobuf:"varint,1,opt,name=option,proto3,enum=lbm.gov.v1beta1.VoteOption" json:"option,omitempty"`
+	Weight github_com_line_lbm_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/line/lbm-sdk/types.Dec" json:"weight" yaml:"weight"`
+}
+
+func (m *WeightedVoteOption) Reset()      { *m = WeightedVoteOption{} }
+func (*WeightedVoteOption) ProtoMessage() {}
+func (*WeightedVoteOption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac6b6490ef95bb56, []int{0}
+}
+func (m *WeightedVoteOption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedVoteOption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedVoteOption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedVoteOption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedVoteOption.Merge(m, src)
+}
+func (m *WeightedVoteOption) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedVoteOption) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedVoteOption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedVoteOption proto.InternalMessageInfo
+
 // TextProposal defines a standard text proposal whose changes need to be
 // manually updated in case of approval.
 type TextProposal struct {
@@ -131,7 +169,7 @@ type TextProposal struct {
 func (m *TextProposal) Reset()      { *m = TextProposal{} }
 func (*TextProposal) ProtoMessage() {}
 func (*TextProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ac6b6490ef95bb56, []int{0}
+	return fileDescriptor_ac6b6490ef95bb56, []int{1}
 }
 func (m *TextProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -171,7 +209,7 @@ type Deposit struct {
 func (m *Deposit) Reset()      { *m = Deposit{} }
 func (*Deposit) ProtoMessage() {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ac6b6490ef95bb56, []int{1}
+	return fileDescriptor_ac6b6490ef95bb56, []int{2}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -216,7 +254,7 @@ type Proposal struct {
 func (m *Proposal) Reset()      { *m = Proposal{} }
 func (*Proposal) ProtoMessage() {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ac6b6490ef95bb56, []int{2}
+	return fileDescriptor_ac6b6490ef95bb56, []int{3}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -256,7 +294,7 @@ type TallyResult struct {
 func (m *TallyResult) Reset()      { *m = TallyResult{} }
 func (*TallyResult) ProtoMessage() {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ac6b6490ef95bb56, []int{3}
+	return fileDescriptor_ac6b6490ef95bb56, []int{4}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -286,17 +324,21 @@ func (m *TallyResult) XXX_DiscardUnknown() {
 var xxx_messageInfo_TallyResult proto.InternalMessageInfo
 
 // Vote defines a vote on a governance proposal.
-// A Vote consists of a proposal ID, the voter, and the vote option.
+// A Vote consists of a proposal ID, the voter, and the weighted vote options.
 type Vote struct {
-	ProposalId uint64     `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
-	Voter      string     `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
-	Option     VoteOption `protobuf:"varint,3,opt,name=option,proto3,enum=lbm.gov.v1beta1.VoteOption" json:"option,omitempty"`
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
+	Voter      string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	// Deprecated: Prefer to use `options` instead. This field is set in queries
+	// if and only if `len(options) == 1` and that option has weight 1. In all
+	// other cases, this field will default to VOTE_OPTION_UNSPECIFIED.
+	Option  VoteOption           `protobuf:"varint,3,opt,name=option,proto3,enum=lbm.gov.v1beta1.VoteOption" json:"option,omitempty"` // Deprecated: Do not use.
+	Options []WeightedVoteOption `protobuf:"bytes,4,rep,name=options,proto3" json:"options"`
 }
 
 func (m *Vote) Reset()      { *m = Vote{} }
 func (*Vote) ProtoMessage() {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ac6b6490ef95bb56, []int{4}
+	return fileDescriptor_ac6b6490ef95bb56, []int{5}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -337,7 +379,7 @@ type DepositParams struct {
 func (m *DepositParams) Reset()      { *m = DepositParams{} }
 func (*DepositParams) ProtoMessage() {}
 func (*DepositParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ac6b6490ef95bb56, []int{5}
+	return fileDescriptor_ac6b6490ef95bb56, []int{6}
 }
 func (m *DepositParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -375,7 +417,7 @@ type VotingParams struct {
 func (m *VotingParams) Reset()      { *m = VotingParams{} }
 func (*VotingParams) ProtoMessage() {}
 func (*VotingParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ac6b6490ef95bb56, []int{6}
+	return fileDescriptor_ac6b6490ef95bb56, []int{7}
 }
 func (m *VotingParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -419,7 +461,7 @@ type TallyParams struct {
 func (m *TallyParams) Reset()      { *m = TallyParams{} }
 func (*TallyParams) ProtoMessage() {}
 func (*TallyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ac6b6490ef95bb56, []int{7}
+	return fileDescriptor_ac6b6490ef95bb56, []int{8}
 }
 func (m *TallyParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -451,6 +493,7 @@ var xxx_messageInfo_TallyParams proto.InternalMessageInfo
 func init() {
 	proto.RegisterEnum("lbm.gov.v1beta1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("lbm.gov.v1beta1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
+	proto.RegisterType((*WeightedVoteOption)(nil), "lbm.gov.v1beta1.WeightedVoteOption")
 	proto.RegisterType((*TextProposal)(nil), "lbm.gov.v1beta1.TextProposal")
 	proto.RegisterType((*Deposit)(nil), "lbm.gov.v1beta1.Deposit")
 	proto.RegisterType((*Proposal)(nil), "lbm.gov.v1beta1.Proposal")
@@ -464,94 +507,98 @@ func init() {
 func init() { proto.RegisterFile("lbm/gov/v1beta1/gov.proto", fileDescriptor_ac6b6490ef95bb56) }
 
 var fileDescriptor_ac6b6490ef95bb56 = []byte{
-	// 1387 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcf, 0x6f, 0x13, 0x47,
-	0x1b, 0xf6, 0x3a, 0xbf, 0xc7, 0x4e, 0xb2, 0x4c, 0x4c, 0xe2, 0x2c, 0x7c, 0xde, 0x65, 0xf9, 0x0e,
-	0x7c, 0x7c, 0x60, 0x97, 0x50, 0xa9, 0x22, 0x51, 0xa5, 0xda, 0xf1, 0xd2, 0xba, 0x42, 0xb6, 0xb5,
-	0x5e, 0x8c, 0x00, 0x89, 0xd5, 0xda, 0x1e, 0x9c, 0x6d, 0x77, 0x77, 0x5c, 0xef, 0x38, 0x8d, 0xd5,
-	0x0b, 0x97, 0x4a, 0xc8, 0x95, 0x2a, 0xa4, 0xf6, 0xc0, 0xc5, 0x12, 0x52, 0x6f, 0x3d, 0xa3, 0xfe,
-	0x0b, 0x45, 0x15, 0x07, 0xd4, 0x13, 0xea, 0xc1, 0x94, 0x20, 0x55, 0x88, 0x63, 0xfe, 0x82, 0x6a,
-	0x77, 0x66, 0xe3, 0xb5, 0x4d, 0x49, 0xe8, 0xcd, 0xf3, 0xce, 0xf3, 0xbc, 0xcf, 0x3b, 0xcf, 0xbe,
-	0xef, 0x0b, 0x01, 0xeb, 0x56, 0xcd, 0xce, 0x34, 0xf1, 0x6e, 0x66, 0xf7, 0x52, 0x0d, 0x11, 0xe3,
-	0x92, 0xf7, 0x3b, 0xdd, 0x6a, 0x63, 0x82, 0xe1, 0xb2, 0x55, 0xb3, 0xd3, 0xde, 0x91, 0x5d, 0x09,
-	0xa7, 0x3c, 0x6c, 0xcd, 0x70, 0xd1, 0x21, 0xb8, 0x8e, 0x4d, 0x87, 0xa2, 0x85, 0x44, 0x13, 0x37,
-	0xb1, 0xff, 0x33, 0xe3, 0xfd, 0x62, 0xd1, 0xf5, 0x3a, 0x76, 0x6d, 0xec, 0xea, 0xf4, 0x82, 0x1e,
-	0xd8, 0x95, 0xd8, 0xc4, 0xb8, 0x69, 0xa1, 0x8c, 0x7f, 0xaa, 0x75, 0xee, 0x66, 0x88, 0x69, 0x23,
-	0x97, 0x18, 0x76, 0x2b, 0xe0, 0x8e, 0x03, 0x0c, 0xa7, 0xcb, 0xae, 0x52, 0xe3, 0x57, 0x8d, 0x4e,
-	0xdb, 0x20, 0x26, 0x66, 0xc5, 0xc8, 0x37, 0x40, 0x5c, 0x43, 0x7b, 0xa4, 0xdc, 0xc6, 0x2d, 0xec,
-	0x1a, 0x16, 0x4c, 0x80, 0x19, 0x62, 0x12, 0x0b, 0x25, 0x39, 0x89, 0x3b, 0xb7, 0xa0, 0xd2, 0x03,
-	0x94, 0x40, 0xac, 0x81, 0xdc, 0x7a, 0xdb, 0x6c, 0x79, 0xd4, 0x64, 0xd4, 0xbf, 0x0b, 0x87, 0x36,
-	0x97, 0x5f, 0x3f, 0x12, 0xb9, 0xdf, 0x1f, 0x5f, 0x9c, 0xdb, 0xc6, 0x0e, 0x41, 0x0e, 0x91, 0x7f,
-	0xe5, 0xc0, 0x5c, 0x1e, 0xb5, 0xb0, 0x6b, 0x12, 0xf8, 0x11, 0x88, 0xb5, 0x98, 0x80, 0x6e, 0x36,
-	0xfc, 0xd4, 0xd3, 0xb9, 0xd5, 0x83, 0x81, 0x08, 0xbb, 0x86, 0x6d, 0x6d, 0xca, 0xa1, 0x4b, 0x59,
-	0x05, 0xc1, 0xa9, 0xd0, 0x80, 0xa7, 0xc1, 0x42, 0x83, 0xe6, 0xc0, 0x6d, 0xa6, 0x3a, 0x0c, 0xc0,
-	0xdb, 0x60, 0xd6, 0xb0, 0x71, 0xc7, 0x21, 0xc9, 0x29, 0x69, 0xea, 0x5c, 0x6c, 0x63, 0x35, 0xed,
-	0x7d, 0x07, 0xcf, 0xf6, 0xe0, 0x43, 0xa4, 0xb7, 0xb1, 0xe9, 0xe4, 0xfe, 0xff, 0x64, 0x20, 0x46,
-	0x7e, 0x7e, 0x21, 0x9e, 0x6d, 0x9a, 0x64, 0xa7, 0x53, 0x4b, 0xd7, 0xb1, 0x9d, 0xb1, 0x4c, 0x07,
-	0x65, 0xac, 0x9a, 0x7d, 0xd1, 0x6d, 0x7c, 0x99, 0x21, 0xdd, 0x16, 0x72, 0x7d, 0xac, 0xab, 0xb2,
-	0x94, 0x9b, 0xf3, 0xf7, 0x1f, 0x89, 0x91, 0xd7, 0x8f, 0xc4, 0x88, 0xfc, 0x7a, 0x16, 0xcc, 0x1f,
-	0xfa, 0xf3, 0xe1, 0xdb, 0x9e, 0xb2, 0xf2, 0x66, 0x20, 0x46, 0xcd, 0xc6, 0xc1, 0x40, 0x5c, 0xa0,
-	0x0f, 0x1a, 0x7f, 0xc7, 0x16, 0x98, 0xab, 0x53, 0x5f, 0xfc, 0x57, 0xc4, 0x36, 0x12, 0x69, 0xfa,
-	0x5d, 0xd2, 0xc1, 0x77, 0x49, 0x67, 0x9d, 0x6e, 0x2e, 0xf6, 0xdb, 0xd0, 0x40, 0x35, 0x60, 0xc0,
-	0x0a, 0x98, 0x75, 0x89, 0x41, 0x3a, 0x6e, 0x72, 0x4a, 0xe2, 0xce, 0x2d, 0x6d, 0x88, 0xe9, 0xb1,
-	0x76, 0x4b, 0x07, 0xd5, 0x55, 0x7c, 0x58, 0x4e, 0x38, 0x18, 0x88, 0xab, 0x63, 0xce, 0xd2, 0x0c,
-	0xb2, 0xca, 0x52, 0x41, 0x1b, 0xc0, 0xbb, 0xa6, 0x63, 0x58, 0x3a, 0x31, 0x2c, 0xab, 0xab, 0xb7,
-	0x91, 0xdb, 0xb1, 0x48, 0x72, 0xda, 0x2f, 0xee, 0xf4, 0x84, 0x80, 0xe6, 0x81, 0x54, 0x1f, 0x93,
-	0x3b, 0xe3, 0xb9, 0x79, 0x30, 0x10, 0xd7, 0xa9, 0xc2, 0x64, 0x16, 0x59, 0xe5, 0xfd, 0x60, 0x88,
-	0x04, 0x6f, 0x83, 0x98, 0xdb, 0xa9, 0xd9, 0x26, 0xd1, 0xbd, 0xde, 0x4d, 0xce, 0xf8, 0x3a, 0xc2,
-	0x84, 0x09, 0x5a, 0xd0, 0xd8, 0xb9, 0x14, 0x53, 0x61, 0x1d, 0x12, 0x22, 0xcb, 0x0f, 0x5e, 0x88,
-	0x9c, 0x0a, 0x68, 0xc4, 0x23, 0x40, 0x13, 0xf0, 0xac, 0x29, 0x74, 0xe4, 0x34, 0xa8, 0xc2, 0xec,
-	0x91, 0x0a, 0x67, 0x99, 0xc2, 0x1a, 0x55, 0x18, 0xcf, 0x40, 0x65, 0x96, 0x58, 0x58, 0x71, 0x1a,
-	0xbe, 0xd4, 0x3d, 0x0e, 0x2c, 0x12, 0x4c, 0x0c, 0x4b, 0x67, 0x17, 0xc9, 0xb9, 0x77, 0xb6, 0xde,
-	0x36, 0x13, 0x49, 0x50, 0x91, 0x11, 0xaa, 0x7c, 0xdc, 0x96, 0x8c, 0xfb, 0xb4, 0x60, 0x98, 0x2c,
-	0x70, 0x62, 0x17, 0x13, 0xd3, 0x69, 0x7a, 0xdf, 0xb4, 0xcd, 0x0c, 0x9d, 0x3f, 0xf2, 0xb9, 0xff,
-	0x65, 0x95, 0x24, 0x69, 0x25, 0x13, 0x29, 0xe8, 0x7b, 0x97, 0x69, 0xbc, 0xe2, 0x85, 0xfd, 0x07,
-	0xdf, 0x05, 0x2c, 0x34, 0xb4, 0x76, 0xe1, 0x48, 0x2d, 0x99, 0x69, 0xad, 0x8e, 0x68, 0x8d, 0x3a,
-	0xbb, 0x48, 0xa3, 0xcc, 0xd8, 0xcd, 0x69, 0x6f, 0x7f, 0xc8, 0x8f, 0xa3, 0x20, 0x16, 0x6e, 0x9b,
-	0x2d, 0x30, 0xd5, 0x45, 0x2e, 0xdd, 0x45, 0xb9, 0xff, 0x79, 0x59, 0xff, 0x18, 0x88, 0x67, 0xde,
-	0xed, 0x59, 0xc1, 0x21, 0xaa, 0xc7, 0x82, 0xdb, 0x60, 0xce, 0xa8, 0xb9, 0xc4, 0x30, 0xd9, 0xc2,
-	0x7a, 0x9f, 0x04, 0x01, 0x13, 0x5e, 0x01, 0x51, 0x07, 0xfb, 0x83, 0xf7, 0x5e, 0xfc, 0xa8, 0x83,
-	0x61, 0x0d, 0xc4, 0x1d, 0xac, 0x7f, 0x6d, 0x92, 0x1d, 0x7d, 0x17, 0x11, 0xec, 0x0f, 0xd7, 0x42,
-	0xee, 0x93, 0x63, 0x27, 0x39, 0x18, 0x88, 0x2b, 0xd4, 0xc0, 0x70, 0x1a, 0x59, 0x05, 0x0e, 0xbe,
-	0x61, 0x92, 0x9d, 0x2a, 0x22, 0x98, 0xd9, 0xf6, 0x23, 0x07, 0xa6, 0xab, 0x98, 0xa0, 0x7f, 0xbf,
-	0x68, 0x13, 0x60, 0x66, 0x17, 0x13, 0x14, 0x2c, 0x59, 0x7a, 0x80, 0x97, 0xc1, 0x2c, 0xa6, 0x1b,
-	0x9f, 0x6e, 0x9e, 0x53, 0x13, 0x8b, 0xc1, 0x53, 0x2d, 0xf9, 0x10, 0x95, 0x41, 0x37, 0xe7, 0x1f,
-	0x06, 0x8b, 0xf3, 0x97, 0x28, 0x58, 0x64, 0x5d, 0x5b, 0x36, 0xda, 0x86, 0xed, 0xc2, 0x1f, 0x38,
-	0x10, 0xb3, 0x4d, 0xe7, 0x70, 0x78, 0xb8, 0x77, 0x0e, 0xcf, 0x2d, 0xcf, 0xaa, 0x37, 0x03, 0xf1,
-	0x64, 0x88, 0x72, 0x01, 0xdb, 0x26, 0x41, 0x76, 0x8b, 0x74, 0x87, 0xaf, 0x0a, 0x5d, 0x1f, 0x7b,
-	0xa6, 0x80, 0x6d, 0x3a, 0xc1, 0x44, 0x7d, 0xcf, 0x01, 0x68, 0x1b, 0x7b, 0x41, 0x0e, 0xbd, 0x85,
-	0xda, 0x26, 0x6e, 0xb0, 0x4d, 0xbd, 0x3e, 0xd1, 0xe7, 0x79, 0xf6, 0x2f, 0x68, 0x4e, 0x61, 0xf5,
-	0x9d, 0x9e, 0x24, 0x8f, 0x94, 0xc9, 0x36, 0xe5, 0x24, 0x4a, 0x7e, 0xe8, 0x4d, 0x02, 0x6f, 0x1b,
-	0x7b, 0x81, 0x4d, 0x34, 0xfc, 0x1d, 0x07, 0xe2, 0x55, 0x7f, 0x3c, 0x98, 0x6f, 0xdf, 0x00, 0x36,
-	0x2e, 0x41, 0x6d, 0xdc, 0x51, 0xb5, 0x6d, 0xb1, 0xda, 0xd6, 0x46, 0x78, 0x23, 0x65, 0x25, 0x46,
-	0xa6, 0x33, 0x5c, 0x51, 0x9c, 0xc6, 0x58, 0x35, 0x4f, 0x83, 0xa1, 0x64, 0xc5, 0x5c, 0x07, 0xb3,
-	0x5f, 0x75, 0x70, 0xbb, 0x63, 0xfb, 0x55, 0xc4, 0x73, 0x1f, 0x1f, 0xaf, 0xa3, 0xf3, 0xa8, 0xfe,
-	0x66, 0x20, 0xf2, 0x94, 0x3a, 0x2c, 0x44, 0x65, 0xc9, 0xe0, 0x1d, 0xb0, 0x40, 0x76, 0xda, 0xc8,
-	0xdd, 0xc1, 0x16, 0xf5, 0x3e, 0x7e, 0xdc, 0x59, 0xa1, 0x99, 0x57, 0x0e, 0xd9, 0xa1, 0xe4, 0xc3,
-	0x94, 0xf0, 0x5b, 0x0e, 0x2c, 0x79, 0x03, 0xa4, 0x0f, 0x55, 0xa6, 0x7c, 0x95, 0x3b, 0xef, 0xa3,
-	0x92, 0x1c, 0x4d, 0x31, 0x62, 0xe8, 0x49, 0x66, 0xe8, 0x08, 0x42, 0x56, 0x17, 0xbd, 0x80, 0x16,
-	0x9c, 0xcf, 0xff, 0xc5, 0x01, 0x30, 0x1c, 0x1b, 0x78, 0x01, 0xac, 0x55, 0x4b, 0x9a, 0xa2, 0x97,
-	0xca, 0x5a, 0xa1, 0x54, 0xd4, 0xaf, 0x17, 0x2b, 0x65, 0x65, 0xbb, 0x70, 0xb5, 0xa0, 0xe4, 0xf9,
-	0x88, 0xb0, 0xdc, 0xeb, 0x4b, 0x31, 0x0a, 0x54, 0x3c, 0x11, 0x28, 0x83, 0xe5, 0x30, 0xfa, 0xa6,
-	0x52, 0xe1, 0x39, 0x61, 0xb1, 0xd7, 0x97, 0x16, 0x28, 0xea, 0x26, 0x72, 0xe1, 0x79, 0xb0, 0x12,
-	0xc6, 0x64, 0x73, 0x15, 0x2d, 0x5b, 0x28, 0xf2, 0x51, 0xe1, 0x44, 0xaf, 0x2f, 0x2d, 0x52, 0x5c,
-	0x96, 0xad, 0x37, 0x09, 0x2c, 0x85, 0xb1, 0xc5, 0x12, 0x3f, 0x25, 0xc4, 0x7b, 0x7d, 0x69, 0x9e,
-	0xc2, 0x8a, 0x18, 0x6e, 0x80, 0xe4, 0x28, 0x42, 0xbf, 0x51, 0xd0, 0x3e, 0xd3, 0xab, 0x8a, 0x56,
-	0xe2, 0xa7, 0x85, 0x44, 0xaf, 0x2f, 0xf1, 0x01, 0x36, 0xd8, 0x4a, 0xc2, 0xf4, 0xfd, 0x9f, 0x52,
-	0x91, 0xf3, 0x4f, 0xa3, 0x60, 0x69, 0xf4, 0x7f, 0x26, 0x30, 0x0d, 0x4e, 0x95, 0xd5, 0x52, 0xb9,
-	0x54, 0xc9, 0x5e, 0xd3, 0x2b, 0x5a, 0x56, 0xbb, 0x5e, 0x19, 0x7b, 0xb0, 0xff, 0x14, 0x0a, 0x2e,
-	0x9a, 0x16, 0xdc, 0x02, 0xa9, 0x71, 0x7c, 0x5e, 0x29, 0x97, 0x2a, 0x05, 0x4d, 0x2f, 0x2b, 0x6a,
-	0xa1, 0x94, 0xe7, 0x39, 0x61, 0xad, 0xd7, 0x97, 0x56, 0x28, 0x65, 0x64, 0x8a, 0xe0, 0x15, 0xf0,
-	0x9f, 0x71, 0x72, 0xb5, 0xa4, 0x15, 0x8a, 0x9f, 0x06, 0xdc, 0xa8, 0xb0, 0xda, 0xeb, 0x4b, 0x90,
-	0x72, 0xab, 0xa1, 0x96, 0x87, 0x17, 0xc0, 0xea, 0x38, 0xb5, 0x9c, 0xad, 0x54, 0x94, 0x3c, 0x3f,
-	0x25, 0xf0, 0xbd, 0xbe, 0x14, 0xa7, 0x9c, 0xb2, 0xe1, 0xba, 0xa8, 0x01, 0x3f, 0x00, 0xc9, 0x71,
-	0xb4, 0xaa, 0x7c, 0xae, 0x6c, 0x6b, 0x4a, 0x9e, 0x9f, 0x16, 0x60, 0xaf, 0x2f, 0x2d, 0x51, 0xbc,
-	0x8a, 0xbe, 0x40, 0x75, 0x82, 0xde, 0x9a, 0xff, 0x6a, 0xb6, 0x70, 0x4d, 0xc9, 0xf3, 0x33, 0xe1,
-	0xfc, 0x57, 0x0d, 0xd3, 0x42, 0x0d, 0x6a, 0x67, 0xae, 0xf0, 0xe4, 0x65, 0x2a, 0xf2, 0xfc, 0x65,
-	0x2a, 0x72, 0x6f, 0x3f, 0x15, 0x79, 0xb2, 0x9f, 0xe2, 0x9e, 0xed, 0xa7, 0xb8, 0x3f, 0xf7, 0x53,
-	0xdc, 0x83, 0x57, 0xa9, 0xc8, 0xb3, 0x57, 0xa9, 0xc8, 0xf3, 0x57, 0xa9, 0xc8, 0xad, 0x7f, 0x5c,
-	0x7e, 0x7b, 0xfe, 0xdf, 0x2e, 0x7e, 0x2b, 0xd7, 0x66, 0xfd, 0x7d, 0x71, 0xf9, 0xef, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xa0, 0x35, 0xbe, 0x78, 0xd3, 0x0c, 0x00, 0x00,
+	// 1455 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xd1, 0x6f, 0xd3, 0xd6,
+	0x17, 0x8e, 0x93, 0x34, 0x6d, 0x6e, 0xd2, 0xd6, 0xdc, 0x96, 0x36, 0x0d, 0xfc, 0xe2, 0x60, 0x7e,
+	0x0f, 0x8c, 0x41, 0x32, 0xca, 0x24, 0x44, 0xab, 0x49, 0xc4, 0x8d, 0xd9, 0x32, 0xa1, 0x26, 0x72,
+	0x4c, 0x2b, 0x40, 0xc2, 0x72, 0x9a, 0x4b, 0xea, 0xcd, 0xf6, 0xcd, 0xe2, 0x9b, 0xd2, 0x6a, 0x2f,
+	0xbc, 0x4c, 0x42, 0x99, 0x34, 0x21, 0xed, 0x85, 0x97, 0x48, 0x48, 0x7b, 0xdb, 0x33, 0xda, 0xbf,
+	0x30, 0x34, 0xf1, 0x80, 0xf6, 0x84, 0x26, 0x2d, 0x8c, 0x22, 0x4d, 0x88, 0xc7, 0xfe, 0x05, 0x93,
+	0x7d, 0xaf, 0x13, 0x27, 0x61, 0xb4, 0xdd, 0x9b, 0x7d, 0xee, 0xf7, 0x7d, 0xe7, 0xdc, 0xaf, 0xe7,
+	0x1c, 0xa7, 0x60, 0xc9, 0xac, 0x59, 0xf9, 0x06, 0xde, 0xc9, 0xef, 0x5c, 0xaa, 0x21, 0xa2, 0x5f,
+	0x72, 0x9f, 0x73, 0xcd, 0x16, 0x26, 0x18, 0xce, 0x9a, 0x35, 0x2b, 0xe7, 0xbe, 0xb2, 0xa3, 0xf4,
+	0x29, 0x17, 0x5b, 0xd3, 0x1d, 0xd4, 0x07, 0x6f, 0x61, 0xc3, 0xa6, 0xe8, 0xf4, 0x7c, 0x03, 0x37,
+	0xb0, 0xf7, 0x98, 0x77, 0x9f, 0x58, 0x74, 0x69, 0x0b, 0x3b, 0x16, 0x76, 0x34, 0x7a, 0x40, 0x5f,
+	0xd8, 0x91, 0xd0, 0xc0, 0xb8, 0x61, 0xa2, 0xbc, 0xf7, 0x56, 0x6b, 0xdf, 0xcb, 0x13, 0xc3, 0x42,
+	0x0e, 0xd1, 0xad, 0xa6, 0xcf, 0x1d, 0x05, 0xe8, 0xf6, 0x1e, 0x3b, 0xca, 0x8c, 0x1e, 0xd5, 0xdb,
+	0x2d, 0x9d, 0x18, 0x98, 0x15, 0x23, 0x76, 0x39, 0x00, 0x37, 0x91, 0xd1, 0xd8, 0x26, 0xa8, 0xbe,
+	0x81, 0x09, 0x2a, 0x37, 0xdd, 0x43, 0x78, 0x19, 0xc4, 0xb0, 0xf7, 0x94, 0xe2, 0xb2, 0xdc, 0xb9,
+	0x99, 0xe5, 0x53, 0xb9, 0x91, 0x2b, 0xe6, 0x06, 0x60, 0x85, 0x41, 0xa1, 0x02, 0x62, 0xf7, 0x3d,
+	0xa9, 0x54, 0x38, 0xcb, 0x9d, 0x8b, 0x4b, 0x2b, 0xcf, 0x7a, 0x42, 0xe8, 0x8f, 0x9e, 0x70, 0xa6,
+	0x61, 0x90, 0xed, 0x76, 0x2d, 0xb7, 0x85, 0xad, 0xbc, 0x69, 0xd8, 0x28, 0x6f, 0xd6, 0xac, 0x8b,
+	0x4e, 0xfd, 0xeb, 0x3c, 0xd9, 0x6b, 0x22, 0x27, 0x57, 0x44, 0x5b, 0x07, 0x3d, 0x61, 0x7a, 0x4f,
+	0xb7, 0xcc, 0x15, 0x91, 0x0a, 0x88, 0x0a, 0x53, 0x12, 0x37, 0x41, 0x52, 0x45, 0xbb, 0xa4, 0xd2,
+	0xc2, 0x4d, 0xec, 0xe8, 0x26, 0x9c, 0x07, 0x13, 0xc4, 0x20, 0x26, 0xf2, 0xea, 0x8a, 0x2b, 0xf4,
+	0x05, 0x66, 0x41, 0xa2, 0x8e, 0x9c, 0xad, 0x96, 0x41, 0x6b, 0xf6, 0xd2, 0x2b, 0xc1, 0xd0, 0xca,
+	0xec, 0xdb, 0x27, 0x02, 0xf7, 0xfb, 0xd3, 0x8b, 0x93, 0x6b, 0xd8, 0x26, 0xc8, 0x26, 0xe2, 0xaf,
+	0x1c, 0x98, 0x2c, 0xa2, 0x26, 0x76, 0x0c, 0x02, 0xaf, 0x80, 0x44, 0x93, 0x25, 0xd0, 0x8c, 0xba,
+	0x27, 0x1d, 0x95, 0x16, 0x0e, 0x7a, 0x02, 0xa4, 0x45, 0x05, 0x0e, 0x45, 0x05, 0xf8, 0x6f, 0xa5,
+	0x3a, 0x3c, 0x0d, 0xe2, 0x75, 0xaa, 0x81, 0x5b, 0x2c, 0xeb, 0x20, 0x00, 0xef, 0x80, 0x98, 0x6e,
+	0xe1, 0xb6, 0x4d, 0x52, 0x91, 0x6c, 0xe4, 0x5c, 0x62, 0x79, 0xc1, 0x33, 0xd1, 0x6d, 0x8b, 0xbe,
+	0x8b, 0x6b, 0xd8, 0xb0, 0xa5, 0x8f, 0x5d, 0x9f, 0x7e, 0x7e, 0x25, 0x9c, 0xfd, 0xb0, 0x4f, 0x2e,
+	0xd6, 0x51, 0x98, 0xe4, 0xca, 0xd4, 0xc3, 0x27, 0x42, 0xe8, 0xed, 0x13, 0x21, 0x24, 0xbe, 0x8d,
+	0x81, 0xa9, 0xbe, 0x3f, 0x9f, 0xbe, 0xef, 0x2a, 0x73, 0xef, 0x7a, 0x42, 0xd8, 0xa8, 0x1f, 0xf4,
+	0x84, 0x38, 0xbd, 0xd0, 0xe8, 0x3d, 0x56, 0xc1, 0xe4, 0x16, 0xf5, 0xc5, 0xbb, 0x45, 0x62, 0x79,
+	0x3e, 0x47, 0xfb, 0x26, 0xe7, 0xf7, 0x4d, 0xae, 0x60, 0xef, 0x49, 0x89, 0xdf, 0x06, 0x06, 0x2a,
+	0x3e, 0x03, 0x56, 0x41, 0xcc, 0x21, 0x3a, 0x69, 0x3b, 0xa9, 0x88, 0xd7, 0x2b, 0xc2, 0x58, 0xaf,
+	0xf8, 0xd5, 0x55, 0x3d, 0x98, 0x94, 0x3e, 0xe8, 0x09, 0x0b, 0x23, 0xce, 0x52, 0x05, 0x51, 0x61,
+	0x52, 0xd0, 0x02, 0xf0, 0x9e, 0x61, 0xeb, 0xa6, 0x46, 0x74, 0xd3, 0xdc, 0xd3, 0x5a, 0xc8, 0x69,
+	0x9b, 0x24, 0x15, 0xf5, 0x8a, 0x3b, 0x3d, 0x96, 0x40, 0x75, 0x41, 0x8a, 0x87, 0x91, 0xce, 0xb8,
+	0x6e, 0x1e, 0xf4, 0x84, 0x25, 0x9a, 0x61, 0x5c, 0x45, 0x54, 0x78, 0x2f, 0x18, 0x20, 0xc1, 0x3b,
+	0x20, 0xe1, 0xb4, 0x6b, 0x96, 0x41, 0x34, 0x77, 0xb6, 0x52, 0x13, 0x5e, 0x9e, 0xf4, 0x98, 0x09,
+	0xaa, 0x3f, 0x78, 0x52, 0x86, 0x65, 0x61, 0x1d, 0x12, 0x20, 0x8b, 0x8f, 0x5e, 0x09, 0x9c, 0x02,
+	0x68, 0xc4, 0x25, 0x40, 0x03, 0xf0, 0xac, 0x29, 0x34, 0x64, 0xd7, 0x69, 0x86, 0xd8, 0xa1, 0x19,
+	0xce, 0xb2, 0x0c, 0x8b, 0x34, 0xc3, 0xa8, 0x02, 0x4d, 0x33, 0xc3, 0xc2, 0xb2, 0x5d, 0xf7, 0x52,
+	0x3d, 0xe0, 0xc0, 0x34, 0xc1, 0x44, 0x37, 0x35, 0x76, 0x90, 0x9a, 0xfc, 0x60, 0xeb, 0xad, 0xb1,
+	0x24, 0xf3, 0x34, 0xc9, 0x10, 0x55, 0x3c, 0x6a, 0x4b, 0x26, 0x3d, 0x9a, 0x3f, 0x4c, 0x26, 0x38,
+	0xb1, 0x83, 0x89, 0x61, 0x37, 0xdc, 0xbf, 0x69, 0x8b, 0x19, 0x3a, 0x75, 0xe8, 0x75, 0xff, 0xcf,
+	0x2a, 0x49, 0xd1, 0x4a, 0xc6, 0x24, 0xe8, 0x7d, 0x67, 0x69, 0xbc, 0xea, 0x86, 0xbd, 0x0b, 0xdf,
+	0x03, 0x2c, 0x34, 0xb0, 0x36, 0x7e, 0x68, 0x2e, 0x91, 0xe5, 0x5a, 0x18, 0xca, 0x35, 0xec, 0xec,
+	0x34, 0x8d, 0x32, 0x63, 0x57, 0xa2, 0xee, 0xfe, 0x10, 0x9f, 0x86, 0x41, 0x22, 0xd8, 0x36, 0xab,
+	0x20, 0xb2, 0x87, 0x1c, 0xba, 0x8b, 0xa4, 0x8f, 0x8e, 0xb6, 0xee, 0x4a, 0x36, 0x51, 0x5c, 0x16,
+	0x5c, 0x03, 0x93, 0x7a, 0xcd, 0x21, 0xba, 0xc1, 0x16, 0xd6, 0x71, 0x04, 0x7c, 0x26, 0xbc, 0x0a,
+	0xc2, 0x36, 0x4e, 0x45, 0x8e, 0xcb, 0x0f, 0xdb, 0x18, 0xd6, 0x40, 0xd2, 0xc6, 0xda, 0x7d, 0x83,
+	0x6c, 0x6b, 0x3b, 0x88, 0x60, 0x6f, 0xb8, 0xe2, 0xd2, 0xb5, 0x23, 0x8b, 0x1c, 0xf4, 0x84, 0x39,
+	0x6a, 0x60, 0x50, 0x46, 0x54, 0x80, 0x8d, 0x37, 0x0d, 0xb2, 0xbd, 0x81, 0x08, 0x66, 0xb6, 0xfd,
+	0xc9, 0x81, 0xa8, 0xfb, 0xbd, 0xf8, 0xef, 0x8b, 0x76, 0x1e, 0x4c, 0xec, 0x60, 0x82, 0xfc, 0x25,
+	0x4b, 0x5f, 0xe0, 0x95, 0xfe, 0x57, 0x2a, 0x72, 0xe8, 0x57, 0x4a, 0x0a, 0xa7, 0xb8, 0xfe, 0x97,
+	0x6a, 0x0d, 0x4c, 0xd2, 0x27, 0x27, 0x15, 0xf5, 0xe6, 0xe3, 0xec, 0x18, 0x73, 0xfc, 0xa3, 0x28,
+	0x45, 0x5d, 0x6b, 0x14, 0x9f, 0xb9, 0x32, 0xf5, 0xd8, 0xdf, 0xc0, 0xbf, 0x84, 0xc1, 0x34, 0x6b,
+	0xff, 0x8a, 0xde, 0xd2, 0x2d, 0x07, 0xfe, 0xc8, 0x81, 0x84, 0x65, 0xd8, 0xfd, 0x29, 0xe4, 0x3e,
+	0x38, 0x85, 0xb7, 0x5d, 0xe1, 0x77, 0x3d, 0xe1, 0x64, 0x80, 0x72, 0x01, 0x5b, 0x06, 0x41, 0x56,
+	0x93, 0xec, 0x0d, 0xec, 0x09, 0x1c, 0x1f, 0x79, 0x38, 0x81, 0x65, 0xd8, 0xfe, 0x68, 0xfe, 0xc0,
+	0x01, 0x68, 0xe9, 0xbb, 0xbe, 0x86, 0xd6, 0x44, 0x2d, 0x03, 0xd7, 0xd9, 0xca, 0x5f, 0x1a, 0x1b,
+	0x98, 0x22, 0xfb, 0xa9, 0x20, 0xc9, 0xac, 0xbe, 0xd3, 0xe3, 0xe4, 0xa1, 0x32, 0xd9, 0xca, 0x1d,
+	0x47, 0x89, 0x8f, 0xdd, 0x91, 0xe2, 0x2d, 0x7d, 0xd7, 0xb7, 0x89, 0x86, 0xbf, 0xe7, 0x40, 0x72,
+	0xc3, 0x9b, 0x33, 0xe6, 0xdb, 0xb7, 0x80, 0xcd, 0x9d, 0x5f, 0x1b, 0x77, 0x58, 0x6d, 0xab, 0xac,
+	0xb6, 0xc5, 0x21, 0xde, 0x50, 0x59, 0xf3, 0x43, 0x63, 0x1e, 0xac, 0x28, 0x49, 0x63, 0xac, 0x9a,
+	0xe7, 0xfe, 0x74, 0xb3, 0x62, 0x6e, 0x82, 0xd8, 0x37, 0x6d, 0xdc, 0x6a, 0x5b, 0x5e, 0x15, 0x49,
+	0xe9, 0xb3, 0x23, 0xff, 0x9e, 0x79, 0xd7, 0x13, 0x78, 0x4a, 0x1d, 0x14, 0xa2, 0x30, 0x31, 0x78,
+	0x17, 0xc4, 0xc9, 0x76, 0x0b, 0x39, 0xdb, 0xd8, 0xa4, 0xde, 0x27, 0xa5, 0x6b, 0xc7, 0x51, 0x9e,
+	0xeb, 0xb3, 0x03, 0xe2, 0x03, 0x49, 0xf8, 0x1d, 0x07, 0x66, 0xdc, 0x49, 0xd4, 0x06, 0x59, 0x22,
+	0x5e, 0x96, 0xbb, 0xc7, 0xc9, 0x92, 0x1a, 0x96, 0x18, 0x32, 0xf4, 0x24, 0x33, 0x74, 0x08, 0x21,
+	0x2a, 0xd3, 0x6e, 0x40, 0xf5, 0xdf, 0xcf, 0xff, 0xcd, 0x01, 0x10, 0xf8, 0x49, 0x79, 0x01, 0x2c,
+	0x6e, 0x94, 0x55, 0x59, 0x2b, 0x57, 0xd4, 0x52, 0x79, 0x5d, 0xbb, 0xb9, 0x5e, 0xad, 0xc8, 0x6b,
+	0xa5, 0xeb, 0x25, 0xb9, 0xc8, 0x87, 0xd2, 0xb3, 0x9d, 0x6e, 0x36, 0x41, 0x81, 0xb2, 0x9b, 0x04,
+	0x8a, 0x60, 0x36, 0x88, 0xbe, 0x25, 0x57, 0x79, 0x2e, 0x3d, 0xdd, 0xe9, 0x66, 0xe3, 0x14, 0x75,
+	0x0b, 0x39, 0xf0, 0x3c, 0x98, 0x0b, 0x62, 0x0a, 0x52, 0x55, 0x2d, 0x94, 0xd6, 0xf9, 0x70, 0xfa,
+	0x44, 0xa7, 0x9b, 0x9d, 0xa6, 0xb8, 0x02, 0xdb, 0x93, 0x59, 0x30, 0x13, 0xc4, 0xae, 0x97, 0xf9,
+	0x48, 0x3a, 0xd9, 0xe9, 0x66, 0xa7, 0x28, 0x6c, 0x1d, 0xc3, 0x65, 0x90, 0x1a, 0x46, 0x68, 0x9b,
+	0x25, 0xf5, 0x0b, 0x6d, 0x43, 0x56, 0xcb, 0x7c, 0x34, 0x3d, 0xdf, 0xe9, 0x66, 0x79, 0x1f, 0xeb,
+	0xaf, 0xb7, 0x74, 0xf4, 0xe1, 0x4f, 0x99, 0xd0, 0xf9, 0xe7, 0x61, 0x30, 0x33, 0xfc, 0x13, 0x07,
+	0xe6, 0xc0, 0xa9, 0x8a, 0x52, 0xae, 0x94, 0xab, 0x85, 0x1b, 0x5a, 0x55, 0x2d, 0xa8, 0x37, 0xab,
+	0x23, 0x17, 0xf6, 0xae, 0x42, 0xc1, 0xeb, 0x86, 0x09, 0x57, 0x41, 0x66, 0x14, 0x5f, 0x94, 0x2b,
+	0xe5, 0x6a, 0x49, 0xd5, 0x2a, 0xb2, 0x52, 0x2a, 0x17, 0x79, 0x2e, 0xbd, 0xd8, 0xe9, 0x66, 0xe7,
+	0x28, 0x65, 0x68, 0x8a, 0xe0, 0x55, 0xf0, 0xbf, 0x51, 0xf2, 0x46, 0x59, 0x2d, 0xad, 0x7f, 0xee,
+	0x73, 0xc3, 0xe9, 0x85, 0x4e, 0x37, 0x0b, 0x29, 0x77, 0x23, 0xd0, 0xf2, 0xf0, 0x02, 0x58, 0x18,
+	0xa5, 0x56, 0x0a, 0xd5, 0xaa, 0x5c, 0xe4, 0x23, 0x69, 0xbe, 0xd3, 0xcd, 0x26, 0x29, 0xa7, 0xa2,
+	0x3b, 0x0e, 0xaa, 0xc3, 0x4f, 0x40, 0x6a, 0x14, 0xad, 0xc8, 0x5f, 0xca, 0x6b, 0xaa, 0x5c, 0xe4,
+	0xa3, 0x69, 0xd8, 0xe9, 0x66, 0x67, 0x28, 0x5e, 0x41, 0x5f, 0xa1, 0x2d, 0x82, 0xde, 0xab, 0x7f,
+	0xbd, 0x50, 0xba, 0x21, 0x17, 0xf9, 0x89, 0xa0, 0xfe, 0x75, 0xdd, 0x30, 0x51, 0x9d, 0xda, 0x29,
+	0x95, 0x9e, 0xbd, 0xce, 0x84, 0x5e, 0xbe, 0xce, 0x84, 0x1e, 0xec, 0x67, 0x42, 0xcf, 0xf6, 0x33,
+	0xdc, 0x8b, 0xfd, 0x0c, 0xf7, 0xd7, 0x7e, 0x86, 0x7b, 0xf4, 0x26, 0x13, 0x7a, 0xf1, 0x26, 0x13,
+	0x7a, 0xf9, 0x26, 0x13, 0xba, 0xfd, 0xaf, 0xcb, 0x6f, 0xd7, 0xfb, 0x27, 0xcd, 0x6b, 0xe5, 0x5a,
+	0xcc, 0xdb, 0x17, 0x97, 0xff, 0x19, 0x00, 0x9e, 0x79, 0x6e, 0xf4, 0xbc, 0x0d, 0x00, 0x00,
 }
 
 func (this *TextProposal) Equal(that interface{}) bool {
@@ -667,6 +714,44 @@ func (this *TallyResult) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedVoteOption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedVoteOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Option != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TextProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -937,6 +1022,20 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.Option != 0 {
 		i = encodeVarintGov(dAtA, i, uint64(m.Option))
 		i--
@@ -1097,6 +1196,20 @@ func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *WeightedVoteOption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Option != 0 {
+		n += 1 + sovGov(uint64(m.Option))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
 func (m *TextProposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1204,6 +1317,12 @@ func (m *Vote) Size() (n int) {
 	if m.Option != 0 {
 		n += 1 + sovGov(uint64(m.Option))
 	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1256,6 +1375,109 @@ func sovGov(x uint64) (n int) {
 func sozGov(x uint64) (n int) {
 	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedVoteOption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedVoteOption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TextProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2113,6 +2335,40 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -193,6 +193,83 @@ func (m *MsgVoteResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgVoteResponse proto.InternalMessageInfo
 
+// MsgVoteWeighted defines a message to cast a vote, with the voting power split
+// across several options.
+type MsgVoteWeighted struct {
+	ProposalId uint64               `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id" yaml:"proposal_id"`
+	Voter      string               `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	Options    []WeightedVoteOption `protobuf:"bytes,3,rep,name=options,proto3" json:"options"`
+}
+
+func (m *MsgVoteWeighted) Reset()      { *m = MsgVoteWeighted{} }
+func (*MsgVoteWeighted) ProtoMessage() {}
+func (*MsgVoteWeighted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ac4aac0fb082f49, []int{4}
+}
+func (m *MsgVoteWeighted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteWeighted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteWeighted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteWeighted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteWeighted.Merge(m, src)
+}
+func (m *MsgVoteWeighted) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteWeighted) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteWeighted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteWeighted proto.InternalMessageInfo
+
+// MsgVoteWeightedResponse defines the Msg/VoteWeighted response type.
+type MsgVoteWeightedResponse struct {
+}
+
+func (m *MsgVoteWeightedResponse) Reset()         { *m = MsgVoteWeightedResponse{} }
+func (m *MsgVoteWeightedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteWeightedResponse) ProtoMessage()    {}
+func (*MsgVoteWeightedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ac4aac0fb082f49, []int{5}
+}
+func (m *MsgVoteWeightedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteWeightedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteWeightedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteWeightedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteWeightedResponse.Merge(m, src)
+}
+func (m *MsgVoteWeightedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteWeightedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteWeightedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteWeightedResponse proto.InternalMessageInfo
+
 // MsgDeposit defines a message to submit a deposit to an existing proposal.
 type MsgDeposit struct {
 	ProposalId uint64                              `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id" yaml:"proposal_id"`
@@ -203,7 +280,7 @@ type MsgDeposit struct {
 func (m *MsgDeposit) Reset()      { *m = MsgDeposit{} }
 func (*MsgDeposit) ProtoMessage() {}
 func (*MsgDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4ac4aac0fb082f49, []int{4}
+	return fileDescriptor_4ac4aac0fb082f49, []int{6}
 }
 func (m *MsgDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -240,7 +317,7 @@ func (m *MsgDepositResponse) Reset()         { *m = MsgDepositResponse{} }
 func (m *MsgDepositResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDepositResponse) ProtoMessage()    {}
 func (*MsgDepositResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4ac4aac0fb082f49, []int{5}
+	return fileDescriptor_4ac4aac0fb082f49, []int{7}
 }
 func (m *MsgDepositResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -274,6 +351,8 @@ func init() {
 	proto.RegisterType((*MsgSubmitProposalResponse)(nil), "lbm.gov.v1beta1.MsgSubmitProposalResponse")
 	proto.RegisterType((*MsgVote)(nil), "lbm.gov.v1beta1.MsgVote")
 	proto.RegisterType((*MsgVoteResponse)(nil), "lbm.gov.v1beta1.MsgVoteResponse")
+	proto.RegisterType((*MsgVoteWeighted)(nil), "lbm.gov.v1beta1.MsgVoteWeighted")
+	proto.RegisterType((*MsgVoteWeightedResponse)(nil), "lbm.gov.v1beta1.MsgVoteWeightedResponse")
 	proto.RegisterType((*MsgDeposit)(nil), "lbm.gov.v1beta1.MsgDeposit")
 	proto.RegisterType((*MsgDepositResponse)(nil), "lbm.gov.v1beta1.MsgDepositResponse")
 }
@@ -281,44 +360,48 @@ func init() {
 func init() { proto.RegisterFile("lbm/gov/v1beta1/tx.proto", fileDescriptor_4ac4aac0fb082f49) }
 
 var fileDescriptor_4ac4aac0fb082f49 = []byte{
-	// 589 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xb6, 0x9b, 0x92, 0xd0, 0x8b, 0x94, 0x50, 0x2b, 0xaa, 0x5c, 0x07, 0xd9, 0x91, 0x2b, 0xa4,
-	0x08, 0x54, 0x5b, 0x4d, 0xb7, 0x22, 0x06, 0x52, 0x40, 0x42, 0x28, 0x02, 0x19, 0x89, 0x01, 0x86,
-	0x60, 0x27, 0xc6, 0x9c, 0xb0, 0xef, 0x59, 0xb9, 0x4b, 0xd4, 0x6c, 0x4c, 0xc0, 0xc8, 0xc8, 0x98,
-	0x99, 0x81, 0x89, 0x1f, 0x51, 0x31, 0x75, 0x64, 0x40, 0x01, 0x92, 0x05, 0x31, 0x76, 0x62, 0x44,
-	0x3e, 0xfb, 0xd2, 0x2a, 0x49, 0x0b, 0x43, 0x37, 0xbf, 0xf7, 0x7d, 0xef, 0xd3, 0x7d, 0xdf, 0x3d,
-	0x1f, 0x52, 0x43, 0x2f, 0xb2, 0x03, 0x18, 0xd8, 0x83, 0x1d, 0xcf, 0x67, 0xee, 0x8e, 0xcd, 0x0e,
-	0xac, 0xb8, 0x07, 0x0c, 0x94, 0x72, 0xe8, 0x45, 0x56, 0x00, 0x03, 0x2b, 0x43, 0xb4, 0x6a, 0x42,
-	0xf5, 0x5c, 0xea, 0xcf, 0xb8, 0x1d, 0xc0, 0x24, 0x65, 0x6b, 0x9b, 0xf3, 0x3a, 0xc9, 0x64, 0x06,
-	0x75, 0x80, 0x46, 0x40, 0xdb, 0xbc, 0xb2, 0xd3, 0x22, 0x83, 0x2a, 0x01, 0x04, 0x90, 0xf6, 0x93,
-	0x2f, 0x31, 0x10, 0x00, 0x04, 0xa1, 0x6f, 0xf3, 0xca, 0xeb, 0xbf, 0xb0, 0x5d, 0x32, 0x4c, 0x21,
-	0xf3, 0xed, 0x0a, 0x5a, 0x6f, 0xd1, 0xe0, 0x71, 0xdf, 0x8b, 0x30, 0x7b, 0xd4, 0x83, 0x18, 0xa8,
-	0x1b, 0x2a, 0x37, 0x51, 0xa1, 0x03, 0x84, 0xf9, 0x84, 0xa9, 0x72, 0x4d, 0xae, 0x17, 0x1b, 0x15,
-	0x2b, 0x95, 0xb0, 0x84, 0x84, 0x75, 0x9b, 0x0c, 0x9b, 0xc5, 0x2f, 0x9f, 0xb7, 0x0b, 0xfb, 0x29,
-	0xd1, 0x11, 0x13, 0xca, 0x1b, 0x19, 0x95, 0x31, 0xc1, 0x0c, 0xbb, 0x61, 0xbb, 0xeb, 0xc7, 0x40,
-	0x31, 0x53, 0x57, 0x6a, 0xb9, 0x7a, 0xb1, 0xb1, 0x61, 0x25, 0x11, 0x24, 0x8e, 0x45, 0x06, 0xd6,
-	0x3e, 0x60, 0xd2, 0xbc, 0x7b, 0x38, 0x36, 0xa4, 0xe3, 0xb1, 0xb1, 0x31, 0x74, 0xa3, 0x70, 0xcf,
-	0x9c, 0x1b, 0x36, 0x3f, 0x7e, 0x37, 0xb6, 0x02, 0xcc, 0x5e, 0xf6, 0x3d, 0xab, 0x03, 0x91, 0x1d,
-	0x62, 0xe2, 0xdb, 0xa1, 0x17, 0x6d, 0xd3, 0xee, 0x2b, 0x9b, 0x0d, 0x63, 0x9f, 0x72, 0x15, 0xea,
-	0x94, 0xb2, 0xc1, 0x3b, 0xe9, 0x9c, 0xa2, 0xa1, 0xcb, 0x31, 0x77, 0xe4, 0xf7, 0xd4, 0x5c, 0x4d,
-	0xae, 0xaf, 0x39, 0xb3, 0x7a, 0xef, 0xca, 0xbb, 0x91, 0x21, 0x7d, 0x18, 0x19, 0xd2, 0xaf, 0x91,
-	0x21, 0xbd, 0xfe, 0x56, 0x93, 0xcc, 0x0e, 0xda, 0x5c, 0x08, 0xc2, 0xf1, 0x69, 0x0c, 0x84, 0xfa,
-	0xca, 0x3d, 0x54, 0x8c, 0xb3, 0x5e, 0x1b, 0x77, 0x79, 0x28, 0xab, 0xcd, 0x6b, 0xbf, 0xc7, 0xc6,
-	0xe9, 0xf6, 0xf1, 0xd8, 0x50, 0x52, 0x07, 0xa7, 0x9a, 0xa6, 0x83, 0x44, 0x75, 0xbf, 0x6b, 0x7e,
-	0x92, 0x51, 0xa1, 0x45, 0x83, 0x27, 0xc0, 0x2e, 0x4c, 0x53, 0xa9, 0xa0, 0x4b, 0x03, 0x60, 0x7e,
-	0x4f, 0x5d, 0xe1, 0x1e, 0xd3, 0x42, 0xd9, 0x45, 0x79, 0x88, 0x19, 0x06, 0xc2, 0xad, 0x97, 0x1a,
-	0x55, 0x6b, 0x6e, 0xfd, 0xac, 0xe4, 0x10, 0x0f, 0x39, 0xc5, 0xc9, 0xa8, 0x4b, 0x52, 0x59, 0x47,
-	0xe5, 0xec, 0xbc, 0x22, 0x0b, 0xf3, 0xa7, 0x8c, 0x50, 0x8b, 0x06, 0x22, 0xe5, 0x8b, 0xb2, 0x71,
-	0x15, 0xad, 0x65, 0x17, 0x0e, 0xc2, 0xca, 0x49, 0x43, 0x79, 0x86, 0xf2, 0x6e, 0x04, 0x7d, 0xc2,
-	0xd4, 0xdc, 0xb9, 0xab, 0x74, 0x23, 0x59, 0xa5, 0xff, 0x5d, 0x98, 0x4c, 0x72, 0x89, 0xed, 0x0a,
-	0x52, 0x4e, 0x2c, 0x0a, 0xe7, 0x8d, 0x3f, 0x32, 0xca, 0xb5, 0x68, 0xa0, 0x3c, 0x47, 0xa5, 0xb9,
-	0x1f, 0xc6, 0x5c, 0x48, 0x77, 0x61, 0x97, 0xb4, 0xeb, 0xff, 0xe6, 0xcc, 0xf6, 0xad, 0x89, 0x56,
-	0xf9, 0x8e, 0xa8, 0xcb, 0x66, 0x12, 0x44, 0xab, 0x9d, 0x85, 0xcc, 0x34, 0x1e, 0xa0, 0x82, 0xb8,
-	0xa3, 0xea, 0x32, 0x72, 0x06, 0x6a, 0x5b, 0xe7, 0x80, 0x42, 0xac, 0x79, 0xeb, 0x70, 0xa2, 0xcb,
-	0x47, 0x13, 0x5d, 0xfe, 0x31, 0xd1, 0xe5, 0xf7, 0x53, 0x5d, 0x3a, 0x9a, 0xea, 0xd2, 0xd7, 0xa9,
-	0x2e, 0x3d, 0x3d, 0x33, 0xe9, 0x03, 0xfe, 0x7c, 0xf1, 0xbc, 0xbd, 0x3c, 0x7f, 0x37, 0x76, 0xff,
-	0x06, 0x00, 0x00, 0xff, 0xff, 0x57, 0x55, 0xa2, 0xf2, 0x1e, 0x05, 0x00, 0x00,
+	// 652 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x3f, 0x6f, 0xd3, 0x5e,
+	0x14, 0xb5, 0x93, 0xfe, 0x9a, 0x5f, 0x5f, 0x50, 0x4b, 0xad, 0xa8, 0xb8, 0x2e, 0x8a, 0x23, 0x57,
+	0x48, 0x11, 0xa8, 0xb6, 0x9a, 0x6e, 0x45, 0x0c, 0xb8, 0x80, 0x84, 0x50, 0x04, 0x32, 0x12, 0x48,
+	0x65, 0x28, 0x76, 0xf2, 0x70, 0x9f, 0xb0, 0x7d, 0xad, 0xbc, 0x97, 0xa8, 0xd9, 0x98, 0x80, 0x91,
+	0x91, 0xb1, 0x33, 0x03, 0x13, 0x9f, 0x01, 0x55, 0x4c, 0x1d, 0x18, 0x18, 0x50, 0x80, 0x76, 0x41,
+	0x8c, 0xfd, 0x04, 0xc8, 0xcf, 0x7e, 0x6e, 0xc9, 0x9f, 0x96, 0xa1, 0x6c, 0xbe, 0xf7, 0x9e, 0x73,
+	0x74, 0xcf, 0xf5, 0xbd, 0x0f, 0xa9, 0x81, 0x17, 0x5a, 0x3e, 0xf4, 0xac, 0xde, 0xaa, 0x87, 0x99,
+	0xbb, 0x6a, 0xb1, 0x1d, 0x33, 0xee, 0x00, 0x03, 0x65, 0x2e, 0xf0, 0x42, 0xd3, 0x87, 0x9e, 0x99,
+	0x55, 0xb4, 0xa5, 0x04, 0xea, 0xb9, 0x14, 0xe7, 0xd8, 0x16, 0x90, 0x28, 0x45, 0x6b, 0x8b, 0xc3,
+	0x3a, 0x09, 0x33, 0x2b, 0xb5, 0x80, 0x86, 0x40, 0xb7, 0x78, 0x64, 0xa5, 0x41, 0x56, 0xaa, 0xf8,
+	0xe0, 0x43, 0x9a, 0x4f, 0xbe, 0x04, 0xc1, 0x07, 0xf0, 0x03, 0x6c, 0xf1, 0xc8, 0xeb, 0x3e, 0xb3,
+	0xdc, 0xa8, 0x9f, 0x96, 0x8c, 0x57, 0x05, 0x34, 0xdf, 0xa4, 0xfe, 0xc3, 0xae, 0x17, 0x12, 0xf6,
+	0xa0, 0x03, 0x31, 0x50, 0x37, 0x50, 0xae, 0xa3, 0x52, 0x0b, 0x22, 0x86, 0x23, 0xa6, 0xca, 0x35,
+	0xb9, 0x5e, 0x6e, 0x54, 0xcc, 0x54, 0xc2, 0x14, 0x12, 0xe6, 0xcd, 0xa8, 0x6f, 0x97, 0x3f, 0x7d,
+	0x58, 0x29, 0x6d, 0xa4, 0x40, 0x47, 0x30, 0x94, 0x97, 0x32, 0x9a, 0x23, 0x11, 0x61, 0xc4, 0x0d,
+	0xb6, 0xda, 0x38, 0x06, 0x4a, 0x98, 0x5a, 0xa8, 0x15, 0xeb, 0xe5, 0xc6, 0x82, 0x99, 0x8c, 0x20,
+	0x71, 0x2c, 0x66, 0x60, 0x6e, 0x00, 0x89, 0xec, 0xdb, 0x7b, 0x03, 0x5d, 0x3a, 0x1a, 0xe8, 0x0b,
+	0x7d, 0x37, 0x0c, 0xd6, 0x8d, 0x21, 0xb2, 0xf1, 0xee, 0x9b, 0xbe, 0xec, 0x13, 0xb6, 0xdd, 0xf5,
+	0xcc, 0x16, 0x84, 0x56, 0x40, 0x22, 0x6c, 0x05, 0x5e, 0xb8, 0x42, 0xdb, 0xcf, 0x2d, 0xd6, 0x8f,
+	0x31, 0xe5, 0x2a, 0xd4, 0x99, 0xcd, 0x88, 0xb7, 0x52, 0x9e, 0xa2, 0xa1, 0xff, 0x63, 0xee, 0x08,
+	0x77, 0xd4, 0x62, 0x4d, 0xae, 0xcf, 0x38, 0x79, 0xbc, 0x7e, 0xf1, 0xf5, 0xae, 0x2e, 0xbd, 0xdd,
+	0xd5, 0xa5, 0x9f, 0xbb, 0xba, 0xf4, 0xe2, 0x6b, 0x4d, 0x32, 0x5a, 0x68, 0x71, 0x64, 0x10, 0x0e,
+	0xa6, 0x31, 0x44, 0x14, 0x2b, 0x77, 0x50, 0x39, 0xce, 0x72, 0x5b, 0xa4, 0xcd, 0x87, 0x32, 0x65,
+	0x5f, 0xf9, 0x35, 0xd0, 0x4f, 0xa6, 0x8f, 0x06, 0xba, 0x92, 0x3a, 0x38, 0x91, 0x34, 0x1c, 0x24,
+	0xa2, 0xbb, 0x6d, 0xe3, 0xbd, 0x8c, 0x4a, 0x4d, 0xea, 0x3f, 0x02, 0x76, 0x6e, 0x9a, 0x4a, 0x05,
+	0xfd, 0xd7, 0x03, 0x86, 0x3b, 0x6a, 0x81, 0x7b, 0x4c, 0x03, 0x65, 0x0d, 0x4d, 0x43, 0xcc, 0x08,
+	0x44, 0xdc, 0xfa, 0x6c, 0x63, 0xc9, 0x1c, 0x5a, 0x3f, 0x33, 0x69, 0xe2, 0x3e, 0x87, 0x38, 0x19,
+	0x74, 0xcc, 0x54, 0xe6, 0xd1, 0x5c, 0xd6, 0xaf, 0x98, 0x85, 0xf1, 0x51, 0xce, 0x73, 0x8f, 0x31,
+	0xf1, 0xb7, 0x19, 0x6e, 0xff, 0x63, 0x2f, 0x1b, 0xa8, 0x94, 0x36, 0x48, 0xd5, 0x22, 0x5f, 0xa4,
+	0xe5, 0x11, 0x33, 0xa2, 0x93, 0x63, 0x53, 0xf6, 0x54, 0xb2, 0x55, 0x8e, 0x60, 0x8e, 0xf1, 0xb6,
+	0x88, 0x2e, 0x0d, 0xf9, 0xc8, 0x3d, 0xfe, 0x90, 0x11, 0x6a, 0x52, 0x5f, 0x6c, 0xd2, 0x79, 0xd9,
+	0xbb, 0x8c, 0x66, 0xb2, 0xa5, 0x06, 0x61, 0xf1, 0x38, 0xa1, 0x3c, 0x41, 0xd3, 0x6e, 0x08, 0xdd,
+	0x88, 0xa9, 0xc5, 0x53, 0xcf, 0xe5, 0x5a, 0x62, 0xec, 0x6f, 0x8f, 0x22, 0x93, 0x1c, 0x63, 0xbf,
+	0x82, 0x94, 0x63, 0x8b, 0xc2, 0x79, 0xe3, 0x73, 0x01, 0x15, 0x9b, 0xd4, 0x57, 0x9e, 0xa2, 0xd9,
+	0xa1, 0x47, 0xc1, 0x18, 0x19, 0xfa, 0xc8, 0xbd, 0x68, 0x57, 0xcf, 0xc6, 0xe4, 0x37, 0x65, 0xa3,
+	0x29, 0x7e, 0x07, 0xea, 0x38, 0x4e, 0x52, 0xd1, 0x6a, 0x93, 0x2a, 0xb9, 0xc6, 0x26, 0xba, 0xf0,
+	0xc7, 0x1e, 0x4e, 0x64, 0x08, 0x84, 0x56, 0x3f, 0x0b, 0x91, 0x6b, 0xdf, 0x43, 0x25, 0xf1, 0xff,
+	0x97, 0xc6, 0x91, 0xb2, 0xa2, 0xb6, 0x7c, 0x4a, 0x51, 0x88, 0xd9, 0x37, 0xf6, 0x0e, 0xaa, 0xf2,
+	0xfe, 0x41, 0x55, 0xfe, 0x7e, 0x50, 0x95, 0xdf, 0x1c, 0x56, 0xa5, 0xfd, 0xc3, 0xaa, 0xf4, 0xe5,
+	0xb0, 0x2a, 0x6d, 0x4e, 0xfc, 0x8b, 0x3b, 0xfc, 0xf9, 0xe7, 0xff, 0xd2, 0x9b, 0xe6, 0xef, 0xee,
+	0xda, 0xef, 0x01, 0x00, 0xe5, 0xf8, 0x69, 0x85, 0x5e, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -337,6 +420,8 @@ type MsgClient interface {
 	SubmitProposal(ctx context.Context, in *MsgSubmitProposal, opts ...grpc.CallOption) (*MsgSubmitProposalResponse, error)
 	// Vote defines a method to add a vote on a specific proposal.
 	Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOption) (*MsgVoteResponse, error)
+	// VoteWeighted defines a method to add a weighted vote on a specific proposal.
+	VoteWeighted(ctx context.Context, in *MsgVoteWeighted, opts ...grpc.CallOption) (*MsgVoteWeightedResponse, error)
 	// Deposit defines a method to add deposit on a specific proposal.
 	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error)
 }
@@ -367,6 +452,15 @@ func (c *msgClient) Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *msgClient) VoteWeighted(ctx context.Context, in *MsgVoteWeighted, opts ...grpc.CallOption) (*MsgVoteWeightedResponse, error) {
+	out := new(MsgVoteWeightedResponse)
+	err := c.cc.Invoke(ctx, "/lbm.gov.v1beta1.Msg/VoteWeighted", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error) {
 	out := new(MsgDepositResponse)
 	err := c.cc.Invoke(ctx, "/lbm.gov.v1beta1.Msg/Deposit", in, out, opts...)
@@ -382,6 +476,8 @@ type MsgServer interface {
 	SubmitProposal(context.Context, *MsgSubmitProposal) (*MsgSubmitProposalResponse, error)
 	// Vote defines a method to add a vote on a specific proposal.
 	Vote(context.Context, *MsgVote) (*MsgVoteResponse, error)
+	// VoteWeighted defines a method to add a weighted vote on a specific proposal.
+	VoteWeighted(context.Context, *MsgVoteWeighted) (*MsgVoteWeightedResponse, error)
 	// Deposit defines a method to add deposit on a specific proposal.
 	Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error)
 }
@@ -396,6 +492,9 @@ func (*UnimplementedMsgServer) SubmitProposal(ctx context.Context, req *MsgSubmi
 func (*UnimplementedMsgServer) Vote(ctx context.Context, req *MsgVote) (*MsgVoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
 }
+func (*UnimplementedMsgServer) VoteWeighted(ctx context.Context, req *MsgVoteWeighted) (*MsgVoteWeightedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteWeighted not implemented")
+}
 func (*UnimplementedMsgServer) Deposit(ctx context.Context, req *MsgDeposit) (*MsgDepositResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
 }
@@ -440,6 +539,24 @@ func _Msg_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_VoteWeighted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoteWeighted)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VoteWeighted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.gov.v1beta1.Msg/VoteWeighted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoteWeighted(ctx, req.(*MsgVoteWeighted))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgDeposit)
 	if err := dec(in); err != nil {
@@ -470,6 +587,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Vote",
 			Handler:    _Msg_Vote_Handler,
 		},
+		{
+			MethodName: "VoteWeighted",
+			Handler:    _Msg_VoteWeighted_Handler,
+		},
 		{
 			MethodName: "Deposit",
 			Handler:    _Msg_Deposit_Handler,
@@ -626,6 +747,78 @@ func (m *MsgVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgVoteWeighted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteWeighted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteWeighted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteWeightedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteWeightedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteWeightedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -772,6 +965,37 @@ func (m *MsgVoteResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgVoteWeighted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgVoteWeightedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgDeposit) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1200,6 +1424,191 @@ func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgVoteWeighted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteWeighted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteWeighted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteWeightedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteWeightedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteWeightedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,16 +2,18 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	yaml "gopkg.in/yaml.v2"
 
 	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
 )
 
 // NewVote creates a new Vote instance
 //nolint:interfacer
-func NewVote(proposalID uint64, voter sdk.AccAddress, option VoteOption) Vote {
-	return Vote{proposalID, voter.String(), option}
+func NewVote(proposalID uint64, voter sdk.AccAddress, options WeightedVoteOptions) Vote {
+	return Vote{ProposalId: proposalID, Voter: voter.String(), Options: options}
 }
 
 func (v Vote) String() string {
@@ -43,7 +45,7 @@ func (v Votes) String() string {
 	}
 	out := fmt.Sprintf("Votes for Proposal %d:", v[0].ProposalId)
 	for _, vot := range v {
-		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Option)
+		out += fmt.Sprintf("\n  %s: %s", vot.Voter, WeightedVoteOptions(vot.Options))
 	}
 	return out
 }
@@ -53,6 +55,67 @@ func (v Vote) Empty() bool {
 	return v.String() == Vote{}.String()
 }
 
+// NewWeightedVoteOption creates a new WeightedVoteOption instance
+func NewWeightedVoteOption(option VoteOption, weight sdk.Dec) WeightedVoteOption {
+	return WeightedVoteOption{Option: option, Weight: weight}
+}
+
+// NewNonSplitVoteOption creates single option vote with weight 1
+func NewNonSplitVoteOption(option VoteOption) WeightedVoteOptions {
+	return WeightedVoteOptions{{option, sdk.NewDec(1)}}
+}
+
+func (v WeightedVoteOption) String() string {
+	out, _ := yaml.Marshal(v)
+	return string(out)
+}
+
+// WeightedVoteOptions describes array of WeightedVoteOptions
+type WeightedVoteOptions []WeightedVoteOption
+
+func (v WeightedVoteOptions) String() (out string) {
+	for _, opt := range v {
+		out += opt.String() + "\n"
+	}
+
+	return strings.TrimSpace(out)
+}
+
+// ValidWeightedVoteOption returns true if the sub vote is valid and false otherwise.
+func ValidWeightedVoteOption(option WeightedVoteOption) bool {
+	if !option.Weight.IsPositive() || option.Weight.GT(sdk.NewDec(1)) {
+		return false
+	}
+	return ValidVoteOption(option.Option)
+}
+
+// ValidWeightedVoteOptions returns an error if the options are not a valid split
+// of the voting power: each option is valid and used once, and the weights sum to 1.
+func ValidWeightedVoteOptions(options WeightedVoteOptions) error {
+	if len(options) == 0 {
+		return sdkerrors.Wrap(ErrInvalidVote, "no vote option")
+	}
+
+	totalWeight := sdk.ZeroDec()
+	usedOptions := make(map[VoteOption]bool)
+	for _, option := range options {
+		if !ValidWeightedVoteOption(option) {
+			return sdkerrors.Wrap(ErrInvalidVote, option.String())
+		}
+		if usedOptions[option.Option] {
+			return sdkerrors.Wrap(ErrInvalidVote, "duplicated vote option")
+		}
+		usedOptions[option.Option] = true
+		totalWeight = totalWeight.Add(option.Weight)
+	}
+
+	if !totalWeight.Equal(sdk.NewDec(1)) {
+		return sdkerrors.Wrap(ErrInvalidVote, "total weight of vote options must be 1")
+	}
+
+	return nil
+}
+
 // VoteOptionFromString returns a VoteOption from a string. It returns an error
 // if the string is invalid.
 func VoteOptionFromString(str string) (VoteOption, error) {
@@ -63,6 +126,30 @@ func VoteOptionFromString(str string) (VoteOption, error) {
 	return VoteOption(option), nil
 }
 
+// WeightedVoteOptionsFromString returns weighted vote options from string. It
+// returns an error if the string is invalid. The expected format is a comma
+// separated list of option=weight pairs, e.g.
+// "VOTE_OPTION_YES=0.6,VOTE_OPTION_NO=0.3,VOTE_OPTION_ABSTAIN=0.1".
+func WeightedVoteOptionsFromString(str string) (WeightedVoteOptions, error) {
+	options := WeightedVoteOptions{}
+	for _, option := range strings.Split(str, ",") {
+		fields := strings.Split(option, "=")
+		if len(fields) != 2 {
+			return options, fmt.Errorf("'%s' is not a valid weighted vote option", option)
+		}
+		voteOption, err := VoteOptionFromString(fields[0])
+		if err != nil {
+			return options, err
+		}
+		weight, err := sdk.NewDecFromStr(fields[1])
+		if err != nil {
+			return options, err
+		}
+		options = append(options, NewWeightedVoteOption(voteOption, weight))
+	}
+	return options, nil
+}
+
 // ValidVoteOption returns true if the vote option is valid and false otherwise.
 func ValidVoteOption(option VoteOption) bool {
 	if option == OptionYes ||
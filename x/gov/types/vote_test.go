@@ -0,0 +1,49 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lbm-sdk/types"
+)
+
+func TestWeightedVoteOptionsFromString(t *testing.T) {
+	tests := []struct {
+		name      string
+		str       string
+		expected  WeightedVoteOptions
+		expectErr bool
+	}{
+		{
+			"single option",
+			"VOTE_OPTION_YES=1",
+			NewNonSplitVoteOption(OptionYes),
+			false,
+		},
+		{
+			"split options",
+			"VOTE_OPTION_YES=0.6,VOTE_OPTION_NO_WITH_VETO=0.4",
+			WeightedVoteOptions{
+				NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(6, 1)),
+				NewWeightedVoteOption(OptionNoWithVeto, sdk.NewDecWithPrec(4, 1)),
+			},
+			false,
+		},
+		{"missing weight", "VOTE_OPTION_YES", nil, true},
+		{"invalid option", "VOTE_OPTION_MAYBE=1", nil, true},
+		{"invalid weight", "VOTE_OPTION_YES=one", nil, true},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			options, err := WeightedVoteOptionsFromString(tc.str)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, options)
+		})
+	}
+}
@@ -0,0 +1,212 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/line/lbm-sdk/client"
+	"github.com/line/lbm-sdk/client/flags"
+	"github.com/line/lbm-sdk/client/tx"
+	"github.com/line/lbm-sdk/codec"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/version"
+	govcli "github.com/line/lbm-sdk/x/gov/client/cli"
+	govtypes "github.com/line/lbm-sdk/x/gov/types"
+	"github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/controller/types"
+	icatypes "github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/types"
+)
+
+const (
+	// FlagRelativePacketTimeout is the flag of the relative packet timeout
+	FlagRelativePacketTimeout = "relative-packet-timeout"
+
+	// DefaultRelativePacketTimeout is the default packet timeout relative to the block time
+	DefaultRelativePacketTimeout = 10 * time.Minute
+)
+
+// NewRegisterInterchainAccountCmd returns the command to create a MsgRegisterInterchainAccount
+// transaction
+func NewRegisterInterchainAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "register [connection-id]",
+		Short:   "Register an interchain account on the host chain of a connection",
+		Long:    "Register an interchain account owned by the sender on the host chain of a connection.",
+		Example: fmt.Sprintf("%s tx interchain-accounts-controller register connection-0 --from mykey", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterInterchainAccount(args[0], clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSendTxCmd returns the command to create a MsgSendTx transaction
+func NewSendTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-tx [connection-id] [path/to/packet_data.json]",
+		Short: "Send a transaction to be executed by an interchain account",
+		Long: `Send a transaction to be executed by the interchain account of the sender on the host
+chain of a connection. The file contains the JSON encoded interchain account packet data, which
+can be generated with the generate-packet-data command of the host submodule.`,
+		Example: fmt.Sprintf("%s tx interchain-accounts-controller send-tx connection-0 packet_data.json --from mykey", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			packetData, err := parsePacketData(clientCtx.JSONMarshaler, args[1])
+			if err != nil {
+				return err
+			}
+
+			relativeTimeout, err := cmd.Flags().GetDuration(FlagRelativePacketTimeout)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSendTx(clientCtx.GetFromAddress().String(), args[0], uint64(relativeTimeout.Nanoseconds()), packetData)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Duration(FlagRelativePacketTimeout, DefaultRelativePacketTimeout, "Packet timeout relative to the block time of the controller chain")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdSubmitRegisterInterchainAccountProposal implements a command handler for submitting a
+// register interchain account proposal transaction.
+func NewCmdSubmitRegisterInterchainAccountProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-interchain-account [connection-id] [flags]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to register an interchain account owned by governance",
+		Long: "Submit a proposal to register an interchain account owned by governance on the host chain of a connection\n" +
+			"along with an initial deposit.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(title, description string) govtypes.Content {
+				return types.NewRegisterInterchainAccountProposal(title, description, args[0])
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// NewCmdSubmitSendTxProposal implements a command handler for submitting a send interchain
+// account tx proposal transaction.
+func NewCmdSubmitSendTxProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-interchain-account-tx [connection-id] [path/to/packet_data.json] [flags]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to execute a transaction with the interchain account owned by governance",
+		Long: "Submit a proposal to execute a transaction with the interchain account owned by governance on the host\n" +
+			"chain of a connection along with an initial deposit. The file contains the JSON encoded interchain account packet data.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			packetData, err := parsePacketData(clientCtx.JSONMarshaler, args[1])
+			if err != nil {
+				return err
+			}
+
+			relativeTimeout, err := cmd.Flags().GetDuration(FlagRelativePacketTimeout)
+			if err != nil {
+				return err
+			}
+
+			return submitProposal(cmd, func(title, description string) govtypes.Content {
+				return types.NewSendTxProposal(title, description, args[0], uint64(relativeTimeout.Nanoseconds()), packetData)
+			})
+		},
+	}
+
+	cmd.Flags().Duration(FlagRelativePacketTimeout, DefaultRelativePacketTimeout, "Packet timeout relative to the block time when the proposal passes")
+	addProposalFlags(cmd)
+	return cmd
+}
+
+func parsePacketData(cdc codec.JSONMarshaler, path string) (icatypes.InterchainAccountPacketData, error) {
+	var packetData icatypes.InterchainAccountPacketData
+
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return packetData, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &packetData); err != nil {
+		return packetData, fmt.Errorf("error unmarshalling packet data: %w", err)
+	}
+	return packetData, nil
+}
+
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.MarkFlagRequired(govcli.FlagTitle)
+	cmd.MarkFlagRequired(govcli.FlagDescription)
+}
+
+func submitProposal(cmd *cobra.Command, newContent func(title, description string) govtypes.Content) error {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return err
+	}
+
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return err
+	}
+
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return err
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(newContent(title, description), deposit, clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+
+	if err = msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
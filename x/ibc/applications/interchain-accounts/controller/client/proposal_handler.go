@@ -0,0 +1,14 @@
+package client
+
+import (
+	govclient "github.com/line/lbm-sdk/x/gov/client"
+	"github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/controller/client/cli"
+	"github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/controller/client/rest"
+)
+
+var (
+	// RegisterInterchainAccountProposalHandler is the register interchain account proposal handler.
+	RegisterInterchainAccountProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitRegisterInterchainAccountProposal, rest.RegisterInterchainAccountProposalRESTHandler)
+	// SendTxProposalHandler is the send interchain account tx proposal handler.
+	SendTxProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitSendTxProposal, rest.SendTxProposalRESTHandler)
+)
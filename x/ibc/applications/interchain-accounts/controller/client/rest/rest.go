@@ -0,0 +1,86 @@
+package rest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/line/lbm-sdk/client"
+	"github.com/line/lbm-sdk/client/tx"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/types/rest"
+	govrest "github.com/line/lbm-sdk/x/gov/client/rest"
+	govtypes "github.com/line/lbm-sdk/x/gov/types"
+	"github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/controller/types"
+	icatypes "github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/types"
+)
+
+// InterchainAccountProposalReq defines an interchain account proposal request body.
+type InterchainAccountProposalReq struct {
+	BaseReq      rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title        string       `json:"title" yaml:"title"`
+	Description  string       `json:"description" yaml:"description"`
+	Deposit      sdk.Coins    `json:"deposit" yaml:"deposit"`
+	ConnectionID string       `json:"connection_id" yaml:"connection_id"`
+}
+
+// SendTxProposalReq defines a send interchain account tx proposal request body.
+type SendTxProposalReq struct {
+	InterchainAccountProposalReq
+	PacketData icatypes.InterchainAccountPacketData `json:"packet_data" yaml:"packet_data"`
+	// RelativeTimeout is the packet timeout relative to the block time (ex. 10m)
+	RelativeTimeout string `json:"relative_timeout" yaml:"relative_timeout"`
+}
+
+func RegisterInterchainAccountProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "register_interchain_account",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req InterchainAccountProposalReq
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			content := types.NewRegisterInterchainAccountProposal(req.Title, req.Description, req.ConnectionID)
+			writeProposalTx(clientCtx, w, req, content)
+		},
+	}
+}
+
+func SendTxProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "send_interchain_account_tx",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req SendTxProposalReq
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			relativeTimeout, err := time.ParseDuration(req.RelativeTimeout)
+			if rest.CheckBadRequestError(w, err) {
+				return
+			}
+
+			content := types.NewSendTxProposal(
+				req.Title, req.Description, req.ConnectionID, uint64(relativeTimeout.Nanoseconds()), req.PacketData,
+			)
+			writeProposalTx(clientCtx, w, req.InterchainAccountProposalReq, content)
+		},
+	}
+}
+
+func writeProposalTx(clientCtx client.Context, w http.ResponseWriter, req InterchainAccountProposalReq, content govtypes.Content) {
+	req.BaseReq = req.BaseReq.Sanitize()
+	if !req.BaseReq.ValidateBasic(w) {
+		return
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, sdk.AccAddress(req.BaseReq.From))
+	if rest.CheckBadRequestError(w, err) {
+		return
+	}
+	if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+		return
+	}
+
+	tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/controller/types"
+	icatypes "github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/types"
+	channeltypes "github.com/line/lbm-sdk/x/ibc/core/04-channel/types"
+)
+
+// InitInterchainAccount binds the controller port of the owner, if it is not bound yet,
+// and initiates the opening handshake of a new ordered channel to the host port on the given
+// connection. The handshake is routed through the MsgServiceRouter as a MsgChannelOpenInit
+// signed by the owner, so the regular IBC handlers and callbacks are executed.
+//
+// The interchain account address is known once the channel is acknowledged by the host.
+func (k Keeper) InitInterchainAccount(ctx sdk.Context, connectionID, owner string) (string, error) {
+	if !k.IsControllerEnabled(ctx) {
+		return "", types.ErrControllerSubModuleDisabled
+	}
+
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return "", err
+	}
+
+	if k.IsActiveChannelOpen(ctx, connectionID, portID) {
+		channelID, _ := k.GetActiveChannelID(ctx, connectionID, portID)
+		return "", sdkerrors.Wrapf(icatypes.ErrActiveChannelAlreadySet, "existing active channel %s for portID %s", channelID, portID)
+	}
+
+	if !k.IsBound(ctx, portID) {
+		if err := k.BindPort(ctx, portID); err != nil {
+			return "", sdkerrors.Wrapf(err, "unable to bind to newly generated portID: %s", portID)
+		}
+	}
+
+	msg := channeltypes.NewMsgChannelOpenInit(
+		portID, icatypes.Version, channeltypes.ORDERED, []string{connectionID},
+		icatypes.HostPortID, sdk.AccAddress(owner),
+	)
+	if err := msg.ValidateBasic(); err != nil {
+		return "", err
+	}
+
+	handler := k.msgRouter.HandlerByTypeURL(sdk.MsgTypeURL(msg))
+	if handler == nil {
+		return "", sdkerrors.Wrapf(icatypes.ErrInvalidRoute, "unrecognized message route: %s", sdk.MsgTypeURL(msg))
+	}
+
+	// the channel handshake handler does not return the identifier of the new channel
+	channelID := channeltypes.FormatChannelIdentifier(k.channelKeeper.GetNextChannelSequence(ctx))
+
+	res, err := handler(ctx, msg)
+	if err != nil {
+		return "", err
+	}
+
+	// emit the events from the channel handshake
+	events := make([]sdk.Event, 0, len(res.Events))
+	for _, event := range res.Events {
+		events = append(events, sdk.Event(event))
+	}
+	ctx.EventManager().EmitEvents(events)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypeRegisterInterchainAccount,
+			sdk.NewAttribute(icatypes.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(icatypes.AttributeKeyPortID, portID),
+		),
+	)
+
+	k.Logger(ctx).Info("interchain account registration initiated", "port-id", portID, "channel-id", channelID)
+	return channelID, nil
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/controller/types"
+)
+
+// InitGenesis initializes the interchain accounts controller application state from a
+// provided genesis state
+func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
+	for _, portID := range state.Ports {
+		if !k.IsBound(ctx, portID) {
+			if err := k.BindPort(ctx, portID); err != nil {
+				panic(fmt.Sprintf("could not claim port capability: %v", err))
+			}
+		}
+	}
+
+	for _, ch := range state.ActiveChannels {
+		k.SetActiveChannelID(ctx, ch.ConnectionId, ch.PortId, ch.ChannelId)
+	}
+
+	for _, acc := range state.InterchainAccounts {
+		k.SetInterchainAccountAddress(ctx, acc.ConnectionId, acc.PortId, acc.AccountAddress)
+	}
+
+	k.SetParams(ctx, state.Params)
+}
+
+// ExportGenesis returns the interchain accounts controller exported genesis
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(
+		k.GetAllActiveChannels(ctx),
+		k.GetAllInterchainAccounts(ctx),
+		k.GetAllPorts(ctx),
+		k.GetParams(ctx),
+	)
+}
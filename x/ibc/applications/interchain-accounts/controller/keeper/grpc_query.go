@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/controller/types"
+	icatypes "github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// InterchainAccount implements the Query/InterchainAccount gRPC method
+func (q Keeper) InterchainAccount(c context.Context, req *types.QueryInterchainAccountRequest) (*types.QueryInterchainAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	portID, err := icatypes.NewControllerPortID(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	address, found := q.GetInterchainAccountAddress(ctx, req.ConnectionId, portID)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(icatypes.ErrInterchainAccountNotFound, "connection %s, port %s", req.ConnectionId, portID).Error(),
+		)
+	}
+
+	return &types.QueryInterchainAccountResponse{
+		Address: address,
+	}, nil
+}
+
+// Params implements the Query/Params gRPC method
+func (q Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := q.GetParams(ctx)
+
+	return &types.QueryParamsResponse{
+		Params: &params,
+	}, nil
+}
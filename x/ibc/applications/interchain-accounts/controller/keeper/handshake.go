@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	capabilitytypes "github.com/line/lbm-sdk/x/capability/types"
+	icatypes "github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/types"
+	channeltypes "github.com/line/lbm-sdk/x/ibc/core/04-channel/types"
+	host "github.com/line/lbm-sdk/x/ibc/core/24-host"
+)
+
+// OnChanOpenInit performs basic validation of channel initialization.
+// The channel order must be ORDERED, the counterparty port identifier must be the host port,
+// the version must be the ICS-27 version and the owner must not have an open active channel
+// on the connection.
+func (k Keeper) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) error {
+	if order != channeltypes.ORDERED {
+		return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s", channeltypes.ORDERED, order)
+	}
+	if err := icatypes.ValidateControllerPortID(portID); err != nil {
+		return err
+	}
+	if counterparty.PortId != icatypes.HostPortID {
+		return sdkerrors.Wrapf(icatypes.ErrInvalidHostPort, "expected %s, got %s", icatypes.HostPortID, counterparty.PortId)
+	}
+	if version != icatypes.Version {
+		return sdkerrors.Wrapf(icatypes.ErrInvalidVersion, "expected %s, got %s", icatypes.Version, version)
+	}
+
+	if k.IsActiveChannelOpen(ctx, connectionHops[0], portID) {
+		activeChannelID, _ := k.GetActiveChannelID(ctx, connectionHops[0], portID)
+		return sdkerrors.Wrapf(icatypes.ErrActiveChannelAlreadySet, "existing active channel %s for portID %s", activeChannelID, portID)
+	}
+
+	// Claim channel capability passed back by IBC module
+	return k.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID))
+}
+
+// OnChanOpenAck sets the active channel of the owner and stores the interchain account
+// address carried by the counterparty version.
+func (k Keeper) OnChanOpenAck(ctx sdk.Context, portID, channelID string, counterpartyVersion string) error {
+	if portID == icatypes.HostPortID {
+		return sdkerrors.Wrapf(icatypes.ErrInvalidControllerPort, "portID cannot be host chain port ID: %s", icatypes.HostPortID)
+	}
+
+	address, err := icatypes.ParseAddressFromVersion(counterpartyVersion)
+	if err != nil {
+		return err
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+	connectionID := channel.ConnectionHops[0]
+
+	if k.IsActiveChannelOpen(ctx, connectionID, portID) {
+		activeChannelID, _ := k.GetActiveChannelID(ctx, connectionID, portID)
+		return sdkerrors.Wrapf(icatypes.ErrActiveChannelAlreadySet, "existing active channel %s for portID %s", activeChannelID, portID)
+	}
+
+	if existing, found := k.GetInterchainAccountAddress(ctx, connectionID, portID); found && existing != address {
+		return sdkerrors.Wrapf(icatypes.ErrInterchainAccountAlreadySet, "existing interchain account address %s does not match %s", existing, address)
+	}
+
+	k.SetActiveChannelID(ctx, connectionID, portID, channelID)
+	k.SetInterchainAccountAddress(ctx, connectionID, portID, address)
+
+	return nil
+}
@@ -0,0 +1,327 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/line/lbm-sdk/baseapp"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/controller/keeper"
+	"github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/controller/types"
+	hosttypes "github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/host/types"
+	icatypes "github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/types"
+	clienttypes "github.com/line/lbm-sdk/x/ibc/core/02-client/types"
+	channeltypes "github.com/line/lbm-sdk/x/ibc/core/04-channel/types"
+	host "github.com/line/lbm-sdk/x/ibc/core/24-host"
+	"github.com/line/lbm-sdk/x/ibc/core/exported"
+	ibctesting "github.com/line/lbm-sdk/x/ibc/testing"
+	stakingtypes "github.com/line/lbm-sdk/x/staking/types"
+)
+
+const relativeTimeout = uint64(time.Hour)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// chainA is the controller chain and chainB is the host chain
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	connA *ibctesting.TestConnection
+	connB *ibctesting.TestConnection
+
+	owner  string
+	portID string
+
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(0))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+
+	_, _, suite.connA, suite.connB = suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Ostracon)
+
+	suite.owner = suite.chainA.SenderAccount.GetAddress().String()
+	portID, err := icatypes.NewControllerPortID(suite.owner)
+	suite.Require().NoError(err)
+	suite.portID = portID
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.chainA.GetContext(), suite.chainA.App.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.chainA.App.ICAControllerKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// registerInterchainAccount initiates the registration with a MsgRegisterInterchainAccount and
+// completes the channel handshake with the host chain.
+func (suite *KeeperTestSuite) registerInterchainAccount(owner string) (ibctesting.TestChannel, ibctesting.TestChannel) {
+	portID, err := icatypes.NewControllerPortID(owner)
+	suite.Require().NoError(err)
+
+	channelA := suite.chainA.NextTestChannel(suite.connA, portID)
+	channelA.Version = icatypes.Version
+	channelB := suite.chainB.NextTestChannel(suite.connB, icatypes.HostPortID)
+	channelB.Version = icatypes.NewAppVersion(icatypes.Version, icatypes.GenerateAddress(suite.connB.ID, portID).String())
+
+	msg := types.NewMsgRegisterInterchainAccount(suite.connA.ID, owner)
+	suite.Require().NoError(suite.coordinator.SendMsg(suite.chainA, suite.chainB, suite.connB.ClientID, msg))
+
+	return suite.openInterchainAccountChannel(channelA, channelB)
+}
+
+// openInterchainAccountChannel completes the channel handshake initiated on the controller chain.
+func (suite *KeeperTestSuite) openInterchainAccountChannel(channelA, channelB ibctesting.TestChannel) (ibctesting.TestChannel, ibctesting.TestChannel) {
+	suite.Require().NoError(suite.coordinator.ChanOpenTry(suite.chainB, suite.chainA, channelB, channelA, suite.connB, channeltypes.ORDERED))
+	suite.Require().NoError(suite.coordinator.ChanOpenAck(suite.chainA, suite.chainB, channelA, channelB))
+	suite.Require().NoError(suite.coordinator.ChanOpenConfirm(suite.chainB, suite.chainA, channelB, channelA))
+
+	return channelA, channelB
+}
+
+// delegatePacketData returns the packet data of a delegation of the interchain account and
+// allows the delegations on the host chain.
+func (suite *KeeperTestSuite) delegatePacketData(address sdk.AccAddress, amount sdk.Coin) (icatypes.InterchainAccountPacketData, sdk.ValAddress) {
+	ctx := suite.chainB.GetContext()
+	validator := suite.chainB.App.StakingKeeper.GetAllValidators(ctx)[0].GetOperator()
+
+	msg := stakingtypes.NewMsgDelegate(address, validator, amount)
+	data, err := icatypes.SerializeCosmosTx(suite.chainB.App.AppCodec(), []sdk.Msg{msg})
+	suite.Require().NoError(err)
+
+	suite.chainB.App.ICAHostKeeper.SetParams(ctx, hosttypes.NewParams(true, []string{sdk.MsgTypeURL(msg)}))
+
+	return icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}, validator
+}
+
+func (suite *KeeperTestSuite) TestRegisterInterchainAccount() {
+	channelA, channelB := suite.registerInterchainAccount(suite.owner)
+	ctxA, ctxB := suite.chainA.GetContext(), suite.chainB.GetContext()
+	expAddress := icatypes.GenerateAddress(suite.connB.ID, suite.portID)
+
+	// controller chain
+	suite.Require().True(suite.chainA.App.ICAControllerKeeper.IsBound(ctxA, suite.portID))
+	suite.Require().Equal([]string{suite.portID}, suite.chainA.App.ICAControllerKeeper.GetAllPorts(ctxA))
+	activeChannelID, found := suite.chainA.App.ICAControllerKeeper.GetActiveChannelID(ctxA, suite.connA.ID, suite.portID)
+	suite.Require().True(found)
+	suite.Require().Equal(channelA.ID, activeChannelID)
+	suite.Require().True(suite.chainA.App.ICAControllerKeeper.IsActiveChannelOpen(ctxA, suite.connA.ID, suite.portID))
+	address, found := suite.chainA.App.ICAControllerKeeper.GetInterchainAccountAddress(ctxA, suite.connA.ID, suite.portID)
+	suite.Require().True(found)
+	suite.Require().Equal(expAddress.String(), address)
+
+	// host chain
+	activeChannelID, found = suite.chainB.App.ICAHostKeeper.GetActiveChannelID(ctxB, suite.connB.ID, suite.portID)
+	suite.Require().True(found)
+	suite.Require().Equal(channelB.ID, activeChannelID)
+	acc := suite.chainB.App.AccountKeeper.GetAccount(ctxB, expAddress)
+	suite.Require().IsType(&icatypes.InterchainAccount{}, acc)
+	suite.Require().Equal(suite.portID, acc.(*icatypes.InterchainAccount).AccountOwner)
+
+	// the active channel is open
+	_, err := suite.chainA.App.ICAControllerKeeper.InitInterchainAccount(ctxA, suite.connA.ID, suite.owner)
+	suite.Require().ErrorIs(err, icatypes.ErrActiveChannelAlreadySet)
+
+	// the query returns the address
+	res, err := suite.queryClient.InterchainAccount(sdk.WrapSDKContext(ctxA), &types.QueryInterchainAccountRequest{
+		Owner: suite.owner, ConnectionId: suite.connA.ID,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expAddress.String(), res.Address)
+}
+
+func (suite *KeeperTestSuite) TestRegisterInterchainAccountDisabled() {
+	ctx := suite.chainA.GetContext()
+	suite.chainA.App.ICAControllerKeeper.SetParams(ctx, types.NewParams(false))
+
+	_, err := suite.chainA.App.ICAControllerKeeper.InitInterchainAccount(ctx, suite.connA.ID, suite.owner)
+	suite.Require().ErrorIs(err, types.ErrControllerSubModuleDisabled)
+	suite.Require().False(suite.chainA.App.ICAControllerKeeper.IsBound(ctx, suite.portID))
+}
+
+func (suite *KeeperTestSuite) TestSendTx() {
+	channelA, channelB := suite.registerInterchainAccount(suite.owner)
+	address := icatypes.GenerateAddress(suite.connB.ID, suite.portID)
+
+	amount := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000))
+	suite.Require().NoError(suite.chainB.App.BankKeeper.SendCoins(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), address, sdk.NewCoins(amount)))
+	packetData, validator := suite.delegatePacketData(address, amount)
+
+	ctx := suite.chainA.GetContext()
+	sequence, err := suite.chainA.App.ICAControllerKeeper.SendInterchainAccountTx(ctx, suite.connA.ID, suite.owner, packetData, relativeTimeout)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), sequence)
+
+	packet := channeltypes.NewPacket(
+		packetData.GetBytes(), sequence, channelA.PortID, channelA.ID, channelB.PortID, channelB.ID,
+		clienttypes.ZeroHeight(), uint64(ctx.BlockTime().UnixNano())+relativeTimeout,
+	)
+	suite.chainA.CommitBlock()
+	suite.chainA.NextBlock()
+	suite.Require().NoError(suite.coordinator.UpdateClient(suite.chainB, suite.chainA, suite.connB.ClientID, exported.Ostracon))
+
+	txMsgData, err := proto.Marshal(&sdk.TxMsgData{
+		Data: []*sdk.MsgData{{MsgType: sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})}},
+	})
+	suite.Require().NoError(err)
+	ack := channeltypes.NewResultAcknowledgement(txMsgData)
+	suite.Require().NoError(suite.coordinator.RelayPacket(suite.chainA, suite.chainB, channelA.ClientID, channelB.ClientID, packet, ack.GetBytes()))
+
+	delegation, found := suite.chainB.App.StakingKeeper.GetDelegation(suite.chainB.GetContext(), address, validator)
+	suite.Require().True(found)
+	suite.Require().False(delegation.Shares.IsZero())
+}
+
+func (suite *KeeperTestSuite) TestSendTxErrors() {
+	_, _ = suite.registerInterchainAccount(suite.owner)
+	packetData, _ := suite.delegatePacketData(icatypes.GenerateAddress(suite.connB.ID, suite.portID), sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1)))
+
+	testCases := []struct {
+		msg        string
+		malleate   func(ctx sdk.Context)
+		owner      string
+		packetData icatypes.InterchainAccountPacketData
+		timeout    uint64
+		expErr     error
+	}{
+		{"no active channel", func(sdk.Context) {}, suite.chainB.SenderAccount.GetAddress().String(), packetData, relativeTimeout, icatypes.ErrActiveChannelNotFound},
+		{"invalid packet data", func(sdk.Context) {}, suite.owner, icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX}, relativeTimeout, icatypes.ErrInvalidOutgoingData},
+		{"zero timeout", func(sdk.Context) {}, suite.owner, packetData, 0, icatypes.ErrInvalidTimeoutTimestamp},
+		{"closed channel", func(ctx sdk.Context) {
+			channelID, _ := suite.chainA.App.ICAControllerKeeper.GetActiveChannelID(ctx, suite.connA.ID, suite.portID)
+			channel, _ := suite.chainA.App.IBCKeeper.ChannelKeeper.GetChannel(ctx, suite.portID, channelID)
+			channel.State = channeltypes.CLOSED
+			suite.chainA.App.IBCKeeper.ChannelKeeper.SetChannel(ctx, suite.portID, channelID, channel)
+		}, suite.owner, packetData, relativeTimeout, channeltypes.ErrInvalidChannelState},
+		// params are cached by the subspace, so the disabled case must run last
+		{"disabled", func(ctx sdk.Context) {
+			suite.chainA.App.ICAControllerKeeper.SetParams(ctx, types.NewParams(false))
+		}, suite.owner, packetData, relativeTimeout, types.ErrControllerSubModuleDisabled},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			ctx, _ := suite.chainA.GetContext().CacheContext()
+			tc.malleate(ctx)
+
+			_, err := suite.chainA.App.ICAControllerKeeper.SendInterchainAccountTx(ctx, suite.connA.ID, tc.owner, tc.packetData, tc.timeout)
+			suite.Require().ErrorIs(err, tc.expErr)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTimeoutAndRegisterAgain() {
+	channelA, channelB := suite.registerInterchainAccount(suite.owner)
+	address := icatypes.GenerateAddress(suite.connB.ID, suite.portID)
+	packetData, _ := suite.delegatePacketData(address, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1)))
+
+	ctx := suite.chainA.GetContext()
+	sequence, err := suite.chainA.App.ICAControllerKeeper.SendInterchainAccountTx(ctx, suite.connA.ID, suite.owner, packetData, 1)
+	suite.Require().NoError(err)
+	packet := channeltypes.NewPacket(
+		packetData.GetBytes(), sequence, channelA.PortID, channelA.ID, channelB.PortID, channelB.ID,
+		clienttypes.ZeroHeight(), uint64(ctx.BlockTime().UnixNano())+1,
+	)
+	suite.coordinator.CommitBlock(suite.chainA)
+	suite.coordinator.IncrementTime()
+	suite.coordinator.CommitBlock(suite.chainB)
+
+	// time out the packet, closing the ordered channel on the controller chain
+	suite.Require().NoError(suite.coordinator.UpdateClient(suite.chainA, suite.chainB, channelA.ClientID, exported.Ostracon))
+	proof, proofHeight := suite.chainB.QueryProof(host.NextSequenceRecvKey(channelB.PortID, channelB.ID))
+	msg := channeltypes.NewMsgTimeout(packet, sequence, proof, proofHeight, suite.chainA.SenderAccount.GetAddress())
+	suite.Require().NoError(suite.coordinator.SendMsg(suite.chainA, suite.chainB, channelB.ClientID, msg))
+	suite.Require().Equal(channeltypes.CLOSED, suite.chainA.GetChannel(channelA).State)
+	suite.Require().False(suite.chainA.App.ICAControllerKeeper.IsActiveChannelOpen(suite.chainA.GetContext(), suite.connA.ID, suite.portID))
+
+	_, err = suite.chainA.App.ICAControllerKeeper.SendInterchainAccountTx(suite.chainA.GetContext(), suite.connA.ID, suite.owner, packetData, relativeTimeout)
+	suite.Require().ErrorIs(err, channeltypes.ErrInvalidChannelState)
+
+	// the host chain closes its channel end
+	proof, proofHeight = suite.chainA.QueryProof(host.ChannelKey(channelA.PortID, channelA.ID))
+	closeMsg := channeltypes.NewMsgChannelCloseConfirm(channelB.PortID, channelB.ID, proof, proofHeight, suite.chainB.SenderAccount.GetAddress())
+	suite.Require().NoError(suite.coordinator.SendMsg(suite.chainB, suite.chainA, channelA.ClientID, closeMsg))
+
+	// a new active channel is opened for the same interchain account
+	newChannelA, newChannelB := suite.registerInterchainAccount(suite.owner)
+	suite.Require().NotEqual(channelA.ID, newChannelA.ID)
+
+	activeChannelID, found := suite.chainA.App.ICAControllerKeeper.GetActiveChannelID(suite.chainA.GetContext(), suite.connA.ID, suite.portID)
+	suite.Require().True(found)
+	suite.Require().Equal(newChannelA.ID, activeChannelID)
+	activeChannelID, found = suite.chainB.App.ICAHostKeeper.GetActiveChannelID(suite.chainB.GetContext(), suite.connB.ID, suite.portID)
+	suite.Require().True(found)
+	suite.Require().Equal(newChannelB.ID, activeChannelID)
+	registered, _ := suite.chainA.App.ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), suite.connA.ID, suite.portID)
+	suite.Require().Equal(address.String(), registered)
+}
+
+func (suite *KeeperTestSuite) TestProposals() {
+	govOwner := keeper.GovernanceOwner()
+	govPortID, err := icatypes.NewControllerPortID(govOwner)
+	suite.Require().NoError(err)
+
+	channelA := suite.chainA.NextTestChannel(suite.connA, govPortID)
+	channelA.Version = icatypes.Version
+	channelB := suite.chainB.NextTestChannel(suite.connB, icatypes.HostPortID)
+	channelB.Version = icatypes.NewAppVersion(icatypes.Version, icatypes.GenerateAddress(suite.connB.ID, govPortID).String())
+
+	registerProposal := types.NewRegisterInterchainAccountProposal("title", "description", suite.connA.ID)
+	suite.Require().NoError(suite.chainA.App.ICAControllerKeeper.RegisterInterchainAccountProposal(suite.chainA.GetContext(), registerProposal))
+	suite.Require().True(suite.chainA.App.ICAControllerKeeper.IsBound(suite.chainA.GetContext(), govPortID))
+	suite.coordinator.CommitBlock(suite.chainA)
+	suite.Require().NoError(suite.coordinator.UpdateClient(suite.chainB, suite.chainA, suite.connB.ClientID, exported.Ostracon))
+	channelA, channelB = suite.openInterchainAccountChannel(channelA, channelB)
+
+	address := icatypes.GenerateAddress(suite.connB.ID, govPortID)
+	amount := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000))
+	suite.Require().NoError(suite.chainB.App.BankKeeper.SendCoins(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), address, sdk.NewCoins(amount)))
+	packetData, validator := suite.delegatePacketData(address, amount)
+
+	ctx := suite.chainA.GetContext()
+	sendTxProposal := types.NewSendTxProposal("title", "description", suite.connA.ID, relativeTimeout, packetData)
+	suite.Require().NoError(suite.chainA.App.ICAControllerKeeper.SendTxProposal(ctx, sendTxProposal))
+
+	packet := channeltypes.NewPacket(
+		packetData.GetBytes(), 1, channelA.PortID, channelA.ID, channelB.PortID, channelB.ID,
+		clienttypes.ZeroHeight(), uint64(ctx.BlockTime().UnixNano())+relativeTimeout,
+	)
+	suite.coordinator.CommitBlock(suite.chainA)
+	suite.Require().NoError(suite.coordinator.UpdateClient(suite.chainB, suite.chainA, suite.connB.ClientID, exported.Ostracon))
+	suite.Require().NoError(suite.coordinator.RecvPacket(suite.chainA, suite.chainB, channelA.ClientID, packet))
+
+	_, found := suite.chainB.App.StakingKeeper.GetDelegation(suite.chainB.GetContext(), address, validator)
+	suite.Require().True(found)
+}
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	channelA, _ := suite.registerInterchainAccount(suite.owner)
+	ctx := suite.chainA.GetContext()
+	address := icatypes.GenerateAddress(suite.connB.ID, suite.portID)
+
+	genesis := suite.chainA.App.ICAControllerKeeper.ExportGenesis(ctx)
+	suite.Require().Equal([]string{suite.portID}, genesis.Ports)
+	suite.Require().Equal([]icatypes.ActiveChannel{icatypes.NewActiveChannel(suite.connA.ID, suite.portID, channelA.ID)}, genesis.ActiveChannels)
+	suite.Require().Equal([]icatypes.RegisteredInterchainAccount{icatypes.NewRegisteredInterchainAccount(suite.connA.ID, suite.portID, address.String())}, genesis.InterchainAccounts)
+	suite.Require().Equal(types.DefaultParams(), genesis.Params)
+	suite.Require().NoError(genesis.Validate())
+
+	portID := suite.portID
+	suite.SetupTest()
+	ctx = suite.chainA.GetContext()
+	suite.chainA.App.ICAControllerKeeper.InitGenesis(ctx, *genesis)
+	suite.Require().True(suite.chainA.App.ICAControllerKeeper.IsBound(ctx, portID))
+	suite.Require().Equal(genesis, suite.chainA.App.ICAControllerKeeper.ExportGenesis(ctx))
+}
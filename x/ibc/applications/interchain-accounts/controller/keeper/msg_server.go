@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/controller/types"
+)
+
+var _ types.MsgServer = Keeper{}
+
+// RegisterInterchainAccount defines a rpc handler method for MsgRegisterInterchainAccount
+func (k Keeper) RegisterInterchainAccount(goCtx context.Context, msg *types.MsgRegisterInterchainAccount) (*types.MsgRegisterInterchainAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	channelID, err := k.InitInterchainAccount(ctx, msg.ConnectionId, msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.SubModuleName),
+		),
+	)
+
+	return &types.MsgRegisterInterchainAccountResponse{
+		ChannelId: channelID,
+	}, nil
+}
+
+// SendTx defines a rpc handler method for MsgSendTx
+func (k Keeper) SendTx(goCtx context.Context, msg *types.MsgSendTx) (*types.MsgSendTxResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sequence, err := k.SendInterchainAccountTx(ctx, msg.ConnectionId, msg.Owner, msg.PacketData, msg.RelativeTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.SubModuleName),
+		),
+	)
+
+	return &types.MsgSendTxResponse{
+		Sequence: sequence,
+	}, nil
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/controller/types"
+)
+
+// IsControllerEnabled retrieves the controller enabled boolean from the paramstore
+func (k Keeper) IsControllerEnabled(ctx sdk.Context) bool {
+	var res bool
+	k.paramSpace.Get(ctx, types.KeyControllerEnabled, &res)
+	return res
+}
+
+// GetParams returns the total set of the interchain accounts controller parameters.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	return types.NewParams(k.IsControllerEnabled(ctx))
+}
+
+// SetParams sets the total set of the interchain accounts controller parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	authtypes "github.com/line/lbm-sdk/x/auth/types"
+	govtypes "github.com/line/lbm-sdk/x/gov/types"
+	"github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/controller/types"
+)
+
+// GovernanceOwner returns the owner of the interchain accounts controlled by governance,
+// which is the address of the gov module account.
+func GovernanceOwner() string {
+	return authtypes.NewModuleAddress(govtypes.ModuleName).String()
+}
+
+// RegisterInterchainAccountProposal initiates the registration of an interchain account owned
+// by governance on the given connection.
+func (k Keeper) RegisterInterchainAccountProposal(ctx sdk.Context, p *types.RegisterInterchainAccountProposal) error {
+	_, err := k.InitInterchainAccount(ctx, p.ConnectionId, GovernanceOwner())
+	return err
+}
+
+// SendTxProposal sends a transaction to be executed by the interchain account owned by
+// governance on the given connection.
+func (k Keeper) SendTxProposal(ctx sdk.Context, p *types.SendTxProposal) error {
+	_, err := k.SendInterchainAccountTx(ctx, p.ConnectionId, GovernanceOwner(), p.PacketData, p.RelativeTimeout)
+	return err
+}
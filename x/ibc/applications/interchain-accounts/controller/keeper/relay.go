@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/controller/types"
+	icatypes "github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/types"
+	clienttypes "github.com/line/lbm-sdk/x/ibc/core/02-client/types"
+	channeltypes "github.com/line/lbm-sdk/x/ibc/core/04-channel/types"
+	host "github.com/line/lbm-sdk/x/ibc/core/24-host"
+)
+
+// SendInterchainAccountTx sends the packet data over the active channel of the owner on the given connection
+// and returns the sequence of the packet. The packet times out relativeTimeout nanoseconds
+// after the current block time.
+func (k Keeper) SendInterchainAccountTx(
+	ctx sdk.Context, connectionID, owner string, packetData icatypes.InterchainAccountPacketData, relativeTimeout uint64,
+) (uint64, error) {
+	if !k.IsControllerEnabled(ctx) {
+		return 0, types.ErrControllerSubModuleDisabled
+	}
+
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return 0, err
+	}
+
+	channelID, found := k.GetActiveChannelID(ctx, connectionID, portID)
+	if !found {
+		return 0, sdkerrors.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to retrieve active channel on connection %s for port %s", connectionID, portID)
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return 0, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+	if channel.State != channeltypes.OPEN {
+		return 0, sdkerrors.Wrapf(channeltypes.ErrInvalidChannelState, "channel is not OPEN (got %s)", channel.State)
+	}
+
+	chanCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(portID, channelID))
+	if !ok {
+		return 0, sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	if err := packetData.ValidateBasic(); err != nil {
+		return 0, err
+	}
+	if relativeTimeout == 0 {
+		return 0, sdkerrors.Wrap(icatypes.ErrInvalidTimeoutTimestamp, "relative timeout cannot be zero")
+	}
+
+	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, portID, channelID)
+	if !found {
+		return 0, sdkerrors.Wrapf(
+			channeltypes.ErrSequenceSendNotFound,
+			"source port: %s, source channel: %s", portID, channelID,
+		)
+	}
+
+	timeoutTimestamp := uint64(ctx.BlockTime().UnixNano()) + relativeTimeout
+	packet := channeltypes.NewPacket(
+		packetData.GetBytes(),
+		sequence,
+		portID,
+		channelID,
+		channel.Counterparty.PortId,
+		channel.Counterparty.ChannelId,
+		clienttypes.ZeroHeight(),
+		timeoutTimestamp,
+	)
+
+	if err := k.channelKeeper.SendPacket(ctx, chanCap, packet); err != nil {
+		return 0, err
+	}
+
+	return sequence, nil
+}
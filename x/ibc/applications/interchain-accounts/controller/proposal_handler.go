@@ -0,0 +1,26 @@
+package controller
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	govtypes "github.com/line/lbm-sdk/x/gov/types"
+	"github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/controller/keeper"
+	"github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/controller/types"
+)
+
+// NewInterchainAccountProposalHandler defines the interchain accounts controller proposal
+// handler
+func NewInterchainAccountProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.RegisterInterchainAccountProposal:
+			return k.RegisterInterchainAccountProposal(ctx, c)
+
+		case *types.SendTxProposal:
+			return k.SendTxProposal(ctx, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized interchain accounts controller proposal content type: %T", c)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"github.com/line/lbm-sdk/codec"
+	codectypes "github.com/line/lbm-sdk/codec/types"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/types/msgservice"
+	govtypes "github.com/line/lbm-sdk/x/gov/types"
+	icatypes "github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/types"
+)
+
+// RegisterLegacyAminoCodec registers the necessary interchain accounts controller interfaces
+// and concrete types on the provided LegacyAmino codec. These types are used for Amino JSON
+// serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgRegisterInterchainAccount{}, "lbm-sdk/MsgRegisterInterchainAccount", nil)
+	cdc.RegisterConcrete(&MsgSendTx{}, "lbm-sdk/MsgSendInterchainAccountTx", nil)
+}
+
+// RegisterInterfaces registers the interchain accounts controller module interfaces to
+// protobuf Any. The shared interchain account types are registered as well.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	icatypes.RegisterInterfaces(registry)
+
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgRegisterInterchainAccount{},
+		&MsgSendTx{},
+	)
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&RegisterInterchainAccountProposal{},
+		&SendTxProposal{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	amino = codec.NewLegacyAmino()
+
+	// ModuleCdc references the global interchain accounts controller module codec. Note, the
+	// codec should ONLY be used in certain instances of tests and for JSON encoding.
+	ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	// AminoCdc is a amino codec created to support amino json compatible msgs.
+	AminoCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	amino.Seal()
+}
@@ -0,0 +1,10 @@
+package types
+
+import (
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+// ICA controller sentinel errors
+var (
+	ErrControllerSubModuleDisabled = sdkerrors.Register(SubModuleName, 2, "controller submodule is disabled")
+)
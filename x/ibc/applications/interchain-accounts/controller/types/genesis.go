@@ -0,0 +1,46 @@
+package types
+
+import (
+	icatypes "github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/types"
+)
+
+// NewGenesisState creates a new interchain accounts controller GenesisState instance.
+func NewGenesisState(
+	activeChannels []icatypes.ActiveChannel, interchainAccounts []icatypes.RegisteredInterchainAccount,
+	ports []string, params Params,
+) *GenesisState {
+	return &GenesisState{
+		ActiveChannels:     activeChannels,
+		InterchainAccounts: interchainAccounts,
+		Ports:              ports,
+		Params:             params,
+	}
+}
+
+// DefaultGenesisState returns a GenesisState with no interchain account registered.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	for _, ch := range gs.ActiveChannels {
+		if err := ch.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, acc := range gs.InterchainAccounts {
+		if err := acc.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, port := range gs.Ports {
+		if err := icatypes.ValidateControllerPortID(port); err != nil {
+			return err
+		}
+	}
+	return gs.Params.Validate()
+}
@@ -0,0 +1,15 @@
+package types
+
+const (
+	// SubModuleName defines the interchain accounts controller module name
+	SubModuleName = "icacontroller"
+
+	// StoreKey is the store key string for the interchain accounts controller module
+	StoreKey = SubModuleName
+
+	// RouterKey is the message route for the interchain accounts controller module
+	RouterKey = SubModuleName
+
+	// QuerierRoute is the querier route for the interchain accounts controller module
+	QuerierRoute = SubModuleName
+)
@@ -0,0 +1,105 @@
+package types
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	icatypes "github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/types"
+	host "github.com/line/lbm-sdk/x/ibc/core/24-host"
+)
+
+// msg types
+const (
+	TypeMsgRegisterInterchainAccount = "register_interchain_account"
+	TypeMsgSendTx                    = "send_tx"
+)
+
+var (
+	_ sdk.Msg = &MsgRegisterInterchainAccount{}
+	_ sdk.Msg = &MsgSendTx{}
+)
+
+// NewMsgRegisterInterchainAccount creates a new MsgRegisterInterchainAccount instance
+func NewMsgRegisterInterchainAccount(connectionID, owner string) *MsgRegisterInterchainAccount {
+	return &MsgRegisterInterchainAccount{
+		ConnectionId: connectionID,
+		Owner:        owner,
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgRegisterInterchainAccount) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgRegisterInterchainAccount) Type() string {
+	return TypeMsgRegisterInterchainAccount
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgRegisterInterchainAccount) ValidateBasic() error {
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return sdkerrors.Wrap(err, "invalid connection ID")
+	}
+	if err := sdk.ValidateAccAddress(msg.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgRegisterInterchainAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRegisterInterchainAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Owner)}
+}
+
+// NewMsgSendTx creates a new MsgSendTx instance
+func NewMsgSendTx(owner, connectionID string, relativeTimeout uint64, packetData icatypes.InterchainAccountPacketData) *MsgSendTx {
+	return &MsgSendTx{
+		ConnectionId:    connectionID,
+		Owner:           owner,
+		RelativeTimeout: relativeTimeout,
+		PacketData:      packetData,
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgSendTx) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgSendTx) Type() string {
+	return TypeMsgSendTx
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgSendTx) ValidateBasic() error {
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return sdkerrors.Wrap(err, "invalid connection ID")
+	}
+	if err := sdk.ValidateAccAddress(msg.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if err := msg.PacketData.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "invalid interchain account packet data")
+	}
+	if msg.RelativeTimeout == 0 {
+		return sdkerrors.Wrap(icatypes.ErrInvalidTimeoutTimestamp, "relative timeout cannot be zero")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgSendTx) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgSendTx) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Owner)}
+}
@@ -0,0 +1,62 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/crypto/keys/secp256k1"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/controller/types"
+	icatypes "github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/types"
+)
+
+var (
+	owner      = sdk.BytesToAccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	packetData = icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: []byte("data")}
+)
+
+func TestMsgRegisterInterchainAccountValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgRegisterInterchainAccount
+		expPass bool
+	}{
+		{"valid msg", types.NewMsgRegisterInterchainAccount("connection-0", owner), true},
+		{"invalid connection ID", types.NewMsgRegisterInterchainAccount("", owner), false},
+		{"invalid owner", types.NewMsgRegisterInterchainAccount("connection-0", "owner"), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, []sdk.AccAddress{sdk.AccAddress(owner)}, tc.msg.GetSigners())
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgSendTxValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgSendTx
+		expPass bool
+	}{
+		{"valid msg", types.NewMsgSendTx(owner, "connection-0", 1, packetData), true},
+		{"invalid connection ID", types.NewMsgSendTx(owner, "", 1, packetData), false},
+		{"invalid owner", types.NewMsgSendTx("owner", "connection-0", 1, packetData), false},
+		{"zero timeout", types.NewMsgSendTx(owner, "connection-0", 0, packetData), false},
+		{"empty packet data", types.NewMsgSendTx(owner, "connection-0", 1, icatypes.InterchainAccountPacketData{}), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package types
+
+import (
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	govtypes "github.com/line/lbm-sdk/x/gov/types"
+	icatypes "github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/types"
+	host "github.com/line/lbm-sdk/x/ibc/core/24-host"
+)
+
+const (
+	// ProposalTypeRegisterInterchainAccount defines the type for a RegisterInterchainAccountProposal
+	ProposalTypeRegisterInterchainAccount = "RegisterInterchainAccount"
+	// ProposalTypeSendTx defines the type for a SendTxProposal
+	ProposalTypeSendTx = "SendInterchainAccountTx"
+)
+
+var (
+	_ govtypes.Content = &RegisterInterchainAccountProposal{}
+	_ govtypes.Content = &SendTxProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeRegisterInterchainAccount)
+	govtypes.RegisterProposalType(ProposalTypeSendTx)
+	govtypes.RegisterProposalTypeCodec(&RegisterInterchainAccountProposal{}, "lbm-sdk/RegisterInterchainAccountProposal")
+	govtypes.RegisterProposalTypeCodec(&SendTxProposal{}, "lbm-sdk/SendInterchainAccountTxProposal")
+}
+
+// NewRegisterInterchainAccountProposal creates a new register interchain account proposal.
+func NewRegisterInterchainAccountProposal(title, description, connectionID string) *RegisterInterchainAccountProposal {
+	return &RegisterInterchainAccountProposal{
+		Title:        title,
+		Description:  description,
+		ConnectionId: connectionID,
+	}
+}
+
+// GetTitle returns the title of a register interchain account proposal.
+func (p *RegisterInterchainAccountProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a register interchain account proposal.
+func (p *RegisterInterchainAccountProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a register interchain account proposal.
+func (p *RegisterInterchainAccountProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a register interchain account proposal.
+func (p *RegisterInterchainAccountProposal) ProposalType() string {
+	return ProposalTypeRegisterInterchainAccount
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *RegisterInterchainAccountProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if err := host.ConnectionIdentifierValidator(p.ConnectionId); err != nil {
+		return sdkerrors.Wrap(err, "invalid connection ID")
+	}
+	return nil
+}
+
+// NewSendTxProposal creates a new send interchain account tx proposal.
+func NewSendTxProposal(
+	title, description, connectionID string, relativeTimeout uint64, packetData icatypes.InterchainAccountPacketData,
+) *SendTxProposal {
+	return &SendTxProposal{
+		Title:           title,
+		Description:     description,
+		ConnectionId:    connectionID,
+		PacketData:      packetData,
+		RelativeTimeout: relativeTimeout,
+	}
+}
+
+// GetTitle returns the title of a send interchain account tx proposal.
+func (p *SendTxProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a send interchain account tx proposal.
+func (p *SendTxProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a send interchain account tx proposal.
+func (p *SendTxProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a send interchain account tx proposal.
+func (p *SendTxProposal) ProposalType() string { return ProposalTypeSendTx }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *SendTxProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if err := host.ConnectionIdentifierValidator(p.ConnectionId); err != nil {
+		return sdkerrors.Wrap(err, "invalid connection ID")
+	}
+	if err := p.PacketData.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "invalid interchain account packet data")
+	}
+	if p.RelativeTimeout == 0 {
+		return sdkerrors.Wrap(icatypes.ErrInvalidTimeoutTimestamp, "relative timeout cannot be zero")
+	}
+	return nil
+}
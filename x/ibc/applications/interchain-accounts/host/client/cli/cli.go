@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/line/lbm-sdk/client"
+)
+
+// GetQueryCmd returns the query commands for the interchain accounts host submodule
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        "interchain-accounts-host",
+		Aliases:                    []string{"ica-host"},
+		Short:                      "interchain accounts host subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdQueryInterchainAccount(),
+		GetCmdParams(),
+	)
+
+	return queryCmd
+}
+
+// NewTxCmd returns the transaction commands for the interchain accounts host submodule
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        "interchain-accounts-host",
+		Aliases:                    []string{"ica-host"},
+		Short:                      "interchain accounts host transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		GeneratePacketDataCmd(),
+	)
+
+	return txCmd
+}
@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/line/lbm-sdk/client"
+	"github.com/line/lbm-sdk/client/flags"
+	"github.com/line/lbm-sdk/version"
+	"github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/host/types"
+	icatypes "github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/types"
+)
+
+// GetCmdQueryInterchainAccount defines the command to query the address of the interchain
+// account of a controller port on a connection.
+func GetCmdQueryInterchainAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "interchain-account [controller-port-id] [connection-id]",
+		Short: "Query the interchain account address of a controller port on a connection",
+		Long: fmt.Sprintf(`Query the interchain account address of a controller port on a connection of the host chain.
+The channel version of the host chain is %s%s{address}.`, icatypes.Version, icatypes.VersionDelimiter),
+		Example: fmt.Sprintf("%s query interchain-accounts-host interchain-account [controller-port-id] [connection-id]", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryInterchainAccountRequest{
+				PortId:       args[0],
+				ConnectionId: args[1],
+			}
+
+			res, err := queryClient.InterchainAccount(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdParams returns the command handler for the interchain accounts host parameter
+// querying.
+func GetCmdParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Short:   "Query the current interchain accounts host parameters",
+		Long:    "Query the current interchain accounts host parameters",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query interchain-accounts-host params", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
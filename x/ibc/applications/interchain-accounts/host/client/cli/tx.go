@@ -0,0 +1,104 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/line/lbm-sdk/client"
+	"github.com/line/lbm-sdk/codec"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/version"
+	icatypes "github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/types"
+)
+
+const (
+	// FlagMemo is the flag of the packet data memo
+	FlagMemo = "memo"
+)
+
+// GeneratePacketDataCmd returns a command that generates the interchain account packet data
+// executing the given messages. The output is used by the send-tx command of the controller
+// submodule.
+func GeneratePacketDataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "generate-packet-data [message]",
+		Short: "Generate interchain account packet data for the provided message(s)",
+		Long: `Generate interchain account packet data for the provided JSON encoded message or array of
+messages. The messages must be signed by the interchain account and registered in the host chain.`,
+		Example: fmt.Sprintf(`%s tx interchain-accounts-host generate-packet-data '{
+    "@type":"/lbm.bank.v1beta1.MsgSend",
+    "from_address":"link1...",
+    "to_address":"link1...",
+    "amount": [{"denom": "stake", "amount": "1000"}]
+}' --memo memo`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cdc, ok := clientCtx.JSONMarshaler.(*codec.ProtoCodec)
+			if !ok {
+				return fmt.Errorf("expected %T, got %T", &codec.ProtoCodec{}, clientCtx.JSONMarshaler)
+			}
+
+			memo, err := cmd.Flags().GetString(FlagMemo)
+			if err != nil {
+				return err
+			}
+
+			msgs, err := parseMsgs(cdc, []byte(args[0]))
+			if err != nil {
+				return err
+			}
+
+			data, err := icatypes.SerializeCosmosTx(cdc, msgs)
+			if err != nil {
+				return err
+			}
+
+			packetData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+				Memo: memo,
+			}
+			if err := packetData.ValidateBasic(); err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(&packetData)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintBytes(bz)
+		},
+	}
+
+	cmd.Flags().String(FlagMemo, "", "an optional memo to be included in the interchain account packet data")
+	return cmd
+}
+
+// parseMsgs decodes a single message or an array of messages
+func parseMsgs(cdc *codec.ProtoCodec, bz []byte) ([]sdk.Msg, error) {
+	var msg sdk.Msg
+	if err := cdc.UnmarshalInterfaceJSON(bz, &msg); err == nil {
+		return []sdk.Msg{msg}, nil
+	}
+
+	var rawMsgs []json.RawMessage
+	if err := json.Unmarshal(bz, &rawMsgs); err != nil {
+		return nil, fmt.Errorf("message must be a JSON encoded message or array of messages: %w", err)
+	}
+
+	msgs := make([]sdk.Msg, len(rawMsgs))
+	for i, rawMsg := range rawMsgs {
+		if err := cdc.UnmarshalInterfaceJSON(rawMsg, &msgs[i]); err != nil {
+			return nil, err
+		}
+	}
+	return msgs, nil
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	authtypes "github.com/line/lbm-sdk/x/auth/types"
+	icatypes "github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/types"
+)
+
+// RegisterInterchainAccount creates the interchain account of a controller port on a
+// connection. A base account holding funds sent to the address before the registration is
+// converted into an interchain account, as long as it has never signed a transaction.
+func (k Keeper) RegisterInterchainAccount(ctx sdk.Context, connectionID, controllerPortID string) (sdk.AccAddress, error) {
+	address := icatypes.GenerateAddress(connectionID, controllerPortID)
+
+	acc := k.accountKeeper.GetAccount(ctx, address)
+	switch acc := acc.(type) {
+	case nil:
+		k.accountKeeper.SetAccount(ctx, icatypes.NewInterchainAccount(authtypes.NewBaseAccountWithAddress(address), controllerPortID))
+
+	case *icatypes.InterchainAccount:
+		// the account is reused when a new channel is opened after the previous one was closed
+		if acc.AccountOwner != controllerPortID {
+			return "", sdkerrors.Wrapf(icatypes.ErrAccountAlreadyExist, "interchain account %s is owned by %s", address, acc.AccountOwner)
+		}
+
+	case *authtypes.BaseAccount:
+		if acc.GetPubKey() != nil || acc.GetSequence() != 0 {
+			return "", sdkerrors.Wrapf(icatypes.ErrAccountAlreadyExist, "account %s is controlled by a key", address)
+		}
+		k.accountKeeper.SetAccount(ctx, icatypes.NewInterchainAccount(acc, controllerPortID))
+
+	default:
+		return "", sdkerrors.Wrapf(icatypes.ErrAccountAlreadyExist, "account %s of type %T", address, acc)
+	}
+
+	k.SetInterchainAccountAddress(ctx, connectionID, controllerPortID, address.String())
+	return address, nil
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/host/types"
+)
+
+// InitGenesis initializes the interchain accounts host application state from a provided
+// genesis state
+func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
+	k.SetPort(ctx, state.Port)
+
+	// Only try to bind to port if it is not already bound, since we may already own
+	// port capability from capability InitGenesis
+	if !k.IsBound(ctx, state.Port) {
+		// host submodule binds to the host port on InitChain
+		// and claims the returned capability
+		if err := k.BindPort(ctx, state.Port); err != nil {
+			panic(fmt.Sprintf("could not claim port capability: %v", err))
+		}
+	}
+
+	for _, ch := range state.ActiveChannels {
+		k.SetActiveChannelID(ctx, ch.ConnectionId, ch.PortId, ch.ChannelId)
+	}
+
+	for _, acc := range state.InterchainAccounts {
+		k.SetInterchainAccountAddress(ctx, acc.ConnectionId, acc.PortId, acc.AccountAddress)
+	}
+
+	k.SetParams(ctx, state.Params)
+}
+
+// ExportGenesis returns the interchain accounts host exported genesis
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(
+		k.GetAllActiveChannels(ctx),
+		k.GetAllInterchainAccounts(ctx),
+		k.GetPort(ctx),
+		k.GetParams(ctx),
+	)
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/host/types"
+	icatypes "github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/types"
+	host "github.com/line/lbm-sdk/x/ibc/core/24-host"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// InterchainAccount implements the Query/InterchainAccount gRPC method. The address is
+// derived from the connection and the controller port, so it is returned before the account
+// is registered and can be used to build the channel version of the host chain.
+func (q Keeper) InterchainAccount(c context.Context, req *types.QueryInterchainAccountRequest) (*types.QueryInterchainAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := icatypes.ValidateControllerPortID(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	address, found := q.GetInterchainAccountAddress(ctx, req.ConnectionId, req.PortId)
+	if !found {
+		address = icatypes.GenerateAddress(req.ConnectionId, req.PortId).String()
+	}
+
+	return &types.QueryInterchainAccountResponse{
+		Address: address,
+	}, nil
+}
+
+// Params implements the Query/Params gRPC method
+func (q Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := q.GetParams(ctx)
+
+	return &types.QueryParamsResponse{
+		Params: &params,
+	}, nil
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	capabilitytypes "github.com/line/lbm-sdk/x/capability/types"
+	icatypes "github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/types"
+	channeltypes "github.com/line/lbm-sdk/x/ibc/core/04-channel/types"
+	porttypes "github.com/line/lbm-sdk/x/ibc/core/05-port/types"
+	host "github.com/line/lbm-sdk/x/ibc/core/24-host"
+)
+
+// OnChanOpenTry performs basic validation of the channel opened by a controller chain and
+// registers the interchain account of the controller port.
+// The channel order must be ORDERED, the counterparty version must be the ICS-27 version and
+// the version must carry the address of the interchain account, which is returned by the
+// InterchainAccount query.
+func (k Keeper) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version,
+	counterpartyVersion string,
+) error {
+	if order != channeltypes.ORDERED {
+		return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s", channeltypes.ORDERED, order)
+	}
+
+	// Require portID is the portID host submodule is bound to
+	boundPort := k.GetPort(ctx)
+	if boundPort != portID {
+		return sdkerrors.Wrapf(porttypes.ErrInvalidPort, "invalid port: %s, expected %s", portID, boundPort)
+	}
+
+	if err := icatypes.ValidateControllerPortID(counterparty.PortId); err != nil {
+		return err
+	}
+
+	if counterpartyVersion != icatypes.Version {
+		return sdkerrors.Wrapf(icatypes.ErrInvalidVersion, "invalid counterparty version: got %s, expected %s", counterpartyVersion, icatypes.Version)
+	}
+
+	connectionID := connectionHops[0]
+	expectedVersion := icatypes.NewAppVersion(icatypes.Version, icatypes.GenerateAddress(connectionID, counterparty.PortId).String())
+	if version != expectedVersion {
+		return sdkerrors.Wrapf(icatypes.ErrInvalidVersion, "got %s, expected %s", version, expectedVersion)
+	}
+
+	if k.IsActiveChannelOpen(ctx, connectionID, counterparty.PortId) {
+		activeChannelID, _ := k.GetActiveChannelID(ctx, connectionID, counterparty.PortId)
+		return sdkerrors.Wrapf(icatypes.ErrActiveChannelAlreadySet, "existing active channel %s for portID %s", activeChannelID, counterparty.PortId)
+	}
+
+	if _, err := k.RegisterInterchainAccount(ctx, connectionID, counterparty.PortId); err != nil {
+		return err
+	}
+
+	// Claim channel capability passed back by IBC module
+	return k.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID))
+}
+
+// OnChanOpenConfirm sets the channel as the active channel of the controller port.
+func (k Keeper) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	k.SetActiveChannelID(ctx, channel.ConnectionHops[0], channel.Counterparty.PortId, channelID)
+	return nil
+}
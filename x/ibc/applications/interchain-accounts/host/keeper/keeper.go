@@ -0,0 +1,173 @@
+package keeper
+
+import (
+	"github.com/line/ostracon/libs/log"
+
+	"github.com/line/lbm-sdk/baseapp"
+	"github.com/line/lbm-sdk/codec"
+	sdk "github.com/line/lbm-sdk/types"
+	capabilitykeeper "github.com/line/lbm-sdk/x/capability/keeper"
+	capabilitytypes "github.com/line/lbm-sdk/x/capability/types"
+	"github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/host/types"
+	icatypes "github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/types"
+	channeltypes "github.com/line/lbm-sdk/x/ibc/core/04-channel/types"
+	host "github.com/line/lbm-sdk/x/ibc/core/24-host"
+	paramtypes "github.com/line/lbm-sdk/x/params/types"
+)
+
+// Keeper defines the IBC interchain accounts host keeper
+type Keeper struct {
+	storeKey   sdk.StoreKey
+	cdc        codec.BinaryMarshaler
+	paramSpace *paramtypes.Subspace
+
+	channelKeeper icatypes.ChannelKeeper
+	portKeeper    icatypes.PortKeeper
+	accountKeeper icatypes.AccountKeeper
+	scopedKeeper  capabilitykeeper.ScopedKeeper
+	msgRouter     *baseapp.MsgServiceRouter
+}
+
+// NewKeeper creates a new interchain accounts host Keeper instance. The codec must be a proto
+// codec registering the messages executed by interchain accounts.
+func NewKeeper(
+	cdc codec.BinaryMarshaler, key sdk.StoreKey, paramSpace *paramtypes.Subspace,
+	channelKeeper icatypes.ChannelKeeper, portKeeper icatypes.PortKeeper,
+	accountKeeper icatypes.AccountKeeper, scopedKeeper capabilitykeeper.ScopedKeeper,
+	msgRouter *baseapp.MsgServiceRouter,
+) Keeper {
+
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeKey:      key,
+		cdc:           cdc,
+		paramSpace:    paramSpace,
+		channelKeeper: channelKeeper,
+		portKeeper:    portKeeper,
+		accountKeeper: accountKeeper,
+		scopedKeeper:  scopedKeeper,
+		msgRouter:     msgRouter,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+host.ModuleName+"-"+types.SubModuleName)
+}
+
+// IsBound checks if the host submodule is already bound to the desired port
+func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
+	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
+	return ok
+}
+
+// BindPort defines a wrapper function for the port Keeper's function in
+// order to expose it to module's InitGenesis function
+func (k Keeper) BindPort(ctx sdk.Context, portID string) error {
+	cap := k.portKeeper.BindPort(ctx, portID)
+	return k.ClaimCapability(ctx, cap, host.PortPath(portID))
+}
+
+// GetPort returns the portID for the host submodule. Used in ExportGenesis
+func (k Keeper) GetPort(ctx sdk.Context) string {
+	store := ctx.KVStore(k.storeKey)
+	return string(store.Get(icatypes.PortKeyPrefix))
+}
+
+// SetPort sets the portID for the host submodule. Used in InitGenesis
+func (k Keeper) SetPort(ctx sdk.Context, portID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(icatypes.PortKeyPrefix, []byte(portID))
+}
+
+// AuthenticateCapability wraps the scopedKeeper's AuthenticateCapability function
+func (k Keeper) AuthenticateCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) bool {
+	return k.scopedKeeper.AuthenticateCapability(ctx, cap, name)
+}
+
+// ClaimCapability wraps the scopedKeeper's ClaimCapability function
+func (k Keeper) ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error {
+	return k.scopedKeeper.ClaimCapability(ctx, cap, name)
+}
+
+// GetActiveChannelID returns the ID of the active channel of a controller port on a
+// connection
+func (k Keeper) GetActiveChannelID(ctx sdk.Context, connectionID, portID string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(icatypes.KeyActiveChannel(connectionID, portID))
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// SetActiveChannelID stores the ID of the active channel of a controller port on a
+// connection
+func (k Keeper) SetActiveChannelID(ctx sdk.Context, connectionID, portID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(icatypes.KeyActiveChannel(connectionID, portID), []byte(channelID))
+}
+
+// IsActiveChannelOpen returns true if the active channel of a controller port on a connection
+// exists and is in the OPEN state
+func (k Keeper) IsActiveChannelOpen(ctx sdk.Context, connectionID, portID string) bool {
+	channelID, found := k.GetActiveChannelID(ctx, connectionID, portID)
+	if !found {
+		return false
+	}
+	channel, found := k.channelKeeper.GetChannel(ctx, k.GetPort(ctx), channelID)
+	return found && channel.State == channeltypes.OPEN
+}
+
+// GetAllActiveChannels returns all the active channels of the host submodule
+func (k Keeper) GetAllActiveChannels(ctx sdk.Context) []icatypes.ActiveChannel {
+	activeChannels := []icatypes.ActiveChannel{}
+	iterateConnectionPortKeys(ctx.KVStore(k.storeKey), icatypes.ActiveChannelKeyPrefix, func(connectionID, portID string, value []byte) {
+		activeChannels = append(activeChannels, icatypes.NewActiveChannel(connectionID, portID, string(value)))
+	})
+	return activeChannels
+}
+
+// GetInterchainAccountAddress returns the address of the interchain account of a controller
+// port on a connection
+func (k Keeper) GetInterchainAccountAddress(ctx sdk.Context, connectionID, portID string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(icatypes.KeyOwnerAccount(connectionID, portID))
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// SetInterchainAccountAddress stores the address of the interchain account of a controller
+// port on a connection
+func (k Keeper) SetInterchainAccountAddress(ctx sdk.Context, connectionID, portID, address string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(icatypes.KeyOwnerAccount(connectionID, portID), []byte(address))
+}
+
+// GetAllInterchainAccounts returns all the interchain accounts registered on the host chain
+func (k Keeper) GetAllInterchainAccounts(ctx sdk.Context) []icatypes.RegisteredInterchainAccount {
+	accounts := []icatypes.RegisteredInterchainAccount{}
+	iterateConnectionPortKeys(ctx.KVStore(k.storeKey), icatypes.OwnerKeyPrefix, func(connectionID, portID string, value []byte) {
+		accounts = append(accounts, icatypes.NewRegisteredInterchainAccount(connectionID, portID, string(value)))
+	})
+	return accounts
+}
+
+func iterateConnectionPortKeys(store sdk.KVStore, keyPrefix []byte, cb func(connectionID, portID string, value []byte)) {
+	iterator := sdk.KVStorePrefixIterator(store, keyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		connectionID, portID, err := icatypes.ParseConnectionPortKey(iterator.Key()[len(keyPrefix):])
+		if err != nil {
+			panic(err)
+		}
+		cb(connectionID, portID, iterator.Value())
+	}
+}
@@ -0,0 +1,289 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/line/lbm-sdk/baseapp"
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	authtypes "github.com/line/lbm-sdk/x/auth/types"
+	banktypes "github.com/line/lbm-sdk/x/bank/types"
+	capabilitytypes "github.com/line/lbm-sdk/x/capability/types"
+	"github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/host/types"
+	icatypes "github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/types"
+	clienttypes "github.com/line/lbm-sdk/x/ibc/core/02-client/types"
+	channeltypes "github.com/line/lbm-sdk/x/ibc/core/04-channel/types"
+	porttypes "github.com/line/lbm-sdk/x/ibc/core/05-port/types"
+	host "github.com/line/lbm-sdk/x/ibc/core/24-host"
+	ibctesting "github.com/line/lbm-sdk/x/ibc/testing"
+)
+
+const (
+	connectionID = "connection-0"
+	channelID    = "channel-0"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// chain is the host chain
+	chain *ibctesting.TestChain
+
+	controllerPortID string
+	address          sdk.AccAddress
+
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 1)
+	suite.chain = suite.coordinator.GetChain(ibctesting.GetChainID(0))
+
+	portID, err := icatypes.NewControllerPortID(suite.chain.SenderAccount.GetAddress().String())
+	suite.Require().NoError(err)
+	suite.controllerPortID = portID
+	suite.address = icatypes.GenerateAddress(connectionID, portID)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.chain.GetContext(), suite.chain.App.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.chain.App.ICAHostKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) newChannelCapability(ctx sdk.Context) *capabilitytypes.Capability {
+	chanCap, err := suite.chain.App.ScopedIBCKeeper.NewCapability(ctx, host.ChannelCapabilityPath(icatypes.HostPortID, channelID))
+	suite.Require().NoError(err)
+	return chanCap
+}
+
+// openChannel registers the interchain account and stores an open channel of the host port.
+func (suite *KeeperTestSuite) openChannel(ctx sdk.Context) {
+	version := icatypes.NewAppVersion(icatypes.Version, suite.address.String())
+	err := suite.chain.App.ICAHostKeeper.OnChanOpenTry(
+		ctx, channeltypes.ORDERED, []string{connectionID}, icatypes.HostPortID, channelID, suite.newChannelCapability(ctx),
+		channeltypes.NewCounterparty(suite.controllerPortID, channelID), version, icatypes.Version,
+	)
+	suite.Require().NoError(err)
+
+	channel := channeltypes.NewChannel(
+		channeltypes.OPEN, channeltypes.ORDERED, channeltypes.NewCounterparty(suite.controllerPortID, channelID),
+		[]string{connectionID}, version,
+	)
+	suite.chain.App.IBCKeeper.ChannelKeeper.SetChannel(ctx, icatypes.HostPortID, channelID, channel)
+	suite.Require().NoError(suite.chain.App.ICAHostKeeper.OnChanOpenConfirm(ctx, icatypes.HostPortID, channelID))
+}
+
+func (suite *KeeperTestSuite) packet(msgs ...sdk.Msg) channeltypes.Packet {
+	data, err := icatypes.SerializeCosmosTx(suite.chain.App.AppCodec(), msgs)
+	suite.Require().NoError(err)
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+	return channeltypes.NewPacket(
+		packetData.GetBytes(), 1, suite.controllerPortID, channelID, icatypes.HostPortID, channelID,
+		clienttypes.NewHeight(0, 100), 0,
+	)
+}
+
+func (suite *KeeperTestSuite) TestOnChanOpenTry() {
+	var (
+		order        channeltypes.Order
+		portID       string
+		counterparty channeltypes.Counterparty
+		version      string
+		cpVersion    string
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func(ctx sdk.Context)
+		expErr   error
+	}{
+		{"success", func(sdk.Context) {}, nil},
+		{"unordered channel", func(sdk.Context) { order = channeltypes.UNORDERED }, channeltypes.ErrInvalidChannelOrdering},
+		{"invalid host port", func(sdk.Context) { portID = "transfer" }, porttypes.ErrInvalidPort},
+		{"invalid controller port", func(sdk.Context) {
+			counterparty = channeltypes.NewCounterparty("transfer", channelID)
+		}, icatypes.ErrInvalidControllerPort},
+		{"invalid counterparty version", func(sdk.Context) { cpVersion = "ics20-1" }, icatypes.ErrInvalidVersion},
+		{"invalid address in version", func(sdk.Context) {
+			version = icatypes.NewAppVersion(icatypes.Version, suite.chain.SenderAccount.GetAddress().String())
+		}, icatypes.ErrInvalidVersion},
+		{"active channel already open", func(ctx sdk.Context) {
+			suite.openChannel(ctx)
+		}, icatypes.ErrActiveChannelAlreadySet},
+		{"account controlled by a key", func(ctx sdk.Context) {
+			acc := authtypes.NewBaseAccountWithAddress(suite.address)
+			suite.Require().NoError(acc.SetSequence(1))
+			suite.chain.App.AccountKeeper.SetAccount(ctx, acc)
+		}, icatypes.ErrAccountAlreadyExist},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest()
+			ctx := suite.chain.GetContext()
+
+			order = channeltypes.ORDERED
+			portID = icatypes.HostPortID
+			counterparty = channeltypes.NewCounterparty(suite.controllerPortID, channelID)
+			version = icatypes.NewAppVersion(icatypes.Version, suite.address.String())
+			cpVersion = icatypes.Version
+			tc.malleate(ctx)
+
+			// the channel identifier differs from the one of the already open channel
+			tryChannelID := "channel-1"
+			chanCap, err := suite.chain.App.ScopedIBCKeeper.NewCapability(ctx, host.ChannelCapabilityPath(portID, tryChannelID))
+			suite.Require().NoError(err)
+
+			err = suite.chain.App.ICAHostKeeper.OnChanOpenTry(
+				ctx, order, []string{connectionID}, portID, tryChannelID, chanCap, counterparty, version, cpVersion,
+			)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+
+			suite.Require().NoError(err)
+			acc := suite.chain.App.AccountKeeper.GetAccount(ctx, suite.address)
+			suite.Require().IsType(&icatypes.InterchainAccount{}, acc)
+			address, found := suite.chain.App.ICAHostKeeper.GetInterchainAccountAddress(ctx, connectionID, suite.controllerPortID)
+			suite.Require().True(found)
+			suite.Require().Equal(suite.address.String(), address)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRegisterPrefundedAccount() {
+	ctx := suite.chain.GetContext()
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	suite.Require().NoError(suite.chain.App.BankKeeper.SendCoins(ctx, suite.chain.SenderAccount.GetAddress(), suite.address, coins))
+	suite.Require().IsType(&authtypes.BaseAccount{}, suite.chain.App.AccountKeeper.GetAccount(ctx, suite.address))
+
+	suite.openChannel(ctx)
+
+	acc := suite.chain.App.AccountKeeper.GetAccount(ctx, suite.address)
+	suite.Require().IsType(&icatypes.InterchainAccount{}, acc)
+	suite.Require().Equal(suite.controllerPortID, acc.(*icatypes.InterchainAccount).AccountOwner)
+	suite.Require().Equal(coins, suite.chain.App.BankKeeper.GetAllBalances(ctx, suite.address))
+
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacket() {
+	recipient := suite.chain.SenderAccount.GetAddress()
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+
+	testCases := []struct {
+		msg      string
+		malleate func(ctx sdk.Context) channeltypes.Packet
+		expErr   error
+	}{
+		{"success", func(sdk.Context) channeltypes.Packet {
+			return suite.packet(banktypes.NewMsgSend(suite.address, recipient, coins))
+		}, nil},
+		{"message not allowed", func(ctx sdk.Context) channeltypes.Packet {
+			suite.chain.App.ICAHostKeeper.SetParams(ctx, types.NewParams(true, []string{"/lbm.staking.v1beta1.MsgDelegate"}))
+			return suite.packet(banktypes.NewMsgSend(suite.address, recipient, coins))
+		}, icatypes.ErrMsgNotAllowed},
+		{"signer is not the interchain account", func(sdk.Context) channeltypes.Packet {
+			return suite.packet(banktypes.NewMsgSend(recipient, suite.address, coins))
+		}, sdkerrors.ErrUnauthorized},
+		{"message execution fails", func(sdk.Context) channeltypes.Packet {
+			return suite.packet(banktypes.NewMsgSend(suite.address, recipient, coins.Add(coins...).Add(coins...)))
+		}, sdkerrors.ErrInsufficientFunds},
+		{"invalid packet data", func(sdk.Context) channeltypes.Packet {
+			packet := suite.packet(banktypes.NewMsgSend(suite.address, recipient, coins))
+			packet.Data = []byte("invalid")
+			return packet
+		}, icatypes.ErrUnknownDataType},
+		{"unknown packet type", func(sdk.Context) channeltypes.Packet {
+			packet := suite.packet(banktypes.NewMsgSend(suite.address, recipient, coins))
+			packet.Data = icatypes.InterchainAccountPacketData{Type: icatypes.UNSPECIFIED, Data: []byte("data")}.GetBytes()
+			return packet
+		}, icatypes.ErrUnknownDataType},
+		{"host disabled", func(ctx sdk.Context) channeltypes.Packet {
+			suite.chain.App.ICAHostKeeper.SetParams(ctx, types.NewParams(false, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}))
+			return suite.packet(banktypes.NewMsgSend(suite.address, recipient, coins))
+		}, types.ErrHostSubModuleDisabled},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest()
+			recipient = suite.chain.SenderAccount.GetAddress()
+			ctx := suite.chain.GetContext()
+
+			suite.openChannel(ctx)
+			suite.Require().NoError(suite.chain.App.BankKeeper.SendCoins(ctx, recipient, suite.address, coins.Add(coins...)))
+			suite.chain.App.ICAHostKeeper.SetParams(ctx, types.NewParams(true, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}))
+			packet := tc.malleate(ctx)
+
+			res, err := suite.chain.App.ICAHostKeeper.OnRecvPacket(ctx, packet)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Equal(coins.Add(coins...), suite.chain.App.BankKeeper.GetAllBalances(ctx, suite.address))
+				return
+			}
+
+			suite.Require().NoError(err)
+			var txMsgData sdk.TxMsgData
+			suite.Require().NoError(proto.Unmarshal(res, &txMsgData))
+			suite.Require().Len(txMsgData.Data, 1)
+			suite.Require().Equal(sdk.MsgTypeURL(&banktypes.MsgSend{}), txMsgData.Data[0].MsgType)
+			suite.Require().Equal(coins, suite.chain.App.BankKeeper.GetAllBalances(ctx, suite.address))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	ctx := suite.chain.GetContext()
+	suite.openChannel(ctx)
+
+	genesis := suite.chain.App.ICAHostKeeper.ExportGenesis(ctx)
+	suite.Require().Equal(icatypes.HostPortID, genesis.Port)
+	suite.Require().Equal([]icatypes.ActiveChannel{icatypes.NewActiveChannel(connectionID, suite.controllerPortID, channelID)}, genesis.ActiveChannels)
+	suite.Require().Equal([]icatypes.RegisteredInterchainAccount{icatypes.NewRegisteredInterchainAccount(connectionID, suite.controllerPortID, suite.address.String())}, genesis.InterchainAccounts)
+	suite.Require().NoError(genesis.Validate())
+
+	suite.SetupTest()
+	ctx = suite.chain.GetContext()
+	suite.chain.App.ICAHostKeeper.InitGenesis(ctx, *genesis)
+	suite.Require().True(suite.chain.App.ICAHostKeeper.IsBound(ctx, icatypes.HostPortID))
+	suite.Require().Equal(genesis, suite.chain.App.ICAHostKeeper.ExportGenesis(ctx))
+}
+
+func (suite *KeeperTestSuite) TestQueryInterchainAccount() {
+	ctx := sdk.WrapSDKContext(suite.chain.GetContext())
+
+	// the address is returned before the account is registered
+	res, err := suite.queryClient.InterchainAccount(ctx, &types.QueryInterchainAccountRequest{
+		PortId: suite.controllerPortID, ConnectionId: connectionID,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.address.String(), res.Address)
+
+	_, err = suite.queryClient.InterchainAccount(ctx, &types.QueryInterchainAccountRequest{
+		PortId: "transfer", ConnectionId: connectionID,
+	})
+	suite.Require().Error(err)
+
+	_, err = suite.queryClient.InterchainAccount(ctx, &types.QueryInterchainAccountRequest{
+		PortId: suite.controllerPortID, ConnectionId: "",
+	})
+	suite.Require().Error(err)
+
+	params, err := suite.queryClient.Params(ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().True(params.Params.HostEnabled)
+	suite.Require().Empty(params.Params.AllowMessages)
+}
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/host/types"
+	icatypes "github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/types"
+	channeltypes "github.com/line/lbm-sdk/x/ibc/core/04-channel/types"
+)
+
+// OnRecvPacket executes the messages of an EXECUTE_TX packet with the interchain account of
+// the controller port. It returns the marshalled sdk.TxMsgData of the execution, which is
+// written into the result acknowledgement.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byte, error) {
+	if !k.IsHostEnabled(ctx) {
+		return nil, types.ErrHostSubModuleDisabled
+	}
+
+	var data icatypes.InterchainAccountPacketData
+	if err := icatypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return nil, sdkerrors.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal ICS-27 interchain account packet data: %s", err.Error())
+	}
+
+	switch data.Type {
+	case icatypes.EXECUTE_TX:
+		msgs, err := icatypes.DeserializeCosmosTx(k.cdc, data.Data)
+		if err != nil {
+			return nil, err
+		}
+
+		return k.executeTx(ctx, packet.SourcePort, packet.DestinationPort, packet.DestinationChannel, msgs)
+
+	default:
+		return nil, icatypes.ErrUnknownDataType
+	}
+}
+
+// executeTx routes the messages through the MsgServiceRouter. The state changes are only
+// committed if every message is executed successfully.
+func (k Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel string, msgs []sdk.Msg) ([]byte, error) {
+	channel, found := k.channelKeeper.GetChannel(ctx, destPort, destChannel)
+	if !found {
+		return nil, channeltypes.ErrChannelNotFound
+	}
+
+	address, found := k.GetInterchainAccountAddress(ctx, channel.ConnectionHops[0], sourcePort)
+	if !found {
+		return nil, sdkerrors.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account on connection %s for port %s", channel.ConnectionHops[0], sourcePort)
+	}
+
+	params := k.GetParams(ctx)
+	for _, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, err
+		}
+
+		signers := msg.GetSigners()
+		if len(signers) != 1 || signers[0].String() != address {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "message %s must be signed by the interchain account %s only", sdk.MsgTypeURL(msg), address)
+		}
+
+		if !params.IsMessageAllowed(sdk.MsgTypeURL(msg)) {
+			return nil, sdkerrors.Wrapf(icatypes.ErrMsgNotAllowed, "message type not allowed: %s", sdk.MsgTypeURL(msg))
+		}
+	}
+
+	// CacheContext returns a new context with the multi-store branched into a cached storage object
+	// writeCache is called only if all msgs succeed, performing state transitions atomically
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	txMsgData := &sdk.TxMsgData{
+		Data: make([]*sdk.MsgData, len(msgs)),
+	}
+	for i, msg := range msgs {
+		msgData, err := k.executeMsg(cacheCtx, msg)
+		if err != nil {
+			return nil, err
+		}
+		txMsgData.Data[i] = msgData
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return proto.Marshal(txMsgData)
+}
+
+func (k Keeper) executeMsg(ctx sdk.Context, msg sdk.Msg) (*sdk.MsgData, error) {
+	handler := k.msgRouter.HandlerByTypeURL(sdk.MsgTypeURL(msg))
+	if handler == nil {
+		return nil, sdkerrors.Wrapf(icatypes.ErrInvalidRoute, "unrecognized message route: %s", sdk.MsgTypeURL(msg))
+	}
+
+	res, err := handler(ctx, msg)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "failed to execute message; message %s", sdk.MsgTypeURL(msg))
+	}
+
+	// emit the events from the executed message
+	events := make([]sdk.Event, 0, len(res.Events))
+	for _, event := range res.Events {
+		events = append(events, sdk.Event(event))
+	}
+	ctx.EventManager().EmitEvents(events)
+
+	return &sdk.MsgData{
+		MsgType: sdk.MsgTypeURL(msg),
+		Data:    res.Data,
+	}, nil
+}
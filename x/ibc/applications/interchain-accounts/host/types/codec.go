@@ -0,0 +1,12 @@
+package types
+
+import (
+	codectypes "github.com/line/lbm-sdk/codec/types"
+	icatypes "github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/types"
+)
+
+// RegisterInterfaces registers the interchain account types required by the host submodule
+// to protobuf Any.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	icatypes.RegisterInterfaces(registry)
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	icatypes "github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/types"
+	host "github.com/line/lbm-sdk/x/ibc/core/24-host"
+)
+
+// NewGenesisState creates a new interchain accounts host GenesisState instance.
+func NewGenesisState(
+	activeChannels []icatypes.ActiveChannel, interchainAccounts []icatypes.RegisteredInterchainAccount,
+	port string, params Params,
+) *GenesisState {
+	return &GenesisState{
+		ActiveChannels:     activeChannels,
+		InterchainAccounts: interchainAccounts,
+		Port:               port,
+		Params:             params,
+	}
+}
+
+// DefaultGenesisState returns a GenesisState with the default host port and no interchain
+// account registered.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Port:   icatypes.HostPortID,
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	for _, ch := range gs.ActiveChannels {
+		if err := ch.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, acc := range gs.InterchainAccounts {
+		if err := acc.Validate(); err != nil {
+			return err
+		}
+	}
+	if err := host.PortIdentifierValidator(gs.Port); err != nil {
+		return err
+	}
+	return gs.Params.Validate()
+}
@@ -0,0 +1,15 @@
+package types
+
+const (
+	// SubModuleName defines the interchain accounts host module name
+	SubModuleName = "icahost"
+
+	// StoreKey is the store key string for the interchain accounts host module
+	StoreKey = SubModuleName
+
+	// RouterKey is the message route for the interchain accounts host module
+	RouterKey = SubModuleName
+
+	// QuerierRoute is the querier route for the interchain accounts host module
+	QuerierRoute = SubModuleName
+)
@@ -0,0 +1,173 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"gopkg.in/yaml.v2"
+
+	cryptotypes "github.com/line/lbm-sdk/crypto/types"
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	authtypes "github.com/line/lbm-sdk/x/auth/types"
+)
+
+var (
+	_ authtypes.GenesisAccount = (*InterchainAccount)(nil)
+	_ InterchainAccountI       = (*InterchainAccount)(nil)
+)
+
+// InterchainAccountI wraps the authtypes.AccountI interface
+type InterchainAccountI interface {
+	authtypes.AccountI
+}
+
+// GenerateAddress returns the address of the interchain account of a controller port on a
+// connection of the host chain. The address follows the format as outlined in ADR 028:
+// https://github.com/cosmos/cosmos-sdk/blob/master/docs/architecture/adr-028-public-key-addresses.md
+func GenerateAddress(connectionID, portID string) sdk.AccAddress {
+	// a slash is used to create domain separation between connection and port identifiers
+	// to prevent address collisions between interchain accounts
+	contents := fmt.Sprintf("%s/%s", connectionID, portID)
+
+	preImage := []byte(Version)
+	preImage = append(preImage, 0)
+	preImage = append(preImage, contents...)
+	hash := sha256.Sum256(preImage)
+	return sdk.BytesToAccAddress(hash[:20])
+}
+
+// NewInterchainAccount creates and returns a new InterchainAccount type
+func NewInterchainAccount(ba *authtypes.BaseAccount, accountOwner string) *InterchainAccount {
+	return &InterchainAccount{
+		BaseAccount:  ba,
+		AccountOwner: accountOwner,
+	}
+}
+
+// SetPubKey implements the authtypes.AccountI interface. An interchain account has no key,
+// it is controlled through its channel.
+func (ia InterchainAccount) SetPubKey(pubKey cryptotypes.PubKey) error {
+	return sdkerrors.Wrap(ErrUnsupported, "cannot set public key for interchain account")
+}
+
+// SetSequence implements the authtypes.AccountI interface
+func (ia InterchainAccount) SetSequence(seq uint64) error {
+	return sdkerrors.Wrap(ErrUnsupported, "cannot set sequence number for interchain account")
+}
+
+// MarshalX implements the authtypes.AccountI interface. Interchain accounts are stored as
+// interfaces, so no signature prefixed encoding is defined for them.
+func (ia *InterchainAccount) MarshalX() ([]byte, error) {
+	return nil, sdkerrors.Wrap(ErrUnsupported, "cannot marshal interchain account with a signature prefix")
+}
+
+// Validate checks for errors on the account fields
+func (ia InterchainAccount) Validate() error {
+	if strings.TrimSpace(ia.AccountOwner) == "" {
+		return errors.New("account owner cannot be empty")
+	}
+	if ia.BaseAccount == nil {
+		return errors.New("base account cannot be nil")
+	}
+
+	return ia.BaseAccount.Validate()
+}
+
+type interchainAccountPretty struct {
+	Address      sdk.AccAddress `json:"address" yaml:"address"`
+	PubKey       string         `json:"public_key" yaml:"public_key"`
+	Sequence     uint64         `json:"sequence" yaml:"sequence"`
+	AccountOwner string         `json:"account_owner" yaml:"account_owner"`
+}
+
+func (ia InterchainAccount) String() string {
+	out, _ := ia.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of an InterchainAccount
+func (ia InterchainAccount) MarshalYAML() (interface{}, error) {
+	if err := sdk.ValidateAccAddress(ia.Address); err != nil {
+		return nil, err
+	}
+
+	bz, err := yaml.Marshal(interchainAccountPretty{
+		Address:      sdk.AccAddress(ia.Address),
+		PubKey:       "",
+		Sequence:     ia.Sequence,
+		AccountOwner: ia.AccountOwner,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return string(bz), nil
+}
+
+// MarshalJSON returns the JSON representation of an InterchainAccount
+func (ia InterchainAccount) MarshalJSON() ([]byte, error) {
+	if err := sdk.ValidateAccAddress(ia.Address); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(interchainAccountPretty{
+		Address:      sdk.AccAddress(ia.Address),
+		PubKey:       "",
+		Sequence:     ia.Sequence,
+		AccountOwner: ia.AccountOwner,
+	})
+}
+
+// UnmarshalJSON unmarshals raw JSON bytes into an InterchainAccount
+func (ia *InterchainAccount) UnmarshalJSON(bz []byte) error {
+	var alias interchainAccountPretty
+	if err := json.Unmarshal(bz, &alias); err != nil {
+		return err
+	}
+
+	ia.BaseAccount = authtypes.NewBaseAccount(alias.Address, nil, alias.Sequence)
+	ia.AccountOwner = alias.AccountOwner
+
+	return nil
+}
+
+type interchainAccountJSON struct {
+	BaseAccount  json.RawMessage `json:"base_account"`
+	AccountOwner string          `json:"account_owner"`
+}
+
+// MarshalJSONPB implements jsonpb.JSONPBMarshaler. It is required since the embedded base
+// account has a custom JSON encoding.
+func (ia InterchainAccount) MarshalJSONPB(m *jsonpb.Marshaler) ([]byte, error) {
+	bz, err := ia.BaseAccount.MarshalJSONPB(m)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(interchainAccountJSON{
+		BaseAccount:  bz,
+		AccountOwner: ia.AccountOwner,
+	})
+}
+
+// UnmarshalJSONPB implements jsonpb.JSONPBUnmarshaler
+func (ia *InterchainAccount) UnmarshalJSONPB(m *jsonpb.Unmarshaler, bz []byte) error {
+	var iaj interchainAccountJSON
+	if err := json.Unmarshal(bz, &iaj); err != nil {
+		return err
+	}
+
+	ba := new(authtypes.BaseAccount)
+	if err := m.Unmarshal(strings.NewReader(string(iaj.BaseAccount)), ba); err != nil {
+		return err
+	}
+	ia.BaseAccount = ba
+	ia.AccountOwner = iaj.AccountOwner
+
+	return nil
+}
@@ -0,0 +1,378 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/interchain_accounts/v1/account.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/line/lbm-sdk/x/auth/types"
+	_ "github.com/regen-network/cosmos-proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// InterchainAccount defines an account on a host chain which is controlled by an owner on
+// a controller chain through an ICS-27 channel.
+type InterchainAccount struct {
+	*types.BaseAccount `protobuf:"bytes,1,opt,name=base_account,json=baseAccount,proto3,embedded=base_account" json:"base_account,omitempty" yaml:"base_account"`
+	// account_owner is the controller port ID of the owner
+	AccountOwner string `protobuf:"bytes,2,opt,name=account_owner,json=accountOwner,proto3" json:"account_owner,omitempty" yaml:"account_owner"`
+}
+
+func (m *InterchainAccount) Reset()      { *m = InterchainAccount{} }
+func (*InterchainAccount) ProtoMessage() {}
+func (*InterchainAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5561bd92625bf7da, []int{0}
+}
+func (m *InterchainAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InterchainAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InterchainAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InterchainAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterchainAccount.Merge(m, src)
+}
+func (m *InterchainAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *InterchainAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterchainAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InterchainAccount proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*InterchainAccount)(nil), "ibc.applications.interchain_accounts.v1.InterchainAccount")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/interchain_accounts/v1/account.proto", fileDescriptor_5561bd92625bf7da)
+}
+
+var fileDescriptor_5561bd92625bf7da = []byte{
+	// 332 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xb1, 0x4e, 0xf3, 0x30,
+	0x14, 0x85, 0xe3, 0x7f, 0xf8, 0x25, 0xd2, 0x32, 0x10, 0x3a, 0x94, 0x56, 0x38, 0x55, 0x16, 0xba,
+	0xd4, 0x56, 0x40, 0x2c, 0x95, 0x18, 0x88, 0x58, 0x3a, 0x21, 0x75, 0x44, 0x82, 0xca, 0x36, 0x56,
+	0x6b, 0x91, 0xc4, 0x51, 0xed, 0x16, 0xfa, 0x06, 0x8c, 0x8c, 0x8c, 0x7d, 0x08, 0x1e, 0x82, 0xb1,
+	0x30, 0x31, 0x55, 0xa8, 0x79, 0x83, 0x3e, 0x01, 0x6a, 0x6c, 0x4a, 0x11, 0x88, 0xed, 0x9e, 0x7b,
+	0xf2, 0x9d, 0x13, 0xf9, 0xba, 0xc7, 0x82, 0x32, 0x4c, 0xb2, 0x2c, 0x16, 0x8c, 0x68, 0x21, 0x53,
+	0x85, 0x45, 0xaa, 0xf9, 0x90, 0x0d, 0x88, 0x48, 0x7b, 0x84, 0x31, 0x39, 0x4a, 0xb5, 0xc2, 0xe3,
+	0x10, 0xdb, 0x19, 0x65, 0x43, 0xa9, 0xa5, 0x77, 0x20, 0x28, 0x43, 0x9b, 0x18, 0xfa, 0x05, 0x43,
+	0xe3, 0xb0, 0xb6, 0xc7, 0xa4, 0x4a, 0xa4, 0xea, 0x15, 0x18, 0x36, 0xc2, 0x64, 0xd4, 0x2a, 0x7d,
+	0xd9, 0x97, 0x66, 0xbf, 0x9a, 0xec, 0xb6, 0x1e, 0xd3, 0x04, 0x93, 0x91, 0x1e, 0xe0, 0x71, 0x48,
+	0xb9, 0x26, 0x61, 0x21, 0x8c, 0x19, 0xbc, 0x00, 0x77, 0xa7, 0xb3, 0x2e, 0x3a, 0x35, 0x3d, 0xde,
+	0xa5, 0x5b, 0xa6, 0x44, 0xf1, 0xcf, 0xde, 0x2a, 0x68, 0x80, 0x66, 0xe9, 0x70, 0x1f, 0xc5, 0x34,
+	0x41, 0x05, 0x6c, 0x93, 0x50, 0x44, 0x14, 0xb7, 0x50, 0x54, 0x9f, 0xcd, 0x7d, 0xb0, 0x9c, 0xfb,
+	0xbb, 0x13, 0x92, 0xc4, 0xed, 0x60, 0x33, 0x20, 0xe8, 0x96, 0xe8, 0xd7, 0x97, 0xde, 0x89, 0xbb,
+	0x6d, 0x8d, 0x9e, 0xbc, 0x4d, 0xf9, 0xb0, 0xfa, 0xaf, 0x01, 0x9a, 0x5b, 0x51, 0x75, 0x39, 0xf7,
+	0x2b, 0x06, 0xfe, 0x66, 0x07, 0xdd, 0xb2, 0xd5, 0xe7, 0x2b, 0xd9, 0x86, 0xf7, 0x53, 0xdf, 0x79,
+	0x9c, 0xfa, 0xce, 0xeb, 0x53, 0xcb, 0xfb, 0xf1, 0xf3, 0x9d, 0xe8, 0xea, 0x79, 0x01, 0xc1, 0x6c,
+	0x01, 0xc1, 0xfb, 0x02, 0x82, 0x87, 0x1c, 0x3a, 0xb3, 0x1c, 0x3a, 0x6f, 0x39, 0x74, 0x2e, 0xce,
+	0xfa, 0x42, 0x0f, 0x46, 0x14, 0x31, 0x99, 0xe0, 0x58, 0xa4, 0x1c, 0xc7, 0x34, 0x69, 0xa9, 0xeb,
+	0x1b, 0x7c, 0x87, 0xff, 0xb8, 0x5a, 0x6b, 0x7d, 0x35, 0x3d, 0xc9, 0xb8, 0xa2, 0xff, 0x8b, 0xa7,
+	0x3b, 0xfa, 0x18, 0x00, 0x31, 0x0e, 0x7d, 0x35, 0xea, 0x01, 0x00, 0x00,
+}
+
+func (m *InterchainAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InterchainAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InterchainAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AccountOwner) > 0 {
+		i -= len(m.AccountOwner)
+		copy(dAtA[i:], m.AccountOwner)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.AccountOwner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BaseAccount != nil {
+		{
+			size, err := m.BaseAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAccount(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAccount(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAccount(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InterchainAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseAccount != nil {
+		l = m.BaseAccount.Size()
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	l = len(m.AccountOwner)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	return n
+}
+
+func sovAccount(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAccount(x uint64) (n int) {
+	return sovAccount(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InterchainAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterchainAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterchainAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseAccount == nil {
+				m.BaseAccount = &types.BaseAccount{}
+			}
+			if err := m.BaseAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAccount(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAccount
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAccount
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAccount
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAccount        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAccount          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAccount = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,59 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/crypto/keys/secp256k1"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/ibc/applications/interchain-accounts/types"
+)
+
+// Test that there is domain separation between the connection id and the port id otherwise an
+// interchain account address may overlap with another controller port
+func TestGenerateAddress(t *testing.T) {
+	address1 := types.GenerateAddress("connection-0", "icacontroller-owner")
+	address2 := types.GenerateAddress("connection-0icacontroller", "-owner")
+	require.NotEqual(t, address1, address2)
+	require.Equal(t, address1, types.GenerateAddress("connection-0", "icacontroller-owner"))
+	require.NoError(t, sdk.ValidateAccAddress(address1.String()))
+}
+
+func TestParseAddressFromVersion(t *testing.T) {
+	address := sdk.BytesToAccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+	testCases := []struct {
+		name    string
+		version string
+		expPass bool
+	}{
+		{"valid version", types.NewAppVersion(types.Version, address), true},
+		{"missing address", types.Version, false},
+		{"empty address", types.NewAppVersion(types.Version, ""), false},
+		{"invalid address", types.NewAppVersion(types.Version, "address"), false},
+		{"invalid version", types.NewAppVersion("ics20-1", address), false},
+	}
+
+	for _, tc := range testCases {
+		parsed, err := types.ParseAddressFromVersion(tc.version)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, address, parsed, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestNewControllerPortID(t *testing.T) {
+	portID, err := types.NewControllerPortID("owner")
+	require.NoError(t, err)
+	require.Equal(t, types.ControllerPortPrefix+"owner", portID)
+	require.NoError(t, types.ValidateControllerPortID(portID))
+
+	_, err = types.NewControllerPortID(" ")
+	require.Error(t, err)
+	require.Error(t, types.ValidateControllerPortID(types.ControllerPortPrefix))
+	require.Error(t, types.ValidateControllerPortID(types.HostPortID))
+}
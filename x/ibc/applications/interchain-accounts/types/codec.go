@@ -0,0 +1,29 @@
+package types
+
+import (
+	"github.com/line/lbm-sdk/codec"
+	codectypes "github.com/line/lbm-sdk/codec/types"
+	authtypes "github.com/line/lbm-sdk/x/auth/types"
+)
+
+// RegisterLegacyAminoCodec registers the concrete types on the provided LegacyAmino codec.
+// These types are used for Amino JSON serialization
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&InterchainAccount{}, "lbm-sdk/InterchainAccount", nil)
+}
+
+// RegisterInterfaces registers the interchain account implementations of the auth account
+// interfaces
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*authtypes.AccountI)(nil), &InterchainAccount{})
+	registry.RegisterImplementations((*authtypes.GenesisAccount)(nil), &InterchainAccount{})
+}
+
+var (
+	// ModuleCdc references the global interchain accounts module codec. Note, the codec
+	// should ONLY be used in certain instances of tests and for JSON encoding.
+	//
+	// The actual codec used for serialization should be provided to the submodules and
+	// defined at the application level.
+	ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+)
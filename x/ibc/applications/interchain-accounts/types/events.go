@@ -0,0 +1,14 @@
+package types
+
+// ICS-27 events
+const (
+	EventTypePacket = "ics27_packet"
+
+	AttributeKeyAckSuccess   = "success"
+	AttributeKeyAckError     = "error"
+	AttributeKeyConnectionID = "connection_id"
+	AttributeKeyPortID       = "port_id"
+	AttributeKeyAddress      = "address"
+
+	EventTypeRegisterInterchainAccount = "register_interchain_account"
+)
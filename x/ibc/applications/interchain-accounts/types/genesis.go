@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	host "github.com/line/lbm-sdk/x/ibc/core/24-host"
+)
+
+// NewActiveChannel creates and returns a new ActiveChannel
+func NewActiveChannel(connectionID, portID, channelID string) ActiveChannel {
+	return ActiveChannel{
+		ConnectionId: connectionID,
+		PortId:       portID,
+		ChannelId:    channelID,
+	}
+}
+
+// Validate performs a basic validation of the active channel identifiers.
+func (ac ActiveChannel) Validate() error {
+	if err := host.ConnectionIdentifierValidator(ac.ConnectionId); err != nil {
+		return err
+	}
+	if err := ValidateControllerPortID(ac.PortId); err != nil {
+		return err
+	}
+	return host.ChannelIdentifierValidator(ac.ChannelId)
+}
+
+// NewRegisteredInterchainAccount creates and returns a new RegisteredInterchainAccount
+func NewRegisteredInterchainAccount(connectionID, portID, accAddr string) RegisteredInterchainAccount {
+	return RegisteredInterchainAccount{
+		ConnectionId:   connectionID,
+		PortId:         portID,
+		AccountAddress: accAddr,
+	}
+}
+
+// Validate performs a basic validation of the registered interchain account.
+func (ia RegisteredInterchainAccount) Validate() error {
+	if err := host.ConnectionIdentifierValidator(ia.ConnectionId); err != nil {
+		return err
+	}
+	if err := ValidateControllerPortID(ia.PortId); err != nil {
+		return err
+	}
+	return sdk.ValidateAccAddress(ia.AccountAddress)
+}
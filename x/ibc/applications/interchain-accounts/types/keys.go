@@ -0,0 +1,66 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// ModuleName defines the interchain accounts module name
+	ModuleName = "interchainaccounts"
+
+	// Version defines the current version the ICS-27 interchain accounts protocol supports
+	Version = "ics27-1"
+
+	// VersionDelimiter separates the protocol version from the interchain account address in
+	// the channel version of the host chain
+	VersionDelimiter = "."
+
+	// ControllerPortPrefix is the prefix of the port IDs bound by the controller submodule,
+	// one for each owner
+	ControllerPortPrefix = "icacontroller-"
+
+	// HostPortID is the default port id that the host submodule binds to
+	HostPortID = "icahost"
+)
+
+var (
+	// PortKeyPrefix defines the key prefix to store the port IDs in store
+	PortKeyPrefix = []byte{0x01}
+	// ActiveChannelKeyPrefix defines the key prefix to store the active channels in store
+	ActiveChannelKeyPrefix = []byte{0x02}
+	// OwnerKeyPrefix defines the key prefix to store the interchain account addresses in store
+	OwnerKeyPrefix = []byte{0x03}
+)
+
+// KeyPort returns the store key for a bound port ID
+func KeyPort(portID string) []byte {
+	return append(PortKeyPrefix, []byte(portID)...)
+}
+
+// KeyActiveChannel returns the store key of the active channel of a controller port on a
+// connection
+func KeyActiveChannel(connectionID, portID string) []byte {
+	return append(ActiveChannelKeyPrefix, connectionPortKey(connectionID, portID)...)
+}
+
+// KeyOwnerAccount returns the store key of the interchain account address of a controller
+// port on a connection
+func KeyOwnerAccount(connectionID, portID string) []byte {
+	return append(OwnerKeyPrefix, connectionPortKey(connectionID, portID)...)
+}
+
+// ParseConnectionPortKey returns the connection and port IDs of a KeyActiveChannel or
+// KeyOwnerAccount store key stripped of its prefix
+func ParseConnectionPortKey(key []byte) (connectionID, portID string, err error) {
+	parts := strings.SplitN(string(key), "/", 2)
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("key %s is not of the form {connection-id}/{port-id}", key)
+	}
+	return parts[0], parts[1], nil
+}
+
+// connection and port identifiers never contain a slash
+func connectionPortKey(connectionID, portID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", connectionID, portID))
+}
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"github.com/line/lbm-sdk/codec"
+	codectypes "github.com/line/lbm-sdk/codec/types"
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+// MaxMemoCharLength defines the maximum length for the InterchainAccountPacketData memo field
+const MaxMemoCharLength = 256
+
+var _ codectypes.UnpackInterfacesMessage = CosmosTx{}
+
+// ValidateBasic performs basic validation of the interchain account packet data.
+func (iapd InterchainAccountPacketData) ValidateBasic() error {
+	if iapd.Type == UNSPECIFIED {
+		return sdkerrors.Wrap(ErrInvalidOutgoingData, "packet data type cannot be unspecified")
+	}
+	if len(iapd.Data) == 0 {
+		return sdkerrors.Wrap(ErrInvalidOutgoingData, "packet data cannot be empty")
+	}
+	if len(iapd.Memo) > MaxMemoCharLength {
+		return sdkerrors.Wrapf(ErrInvalidOutgoingData, "packet data memo cannot be greater than %d characters", MaxMemoCharLength)
+	}
+	return nil
+}
+
+// GetBytes returns the JSON marshalled interchain account packet data.
+func (iapd InterchainAccountPacketData) GetBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&iapd))
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (ct CosmosTx) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, any := range ct.Messages {
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(any, &msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SerializeCosmosTx serializes messages into the data of an EXECUTE_TX packet. The codec
+// must be a proto codec.
+func SerializeCosmosTx(cdc codec.BinaryMarshaler, msgs []sdk.Msg) ([]byte, error) {
+	if _, ok := cdc.(*codec.ProtoCodec); !ok {
+		return nil, sdkerrors.Wrapf(ErrInvalidCodec, "expected %T, got %T", &codec.ProtoCodec{}, cdc)
+	}
+
+	msgAnys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+		msgAnys[i] = any
+	}
+
+	return cdc.MarshalBinaryBare(&CosmosTx{Messages: msgAnys})
+}
+
+// DeserializeCosmosTx unmarshals the messages of an EXECUTE_TX packet. The codec must be a
+// proto codec and the messages must be registered in its interface registry.
+func DeserializeCosmosTx(cdc codec.BinaryMarshaler, data []byte) ([]sdk.Msg, error) {
+	if _, ok := cdc.(*codec.ProtoCodec); !ok {
+		return nil, sdkerrors.Wrapf(ErrInvalidCodec, "expected %T, got %T", &codec.ProtoCodec{}, cdc)
+	}
+
+	var cosmosTx CosmosTx
+	if err := cdc.UnmarshalBinaryBare(data, &cosmosTx); err != nil {
+		return nil, err
+	}
+
+	msgs := make([]sdk.Msg, len(cosmosTx.Messages))
+	for i, any := range cosmosTx.Messages {
+		msg, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "cannot unpack %s into sdk.Msg", any.TypeUrl)
+		}
+		msgs[i] = msg
+	}
+	return msgs, nil
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"strings"
+
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	host "github.com/line/lbm-sdk/x/ibc/core/24-host"
+)
+
+// NewControllerPortID returns the controller port ID bound for an owner. The owner is
+// typically the address of an account or of a module on the controller chain.
+func NewControllerPortID(owner string) (string, error) {
+	if strings.TrimSpace(owner) == "" {
+		return "", sdkerrors.Wrap(ErrInvalidControllerPort, "owner address cannot be empty")
+	}
+
+	portID := ControllerPortPrefix + owner
+	if err := host.PortIdentifierValidator(portID); err != nil {
+		return "", sdkerrors.Wrap(ErrInvalidControllerPort, err.Error())
+	}
+	return portID, nil
+}
+
+// ValidateControllerPortID returns an error if the port ID is not a controller port ID.
+func ValidateControllerPortID(portID string) error {
+	if !strings.HasPrefix(portID, ControllerPortPrefix) || len(portID) == len(ControllerPortPrefix) {
+		return sdkerrors.Wrapf(ErrInvalidControllerPort, "expected %s{owner}, got %s", ControllerPortPrefix, portID)
+	}
+	return host.PortIdentifierValidator(portID)
+}
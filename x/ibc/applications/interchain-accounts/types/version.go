@@ -0,0 +1,30 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+// NewAppVersion returns the channel version of the host chain, which carries the address of
+// the interchain account to the controller chain.
+//
+// NOTE: the host channel version must be provided on ChanOpenTry. It can be retrieved with
+// the InterchainAccount query of the host submodule.
+func NewAppVersion(version, address string) string {
+	return version + VersionDelimiter + address
+}
+
+// ParseAddressFromVersion returns the interchain account address carried by the channel
+// version of a host chain.
+func ParseAddressFromVersion(version string) (string, error) {
+	parts := strings.SplitN(version, VersionDelimiter, 2)
+	if len(parts) != 2 || parts[0] != Version {
+		return "", sdkerrors.Wrapf(ErrInvalidVersion, "expected %s%s{address}, got %s", Version, VersionDelimiter, version)
+	}
+	if err := sdk.ValidateAccAddress(parts[1]); err != nil {
+		return "", sdkerrors.Wrap(ErrInvalidAccountAddress, err.Error())
+	}
+	return parts[1], nil
+}
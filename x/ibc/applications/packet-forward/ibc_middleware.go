@@ -0,0 +1,200 @@
+package packetforward
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	capabilitytypes "github.com/line/lbm-sdk/x/capability/types"
+	"github.com/line/lbm-sdk/x/ibc/applications/packet-forward/keeper"
+	"github.com/line/lbm-sdk/x/ibc/applications/packet-forward/types"
+	transfertypes "github.com/line/lbm-sdk/x/ibc/applications/transfer/types"
+	channeltypes "github.com/line/lbm-sdk/x/ibc/core/04-channel/types"
+	porttypes "github.com/line/lbm-sdk/x/ibc/core/05-port/types"
+)
+
+var _ porttypes.IBCModule = IBCMiddleware{}
+
+// IBCMiddleware implements the ICS-26 callbacks of the transfer application wrapped by
+// the packet forwarding. Received packets whose memo holds a forwarding instruction are
+// received by an intermediate account and sent on to a further chain. They are
+// acknowledged once the forwarded packet is acknowledged or timed out.
+type IBCMiddleware struct {
+	app    porttypes.IBCModule
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware wrapping the given transfer application
+func NewIBCMiddleware(app porttypes.IBCModule, k keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		app:    app,
+		keeper: k,
+	}
+}
+
+// OnChanOpenInit implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) error {
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version)
+}
+
+// OnChanOpenTry implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version, counterpartyVersion)
+}
+
+// OnChanOpenAck implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCModule interface
+func (im IBCMiddleware) OnChanCloseInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm implements the IBCModule interface
+func (im IBCMiddleware) OnChanCloseConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnRecvPacket implements the IBCModule interface. Packets without a forwarding
+// instruction are passed to the wrapped application. Otherwise the tokens are received by
+// the intermediate receiver and forwarded, and the acknowledgement is written once the
+// forwarded packet completes. The packet is acknowledged with an error if it cannot be
+// forwarded.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+) (*sdk.Result, []byte, error) {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return im.app.OnRecvPacket(ctx, packet)
+	}
+
+	metadata, err := types.ParseForwardMetadata(data.Memo)
+	if err != nil {
+		return newErrorAcknowledgement(ctx, err)
+	}
+	if metadata == nil {
+		return im.app.OnRecvPacket(ctx, packet)
+	}
+
+	data.Receiver = types.GetIntermediateReceiver(packet.GetDestChannel(), data.Sender).String()
+	data.Memo = ""
+	receivePacket := packet
+	receivePacket.Data = data.GetBytes()
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	res, ack, err := im.app.OnRecvPacket(cacheCtx, receivePacket)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !isSuccessAcknowledgement(ack) {
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		return res, ack, nil
+	}
+
+	if err := im.keeper.ForwardTransferPacket(cacheCtx, packet, data, *metadata); err != nil {
+		return newErrorAcknowledgement(ctx, err)
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	// NOTE: the acknowledgement is written asynchronously once the forwarded packet
+	// completes.
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil, nil
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. The packet a forwarded
+// packet was forwarded from is acknowledged, after the wrapped application refunded the
+// intermediate receiver if the forwarded packet failed.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+) (*sdk.Result, error) {
+	res, err := im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := im.keeper.OnForwardedPacketAcknowledged(ctx, packet, acknowledgement); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface. The packet a forwarded packet was
+// forwarded from is acknowledged with an error, after the wrapped application refunded
+// the intermediate receiver.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+) (*sdk.Result, error) {
+	res, err := im.app.OnTimeoutPacket(ctx, packet)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := im.keeper.OnForwardedPacketTimedOut(ctx, packet); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func newErrorAcknowledgement(ctx sdk.Context, err error) (*sdk.Result, []byte, error) {
+	acknowledgement := channeltypes.NewErrorAcknowledgement(err.Error())
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, acknowledgement.GetBytes(), nil
+}
+
+func isSuccessAcknowledgement(bz []byte) bool {
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(bz, &ack); err != nil {
+		return false
+	}
+	_, ok := ack.Response.(*channeltypes.Acknowledgement_Result)
+	return ok
+}
@@ -0,0 +1,224 @@
+package packetforward_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/x/ibc/applications/packet-forward/types"
+	transfertypes "github.com/line/lbm-sdk/x/ibc/applications/transfer/types"
+	clienttypes "github.com/line/lbm-sdk/x/ibc/core/02-client/types"
+	channeltypes "github.com/line/lbm-sdk/x/ibc/core/04-channel/types"
+	host "github.com/line/lbm-sdk/x/ibc/core/24-host"
+	"github.com/line/lbm-sdk/x/ibc/core/exported"
+	ibctesting "github.com/line/lbm-sdk/x/ibc/testing"
+)
+
+const amount = uint64(100)
+
+type MiddlewareTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// chainB forwards the tokens sent by chainA to chainC
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+	chainC *ibctesting.TestChain
+
+	channelAB ibctesting.TestChannel
+	channelBA ibctesting.TestChannel
+	channelBC ibctesting.TestChannel
+	channelCB ibctesting.TestChannel
+}
+
+func (suite *MiddlewareTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 3)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(0))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainC = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	_, _, connAB, connBA := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Ostracon)
+	suite.channelAB, suite.channelBA = suite.coordinator.CreateTransferChannels(suite.chainA, suite.chainB, connAB, connBA, channeltypes.UNORDERED)
+	_, _, connBC, connCB := suite.coordinator.SetupClientConnections(suite.chainB, suite.chainC, exported.Ostracon)
+	suite.channelBC, suite.channelCB = suite.coordinator.CreateTransferChannels(suite.chainB, suite.chainC, connBC, connCB, channeltypes.UNORDERED)
+}
+
+func TestMiddlewareTestSuite(t *testing.T) {
+	suite.Run(t, new(MiddlewareTestSuite))
+}
+
+// forwardMemo returns the memo forwarding the tokens received by chainB to chainC.
+func (suite *MiddlewareTestSuite) forwardMemo(timeout string) string {
+	return fmt.Sprintf(
+		`{"forward":{"receiver":"%s","port":"%s","channel":"%s","timeout":"%s"}}`,
+		suite.chainC.SenderAccount.GetAddress(), suite.channelBC.PortID, suite.channelBC.ID, timeout,
+	)
+}
+
+// sendFromChainA transfers stake from chainA to chainB with the given memo and returns the
+// sent packet.
+func (suite *MiddlewareTestSuite) sendFromChainA(memo string) channeltypes.Packet {
+	sender := suite.chainA.SenderAccount.GetAddress()
+	receiver := suite.chainB.SenderAccount.GetAddress().String()
+	timeoutHeight := clienttypes.NewHeight(0, 1000)
+	msg := transfertypes.NewMsgTransfer(
+		suite.channelAB.PortID, suite.channelAB.ID, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewIntFromUint64(amount)),
+		sender, receiver, timeoutHeight, 0, memo,
+	)
+	suite.Require().NoError(suite.coordinator.SendMsg(suite.chainA, suite.chainB, suite.channelBA.ClientID, msg))
+
+	data := transfertypes.NewFungibleTokenPacketData(sdk.DefaultBondDenom, amount, sender.String(), receiver, memo)
+	return channeltypes.NewPacket(
+		data.GetBytes(), 1, suite.channelAB.PortID, suite.channelAB.ID, suite.channelBA.PortID, suite.channelBA.ID, timeoutHeight, 0,
+	)
+}
+
+// recvOnChainB receives a packet sent by chainA on chainB and returns the packet forwarded
+// to chainC with the given timeout.
+func (suite *MiddlewareTestSuite) recvOnChainB(packet channeltypes.Packet, timeout time.Duration) channeltypes.Packet {
+	proof, proofHeight := suite.chainA.QueryProof(host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+	suite.coordinator.IncrementTime()
+	suite.coordinator.CommitBlock(suite.chainA, suite.chainB)
+
+	timeoutTimestamp := uint64(suite.chainB.GetContext().BlockTime().Add(timeout).UnixNano())
+	msg := channeltypes.NewMsgRecvPacket(packet, proof, proofHeight, suite.chainB.SenderAccount.GetAddress())
+	suite.Require().NoError(suite.coordinator.SendMsg(suite.chainB, suite.chainC, suite.channelCB.ClientID, msg))
+
+	// the packet is acknowledged once the forwarded packet completes
+	_, found := suite.chainB.App.IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(
+		suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
+	)
+	suite.Require().False(found)
+
+	denom := transfertypes.GetPrefixedDenom(suite.channelBA.PortID, suite.channelBA.ID, sdk.DefaultBondDenom)
+	data := transfertypes.NewFungibleTokenPacketData(
+		denom, amount, suite.intermediateReceiver().String(), suite.chainC.SenderAccount.GetAddress().String(), "",
+	)
+	return channeltypes.NewPacket(
+		data.GetBytes(), 1, suite.channelBC.PortID, suite.channelBC.ID, suite.channelCB.PortID, suite.channelCB.ID,
+		clienttypes.ZeroHeight(), timeoutTimestamp,
+	)
+}
+
+// acknowledgeOnChainA acknowledges the packet sent by chainA with the acknowledgement
+// written by chainB.
+func (suite *MiddlewareTestSuite) acknowledgeOnChainA(packet channeltypes.Packet, ack []byte) {
+	suite.Require().NoError(suite.coordinator.UpdateClient(suite.chainA, suite.chainB, suite.channelAB.ClientID, exported.Ostracon))
+	suite.Require().NoError(suite.coordinator.AcknowledgePacket(suite.chainA, suite.chainB, suite.channelBA.ClientID, packet, ack))
+}
+
+func (suite *MiddlewareTestSuite) intermediateReceiver() sdk.AccAddress {
+	return types.GetIntermediateReceiver(suite.channelBA.ID, suite.chainA.SenderAccount.GetAddress().String())
+}
+
+func (suite *MiddlewareTestSuite) voucherDenomOnChainB() string {
+	return transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(suite.channelBA.PortID, suite.channelBA.ID, sdk.DefaultBondDenom)).IBCDenom()
+}
+
+func (suite *MiddlewareTestSuite) TestForwardPacket() {
+	balance := suite.chainA.App.BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+	packet := suite.sendFromChainA(suite.forwardMemo("10m"))
+	forwarded := suite.recvOnChainB(packet, 10*time.Minute)
+
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	suite.Require().NoError(suite.coordinator.RelayPacket(
+		suite.chainB, suite.chainC, suite.channelBC.ClientID, suite.channelCB.ClientID, forwarded, ack.GetBytes(),
+	))
+	suite.acknowledgeOnChainA(packet, ack.GetBytes())
+
+	// chainC received the vouchers of the vouchers of chainB
+	prefixedDenom := transfertypes.GetPrefixedDenom(suite.channelCB.PortID, suite.channelCB.ID,
+		transfertypes.GetPrefixedDenom(suite.channelBA.PortID, suite.channelBA.ID, sdk.DefaultBondDenom))
+	received := suite.chainC.App.BankKeeper.GetBalance(
+		suite.chainC.GetContext(), suite.chainC.SenderAccount.GetAddress(), transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom(),
+	)
+	suite.Require().Equal(sdk.NewIntFromUint64(amount), received.Amount)
+
+	// the vouchers of chainB are escrowed and the tokens of chainA are not refunded
+	ctxB := suite.chainB.GetContext()
+	voucherDenom := suite.voucherDenomOnChainB()
+	escrowAddress := transfertypes.GetEscrowAddress(suite.channelBC.PortID, suite.channelBC.ID)
+	suite.Require().Equal(sdk.NewIntFromUint64(amount), suite.chainB.App.BankKeeper.GetBalance(ctxB, escrowAddress, voucherDenom).Amount)
+	suite.Require().True(suite.chainB.App.BankKeeper.GetBalance(ctxB, suite.intermediateReceiver(), voucherDenom).IsZero())
+	suite.Require().Empty(suite.chainB.App.PacketForwardKeeper.GetAllInFlightPackets(ctxB))
+
+	expBalance := balance.Sub(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewIntFromUint64(amount)))
+	suite.Require().Equal(expBalance, suite.chainA.App.BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom))
+}
+
+func (suite *MiddlewareTestSuite) TestForwardPacketFailed() {
+	// chainC rejects the forwarded packet
+	suite.chainC.App.TransferKeeper.SetParams(suite.chainC.GetContext(), transfertypes.NewParams(true, false))
+
+	balance := suite.chainA.App.BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+	packet := suite.sendFromChainA(suite.forwardMemo("10m"))
+	forwarded := suite.recvOnChainB(packet, 10*time.Minute)
+
+	ack := channeltypes.NewErrorAcknowledgement(transfertypes.ErrReceiveDisabled.Error())
+	suite.Require().NoError(suite.coordinator.RelayPacket(
+		suite.chainB, suite.chainC, suite.channelBC.ClientID, suite.channelCB.ClientID, forwarded, ack.GetBytes(),
+	))
+	forwardAck := channeltypes.NewErrorAcknowledgement(sdkerrors.Wrap(types.ErrForwardFailed, ack.GetError()).Error())
+	suite.acknowledgeOnChainA(packet, forwardAck.GetBytes())
+
+	suite.requireReverted(balance)
+}
+
+func (suite *MiddlewareTestSuite) TestForwardPacketTimeout() {
+	balance := suite.chainA.App.BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+	packet := suite.sendFromChainA(suite.forwardMemo("1s"))
+	forwarded := suite.recvOnChainB(packet, time.Second)
+
+	// time out the forwarded packet on chainB
+	suite.coordinator.IncrementTime()
+	suite.coordinator.CommitBlock(suite.chainC)
+	suite.Require().NoError(suite.coordinator.UpdateClient(suite.chainB, suite.chainC, suite.channelBC.ClientID, exported.Ostracon))
+	proof, proofHeight := suite.chainC.QueryProof(host.PacketReceiptKey(forwarded.GetDestPort(), forwarded.GetDestChannel(), forwarded.GetSequence()))
+	msg := channeltypes.NewMsgTimeout(forwarded, forwarded.GetSequence(), proof, proofHeight, suite.chainB.SenderAccount.GetAddress())
+	suite.Require().NoError(suite.coordinator.SendMsg(suite.chainB, suite.chainC, suite.channelCB.ClientID, msg))
+
+	forwardAck := channeltypes.NewErrorAcknowledgement(sdkerrors.Wrap(types.ErrForwardFailed, types.ErrForwardTimeout.Error()).Error())
+	suite.acknowledgeOnChainA(packet, forwardAck.GetBytes())
+
+	suite.requireReverted(balance)
+}
+
+func (suite *MiddlewareTestSuite) TestInvalidForwardMetadata() {
+	balance := suite.chainA.App.BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+	packet := suite.sendFromChainA(`{"forward":{"receiver":"","port":"transfer","channel":"channel-1"}}`)
+	suite.Require().NoError(suite.coordinator.RecvPacket(suite.chainA, suite.chainB, suite.channelAB.ClientID, packet))
+
+	_, err := types.ParseForwardMetadata(`{"forward":{"receiver":"","port":"transfer","channel":"channel-1"}}`)
+	ack := channeltypes.NewErrorAcknowledgement(err.Error())
+	suite.Require().NoError(suite.coordinator.AcknowledgePacket(suite.chainA, suite.chainB, suite.channelBA.ClientID, packet, ack.GetBytes()))
+
+	suite.requireReverted(balance)
+}
+
+func (suite *MiddlewareTestSuite) TestRecvPacketWithoutForward() {
+	packet := suite.sendFromChainA("a plain memo")
+	suite.Require().NoError(suite.coordinator.RecvPacket(suite.chainA, suite.chainB, suite.channelAB.ClientID, packet))
+
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	suite.Require().NoError(suite.coordinator.AcknowledgePacket(suite.chainA, suite.chainB, suite.channelBA.ClientID, packet, ack.GetBytes()))
+
+	received := suite.chainB.App.BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), suite.voucherDenomOnChainB())
+	suite.Require().Equal(sdk.NewIntFromUint64(amount), received.Amount)
+}
+
+// requireReverted checks that the sender on chainA was refunded and no vouchers are left
+// on chainB.
+func (suite *MiddlewareTestSuite) requireReverted(balance sdk.Coin) {
+	ctxB := suite.chainB.GetContext()
+	voucherDenom := suite.voucherDenomOnChainB()
+	suite.Require().True(suite.chainB.App.BankKeeper.GetSupply(ctxB).GetTotal().AmountOf(voucherDenom).IsZero())
+	suite.Require().True(suite.chainB.App.BankKeeper.GetBalance(ctxB, suite.intermediateReceiver(), voucherDenom).IsZero())
+	suite.Require().Empty(suite.chainB.App.PacketForwardKeeper.GetAllInFlightPackets(ctxB))
+
+	suite.Require().Equal(balance, suite.chainA.App.BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom))
+}
@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/x/ibc/applications/packet-forward/types"
+	transfertypes "github.com/line/lbm-sdk/x/ibc/applications/transfer/types"
+	clienttypes "github.com/line/lbm-sdk/x/ibc/core/02-client/types"
+	channeltypes "github.com/line/lbm-sdk/x/ibc/core/04-channel/types"
+)
+
+// ForwardTransferPacket sends the tokens received with a transfer packet on to the
+// receiver of the forward metadata. The tokens must have been received by the
+// intermediate receiver of the packet. The packet is acknowledged once the forwarded
+// packet is acknowledged or timed out.
+func (k Keeper) ForwardTransferPacket(
+	ctx sdk.Context, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData, metadata types.ForwardMetadata,
+) error {
+	timeout, err := metadata.GetTimeout()
+	if err != nil {
+		return err
+	}
+	memo, err := metadata.GetNextMemo()
+	if err != nil {
+		return err
+	}
+
+	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, metadata.Port, metadata.Channel)
+	if !found {
+		return sdkerrors.Wrapf(
+			channeltypes.ErrSequenceSendNotFound,
+			"source port: %s, source channel: %s", metadata.Port, metadata.Channel,
+		)
+	}
+
+	token := sdk.NewCoin(types.GetReceivedDenom(packet, data.Denom), sdk.NewIntFromUint64(data.Amount))
+	sender := types.GetIntermediateReceiver(packet.GetDestChannel(), data.Sender)
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(timeout).UnixNano())
+
+	if err := k.transferKeeper.SendTransfer(
+		ctx, metadata.Port, metadata.Channel, token, sender, metadata.Receiver,
+		clienttypes.ZeroHeight(), timeoutTimestamp, memo,
+	); err != nil {
+		return sdkerrors.Wrap(types.ErrForwardFailed, err.Error())
+	}
+
+	k.SetInFlightPacket(ctx, types.NewInFlightPacket(metadata.Port, metadata.Channel, sequence, packet))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePacketForward,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyForwardReceiver, metadata.Receiver),
+			sdk.NewAttribute(types.AttributeKeyForwardPort, metadata.Port),
+			sdk.NewAttribute(types.AttributeKeyForwardChannel, metadata.Channel),
+			sdk.NewAttribute(types.AttributeKeyForwardSequence, fmt.Sprintf("%d", sequence)),
+		),
+	)
+
+	k.Logger(ctx).Info(
+		"packet forwarded",
+		"src-port", packet.GetSourcePort(), "src-channel", packet.GetSourceChannel(), "sequence", packet.GetSequence(),
+		"forward-port", metadata.Port, "forward-channel", metadata.Channel, "forward-sequence", sequence,
+	)
+	return nil
+}
+
+// OnForwardedPacketAcknowledged acknowledges the packet a forwarded packet was forwarded
+// from. The receive of the packet is reverted if the forwarded packet failed, so that
+// its sender is refunded. It is a no-op for packets which were not forwarded.
+func (k Keeper) OnForwardedPacketAcknowledged(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) error {
+	inFlight, found := k.GetInFlightPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return nil
+	}
+	k.DeleteInFlightPacket(ctx, inFlight.ForwardPortId, inFlight.ForwardChannelId, inFlight.ForwardSequence)
+
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return k.failInFlightPacket(ctx, inFlight, fmt.Sprintf("cannot unmarshal acknowledgement: %s", err))
+	}
+	if _, ok := ack.Response.(*channeltypes.Acknowledgement_Result); !ok {
+		return k.failInFlightPacket(ctx, inFlight, ack.GetError())
+	}
+
+	emitForwardResultEvent(ctx, inFlight, "")
+	return k.writeAcknowledgement(ctx, inFlight.Packet, acknowledgement)
+}
+
+// OnForwardedPacketTimedOut reverts the receive of the packet a forwarded packet was
+// forwarded from, and acknowledges it with an error so that its sender is refunded. It
+// is a no-op for packets which were not forwarded.
+func (k Keeper) OnForwardedPacketTimedOut(ctx sdk.Context, packet channeltypes.Packet) error {
+	inFlight, found := k.GetInFlightPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return nil
+	}
+	k.DeleteInFlightPacket(ctx, inFlight.ForwardPortId, inFlight.ForwardChannelId, inFlight.ForwardSequence)
+
+	return k.failInFlightPacket(ctx, inFlight, types.ErrForwardTimeout.Error())
+}
+
+// failInFlightPacket reverts the receive of the packet an in-flight packet was forwarded
+// from and acknowledges it with an error.
+func (k Keeper) failInFlightPacket(ctx sdk.Context, inFlight types.InFlightPacket, reason string) error {
+	if err := k.revertReceive(ctx, inFlight.Packet); err != nil {
+		return err
+	}
+
+	emitForwardResultEvent(ctx, inFlight, reason)
+	acknowledgement := channeltypes.NewErrorAcknowledgement(sdkerrors.Wrap(types.ErrForwardFailed, reason).Error())
+	return k.writeAcknowledgement(ctx, inFlight.Packet, acknowledgement.GetBytes())
+}
+
+// revertReceive reverts the receive of the tokens of a forwarded packet. The refund of the
+// failed forwarded packet has returned the tokens to the intermediate receiver, from
+// where they are escrowed again or burned, the same way they were unescrowed or minted
+// when the packet was received.
+func (k Keeper) revertReceive(ctx sdk.Context, packet channeltypes.Packet) error {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+	}
+
+	token := sdk.NewCoin(types.GetReceivedDenom(packet, data.Denom), sdk.NewIntFromUint64(data.Amount))
+	coins := sdk.NewCoins(token)
+	intermediate := types.GetIntermediateReceiver(packet.GetDestChannel(), data.Sender)
+
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		escrowAddress := transfertypes.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
+		return k.bankKeeper.SendCoins(ctx, intermediate, escrowAddress, coins)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, intermediate, transfertypes.ModuleName, coins); err != nil {
+		return err
+	}
+	return k.bankKeeper.BurnCoins(ctx, transfertypes.ModuleName, coins)
+}
+
+// writeAcknowledgement writes the acknowledgement of a packet whose acknowledgement was
+// deferred until its forwarded packet completed.
+func (k Keeper) writeAcknowledgement(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) error {
+	_, chanCap, err := k.channelKeeper.LookupModuleByChannel(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	if err != nil {
+		return err
+	}
+	return k.channelKeeper.WriteAcknowledgement(ctx, chanCap, packet, acknowledgement)
+}
+
+func emitForwardResultEvent(ctx sdk.Context, inFlight types.InFlightPacket, reason string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePacketForwardResult,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyForwardPort, inFlight.ForwardPortId),
+			sdk.NewAttribute(types.AttributeKeyForwardChannel, inFlight.ForwardChannelId),
+			sdk.NewAttribute(types.AttributeKeyForwardSequence, fmt.Sprintf("%d", inFlight.ForwardSequence)),
+			sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", reason == "")),
+			sdk.NewAttribute(types.AttributeKeyAckError, reason),
+		),
+	)
+}
@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/ibc/applications/packet-forward/types"
+)
+
+// InitGenesis initializes the ibc-packetforward state.
+func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
+	for _, packet := range state.InFlightPackets {
+		k.SetInFlightPacket(ctx, packet)
+	}
+}
+
+// ExportGenesis exports the in-flight packets of the ibc-packetforward module into its
+// genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{
+		InFlightPackets: k.GetAllInFlightPackets(ctx),
+	}
+}
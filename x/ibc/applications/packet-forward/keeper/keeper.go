@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"github.com/line/ostracon/libs/log"
+
+	"github.com/line/lbm-sdk/codec"
+	"github.com/line/lbm-sdk/store/prefix"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/ibc/applications/packet-forward/types"
+	host "github.com/line/lbm-sdk/x/ibc/core/24-host"
+)
+
+// Keeper defines the IBC packet forward keeper. It keeps track of the packets sent on to
+// a further chain until they are acknowledged, in order to acknowledge the packets they
+// were forwarded from.
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      codec.BinaryMarshaler
+
+	transferKeeper types.TransferKeeper
+	channelKeeper  types.ChannelKeeper
+	bankKeeper     types.BankKeeper
+}
+
+// NewKeeper creates a new IBC packet forward Keeper instance
+func NewKeeper(
+	cdc codec.BinaryMarshaler, key sdk.StoreKey,
+	transferKeeper types.TransferKeeper, channelKeeper types.ChannelKeeper, bankKeeper types.BankKeeper,
+) Keeper {
+	return Keeper{
+		cdc:            cdc,
+		storeKey:       key,
+		transferKeeper: transferKeeper,
+		channelKeeper:  channelKeeper,
+		bankKeeper:     bankKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+host.ModuleName+"-"+types.ModuleName)
+}
+
+// GetInFlightPacket returns the in-flight packet forwarded with the given sequence on a
+// channel.
+func (k Keeper) GetInFlightPacket(ctx sdk.Context, portID, channelID string, sequence uint64) (types.InFlightPacket, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.InFlightPacketKey)
+	bz := store.Get(types.GetInFlightPacketKey(portID, channelID, sequence))
+	if bz == nil {
+		return types.InFlightPacket{}, false
+	}
+
+	var packet types.InFlightPacket
+	k.cdc.MustUnmarshalBinaryBare(bz, &packet)
+	return packet, true
+}
+
+// SetInFlightPacket stores an in-flight packet.
+func (k Keeper) SetInFlightPacket(ctx sdk.Context, packet types.InFlightPacket) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.InFlightPacketKey)
+	bz := k.cdc.MustMarshalBinaryBare(&packet)
+	store.Set(types.GetInFlightPacketKey(packet.ForwardPortId, packet.ForwardChannelId, packet.ForwardSequence), bz)
+}
+
+// DeleteInFlightPacket removes the in-flight packet forwarded with the given sequence on
+// a channel.
+func (k Keeper) DeleteInFlightPacket(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.InFlightPacketKey)
+	store.Delete(types.GetInFlightPacketKey(portID, channelID, sequence))
+}
+
+// IterateInFlightPackets iterates over all the in-flight packets. The iteration stops if
+// the callback returns true.
+func (k Keeper) IterateInFlightPackets(ctx sdk.Context, cb func(packet types.InFlightPacket) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.InFlightPacketKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var packet types.InFlightPacket
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &packet)
+		if cb(packet) {
+			break
+		}
+	}
+}
+
+// GetAllInFlightPackets returns all the in-flight packets.
+func (k Keeper) GetAllInFlightPackets(ctx sdk.Context) []types.InFlightPacket {
+	packets := []types.InFlightPacket{}
+	k.IterateInFlightPackets(ctx, func(packet types.InFlightPacket) bool {
+		packets = append(packets, packet)
+		return false
+	})
+	return packets
+}
@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	ocproto "github.com/line/ostracon/proto/ostracon/types"
+
+	"github.com/line/lbm-sdk/simapp"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/ibc/applications/packet-forward/types"
+	transfertypes "github.com/line/lbm-sdk/x/ibc/applications/transfer/types"
+	clienttypes "github.com/line/lbm-sdk/x/ibc/core/02-client/types"
+	channeltypes "github.com/line/lbm-sdk/x/ibc/core/04-channel/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app *simapp.SimApp
+	ctx sdk.Context
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app = simapp.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, ocproto.Header{})
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func newInFlightPacket(channelID string, sequence uint64) types.InFlightPacket {
+	data := transfertypes.NewFungibleTokenPacketData("stake", 1, "sender", "receiver", "")
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, "transfer", "channel-0", "transfer", "channel-1", clienttypes.NewHeight(0, 100), 0)
+	return types.NewInFlightPacket("transfer", channelID, sequence, packet)
+}
+
+func (suite *KeeperTestSuite) TestInFlightPackets() {
+	k := suite.app.PacketForwardKeeper
+
+	_, found := k.GetInFlightPacket(suite.ctx, "transfer", "channel-2", 1)
+	suite.Require().False(found)
+
+	packets := []types.InFlightPacket{
+		newInFlightPacket("channel-2", 1),
+		newInFlightPacket("channel-2", 2),
+		newInFlightPacket("channel-3", 1),
+	}
+	for _, packet := range packets {
+		k.SetInFlightPacket(suite.ctx, packet)
+	}
+
+	packet, found := k.GetInFlightPacket(suite.ctx, "transfer", "channel-2", 2)
+	suite.Require().True(found)
+	suite.Require().Equal(packets[1], packet)
+	suite.Require().Equal(packets, k.GetAllInFlightPackets(suite.ctx))
+
+	k.DeleteInFlightPacket(suite.ctx, "transfer", "channel-2", 2)
+	_, found = k.GetInFlightPacket(suite.ctx, "transfer", "channel-2", 2)
+	suite.Require().False(found)
+	suite.Require().Len(k.GetAllInFlightPackets(suite.ctx), 2)
+}
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	genState := types.NewGenesisState([]types.InFlightPacket{
+		newInFlightPacket("channel-2", 1),
+		newInFlightPacket("channel-3", 5),
+	})
+	suite.app.PacketForwardKeeper.InitGenesis(suite.ctx, *genState)
+	suite.Require().Equal(genState, suite.app.PacketForwardKeeper.ExportGenesis(suite.ctx))
+}
@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+// IBC packet forward sentinel errors
+var (
+	ErrInvalidForwardMetadata = sdkerrors.Register(ModuleName, 2, "invalid forward metadata")
+	ErrForwardFailed          = sdkerrors.Register(ModuleName, 3, "failed to forward packet")
+	ErrForwardTimeout         = sdkerrors.Register(ModuleName, 4, "forwarded packet timed out")
+)
@@ -0,0 +1,14 @@
+package types
+
+// IBC packet forward events
+const (
+	EventTypePacketForward       = "packet_forward"
+	EventTypePacketForwardResult = "packet_forward_result"
+
+	AttributeKeyForwardReceiver = "forward_receiver"
+	AttributeKeyForwardPort     = "forward_port"
+	AttributeKeyForwardChannel  = "forward_channel"
+	AttributeKeyForwardSequence = "forward_sequence"
+	AttributeKeyAckSuccess      = "success"
+	AttributeKeyAckError        = "error"
+)
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new ibc-packetforward GenesisState instance.
+func NewGenesisState(inFlightPackets []InFlightPacket) *GenesisState {
+	return &GenesisState{
+		InFlightPackets: inFlightPackets,
+	}
+}
+
+// DefaultGenesisState returns a GenesisState without in-flight packets.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		InFlightPackets: []InFlightPacket{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool)
+	for i, p := range gs.InFlightPackets {
+		if err := p.Validate(); err != nil {
+			return fmt.Errorf("invalid in-flight packet %d: %w", i, err)
+		}
+		key := string(GetInFlightPacketKey(p.ForwardPortId, p.ForwardChannelId, p.ForwardSequence))
+		if seen[key] {
+			return fmt.Errorf("duplicate in-flight packet %d on %s/%s", p.ForwardSequence, p.ForwardPortId, p.ForwardChannelId)
+		}
+		seen[key] = true
+	}
+	return nil
+}
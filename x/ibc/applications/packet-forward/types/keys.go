@@ -0,0 +1,40 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/line/lbm-sdk/types"
+	authtypes "github.com/line/lbm-sdk/x/auth/types"
+)
+
+const (
+	// ModuleName defines the IBC packet forward name
+	ModuleName = "packetforward"
+
+	// StoreKey is the store key string for IBC packet forward
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for IBC packet forward
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for IBC packet forward
+	QuerierRoute = ModuleName
+)
+
+var (
+	// InFlightPacketKey defines the key to store the in-flight packets in store
+	InFlightPacketKey = []byte{0x01}
+)
+
+// GetInFlightPacketKey returns the store key of the in-flight packet forwarded with the
+// given sequence on a channel, relative to InFlightPacketKey.
+func GetInFlightPacketKey(portID, channelID string, sequence uint64) []byte {
+	return append([]byte(fmt.Sprintf("%s/%s/", portID, channelID)), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// GetIntermediateReceiver returns the address which receives the tokens of a packet on
+// the given destination channel before they are forwarded. The address is derived from
+// the channel and the sender, so that the tokens of different senders are never mixed up.
+func GetIntermediateReceiver(channelID, sender string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(fmt.Sprintf("%s/%s/%s", ModuleName, channelID, sender))
+}
@@ -0,0 +1,157 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"time"
+
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	transfertypes "github.com/line/lbm-sdk/x/ibc/applications/transfer/types"
+	channeltypes "github.com/line/lbm-sdk/x/ibc/core/04-channel/types"
+	host "github.com/line/lbm-sdk/x/ibc/core/24-host"
+)
+
+// DefaultForwardTimeout is the timeout of a forwarded packet relative to the block time
+// when the forward metadata does not set one.
+const DefaultForwardTimeout = 10 * time.Minute
+
+// PacketMetadata defines the JSON memo of a transfer packet carrying a forwarding
+// instruction:
+//
+//	{"forward": {"receiver": "...", "port": "transfer", "channel": "channel-1", "timeout": "10m", "next": {...}}}
+type PacketMetadata struct {
+	Forward *ForwardMetadata `json:"forward"`
+}
+
+// ForwardMetadata defines where the tokens of a received transfer packet are sent on to.
+// Next is sent as the memo of the forwarded packet, e.g. to forward it once again.
+type ForwardMetadata struct {
+	Receiver string          `json:"receiver"`
+	Port     string          `json:"port"`
+	Channel  string          `json:"channel"`
+	Timeout  string          `json:"timeout,omitempty"`
+	Next     json.RawMessage `json:"next,omitempty"`
+}
+
+// ParseForwardMetadata returns the forward metadata of a transfer memo. It returns nil
+// without error if the memo is not a JSON object with a forward field, in which case the
+// packet is not forwarded.
+func ParseForwardMetadata(memo string) (*ForwardMetadata, error) {
+	if !strings.HasPrefix(strings.TrimSpace(memo), "{") {
+		return nil, nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &fields); err != nil {
+		return nil, nil
+	}
+	if _, ok := fields["forward"]; !ok {
+		return nil, nil
+	}
+
+	var metadata PacketMetadata
+	if err := json.Unmarshal([]byte(memo), &metadata); err != nil {
+		return nil, sdkerrors.Wrap(ErrInvalidForwardMetadata, err.Error())
+	}
+	if metadata.Forward == nil {
+		return nil, sdkerrors.Wrap(ErrInvalidForwardMetadata, "forward cannot be null")
+	}
+	if err := metadata.Forward.Validate(); err != nil {
+		return nil, err
+	}
+	return metadata.Forward, nil
+}
+
+// Validate performs a basic validation of the forward metadata
+func (m ForwardMetadata) Validate() error {
+	if strings.TrimSpace(m.Receiver) == "" {
+		return sdkerrors.Wrap(ErrInvalidForwardMetadata, "receiver cannot be blank")
+	}
+	if err := host.PortIdentifierValidator(m.Port); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidForwardMetadata, "invalid port: %s", err)
+	}
+	if err := host.ChannelIdentifierValidator(m.Channel); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidForwardMetadata, "invalid channel: %s", err)
+	}
+	if _, err := m.GetTimeout(); err != nil {
+		return err
+	}
+	if _, err := m.GetNextMemo(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetTimeout returns the timeout of the forwarded packet relative to the block time
+func (m ForwardMetadata) GetTimeout() (time.Duration, error) {
+	if m.Timeout == "" {
+		return DefaultForwardTimeout, nil
+	}
+	timeout, err := time.ParseDuration(m.Timeout)
+	if err != nil {
+		return 0, sdkerrors.Wrapf(ErrInvalidForwardMetadata, "invalid timeout: %s", err)
+	}
+	if timeout <= 0 {
+		return 0, sdkerrors.Wrapf(ErrInvalidForwardMetadata, "timeout must be positive, got %s", m.Timeout)
+	}
+	return timeout, nil
+}
+
+// GetNextMemo returns the memo of the forwarded packet. Next is either a JSON object,
+// which is sent in its compact form, or a JSON string.
+func (m ForwardMetadata) GetNextMemo() (string, error) {
+	next := bytes.TrimSpace(m.Next)
+	if len(next) == 0 || bytes.Equal(next, []byte("null")) {
+		return "", nil
+	}
+
+	var memo string
+	if err := json.Unmarshal(next, &memo); err == nil {
+		return memo, nil
+	}
+
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, next); err != nil || next[0] != '{' {
+		return "", sdkerrors.Wrap(ErrInvalidForwardMetadata, "next must be a JSON object or string")
+	}
+	return buf.String(), nil
+}
+
+// NewInFlightPacket creates a new InFlightPacket instance
+func NewInFlightPacket(forwardPortID, forwardChannelID string, forwardSequence uint64, packet channeltypes.Packet) InFlightPacket {
+	return InFlightPacket{
+		ForwardPortId:    forwardPortID,
+		ForwardChannelId: forwardChannelID,
+		ForwardSequence:  forwardSequence,
+		Packet:           packet,
+	}
+}
+
+// Validate performs a basic validation of the in-flight packet
+func (p InFlightPacket) Validate() error {
+	if err := host.PortIdentifierValidator(p.ForwardPortId); err != nil {
+		return err
+	}
+	if err := host.ChannelIdentifierValidator(p.ForwardChannelId); err != nil {
+		return err
+	}
+	if p.ForwardSequence == 0 {
+		return sdkerrors.Wrap(channeltypes.ErrInvalidPacket, "forward sequence cannot be 0")
+	}
+	return p.Packet.ValidateBasic()
+}
+
+// GetReceivedDenom returns the denomination on this chain of the tokens received with a
+// transfer packet. It mirrors the denomination handling of the transfer module.
+func GetReceivedDenom(packet channeltypes.Packet, denom string) string {
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), denom) {
+		// the tokens were unescrowed
+		voucherPrefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		return transfertypes.ParseDenomTrace(denom[len(voucherPrefix):]).IBCDenom()
+	}
+
+	// vouchers were minted
+	prefixedDenom := transfertypes.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel()) + denom
+	return transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+}
@@ -0,0 +1,476 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/packetforward/v1/packetforward.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/line/lbm-sdk/x/ibc/core/04-channel/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// InFlightPacket defines a received transfer packet whose tokens have been forwarded to
+// another chain. The acknowledgement of the received packet is written once the forwarded
+// packet is acknowledged or timed out.
+type InFlightPacket struct {
+	// the port on which the forwarded packet was sent
+	ForwardPortId string `protobuf:"bytes,1,opt,name=forward_port_id,json=forwardPortId,proto3" json:"forward_port_id,omitempty" yaml:"forward_port_id"`
+	// the channel on which the forwarded packet was sent
+	ForwardChannelId string `protobuf:"bytes,2,opt,name=forward_channel_id,json=forwardChannelId,proto3" json:"forward_channel_id,omitempty" yaml:"forward_channel_id"`
+	// the sequence of the forwarded packet
+	ForwardSequence uint64 `protobuf:"varint,3,opt,name=forward_sequence,json=forwardSequence,proto3" json:"forward_sequence,omitempty" yaml:"forward_sequence"`
+	// the received packet
+	Packet types.Packet `protobuf:"bytes,4,opt,name=packet,proto3" json:"packet"`
+}
+
+func (m *InFlightPacket) Reset()         { *m = InFlightPacket{} }
+func (m *InFlightPacket) String() string { return proto.CompactTextString(m) }
+func (*InFlightPacket) ProtoMessage()    {}
+func (*InFlightPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5037a5358f18ac2, []int{0}
+}
+func (m *InFlightPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InFlightPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InFlightPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InFlightPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InFlightPacket.Merge(m, src)
+}
+func (m *InFlightPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *InFlightPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_InFlightPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InFlightPacket proto.InternalMessageInfo
+
+func (m *InFlightPacket) GetForwardPortId() string {
+	if m != nil {
+		return m.ForwardPortId
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetForwardChannelId() string {
+	if m != nil {
+		return m.ForwardChannelId
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetForwardSequence() uint64 {
+	if m != nil {
+		return m.ForwardSequence
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetPacket() types.Packet {
+	if m != nil {
+		return m.Packet
+	}
+	return types.Packet{}
+}
+
+func init() {
+	proto.RegisterType((*InFlightPacket)(nil), "ibc.applications.packetforward.v1.InFlightPacket")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/packetforward/v1/packetforward.proto", fileDescriptor_a5037a5358f18ac2)
+}
+
+var fileDescriptor_a5037a5358f18ac2 = []byte{
+	// 352 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x4f, 0x4a, 0xfb, 0x40,
+	0x1c, 0xc5, 0x33, 0xfd, 0x95, 0xc2, 0x2f, 0xe2, 0x1f, 0x82, 0x68, 0x6d, 0x31, 0xa9, 0x59, 0x75,
+	0xd3, 0x19, 0xaa, 0xb8, 0xd0, 0x8d, 0x10, 0xa1, 0x50, 0xdc, 0x94, 0xb8, 0xd2, 0x4d, 0x49, 0x26,
+	0x63, 0x3a, 0x34, 0xcd, 0xc4, 0x64, 0x5a, 0xed, 0x2d, 0x3c, 0x84, 0x87, 0xe9, 0xb2, 0x4b, 0x57,
+	0x41, 0xda, 0x1b, 0xe4, 0x04, 0x92, 0x64, 0x06, 0x8d, 0xe0, 0x6e, 0x78, 0x7c, 0xde, 0xfb, 0x32,
+	0xef, 0xa9, 0x97, 0xd4, 0xc5, 0xc8, 0x89, 0xa2, 0x80, 0x62, 0x87, 0x53, 0x16, 0x26, 0x28, 0x72,
+	0xf0, 0x94, 0xf0, 0x27, 0x16, 0xbf, 0x38, 0xb1, 0x87, 0x16, 0xfd, 0xaa, 0x00, 0xa3, 0x98, 0x71,
+	0xa6, 0x9d, 0x51, 0x17, 0xc3, 0x9f, 0x36, 0x58, 0xa5, 0x16, 0xfd, 0xd6, 0xa1, 0xcf, 0x7c, 0x56,
+	0xd0, 0x28, 0x7f, 0x95, 0xc6, 0x56, 0x6e, 0x44, 0x98, 0xc5, 0x04, 0xe1, 0x89, 0x13, 0x86, 0x24,
+	0xc8, 0x2f, 0x88, 0x67, 0x89, 0x98, 0xef, 0x35, 0x75, 0x6f, 0x18, 0x0e, 0x02, 0xea, 0x4f, 0xf8,
+	0xa8, 0x48, 0xd5, 0x2c, 0x75, 0x5f, 0x24, 0x8f, 0x23, 0x16, 0xf3, 0x31, 0xf5, 0x9a, 0xa0, 0x03,
+	0xba, 0xff, 0xad, 0x56, 0x96, 0x1a, 0x47, 0x4b, 0x67, 0x16, 0x5c, 0x9b, 0xbf, 0x00, 0xd3, 0xde,
+	0x15, 0xca, 0x88, 0xc5, 0x7c, 0xe8, 0x69, 0x77, 0xaa, 0x26, 0x11, 0x71, 0x2f, 0x8f, 0xa9, 0x15,
+	0x31, 0xa7, 0x59, 0x6a, 0x9c, 0x54, 0x63, 0xbe, 0x19, 0xd3, 0x3e, 0x10, 0xe2, 0x6d, 0xa9, 0x0d,
+	0x3d, 0x6d, 0xa0, 0x4a, 0x6d, 0x9c, 0x90, 0xe7, 0x39, 0x09, 0x31, 0x69, 0xfe, 0xeb, 0x80, 0x6e,
+	0xdd, 0x6a, 0x67, 0xa9, 0x71, 0x5c, 0x8d, 0x92, 0x84, 0x69, 0xcb, 0x5f, 0xdc, 0x0b, 0x45, 0xbb,
+	0x52, 0x1b, 0x65, 0x71, 0xcd, 0x7a, 0x07, 0x74, 0x77, 0xce, 0xdb, 0x30, 0x2f, 0x36, 0xef, 0x07,
+	0xca, 0x52, 0x16, 0x7d, 0x58, 0xb6, 0x60, 0xd5, 0x57, 0xa9, 0xa1, 0xd8, 0xc2, 0x60, 0x3d, 0xac,
+	0x36, 0x3a, 0x58, 0x6f, 0x74, 0xf0, 0xb9, 0xd1, 0xc1, 0xdb, 0x56, 0x57, 0xd6, 0x5b, 0x5d, 0xf9,
+	0xd8, 0xea, 0xca, 0xe3, 0x8d, 0x4f, 0xf9, 0x64, 0xee, 0x42, 0xcc, 0x66, 0x28, 0xa0, 0x21, 0x41,
+	0x81, 0x3b, 0xeb, 0x25, 0xde, 0x14, 0xbd, 0xa2, 0x3f, 0xd6, 0xee, 0xc9, 0xb9, 0xf9, 0x32, 0x22,
+	0x89, 0xdb, 0x28, 0x86, 0xb8, 0xf8, 0x1a, 0x00, 0xb7, 0xac, 0xdc, 0x24, 0x1d, 0x02, 0x00, 0x00,
+}
+
+func (m *InFlightPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InFlightPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InFlightPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPacketforward(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ForwardSequence != 0 {
+		i = encodeVarintPacketforward(dAtA, i, uint64(m.ForwardSequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ForwardChannelId) > 0 {
+		i -= len(m.ForwardChannelId)
+		copy(dAtA[i:], m.ForwardChannelId)
+		i = encodeVarintPacketforward(dAtA, i, uint64(len(m.ForwardChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ForwardPortId) > 0 {
+		i -= len(m.ForwardPortId)
+		copy(dAtA[i:], m.ForwardPortId)
+		i = encodeVarintPacketforward(dAtA, i, uint64(len(m.ForwardPortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPacketforward(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPacketforward(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InFlightPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ForwardPortId)
+	if l > 0 {
+		n += 1 + l + sovPacketforward(uint64(l))
+	}
+	l = len(m.ForwardChannelId)
+	if l > 0 {
+		n += 1 + l + sovPacketforward(uint64(l))
+	}
+	if m.ForwardSequence != 0 {
+		n += 1 + sovPacketforward(uint64(m.ForwardSequence))
+	}
+	l = m.Packet.Size()
+	n += 1 + l + sovPacketforward(uint64(l))
+	return n
+}
+
+func sovPacketforward(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPacketforward(x uint64) (n int) {
+	return sovPacketforward(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacketforward
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InFlightPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InFlightPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardSequence", wireType)
+			}
+			m.ForwardSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForwardSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacketforward(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacketforward
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPacketforward(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPacketforward
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacketforward
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPacketforward
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPacketforward
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPacketforward
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPacketforward        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPacketforward          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPacketforward = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,126 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/x/ibc/applications/packet-forward/types"
+	transfertypes "github.com/line/lbm-sdk/x/ibc/applications/transfer/types"
+	clienttypes "github.com/line/lbm-sdk/x/ibc/core/02-client/types"
+	channeltypes "github.com/line/lbm-sdk/x/ibc/core/04-channel/types"
+)
+
+func TestParseForwardMetadata(t *testing.T) {
+	testCases := []struct {
+		name       string
+		memo       string
+		expForward bool
+		expPass    bool
+	}{
+		{"empty memo", "", false, true},
+		{"plain memo", "hello", false, true},
+		{"not a JSON object", "[1, 2]", false, true},
+		{"invalid JSON", "{", false, true},
+		{"no forward", `{"wasm":{}}`, false, true},
+		{"valid forward", `{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-1"}}`, true, true},
+		{"valid forward with timeout", `{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-1","timeout":"1h"}}`, true, true},
+		{"valid forward with next", `{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-1","next":{"forward":{}}}}`, true, true},
+		{"null forward", `{"forward":null}`, false, false},
+		{"invalid forward", `{"forward":"channel-1"}`, false, false},
+		{"blank receiver", `{"forward":{"receiver":" ","port":"transfer","channel":"channel-1"}}`, false, false},
+		{"invalid port", `{"forward":{"receiver":"cosmos1","port":"","channel":"channel-1"}}`, false, false},
+		{"invalid channel", `{"forward":{"receiver":"cosmos1","port":"transfer","channel":"c"}}`, false, false},
+		{"invalid timeout", `{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-1","timeout":"1x"}}`, false, false},
+		{"negative timeout", `{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-1","timeout":"-1h"}}`, false, false},
+		{"invalid next", `{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-1","next":1}}`, false, false},
+	}
+
+	for _, tc := range testCases {
+		metadata, err := types.ParseForwardMetadata(tc.memo)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, types.ErrInvalidForwardMetadata, tc.name)
+		}
+		require.Equal(t, tc.expForward, metadata != nil, tc.name)
+	}
+}
+
+func TestForwardMetadataTimeoutAndNext(t *testing.T) {
+	metadata, err := types.ParseForwardMetadata(`{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-1"}}`)
+	require.NoError(t, err)
+	timeout, _ := metadata.GetTimeout()
+	require.Equal(t, types.DefaultForwardTimeout, timeout)
+	next, _ := metadata.GetNextMemo()
+	require.Equal(t, "", next)
+
+	metadata, err = types.ParseForwardMetadata(`{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-1","timeout":"30s",
+		"next": {"forward": {"receiver": "cosmos2", "port": "transfer", "channel": "channel-2"}}}}`)
+	require.NoError(t, err)
+	timeout, _ = metadata.GetTimeout()
+	require.Equal(t, 30*time.Second, timeout)
+	next, _ = metadata.GetNextMemo()
+	require.Equal(t, `{"forward":{"receiver":"cosmos2","port":"transfer","channel":"channel-2"}}`, next)
+
+	metadata, err = types.ParseForwardMetadata(`{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-1","next":"a plain memo"}}`)
+	require.NoError(t, err)
+	next, _ = metadata.GetNextMemo()
+	require.Equal(t, "a plain memo", next)
+}
+
+func TestGetReceivedDenom(t *testing.T) {
+	newPacket := func(denom string) channeltypes.Packet {
+		data := transfertypes.NewFungibleTokenPacketData(denom, 1, "sender", "receiver", "")
+		return channeltypes.NewPacket(data.GetBytes(), 1, "transfer", "channel-0", "transfer", "channel-1", clienttypes.NewHeight(0, 100), 0)
+	}
+
+	// tokens of the sender chain are received as vouchers
+	denom := types.GetReceivedDenom(newPacket("stake"), "stake")
+	require.Equal(t, transfertypes.ParseDenomTrace("transfer/channel-1/stake").IBCDenom(), denom)
+
+	// vouchers returning to their source chain are unescrowed
+	require.Equal(t, "stake", types.GetReceivedDenom(newPacket("transfer/channel-0/stake"), "transfer/channel-0/stake"))
+	denom = types.GetReceivedDenom(newPacket("transfer/channel-0/transfer/channel-5/stake"), "transfer/channel-0/transfer/channel-5/stake")
+	require.Equal(t, transfertypes.ParseDenomTrace("transfer/channel-5/stake").IBCDenom(), denom)
+}
+
+func TestGenesisValidate(t *testing.T) {
+	data := transfertypes.NewFungibleTokenPacketData("stake", 1, "sender", "receiver", "")
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, "transfer", "channel-0", "transfer", "channel-1", clienttypes.NewHeight(0, 100), 0)
+
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expPass  bool
+	}{
+		{"default", types.DefaultGenesisState(), true},
+		{"valid genesis", types.NewGenesisState([]types.InFlightPacket{
+			types.NewInFlightPacket("transfer", "channel-2", 1, packet),
+			types.NewInFlightPacket("transfer", "channel-2", 2, packet),
+		}), true},
+		{"duplicate in-flight packet", types.NewGenesisState([]types.InFlightPacket{
+			types.NewInFlightPacket("transfer", "channel-2", 1, packet),
+			types.NewInFlightPacket("transfer", "channel-2", 1, packet),
+		}), false},
+		{"invalid forward channel", types.NewGenesisState([]types.InFlightPacket{
+			types.NewInFlightPacket("transfer", "c", 1, packet),
+		}), false},
+		{"zero forward sequence", types.NewGenesisState([]types.InFlightPacket{
+			types.NewInFlightPacket("transfer", "channel-2", 0, packet),
+		}), false},
+		{"invalid packet", types.NewGenesisState([]types.InFlightPacket{
+			types.NewInFlightPacket("transfer", "channel-2", 1, channeltypes.Packet{}),
+		}), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/line/lbm-sdk/client"
+	"github.com/line/lbm-sdk/client/tx"
+	sdk "github.com/line/lbm-sdk/types"
+	govcli "github.com/line/lbm-sdk/x/gov/client/cli"
+	govtypes "github.com/line/lbm-sdk/x/gov/types"
+	"github.com/line/lbm-sdk/x/ibc/applications/ratelimit/types"
+)
+
+const (
+	FlagMaxPercentSend = "max-percent-send"
+	FlagMaxPercentRecv = "max-percent-recv"
+	FlagDuration       = "duration"
+)
+
+// NewCmdSubmitAddRateLimitProposal implements a command handler for submitting an add rate limit proposal transaction.
+func NewCmdSubmitAddRateLimitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-rate-limit [channel-id] [denom] [flags]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to rate limit the transfers of a denomination on a channel",
+		Long: "Submit a proposal to rate limit the transfers of a denomination on a channel along with an initial deposit.\n" +
+			"The net flow in each direction within a window is limited to a percentage of the supply of the denomination.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			quota, err := parseQuota(cmd)
+			if err != nil {
+				return err
+			}
+			return submitProposal(cmd, func(title, description string) govtypes.Content {
+				return types.NewAddRateLimitProposal(title, description, args[1], args[0], quota)
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+	addQuotaFlags(cmd)
+	return cmd
+}
+
+// NewCmdSubmitUpdateRateLimitProposal implements a command handler for submitting an update rate limit proposal transaction.
+func NewCmdSubmitUpdateRateLimitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-rate-limit [channel-id] [denom] [flags]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to replace the quota of a rate limit",
+		Long:  "Submit a proposal to replace the quota of a rate limit along with an initial deposit. The flow is reset.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			quota, err := parseQuota(cmd)
+			if err != nil {
+				return err
+			}
+			return submitProposal(cmd, func(title, description string) govtypes.Content {
+				return types.NewUpdateRateLimitProposal(title, description, args[1], args[0], quota)
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+	addQuotaFlags(cmd)
+	return cmd
+}
+
+// NewCmdSubmitRemoveRateLimitProposal implements a command handler for submitting a remove rate limit proposal transaction.
+func NewCmdSubmitRemoveRateLimitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-rate-limit [channel-id] [denom] [flags]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to remove a rate limit",
+		Long:  "Submit a proposal to remove a rate limit along with an initial deposit.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(title, description string) govtypes.Content {
+				return types.NewRemoveRateLimitProposal(title, description, args[1], args[0])
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// NewCmdSubmitResetRateLimitProposal implements a command handler for submitting a reset rate limit proposal transaction.
+func NewCmdSubmitResetRateLimitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reset-rate-limit [channel-id] [denom] [flags]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to reset the flow of a rate limit",
+		Long:  "Submit a proposal to reset the flow of a rate limit along with an initial deposit. A new window starts.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(title, description string) govtypes.Content {
+				return types.NewResetRateLimitProposal(title, description, args[1], args[0])
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.MarkFlagRequired(govcli.FlagTitle)
+	cmd.MarkFlagRequired(govcli.FlagDescription)
+}
+
+func addQuotaFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint32(FlagMaxPercentSend, 0, "The max net outflow within a window in percent of the channel value")
+	cmd.Flags().Uint32(FlagMaxPercentRecv, 0, "The max net inflow within a window in percent of the channel value")
+	cmd.Flags().Duration(FlagDuration, 0, "The length of a window (ex. 24h)")
+	cmd.MarkFlagRequired(FlagDuration)
+}
+
+func parseQuota(cmd *cobra.Command) (types.Quota, error) {
+	maxPercentSend, err := cmd.Flags().GetUint32(FlagMaxPercentSend)
+	if err != nil {
+		return types.Quota{}, err
+	}
+
+	maxPercentRecv, err := cmd.Flags().GetUint32(FlagMaxPercentRecv)
+	if err != nil {
+		return types.Quota{}, err
+	}
+
+	duration, err := cmd.Flags().GetDuration(FlagDuration)
+	if err != nil {
+		return types.Quota{}, err
+	}
+
+	return types.NewQuota(maxPercentSend, maxPercentRecv, duration), nil
+}
+
+func submitProposal(cmd *cobra.Command, newContent func(title, description string) govtypes.Content) error {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return err
+	}
+
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return err
+	}
+
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return err
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(newContent(title, description), deposit, clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+
+	if err = msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
@@ -0,0 +1,18 @@
+package client
+
+import (
+	govclient "github.com/line/lbm-sdk/x/gov/client"
+	"github.com/line/lbm-sdk/x/ibc/applications/ratelimit/client/cli"
+	"github.com/line/lbm-sdk/x/ibc/applications/ratelimit/client/rest"
+)
+
+var (
+	// AddRateLimitProposalHandler is the add rate limit proposal handler.
+	AddRateLimitProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitAddRateLimitProposal, rest.AddRateLimitProposalRESTHandler)
+	// UpdateRateLimitProposalHandler is the update rate limit proposal handler.
+	UpdateRateLimitProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitUpdateRateLimitProposal, rest.UpdateRateLimitProposalRESTHandler)
+	// RemoveRateLimitProposalHandler is the remove rate limit proposal handler.
+	RemoveRateLimitProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitRemoveRateLimitProposal, rest.RemoveRateLimitProposalRESTHandler)
+	// ResetRateLimitProposalHandler is the reset rate limit proposal handler.
+	ResetRateLimitProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitResetRateLimitProposal, rest.ResetRateLimitProposalRESTHandler)
+)
@@ -0,0 +1,114 @@
+package rest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/line/lbm-sdk/client"
+	"github.com/line/lbm-sdk/client/tx"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/types/rest"
+	govrest "github.com/line/lbm-sdk/x/gov/client/rest"
+	govtypes "github.com/line/lbm-sdk/x/gov/types"
+	"github.com/line/lbm-sdk/x/ibc/applications/ratelimit/types"
+)
+
+// RateLimitProposalReq defines a rate limit proposal request body.
+type RateLimitProposalReq struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	Deposit     sdk.Coins    `json:"deposit" yaml:"deposit"`
+	ChannelID   string       `json:"channel_id" yaml:"channel_id"`
+	Denom       string       `json:"denom" yaml:"denom"`
+}
+
+// QuotaProposalReq defines a rate limit proposal request body setting a quota.
+type QuotaProposalReq struct {
+	RateLimitProposalReq
+	MaxPercentSend uint32 `json:"max_percent_send" yaml:"max_percent_send"`
+	MaxPercentRecv uint32 `json:"max_percent_recv" yaml:"max_percent_recv"`
+	// Duration is the length of a window (ex. 24h)
+	Duration string `json:"duration" yaml:"duration"`
+}
+
+func AddRateLimitProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "add_rate_limit",
+		Handler: newPostQuotaProposalHandler(clientCtx, func(req RateLimitProposalReq, quota types.Quota) govtypes.Content {
+			return types.NewAddRateLimitProposal(req.Title, req.Description, req.Denom, req.ChannelID, quota)
+		}),
+	}
+}
+
+func UpdateRateLimitProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "update_rate_limit",
+		Handler: newPostQuotaProposalHandler(clientCtx, func(req RateLimitProposalReq, quota types.Quota) govtypes.Content {
+			return types.NewUpdateRateLimitProposal(req.Title, req.Description, req.Denom, req.ChannelID, quota)
+		}),
+	}
+}
+
+func RemoveRateLimitProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "remove_rate_limit",
+		Handler: newPostProposalHandler(clientCtx, func(req RateLimitProposalReq) govtypes.Content {
+			return types.NewRemoveRateLimitProposal(req.Title, req.Description, req.Denom, req.ChannelID)
+		}),
+	}
+}
+
+func ResetRateLimitProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "reset_rate_limit",
+		Handler: newPostProposalHandler(clientCtx, func(req RateLimitProposalReq) govtypes.Content {
+			return types.NewResetRateLimitProposal(req.Title, req.Description, req.Denom, req.ChannelID)
+		}),
+	}
+}
+
+func newPostQuotaProposalHandler(clientCtx client.Context, newContent func(RateLimitProposalReq, types.Quota) govtypes.Content) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req QuotaProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		duration, err := time.ParseDuration(req.Duration)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		quota := types.NewQuota(req.MaxPercentSend, req.MaxPercentRecv, duration)
+		writeProposalTx(clientCtx, w, req.RateLimitProposalReq, newContent(req.RateLimitProposalReq, quota))
+	}
+}
+
+func newPostProposalHandler(clientCtx client.Context, newContent func(RateLimitProposalReq) govtypes.Content) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RateLimitProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		writeProposalTx(clientCtx, w, req, newContent(req))
+	}
+}
+
+func writeProposalTx(clientCtx client.Context, w http.ResponseWriter, req RateLimitProposalReq, content govtypes.Content) {
+	req.BaseReq = req.BaseReq.Sanitize()
+	if !req.BaseReq.ValidateBasic(w) {
+		return
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, sdk.AccAddress(req.BaseReq.From))
+	if rest.CheckBadRequestError(w, err) {
+		return
+	}
+	if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+		return
+	}
+
+	tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+}
@@ -0,0 +1,166 @@
+package ratelimit
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	capabilitytypes "github.com/line/lbm-sdk/x/capability/types"
+	"github.com/line/lbm-sdk/x/ibc/applications/ratelimit/keeper"
+	transfertypes "github.com/line/lbm-sdk/x/ibc/applications/transfer/types"
+	channeltypes "github.com/line/lbm-sdk/x/ibc/core/04-channel/types"
+	porttypes "github.com/line/lbm-sdk/x/ibc/core/05-port/types"
+)
+
+var _ porttypes.IBCModule = IBCMiddleware{}
+
+// IBCMiddleware implements the ICS-26 callbacks of the transfer application wrapped by
+// the rate limits. Received packets exceeding the recv quota are acknowledged with an
+// error, and the outflow of sent packets is reverted when they fail. Sent packets are
+// checked against the send quota by the keeper, which wraps the channel keeper of the
+// transfer keeper.
+type IBCMiddleware struct {
+	app    porttypes.IBCModule
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware wrapping the given transfer application
+func NewIBCMiddleware(app porttypes.IBCModule, k keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		app:    app,
+		keeper: k,
+	}
+}
+
+// OnChanOpenInit implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) error {
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version)
+}
+
+// OnChanOpenTry implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version, counterpartyVersion)
+}
+
+// OnChanOpenAck implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCModule interface
+func (im IBCMiddleware) OnChanCloseInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm implements the IBCModule interface
+func (im IBCMiddleware) OnChanCloseConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnRecvPacket implements the IBCModule interface. The packet is acknowledged with an
+// error if it exceeds the recv quota, and only counted in the inflow if the wrapped
+// application received it successfully.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+) (*sdk.Result, []byte, error) {
+	rateLimit, err := im.keeper.CheckInflow(ctx, packet)
+	if err != nil {
+		acknowledgement := channeltypes.NewErrorAcknowledgement(err.Error())
+		return &sdk.Result{
+			Events: ctx.EventManager().Events().ToABCIEvents(),
+		}, acknowledgement.GetBytes(), nil
+	}
+
+	res, ack, err := im.app.OnRecvPacket(ctx, packet)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if rateLimit != nil && isSuccessAcknowledgement(ack) {
+		im.keeper.SetRateLimit(ctx, *rateLimit)
+	}
+	return res, ack, nil
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. The outflow of the packet
+// is reverted if the counterparty failed to receive it.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+) (*sdk.Result, error) {
+	res, err := im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement)
+	if err != nil {
+		return nil, err
+	}
+
+	if isSuccessAcknowledgement(acknowledgement) {
+		im.keeper.OnSendPacketSucceeded(ctx, packet)
+	} else {
+		im.keeper.OnSendPacketFailed(ctx, packet)
+	}
+	return res, nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface. The outflow of the packet is
+// reverted.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+) (*sdk.Result, error) {
+	res, err := im.app.OnTimeoutPacket(ctx, packet)
+	if err != nil {
+		return nil, err
+	}
+
+	im.keeper.OnSendPacketFailed(ctx, packet)
+	return res, nil
+}
+
+func isSuccessAcknowledgement(bz []byte) bool {
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(bz, &ack); err != nil {
+		return false
+	}
+	_, ok := ack.Response.(*channeltypes.Acknowledgement_Result)
+	return ok
+}
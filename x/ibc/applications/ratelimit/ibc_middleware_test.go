@@ -0,0 +1,142 @@
+package ratelimit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/ibc/applications/ratelimit"
+	"github.com/line/lbm-sdk/x/ibc/applications/ratelimit/types"
+	"github.com/line/lbm-sdk/x/ibc/applications/transfer"
+	transfertypes "github.com/line/lbm-sdk/x/ibc/applications/transfer/types"
+	clienttypes "github.com/line/lbm-sdk/x/ibc/core/02-client/types"
+	channeltypes "github.com/line/lbm-sdk/x/ibc/core/04-channel/types"
+	"github.com/line/lbm-sdk/x/ibc/core/exported"
+	ibctesting "github.com/line/lbm-sdk/x/ibc/testing"
+)
+
+type MiddlewareTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	channelA ibctesting.TestChannel
+	channelB ibctesting.TestChannel
+
+	middleware ratelimit.IBCMiddleware
+}
+
+func (suite *MiddlewareTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(0))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+
+	_, _, connA, connB := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Ostracon)
+	suite.channelA, suite.channelB = suite.coordinator.CreateTransferChannels(suite.chainA, suite.chainB, connA, connB, channeltypes.UNORDERED)
+
+	suite.middleware = ratelimit.NewIBCMiddleware(transfer.NewAppModule(suite.chainA.App.TransferKeeper), suite.chainA.App.RateLimitKeeper)
+}
+
+func TestMiddlewareTestSuite(t *testing.T) {
+	suite.Run(t, new(MiddlewareTestSuite))
+}
+
+// sendToChainB escrows the amount of stake on chainA and returns the sent packet.
+func (suite *MiddlewareTestSuite) sendToChainB(amount uint64) channeltypes.Packet {
+	ctx := suite.chainA.GetContext()
+	sequence, _ := suite.chainA.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(ctx, suite.channelA.PortID, suite.channelA.ID)
+	err := suite.chainA.App.TransferKeeper.SendTransfer(
+		ctx, suite.channelA.PortID, suite.channelA.ID, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewIntFromUint64(amount)),
+		suite.chainA.SenderAccount.GetAddress(), suite.chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0, "",
+	)
+	suite.Require().NoError(err)
+
+	data := transfertypes.NewFungibleTokenPacketData(sdk.DefaultBondDenom, amount, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), "")
+	return channeltypes.NewPacket(data.GetBytes(), sequence, suite.channelA.PortID, suite.channelA.ID, suite.channelB.PortID, suite.channelB.ID, clienttypes.NewHeight(0, 110), 0)
+}
+
+// returnFromChainB returns a packet sending the stake vouchers of chainB back to chainA.
+func (suite *MiddlewareTestSuite) returnFromChainB(amount uint64) channeltypes.Packet {
+	denom := transfertypes.GetPrefixedDenom(suite.channelB.PortID, suite.channelB.ID, sdk.DefaultBondDenom)
+	data := transfertypes.NewFungibleTokenPacketData(denom, amount, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), "")
+	return channeltypes.NewPacket(data.GetBytes(), 1, suite.channelB.PortID, suite.channelB.ID, suite.channelA.PortID, suite.channelA.ID, clienttypes.NewHeight(0, 110), 0)
+}
+
+func (suite *MiddlewareTestSuite) addRateLimit(quota types.Quota) sdk.Int {
+	ctx := suite.chainA.GetContext()
+	p := types.NewAddRateLimitProposal("title", "description", sdk.DefaultBondDenom, suite.channelA.ID, quota)
+	suite.Require().NoError(suite.chainA.App.RateLimitKeeper.AddRateLimitProposal(ctx, p))
+
+	rateLimit, _ := suite.chainA.App.RateLimitKeeper.GetRateLimit(ctx, suite.channelA.ID, sdk.DefaultBondDenom)
+	return rateLimit.Flow.ChannelValue
+}
+
+func (suite *MiddlewareTestSuite) TestOnRecvPacket() {
+	suite.sendToChainB(20_000_000_000_000)
+	channelValue := suite.addRateLimit(types.NewQuota(100, 10, time.Hour))
+	threshold := channelValue.QuoRaw(10).Uint64()
+
+	testCases := []struct {
+		msg     string
+		amount  uint64
+		expPass bool
+	}{
+		{"quota exceeded", threshold + 1, false},
+		{"success", threshold, true},
+		{"quota exceeded after receive", 1, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			ctx := suite.chainA.GetContext()
+			_, ackBz, err := suite.middleware.OnRecvPacket(ctx, suite.returnFromChainB(tc.amount))
+			suite.Require().NoError(err)
+
+			var ack channeltypes.Acknowledgement
+			suite.Require().NoError(transfertypes.ModuleCdc.UnmarshalJSON(ackBz, &ack))
+			suite.Require().Equal(tc.expPass, ack.GetError() == "")
+
+			rateLimit, _ := suite.chainA.App.RateLimitKeeper.GetRateLimit(ctx, suite.channelA.ID, sdk.DefaultBondDenom)
+			if tc.expPass {
+				suite.Require().Equal(sdk.NewIntFromUint64(tc.amount), rateLimit.Flow.Inflow)
+			} else {
+				suite.Require().True(rateLimit.Flow.Inflow.LTE(sdk.NewIntFromUint64(threshold)))
+			}
+		})
+	}
+}
+
+func (suite *MiddlewareTestSuite) TestOnAcknowledgementPacket() {
+	suite.addRateLimit(types.NewQuota(10, 10, time.Hour))
+	succeeded := suite.sendToChainB(100)
+	failed := suite.sendToChainB(50)
+
+	ctx := suite.chainA.GetContext()
+	_, err := suite.middleware.OnAcknowledgementPacket(ctx, succeeded, channeltypes.NewResultAcknowledgement([]byte{byte(1)}).GetBytes())
+	suite.Require().NoError(err)
+	_, err = suite.middleware.OnAcknowledgementPacket(ctx, failed, channeltypes.NewErrorAcknowledgement("failed").GetBytes())
+	suite.Require().NoError(err)
+
+	rateLimit, _ := suite.chainA.App.RateLimitKeeper.GetRateLimit(ctx, suite.channelA.ID, sdk.DefaultBondDenom)
+	suite.Require().Equal(sdk.NewInt(100), rateLimit.Flow.Outflow)
+	suite.Require().Empty(suite.chainA.App.RateLimitKeeper.GetAllPendingSendPackets(ctx))
+}
+
+func (suite *MiddlewareTestSuite) TestOnTimeoutPacket() {
+	suite.addRateLimit(types.NewQuota(10, 10, time.Hour))
+	packet := suite.sendToChainB(100)
+
+	ctx := suite.chainA.GetContext()
+	_, err := suite.middleware.OnTimeoutPacket(ctx, packet)
+	suite.Require().NoError(err)
+
+	rateLimit, _ := suite.chainA.App.RateLimitKeeper.GetRateLimit(ctx, suite.channelA.ID, sdk.DefaultBondDenom)
+	suite.Require().True(rateLimit.Flow.Outflow.IsZero())
+	suite.Require().Empty(suite.chainA.App.RateLimitKeeper.GetAllPendingSendPackets(ctx))
+}
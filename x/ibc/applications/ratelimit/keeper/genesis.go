@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/ibc/applications/ratelimit/types"
+)
+
+// InitGenesis initializes the ibc-ratelimit state.
+func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
+	for _, rateLimit := range state.RateLimits {
+		k.SetRateLimit(ctx, rateLimit)
+	}
+	for _, packet := range state.PendingSendPackets {
+		k.SetPendingSendPacket(ctx, packet)
+	}
+}
+
+// ExportGenesis exports the rate limits and the pending send packets of the ibc-ratelimit
+// module into its genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{
+		RateLimits:         k.GetAllRateLimits(ctx),
+		PendingSendPackets: k.GetAllPendingSendPackets(ctx),
+	}
+}
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/line/lbm-sdk/store/prefix"
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/types/query"
+	"github.com/line/lbm-sdk/x/ibc/applications/ratelimit/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// RateLimit implements the Query/RateLimit gRPC method
+func (q Keeper) RateLimit(c context.Context, req *types.QueryRateLimitRequest) (*types.QueryRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	rateLimit, found := q.GetRateLimit(ctx, req.ChannelId, req.Denom)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrRateLimitNotFound, "denom %s on channel %s", req.Denom, req.ChannelId).Error(),
+		)
+	}
+
+	return &types.QueryRateLimitResponse{
+		RateLimit: &rateLimit,
+	}, nil
+}
+
+// RateLimits implements the Query/RateLimits gRPC method
+func (q Keeper) RateLimits(c context.Context, req *types.QueryRateLimitsRequest) (*types.QueryRateLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	rateLimits := []types.RateLimit{}
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.RateLimitKey)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var rateLimit types.RateLimit
+		if err := q.cdc.UnmarshalBinaryBare(value, &rateLimit); err != nil {
+			return err
+		}
+
+		rateLimits = append(rateLimits, rateLimit)
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRateLimitsResponse{
+		RateLimits: rateLimits,
+		Pagination: pageRes,
+	}, nil
+}
@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"github.com/line/ostracon/libs/log"
+
+	"github.com/line/lbm-sdk/codec"
+	"github.com/line/lbm-sdk/store/prefix"
+	sdk "github.com/line/lbm-sdk/types"
+	capabilitytypes "github.com/line/lbm-sdk/x/capability/types"
+	"github.com/line/lbm-sdk/x/ibc/applications/ratelimit/types"
+	channeltypes "github.com/line/lbm-sdk/x/ibc/core/04-channel/types"
+	host "github.com/line/lbm-sdk/x/ibc/core/24-host"
+	ibcexported "github.com/line/lbm-sdk/x/ibc/core/exported"
+)
+
+// Keeper defines the IBC rate limit keeper. It wraps the channel keeper used by the
+// transfer module to count outgoing transfers.
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      codec.BinaryMarshaler
+
+	channelKeeper types.ChannelKeeper
+	bankKeeper    types.BankKeeper
+}
+
+// NewKeeper creates a new IBC rate limit Keeper instance
+func NewKeeper(
+	cdc codec.BinaryMarshaler, key sdk.StoreKey,
+	channelKeeper types.ChannelKeeper, bankKeeper types.BankKeeper,
+) Keeper {
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      key,
+		channelKeeper: channelKeeper,
+		bankKeeper:    bankKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+host.ModuleName+"-"+types.ModuleName)
+}
+
+// GetChannel implements the transfer ChannelKeeper interface
+func (k Keeper) GetChannel(ctx sdk.Context, srcPort, srcChan string) (channeltypes.Channel, bool) {
+	return k.channelKeeper.GetChannel(ctx, srcPort, srcChan)
+}
+
+// GetNextSequenceSend implements the transfer ChannelKeeper interface
+func (k Keeper) GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (uint64, bool) {
+	return k.channelKeeper.GetNextSequenceSend(ctx, portID, channelID)
+}
+
+// ChanCloseInit implements the transfer ChannelKeeper interface
+func (k Keeper) ChanCloseInit(ctx sdk.Context, portID, channelID string, chanCap *capabilitytypes.Capability) error {
+	return k.channelKeeper.ChanCloseInit(ctx, portID, channelID, chanCap)
+}
+
+// SendPacket implements the transfer ChannelKeeper interface. The packet is rejected if
+// it exceeds the send quota of its denomination on the source channel.
+func (k Keeper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI) error {
+	if err := k.checkOutflow(ctx, packet); err != nil {
+		return err
+	}
+	return k.channelKeeper.SendPacket(ctx, chanCap, packet)
+}
+
+// GetRateLimit returns the rate limit of a denomination on a channel.
+func (k Keeper) GetRateLimit(ctx sdk.Context, channelID, denom string) (types.RateLimit, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitKey)
+	bz := store.Get(types.GetRateLimitKey(channelID, denom))
+	if bz == nil {
+		return types.RateLimit{}, false
+	}
+
+	var rateLimit types.RateLimit
+	k.cdc.MustUnmarshalBinaryBare(bz, &rateLimit)
+	return rateLimit, true
+}
+
+// SetRateLimit sets a rate limit in the store.
+func (k Keeper) SetRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitKey)
+	bz := k.cdc.MustMarshalBinaryBare(&rateLimit)
+	store.Set(types.GetRateLimitKey(rateLimit.ChannelId, rateLimit.Denom), bz)
+}
+
+// DeleteRateLimit removes a rate limit and its pending send packets from the store.
+func (k Keeper) DeleteRateLimit(ctx sdk.Context, channelID, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitKey)
+	store.Delete(types.GetRateLimitKey(channelID, denom))
+	k.deletePendingSendPackets(ctx, channelID, denom)
+}
+
+// IterateRateLimits iterates over all the rate limits in the store and performs a
+// callback function.
+func (k Keeper) IterateRateLimits(ctx sdk.Context, cb func(rateLimit types.RateLimit) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.RateLimitKey)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var rateLimit types.RateLimit
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &rateLimit)
+
+		if cb(rateLimit) {
+			break
+		}
+	}
+}
+
+// GetAllRateLimits returns all the rate limits.
+func (k Keeper) GetAllRateLimits(ctx sdk.Context) []types.RateLimit {
+	rateLimits := []types.RateLimit{}
+	k.IterateRateLimits(ctx, func(rateLimit types.RateLimit) bool {
+		rateLimits = append(rateLimits, rateLimit)
+		return false
+	})
+	return rateLimits
+}
+
+// GetPendingSendPacket returns a packet counted in the outflow which has not been
+// acknowledged yet.
+func (k Keeper) GetPendingSendPacket(ctx sdk.Context, channelID string, sequence uint64) (types.PendingSendPacket, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSendPacketKey)
+	bz := store.Get(types.GetPendingSendPacketKey(channelID, sequence))
+	if bz == nil {
+		return types.PendingSendPacket{}, false
+	}
+
+	var packet types.PendingSendPacket
+	k.cdc.MustUnmarshalBinaryBare(bz, &packet)
+	return packet, true
+}
+
+// SetPendingSendPacket sets a pending send packet in the store.
+func (k Keeper) SetPendingSendPacket(ctx sdk.Context, packet types.PendingSendPacket) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSendPacketKey)
+	bz := k.cdc.MustMarshalBinaryBare(&packet)
+	store.Set(types.GetPendingSendPacketKey(packet.ChannelId, packet.Sequence), bz)
+}
+
+// DeletePendingSendPacket removes a pending send packet from the store.
+func (k Keeper) DeletePendingSendPacket(ctx sdk.Context, channelID string, sequence uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSendPacketKey)
+	store.Delete(types.GetPendingSendPacketKey(channelID, sequence))
+}
+
+// GetAllPendingSendPackets returns all the pending send packets.
+func (k Keeper) GetAllPendingSendPackets(ctx sdk.Context) []types.PendingSendPacket {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PendingSendPacketKey)
+	defer iterator.Close()
+
+	packets := []types.PendingSendPacket{}
+	for ; iterator.Valid(); iterator.Next() {
+		var packet types.PendingSendPacket
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &packet)
+		packets = append(packets, packet)
+	}
+	return packets
+}
+
+// deletePendingSendPackets removes the pending send packets of a denomination on a channel.
+func (k Keeper) deletePendingSendPackets(ctx sdk.Context, channelID, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSendPacketKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetRateLimitChannelPrefix(channelID))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		var packet types.PendingSendPacket
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &packet)
+		if packet.Denom == denom {
+			keys = append(keys, iterator.Key())
+		}
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,261 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/line/lbm-sdk/baseapp"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/ibc/applications/ratelimit/types"
+	transfertypes "github.com/line/lbm-sdk/x/ibc/applications/transfer/types"
+	clienttypes "github.com/line/lbm-sdk/x/ibc/core/02-client/types"
+	channeltypes "github.com/line/lbm-sdk/x/ibc/core/04-channel/types"
+	"github.com/line/lbm-sdk/x/ibc/core/exported"
+	ibctesting "github.com/line/lbm-sdk/x/ibc/testing"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	channelA ibctesting.TestChannel
+	channelB ibctesting.TestChannel
+
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(0))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+
+	_, _, connA, connB := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Ostracon)
+	suite.channelA, suite.channelB = suite.coordinator.CreateTransferChannels(suite.chainA, suite.chainB, connA, connB, channeltypes.UNORDERED)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.chainA.GetContext(), suite.chainA.App.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.chainA.App.RateLimitKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) addRateLimit(denom string, quota types.Quota) types.RateLimit {
+	ctx := suite.chainA.GetContext()
+	p := types.NewAddRateLimitProposal("title", "description", denom, suite.channelA.ID, quota)
+	suite.Require().NoError(suite.chainA.App.RateLimitKeeper.AddRateLimitProposal(ctx, p))
+
+	rateLimit, found := suite.chainA.App.RateLimitKeeper.GetRateLimit(ctx, suite.channelA.ID, denom)
+	suite.Require().True(found)
+	return rateLimit
+}
+
+func (suite *KeeperTestSuite) sendTransfer(amount sdk.Int) error {
+	return suite.chainA.App.TransferKeeper.SendTransfer(
+		suite.chainA.GetContext(), suite.channelA.PortID, suite.channelA.ID, sdk.NewCoin(sdk.DefaultBondDenom, amount),
+		suite.chainA.SenderAccount.GetAddress(), suite.chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0, "",
+	)
+}
+
+func (suite *KeeperTestSuite) TestAddRateLimitProposal() {
+	quota := types.NewQuota(10, 10, time.Hour)
+
+	testCases := []struct {
+		msg     string
+		denom   string
+		channel string
+		expPass bool
+	}{
+		{"success", sdk.DefaultBondDenom, suite.channelA.ID, true},
+		{"channel not found", sdk.DefaultBondDenom, ibctesting.InvalidID, false},
+		{"no supply", "nosupply", suite.channelA.ID, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest()
+			if tc.channel != ibctesting.InvalidID {
+				tc.channel = suite.channelA.ID
+			}
+
+			ctx := suite.chainA.GetContext()
+			p := types.NewAddRateLimitProposal("title", "description", tc.denom, tc.channel, quota)
+			err := suite.chainA.App.RateLimitKeeper.AddRateLimitProposal(ctx, p)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			rateLimit, found := suite.chainA.App.RateLimitKeeper.GetRateLimit(ctx, tc.channel, tc.denom)
+			suite.Require().True(found)
+			supply := suite.chainA.App.BankKeeper.GetSupply(ctx).GetTotal().AmountOf(tc.denom)
+			suite.Require().Equal(supply, rateLimit.Flow.ChannelValue)
+			suite.Require().Equal(ctx.BlockTime().Add(time.Hour), rateLimit.Flow.WindowEnd)
+
+			// a rate limit can only be added once
+			suite.Require().ErrorIs(suite.chainA.App.RateLimitKeeper.AddRateLimitProposal(ctx, p), types.ErrRateLimitExists)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSendQuota() {
+	rateLimit := suite.addRateLimit(sdk.DefaultBondDenom, types.NewQuota(10, 10, time.Hour))
+	threshold := rateLimit.Flow.ChannelValue.QuoRaw(10)
+
+	suite.Require().NoError(suite.sendTransfer(sdk.NewInt(100)))
+
+	err := suite.sendTransfer(threshold.AddRaw(1))
+	suite.Require().ErrorIs(err, types.ErrQuotaExceeded)
+
+	suite.Require().NoError(suite.sendTransfer(threshold.SubRaw(100)))
+	suite.Require().ErrorIs(suite.sendTransfer(sdk.OneInt()), types.ErrQuotaExceeded)
+
+	ctx := suite.chainA.GetContext()
+	rateLimit, _ = suite.chainA.App.RateLimitKeeper.GetRateLimit(ctx, suite.channelA.ID, sdk.DefaultBondDenom)
+	suite.Require().Equal(threshold, rateLimit.Flow.Outflow)
+	suite.Require().Len(suite.chainA.App.RateLimitKeeper.GetAllPendingSendPackets(ctx), 2)
+}
+
+func (suite *KeeperTestSuite) TestSendPacketFailed() {
+	suite.addRateLimit(sdk.DefaultBondDenom, types.NewQuota(10, 10, time.Hour))
+	suite.Require().NoError(suite.sendTransfer(sdk.NewInt(100)))
+
+	ctx := suite.chainA.GetContext()
+	data := transfertypes.NewFungibleTokenPacketData(sdk.DefaultBondDenom, 100, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), "")
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, suite.channelA.PortID, suite.channelA.ID, suite.channelB.PortID, suite.channelB.ID, clienttypes.NewHeight(0, 110), 0)
+
+	_, found := suite.chainA.App.RateLimitKeeper.GetPendingSendPacket(ctx, suite.channelA.ID, 1)
+	suite.Require().True(found)
+
+	suite.chainA.App.RateLimitKeeper.OnSendPacketFailed(ctx, packet)
+
+	_, found = suite.chainA.App.RateLimitKeeper.GetPendingSendPacket(ctx, suite.channelA.ID, 1)
+	suite.Require().False(found)
+	rateLimit, _ := suite.chainA.App.RateLimitKeeper.GetRateLimit(ctx, suite.channelA.ID, sdk.DefaultBondDenom)
+	suite.Require().True(rateLimit.Flow.Outflow.IsZero())
+
+	// the outflow is only reverted once
+	suite.Require().NoError(suite.sendTransfer(sdk.NewInt(50)))
+	suite.chainA.App.RateLimitKeeper.OnSendPacketFailed(ctx, packet)
+	rateLimit, _ = suite.chainA.App.RateLimitKeeper.GetRateLimit(ctx, suite.channelA.ID, sdk.DefaultBondDenom)
+	suite.Require().Equal(sdk.NewInt(50), rateLimit.Flow.Outflow)
+}
+
+func (suite *KeeperTestSuite) TestWindowReset() {
+	rateLimit := suite.addRateLimit(sdk.DefaultBondDenom, types.NewQuota(10, 10, time.Hour))
+	threshold := rateLimit.Flow.ChannelValue.QuoRaw(10)
+
+	suite.Require().NoError(suite.sendTransfer(threshold))
+	suite.Require().ErrorIs(suite.sendTransfer(sdk.OneInt()), types.ErrQuotaExceeded)
+
+	suite.coordinator.IncrementTimeBy(time.Hour)
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	suite.Require().NoError(suite.sendTransfer(sdk.OneInt()))
+
+	ctx := suite.chainA.GetContext()
+	rateLimit, _ = suite.chainA.App.RateLimitKeeper.GetRateLimit(ctx, suite.channelA.ID, sdk.DefaultBondDenom)
+	suite.Require().Equal(sdk.OneInt(), rateLimit.Flow.Outflow)
+	suite.Require().True(rateLimit.Flow.WindowEnd.After(ctx.BlockTime()))
+	// the packet of the previous window is no longer pending
+	suite.Require().Len(suite.chainA.App.RateLimitKeeper.GetAllPendingSendPackets(ctx), 1)
+}
+
+func (suite *KeeperTestSuite) TestZeroChannelValue() {
+	k := suite.chainA.App.RateLimitKeeper
+	ctx := suite.chainA.GetContext()
+
+	// a window of a voucher started after all the vouchers have been returned
+	voucherDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(suite.channelA.PortID, suite.channelA.ID, sdk.DefaultBondDenom)).IBCDenom()
+	k.SetRateLimit(ctx, types.NewRateLimit(voucherDenom, suite.channelA.ID, types.NewQuota(10, 10, time.Hour), sdk.ZeroInt(), ctx.BlockTime()))
+
+	recvPacket := func(sequence uint64, amount uint64) channeltypes.Packet {
+		data := transfertypes.NewFungibleTokenPacketData(sdk.DefaultBondDenom, amount, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), "")
+		return channeltypes.NewPacket(data.GetBytes(), sequence, suite.channelB.PortID, suite.channelB.ID, suite.channelA.PortID, suite.channelA.ID, clienttypes.NewHeight(0, 110), 0)
+	}
+
+	// the window does not block the vouchers to be received
+	rateLimit, err := k.CheckInflow(ctx, recvPacket(1, 1000))
+	suite.Require().NoError(err)
+	k.SetRateLimit(ctx, *rateLimit)
+	suite.Require().NoError(suite.chainA.App.BankKeeper.MintCoins(ctx, transfertypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(voucherDenom, 1000))))
+
+	// the window is valued at the supply of the vouchers received
+	_, err = k.CheckInflow(ctx, recvPacket(2, 1))
+	suite.Require().ErrorIs(err, types.ErrQuotaExceeded)
+}
+
+func (suite *KeeperTestSuite) TestUpdateRemoveResetProposals() {
+	suite.addRateLimit(sdk.DefaultBondDenom, types.NewQuota(10, 10, time.Hour))
+	suite.Require().NoError(suite.sendTransfer(sdk.NewInt(100)))
+
+	k := suite.chainA.App.RateLimitKeeper
+	ctx := suite.chainA.GetContext()
+
+	err := k.UpdateRateLimitProposal(ctx, types.NewUpdateRateLimitProposal("title", "description", sdk.DefaultBondDenom, suite.channelA.ID, types.NewQuota(50, 0, 2*time.Hour)))
+	suite.Require().NoError(err)
+	rateLimit, _ := k.GetRateLimit(ctx, suite.channelA.ID, sdk.DefaultBondDenom)
+	suite.Require().Equal(uint32(50), rateLimit.Quota.MaxPercentSend)
+	suite.Require().True(rateLimit.Flow.Outflow.IsZero())
+	suite.Require().Empty(k.GetAllPendingSendPackets(ctx))
+
+	suite.Require().NoError(suite.sendTransfer(sdk.NewInt(100)))
+	suite.Require().NoError(k.ResetRateLimitProposal(ctx, types.NewResetRateLimitProposal("title", "description", sdk.DefaultBondDenom, suite.channelA.ID)))
+	rateLimit, _ = k.GetRateLimit(ctx, suite.channelA.ID, sdk.DefaultBondDenom)
+	suite.Require().True(rateLimit.Flow.Outflow.IsZero())
+
+	suite.Require().NoError(k.RemoveRateLimitProposal(ctx, types.NewRemoveRateLimitProposal("title", "description", sdk.DefaultBondDenom, suite.channelA.ID)))
+	_, found := k.GetRateLimit(ctx, suite.channelA.ID, sdk.DefaultBondDenom)
+	suite.Require().False(found)
+
+	err = k.RemoveRateLimitProposal(ctx, types.NewRemoveRateLimitProposal("title", "description", sdk.DefaultBondDenom, suite.channelA.ID))
+	suite.Require().ErrorIs(err, types.ErrRateLimitNotFound)
+	err = k.ResetRateLimitProposal(ctx, types.NewResetRateLimitProposal("title", "description", sdk.DefaultBondDenom, suite.channelA.ID))
+	suite.Require().ErrorIs(err, types.ErrRateLimitNotFound)
+	err = k.UpdateRateLimitProposal(ctx, types.NewUpdateRateLimitProposal("title", "description", sdk.DefaultBondDenom, suite.channelA.ID, types.NewQuota(50, 0, time.Hour)))
+	suite.Require().ErrorIs(err, types.ErrRateLimitNotFound)
+}
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	suite.addRateLimit(sdk.DefaultBondDenom, types.NewQuota(10, 10, time.Hour))
+	suite.Require().NoError(suite.sendTransfer(sdk.NewInt(100)))
+
+	ctx := suite.chainA.GetContext()
+	genesis := suite.chainA.App.RateLimitKeeper.ExportGenesis(ctx)
+	suite.Require().Len(genesis.RateLimits, 1)
+	suite.Require().Len(genesis.PendingSendPackets, 1)
+	suite.Require().NoError(genesis.Validate())
+
+	suite.chainB.App.RateLimitKeeper.InitGenesis(suite.chainB.GetContext(), *genesis)
+	suite.Require().Equal(genesis, suite.chainB.App.RateLimitKeeper.ExportGenesis(suite.chainB.GetContext()))
+}
+
+func (suite *KeeperTestSuite) TestQueryRateLimits() {
+	suite.addRateLimit(sdk.DefaultBondDenom, types.NewQuota(10, 10, time.Hour))
+
+	res, err := suite.queryClient.RateLimit(sdk.WrapSDKContext(suite.chainA.GetContext()), &types.QueryRateLimitRequest{
+		ChannelId: suite.channelA.ID,
+		Denom:     sdk.DefaultBondDenom,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint32(10), res.RateLimit.Quota.MaxPercentSend)
+
+	_, err = suite.queryClient.RateLimit(sdk.WrapSDKContext(suite.chainA.GetContext()), &types.QueryRateLimitRequest{
+		ChannelId: suite.channelA.ID,
+		Denom:     "unknown",
+	})
+	suite.Require().Error(err)
+
+	all, err := suite.queryClient.RateLimits(sdk.WrapSDKContext(suite.chainA.GetContext()), &types.QueryRateLimitsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(all.RateLimits, 1)
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/x/ibc/applications/ratelimit/types"
+	transfertypes "github.com/line/lbm-sdk/x/ibc/applications/transfer/types"
+	channeltypes "github.com/line/lbm-sdk/x/ibc/core/04-channel/types"
+)
+
+// AddRateLimitProposal adds a rate limit to a denomination on a transfer channel. The
+// channel value of the first window is the current supply of the denomination.
+func (k Keeper) AddRateLimitProposal(ctx sdk.Context, p *types.AddRateLimitProposal) error {
+	if _, found := k.GetRateLimit(ctx, p.ChannelId, p.Denom); found {
+		return sdkerrors.Wrapf(types.ErrRateLimitExists, "denom %s on channel %s", p.Denom, p.ChannelId)
+	}
+	if _, found := k.channelKeeper.GetChannel(ctx, transfertypes.PortID, p.ChannelId); !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port-id: %s, channel-id: %s", transfertypes.PortID, p.ChannelId)
+	}
+
+	channelValue := k.getChannelValue(ctx, p.Denom)
+	if channelValue.IsZero() {
+		return sdkerrors.Wrapf(types.ErrZeroChannelValue, "no supply of %s", p.Denom)
+	}
+
+	k.SetRateLimit(ctx, types.NewRateLimit(p.Denom, p.ChannelId, p.Quota, channelValue, ctx.BlockTime()))
+	emitRateLimitEvent(ctx, types.EventTypeAddRateLimit, p.Denom, p.ChannelId)
+	return nil
+}
+
+// UpdateRateLimitProposal replaces the quota of a rate limit and starts a new window.
+func (k Keeper) UpdateRateLimitProposal(ctx sdk.Context, p *types.UpdateRateLimitProposal) error {
+	rateLimit, found := k.GetRateLimit(ctx, p.ChannelId, p.Denom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrRateLimitNotFound, "denom %s on channel %s", p.Denom, p.ChannelId)
+	}
+
+	rateLimit.Quota = p.Quota
+	k.resetFlow(ctx, &rateLimit)
+	k.SetRateLimit(ctx, rateLimit)
+	emitRateLimitEvent(ctx, types.EventTypeUpdateRateLimit, p.Denom, p.ChannelId)
+	return nil
+}
+
+// RemoveRateLimitProposal removes a rate limit.
+func (k Keeper) RemoveRateLimitProposal(ctx sdk.Context, p *types.RemoveRateLimitProposal) error {
+	if _, found := k.GetRateLimit(ctx, p.ChannelId, p.Denom); !found {
+		return sdkerrors.Wrapf(types.ErrRateLimitNotFound, "denom %s on channel %s", p.Denom, p.ChannelId)
+	}
+
+	k.DeleteRateLimit(ctx, p.ChannelId, p.Denom)
+	emitRateLimitEvent(ctx, types.EventTypeRemoveRateLimit, p.Denom, p.ChannelId)
+	return nil
+}
+
+// ResetRateLimitProposal resets the flow of a rate limit and starts a new window.
+func (k Keeper) ResetRateLimitProposal(ctx sdk.Context, p *types.ResetRateLimitProposal) error {
+	rateLimit, found := k.GetRateLimit(ctx, p.ChannelId, p.Denom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrRateLimitNotFound, "denom %s on channel %s", p.Denom, p.ChannelId)
+	}
+
+	k.resetFlow(ctx, &rateLimit)
+	k.SetRateLimit(ctx, rateLimit)
+	emitRateLimitEvent(ctx, types.EventTypeResetRateLimit, p.Denom, p.ChannelId)
+	return nil
+}
+
+func emitRateLimitEvent(ctx sdk.Context, eventType, denom, channelID string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyChannel, channelID),
+		),
+	)
+}
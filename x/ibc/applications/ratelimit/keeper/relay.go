@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/ibc/applications/ratelimit/types"
+	transfertypes "github.com/line/lbm-sdk/x/ibc/applications/transfer/types"
+	ibcexported "github.com/line/lbm-sdk/x/ibc/core/exported"
+)
+
+// checkOutflow adds a transfer packet to the outflow of its denomination on the source
+// channel and records it as pending until it is acknowledged.
+func (k Keeper) checkOutflow(ctx sdk.Context, packet ibcexported.PacketI) error {
+	data, ok := unmarshalPacketData(packet)
+	if !ok {
+		return nil
+	}
+
+	denom := transfertypes.ParseDenomTrace(data.Denom).IBCDenom()
+	rateLimit, found := k.getCurrentRateLimit(ctx, packet.GetSourceChannel(), denom)
+	if !found {
+		return nil
+	}
+
+	if err := rateLimit.AddOutflow(sdk.NewIntFromUint64(data.Amount)); err != nil {
+		emitQuotaExceededEvent(ctx, rateLimit, types.AttributeValueSend, data.Amount)
+		return err
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+	k.SetPendingSendPacket(ctx, types.PendingSendPacket{
+		ChannelId: packet.GetSourceChannel(),
+		Sequence:  packet.GetSequence(),
+		Denom:     denom,
+	})
+	return nil
+}
+
+// CheckInflow adds a received transfer packet to the inflow of its denomination on the
+// destination channel. The updated rate limit is returned without being stored, so that
+// it is only stored once the packet has been received successfully. A nil rate limit is
+// returned if the denomination is not rate limited.
+func (k Keeper) CheckInflow(ctx sdk.Context, packet ibcexported.PacketI) (*types.RateLimit, error) {
+	data, ok := unmarshalPacketData(packet)
+	if !ok {
+		return nil, nil
+	}
+
+	denom := receiveDenom(packet, data.Denom)
+	rateLimit, found := k.getCurrentRateLimit(ctx, packet.GetDestChannel(), denom)
+	if !found {
+		return nil, nil
+	}
+
+	if err := rateLimit.AddInflow(sdk.NewIntFromUint64(data.Amount)); err != nil {
+		emitQuotaExceededEvent(ctx, rateLimit, types.AttributeValueRecv, data.Amount)
+		return nil, err
+	}
+	return &rateLimit, nil
+}
+
+// OnSendPacketSucceeded removes a sent packet acknowledged successfully from the pending
+// send packets.
+func (k Keeper) OnSendPacketSucceeded(ctx sdk.Context, packet ibcexported.PacketI) {
+	k.DeletePendingSendPacket(ctx, packet.GetSourceChannel(), packet.GetSequence())
+}
+
+// OnSendPacketFailed reverts the outflow of a sent packet that failed or timed out. The
+// outflow is only reverted if the packet was sent in the current window.
+func (k Keeper) OnSendPacketFailed(ctx sdk.Context, packet ibcexported.PacketI) {
+	pending, found := k.GetPendingSendPacket(ctx, packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return
+	}
+	k.DeletePendingSendPacket(ctx, pending.ChannelId, pending.Sequence)
+
+	data, ok := unmarshalPacketData(packet)
+	if !ok {
+		return
+	}
+	rateLimit, found := k.GetRateLimit(ctx, pending.ChannelId, pending.Denom)
+	if !found || rateLimit.IsWindowExpired(ctx.BlockTime()) {
+		return
+	}
+	rateLimit.RevertOutflow(sdk.NewIntFromUint64(data.Amount))
+	k.SetRateLimit(ctx, rateLimit)
+}
+
+// getCurrentRateLimit returns the rate limit of a denomination on a channel. The flow is
+// reset if its window has ended. A window started while the denomination had no supply
+// is valued at its current supply, so that it is limited once tokens exist.
+func (k Keeper) getCurrentRateLimit(ctx sdk.Context, channelID, denom string) (types.RateLimit, bool) {
+	rateLimit, found := k.GetRateLimit(ctx, channelID, denom)
+	if !found {
+		return types.RateLimit{}, false
+	}
+	if rateLimit.IsWindowExpired(ctx.BlockTime()) {
+		k.resetFlow(ctx, &rateLimit)
+	} else if rateLimit.Flow.ChannelValue.IsZero() {
+		rateLimit.Flow.ChannelValue = k.getChannelValue(ctx, denom)
+	}
+	return rateLimit, true
+}
+
+// resetFlow starts a new window valued at the current supply of the denomination. The
+// packets pending in the previous window are no longer reverted.
+func (k Keeper) resetFlow(ctx sdk.Context, rateLimit *types.RateLimit) {
+	rateLimit.ResetFlow(k.getChannelValue(ctx, rateLimit.Denom), ctx.BlockTime())
+	k.deletePendingSendPackets(ctx, rateLimit.ChannelId, rateLimit.Denom)
+}
+
+func (k Keeper) getChannelValue(ctx sdk.Context, denom string) sdk.Int {
+	return k.bankKeeper.GetSupply(ctx).GetTotal().AmountOf(denom)
+}
+
+// unmarshalPacketData returns the ICS-20 data of a packet. Packets of other applications
+// are not rate limited.
+func unmarshalPacketData(packet ibcexported.PacketI) (transfertypes.FungibleTokenPacketData, bool) {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return data, false
+	}
+	return data, data.Denom != ""
+}
+
+// receiveDenom returns the denomination on this chain of the tokens received by a packet.
+func receiveDenom(packet ibcexported.PacketI, denom string) string {
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), denom) {
+		// the tokens are returned to this chain, remove the prefix added by the sender
+		voucherPrefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		return transfertypes.ParseDenomTrace(strings.TrimPrefix(denom, voucherPrefix)).IBCDenom()
+	}
+	prefixedDenom := transfertypes.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel()) + denom
+	return transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+}
+
+func emitQuotaExceededEvent(ctx sdk.Context, rateLimit types.RateLimit, direction string, amount uint64) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeQuotaExceeded,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyDenom, rateLimit.Denom),
+			sdk.NewAttribute(types.AttributeKeyChannel, rateLimit.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyDirection, direction),
+			sdk.NewAttribute(types.AttributeKeyAmount, sdk.NewIntFromUint64(amount).String()),
+		),
+	)
+}
@@ -0,0 +1,31 @@
+package ratelimit
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	govtypes "github.com/line/lbm-sdk/x/gov/types"
+	"github.com/line/lbm-sdk/x/ibc/applications/ratelimit/keeper"
+	"github.com/line/lbm-sdk/x/ibc/applications/ratelimit/types"
+)
+
+// NewRateLimitProposalHandler defines the rate limit proposal handler
+func NewRateLimitProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.AddRateLimitProposal:
+			return k.AddRateLimitProposal(ctx, c)
+
+		case *types.UpdateRateLimitProposal:
+			return k.UpdateRateLimitProposal(ctx, c)
+
+		case *types.RemoveRateLimitProposal:
+			return k.RemoveRateLimitProposal(ctx, c)
+
+		case *types.ResetRateLimitProposal:
+			return k.ResetRateLimitProposal(ctx, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized ibc rate limit proposal content type: %T", c)
+		}
+	}
+}
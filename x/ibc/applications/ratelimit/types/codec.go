@@ -0,0 +1,17 @@
+package types
+
+import (
+	codectypes "github.com/line/lbm-sdk/codec/types"
+	govtypes "github.com/line/lbm-sdk/x/gov/types"
+)
+
+// RegisterInterfaces registers the ibc rate limit proposals to protobuf Any.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&AddRateLimitProposal{},
+		&UpdateRateLimitProposal{},
+		&RemoveRateLimitProposal{},
+		&ResetRateLimitProposal{},
+	)
+}
@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+// IBC rate limit sentinel errors
+var (
+	ErrRateLimitNotFound = sdkerrors.Register(ModuleName, 2, "rate limit not found")
+	ErrRateLimitExists   = sdkerrors.Register(ModuleName, 3, "rate limit already exists")
+	ErrInvalidQuota      = sdkerrors.Register(ModuleName, 4, "invalid quota")
+	ErrZeroChannelValue  = sdkerrors.Register(ModuleName, 5, "channel value is zero")
+	ErrQuotaExceeded     = sdkerrors.Register(ModuleName, 6, "quota exceeded")
+)
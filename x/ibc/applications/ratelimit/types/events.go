@@ -0,0 +1,19 @@
+package types
+
+// IBC rate limit events
+const (
+	EventTypeQuotaExceeded   = "quota_exceeded"
+	EventTypeAddRateLimit    = "add_rate_limit"
+	EventTypeUpdateRateLimit = "update_rate_limit"
+	EventTypeRemoveRateLimit = "remove_rate_limit"
+	EventTypeResetRateLimit  = "reset_rate_limit"
+
+	AttributeKeyDenom     = "denom"
+	AttributeKeyChannel   = "channel_id"
+	AttributeKeyDirection = "direction"
+	AttributeKeyAmount    = "amount"
+
+	AttributeValueCategory = ModuleName
+	AttributeValueSend     = "send"
+	AttributeValueRecv     = "recv"
+)
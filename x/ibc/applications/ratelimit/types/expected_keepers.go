@@ -0,0 +1,23 @@
+package types
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	bankexported "github.com/line/lbm-sdk/x/bank/exported"
+	capabilitytypes "github.com/line/lbm-sdk/x/capability/types"
+	channeltypes "github.com/line/lbm-sdk/x/ibc/core/04-channel/types"
+	ibcexported "github.com/line/lbm-sdk/x/ibc/core/exported"
+)
+
+// BankKeeper defines the expected bank keeper
+type BankKeeper interface {
+	GetSupply(ctx sdk.Context) bankexported.SupplyI
+}
+
+// ChannelKeeper defines the expected IBC channel keeper. It matches the channel keeper
+// expected by the transfer module, so that the rate limit keeper can be put between them.
+type ChannelKeeper interface {
+	GetChannel(ctx sdk.Context, srcPort, srcChan string) (channel channeltypes.Channel, found bool)
+	GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (uint64, bool)
+	SendPacket(ctx sdk.Context, channelCap *capabilitytypes.Capability, packet ibcexported.PacketI) error
+	ChanCloseInit(ctx sdk.Context, portID, channelID string, chanCap *capabilitytypes.Capability) error
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new ibc-ratelimit GenesisState instance.
+func NewGenesisState(rateLimits []RateLimit, pendingSendPackets []PendingSendPacket) *GenesisState {
+	return &GenesisState{
+		RateLimits:         rateLimits,
+		PendingSendPackets: pendingSendPackets,
+	}
+}
+
+// DefaultGenesisState returns a GenesisState without rate limits.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		RateLimits:         []RateLimit{},
+		PendingSendPackets: []PendingSendPacket{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool)
+	for i, rl := range gs.RateLimits {
+		if err := rl.Validate(); err != nil {
+			return fmt.Errorf("invalid rate limit %d: %w", i, err)
+		}
+		key := string(GetRateLimitKey(rl.ChannelId, rl.Denom))
+		if seen[key] {
+			return fmt.Errorf("duplicate rate limit for %s on %s", rl.Denom, rl.ChannelId)
+		}
+		seen[key] = true
+	}
+	for i, p := range gs.PendingSendPackets {
+		if err := p.Validate(); err != nil {
+			return fmt.Errorf("invalid pending send packet %d: %w", i, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+)
+
+const (
+	// ModuleName defines the IBC rate limit name
+	ModuleName = "ratelimit"
+
+	// StoreKey is the store key string for IBC rate limit
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for IBC rate limit
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for IBC rate limit
+	QuerierRoute = ModuleName
+)
+
+var (
+	// RateLimitKey defines the key to store the rate limits in store
+	RateLimitKey = []byte{0x01}
+	// PendingSendPacketKey defines the key to store the pending send packets in store
+	PendingSendPacketKey = []byte{0x02}
+)
+
+// GetRateLimitChannelPrefix returns the store prefix of the rate limits of a channel.
+func GetRateLimitChannelPrefix(channelID string) []byte {
+	return append([]byte(channelID), '/')
+}
+
+// GetRateLimitKey returns the store key of the rate limit of a denomination on a channel
+// relative to RateLimitKey.
+func GetRateLimitKey(channelID, denom string) []byte {
+	return append(GetRateLimitChannelPrefix(channelID), denom...)
+}
+
+// GetPendingSendPacketKey returns the store key of a pending send packet relative to
+// PendingSendPacketKey.
+func GetPendingSendPacketKey(channelID string, sequence uint64) []byte {
+	return append(GetRateLimitChannelPrefix(channelID), sdk.Uint64ToBigEndian(sequence)...)
+}
@@ -0,0 +1,162 @@
+package types
+
+import (
+	govtypes "github.com/line/lbm-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeAddRateLimit defines the type for an AddRateLimitProposal
+	ProposalTypeAddRateLimit = "AddRateLimit"
+	// ProposalTypeUpdateRateLimit defines the type for an UpdateRateLimitProposal
+	ProposalTypeUpdateRateLimit = "UpdateRateLimit"
+	// ProposalTypeRemoveRateLimit defines the type for a RemoveRateLimitProposal
+	ProposalTypeRemoveRateLimit = "RemoveRateLimit"
+	// ProposalTypeResetRateLimit defines the type for a ResetRateLimitProposal
+	ProposalTypeResetRateLimit = "ResetRateLimit"
+)
+
+var (
+	_ govtypes.Content = &AddRateLimitProposal{}
+	_ govtypes.Content = &UpdateRateLimitProposal{}
+	_ govtypes.Content = &RemoveRateLimitProposal{}
+	_ govtypes.Content = &ResetRateLimitProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeAddRateLimit)
+	govtypes.RegisterProposalType(ProposalTypeUpdateRateLimit)
+	govtypes.RegisterProposalType(ProposalTypeRemoveRateLimit)
+	govtypes.RegisterProposalType(ProposalTypeResetRateLimit)
+	govtypes.RegisterProposalTypeCodec(&AddRateLimitProposal{}, "lbm-sdk/AddRateLimitProposal")
+	govtypes.RegisterProposalTypeCodec(&UpdateRateLimitProposal{}, "lbm-sdk/UpdateRateLimitProposal")
+	govtypes.RegisterProposalTypeCodec(&RemoveRateLimitProposal{}, "lbm-sdk/RemoveRateLimitProposal")
+	govtypes.RegisterProposalTypeCodec(&ResetRateLimitProposal{}, "lbm-sdk/ResetRateLimitProposal")
+}
+
+// NewAddRateLimitProposal creates a new add rate limit proposal.
+func NewAddRateLimitProposal(title, description, denom, channelID string, quota Quota) *AddRateLimitProposal {
+	return &AddRateLimitProposal{
+		Title:       title,
+		Description: description,
+		Denom:       denom,
+		ChannelId:   channelID,
+		Quota:       quota,
+	}
+}
+
+// GetTitle returns the title of an add rate limit proposal.
+func (p *AddRateLimitProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an add rate limit proposal.
+func (p *AddRateLimitProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an add rate limit proposal.
+func (p *AddRateLimitProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an add rate limit proposal.
+func (p *AddRateLimitProposal) ProposalType() string { return ProposalTypeAddRateLimit }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *AddRateLimitProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if err := validatePath(p.Denom, p.ChannelId); err != nil {
+		return err
+	}
+	return p.Quota.Validate()
+}
+
+// NewUpdateRateLimitProposal creates a new update rate limit proposal.
+func NewUpdateRateLimitProposal(title, description, denom, channelID string, quota Quota) *UpdateRateLimitProposal {
+	return &UpdateRateLimitProposal{
+		Title:       title,
+		Description: description,
+		Denom:       denom,
+		ChannelId:   channelID,
+		Quota:       quota,
+	}
+}
+
+// GetTitle returns the title of an update rate limit proposal.
+func (p *UpdateRateLimitProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an update rate limit proposal.
+func (p *UpdateRateLimitProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an update rate limit proposal.
+func (p *UpdateRateLimitProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an update rate limit proposal.
+func (p *UpdateRateLimitProposal) ProposalType() string { return ProposalTypeUpdateRateLimit }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *UpdateRateLimitProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if err := validatePath(p.Denom, p.ChannelId); err != nil {
+		return err
+	}
+	return p.Quota.Validate()
+}
+
+// NewRemoveRateLimitProposal creates a new remove rate limit proposal.
+func NewRemoveRateLimitProposal(title, description, denom, channelID string) *RemoveRateLimitProposal {
+	return &RemoveRateLimitProposal{
+		Title:       title,
+		Description: description,
+		Denom:       denom,
+		ChannelId:   channelID,
+	}
+}
+
+// GetTitle returns the title of a remove rate limit proposal.
+func (p *RemoveRateLimitProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a remove rate limit proposal.
+func (p *RemoveRateLimitProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a remove rate limit proposal.
+func (p *RemoveRateLimitProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a remove rate limit proposal.
+func (p *RemoveRateLimitProposal) ProposalType() string { return ProposalTypeRemoveRateLimit }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *RemoveRateLimitProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	return validatePath(p.Denom, p.ChannelId)
+}
+
+// NewResetRateLimitProposal creates a new reset rate limit proposal.
+func NewResetRateLimitProposal(title, description, denom, channelID string) *ResetRateLimitProposal {
+	return &ResetRateLimitProposal{
+		Title:       title,
+		Description: description,
+		Denom:       denom,
+		ChannelId:   channelID,
+	}
+}
+
+// GetTitle returns the title of a reset rate limit proposal.
+func (p *ResetRateLimitProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a reset rate limit proposal.
+func (p *ResetRateLimitProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a reset rate limit proposal.
+func (p *ResetRateLimitProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a reset rate limit proposal.
+func (p *ResetRateLimitProposal) ProposalType() string { return ProposalTypeResetRateLimit }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *ResetRateLimitProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	return validatePath(p.Denom, p.ChannelId)
+}
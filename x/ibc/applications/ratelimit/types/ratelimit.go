@@ -0,0 +1,138 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	host "github.com/line/lbm-sdk/x/ibc/core/24-host"
+)
+
+// NewQuota creates a new Quota instance.
+func NewQuota(maxPercentSend, maxPercentRecv uint32, duration time.Duration) Quota {
+	return Quota{
+		MaxPercentSend: maxPercentSend,
+		MaxPercentRecv: maxPercentRecv,
+		Duration:       duration,
+	}
+}
+
+// Validate performs a basic validation of the quota fields.
+func (q Quota) Validate() error {
+	if q.MaxPercentSend > 100 || q.MaxPercentRecv > 100 {
+		return sdkerrors.Wrap(ErrInvalidQuota, "max percent must not exceed 100")
+	}
+	if q.MaxPercentSend == 0 && q.MaxPercentRecv == 0 {
+		return sdkerrors.Wrap(ErrInvalidQuota, "max percent send and recv are both zero")
+	}
+	if q.Duration <= 0 {
+		return sdkerrors.Wrap(ErrInvalidQuota, "duration must be positive")
+	}
+	return nil
+}
+
+// NewFlow creates an empty Flow for a window ending at the given time.
+func NewFlow(channelValue sdk.Int, windowEnd time.Time) Flow {
+	return Flow{
+		Inflow:       sdk.ZeroInt(),
+		Outflow:      sdk.ZeroInt(),
+		ChannelValue: channelValue,
+		WindowEnd:    windowEnd,
+	}
+}
+
+// Validate performs a basic validation of the flow fields.
+func (f Flow) Validate() error {
+	if f.Inflow.IsNil() || f.Inflow.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "inflow must not be negative")
+	}
+	if f.Outflow.IsNil() || f.Outflow.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "outflow must not be negative")
+	}
+	if f.ChannelValue.IsNil() || f.ChannelValue.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "channel value must not be negative")
+	}
+	return nil
+}
+
+// NewRateLimit creates a new RateLimit instance with an empty flow.
+func NewRateLimit(denom, channelID string, quota Quota, channelValue sdk.Int, now time.Time) RateLimit {
+	return RateLimit{
+		Denom:     denom,
+		ChannelId: channelID,
+		Quota:     quota,
+		Flow:      NewFlow(channelValue, now.Add(quota.Duration)),
+	}
+}
+
+// Validate performs a basic validation of the rate limit fields.
+func (rl RateLimit) Validate() error {
+	if err := validatePath(rl.Denom, rl.ChannelId); err != nil {
+		return err
+	}
+	if err := rl.Quota.Validate(); err != nil {
+		return err
+	}
+	return rl.Flow.Validate()
+}
+
+// IsWindowExpired returns true if the current window has ended at the given time.
+func (rl RateLimit) IsWindowExpired(now time.Time) bool {
+	return !now.Before(rl.Flow.WindowEnd)
+}
+
+// ResetFlow starts a new window at the given time.
+func (rl *RateLimit) ResetFlow(channelValue sdk.Int, now time.Time) {
+	rl.Flow = NewFlow(channelValue, now.Add(rl.Quota.Duration))
+}
+
+// AddOutflow adds the amount to the outflow. It fails if the net outflow exceeds the
+// send quota of the window.
+func (rl *RateLimit) AddOutflow(amount sdk.Int) error {
+	outflow := rl.Flow.Outflow.Add(amount)
+	if rl.exceedsQuota(outflow.Sub(rl.Flow.Inflow), rl.Quota.MaxPercentSend) {
+		return sdkerrors.Wrapf(ErrQuotaExceeded, "outflow of %s on %s exceeds %d%% of the channel value %s",
+			rl.Denom, rl.ChannelId, rl.Quota.MaxPercentSend, rl.Flow.ChannelValue)
+	}
+	rl.Flow.Outflow = outflow
+	return nil
+}
+
+// AddInflow adds the amount to the inflow. It fails if the net inflow exceeds the
+// recv quota of the window.
+func (rl *RateLimit) AddInflow(amount sdk.Int) error {
+	inflow := rl.Flow.Inflow.Add(amount)
+	if rl.exceedsQuota(inflow.Sub(rl.Flow.Outflow), rl.Quota.MaxPercentRecv) {
+		return sdkerrors.Wrapf(ErrQuotaExceeded, "inflow of %s on %s exceeds %d%% of the channel value %s",
+			rl.Denom, rl.ChannelId, rl.Quota.MaxPercentRecv, rl.Flow.ChannelValue)
+	}
+	rl.Flow.Inflow = inflow
+	return nil
+}
+
+// RevertOutflow removes the amount of a failed packet from the outflow.
+func (rl *RateLimit) RevertOutflow(amount sdk.Int) {
+	rl.Flow.Outflow = sdk.MaxInt(rl.Flow.Outflow.Sub(amount), sdk.ZeroInt())
+}
+
+// exceedsQuota returns true if the net flow exceeds the max percent of the channel
+// value. A window valued at zero does not limit an enabled direction, otherwise no
+// tokens could ever be received to give the denomination a supply.
+func (rl RateLimit) exceedsQuota(netFlow sdk.Int, maxPercent uint32) bool {
+	if maxPercent > 0 && rl.Flow.ChannelValue.IsZero() {
+		return false
+	}
+	return netFlow.GT(rl.Flow.ChannelValue.MulRaw(int64(maxPercent)).QuoRaw(100))
+}
+
+// Validate performs a basic validation of the pending send packet fields.
+func (p PendingSendPacket) Validate() error {
+	return validatePath(p.Denom, p.ChannelId)
+}
+
+func validatePath(denom, channelID string) error {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return err
+	}
+	return host.ChannelIdentifierValidator(channelID)
+}
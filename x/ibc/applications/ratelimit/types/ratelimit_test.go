@@ -0,0 +1,122 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/ibc/applications/ratelimit/types"
+)
+
+func TestQuotaValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		quota   types.Quota
+		expPass bool
+	}{
+		{"valid quota", types.NewQuota(10, 20, time.Hour), true},
+		{"send only", types.NewQuota(10, 0, time.Hour), true},
+		{"full percent", types.NewQuota(100, 100, time.Hour), true},
+		{"send percent above 100", types.NewQuota(101, 20, time.Hour), false},
+		{"recv percent above 100", types.NewQuota(10, 101, time.Hour), false},
+		{"both percents zero", types.NewQuota(0, 0, time.Hour), false},
+		{"zero duration", types.NewQuota(10, 20, 0), false},
+		{"negative duration", types.NewQuota(10, 20, -time.Hour), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.quota.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestRateLimitFlow(t *testing.T) {
+	now := time.Now().UTC()
+	rateLimit := types.NewRateLimit("stake", "channel-0", types.NewQuota(10, 20, time.Hour), sdk.NewInt(1000), now)
+	require.NoError(t, rateLimit.Validate())
+
+	// net outflow is limited to 100
+	require.NoError(t, rateLimit.AddOutflow(sdk.NewInt(60)))
+	require.ErrorIs(t, rateLimit.AddOutflow(sdk.NewInt(41)), types.ErrQuotaExceeded)
+	require.NoError(t, rateLimit.AddOutflow(sdk.NewInt(40)))
+	require.Equal(t, sdk.NewInt(100), rateLimit.Flow.Outflow)
+
+	// net inflow is limited to 200, the outflow is netted
+	require.NoError(t, rateLimit.AddInflow(sdk.NewInt(300)))
+	require.ErrorIs(t, rateLimit.AddInflow(sdk.NewInt(1)), types.ErrQuotaExceeded)
+	require.Equal(t, sdk.NewInt(300), rateLimit.Flow.Inflow)
+
+	// the inflow frees up the outflow
+	require.NoError(t, rateLimit.AddOutflow(sdk.NewInt(300)))
+
+	rateLimit.RevertOutflow(sdk.NewInt(500))
+	require.True(t, rateLimit.Flow.Outflow.IsZero())
+
+	require.False(t, rateLimit.IsWindowExpired(now.Add(time.Hour-time.Second)))
+	require.True(t, rateLimit.IsWindowExpired(now.Add(time.Hour)))
+
+	rateLimit.ResetFlow(sdk.NewInt(2000), now.Add(time.Hour))
+	require.True(t, rateLimit.Flow.Inflow.IsZero())
+	require.Equal(t, sdk.NewInt(2000), rateLimit.Flow.ChannelValue)
+	require.Equal(t, now.Add(2*time.Hour), rateLimit.Flow.WindowEnd)
+}
+
+func TestRateLimitSendDisabled(t *testing.T) {
+	rateLimit := types.NewRateLimit("stake", "channel-0", types.NewQuota(0, 20, time.Hour), sdk.NewInt(1000), time.Now())
+	require.ErrorIs(t, rateLimit.AddOutflow(sdk.OneInt()), types.ErrQuotaExceeded)
+	require.NoError(t, rateLimit.AddInflow(sdk.OneInt()))
+}
+
+func TestRateLimitZeroChannelValue(t *testing.T) {
+	rateLimit := types.NewRateLimit("stake", "channel-0", types.NewQuota(0, 20, time.Hour), sdk.ZeroInt(), time.Now())
+	require.ErrorIs(t, rateLimit.AddOutflow(sdk.OneInt()), types.ErrQuotaExceeded)
+	require.NoError(t, rateLimit.AddInflow(sdk.NewInt(1000)))
+}
+
+func TestGenesisValidate(t *testing.T) {
+	rateLimit := types.NewRateLimit("stake", "channel-0", types.NewQuota(10, 20, time.Hour), sdk.NewInt(1000), time.Now())
+	pending := types.PendingSendPacket{ChannelId: "channel-0", Sequence: 1, Denom: "stake"}
+
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expPass  bool
+	}{
+		{"default", types.DefaultGenesisState(), true},
+		{"valid genesis", types.NewGenesisState([]types.RateLimit{rateLimit}, []types.PendingSendPacket{pending}), true},
+		{"duplicate rate limit", types.NewGenesisState([]types.RateLimit{rateLimit, rateLimit}, nil), false},
+		{"invalid channel", types.NewGenesisState([]types.RateLimit{types.NewRateLimit("stake", "(channel)", rateLimit.Quota, sdk.NewInt(1000), time.Now())}, nil), false},
+		{"invalid quota", types.NewGenesisState([]types.RateLimit{types.NewRateLimit("stake", "channel-0", types.Quota{}, sdk.NewInt(1000), time.Now())}, nil), false},
+		{"invalid pending denom", types.NewGenesisState(nil, []types.PendingSendPacket{{ChannelId: "channel-0", Sequence: 1}}), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestProposalValidateBasic(t *testing.T) {
+	quota := types.NewQuota(10, 20, time.Hour)
+
+	require.NoError(t, types.NewAddRateLimitProposal("title", "description", "stake", "channel-0", quota).ValidateBasic())
+	require.Error(t, types.NewAddRateLimitProposal("", "description", "stake", "channel-0", quota).ValidateBasic())
+	require.Error(t, types.NewAddRateLimitProposal("title", "description", "", "channel-0", quota).ValidateBasic())
+	require.Error(t, types.NewAddRateLimitProposal("title", "description", "stake", "channel-0", types.Quota{}).ValidateBasic())
+	require.NoError(t, types.NewUpdateRateLimitProposal("title", "description", "stake", "channel-0", quota).ValidateBasic())
+	require.Error(t, types.NewUpdateRateLimitProposal("title", "description", "stake", "", quota).ValidateBasic())
+	require.NoError(t, types.NewRemoveRateLimitProposal("title", "description", "stake", "channel-0").ValidateBasic())
+	require.Error(t, types.NewRemoveRateLimitProposal("title", "description", "stake", "").ValidateBasic())
+	require.NoError(t, types.NewResetRateLimitProposal("title", "description", "stake", "channel-0").ValidateBasic())
+	require.Error(t, types.NewResetRateLimitProposal("title", "", "stake", "channel-0").ValidateBasic())
+}
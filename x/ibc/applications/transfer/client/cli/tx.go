@@ -20,6 +20,7 @@ const (
 	flagPacketTimeoutHeight    = "packet-timeout-height"
 	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
 	flagAbsoluteTimeouts       = "absolute-timeouts"
+	flagPacketMemo             = "packet-memo"
 )
 
 // NewTransferTxCmd returns the command to create a NewMsgTransfer transaction
@@ -31,7 +32,8 @@ func NewTransferTxCmd() *cobra.Command {
 as absolute or relative using the "absolute-timeouts" flag. Timeout height can be set by passing in the height string
 in the form {revision}-{height} using the "packet-timeout-height" flag. Relative timeouts are added to
 the block height and block timestamp queried from the latest consensus state corresponding
-to the counterparty channel. Any timeout set to 0 is disabled.`),
+to the counterparty channel. Any timeout set to 0 is disabled. A memo can be sent along with the
+packet using the "packet-memo" flag, e.g. to forward the tokens from the receiving chain.`),
 		Example: fmt.Sprintf("%s tx ibc-transfer transfer [src-port] [src-channel] [receiver] [amount]", version.AppName),
 		Args:    cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -93,8 +95,13 @@ to the counterparty channel. Any timeout set to 0 is disabled.`),
 				}
 			}
 
+			memo, err := cmd.Flags().GetString(flagPacketMemo)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgTransfer(
-				srcPort, srcChannel, coin, sender, receiver, timeoutHeight, timeoutTimestamp,
+				srcPort, srcChannel, coin, sender, receiver, timeoutHeight, timeoutTimestamp, memo,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -107,6 +114,7 @@ to the counterparty channel. Any timeout set to 0 is disabled.`),
 	cmd.Flags().String(flagPacketTimeoutHeight, types.DefaultRelativePacketTimeoutHeight, "Packet timeout block height. The timeout is disabled when set to 0-0.")
 	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, types.DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds. Default is 10 minutes. The timeout is disabled when set to 0.")
 	cmd.Flags().Bool(flagAbsoluteTimeouts, false, "Timeout flags are used as absolute timeouts.")
+	cmd.Flags().String(flagPacketMemo, "", "Memo to be sent along with the packet. It is distinct from the transaction memo.")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -146,7 +146,8 @@ func FungibleTokenPacketFromTla(packet TlaFungibleTokenPacket) FungibleTokenPack
 			DenomFromTla(packet.Data.Denom),
 			uint64(packet.Data.Amount),
 			AddressFromString(packet.Data.Sender),
-			AddressFromString(packet.Data.Receiver)),
+			AddressFromString(packet.Data.Receiver),
+			""),
 	}
 }
 
@@ -358,7 +359,8 @@ func (suite *KeeperTestSuite) TestModelBasedRelay() {
 							sdk.AccAddress(tc.packet.Data.Sender),
 							tc.packet.Data.Receiver,
 							clienttypes.NewHeight(0, 110),
-							0)
+							0,
+							"")
 					}
 				case "OnRecvPacket":
 					err = suite.chainB.App.TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, tc.packet.Data)
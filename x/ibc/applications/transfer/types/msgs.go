@@ -14,12 +14,16 @@ const (
 	TypeMsgTransfer = "transfer"
 )
 
+// MaximumMemoLength is the maximum length in bytes of the memo of a transfer
+const MaximumMemoLength = 32768
+
 // NewMsgTransfer creates a new MsgTransfer instance
 //nolint:interfacer
 func NewMsgTransfer(
 	sourcePort, sourceChannel string,
 	token sdk.Coin, sender sdk.AccAddress, receiver string,
 	timeoutHeight clienttypes.Height, timeoutTimestamp uint64,
+	memo string,
 ) *MsgTransfer {
 	return &MsgTransfer{
 		SourcePort:       sourcePort,
@@ -29,6 +33,7 @@ func NewMsgTransfer(
 		Receiver:         receiver,
 		TimeoutHeight:    timeoutHeight,
 		TimeoutTimestamp: timeoutTimestamp,
+		Memo:             memo,
 	}
 }
 
@@ -67,6 +72,9 @@ func (msg MsgTransfer) ValidateBasic() error {
 	if strings.TrimSpace(msg.Receiver) == "" {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing recipient address")
 	}
+	if len(msg.Memo) > MaximumMemoLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "memo must not exceed %d bytes", MaximumMemoLength)
+	}
 	return ValidateIBCDenom(msg.Token.Denom)
 }
 
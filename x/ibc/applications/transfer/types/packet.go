@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/json"
 	"strings"
 	"time"
 
@@ -25,12 +26,14 @@ var (
 func NewFungibleTokenPacketData(
 	denom string, amount uint64,
 	sender, receiver string,
+	memo string,
 ) FungibleTokenPacketData {
 	return FungibleTokenPacketData{
 		Denom:    denom,
 		Amount:   amount,
 		Sender:   sender,
 		Receiver: receiver,
+		Memo:     memo,
 	}
 }
 
@@ -50,7 +53,22 @@ func (ftpd FungibleTokenPacketData) ValidateBasic() error {
 	return ValidatePrefixedDenom(ftpd.Denom)
 }
 
-// GetBytes is a helper for serialising
+// GetBytes is a helper for serialising. The memo is omitted when empty so that the packet
+// data of transfers without a memo remains readable by counterparty chains which do not
+// support the memo field.
 func (ftpd FungibleTokenPacketData) GetBytes() []byte {
-	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&ftpd))
+	bz := ModuleCdc.MustMarshalJSON(&ftpd)
+	if ftpd.Memo == "" {
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(bz, &fields); err != nil {
+			panic(err)
+		}
+		delete(fields, "memo")
+
+		var err error
+		if bz, err = json.Marshal(fields); err != nil {
+			panic(err)
+		}
+	}
+	return sdk.MustSortJSON(bz)
 }
@@ -18,11 +18,12 @@ func TestFungibleTokenPacketDataValidateBasic(t *testing.T) {
 		packetData FungibleTokenPacketData
 		expPass    bool
 	}{
-		{"valid packet", NewFungibleTokenPacketData(denom, amount, addr1.String(), addr2), true},
-		{"invalid denom", NewFungibleTokenPacketData("", amount, addr1.String(), addr2), false},
-		{"invalid amount", NewFungibleTokenPacketData(denom, 0, addr1.String(), addr2), false},
-		{"missing sender address", NewFungibleTokenPacketData(denom, amount, emptyAddr.String(), addr2), false},
-		{"missing recipient address", NewFungibleTokenPacketData(denom, amount, addr1.String(), emptyAddr.String()), false},
+		{"valid packet", NewFungibleTokenPacketData(denom, amount, addr1.String(), addr2, ""), true},
+		{"invalid denom", NewFungibleTokenPacketData("", amount, addr1.String(), addr2, ""), false},
+		{"invalid amount", NewFungibleTokenPacketData(denom, 0, addr1.String(), addr2, ""), false},
+		{"missing sender address", NewFungibleTokenPacketData(denom, amount, emptyAddr.String(), addr2, ""), false},
+		{"missing recipient address", NewFungibleTokenPacketData(denom, amount, addr1.String(), emptyAddr.String(), ""), false},
+		{"valid packet with memo", NewFungibleTokenPacketData(denom, amount, addr1.String(), addr2, "memo"), true},
 	}
 
 	for i, tc := range testCases {
@@ -34,3 +35,21 @@ func TestFungibleTokenPacketDataValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+// TestFungibleTokenPacketDataGetBytes tests that an empty memo is omitted from the packet data
+func TestFungibleTokenPacketDataGetBytes(t *testing.T) {
+	packetData := NewFungibleTokenPacketData(denom, amount, addr1.String(), addr2, "")
+	bz := packetData.GetBytes()
+	require.NotContains(t, string(bz), "memo")
+
+	var decoded FungibleTokenPacketData
+	require.NoError(t, ModuleCdc.UnmarshalJSON(bz, &decoded))
+	require.Equal(t, packetData, decoded)
+
+	packetData.Memo = `{"forward":{}}`
+	bz = packetData.GetBytes()
+	require.Contains(t, string(bz), `"memo":"{\"forward\":{}}"`)
+
+	require.NoError(t, ModuleCdc.UnmarshalJSON(bz, &decoded))
+	require.Equal(t, packetData, decoded)
+}
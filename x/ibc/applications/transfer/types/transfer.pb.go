@@ -35,6 +35,8 @@ type FungibleTokenPacketData struct {
 	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
 	// the recipient address on the destination chain
 	Receiver string `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// optional memo
+	Memo string `protobuf:"bytes,5,opt,name=memo,proto3" json:"memo,omitempty"`
 }
 
 func (m *FungibleTokenPacketData) Reset()         { *m = FungibleTokenPacketData{} }
@@ -98,6 +100,13 @@ func (m *FungibleTokenPacketData) GetReceiver() string {
 	return ""
 }
 
+func (m *FungibleTokenPacketData) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
 // DenomTrace contains the base denomination for ICS20 fungible tokens and the
 // source tracing information path.
 type DenomTrace struct {
@@ -226,30 +235,30 @@ func init() {
 }
 
 var fileDescriptor_5041673e96e97901 = []byte{
-	// 362 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x41, 0x8b, 0xda, 0x40,
-	0x14, 0xc7, 0x8d, 0xb5, 0xa2, 0xd3, 0xd2, 0xc2, 0x54, 0x34, 0x48, 0x1b, 0x25, 0x27, 0xa1, 0x34,
-	0x83, 0xf4, 0x54, 0x2f, 0x05, 0x6b, 0xf7, 0x2c, 0x59, 0x4f, 0x7b, 0x91, 0x99, 0xe4, 0x6d, 0x1c,
-	0x4c, 0x66, 0xc2, 0x64, 0x94, 0x95, 0xfd, 0x04, 0x7b, 0xdb, 0x8f, 0xb5, 0x47, 0x8f, 0x7b, 0x92,
-	0x45, 0xbf, 0x81, 0x9f, 0x60, 0xc9, 0x24, 0x04, 0x59, 0xd8, 0xdb, 0xfb, 0xbd, 0xf7, 0xff, 0xff,
-	0xdf, 0x83, 0x87, 0x7e, 0x72, 0x16, 0x10, 0x9a, 0xa6, 0x31, 0x0f, 0xa8, 0xe6, 0x52, 0x64, 0x44,
-	0x2b, 0x2a, 0xb2, 0x5b, 0x50, 0x64, 0x3b, 0xae, 0x6a, 0x2f, 0x55, 0x52, 0x4b, 0xfc, 0x9d, 0xb3,
-	0xc0, 0xbb, 0x14, 0x7b, 0x95, 0x60, 0x3b, 0xee, 0x77, 0x22, 0x19, 0x49, 0x23, 0x24, 0x79, 0x55,
-	0x78, 0xdc, 0x7b, 0xd4, 0xbb, 0xda, 0x88, 0x88, 0xb3, 0x18, 0x16, 0x72, 0x0d, 0x62, 0x4e, 0x83,
-	0x35, 0xe8, 0x19, 0xd5, 0x14, 0x77, 0xd0, 0xc7, 0x10, 0x84, 0x4c, 0x6c, 0x6b, 0x68, 0x8d, 0xda,
-	0x7e, 0x01, 0xb8, 0x8b, 0x9a, 0x34, 0x91, 0x1b, 0xa1, 0xed, 0xfa, 0xd0, 0x1a, 0x35, 0xfc, 0x92,
-	0xf2, 0x7e, 0x06, 0x22, 0x04, 0x65, 0x7f, 0x30, 0xf2, 0x92, 0x70, 0x1f, 0xb5, 0x14, 0x04, 0xc0,
-	0xb7, 0xa0, 0xec, 0x86, 0x99, 0x54, 0xec, 0xfe, 0x45, 0x68, 0x96, 0x87, 0x2e, 0x14, 0x0d, 0x00,
-	0x63, 0xd4, 0x48, 0xa9, 0x5e, 0x95, 0xeb, 0x4c, 0x8d, 0x7f, 0x20, 0xc4, 0x68, 0x06, 0xcb, 0xe2,
-	0x90, 0xba, 0x99, 0xb4, 0xf3, 0x8e, 0xf1, 0xb9, 0x0f, 0x16, 0x6a, 0xce, 0xa9, 0xa2, 0x49, 0x86,
-	0x27, 0xe8, 0x73, 0xbe, 0x71, 0x09, 0x82, 0xb2, 0x18, 0x42, 0x93, 0xd2, 0x9a, 0xf6, 0xce, 0x87,
-	0xc1, 0xb7, 0x1d, 0x4d, 0xe2, 0x89, 0x7b, 0x39, 0x75, 0xfd, 0x4f, 0x39, 0xfe, 0x2f, 0x08, 0xff,
-	0x43, 0x5f, 0xcb, 0x9b, 0x2a, 0x7b, 0xdd, 0xd8, 0xfb, 0xe7, 0xc3, 0xa0, 0x5b, 0xd8, 0xdf, 0x08,
-	0x5c, 0xff, 0x4b, 0xd9, 0x29, 0x43, 0xa6, 0xd7, 0x4f, 0x47, 0xc7, 0xda, 0x1f, 0x1d, 0xeb, 0xe5,
-	0xe8, 0x58, 0x8f, 0x27, 0xa7, 0xb6, 0x3f, 0x39, 0xb5, 0xe7, 0x93, 0x53, 0xbb, 0xf9, 0x13, 0x71,
-	0xbd, 0xda, 0x30, 0x2f, 0x90, 0x09, 0x89, 0xb9, 0x00, 0x12, 0xb3, 0xe4, 0x57, 0x16, 0xae, 0xc9,
-	0x1d, 0x79, 0xff, 0xbd, 0x7a, 0x97, 0x42, 0xc6, 0x9a, 0xe6, 0x4b, 0xbf, 0x5f, 0x03, 0x00, 0x00,
-	0xff, 0xff, 0x98, 0xbb, 0xf9, 0x73, 0x08, 0x02, 0x00, 0x00,
+	// 363 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x51, 0x4f, 0xcb, 0xda, 0x30,
+	0x18, 0x37, 0xce, 0x57, 0xde, 0x37, 0x1b, 0x1b, 0x64, 0xa2, 0x45, 0xb6, 0x2a, 0x3d, 0x09, 0x63,
+	0x0d, 0xb2, 0xd3, 0xbc, 0x0c, 0x9c, 0xdb, 0x59, 0x3a, 0x4f, 0xbb, 0x48, 0xd2, 0x3e, 0xab, 0xc1,
+	0x26, 0x29, 0x69, 0x94, 0xf9, 0x11, 0x76, 0xda, 0x3e, 0xd6, 0x8e, 0x1e, 0x77, 0x92, 0xa1, 0xdf,
+	0xc0, 0x4f, 0x30, 0x9a, 0x96, 0x22, 0x83, 0xf7, 0xf6, 0xfb, 0x9b, 0xfc, 0xe0, 0xc1, 0x6f, 0x04,
+	0x8f, 0x29, 0xcb, 0xf3, 0x4c, 0xc4, 0xcc, 0x0a, 0xad, 0x0a, 0x6a, 0x0d, 0x53, 0xc5, 0x37, 0x30,
+	0x74, 0x3f, 0x6d, 0x70, 0x98, 0x1b, 0x6d, 0x35, 0x79, 0x25, 0x78, 0x1c, 0xde, 0x86, 0xc3, 0x26,
+	0xb0, 0x9f, 0x0e, 0x7b, 0xa9, 0x4e, 0xb5, 0x0b, 0xd2, 0x12, 0x55, 0x9d, 0xe0, 0x27, 0xc2, 0x83,
+	0xcf, 0x3b, 0x95, 0x0a, 0x9e, 0xc1, 0x4a, 0x6f, 0x41, 0x2d, 0x59, 0xbc, 0x05, 0xbb, 0x60, 0x96,
+	0x91, 0x1e, 0xbe, 0x4b, 0x40, 0x69, 0xe9, 0xa1, 0x31, 0x9a, 0x3c, 0x44, 0x15, 0x21, 0x7d, 0xdc,
+	0x65, 0x52, 0xef, 0x94, 0xf5, 0xda, 0x63, 0x34, 0xe9, 0x44, 0x35, 0x2b, 0xf5, 0x02, 0x54, 0x02,
+	0xc6, 0x7b, 0xe2, 0xe2, 0x35, 0x23, 0x43, 0x7c, 0x6f, 0x20, 0x06, 0xb1, 0x07, 0xe3, 0x75, 0x9c,
+	0xd3, 0x70, 0x42, 0x70, 0x47, 0x82, 0xd4, 0xde, 0x9d, 0xd3, 0x1d, 0x0e, 0x3e, 0x60, 0xbc, 0x28,
+	0x3f, 0x5a, 0x19, 0x16, 0x43, 0x99, 0xc8, 0x99, 0xdd, 0xd4, 0x13, 0x1c, 0x26, 0xaf, 0x31, 0xe6,
+	0xac, 0x80, 0x75, 0x35, 0xae, 0xed, 0x9c, 0x87, 0x52, 0x71, 0xbd, 0xe0, 0x07, 0xc2, 0xdd, 0x25,
+	0x33, 0x4c, 0x16, 0x64, 0x86, 0x9f, 0x95, 0x2b, 0xd6, 0xa0, 0x18, 0xcf, 0x20, 0x71, 0xaf, 0xdc,
+	0xcf, 0x07, 0xd7, 0xd3, 0xe8, 0xe5, 0x81, 0xc9, 0x6c, 0x16, 0xdc, 0xba, 0x41, 0xf4, 0xb4, 0xa4,
+	0x9f, 0x2a, 0x46, 0x3e, 0xe2, 0x17, 0xf5, 0xce, 0xa6, 0xde, 0x76, 0xf5, 0xe1, 0xf5, 0x34, 0xea,
+	0x57, 0xf5, 0xff, 0x02, 0x41, 0xf4, 0xbc, 0x56, 0xea, 0x47, 0xe6, 0x5f, 0x7e, 0x9f, 0x7d, 0x74,
+	0x3c, 0xfb, 0xe8, 0xef, 0xd9, 0x47, 0xbf, 0x2e, 0x7e, 0xeb, 0x78, 0xf1, 0x5b, 0x7f, 0x2e, 0x7e,
+	0xeb, 0xeb, 0xfb, 0x54, 0xd8, 0xcd, 0x8e, 0x87, 0xb1, 0x96, 0x34, 0x13, 0x0a, 0x68, 0xc6, 0xe5,
+	0xdb, 0x22, 0xd9, 0xd2, 0xef, 0xf4, 0xf1, 0x9b, 0xdb, 0x43, 0x0e, 0x05, 0xef, 0xba, 0xd3, 0xbd,
+	0xfb, 0x37, 0x00, 0x1d, 0x2a, 0x3c, 0xfc, 0x1d, 0x02, 0x00, 0x00,
 }
 
 func (m *FungibleTokenPacketData) Marshal() (dAtA []byte, err error) {
@@ -272,6 +281,13 @@ func (m *FungibleTokenPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Receiver) > 0 {
 		i -= len(m.Receiver)
 		copy(dAtA[i:], m.Receiver)
@@ -413,6 +429,10 @@ func (m *FungibleTokenPacketData) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTransfer(uint64(l))
 	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
 	return n
 }
 
@@ -598,6 +618,38 @@ func (m *FungibleTokenPacketData) Unmarshal(dAtA []byte) error {
 			}
 			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransfer(dAtA[iNdEx:])
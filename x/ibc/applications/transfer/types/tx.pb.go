@@ -50,6 +50,8 @@ type MsgTransfer struct {
 	// Timeout timestamp (in nanoseconds) relative to the current block timestamp.
 	// The timeout is disabled when set to 0.
 	TimeoutTimestamp uint64 `protobuf:"varint,7,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty" yaml:"timeout_timestamp"`
+	// optional memo
+	Memo string `protobuf:"bytes,8,opt,name=memo,proto3" json:"memo,omitempty"`
 }
 
 func (m *MsgTransfer) Reset()         { *m = MsgTransfer{} }
@@ -132,38 +134,39 @@ func init() {
 }
 
 var fileDescriptor_7401ed9bed2f8e09 = []byte{
-	// 487 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x6d, 0x92, 0x86, 0x70, 0x51, 0x2b, 0x38, 0x68, 0x64, 0x4c, 0xb1, 0x23, 0x4b, 0x48,
-	0x61, 0xe0, 0x4e, 0x0e, 0x03, 0xa2, 0x13, 0x72, 0x17, 0x18, 0x2a, 0x21, 0xd3, 0x89, 0xa5, 0xd8,
-	0xd7, 0xc3, 0x3e, 0xd5, 0xbe, 0xb3, 0xee, 0x2e, 0x56, 0xfb, 0x1f, 0x30, 0xf2, 0x27, 0x74, 0xe3,
-	0x5f, 0xe9, 0x98, 0x91, 0x29, 0x42, 0xc9, 0xc2, 0x9c, 0xbf, 0x00, 0xf9, 0x47, 0x42, 0x32, 0x80,
-	0x3a, 0xdd, 0xbd, 0xf7, 0xfd, 0xbc, 0xfb, 0xea, 0xbd, 0x7b, 0xe0, 0x05, 0x8b, 0x09, 0x8e, 0x8a,
-	0x22, 0x63, 0x24, 0xd2, 0x4c, 0x70, 0x85, 0xb5, 0x8c, 0xb8, 0xfa, 0x4a, 0x25, 0x2e, 0x7d, 0xac,
-	0xaf, 0x50, 0x21, 0x85, 0x16, 0xf0, 0x88, 0xc5, 0x04, 0x6d, 0x63, 0x68, 0x8d, 0xa1, 0xd2, 0xb7,
-	0x9f, 0x24, 0x22, 0x11, 0x35, 0x88, 0xab, 0x5b, 0x53, 0x63, 0x3f, 0xcb, 0xe2, 0x1c, 0xc7, 0x91,
-	0xa2, 0xb8, 0xf4, 0x63, 0xaa, 0x23, 0x1f, 0x13, 0xc1, 0x78, 0x2b, 0xba, 0x95, 0x2f, 0x11, 0x92,
-	0x62, 0x92, 0x31, 0xca, 0x75, 0xe5, 0xd6, 0xdc, 0x1a, 0xc0, 0xfb, 0xd1, 0x01, 0x83, 0x53, 0x95,
-	0x9c, 0xb5, 0x36, 0xf0, 0x0d, 0x18, 0x28, 0x31, 0x95, 0x84, 0x9e, 0x17, 0x42, 0x6a, 0xcb, 0x1c,
-	0x99, 0xe3, 0x07, 0xc1, 0x70, 0x35, 0x77, 0xe1, 0x75, 0x94, 0x67, 0xc7, 0xde, 0x96, 0xe8, 0x85,
-	0xa0, 0x89, 0x3e, 0x0a, 0xa9, 0xe1, 0x3b, 0x70, 0xd0, 0x6a, 0x24, 0x8d, 0x38, 0xa7, 0x99, 0x75,
-	0xaf, 0xae, 0x7d, 0xba, 0x9a, 0xbb, 0x87, 0x3b, 0xb5, 0xad, 0xee, 0x85, 0xfb, 0x4d, 0xe2, 0xa4,
-	0x89, 0xe1, 0x04, 0xec, 0x69, 0x71, 0x49, 0xb9, 0xd5, 0x19, 0x99, 0xe3, 0xc1, 0x64, 0x88, 0xb2,
-	0x38, 0x47, 0x55, 0x63, 0xa8, 0x6d, 0x0c, 0x9d, 0x08, 0xc6, 0x83, 0xee, 0xed, 0xdc, 0x35, 0xc2,
-	0x06, 0x85, 0x43, 0xd0, 0x53, 0x94, 0x5f, 0x50, 0x69, 0x75, 0x2b, 0xb7, 0xb0, 0x8d, 0xa0, 0x0d,
-	0xfa, 0x92, 0x12, 0xca, 0x4a, 0x2a, 0xad, 0xbd, 0x5a, 0xd9, 0xc4, 0xf0, 0x0b, 0x38, 0xd0, 0x2c,
-	0xa7, 0x62, 0xaa, 0xcf, 0x53, 0xca, 0x92, 0x54, 0x5b, 0xbd, 0xda, 0xd0, 0x46, 0xd5, 0xf4, 0xab,
-	0x61, 0xa1, 0x76, 0x44, 0xa5, 0x8f, 0xde, 0xd7, 0x44, 0xf0, 0xbc, 0x32, 0xfd, 0xdb, 0xc9, 0x6e,
-	0xbd, 0x17, 0xee, 0xb7, 0x89, 0x86, 0x86, 0x1f, 0xc0, 0xa3, 0x35, 0x51, 0x9d, 0x4a, 0x47, 0x79,
-	0x61, 0xdd, 0x1f, 0x99, 0xe3, 0x6e, 0x70, 0xb4, 0x9a, 0xbb, 0xd6, 0xee, 0x23, 0x1b, 0xc4, 0x0b,
-	0x1f, 0xb6, 0xb9, 0xb3, 0x75, 0xea, 0xb8, 0xff, 0xed, 0xc6, 0x35, 0x7e, 0xdf, 0xb8, 0x86, 0x77,
-	0x08, 0x1e, 0x6f, 0x7d, 0x54, 0x48, 0x55, 0x21, 0xb8, 0xa2, 0x13, 0x01, 0x3a, 0xa7, 0x2a, 0x81,
-	0x29, 0xe8, 0x6f, 0xfe, 0xf0, 0x25, 0xfa, 0xdf, 0x1a, 0xa1, 0xad, 0x57, 0x6c, 0xff, 0xce, 0xe8,
-	0xda, 0x30, 0xf8, 0x74, 0xbb, 0x70, 0xcc, 0xd9, 0xc2, 0x31, 0x7f, 0x2d, 0x1c, 0xf3, 0xfb, 0xd2,
-	0x31, 0x66, 0x4b, 0xc7, 0xf8, 0xb9, 0x74, 0x8c, 0xcf, 0x6f, 0x13, 0xa6, 0xd3, 0x69, 0x8c, 0x88,
-	0xc8, 0x71, 0xc6, 0x38, 0xc5, 0x59, 0x9c, 0xbf, 0x52, 0x17, 0x97, 0xf8, 0x0a, 0xff, 0x7b, 0xfd,
-	0xf5, 0x75, 0x41, 0x55, 0xdc, 0xab, 0xb7, 0xf1, 0xf5, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf9,
-	0x04, 0x61, 0xb0, 0x28, 0x03, 0x00, 0x00,
+	// 497 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xc7, 0xed, 0x5f, 0xd2, 0xfc, 0xc2, 0x46, 0xad, 0x60, 0xa1, 0x91, 0x09, 0xc5, 0x8e, 0x2c,
+	0x21, 0x85, 0x03, 0xbb, 0x72, 0x38, 0x20, 0x7a, 0x42, 0xe9, 0x05, 0x0e, 0x95, 0x90, 0xe9, 0x89,
+	0x4b, 0xb1, 0xb7, 0x83, 0xb3, 0xaa, 0xbd, 0x6b, 0xed, 0x6e, 0xac, 0xf6, 0x0d, 0x38, 0xf2, 0x08,
+	0x7d, 0x0f, 0x5e, 0xa0, 0xc7, 0x1e, 0x39, 0x45, 0x28, 0xb9, 0x70, 0xce, 0x13, 0x20, 0xff, 0x49,
+	0x48, 0x0e, 0x20, 0x4e, 0x9e, 0x99, 0xef, 0x67, 0xf6, 0xab, 0x99, 0x5d, 0xa3, 0x67, 0x3c, 0x66,
+	0x34, 0xca, 0xf3, 0x94, 0xb3, 0xc8, 0x70, 0x29, 0x34, 0x35, 0x2a, 0x12, 0xfa, 0x33, 0x28, 0x5a,
+	0x04, 0xd4, 0x5c, 0x91, 0x5c, 0x49, 0x23, 0xf1, 0x11, 0x8f, 0x19, 0xd9, 0xc6, 0xc8, 0x1a, 0x23,
+	0x45, 0x30, 0x78, 0x94, 0xc8, 0x44, 0x56, 0x20, 0x2d, 0xa3, 0xba, 0x67, 0xf0, 0x24, 0x8d, 0x33,
+	0x1a, 0x47, 0x1a, 0x68, 0x11, 0xc4, 0x60, 0xa2, 0x80, 0x32, 0xc9, 0x45, 0x23, 0x7a, 0xa5, 0x2f,
+	0x93, 0x0a, 0x28, 0x4b, 0x39, 0x08, 0x53, 0xba, 0xd5, 0x51, 0x0d, 0xf8, 0xdf, 0x5a, 0xa8, 0x77,
+	0xaa, 0x93, 0xb3, 0xc6, 0x06, 0xbf, 0x42, 0x3d, 0x2d, 0x67, 0x8a, 0xc1, 0x79, 0x2e, 0x95, 0x71,
+	0xec, 0xa1, 0x3d, 0xba, 0x37, 0xe9, 0xaf, 0xe6, 0x1e, 0xbe, 0x8e, 0xb2, 0xf4, 0xd8, 0xdf, 0x12,
+	0xfd, 0x10, 0xd5, 0xd9, 0x7b, 0xa9, 0x0c, 0x7e, 0x83, 0x0e, 0x1a, 0x8d, 0x4d, 0x23, 0x21, 0x20,
+	0x75, 0xfe, 0xab, 0x7a, 0x1f, 0xaf, 0xe6, 0xde, 0xe1, 0x4e, 0x6f, 0xa3, 0xfb, 0xe1, 0x7e, 0x5d,
+	0x38, 0xa9, 0x73, 0x3c, 0x46, 0x7b, 0x46, 0x5e, 0x82, 0x70, 0x5a, 0x43, 0x7b, 0xd4, 0x1b, 0xf7,
+	0x49, 0x1a, 0x67, 0xa4, 0x1c, 0x8c, 0x34, 0x83, 0x91, 0x13, 0xc9, 0xc5, 0xa4, 0x7d, 0x3b, 0xf7,
+	0xac, 0xb0, 0x46, 0x71, 0x1f, 0x75, 0x34, 0x88, 0x0b, 0x50, 0x4e, 0xbb, 0x74, 0x0b, 0x9b, 0x0c,
+	0x0f, 0x50, 0x57, 0x01, 0x03, 0x5e, 0x80, 0x72, 0xf6, 0x2a, 0x65, 0x93, 0xe3, 0x4f, 0xe8, 0xc0,
+	0xf0, 0x0c, 0xe4, 0xcc, 0x9c, 0x4f, 0x81, 0x27, 0x53, 0xe3, 0x74, 0x2a, 0xc3, 0x01, 0x29, 0xb7,
+	0x5f, 0x2e, 0x8b, 0x34, 0x2b, 0x2a, 0x02, 0xf2, 0xb6, 0x22, 0x26, 0x4f, 0x4b, 0xd3, 0xdf, 0x93,
+	0xec, 0xf6, 0xfb, 0xe1, 0x7e, 0x53, 0xa8, 0x69, 0xfc, 0x0e, 0x3d, 0x58, 0x13, 0xe5, 0x57, 0x9b,
+	0x28, 0xcb, 0x9d, 0xff, 0x87, 0xf6, 0xa8, 0x3d, 0x39, 0x5a, 0xcd, 0x3d, 0x67, 0xf7, 0x90, 0x0d,
+	0xe2, 0x87, 0xf7, 0x9b, 0xda, 0xd9, 0xba, 0x84, 0x31, 0x6a, 0x67, 0x90, 0x49, 0xa7, 0x5b, 0x0d,
+	0x51, 0xc5, 0xc7, 0xdd, 0x2f, 0x37, 0x9e, 0xf5, 0xf3, 0xc6, 0xb3, 0xfc, 0x43, 0xf4, 0x70, 0xeb,
+	0xf2, 0x42, 0xd0, 0xb9, 0x14, 0x1a, 0xc6, 0x12, 0xb5, 0x4e, 0x75, 0x82, 0xa7, 0xa8, 0xbb, 0xb9,
+	0xd7, 0xe7, 0xe4, 0x6f, 0x4f, 0x8b, 0x6c, 0x9d, 0x32, 0x08, 0xfe, 0x19, 0x5d, 0x1b, 0x4e, 0x3e,
+	0xdc, 0x2e, 0x5c, 0xfb, 0x6e, 0xe1, 0xda, 0x3f, 0x16, 0xae, 0xfd, 0x75, 0xe9, 0x5a, 0x77, 0x4b,
+	0xd7, 0xfa, 0xbe, 0x74, 0xad, 0x8f, 0xaf, 0x13, 0x6e, 0xa6, 0xb3, 0x98, 0x30, 0x99, 0xd1, 0x94,
+	0x0b, 0xa0, 0x69, 0x9c, 0xbd, 0xd0, 0x17, 0x97, 0xf4, 0x8a, 0xfe, 0xf9, 0x97, 0x30, 0xd7, 0x39,
+	0xe8, 0xb8, 0x53, 0xbd, 0xd0, 0x97, 0xbf, 0x06, 0x00, 0x37, 0xe1, 0x12, 0x97, 0x3c, 0x03, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -268,6 +271,13 @@ func (m *MsgTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.TimeoutTimestamp != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutTimestamp))
 		i--
@@ -387,6 +397,10 @@ func (m *MsgTransfer) Size() (n int) {
 	if m.TimeoutTimestamp != 0 {
 		n += 1 + sovTx(uint64(m.TimeoutTimestamp))
 	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -647,6 +661,38 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
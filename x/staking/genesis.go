@@ -94,6 +94,13 @@ func InitGenesis(
 		}
 	}
 
+	for _, td := range data.TransferredDelegations {
+		keeper.SetTransferredDelegation(ctx, td)
+
+		valAddr := td.GetValidatorAddr()
+		keeper.SetValidatorTransferredShares(ctx, valAddr, keeper.GetValidatorTransferredShares(ctx, valAddr).Add(td.Shares))
+	}
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -178,14 +185,15 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 	})
 
 	return &types.GenesisState{
-		Params:               keeper.GetParams(ctx),
-		LastTotalPower:       keeper.GetLastTotalPower(ctx),
-		LastValidatorPowers:  lastValidatorPowers,
-		Validators:           keeper.GetAllValidators(ctx),
-		Delegations:          keeper.GetAllDelegations(ctx),
-		UnbondingDelegations: unbondingDelegations,
-		Redelegations:        redelegations,
-		Exported:             true,
+		Params:                 keeper.GetParams(ctx),
+		LastTotalPower:         keeper.GetLastTotalPower(ctx),
+		LastValidatorPowers:    lastValidatorPowers,
+		Validators:             keeper.GetAllValidators(ctx),
+		Delegations:            keeper.GetAllDelegations(ctx),
+		UnbondingDelegations:   unbondingDelegations,
+		Redelegations:          redelegations,
+		Exported:               true,
+		TransferredDelegations: keeper.GetAllTransferredDelegations(ctx),
 	}
 }
 
@@ -221,6 +229,10 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateTransferredDelegations(data.Delegations, data.TransferredDelegations); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
@@ -256,3 +268,23 @@ func validateGenesisStateValidators(validators []types.Validator) error {
 
 	return nil
 }
+
+func validateGenesisStateTransferredDelegations(delegations []types.Delegation, tds []types.TransferredDelegation) error {
+	shares := make(map[string]sdk.Dec, len(delegations))
+	for _, del := range delegations {
+		shares[string(types.GetDelegationKey(del.GetDelegatorAddr(), sdk.ValAddress(del.ValidatorAddress)))] = del.Shares
+	}
+
+	for _, td := range tds {
+		if td.Shares.IsNil() || !td.Shares.IsPositive() {
+			return fmt.Errorf("transferred delegation must have positive shares: %v", td)
+		}
+
+		delShares, ok := shares[string(types.GetDelegationKey(td.GetDelegatorAddr(), td.GetValidatorAddr()))]
+		if !ok || delShares.LT(td.Shares) {
+			return fmt.Errorf("transferred delegation exceeds the delegation shares: %v", td)
+		}
+	}
+
+	return nil
+}
@@ -139,6 +139,10 @@ func TestValidateGenesis(t *testing.T) {
 	genValidators1[0].Tokens = sdk.OneInt()
 	genValidators1[0].DelegatorShares = sdk.OneDec()
 
+	delAddr := sdk.BytesToAccAddress(ed25519.GenPrivKey().PubKey().Address())
+	valAddr := genValidators1[0].GetOperator()
+	genDelegations1 := []types.Delegation{types.NewDelegation(delAddr, valAddr, sdk.NewDec(10))}
+
 	tests := []struct {
 		name    string
 		mutate  func(*types.GenesisState)
@@ -159,6 +163,22 @@ func TestValidateGenesis(t *testing.T) {
 			data.Validators[0].Jailed = true
 			data.Validators[0].Status = types.Bonded
 		}, true},
+		// validate genesis transferred delegations
+		{"transferred delegation", func(data *types.GenesisState) {
+			data.Delegations = genDelegations1
+			data.TransferredDelegations = []types.TransferredDelegation{types.NewTransferredDelegation(delAddr, valAddr, sdk.NewDec(10))}
+		}, false},
+		{"transferred delegation without delegation", func(data *types.GenesisState) {
+			data.TransferredDelegations = []types.TransferredDelegation{types.NewTransferredDelegation(delAddr, valAddr, sdk.NewDec(10))}
+		}, true},
+		{"transferred delegation exceeds delegation", func(data *types.GenesisState) {
+			data.Delegations = genDelegations1
+			data.TransferredDelegations = []types.TransferredDelegation{types.NewTransferredDelegation(delAddr, valAddr, sdk.NewDec(11))}
+		}, true},
+		{"transferred delegation with no shares", func(data *types.GenesisState) {
+			data.Delegations = genDelegations1
+			data.TransferredDelegations = []types.TransferredDelegation{types.NewTransferredDelegation(delAddr, valAddr, sdk.ZeroDec())}
+		}, true},
 	}
 
 	for _, tt := range tests {
@@ -1218,3 +1218,288 @@ func TestInvalidCoinDenom(t *testing.T) {
 	msgRedelegate = types.NewMsgBeginRedelegate(delAddr, valA, valB, oneCoin)
 	tstaking.Handle(msgRedelegate, true)
 }
+
+func TestCancelUnbondingDelegation(t *testing.T) {
+	initPower := int64(1000)
+	app, ctx, delAddrs, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 2, sdk.TokensFromConsensusPower(initPower))
+	validatorAddr, delegatorAddr := valAddrs[0], delAddrs[1]
+	tstaking := teststaking.NewHelper(t, ctx.WithBlockHeight(10), app.StakingKeeper)
+
+	// set the unbonding time
+	params := app.StakingKeeper.GetParams(ctx)
+	params.UnbondingTime = 7 * time.Second
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// create the validator and delegate
+	tstaking.CreateValidatorWithValPower(validatorAddr, PKs[0], 10, true)
+	delTokens := sdk.TokensFromConsensusPower(10)
+	tstaking.Delegate(delegatorAddr, validatorAddr, delTokens)
+	ctx = tstaking.TurnBlock(tstaking.Ctx.BlockHeader().Time)
+	tstaking.CheckValidator(validatorAddr, types.Bonded, false)
+
+	// begin unbonding
+	unbondAmt := sdk.TokensFromConsensusPower(4)
+	tstaking.Undelegate(delegatorAddr, validatorAddr, unbondAmt, true)
+	creationHeight := ctx.BlockHeight()
+
+	bondedPoolBalance := app.BankKeeper.GetBalance(ctx, app.StakingKeeper.GetBondedPool(ctx).GetAddress(), sdk.DefaultBondDenom)
+	notBondedPoolBalance := app.BankKeeper.GetBalance(ctx, app.StakingKeeper.GetNotBondedPool(ctx).GetAddress(), sdk.DefaultBondDenom)
+
+	// cannot cancel an entry of an unknown height
+	tstaking.CancelUnbondingDelegation(delegatorAddr, validatorAddr, creationHeight+1, sdk.OneInt(), false)
+
+	// cannot cancel more than the entry balance
+	tstaking.CancelUnbondingDelegation(delegatorAddr, validatorAddr, creationHeight, unbondAmt.AddRaw(1), false)
+
+	// cannot cancel a different denom
+	msg := types.NewMsgCancelUnbondingDelegation(delegatorAddr, validatorAddr, creationHeight, sdk.NewCoin("churros", sdk.OneInt()))
+	tstaking.Handle(msg, false)
+
+	// cancel part of the entry
+	cancelAmt := sdk.TokensFromConsensusPower(1)
+	res := tstaking.CancelUnbondingDelegation(delegatorAddr, validatorAddr, creationHeight, cancelAmt, true)
+	require.True(t, hasEvent(res.GetEvents(), types.EventTypeCancelUnbondingDelegation))
+
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, unbondAmt.Sub(cancelAmt), ubd.Entries[0].Balance)
+	require.Equal(t, unbondAmt.Sub(cancelAmt), ubd.Entries[0].InitialBalance)
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, delTokens.Sub(unbondAmt).Add(cancelAmt).ToDec(), delegation.Shares)
+
+	// the canceled tokens are moved back to the bonded pool
+	require.Equal(t, bondedPoolBalance.Amount.Add(cancelAmt),
+		app.BankKeeper.GetBalance(ctx, app.StakingKeeper.GetBondedPool(ctx).GetAddress(), sdk.DefaultBondDenom).Amount)
+	require.Equal(t, notBondedPoolBalance.Amount.Sub(cancelAmt),
+		app.BankKeeper.GetBalance(ctx, app.StakingKeeper.GetNotBondedPool(ctx).GetAddress(), sdk.DefaultBondDenom).Amount)
+
+	// cancel the rest of the entry, the unbonding delegation is removed
+	tstaking.CancelUnbondingDelegation(delegatorAddr, validatorAddr, creationHeight, unbondAmt.Sub(cancelAmt), true)
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delegatorAddr, validatorAddr)
+	require.False(t, found)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, delTokens.ToDec(), delegation.Shares)
+
+	// the stale unbonding queue entry is ignored once it matures
+	ctx = tstaking.TurnBlockTimeDiff(7 * time.Second)
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, delTokens.ToDec(), delegation.Shares)
+}
+
+func TestCancelUnbondingDelegationMatureOrJailed(t *testing.T) {
+	initPower := int64(1000)
+	app, ctx, delAddrs, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 2, sdk.TokensFromConsensusPower(initPower))
+	validatorAddr, delegatorAddr := valAddrs[0], delAddrs[1]
+	tstaking := teststaking.NewHelper(t, ctx.WithBlockHeight(10), app.StakingKeeper)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.UnbondingTime = 7 * time.Second
+	app.StakingKeeper.SetParams(ctx, params)
+
+	tstaking.CreateValidatorWithValPower(validatorAddr, PKs[0], 10, true)
+	tstaking.Delegate(delegatorAddr, validatorAddr, sdk.TokensFromConsensusPower(10))
+	ctx = tstaking.TurnBlock(tstaking.Ctx.BlockHeader().Time)
+
+	unbondAmt := sdk.TokensFromConsensusPower(2)
+	tstaking.Undelegate(delegatorAddr, validatorAddr, unbondAmt, true)
+	creationHeight := ctx.BlockHeight()
+
+	// cannot cancel while the validator is jailed
+	app.StakingKeeper.Jail(ctx, sdk.BytesToConsAddress(PKs[0].Address()))
+	tstaking.CancelUnbondingDelegation(delegatorAddr, validatorAddr, creationHeight, sdk.OneInt(), false)
+	app.StakingKeeper.Unjail(ctx, sdk.BytesToConsAddress(PKs[0].Address()))
+
+	// cannot cancel a mature entry even before it is completed by the end blocker
+	tstaking.Ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(7 * time.Second))
+	tstaking.CancelUnbondingDelegation(delegatorAddr, validatorAddr, creationHeight, sdk.OneInt(), false)
+}
+
+func TestCancelUnbondingDelegationAfterSlash(t *testing.T) {
+	initPower := int64(1000)
+	app, ctx, delAddrs, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 2, sdk.TokensFromConsensusPower(initPower))
+	validatorAddr, delegatorAddr := valAddrs[0], delAddrs[1]
+	tstaking := teststaking.NewHelper(t, ctx.WithBlockHeight(10), app.StakingKeeper)
+
+	tstaking.CreateValidatorWithValPower(validatorAddr, PKs[0], 10, true)
+	tstaking.Delegate(delegatorAddr, validatorAddr, sdk.TokensFromConsensusPower(10))
+	ctx = tstaking.TurnBlock(tstaking.Ctx.BlockHeader().Time)
+
+	unbondAmt := sdk.TokensFromConsensusPower(4)
+	tstaking.Undelegate(delegatorAddr, validatorAddr, unbondAmt, true)
+	creationHeight := ctx.BlockHeight()
+
+	// slash the validator for an infraction committed before the unbonding began
+	ctx = ctx.WithBlockHeight(creationHeight + 1)
+	tstaking.Ctx = ctx
+	app.StakingKeeper.Slash(ctx, sdk.BytesToConsAddress(PKs[0].Address()), creationHeight-1, 20, sdk.NewDecWithPrec(5, 1))
+
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	slashedBalance := ubd.Entries[0].Balance
+	require.Equal(t, unbondAmt.QuoRaw(2), slashedBalance)
+
+	// the slashed tokens cannot be delegated back
+	tstaking.CancelUnbondingDelegation(delegatorAddr, validatorAddr, creationHeight, slashedBalance.AddRaw(1), false)
+	tstaking.CancelUnbondingDelegation(delegatorAddr, validatorAddr, creationHeight, slashedBalance, true)
+
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delegatorAddr, validatorAddr)
+	require.False(t, found)
+}
+
+func hasEvent(events sdk.Events, eventType string) bool {
+	for _, e := range events {
+		if e.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func TestTransferDelegation(t *testing.T) {
+	initPower := int64(1000)
+	app, ctx, delAddrs, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 3, sdk.TokensFromConsensusPower(initPower))
+	validatorAddr, delegatorAddr, recipientAddr := valAddrs[0], delAddrs[1], delAddrs[2]
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// create the validator and delegate
+	valTokens := tstaking.CreateValidatorWithValPower(validatorAddr, PKs[0], 10, true)
+	delTokens := sdk.TokensFromConsensusPower(10)
+	tstaking.Delegate(delegatorAddr, validatorAddr, delTokens)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// transfer part of the delegation
+	transferAmt := sdk.TokensFromConsensusPower(4)
+	res := tstaking.TransferDelegation(delegatorAddr, validatorAddr, recipientAddr, transferAmt, true)
+	require.True(t, hasEvent(res.GetEvents(), types.EventTypeTransferDelegation))
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, delTokens.Sub(transferAmt).ToDec(), delegation.Shares)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, recipientAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, transferAmt.ToDec(), delegation.Shares)
+
+	// the validator is not affected by the transfer
+	validator := tstaking.CheckValidator(validatorAddr, types.Bonded, false)
+	require.Equal(t, valTokens.Add(delTokens), validator.Tokens)
+
+	// the received shares are tracked as transferred
+	td, found := app.StakingKeeper.GetTransferredDelegation(ctx, recipientAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, transferAmt.ToDec(), td.Shares)
+	_, found = app.StakingKeeper.GetTransferredDelegation(ctx, delegatorAddr, validatorAddr)
+	require.False(t, found)
+	require.Equal(t, transferAmt.ToDec(), app.StakingKeeper.GetValidatorTransferredShares(ctx, validatorAddr))
+
+	// unbonding releases the transferred shares
+	unbondAmt := sdk.TokensFromConsensusPower(1)
+	tstaking.Undelegate(recipientAddr, validatorAddr, unbondAmt, true)
+	td, found = app.StakingKeeper.GetTransferredDelegation(ctx, recipientAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, transferAmt.Sub(unbondAmt).ToDec(), td.Shares)
+	require.Equal(t, transferAmt.Sub(unbondAmt).ToDec(), app.StakingKeeper.GetValidatorTransferredShares(ctx, validatorAddr))
+
+	// transferring the shares back moves the tracked shares along
+	tstaking.TransferDelegation(recipientAddr, validatorAddr, delegatorAddr, transferAmt.Sub(unbondAmt), true)
+	_, found = app.StakingKeeper.GetDelegation(ctx, recipientAddr, validatorAddr)
+	require.False(t, found)
+	_, found = app.StakingKeeper.GetTransferredDelegation(ctx, recipientAddr, validatorAddr)
+	require.False(t, found)
+	td, found = app.StakingKeeper.GetTransferredDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, transferAmt.Sub(unbondAmt).ToDec(), td.Shares)
+	require.Equal(t, transferAmt.Sub(unbondAmt).ToDec(), app.StakingKeeper.GetValidatorTransferredShares(ctx, validatorAddr))
+
+	// shares not received through a transfer are unbonded first
+	tstaking.Undelegate(delegatorAddr, validatorAddr, delTokens.Sub(transferAmt), true)
+	td, found = app.StakingKeeper.GetTransferredDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, transferAmt.Sub(unbondAmt).ToDec(), td.Shares)
+
+	tstaking.Undelegate(delegatorAddr, validatorAddr, transferAmt.Sub(unbondAmt), true)
+	_, found = app.StakingKeeper.GetTransferredDelegation(ctx, delegatorAddr, validatorAddr)
+	require.False(t, found)
+	require.True(t, app.StakingKeeper.GetValidatorTransferredShares(ctx, validatorAddr).IsZero())
+}
+
+func TestTransferDelegationInvalid(t *testing.T) {
+	initPower := int64(1000)
+	app, ctx, delAddrs, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 3, sdk.TokensFromConsensusPower(initPower))
+	validatorAddr, srcValidatorAddr, delegatorAddr := valAddrs[0], valAddrs[1], delAddrs[2]
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	tstaking.CreateValidatorWithValPower(validatorAddr, PKs[0], 10, true)
+	tstaking.CreateValidatorWithValPower(srcValidatorAddr, PKs[1], 10, true)
+	tstaking.Delegate(delegatorAddr, validatorAddr, sdk.TokensFromConsensusPower(10))
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	amt := sdk.TokensFromConsensusPower(1)
+
+	// the self-delegation cannot be transferred
+	tstaking.TransferDelegation(validatorAddr.ToAccAddress(), validatorAddr, delegatorAddr, amt, false)
+
+	// the delegation cannot be transferred to the delegator itself
+	tstaking.TransferDelegation(delegatorAddr, validatorAddr, delegatorAddr, amt, false)
+
+	// no delegation to transfer
+	tstaking.TransferDelegation(delegatorAddr, srcValidatorAddr, validatorAddr.ToAccAddress(), amt, false)
+
+	// more than the delegation
+	tstaking.TransferDelegation(delegatorAddr, validatorAddr, srcValidatorAddr.ToAccAddress(), sdk.TokensFromConsensusPower(11), false)
+
+	// a different denom
+	msg := types.NewMsgTransferDelegation(delegatorAddr, validatorAddr, srcValidatorAddr.ToAccAddress(), sdk.NewCoin("churros", amt))
+	tstaking.Handle(msg, false)
+
+	// a delegation with a receiving redelegation in progress may still be slashed
+	// for an infraction of the source validator
+	tstaking.Delegate(delegatorAddr, srcValidatorAddr, amt)
+	msgRedelegate := types.NewMsgBeginRedelegate(delegatorAddr, srcValidatorAddr, validatorAddr, sdk.NewCoin(sdk.DefaultBondDenom, amt))
+	tstaking.Handle(msgRedelegate, true)
+	tstaking.TransferDelegation(delegatorAddr, validatorAddr, srcValidatorAddr.ToAccAddress(), amt, false)
+}
+
+func TestTransferDelegationCaps(t *testing.T) {
+	initPower := int64(1000)
+	app, ctx, delAddrs, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 4, sdk.TokensFromConsensusPower(initPower))
+	valA, valB, delegatorAddr, recipientAddr := valAddrs[0], valAddrs[1], delAddrs[2], delAddrs[3]
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// two validators with 20 power each, half of which is delegated
+	tstaking.CreateValidatorWithValPower(valA, PKs[0], 10, true)
+	tstaking.CreateValidatorWithValPower(valB, PKs[1], 10, true)
+	tstaking.DelegateWithPower(delegatorAddr, valA, 10)
+	tstaking.DelegateWithPower(delegatorAddr, valB, 10)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.ValidatorTransferCap = sdk.NewDecWithPrec(2, 1)
+	params.GlobalTransferCap = sdk.NewDecWithPrec(15, 2)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// up to 20% of the shares of a validator can be transferred
+	tstaking.TransferDelegation(delegatorAddr, valA, recipientAddr, sdk.TokensFromConsensusPower(5), false)
+	tstaking.TransferDelegation(delegatorAddr, valA, recipientAddr, sdk.TokensFromConsensusPower(4), true)
+	tstaking.TransferDelegation(delegatorAddr, valA, recipientAddr, sdk.TokensFromConsensusPower(1), false)
+
+	// transferring the transferred shares again does not count twice
+	tstaking.TransferDelegation(recipientAddr, valA, delegatorAddr, sdk.TokensFromConsensusPower(4), true)
+	require.Equal(t, sdk.TokensFromConsensusPower(4).ToDec(), app.StakingKeeper.GetValidatorTransferredShares(ctx, valA))
+	tstaking.TransferDelegation(delegatorAddr, valA, recipientAddr, sdk.TokensFromConsensusPower(1), false)
+
+	// up to 15% of the bonded tokens can be transferred
+	tstaking.TransferDelegation(delegatorAddr, valB, recipientAddr, sdk.TokensFromConsensusPower(3), false)
+	tstaking.TransferDelegation(delegatorAddr, valB, recipientAddr, sdk.TokensFromConsensusPower(2), true)
+
+	// unbonding transferred shares frees up the caps
+	tstaking.Undelegate(delegatorAddr, valA, sdk.TokensFromConsensusPower(10), true)
+	require.True(t, app.StakingKeeper.GetValidatorTransferredShares(ctx, valA).IsZero())
+	tstaking.TransferDelegation(delegatorAddr, valB, recipientAddr, sdk.TokensFromConsensusPower(1), true)
+}
@@ -613,6 +613,9 @@ func (k Keeper) Unbond(
 	// subtract shares from delegation
 	delegation.Shares = delegation.Shares.Sub(shares)
 
+	// release the transferred shares that are no longer held by the delegation
+	k.decreaseTransferredShares(ctx, delAddr, valAddr, delegation.Shares)
+
 	delegatorAddress := sdk.AccAddress(delegation.DelegatorAddress)
 
 	isValidatorOperator := delegatorAddress.Equals(validator.GetOperator().ToAccAddress())
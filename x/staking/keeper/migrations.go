@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/staking/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2. The transfer cap params, which
+// did not exist before, are set to their defaults, leaving the transferred
+// delegations unlimited.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.paramstore.Set(ctx, types.KeyGlobalTransferCap, types.DefaultGlobalTransferCap)
+	m.keeper.paramstore.Set(ctx, types.KeyValidatorTransferCap, types.DefaultValidatorTransferCap)
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package keeper_test
+
+import (
+	"testing"
+
+	ocproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/simapp"
+	"github.com/line/lbm-sdk/store/prefix"
+	paramstypes "github.com/line/lbm-sdk/x/params/types"
+	"github.com/line/lbm-sdk/x/staking/keeper"
+	"github.com/line/lbm-sdk/x/staking/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, ocproto.Header{})
+
+	// remove the transfer cap params as they were absent before version 2
+	subspace := app.GetSubspace(types.ModuleName)
+	store := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+	store.Delete(types.KeyGlobalTransferCap)
+	store.Delete(types.KeyValidatorTransferCap)
+	require.Nil(t, subspace.GetRaw(ctx, types.KeyGlobalTransferCap))
+	require.Nil(t, subspace.GetRaw(ctx, types.KeyValidatorTransferCap))
+
+	require.NoError(t, keeper.NewMigrator(app.StakingKeeper).Migrate1to2(ctx))
+
+	require.NotNil(t, subspace.GetRaw(ctx, types.KeyGlobalTransferCap))
+	require.NotNil(t, subspace.GetRaw(ctx, types.KeyValidatorTransferCap))
+
+	params := app.StakingKeeper.GetParams(ctx)
+	require.Equal(t, types.DefaultGlobalTransferCap, params.GlobalTransferCap)
+	require.Equal(t, types.DefaultValidatorTransferCap, params.ValidatorTransferCap)
+	require.NoError(t, params.Validate())
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	metrics "github.com/armon/go-metrics"
@@ -328,3 +329,161 @@ func (k msgServer) Undelegate(goCtx context.Context, msg *types.MsgUndelegate) (
 		CompletionTime: completionTime,
 	}, nil
 }
+
+// CancelUnbondingDelegation defines a method for canceling an unbonding delegation
+// entry and delegating its remaining balance back to the validator.
+func (k msgServer) CancelUnbondingDelegation(goCtx context.Context, msg *types.MsgCancelUnbondingDelegation) (*types.MsgCancelUnbondingDelegationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr := sdk.ValAddress(msg.ValidatorAddress)
+	delegatorAddress := sdk.AccAddress(msg.DelegatorAddress)
+
+	bondDenom := k.BondDenom(ctx)
+	if msg.Amount.Denom != bondDenom {
+		return nil, sdkerrors.Wrapf(types.ErrBadDenom, "got %s, expected %s", msg.Amount.Denom, bondDenom)
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	// a jailed validator may still be slashed for the infraction that jailed it,
+	// so tokens must not be moved back into its delegation until it is unjailed
+	if validator.IsJailed() {
+		return nil, types.ErrValidatorJailed
+	}
+
+	ubd, found := k.GetUnbondingDelegation(ctx, delegatorAddress, valAddr)
+	if !found {
+		return nil, types.ErrNoUnbondingDelegation
+	}
+
+	entryIndex := -1
+	for i, entry := range ubd.Entries {
+		if entry.CreationHeight == msg.CreationHeight {
+			entryIndex = i
+			break
+		}
+	}
+	if entryIndex == -1 {
+		return nil, sdkerrors.Wrapf(types.ErrNoUnbondingDelegationEntry, "height %d", msg.CreationHeight)
+	}
+
+	// the entry balance already reflects any slashing that happened while
+	// unbonding, so only the remaining balance can be delegated back
+	entry := ubd.Entries[entryIndex]
+	if entry.Balance.LT(msg.Amount.Amount) {
+		return nil, sdkerrors.Wrapf(types.ErrUnbondingEntryTooSmall, "got %s, balance %s", msg.Amount.Amount, entry.Balance)
+	}
+
+	if entry.IsMature(ctx.BlockHeader().Time) {
+		return nil, types.ErrUnbondingEntryMature
+	}
+
+	// the unbonding tokens are held by the not bonded pool, Delegate moves them
+	// to the bonded pool when necessary and calls the delegation hooks
+	if _, err := k.Keeper.Delegate(ctx, delegatorAddress, msg.Amount.Amount, types.Unbonding, validator, false); err != nil {
+		return nil, err
+	}
+
+	remaining := entry.Balance.Sub(msg.Amount.Amount)
+	if remaining.IsZero() {
+		ubd.RemoveEntry(int64(entryIndex))
+	} else {
+		// the initial balance is reduced as well so that future slashes of the
+		// entry do not account for the tokens that were delegated back
+		entry.Balance = remaining
+		entry.InitialBalance = entry.InitialBalance.Sub(msg.Amount.Amount)
+		ubd.Entries[entryIndex] = entry
+	}
+
+	// set the unbonding delegation or remove it if there are no more entries
+	if len(ubd.Entries) == 0 {
+		k.RemoveUnbondingDelegation(ctx, ubd)
+	} else {
+		k.SetUnbondingDelegation(ctx, ubd)
+	}
+
+	if msg.Amount.Amount.IsInt64() {
+		defer func() {
+			telemetry.IncrCounter(1, types.ModuleName, "cancel_unbonding_delegation")
+			telemetry.SetGaugeWithLabels(
+				[]string{"tx", "msg", msg.Type()},
+				float32(msg.Amount.Amount.Int64()),
+				[]metrics.Label{telemetry.NewLabel("denom", msg.Amount.Denom)},
+			)
+		}()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelUnbondingDelegation,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCreationHeight, strconv.FormatInt(msg.CreationHeight, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress),
+		),
+	})
+
+	return &types.MsgCancelUnbondingDelegationResponse{}, nil
+}
+
+// TransferDelegation defines a method for transferring delegation shares to
+// another account without unbonding them.
+func (k msgServer) TransferDelegation(goCtx context.Context, msg *types.MsgTransferDelegation) (*types.MsgTransferDelegationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr := sdk.ValAddress(msg.ValidatorAddress)
+	delegatorAddress := sdk.AccAddress(msg.DelegatorAddress)
+	recipientAddress := sdk.AccAddress(msg.RecipientAddress)
+
+	bondDenom := k.BondDenom(ctx)
+	if msg.Amount.Denom != bondDenom {
+		return nil, sdkerrors.Wrapf(types.ErrBadDenom, "got %s, expected %s", msg.Amount.Denom, bondDenom)
+	}
+
+	shares, err := k.ValidateUnbondAmount(
+		ctx, delegatorAddress, valAddr, msg.Amount.Amount,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.TransferDelegation(ctx, delegatorAddress, recipientAddress, valAddr, shares); err != nil {
+		return nil, err
+	}
+
+	if msg.Amount.Amount.IsInt64() {
+		defer func() {
+			telemetry.IncrCounter(1, types.ModuleName, "transfer_delegation")
+			telemetry.SetGaugeWithLabels(
+				[]string{"tx", "msg", msg.Type()},
+				float32(msg.Amount.Amount.Int64()),
+				[]metrics.Label{telemetry.NewLabel("denom", msg.Amount.Denom)},
+			)
+		}()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTransferDelegation,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.RecipientAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress),
+		),
+	})
+
+	return &types.MsgTransferDelegationResponse{}, nil
+}
@@ -39,6 +39,20 @@ func (k Keeper) BondDenom(ctx sdk.Context) (res string) {
 	return
 }
 
+// GlobalTransferCap - Maximum fraction of the total bonded tokens that may
+// be held in transferred delegations
+func (k Keeper) GlobalTransferCap(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyGlobalTransferCap, &res)
+	return
+}
+
+// ValidatorTransferCap - Maximum fraction of a validator's delegator shares
+// that may be held in transferred delegations
+func (k Keeper) ValidatorTransferCap(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyValidatorTransferCap, &res)
+	return
+}
+
 // Get all parameteras as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return types.NewParams(
@@ -47,6 +61,8 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.MaxEntries(ctx),
 		k.HistoricalEntries(ctx),
 		k.BondDenom(ctx),
+		k.GlobalTransferCap(ctx),
+		k.ValidatorTransferCap(ctx),
 	)
 }
 
@@ -0,0 +1,239 @@
+package keeper
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	vestexported "github.com/line/lbm-sdk/x/auth/vesting/exported"
+	"github.com/line/lbm-sdk/x/staking/types"
+)
+
+// return a specific transferred delegation
+func (k Keeper) GetTransferredDelegation(ctx sdk.Context,
+	delAddr sdk.AccAddress, valAddr sdk.ValAddress) (td types.TransferredDelegation, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetTransferredDelegationKey(delAddr, valAddr))
+	if value == nil {
+		return td, false
+	}
+
+	return types.MustUnmarshalTransferredDelegation(k.cdc, value), true
+}
+
+// set a transferred delegation
+func (k Keeper) SetTransferredDelegation(ctx sdk.Context, td types.TransferredDelegation) {
+	store := ctx.KVStore(k.storeKey)
+	b := types.MustMarshalTransferredDelegation(k.cdc, td)
+	store.Set(types.GetTransferredDelegationKey(td.GetDelegatorAddr(), td.GetValidatorAddr()), b)
+}
+
+// remove a transferred delegation
+func (k Keeper) RemoveTransferredDelegation(ctx sdk.Context, td types.TransferredDelegation) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTransferredDelegationKey(td.GetDelegatorAddr(), td.GetValidatorAddr()))
+}
+
+// IterateTransferredDelegations iterates through all of the transferred delegations
+func (k Keeper) IterateTransferredDelegations(ctx sdk.Context, cb func(td types.TransferredDelegation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.TransferredDelegationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		td := types.MustUnmarshalTransferredDelegation(k.cdc, iterator.Value())
+		if cb(td) {
+			break
+		}
+	}
+}
+
+// GetAllTransferredDelegations returns all transferred delegations used during genesis dump.
+func (k Keeper) GetAllTransferredDelegations(ctx sdk.Context) (tds []types.TransferredDelegation) {
+	k.IterateTransferredDelegations(ctx, func(td types.TransferredDelegation) bool {
+		tds = append(tds, td)
+		return false
+	})
+
+	return tds
+}
+
+// GetValidatorTransferredShares returns the delegation shares of a validator
+// held in transferred delegations.
+func (k Keeper) GetValidatorTransferredShares(ctx sdk.Context, valAddr sdk.ValAddress) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetValidatorTransferredKey(valAddr))
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	shares := sdk.DecProto{}
+	k.cdc.MustUnmarshalBinaryBare(bz, &shares)
+
+	return shares.Dec
+}
+
+// SetValidatorTransferredShares sets the delegation shares of a validator
+// held in transferred delegations.
+func (k Keeper) SetValidatorTransferredShares(ctx sdk.Context, valAddr sdk.ValAddress, shares sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+
+	if shares.IsZero() {
+		store.Delete(types.GetValidatorTransferredKey(valAddr))
+		return
+	}
+
+	bz := k.cdc.MustMarshalBinaryBare(&sdk.DecProto{Dec: shares})
+	store.Set(types.GetValidatorTransferredKey(valAddr), bz)
+}
+
+// GetTotalTransferredTokens returns the tokens worth of all the transferred
+// delegation shares.
+func (k Keeper) GetTotalTransferredTokens(ctx sdk.Context) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorTransferredKey)
+	defer iterator.Close()
+
+	total := sdk.ZeroDec()
+	for ; iterator.Valid(); iterator.Next() {
+		valAddr := sdk.ValAddress(iterator.Key()[len(types.ValidatorTransferredKey):])
+
+		shares := sdk.DecProto{}
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &shares)
+
+		validator := k.mustGetValidator(ctx, valAddr)
+		total = total.Add(validator.TokensFromShares(shares.Dec))
+	}
+
+	return total
+}
+
+// increaseTransferredShares records delegation shares received through a transfer.
+func (k Keeper) increaseTransferredShares(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec) {
+	td, found := k.GetTransferredDelegation(ctx, delAddr, valAddr)
+	if !found {
+		td = types.NewTransferredDelegation(delAddr, valAddr, sdk.ZeroDec())
+	}
+
+	td.Shares = td.Shares.Add(shares)
+	k.SetTransferredDelegation(ctx, td)
+
+	k.SetValidatorTransferredShares(ctx, valAddr, k.GetValidatorTransferredShares(ctx, valAddr).Add(shares))
+}
+
+// decreaseTransferredShares releases transferred delegation shares of a
+// delegation whose shares are being removed. Shares not received through a
+// transfer are removed first.
+func (k Keeper) decreaseTransferredShares(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, remainingShares sdk.Dec) {
+	td, found := k.GetTransferredDelegation(ctx, delAddr, valAddr)
+	if !found || td.Shares.LTE(remainingShares) {
+		return
+	}
+
+	released := td.Shares.Sub(remainingShares)
+	if remainingShares.IsZero() {
+		k.RemoveTransferredDelegation(ctx, td)
+	} else {
+		td.Shares = remainingShares
+		k.SetTransferredDelegation(ctx, td)
+	}
+
+	k.SetValidatorTransferredShares(ctx, valAddr, k.GetValidatorTransferredShares(ctx, valAddr).Sub(released))
+}
+
+// TransferDelegation moves delegation shares from a delegator to a recipient
+// without unbonding them. The transferred shares count against the transfer
+// caps until they are unbonded, redelegated or transferred again.
+func (k Keeper) TransferDelegation(
+	ctx sdk.Context, delAddr, recipientAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec,
+) error {
+	if delAddr.Equals(recipientAddr) {
+		return types.ErrTransferToSelf
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound
+	}
+
+	if delAddr.Equals(valAddr.ToAccAddress()) {
+		return types.ErrSelfDelegationTransfer
+	}
+
+	// vesting accounts track their delegated coins, so the delegation must be
+	// unbonded to the same account it was delegated from
+	if acc := k.authKeeper.GetAccount(ctx, delAddr); acc != nil {
+		if _, ok := acc.(vestexported.VestingAccount); ok {
+			return types.ErrVestingDelegationTransfer
+		}
+	}
+
+	// shares received through a redelegation may still be slashed for an
+	// infraction of the source validator, which is applied to this delegation
+	if k.HasReceivingRedelegation(ctx, delAddr, valAddr) {
+		return types.ErrTransferReceivingRedelegation
+	}
+
+	delegation, found := k.GetDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return types.ErrNoDelegatorForAddress
+	}
+
+	if delegation.Shares.LT(shares) {
+		return sdkerrors.Wrap(types.ErrNotEnoughDelegationShares, delegation.Shares.String())
+	}
+
+	// the delegator's shares that were already counted as transferred and no
+	// longer fit in its remaining delegation are released by this transfer
+	released := sdk.ZeroDec()
+	if td, found := k.GetTransferredDelegation(ctx, delAddr, valAddr); found {
+		released = sdk.MaxDec(td.Shares.Sub(delegation.Shares.Sub(shares)), sdk.ZeroDec())
+	}
+	newlyTransferred := shares.Sub(released)
+
+	// a cap of one leaves the transfers unlimited
+	if validatorCap := k.ValidatorTransferCap(ctx); validatorCap.LT(sdk.OneDec()) {
+		validatorTransferred := k.GetValidatorTransferredShares(ctx, valAddr).Add(newlyTransferred)
+		if validatorTransferred.GT(validator.DelegatorShares.Mul(validatorCap)) {
+			return types.ErrValidatorTransferCapExceeded
+		}
+	}
+
+	if globalCap := k.GlobalTransferCap(ctx); globalCap.LT(sdk.OneDec()) {
+		totalTransferred := k.GetTotalTransferredTokens(ctx).Add(validator.TokensFromShares(newlyTransferred))
+		if totalTransferred.GT(k.TotalBondedTokens(ctx).ToDec().Mul(globalCap)) {
+			return types.ErrGlobalTransferCapExceeded
+		}
+	}
+
+	// withdraw the rewards of the delegator before its shares are modified
+	k.BeforeDelegationSharesModified(ctx, delAddr, valAddr)
+
+	delegation.Shares = delegation.Shares.Sub(shares)
+	k.decreaseTransferredShares(ctx, delAddr, valAddr, delegation.Shares)
+
+	if delegation.Shares.IsZero() {
+		k.RemoveDelegation(ctx, delegation)
+	} else {
+		k.SetDelegation(ctx, delegation)
+		k.AfterDelegationModified(ctx, delAddr, valAddr)
+	}
+
+	recipientDelegation, found := k.GetDelegation(ctx, recipientAddr, valAddr)
+	if found {
+		k.BeforeDelegationSharesModified(ctx, recipientAddr, valAddr)
+	} else {
+		recipientDelegation = types.NewDelegation(recipientAddr, valAddr, sdk.ZeroDec())
+		k.BeforeDelegationCreated(ctx, recipientAddr, valAddr)
+	}
+
+	recipientDelegation.Shares = recipientDelegation.Shares.Add(shares)
+	k.SetDelegation(ctx, recipientDelegation)
+	k.AfterDelegationModified(ctx, recipientAddr, valAddr)
+
+	k.increaseTransferredShares(ctx, recipientAddr, valAddr, shares)
+
+	return nil
+}
@@ -57,6 +57,20 @@ func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshalBinaryBare(kvB.Value, &redB)
 
 			return fmt.Sprintf("%v\n%v", redA, redB)
+		case bytes.Equal(kvA.Key[:1], types.TransferredDelegationKey):
+			var tdA, tdB types.TransferredDelegation
+
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &tdA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &tdB)
+
+			return fmt.Sprintf("%v\n%v", tdA, tdB)
+		case bytes.Equal(kvA.Key[:1], types.ValidatorTransferredKey):
+			var sharesA, sharesB sdk.DecProto
+
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &sharesA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &sharesB)
+
+			return fmt.Sprintf("%v\n%v", sharesA, sharesB)
 		default:
 			panic(fmt.Sprintf("invalid staking key prefix %X", kvA.Key[:1]))
 		}
@@ -42,6 +42,8 @@ func TestDecodeStore(t *testing.T) {
 	del := types.NewDelegation(delAddr1, valAddr1, sdk.OneDec())
 	ubd := types.NewUnbondingDelegation(delAddr1, valAddr1, 15, bondTime, sdk.OneInt())
 	red := types.NewRedelegation(delAddr1, valAddr1, valAddr1, 12, bondTime, sdk.OneInt(), sdk.OneDec())
+	td := types.NewTransferredDelegation(delAddr1, valAddr1, sdk.OneDec())
+	transferred := sdk.DecProto{Dec: sdk.OneDec()}
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
@@ -51,6 +53,8 @@ func TestDecodeStore(t *testing.T) {
 			{Key: types.GetDelegationKey(delAddr1, valAddr1), Value: cdc.MustMarshalBinaryBare(&del)},
 			{Key: types.GetUBDKey(delAddr1, valAddr1), Value: cdc.MustMarshalBinaryBare(&ubd)},
 			{Key: types.GetREDKey(delAddr1, valAddr1, valAddr1), Value: cdc.MustMarshalBinaryBare(&red)},
+			{Key: types.GetTransferredDelegationKey(delAddr1, valAddr1), Value: cdc.MustMarshalBinaryBare(&td)},
+			{Key: types.GetValidatorTransferredKey(valAddr1), Value: cdc.MustMarshalBinaryBare(&transferred)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -65,6 +69,8 @@ func TestDecodeStore(t *testing.T) {
 		{"Delegation", fmt.Sprintf("%v\n%v", del, del)},
 		{"UnbondingDelegation", fmt.Sprintf("%v\n%v", ubd, ubd)},
 		{"Redelegation", fmt.Sprintf("%v\n%v", red, red)},
+		{"TransferredDelegation", fmt.Sprintf("%v\n%v", td, td)},
+		{"ValidatorTransferred", fmt.Sprintf("%v\n%v", transferred, transferred)},
 		{"other", ""},
 	}
 	for i, tt := range tests {
@@ -47,6 +47,8 @@ func TestWeightedOperations(t *testing.T) {
 		{simappparams.DefaultWeightMsgDelegate, types.ModuleName, types.TypeMsgDelegate},
 		{simappparams.DefaultWeightMsgUndelegate, types.ModuleName, types.TypeMsgUndelegate},
 		{simappparams.DefaultWeightMsgBeginRedelegate, types.ModuleName, types.TypeMsgBeginRedelegate},
+		{simappparams.DefaultWeightMsgCancelUnbondingDelegation, types.ModuleName, types.TypeMsgCancelUnbondingDelegation},
+		{simappparams.DefaultWeightMsgTransferDelegation, types.ModuleName, types.TypeMsgTransferDelegation},
 	}
 
 	for i, w := range weightesOps {
@@ -211,6 +213,108 @@ func TestSimulateMsgUndelegate(t *testing.T) {
 
 }
 
+// TestSimulateMsgCancelUnbondingDelegation tests the normal scenario of a valid message of type TypeMsgCancelUnbondingDelegation.
+// Abonormal scenarios, where the message is created by an errors, are not tested here.
+func TestSimulateMsgCancelUnbondingDelegation(t *testing.T) {
+	app, ctx := createTestApp(false)
+	blockTime := time.Now().UTC()
+	ctx = ctx.WithBlockTime(blockTime)
+
+	// setup 3 accounts
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := getTestingAccounts(t, r, app, ctx, 3)
+
+	// setup accounts[0] as validator
+	validator0 := getTestingValidator0(t, app, ctx, accounts)
+
+	// setup delegation
+	delTokens := sdk.TokensFromConsensusPower(2)
+	validator0, issuedShares := validator0.AddTokensFromDel(delTokens)
+	delegator := accounts[1]
+	delegation := types.NewDelegation(delegator.Address, validator0.GetOperator(), issuedShares)
+	app.StakingKeeper.SetDelegation(ctx, delegation)
+	app.DistrKeeper.SetDelegatorStartingInfo(ctx, validator0.GetOperator(), delegator.Address, distrtypes.NewDelegatorStartingInfo(2, sdk.OneDec(), 200))
+
+	setupValidatorRewards(app, ctx, validator0.GetOperator())
+
+	// setup unbonding delegation
+	creationHeight := int64(1)
+	unbondingTokens := sdk.TokensFromConsensusPower(1)
+	ubd := types.NewUnbondingDelegation(delegator.Address, validator0.GetOperator(), creationHeight, blockTime.Add(time.Hour), unbondingTokens)
+	app.StakingKeeper.SetUnbondingDelegation(ctx, ubd)
+
+	// begin a new block
+	app.BeginBlock(abci.RequestBeginBlock{Header: ocproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash, Time: blockTime}})
+
+	// execute operation
+	op := simulation.SimulateMsgCancelUnbondingDelegate(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
+	accs := []simtypes.Account{delegator}
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accs, "")
+	require.NoError(t, err)
+
+	var msg types.MsgCancelUnbondingDelegation
+	types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+
+	require.True(t, operationMsg.OK)
+	require.Equal(t, types.TypeMsgCancelUnbondingDelegation, msg.Type())
+	require.Equal(t, delegator.Address.String(), msg.DelegatorAddress)
+	require.Equal(t, validator0.GetOperator().String(), msg.ValidatorAddress)
+	require.Equal(t, creationHeight, msg.CreationHeight)
+	require.Equal(t, "stake", msg.Amount.Denom)
+	require.True(t, msg.Amount.Amount.IsPositive())
+	require.True(t, msg.Amount.Amount.LTE(unbondingTokens))
+	require.Len(t, futureOperations, 0)
+}
+
+// TestSimulateMsgTransferDelegation tests the normal scenario of a valid message of type TypeMsgTransferDelegation.
+// Abonormal scenarios, where the message is created by an errors, are not tested here.
+func TestSimulateMsgTransferDelegation(t *testing.T) {
+	app, ctx := createTestApp(false)
+	blockTime := time.Now().UTC()
+	ctx = ctx.WithBlockTime(blockTime)
+
+	// setup 3 accounts
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := getTestingAccounts(t, r, app, ctx, 3)
+
+	// setup accounts[0] as validator
+	validator0 := getTestingValidator0(t, app, ctx, accounts)
+
+	// setup delegation
+	delTokens := sdk.TokensFromConsensusPower(2)
+	validator0, issuedShares := validator0.AddTokensFromDel(delTokens)
+	delegator := accounts[1]
+	delegation := types.NewDelegation(delegator.Address, validator0.GetOperator(), issuedShares)
+	app.StakingKeeper.SetDelegation(ctx, delegation)
+	app.DistrKeeper.SetDelegatorStartingInfo(ctx, validator0.GetOperator(), delegator.Address, distrtypes.NewDelegatorStartingInfo(2, sdk.OneDec(), 200))
+
+	setupValidatorRewards(app, ctx, validator0.GetOperator())
+
+	// begin a new block
+	app.BeginBlock(abci.RequestBeginBlock{Header: ocproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash, Time: blockTime}})
+
+	// execute operation
+	op := simulation.SimulateMsgTransferDelegation(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
+	accs := []simtypes.Account{delegator, accounts[2]}
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accs, "")
+	require.NoError(t, err)
+
+	var msg types.MsgTransferDelegation
+	types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+
+	require.True(t, operationMsg.OK)
+	require.Equal(t, types.TypeMsgTransferDelegation, msg.Type())
+	require.Equal(t, delegator.Address.String(), msg.DelegatorAddress)
+	require.Equal(t, validator0.GetOperator().String(), msg.ValidatorAddress)
+	require.Equal(t, accounts[2].Address.String(), msg.RecipientAddress)
+	require.Equal(t, "stake", msg.Amount.Denom)
+	require.True(t, msg.Amount.Amount.IsPositive())
+	require.True(t, msg.Amount.Amount.LTE(delTokens))
+	require.Len(t, futureOperations, 0)
+}
+
 // TestSimulateMsgBeginRedelegate tests the normal scenario of a valid message of type TypeMsgBeginRedelegate.
 // Abonormal scenarios, where the message is created by an errors, are not tested here.
 func TestSimulateMsgBeginRedelegate(t *testing.T) {
@@ -31,5 +31,15 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 				return fmt.Sprintf("%d", GetHistEntries(r))
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyGlobalTransferCap),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenTransferCap(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyValidatorTransferCap),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenTransferCap(r))
+			},
+		),
 	}
 }
@@ -22,11 +22,13 @@ func TestParamChanges(t *testing.T) {
 		{"staking/MaxValidators", "MaxValidators", "82", "staking"},
 		{"staking/UnbondingTime", "UnbondingTime", "\"275307000000000\"", "staking"},
 		{"staking/HistoricalEntries", "HistoricalEntries", "9149", "staking"},
+		{"staking/GlobalTransferCap", "GlobalTransferCap", "\"0.480000000000000000\"", "staking"},
+		{"staking/ValidatorTransferCap", "ValidatorTransferCap", "\"0.700000000000000000\"", "staking"},
 	}
 
 	paramChanges := simulation.ParamChanges(r)
 
-	require.Len(t, paramChanges, 3)
+	require.Len(t, paramChanges, 5)
 
 	for i, p := range paramChanges {
 		require.Equal(t, expected[i].composedKey, p.ComposedKey())
@@ -81,6 +81,21 @@ func (sh *Helper) Undelegate(delegator sdk.AccAddress, val sdk.ValAddress, amoun
 	return sh.Handle(msg, ok)
 }
 
+// CancelUnbondingDelegation calls handler to cancel an unbonding delegation entry
+// created at the given height.
+func (sh *Helper) CancelUnbondingDelegation(delegator sdk.AccAddress, val sdk.ValAddress, creationHeight int64, amount sdk.Int, ok bool) *sdk.Result {
+	coin := sdk.NewCoin(sh.Denom, amount)
+	msg := stakingtypes.NewMsgCancelUnbondingDelegation(delegator, val, creationHeight, coin)
+	return sh.Handle(msg, ok)
+}
+
+// TransferDelegation calls handler to transfer delegation shares worth of amount to a recipient.
+func (sh *Helper) TransferDelegation(delegator sdk.AccAddress, val sdk.ValAddress, recipient sdk.AccAddress, amount sdk.Int, ok bool) *sdk.Result {
+	coin := sdk.NewCoin(sh.Denom, amount)
+	msg := stakingtypes.NewMsgTransferDelegation(delegator, val, recipient, coin)
+	return sh.Handle(msg, ok)
+}
+
 // Handle calls staking handler on a given message
 func (sh *Helper) Handle(msg sdk.Msg, ok bool) *sdk.Result {
 	res, err := sh.h(sh.Ctx, msg)
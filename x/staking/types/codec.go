@@ -6,6 +6,7 @@ import (
 	cryptocodec "github.com/line/lbm-sdk/crypto/codec"
 	sdk "github.com/line/lbm-sdk/types"
 	"github.com/line/lbm-sdk/types/msgservice"
+	authz "github.com/line/lbm-sdk/x/authz/types"
 )
 
 // RegisterLegacyAminoCodec registers the necessary x/staking interfaces and concrete types
@@ -15,7 +16,10 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgEditValidator{}, "lbm-sdk/MsgEditValidator", nil)
 	cdc.RegisterConcrete(&MsgDelegate{}, "lbm-sdk/MsgDelegate", nil)
 	cdc.RegisterConcrete(&MsgUndelegate{}, "lbm-sdk/MsgUndelegate", nil)
+	cdc.RegisterConcrete(&MsgCancelUnbondingDelegation{}, "lbm-sdk/MsgCancelUnbondingDelegation", nil)
+	cdc.RegisterConcrete(&MsgTransferDelegation{}, "lbm-sdk/MsgTransferDelegation", nil)
 	cdc.RegisterConcrete(&MsgBeginRedelegate{}, "lbm-sdk/MsgBeginRedelegate", nil)
+	cdc.RegisterConcrete(&StakeAuthorization{}, "lbm-sdk/StakeAuthorization", nil)
 }
 
 // RegisterInterfaces registers the x/staking interfaces types with the interface registry
@@ -25,9 +29,16 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgEditValidator{},
 		&MsgDelegate{},
 		&MsgUndelegate{},
+		&MsgCancelUnbondingDelegation{},
+		&MsgTransferDelegation{},
 		&MsgBeginRedelegate{},
 	)
 
+	registry.RegisterImplementations(
+		(*authz.Authorization)(nil),
+		&StakeAuthorization{},
+	)
+
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
@@ -90,6 +90,43 @@ func (d Delegations) String() (out string) {
 	return strings.TrimSpace(out)
 }
 
+// NewTransferredDelegation creates a new transferred delegation object
+//nolint:interfacer
+func NewTransferredDelegation(delegatorAddr sdk.AccAddress, validatorAddr sdk.ValAddress, shares sdk.Dec) TransferredDelegation {
+	return TransferredDelegation{
+		DelegatorAddress: delegatorAddr.String(),
+		ValidatorAddress: validatorAddr.String(),
+		Shares:           shares,
+	}
+}
+
+// MustMarshalTransferredDelegation returns the transferred delegation bytes. Panics if fails
+func MustMarshalTransferredDelegation(cdc codec.BinaryMarshaler, td TransferredDelegation) []byte {
+	return cdc.MustMarshalBinaryBare(&td)
+}
+
+// MustUnmarshalTransferredDelegation return the unmarshaled transferred delegation from bytes.
+// Panics if fails.
+func MustUnmarshalTransferredDelegation(cdc codec.BinaryMarshaler, value []byte) TransferredDelegation {
+	var td TransferredDelegation
+	cdc.MustUnmarshalBinaryBare(value, &td)
+	return td
+}
+
+func (td TransferredDelegation) GetDelegatorAddr() sdk.AccAddress {
+	return sdk.AccAddress(td.DelegatorAddress)
+}
+
+func (td TransferredDelegation) GetValidatorAddr() sdk.ValAddress {
+	return sdk.ValAddress(td.ValidatorAddress)
+}
+
+// String returns a human readable string representation of a TransferredDelegation.
+func (td TransferredDelegation) String() string {
+	out, _ := yaml.Marshal(td)
+	return string(out)
+}
+
 func NewUnbondingDelegationEntry(creationHeight int64, completionTime time.Time, balance sdk.Int) UnbondingDelegationEntry {
 	return UnbondingDelegationEntry{
 		CreationHeight: creationHeight,
@@ -57,4 +57,13 @@ var (
 	ErrInvalidHistoricalInfo           = sdkerrors.Register(ModuleName, 45, "invalid historical info")
 	ErrNoHistoricalInfo                = sdkerrors.Register(ModuleName, 46, "no historical info found")
 	ErrEmptyValidatorPubKey            = sdkerrors.Register(ModuleName, 47, "empty validator public key")
+	ErrNoUnbondingDelegationEntry      = sdkerrors.Register(ModuleName, 48, "no unbonding delegation entry found at creation height")
+	ErrUnbondingEntryTooSmall          = sdkerrors.Register(ModuleName, 49, "amount is greater than the unbonding delegation entry balance")
+	ErrUnbondingEntryMature            = sdkerrors.Register(ModuleName, 50, "unbonding delegation entry is already mature")
+	ErrSelfDelegationTransfer          = sdkerrors.Register(ModuleName, 51, "validator self-delegation cannot be transferred")
+	ErrTransferToSelf                  = sdkerrors.Register(ModuleName, 52, "delegation cannot be transferred to the delegator itself")
+	ErrVestingDelegationTransfer       = sdkerrors.Register(ModuleName, 53, "delegation of a vesting account cannot be transferred")
+	ErrTransferReceivingRedelegation   = sdkerrors.Register(ModuleName, 54, "delegation with a receiving redelegation in progress cannot be transferred")
+	ErrGlobalTransferCapExceeded       = sdkerrors.Register(ModuleName, 55, "transfer exceeds the global transfer cap")
+	ErrValidatorTransferCapExceeded    = sdkerrors.Register(ModuleName, 56, "transfer exceeds the validator transfer cap")
 )
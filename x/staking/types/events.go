@@ -10,6 +10,9 @@ const (
 	EventTypeUnbond               = "unbond"
 	EventTypeRedelegate           = "redelegate"
 
+	EventTypeCancelUnbondingDelegation = "cancel_unbonding_delegation"
+	EventTypeTransferDelegation        = "transfer_delegation"
+
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
 	AttributeKeyMinSelfDelegation = "min_self_delegation"
@@ -17,5 +20,7 @@ const (
 	AttributeKeyDstValidator      = "destination_validator"
 	AttributeKeyDelegator         = "delegator"
 	AttributeKeyCompletionTime    = "completion_time"
+	AttributeKeyCreationHeight    = "creation_height"
+	AttributeKeyRecipient         = "recipient"
 	AttributeValueCategory        = ModuleName
 )
@@ -43,6 +43,8 @@ type GenesisState struct {
 	// redelegations defines the redelegations active at genesis.
 	Redelegations []Redelegation `protobuf:"bytes,7,rep,name=redelegations,proto3" json:"redelegations"`
 	Exported      bool           `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	// transferred_delegations defines the transferred delegation shares active at genesis.
+	TransferredDelegations []TransferredDelegation `protobuf:"bytes,9,rep,name=transferred_delegations,json=transferredDelegations,proto3" json:"transferred_delegations" yaml:"transferred_delegations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -127,6 +129,13 @@ func (m *GenesisState) GetExported() bool {
 	return false
 }
 
+func (m *GenesisState) GetTransferredDelegations() []TransferredDelegation {
+	if m != nil {
+		return m.TransferredDelegations
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -176,38 +185,41 @@ func init() {
 func init() { proto.RegisterFile("lbm/staking/v1beta1/genesis.proto", fileDescriptor_4df9c15cb65505b7) }
 
 var fileDescriptor_4df9c15cb65505b7 = []byte{
-	// 492 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0x7d, 0xa4, 0x49, 0xc3, 0xa5, 0x20, 0x74, 0x4d, 0x85, 0x15, 0x90, 0x9d, 0x58, 0x48,
-	0xf5, 0x82, 0xad, 0x96, 0x89, 0x6e, 0x44, 0x91, 0x2a, 0x24, 0x40, 0x95, 0xf9, 0x33, 0xb0, 0x44,
-	0x67, 0x7c, 0x32, 0x56, 0xcf, 0x77, 0x96, 0xef, 0x52, 0xda, 0x11, 0xb1, 0x30, 0xf2, 0x11, 0xfa,
-	0x45, 0xd8, 0x3b, 0x76, 0x44, 0x0c, 0x11, 0x4a, 0x16, 0xe6, 0x7e, 0x02, 0xe4, 0xb3, 0x63, 0x4c,
-	0x72, 0x6c, 0x7e, 0x5f, 0x3f, 0xcf, 0xef, 0x39, 0x9f, 0xdf, 0x17, 0x8e, 0x68, 0x98, 0xfa, 0x42,
-	0xe2, 0xd3, 0x84, 0xc5, 0xfe, 0xd9, 0x41, 0x48, 0x24, 0x3e, 0xf0, 0x63, 0xc2, 0x88, 0x48, 0x84,
-	0x97, 0xe5, 0x5c, 0x72, 0xb4, 0x4b, 0xc3, 0xd4, 0xab, 0x24, 0x5e, 0x25, 0x19, 0xf4, 0x63, 0x1e,
-	0x73, 0xf5, 0xde, 0x2f, 0x9e, 0x4a, 0xe9, 0x40, 0x4b, 0x5b, 0x59, 0x95, 0xc4, 0xf9, 0xde, 0x86,
-	0x3b, 0xc7, 0x25, 0xff, 0xb5, 0xc4, 0x92, 0xa0, 0xa7, 0xb0, 0x93, 0xe1, 0x1c, 0xa7, 0xc2, 0x04,
-	0x43, 0xe0, 0xf6, 0x0e, 0x1f, 0x78, 0x9a, 0x3c, 0xef, 0x44, 0x49, 0xc6, 0x5b, 0x57, 0x73, 0xdb,
-	0x08, 0x2a, 0x03, 0x62, 0xf0, 0x1e, 0xc5, 0x42, 0x4e, 0x25, 0x97, 0x98, 0x4e, 0x33, 0xfe, 0x89,
-	0xe4, 0xe6, 0xad, 0x21, 0x70, 0x77, 0xc6, 0x93, 0x42, 0xf7, 0x73, 0x6e, 0x8f, 0xe2, 0x44, 0x7e,
-	0x9c, 0x85, 0xde, 0x07, 0x9e, 0xfa, 0x34, 0x61, 0xc4, 0xa7, 0x61, 0xfa, 0x58, 0x44, 0xa7, 0xbe,
-	0xbc, 0xc8, 0x88, 0xf0, 0x9e, 0x33, 0x79, 0x33, 0xb7, 0xef, 0x5f, 0xe0, 0x94, 0x1e, 0x39, 0xeb,
-	0x28, 0x27, 0xb8, 0x5b, 0xb4, 0xde, 0x14, 0x9d, 0x93, 0xa2, 0x81, 0x3e, 0x03, 0xb8, 0xa7, 0x54,
-	0x67, 0x98, 0x26, 0x11, 0x96, 0x3c, 0x2f, 0x95, 0xc2, 0x6c, 0x0d, 0x5b, 0x6e, 0xef, 0x70, 0x5f,
-	0x7b, 0xf4, 0x17, 0x58, 0xc8, 0x77, 0x2b, 0x83, 0x02, 0x8d, 0x1f, 0x15, 0xc7, 0xbb, 0x99, 0xdb,
-	0x0f, 0x1b, 0xc9, 0xeb, 0x4c, 0x27, 0xd8, 0xa5, 0x1b, 0x4e, 0x81, 0x26, 0x10, 0xd6, 0x4a, 0x61,
-	0x6e, 0xa9, 0x5c, 0x4b, 0x9b, 0x5b, 0x3b, 0xab, 0x5b, 0x6b, 0xf8, 0xd0, 0x31, 0xec, 0x45, 0x84,
-	0x92, 0x18, 0xcb, 0x84, 0x33, 0x61, 0xb6, 0x15, 0xc6, 0xd6, 0x62, 0x26, 0xb5, 0xae, 0xe2, 0x34,
-	0x9d, 0xe8, 0x0b, 0x80, 0x7b, 0x33, 0x16, 0x72, 0x16, 0x25, 0x2c, 0x9e, 0x36, 0x99, 0x1d, 0xc5,
-	0x74, 0xb5, 0xcc, 0xb7, 0x2b, 0x47, 0x03, 0xbe, 0x76, 0x27, 0x5a, 0xa8, 0x13, 0xf4, 0x67, 0x9b,
-	0x56, 0x81, 0x5e, 0xc2, 0x3b, 0x39, 0x69, 0x86, 0x6f, 0xab, 0xf0, 0x91, 0x36, 0x3c, 0x68, 0x28,
-	0xab, 0x4f, 0xfa, 0xd7, 0x8d, 0x06, 0xb0, 0x4b, 0xce, 0x33, 0x9e, 0x4b, 0x12, 0x99, 0xdd, 0x21,
-	0x70, 0xbb, 0x41, 0x5d, 0x3b, 0xaf, 0x20, 0xda, 0xfc, 0xa1, 0xc8, 0x84, 0xdb, 0x38, 0x8a, 0x72,
-	0x22, 0xca, 0x29, 0xbe, 0x1d, 0xac, 0x4a, 0xd4, 0x87, 0xed, 0xbf, 0x83, 0xd9, 0x0a, 0xca, 0xe2,
-	0xa8, 0xfb, 0xf5, 0xd2, 0x36, 0x7e, 0x5f, 0xda, 0xc6, 0xf8, 0xd9, 0xd5, 0xc2, 0x02, 0xd7, 0x0b,
-	0x0b, 0xfc, 0x5a, 0x58, 0xe0, 0xdb, 0xd2, 0x32, 0xae, 0x97, 0x96, 0xf1, 0x63, 0x69, 0x19, 0xef,
-	0xf7, 0xff, 0x37, 0xbb, 0xe7, 0xf5, 0x8a, 0xa9, 0x29, 0x0e, 0x3b, 0x6a, 0xb3, 0x9e, 0xfc, 0x09,
-	0x00, 0x00, 0xff, 0xff, 0x8e, 0x9c, 0xe1, 0x17, 0xcc, 0x03, 0x00, 0x00,
+	// 531 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x41, 0x6f, 0xd3, 0x3c,
+	0x1c, 0xc6, 0x93, 0xb7, 0x5b, 0xd7, 0xb9, 0x7b, 0x11, 0xf2, 0x3a, 0x16, 0x15, 0x94, 0xb4, 0x11,
+	0x62, 0x15, 0x12, 0x89, 0x36, 0x4e, 0xec, 0x46, 0x55, 0x69, 0x42, 0x02, 0x34, 0x85, 0xc1, 0x81,
+	0x4b, 0xe5, 0x10, 0x13, 0xa2, 0x39, 0x76, 0x65, 0xbb, 0x63, 0x3b, 0x22, 0x2e, 0x48, 0x5c, 0xf8,
+	0x08, 0xfb, 0x38, 0x93, 0xb8, 0xec, 0x88, 0x38, 0x54, 0xa8, 0xbd, 0x70, 0xde, 0x27, 0x40, 0x71,
+	0xd2, 0x2c, 0x6b, 0xbd, 0x5b, 0xfd, 0xef, 0xf3, 0xfc, 0x1e, 0xff, 0xad, 0x3c, 0xa0, 0x4b, 0xc2,
+	0xd4, 0x17, 0x12, 0x1d, 0x27, 0x34, 0xf6, 0x4f, 0x76, 0x43, 0x2c, 0xd1, 0xae, 0x1f, 0x63, 0x8a,
+	0x45, 0x22, 0xbc, 0x11, 0x67, 0x92, 0xc1, 0x4d, 0x12, 0xa6, 0x5e, 0x21, 0xf1, 0x0a, 0x49, 0xbb,
+	0x15, 0xb3, 0x98, 0xa9, 0xff, 0xfd, 0xec, 0x57, 0x2e, 0x6d, 0x6b, 0x69, 0x73, 0xab, 0x92, 0xb8,
+	0x3f, 0xeb, 0x60, 0xe3, 0x20, 0xe7, 0xbf, 0x91, 0x48, 0x62, 0xf8, 0x0c, 0xd4, 0x47, 0x88, 0xa3,
+	0x54, 0x58, 0x66, 0xc7, 0xec, 0x35, 0xf7, 0xee, 0x7b, 0x9a, 0x3c, 0xef, 0x50, 0x49, 0xfa, 0x2b,
+	0x17, 0x13, 0xc7, 0x08, 0x0a, 0x03, 0xa4, 0xe0, 0x2e, 0x41, 0x42, 0x0e, 0x25, 0x93, 0x88, 0x0c,
+	0x47, 0xec, 0x33, 0xe6, 0xd6, 0x7f, 0x1d, 0xb3, 0xb7, 0xd1, 0x1f, 0x64, 0xba, 0xdf, 0x13, 0xa7,
+	0x1b, 0x27, 0xf2, 0xd3, 0x38, 0xf4, 0x3e, 0xb0, 0xd4, 0x27, 0x09, 0xc5, 0x3e, 0x09, 0xd3, 0x27,
+	0x22, 0x3a, 0xf6, 0xe5, 0xd9, 0x08, 0x0b, 0xef, 0x05, 0x95, 0x57, 0x13, 0x67, 0xfb, 0x0c, 0xa5,
+	0x64, 0xdf, 0x5d, 0x44, 0xb9, 0xc1, 0x9d, 0x6c, 0x74, 0x94, 0x4d, 0x0e, 0xb3, 0x01, 0xfc, 0x62,
+	0x82, 0x2d, 0xa5, 0x3a, 0x41, 0x24, 0x89, 0x90, 0x64, 0x3c, 0x57, 0x0a, 0xab, 0xd6, 0xa9, 0xf5,
+	0x9a, 0x7b, 0x3b, 0xda, 0xab, 0xbf, 0x44, 0x42, 0xbe, 0x9b, 0x1b, 0x14, 0xa8, 0xff, 0x30, 0xbb,
+	0xde, 0xd5, 0xc4, 0x79, 0x50, 0x49, 0x5e, 0x64, 0xba, 0xc1, 0x26, 0x59, 0x72, 0x0a, 0x38, 0x00,
+	0xa0, 0x54, 0x0a, 0x6b, 0x45, 0xe5, 0xda, 0xda, 0xdc, 0xd2, 0x59, 0xbc, 0x5a, 0xc5, 0x07, 0x0f,
+	0x40, 0x33, 0xc2, 0x04, 0xc7, 0x48, 0x26, 0x8c, 0x0a, 0x6b, 0x55, 0x61, 0x1c, 0x2d, 0x66, 0x50,
+	0xea, 0x0a, 0x4e, 0xd5, 0x09, 0xbf, 0x9a, 0x60, 0x6b, 0x4c, 0x43, 0x46, 0xa3, 0x84, 0xc6, 0xc3,
+	0x2a, 0xb3, 0xae, 0x98, 0x3d, 0x2d, 0xf3, 0xed, 0xdc, 0x51, 0x81, 0x2f, 0xbc, 0x89, 0x16, 0xea,
+	0x06, 0xad, 0xf1, 0xb2, 0x55, 0xc0, 0x57, 0xe0, 0x7f, 0x8e, 0xab, 0xe1, 0x6b, 0x2a, 0xbc, 0xab,
+	0x0d, 0x0f, 0x70, 0xb4, 0xb8, 0xd2, 0x4d, 0x37, 0x6c, 0x83, 0x06, 0x3e, 0x1d, 0x31, 0x2e, 0x71,
+	0x64, 0x35, 0x3a, 0x66, 0xaf, 0x11, 0x94, 0x67, 0xf8, 0xdd, 0x04, 0xdb, 0x92, 0x23, 0x2a, 0x3e,
+	0x62, 0xce, 0x71, 0x74, 0x63, 0xe5, 0x75, 0x95, 0xfa, 0x58, 0x9b, 0x7a, 0x74, 0xed, 0xa9, 0x2c,
+	0xfd, 0xa8, 0x58, 0xda, 0xce, 0x97, 0xbe, 0x05, 0xec, 0x06, 0xf7, 0xa4, 0xce, 0x2e, 0xdc, 0xd7,
+	0x00, 0x2e, 0x7f, 0x5e, 0xd0, 0x02, 0x6b, 0x28, 0x8a, 0x38, 0x16, 0x79, 0xa7, 0xd6, 0x83, 0xf9,
+	0x11, 0xb6, 0xc0, 0xea, 0x75, 0x4d, 0x6a, 0x41, 0x7e, 0xd8, 0x6f, 0x7c, 0x3b, 0x77, 0x8c, 0xbf,
+	0xe7, 0x8e, 0xd1, 0x7f, 0x7e, 0x31, 0xb5, 0xcd, 0xcb, 0xa9, 0x6d, 0xfe, 0x99, 0xda, 0xe6, 0x8f,
+	0x99, 0x6d, 0x5c, 0xce, 0x6c, 0xe3, 0xd7, 0xcc, 0x36, 0xde, 0xef, 0xdc, 0xd6, 0xa4, 0xd3, 0xb2,
+	0xf0, 0xaa, 0x53, 0x61, 0x5d, 0xf5, 0xfc, 0xe9, 0xbf, 0x01, 0x00, 0x50, 0xdf, 0x6d, 0xd0, 0x5a,
+	0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -230,6 +242,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TransferredDelegations) > 0 {
+		for iNdEx := len(m.TransferredDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferredDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Exported {
 		i--
 		if m.Exported {
@@ -422,6 +448,12 @@ func (m *GenesisState) Size() (n int) {
 	if m.Exported {
 		n += 2
 	}
+	if len(m.TransferredDelegations) > 0 {
+		for _, e := range m.TransferredDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -732,6 +764,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Exported = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferredDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferredDelegations = append(m.TransferredDelegations, TransferredDelegation{})
+			if err := m.TransferredDelegations[len(m.TransferredDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
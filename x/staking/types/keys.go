@@ -34,6 +34,7 @@ var (
 	ValidatorsKey             = []byte{0x21} // prefix for each key to a validator
 	ValidatorsByConsAddrKey   = []byte{0x22} // prefix for each key to a validator index, by pubkey
 	ValidatorsByPowerIndexKey = []byte{0x23} // prefix for each key to a validator index, sorted by power
+	ValidatorTransferredKey   = []byte{0x24} // prefix for each key to the transferred delegation shares of a validator
 
 	DelegationKey                    = []byte{0x31} // key for a delegation
 	UnbondingDelegationKey           = []byte{0x32} // key for an unbonding-delegation
@@ -41,6 +42,7 @@ var (
 	RedelegationKey                  = []byte{0x34} // key for a redelegation
 	RedelegationByValSrcIndexKey     = []byte{0x35} // prefix for each key for an redelegation, by source validator operator
 	RedelegationByValDstIndexKey     = []byte{0x36} // prefix for each key for an redelegation, by destination validator operator
+	TransferredDelegationKey         = []byte{0x37} // key for a transferred delegation
 
 	UnbondingQueueKey    = []byte{0x41} // prefix for the timestamps in unbonding queue
 	RedelegationQueueKey = []byte{0x42} // prefix for the timestamps in redelegations queue
@@ -172,6 +174,23 @@ func GetDelegationsKey(delAddr sdk.AccAddress) []byte {
 	return append(DelegationKey, delAddr.Bytes()...)
 }
 
+// gets the key for the transferred delegation shares of a validator
+// VALUE: sdk.DecProto
+func GetValidatorTransferredKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorTransferredKey, valAddr.Bytes()...)
+}
+
+// gets the key for the transferred delegation shares of a delegator with validator
+// VALUE: staking/TransferredDelegation
+func GetTransferredDelegationKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(append(GetTransferredDelegationsKey(delAddr), AddressDelimiter...), valAddr.Bytes()...)
+}
+
+// gets the prefix for the transferred delegations of a delegator for all validators
+func GetTransferredDelegationsKey(delAddr sdk.AccAddress) []byte {
+	return append(TransferredDelegationKey, delAddr.Bytes()...)
+}
+
 // gets the key for an unbonding delegation by delegator and validator addr
 // VALUE: staking/UnbondingDelegation
 func GetUBDKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
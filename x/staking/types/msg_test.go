@@ -198,3 +198,59 @@ func TestMsgUndelegate(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgCancelUnbondingDelegation(t *testing.T) {
+	tests := []struct {
+		name           string
+		delegatorAddr  sdk.AccAddress
+		validatorAddr  sdk.ValAddress
+		creationHeight int64
+		amount         sdk.Coin
+		expectPass     bool
+	}{
+		{"regular", valAddr1.ToAccAddress(), valAddr2, 10, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), true},
+		{"zero amount", valAddr1.ToAccAddress(), valAddr2, 10, sdk.NewInt64Coin(sdk.DefaultBondDenom, 0), false},
+		{"nil amount", valAddr1.ToAccAddress(), valAddr2, 10, sdk.Coin{}, false},
+		{"zero height", valAddr1.ToAccAddress(), valAddr2, 0, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false},
+		{"negative height", valAddr1.ToAccAddress(), valAddr2, -1, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false},
+		{"empty delegator", emptyAddr.ToAccAddress(), valAddr1, 10, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false},
+		{"empty validator", valAddr1.ToAccAddress(), emptyAddr, 10, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false},
+	}
+
+	for _, tc := range tests {
+		msg := types.NewMsgCancelUnbondingDelegation(tc.delegatorAddr, tc.validatorAddr, tc.creationHeight, tc.amount)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+func TestMsgTransferDelegation(t *testing.T) {
+	tests := []struct {
+		name          string
+		delegatorAddr sdk.AccAddress
+		validatorAddr sdk.ValAddress
+		recipientAddr sdk.AccAddress
+		amount        sdk.Coin
+		expectPass    bool
+	}{
+		{"regular", valAddr1.ToAccAddress(), valAddr2, valAddr3.ToAccAddress(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), true},
+		{"zero amount", valAddr1.ToAccAddress(), valAddr2, valAddr3.ToAccAddress(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 0), false},
+		{"nil amount", valAddr1.ToAccAddress(), valAddr2, valAddr3.ToAccAddress(), sdk.Coin{}, false},
+		{"empty delegator", emptyAddr.ToAccAddress(), valAddr1, valAddr3.ToAccAddress(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false},
+		{"empty validator", valAddr1.ToAccAddress(), emptyAddr, valAddr3.ToAccAddress(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false},
+		{"empty recipient", valAddr1.ToAccAddress(), valAddr2, emptyAddr.ToAccAddress(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false},
+		{"recipient is delegator", valAddr1.ToAccAddress(), valAddr2, valAddr1.ToAccAddress(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false},
+	}
+
+	for _, tc := range tests {
+		msg := types.NewMsgTransferDelegation(tc.delegatorAddr, tc.validatorAddr, tc.recipientAddr, tc.amount)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
@@ -33,11 +33,22 @@ const (
 )
 
 var (
-	KeyUnbondingTime     = []byte("UnbondingTime")
-	KeyMaxValidators     = []byte("MaxValidators")
-	KeyMaxEntries        = []byte("MaxEntries")
-	KeyBondDenom         = []byte("BondDenom")
-	KeyHistoricalEntries = []byte("HistoricalEntries")
+	// DefaultGlobalTransferCap leaves the transferred delegations unlimited
+	DefaultGlobalTransferCap = sdk.OneDec()
+
+	// DefaultValidatorTransferCap leaves the transferred delegations of a
+	// validator unlimited
+	DefaultValidatorTransferCap = sdk.OneDec()
+)
+
+var (
+	KeyUnbondingTime        = []byte("UnbondingTime")
+	KeyMaxValidators        = []byte("MaxValidators")
+	KeyMaxEntries           = []byte("MaxEntries")
+	KeyBondDenom            = []byte("BondDenom")
+	KeyHistoricalEntries    = []byte("HistoricalEntries")
+	KeyGlobalTransferCap    = []byte("GlobalTransferCap")
+	KeyValidatorTransferCap = []byte("ValidatorTransferCap")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -48,13 +59,18 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string) Params {
+func NewParams(
+	unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string,
+	globalTransferCap, validatorTransferCap sdk.Dec,
+) Params {
 	return Params{
-		UnbondingTime:     unbondingTime,
-		MaxValidators:     maxValidators,
-		MaxEntries:        maxEntries,
-		HistoricalEntries: historicalEntries,
-		BondDenom:         bondDenom,
+		UnbondingTime:        unbondingTime,
+		MaxValidators:        maxValidators,
+		MaxEntries:           maxEntries,
+		HistoricalEntries:    historicalEntries,
+		BondDenom:            bondDenom,
+		GlobalTransferCap:    globalTransferCap,
+		ValidatorTransferCap: validatorTransferCap,
 	}
 }
 
@@ -66,6 +82,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyMaxEntries, &p.MaxEntries, validateMaxEntries),
 		paramtypes.NewParamSetPair(KeyHistoricalEntries, &p.HistoricalEntries, validateHistoricalEntries),
 		paramtypes.NewParamSetPair(KeyBondDenom, &p.BondDenom, validateBondDenom),
+		paramtypes.NewParamSetPair(KeyGlobalTransferCap, &p.GlobalTransferCap, validateTransferCap),
+		paramtypes.NewParamSetPair(KeyValidatorTransferCap, &p.ValidatorTransferCap, validateTransferCap),
 	}
 }
 
@@ -77,6 +95,8 @@ func DefaultParams() Params {
 		DefaultMaxEntries,
 		DefaultHistoricalEntries,
 		sdk.DefaultBondDenom,
+		DefaultGlobalTransferCap,
+		DefaultValidatorTransferCap,
 	)
 }
 
@@ -124,6 +144,14 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateTransferCap(p.GlobalTransferCap); err != nil {
+		return err
+	}
+
+	if err := validateTransferCap(p.ValidatorTransferCap); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -191,3 +219,22 @@ func validateBondDenom(i interface{}) error {
 
 	return nil
 }
+
+func validateTransferCap(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return errors.New("transfer cap cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("transfer cap cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("transfer cap too large: %s", v)
+	}
+
+	return nil
+}
@@ -12,13 +12,13 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_protoc_gen_gogo_descriptor "github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/duration"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
 	types1 "github.com/line/lbm-sdk/codec/types"
 	github_com_line_lbm_sdk_types "github.com/line/lbm-sdk/types"
 	types2 "github.com/line/lbm-sdk/types"
 	types "github.com/line/ostracon/proto/ostracon/types"
 	_ "github.com/regen-network/cosmos-proto"
-	_ "google.golang.org/protobuf/types/known/durationpb"
-	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	io_ioutil "io/ioutil"
 	math "math"
@@ -628,6 +628,50 @@ func (m *Delegation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Delegation proto.InternalMessageInfo
 
+// TransferredDelegation tracks the delegation shares a delegator received
+// through MsgTransferDelegation. They count against the transfer caps until
+// they are unbonded, redelegated or transferred again.
+type TransferredDelegation struct {
+	// delegator_address is the bech32-encoded address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	// validator_address is the bech32-encoded address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	// shares define the transferred delegation shares held by the delegator.
+	Shares github_com_line_lbm_sdk_types.Dec `protobuf:"bytes,3,opt,name=shares,proto3,customtype=github.com/line/lbm-sdk/types.Dec" json:"shares"`
+}
+
+func (m *TransferredDelegation) Reset()      { *m = TransferredDelegation{} }
+func (*TransferredDelegation) ProtoMessage() {}
+func (*TransferredDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad53cd24a4dca83b, []int{11}
+}
+func (m *TransferredDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferredDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferredDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferredDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferredDelegation.Merge(m, src)
+}
+func (m *TransferredDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferredDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferredDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferredDelegation proto.InternalMessageInfo
+
 // UnbondingDelegation stores all of a single delegator's unbonding bonds
 // for a single validator in an time-ordered list.
 type UnbondingDelegation struct {
@@ -642,7 +686,7 @@ type UnbondingDelegation struct {
 func (m *UnbondingDelegation) Reset()      { *m = UnbondingDelegation{} }
 func (*UnbondingDelegation) ProtoMessage() {}
 func (*UnbondingDelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ad53cd24a4dca83b, []int{11}
+	return fileDescriptor_ad53cd24a4dca83b, []int{12}
 }
 func (m *UnbondingDelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -686,7 +730,7 @@ type UnbondingDelegationEntry struct {
 func (m *UnbondingDelegationEntry) Reset()      { *m = UnbondingDelegationEntry{} }
 func (*UnbondingDelegationEntry) ProtoMessage() {}
 func (*UnbondingDelegationEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ad53cd24a4dca83b, []int{12}
+	return fileDescriptor_ad53cd24a4dca83b, []int{13}
 }
 func (m *UnbondingDelegationEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -744,7 +788,7 @@ type RedelegationEntry struct {
 func (m *RedelegationEntry) Reset()      { *m = RedelegationEntry{} }
 func (*RedelegationEntry) ProtoMessage() {}
 func (*RedelegationEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ad53cd24a4dca83b, []int{13}
+	return fileDescriptor_ad53cd24a4dca83b, []int{14}
 }
 func (m *RedelegationEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -803,7 +847,7 @@ type Redelegation struct {
 func (m *Redelegation) Reset()      { *m = Redelegation{} }
 func (*Redelegation) ProtoMessage() {}
 func (*Redelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ad53cd24a4dca83b, []int{14}
+	return fileDescriptor_ad53cd24a4dca83b, []int{15}
 }
 func (m *Redelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -844,12 +888,20 @@ type Params struct {
 	HistoricalEntries uint32 `protobuf:"varint,4,opt,name=historical_entries,json=historicalEntries,proto3" json:"historical_entries,omitempty" yaml:"historical_entries"`
 	// bond_denom defines the bondable coin denomination.
 	BondDenom string `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty" yaml:"bond_denom"`
+	// global_transfer_cap is the maximum fraction of the total bonded tokens that
+	// may be held in delegations received through MsgTransferDelegation. A cap
+	// of one leaves the transfers unlimited.
+	GlobalTransferCap github_com_line_lbm_sdk_types.Dec `protobuf:"bytes,6,opt,name=global_transfer_cap,json=globalTransferCap,proto3,customtype=github.com/line/lbm-sdk/types.Dec" json:"global_transfer_cap" yaml:"global_transfer_cap"`
+	// validator_transfer_cap is the maximum fraction of a validator's delegator
+	// shares that may be held in delegations received through MsgTransferDelegation.
+	// A cap of one leaves the transfers unlimited.
+	ValidatorTransferCap github_com_line_lbm_sdk_types.Dec `protobuf:"bytes,7,opt,name=validator_transfer_cap,json=validatorTransferCap,proto3,customtype=github.com/line/lbm-sdk/types.Dec" json:"validator_transfer_cap" yaml:"validator_transfer_cap"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ad53cd24a4dca83b, []int{15}
+	return fileDescriptor_ad53cd24a4dca83b, []int{16}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -923,7 +975,7 @@ type DelegationResponse struct {
 func (m *DelegationResponse) Reset()      { *m = DelegationResponse{} }
 func (*DelegationResponse) ProtoMessage() {}
 func (*DelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ad53cd24a4dca83b, []int{16}
+	return fileDescriptor_ad53cd24a4dca83b, []int{17}
 }
 func (m *DelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -978,7 +1030,7 @@ func (m *RedelegationEntryResponse) Reset()         { *m = RedelegationEntryResp
 func (m *RedelegationEntryResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationEntryResponse) ProtoMessage()    {}
 func (*RedelegationEntryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ad53cd24a4dca83b, []int{17}
+	return fileDescriptor_ad53cd24a4dca83b, []int{18}
 }
 func (m *RedelegationEntryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1026,7 +1078,7 @@ func (m *RedelegationResponse) Reset()         { *m = RedelegationResponse{} }
 func (m *RedelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationResponse) ProtoMessage()    {}
 func (*RedelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ad53cd24a4dca83b, []int{18}
+	return fileDescriptor_ad53cd24a4dca83b, []int{19}
 }
 func (m *RedelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1080,7 +1132,7 @@ func (m *Pool) Reset()         { *m = Pool{} }
 func (m *Pool) String() string { return proto.CompactTextString(m) }
 func (*Pool) ProtoMessage()    {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ad53cd24a4dca83b, []int{19}
+	return fileDescriptor_ad53cd24a4dca83b, []int{20}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1122,6 +1174,7 @@ func init() {
 	proto.RegisterType((*DVVTriplet)(nil), "lbm.staking.v1beta1.DVVTriplet")
 	proto.RegisterType((*DVVTriplets)(nil), "lbm.staking.v1beta1.DVVTriplets")
 	proto.RegisterType((*Delegation)(nil), "lbm.staking.v1beta1.Delegation")
+	proto.RegisterType((*TransferredDelegation)(nil), "lbm.staking.v1beta1.TransferredDelegation")
 	proto.RegisterType((*UnbondingDelegation)(nil), "lbm.staking.v1beta1.UnbondingDelegation")
 	proto.RegisterType((*UnbondingDelegationEntry)(nil), "lbm.staking.v1beta1.UnbondingDelegationEntry")
 	proto.RegisterType((*RedelegationEntry)(nil), "lbm.staking.v1beta1.RedelegationEntry")
@@ -1136,120 +1189,125 @@ func init() {
 func init() { proto.RegisterFile("lbm/staking/v1beta1/staking.proto", fileDescriptor_ad53cd24a4dca83b) }
 
 var fileDescriptor_ad53cd24a4dca83b = []byte{
-	// 1797 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4d, 0x6c, 0x23, 0x49,
-	0x15, 0x76, 0x3b, 0x59, 0xc7, 0x7e, 0xce, 0xc4, 0x71, 0x4d, 0x66, 0xd6, 0xf1, 0x0c, 0x6e, 0x4f,
-	0x0b, 0x2d, 0x01, 0x31, 0x0e, 0x1b, 0x56, 0xbb, 0xd2, 0x68, 0x41, 0xc4, 0x71, 0x66, 0x13, 0x2d,
+	// 1874 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4d, 0x6c, 0x23, 0x49,
+	0x15, 0x76, 0x27, 0x5e, 0x27, 0x79, 0xce, 0xc4, 0x71, 0x4d, 0x26, 0xeb, 0x78, 0x66, 0xdd, 0x9e,
+	0x16, 0x5a, 0x02, 0x62, 0x1c, 0x36, 0xac, 0x76, 0xa5, 0xd1, 0x82, 0x88, 0xe3, 0xcc, 0x26, 0x2c,
 	0x0c, 0x51, 0x27, 0x13, 0x04, 0x7b, 0xb0, 0xca, 0xdd, 0x15, 0xa7, 0x37, 0xfd, 0x63, 0xba, 0xca,
-	0x33, 0xf1, 0x0d, 0x09, 0x90, 0x46, 0x73, 0xda, 0xbd, 0xed, 0x25, 0x52, 0x24, 0xae, 0x1c, 0x11,
-	0x12, 0x67, 0x2e, 0x0b, 0x07, 0x34, 0x47, 0x04, 0xc8, 0xa0, 0x99, 0x0b, 0xe2, 0x84, 0x8c, 0xe0,
-	0x8c, 0xea, 0xa7, 0x7f, 0xd2, 0xf6, 0xec, 0x24, 0xd2, 0x1e, 0x56, 0x82, 0x8b, 0xe5, 0x7a, 0xf5,
-	0xde, 0xf7, 0xea, 0xfd, 0xd6, 0xab, 0x86, 0x3b, 0x6e, 0xcf, 0x5b, 0xa7, 0x0c, 0x9f, 0x38, 0x7e,
-	0x7f, 0xfd, 0xd1, 0x9b, 0x3d, 0xc2, 0xf0, 0x9b, 0xd1, 0xba, 0x35, 0x08, 0x03, 0x16, 0xa0, 0xeb,
-	0x6e, 0xcf, 0x6b, 0x45, 0x24, 0xc5, 0x52, 0x5f, 0xe9, 0x07, 0xfd, 0x40, 0xec, 0xaf, 0xf3, 0x7f,
-	0x92, 0xb5, 0xbe, 0xda, 0x0f, 0x82, 0xbe, 0x4b, 0xd6, 0xc5, 0xaa, 0x37, 0x3c, 0x5a, 0xc7, 0xfe,
-	0x48, 0x6d, 0x35, 0xb2, 0x5b, 0xf6, 0x30, 0xc4, 0xcc, 0x09, 0x7c, 0xb5, 0xaf, 0x67, 0xf7, 0x99,
-	0xe3, 0x11, 0xca, 0xb0, 0x37, 0x88, 0xb0, 0xad, 0x80, 0x7a, 0x01, 0xed, 0x4a, 0xa5, 0x72, 0xa1,
-	0xb6, 0x6e, 0x71, 0x23, 0x7a, 0x98, 0x92, 0xd8, 0x02, 0x2b, 0x70, 0x22, 0xe0, 0x7a, 0x40, 0x59,
-	0x88, 0xad, 0xc0, 0x5f, 0x67, 0xa3, 0x01, 0xa1, 0xf2, 0x57, 0xee, 0x19, 0x3f, 0xd3, 0x60, 0x69,
-	0xc7, 0xa1, 0x2c, 0x08, 0x1d, 0x0b, 0xbb, 0xbb, 0xfe, 0x51, 0x80, 0xde, 0x82, 0xc2, 0x31, 0xc1,
-	0x36, 0x09, 0x6b, 0x5a, 0x53, 0x5b, 0x2b, 0x6f, 0xdc, 0x6c, 0x45, 0xf2, 0x2d, 0x29, 0xb9, 0x23,
-	0x76, 0xdb, 0xf3, 0x9f, 0x8e, 0xf5, 0x9c, 0xa9, 0x78, 0xd1, 0xbb, 0x50, 0x78, 0x84, 0x5d, 0x4a,
-	0x58, 0x2d, 0xdf, 0x9c, 0x5b, 0x2b, 0x6f, 0x34, 0x5a, 0x33, 0x9c, 0xd6, 0x3a, 0xc4, 0xae, 0x63,
-	0x63, 0x16, 0xc4, 0xd2, 0x52, 0xc6, 0x38, 0xcf, 0x43, 0x65, 0x2b, 0xf0, 0x3c, 0x87, 0x52, 0x27,
-	0xf0, 0x4d, 0xcc, 0x08, 0x45, 0xdf, 0x82, 0xf9, 0x10, 0x33, 0x22, 0x4e, 0x51, 0x6a, 0x7f, 0x95,
-	0xf3, 0xff, 0x69, 0xac, 0xdf, 0xe9, 0x3b, 0xec, 0x78, 0xd8, 0x6b, 0x59, 0x81, 0xb7, 0xee, 0x3a,
-	0x3e, 0x59, 0x77, 0x7b, 0xde, 0x5d, 0x6a, 0x9f, 0x28, 0xab, 0x3a, 0xc4, 0x32, 0x85, 0x18, 0xfa,
-	0x01, 0x14, 0x3d, 0x7c, 0xda, 0x15, 0x10, 0x79, 0x01, 0xf1, 0xee, 0xa5, 0x21, 0x26, 0x63, 0xbd,
-	0x32, 0xc2, 0x9e, 0x7b, 0xcf, 0x88, 0x20, 0x0c, 0x73, 0xc1, 0xc3, 0xa7, 0xfc, 0x60, 0xe8, 0x04,
-	0x2a, 0x9c, 0x6a, 0x1d, 0x63, 0xbf, 0x4f, 0x24, 0xfe, 0x9c, 0xc0, 0xdf, 0xba, 0x0a, 0xfe, 0xcd,
-	0x04, 0x3f, 0x85, 0x64, 0x98, 0xd7, 0x3c, 0x7c, 0xba, 0x25, 0x08, 0x5c, 0xd9, 0xbd, 0xe2, 0x27,
-	0xe7, 0x7a, 0xee, 0xef, 0xe7, 0xba, 0x66, 0xfc, 0x41, 0x03, 0x48, 0x5c, 0x84, 0x7e, 0x08, 0xcb,
-	0x56, 0xbc, 0x12, 0xb2, 0x54, 0xc5, 0xeb, 0xcb, 0x33, 0x3d, 0x9f, 0xf1, 0x6e, 0xbb, 0xc8, 0x0f,
-	0xfb, 0x6c, 0xac, 0x6b, 0x66, 0xc5, 0xca, 0x38, 0xfe, 0x03, 0x28, 0x0f, 0x07, 0x36, 0x66, 0xa4,
-	0xcb, 0x33, 0x50, 0x38, 0xaf, 0xbc, 0x51, 0x6f, 0xc9, 0xf4, 0x6c, 0x45, 0xe9, 0xd9, 0x3a, 0x88,
-	0xd2, 0xb3, 0xdd, 0xe0, 0x58, 0x93, 0xb1, 0x8e, 0xa4, 0x4d, 0x29, 0x61, 0xe3, 0xa3, 0xbf, 0xea,
-	0x9a, 0x09, 0x92, 0xc2, 0x05, 0x52, 0x06, 0xfd, 0x4e, 0x83, 0x72, 0x87, 0x50, 0x2b, 0x74, 0x06,
-	0xbc, 0x0a, 0x50, 0x0d, 0x16, 0xbc, 0xc0, 0x77, 0x4e, 0x54, 0xe2, 0x95, 0xcc, 0x68, 0x89, 0xea,
-	0x50, 0x74, 0x6c, 0xe2, 0x33, 0x87, 0x8d, 0x64, 0x28, 0xcd, 0x78, 0xcd, 0xa5, 0x1e, 0x93, 0x1e,
-	0x75, 0xa2, 0x28, 0x98, 0xd1, 0x12, 0xdd, 0x87, 0x65, 0x4a, 0xac, 0x61, 0xe8, 0xb0, 0x51, 0xd7,
-	0x0a, 0x7c, 0x86, 0x2d, 0x56, 0x9b, 0x17, 0x81, 0xba, 0x35, 0x19, 0xeb, 0xaf, 0xcb, 0xb3, 0x66,
-	0x39, 0x0c, 0xb3, 0x12, 0x91, 0xb6, 0x24, 0x85, 0x6b, 0xb0, 0x09, 0xc3, 0x8e, 0x4b, 0x6b, 0xaf,
-	0x49, 0x0d, 0x6a, 0x99, 0xb2, 0xe5, 0xe3, 0x05, 0x28, 0xc5, 0xb9, 0xcd, 0x35, 0x07, 0x03, 0x12,
-	0xf2, 0xff, 0x5d, 0x6c, 0xdb, 0x21, 0xa1, 0x54, 0x65, 0x71, 0x4a, 0x73, 0x96, 0xc3, 0x30, 0x2b,
-	0x11, 0x69, 0x53, 0x52, 0xd0, 0x8f, 0x79, 0x8c, 0x7d, 0x4a, 0x7c, 0x3a, 0xa4, 0xdd, 0xc1, 0xb0,
-	0x77, 0x42, 0x46, 0x2a, 0x1a, 0x2b, 0x53, 0xd1, 0xd8, 0xf4, 0x47, 0xed, 0x6f, 0x24, 0xe8, 0x59,
-	0x39, 0xe3, 0xf7, 0xbf, 0xba, 0x5b, 0xe5, 0x79, 0x61, 0x85, 0xa3, 0x01, 0x0b, 0x5a, 0x7b, 0xc3,
-	0xde, 0xfb, 0x64, 0xc4, 0x63, 0xaf, 0xf8, 0xf6, 0x04, 0x1b, 0xba, 0x09, 0x85, 0x0f, 0xb1, 0xe3,
-	0x12, 0x5b, 0x78, 0xb3, 0x68, 0xaa, 0x15, 0x7a, 0x07, 0x0a, 0x94, 0x61, 0x36, 0xa4, 0xc2, 0x85,
-	0x4b, 0x1b, 0xfa, 0xcc, 0x24, 0x6b, 0x07, 0xbe, 0xbd, 0x2f, 0xd8, 0x4c, 0xc5, 0x8e, 0x36, 0xa1,
-	0xc0, 0x82, 0x13, 0xe2, 0x2b, 0xe7, 0x5d, 0xb6, 0x8e, 0x77, 0x7d, 0x66, 0x2a, 0x41, 0x14, 0xc0,
-	0xb2, 0x4d, 0x5c, 0xd2, 0x17, 0xde, 0xa2, 0xc7, 0x38, 0x24, 0xb4, 0x56, 0x10, 0x60, 0x9d, 0xab,
-	0x54, 0x9c, 0xf2, 0x4c, 0x16, 0xca, 0x30, 0x2b, 0x31, 0x69, 0x5f, 0x50, 0xd0, 0x0e, 0x94, 0xed,
-	0x24, 0x31, 0x6b, 0x0b, 0xc2, 0xe5, 0xcd, 0x99, 0x16, 0xa7, 0x12, 0x58, 0xb5, 0xb4, 0xb4, 0x28,
-	0xcf, 0x84, 0xa1, 0xdf, 0x0b, 0x7c, 0xdb, 0xf1, 0xfb, 0xdd, 0x63, 0xe2, 0xf4, 0x8f, 0x59, 0xad,
-	0xd8, 0xd4, 0xd6, 0xe6, 0xd2, 0x99, 0x90, 0xe5, 0x30, 0xcc, 0x4a, 0x4c, 0xda, 0x11, 0x14, 0x64,
-	0xc3, 0x52, 0xc2, 0x25, 0xaa, 0xb2, 0xf4, 0xca, 0xaa, 0xbc, 0xa3, 0xaa, 0xf2, 0x46, 0x56, 0x4b,
-	0x52, 0x98, 0xd7, 0x62, 0x22, 0x17, 0x43, 0xdb, 0x00, 0x49, 0x2f, 0xa8, 0x81, 0xd0, 0xa0, 0xbf,
-	0xa2, 0x9b, 0x28, 0xab, 0x53, 0x82, 0xe8, 0x31, 0x5c, 0xf7, 0x1c, 0xbf, 0x4b, 0x89, 0x7b, 0xd4,
-	0x55, 0xae, 0xe5, 0x78, 0x65, 0x11, 0xb2, 0xf7, 0x2e, 0x1d, 0xff, 0xc9, 0x58, 0xaf, 0xab, 0x26,
-	0x39, 0x8d, 0x66, 0x98, 0x55, 0xcf, 0xf1, 0xf7, 0x89, 0x7b, 0xd4, 0x89, 0x69, 0xf7, 0x16, 0x9f,
-	0x9c, 0xeb, 0x39, 0x55, 0x93, 0x39, 0xe3, 0x6d, 0x58, 0x3c, 0xc4, 0xae, 0xaa, 0x25, 0x42, 0xd1,
-	0x6d, 0x28, 0xe1, 0x68, 0x51, 0xd3, 0x9a, 0x73, 0x6b, 0x25, 0x33, 0x21, 0xc8, 0x5a, 0xfe, 0xc9,
-	0x5f, 0x9a, 0x9a, 0xf1, 0x4b, 0x0d, 0x0a, 0x9d, 0xc3, 0x3d, 0xec, 0x84, 0x68, 0x17, 0xaa, 0x49,
-	0xba, 0x5c, 0xac, 0xe4, 0xdb, 0x93, 0xb1, 0x5e, 0xcb, 0x66, 0x54, 0x5c, 0xca, 0x49, 0xc2, 0x46,
-	0xb5, 0xbc, 0x0b, 0xd5, 0x47, 0x51, 0x83, 0x88, 0xa1, 0xf2, 0x59, 0xa8, 0x29, 0x16, 0xc3, 0x5c,
-	0x8e, 0x69, 0x0a, 0x2a, 0x63, 0x66, 0x1b, 0x16, 0xe4, 0x69, 0x29, 0x7a, 0x07, 0x5e, 0x1b, 0xf0,
-	0x3f, 0xc2, 0xba, 0xf2, 0xc6, 0xad, 0xd9, 0x19, 0x2b, 0x98, 0x55, 0xd8, 0x24, 0xbf, 0xf1, 0x71,
-	0x1e, 0xa0, 0x73, 0x78, 0x78, 0x10, 0x3a, 0x03, 0x97, 0xb0, 0xcf, 0xd3, 0xec, 0x03, 0xb8, 0x91,
-	0xd8, 0x44, 0x43, 0x2b, 0x63, 0x7a, 0x73, 0x32, 0xd6, 0x6f, 0x67, 0x4d, 0x4f, 0xb1, 0x19, 0xe6,
-	0xf5, 0x98, 0xbe, 0x1f, 0x5a, 0x33, 0x51, 0x6d, 0xca, 0x62, 0xd4, 0xb9, 0x97, 0xa3, 0xa6, 0xd8,
-	0xd2, 0xa8, 0x1d, 0xca, 0x66, 0xfb, 0x75, 0x0f, 0xca, 0x89, 0x4b, 0x78, 0x1f, 0x2b, 0x32, 0xf5,
-	0x5f, 0xb9, 0x57, 0x7f, 0x89, 0x7b, 0x23, 0x19, 0xe5, 0xe2, 0x58, 0xcc, 0xf8, 0x97, 0x06, 0x90,
-	0x64, 0xeb, 0x17, 0x33, 0xb9, 0x78, 0xbf, 0x56, 0x2d, 0x76, 0xee, 0xaa, 0x73, 0x97, 0x12, 0xcc,
-	0xf8, 0xf1, 0x49, 0x1e, 0xae, 0x3f, 0x8c, 0xda, 0xcc, 0x17, 0xde, 0xfc, 0xef, 0xc1, 0x02, 0xf1,
-	0x59, 0xe8, 0x08, 0xfb, 0x79, 0x94, 0xef, 0xce, 0x8c, 0xf2, 0x0c, 0x83, 0xb6, 0x7d, 0x16, 0x8e,
-	0x54, 0xcc, 0x23, 0x8c, 0x8c, 0x2b, 0x7e, 0x3e, 0x07, 0xb5, 0x97, 0x49, 0xa2, 0x2d, 0xa8, 0x58,
-	0x21, 0x11, 0x84, 0xe8, 0xa6, 0xd0, 0xc4, 0x4d, 0x51, 0x4f, 0xa6, 0xc5, 0x0c, 0x83, 0x61, 0x2e,
-	0x45, 0x14, 0x75, 0x4f, 0xf4, 0x81, 0x4f, 0x73, 0x3c, 0xdd, 0x38, 0xd7, 0x25, 0xc7, 0x37, 0x43,
-	0x5d, 0x14, 0x91, 0x92, 0x8b, 0x00, 0xf2, 0xa6, 0x58, 0x4a, 0xa8, 0xe2, 0xaa, 0x70, 0xa1, 0xe2,
-	0xf8, 0x0e, 0x73, 0xb0, 0xdb, 0xed, 0x61, 0x17, 0xfb, 0xd6, 0x15, 0x87, 0x60, 0xd9, 0xdf, 0x95,
-	0xc6, 0x0c, 0x92, 0x61, 0x2e, 0x29, 0x4a, 0x5b, 0x12, 0xd0, 0x16, 0x2c, 0x44, 0x5a, 0xe6, 0xaf,
-	0x3a, 0x45, 0x44, 0x92, 0xa9, 0x69, 0xed, 0xa7, 0x73, 0x50, 0x35, 0x89, 0xfd, 0xff, 0x00, 0x5c,
-	0x3a, 0x00, 0x3b, 0x00, 0xb2, 0xb8, 0x79, 0x1b, 0xbd, 0x5a, 0x0c, 0x78, 0x67, 0x28, 0x49, 0xe1,
-	0x0e, 0x65, 0xa9, 0x28, 0xfc, 0x39, 0x0f, 0x8b, 0xe9, 0x28, 0xfc, 0x8f, 0x5e, 0x3b, 0xe8, 0x7e,
-	0xd2, 0x72, 0xe6, 0x45, 0xcb, 0x79, 0x63, 0x66, 0xcb, 0x99, 0x4a, 0xd8, 0xcf, 0xee, 0x35, 0xff,
-	0xc9, 0x43, 0x61, 0x0f, 0x87, 0xd8, 0xa3, 0xc8, 0x9a, 0x1a, 0x1e, 0xe5, 0x43, 0x71, 0x75, 0x2a,
-	0x25, 0x3b, 0xea, 0x8b, 0xc4, 0x2b, 0x66, 0xc7, 0x4f, 0x66, 0xcc, 0x8e, 0xdf, 0x81, 0x25, 0xfe,
-	0x96, 0x8d, 0x0d, 0x94, 0xae, 0xbe, 0xd6, 0x5e, 0x4d, 0x50, 0x2e, 0xee, 0xcb, 0xa7, 0x6e, 0xfc,
-	0x68, 0xe2, 0xd3, 0x4b, 0x99, 0x73, 0x24, 0xed, 0x97, 0x8b, 0xdf, 0x4c, 0x9e, 0x95, 0xa9, 0x4d,
-	0xc3, 0x04, 0x0f, 0x9f, 0x6e, 0xcb, 0x05, 0xfa, 0x2e, 0xa0, 0xe3, 0xf8, 0x13, 0x46, 0x37, 0xf1,
-	0x25, 0x97, 0xff, 0xd2, 0x64, 0xac, 0xaf, 0x4a, 0xf9, 0x69, 0x1e, 0xc3, 0xac, 0x26, 0xc4, 0x08,
-	0xed, 0x2d, 0x00, 0x6e, 0x57, 0xd7, 0x26, 0x7e, 0xe0, 0xa9, 0x47, 0xcb, 0x8d, 0xc9, 0x58, 0xaf,
-	0x4a, 0x94, 0x64, 0xcf, 0x30, 0x4b, 0x7c, 0xd1, 0xe1, 0xff, 0x53, 0x69, 0x7d, 0xa6, 0x01, 0x4a,
-	0x7a, 0xbb, 0x49, 0xe8, 0x80, 0x3f, 0xb1, 0xf8, 0x6c, 0x9d, 0x9a, 0x85, 0xb5, 0xcf, 0x98, 0xad,
-	0x13, 0xe1, 0x68, 0xb6, 0x4e, 0xd5, 0xc8, 0xdb, 0x49, 0x27, 0xcc, 0xab, 0xaf, 0x33, 0x1c, 0xa3,
-	0x87, 0x29, 0x49, 0x0d, 0xe7, 0x4e, 0x24, 0x3a, 0xd5, 0xfc, 0x72, 0xc6, 0x6f, 0x35, 0x58, 0x9d,
-	0xca, 0xa5, 0xf8, 0x98, 0x1f, 0x00, 0x0a, 0x53, 0x9b, 0xc2, 0x53, 0x23, 0x75, 0xdc, 0xab, 0xe5,
-	0x65, 0x35, 0x9c, 0xd1, 0x61, 0x3f, 0x87, 0x36, 0x3e, 0x2f, 0xbc, 0xfc, 0x1b, 0x0d, 0x56, 0xd2,
-	0x9a, 0x63, 0x03, 0xde, 0x87, 0xc5, 0xb4, 0x62, 0x75, 0xf4, 0x3b, 0xaf, 0x3c, 0xba, 0x3a, 0xf5,
-	0x05, 0x61, 0xf4, 0x20, 0x29, 0x4d, 0xf9, 0x55, 0xab, 0x75, 0x39, 0x17, 0x44, 0xa7, 0xc9, 0x96,
-	0xe8, 0xbc, 0x88, 0xc0, 0xbf, 0x35, 0x98, 0xdf, 0x0b, 0x02, 0x17, 0x7d, 0x08, 0x55, 0x3f, 0x60,
-	0x5d, 0x9e, 0x45, 0xc4, 0xee, 0xaa, 0x67, 0xb2, 0x6c, 0x78, 0xdf, 0xbe, 0xb4, 0x67, 0xfe, 0x31,
-	0xd6, 0xa7, 0x51, 0xcc, 0x8a, 0x1f, 0xb0, 0xb6, 0xa0, 0x1c, 0xc8, 0x47, 0xf4, 0x63, 0xb8, 0x76,
-	0x51, 0x8f, 0xec, 0x84, 0xe6, 0x55, 0xf4, 0x5c, 0x44, 0x98, 0x8c, 0xf5, 0x95, 0xa4, 0x26, 0x62,
-	0xb2, 0x61, 0x2e, 0xf6, 0x52, 0x8a, 0xef, 0x15, 0x79, 0xbc, 0xfe, 0x79, 0xae, 0x6b, 0x5f, 0xfb,
-	0xb5, 0x06, 0x90, 0x7c, 0x21, 0x40, 0x5f, 0x87, 0xd7, 0xdb, 0xdf, 0x7f, 0xd0, 0xe9, 0xee, 0x1f,
-	0x6c, 0x1e, 0x3c, 0xdc, 0xef, 0x3e, 0x7c, 0xb0, 0xbf, 0xb7, 0xbd, 0xb5, 0x7b, 0x7f, 0x77, 0xbb,
-	0xb3, 0x9c, 0xab, 0x57, 0x9e, 0x9e, 0x35, 0xcb, 0x0f, 0x7d, 0x3a, 0x20, 0x96, 0x73, 0xe4, 0x10,
-	0x1b, 0xbd, 0x01, 0x2b, 0x17, 0xb9, 0xf9, 0x6a, 0xbb, 0xb3, 0xac, 0xd5, 0x17, 0x9f, 0x9e, 0x35,
-	0x8b, 0x72, 0xac, 0x22, 0x36, 0x5a, 0x83, 0x1b, 0xd3, 0x7c, 0xbb, 0x0f, 0xde, 0x5b, 0xce, 0xd7,
-	0xaf, 0x3d, 0x3d, 0x6b, 0x96, 0xe2, 0xf9, 0x0b, 0x19, 0x80, 0xd2, 0x9c, 0x0a, 0x6f, 0xae, 0x0e,
-	0x4f, 0xcf, 0x9a, 0x05, 0xe9, 0xbb, 0xfa, 0xfc, 0x93, 0x5f, 0x34, 0x72, 0xed, 0xcd, 0x4f, 0x9f,
-	0x37, 0xb4, 0x67, 0xcf, 0x1b, 0xda, 0xdf, 0x9e, 0x37, 0xb4, 0x8f, 0x5e, 0x34, 0x72, 0xcf, 0x5e,
-	0x34, 0x72, 0x7f, 0x7c, 0xd1, 0xc8, 0xfd, 0xe8, 0x2b, 0x2f, 0x73, 0xdb, 0x69, 0xfc, 0x49, 0x59,
-	0x38, 0xb0, 0x57, 0x10, 0x3d, 0xf6, 0x9b, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x17, 0x27,
-	0x74, 0x6e, 0x16, 0x00, 0x00,
+	0x33, 0xf1, 0x6d, 0x25, 0x40, 0x1a, 0xcd, 0x69, 0xf7, 0xb6, 0x97, 0x48, 0x91, 0xb8, 0x72, 0x44,
+	0x48, 0x9c, 0xb9, 0x2c, 0x1c, 0xd0, 0x1c, 0x11, 0x20, 0x83, 0x66, 0x2e, 0x88, 0x13, 0x32, 0xe2,
+	0x8a, 0x50, 0xfd, 0xf4, 0x4f, 0xda, 0x9e, 0x9d, 0x58, 0xda, 0xc3, 0x4a, 0xcb, 0x25, 0x72, 0xbd,
+	0x7a, 0xef, 0x7b, 0xf5, 0x5e, 0xbd, 0xbf, 0xea, 0xc0, 0x6d, 0xb7, 0xe3, 0x6d, 0x50, 0x86, 0x4f,
+	0x1d, 0xbf, 0xbb, 0xf1, 0xf0, 0x8d, 0x0e, 0x61, 0xf8, 0x8d, 0x68, 0xdd, 0xe8, 0x85, 0x01, 0x0b,
+	0xd0, 0x75, 0xb7, 0xe3, 0x35, 0x22, 0x92, 0x62, 0xa9, 0xae, 0x74, 0x83, 0x6e, 0x20, 0xf6, 0x37,
+	0xf8, 0x2f, 0xc9, 0x5a, 0x5d, 0xeb, 0x06, 0x41, 0xd7, 0x25, 0x1b, 0x62, 0xd5, 0xe9, 0x1f, 0x6f,
+	0x60, 0x7f, 0xa0, 0xb6, 0x6a, 0xd9, 0x2d, 0xbb, 0x1f, 0x62, 0xe6, 0x04, 0xbe, 0xda, 0xd7, 0xb3,
+	0xfb, 0xcc, 0xf1, 0x08, 0x65, 0xd8, 0xeb, 0x45, 0xd8, 0x56, 0x40, 0xbd, 0x80, 0xb6, 0xa5, 0x52,
+	0xb9, 0x50, 0x5b, 0x37, 0xb9, 0x11, 0x1d, 0x4c, 0x49, 0x6c, 0x81, 0x15, 0x38, 0x11, 0x70, 0x35,
+	0xa0, 0x2c, 0xc4, 0x56, 0xe0, 0x6f, 0xb0, 0x41, 0x8f, 0x50, 0xf9, 0x57, 0xee, 0x19, 0x3f, 0xd7,
+	0x60, 0x69, 0xd7, 0xa1, 0x2c, 0x08, 0x1d, 0x0b, 0xbb, 0x7b, 0xfe, 0x71, 0x80, 0xde, 0x84, 0xc2,
+	0x09, 0xc1, 0x36, 0x09, 0x2b, 0x5a, 0x5d, 0x5b, 0x2f, 0x6e, 0xae, 0x36, 0x22, 0xf9, 0x86, 0x94,
+	0xdc, 0x15, 0xbb, 0xcd, 0xfc, 0xa7, 0x43, 0x3d, 0x67, 0x2a, 0x5e, 0xf4, 0x0e, 0x14, 0x1e, 0x62,
+	0x97, 0x12, 0x56, 0x99, 0xa9, 0xcf, 0xae, 0x17, 0x37, 0x6b, 0x8d, 0x09, 0x4e, 0x6b, 0x1c, 0x61,
+	0xd7, 0xb1, 0x31, 0x0b, 0x62, 0x69, 0x29, 0x63, 0x5c, 0xcc, 0x40, 0x69, 0x3b, 0xf0, 0x3c, 0x87,
+	0x52, 0x27, 0xf0, 0x4d, 0xcc, 0x08, 0x45, 0xdf, 0x86, 0x7c, 0x88, 0x19, 0x11, 0xa7, 0x58, 0x68,
+	0x7e, 0x8d, 0xf3, 0xff, 0x79, 0xa8, 0xdf, 0xee, 0x3a, 0xec, 0xa4, 0xdf, 0x69, 0x58, 0x81, 0xb7,
+	0xe1, 0x3a, 0x3e, 0xd9, 0x70, 0x3b, 0xde, 0x1d, 0x6a, 0x9f, 0x2a, 0xab, 0x5a, 0xc4, 0x32, 0x85,
+	0x18, 0xfa, 0x11, 0xcc, 0x7b, 0xf8, 0xac, 0x2d, 0x20, 0x66, 0x04, 0xc4, 0x3b, 0x57, 0x86, 0x18,
+	0x0d, 0xf5, 0xd2, 0x00, 0x7b, 0xee, 0x5d, 0x23, 0x82, 0x30, 0xcc, 0x39, 0x0f, 0x9f, 0xf1, 0x83,
+	0xa1, 0x53, 0x28, 0x71, 0xaa, 0x75, 0x82, 0xfd, 0x2e, 0x91, 0xf8, 0xb3, 0x02, 0x7f, 0x7b, 0x1a,
+	0xfc, 0xd5, 0x04, 0x3f, 0x85, 0x64, 0x98, 0xd7, 0x3c, 0x7c, 0xb6, 0x2d, 0x08, 0x5c, 0xd9, 0xdd,
+	0xf9, 0x4f, 0x2e, 0xf4, 0xdc, 0x3f, 0x2e, 0x74, 0xcd, 0xf8, 0xa3, 0x06, 0x90, 0xb8, 0x08, 0xfd,
+	0x18, 0x96, 0xad, 0x78, 0x25, 0x64, 0xa9, 0xba, 0xaf, 0xaf, 0x4c, 0xf4, 0x7c, 0xc6, 0xbb, 0xcd,
+	0x79, 0x7e, 0xd8, 0xa7, 0x43, 0x5d, 0x33, 0x4b, 0x56, 0xc6, 0xf1, 0xef, 0x43, 0xb1, 0xdf, 0xb3,
+	0x31, 0x23, 0x6d, 0x1e, 0x81, 0xc2, 0x79, 0xc5, 0xcd, 0x6a, 0x43, 0x86, 0x67, 0x23, 0x0a, 0xcf,
+	0xc6, 0x61, 0x14, 0x9e, 0xcd, 0x1a, 0xc7, 0x1a, 0x0d, 0x75, 0x24, 0x6d, 0x4a, 0x09, 0x1b, 0x1f,
+	0xfd, 0x4d, 0xd7, 0x4c, 0x90, 0x14, 0x2e, 0x90, 0x32, 0xe8, 0xf7, 0x1a, 0x14, 0x5b, 0x84, 0x5a,
+	0xa1, 0xd3, 0xe3, 0x59, 0x80, 0x2a, 0x30, 0xe7, 0x05, 0xbe, 0x73, 0xaa, 0x02, 0x6f, 0xc1, 0x8c,
+	0x96, 0xa8, 0x0a, 0xf3, 0x8e, 0x4d, 0x7c, 0xe6, 0xb0, 0x81, 0xbc, 0x4a, 0x33, 0x5e, 0x73, 0xa9,
+	0x47, 0xa4, 0x43, 0x9d, 0xe8, 0x16, 0xcc, 0x68, 0x89, 0xee, 0xc1, 0x32, 0x25, 0x56, 0x3f, 0x74,
+	0xd8, 0xa0, 0x6d, 0x05, 0x3e, 0xc3, 0x16, 0xab, 0xe4, 0xc5, 0x45, 0xdd, 0x1c, 0x0d, 0xf5, 0x57,
+	0xe5, 0x59, 0xb3, 0x1c, 0x86, 0x59, 0x8a, 0x48, 0xdb, 0x92, 0xc2, 0x35, 0xd8, 0x84, 0x61, 0xc7,
+	0xa5, 0x95, 0x57, 0xa4, 0x06, 0xb5, 0x4c, 0xd9, 0xf2, 0xf1, 0x1c, 0x2c, 0xc4, 0xb1, 0xcd, 0x35,
+	0x07, 0x3d, 0x12, 0xf2, 0xdf, 0x6d, 0x6c, 0xdb, 0x21, 0xa1, 0xb4, 0xa2, 0x65, 0x35, 0x67, 0x39,
+	0x0c, 0xb3, 0x14, 0x91, 0xb6, 0x24, 0x05, 0xfd, 0x94, 0xdf, 0xb1, 0x4f, 0x89, 0x4f, 0xfb, 0xb4,
+	0xdd, 0xeb, 0x77, 0x4e, 0xc9, 0x40, 0xdd, 0xc6, 0xca, 0xd8, 0x6d, 0x6c, 0xf9, 0x83, 0xe6, 0x37,
+	0x13, 0xf4, 0xac, 0x9c, 0xf1, 0x87, 0x5f, 0xdf, 0x29, 0xf3, 0xb8, 0xb0, 0xc2, 0x41, 0x8f, 0x05,
+	0x8d, 0xfd, 0x7e, 0xe7, 0x3d, 0x32, 0x30, 0x4b, 0x31, 0xdf, 0xbe, 0x60, 0x43, 0xab, 0x50, 0xf8,
+	0x00, 0x3b, 0x2e, 0xb1, 0x85, 0x37, 0xe7, 0x4d, 0xb5, 0x42, 0x6f, 0x43, 0x81, 0x32, 0xcc, 0xfa,
+	0x54, 0xb8, 0x70, 0x69, 0x53, 0x9f, 0x18, 0x64, 0xcd, 0xc0, 0xb7, 0x0f, 0x04, 0x9b, 0xa9, 0xd8,
+	0xd1, 0x16, 0x14, 0x58, 0x70, 0x4a, 0x7c, 0xe5, 0xbc, 0xab, 0xe6, 0xf1, 0x9e, 0xcf, 0x4c, 0x25,
+	0x88, 0x02, 0x58, 0xb6, 0x89, 0x4b, 0xba, 0xc2, 0x5b, 0xf4, 0x04, 0x87, 0x84, 0x56, 0x0a, 0x02,
+	0xac, 0x35, 0x4d, 0xc6, 0x29, 0xcf, 0x64, 0xa1, 0x0c, 0xb3, 0x14, 0x93, 0x0e, 0x04, 0x05, 0xed,
+	0x42, 0xd1, 0x4e, 0x02, 0xb3, 0x32, 0x27, 0x5c, 0x5e, 0x9f, 0x68, 0x71, 0x2a, 0x80, 0x55, 0x49,
+	0x4b, 0x8b, 0xf2, 0x48, 0xe8, 0xfb, 0x9d, 0xc0, 0xb7, 0x1d, 0xbf, 0xdb, 0x3e, 0x21, 0x4e, 0xf7,
+	0x84, 0x55, 0xe6, 0xeb, 0xda, 0xfa, 0x6c, 0x3a, 0x12, 0xb2, 0x1c, 0x86, 0x59, 0x8a, 0x49, 0xbb,
+	0x82, 0x82, 0x6c, 0x58, 0x4a, 0xb8, 0x44, 0x56, 0x2e, 0xbc, 0x34, 0x2b, 0x6f, 0xab, 0xac, 0xbc,
+	0x91, 0xd5, 0x92, 0x24, 0xe6, 0xb5, 0x98, 0xc8, 0xc5, 0xd0, 0x0e, 0x40, 0x52, 0x0b, 0x2a, 0x20,
+	0x34, 0xe8, 0x2f, 0xa9, 0x26, 0xca, 0xea, 0x94, 0x20, 0x7a, 0x04, 0xd7, 0x3d, 0xc7, 0x6f, 0x53,
+	0xe2, 0x1e, 0xb7, 0x95, 0x6b, 0x39, 0x5e, 0x51, 0x5c, 0xd9, 0xbb, 0x57, 0xbe, 0xff, 0xd1, 0x50,
+	0xaf, 0xaa, 0x22, 0x39, 0x8e, 0x66, 0x98, 0x65, 0xcf, 0xf1, 0x0f, 0x88, 0x7b, 0xdc, 0x8a, 0x69,
+	0x77, 0x17, 0x1f, 0x5f, 0xe8, 0x39, 0x95, 0x93, 0x39, 0xe3, 0x2d, 0x58, 0x3c, 0xc2, 0xae, 0xca,
+	0x25, 0x42, 0xd1, 0x2d, 0x58, 0xc0, 0xd1, 0xa2, 0xa2, 0xd5, 0x67, 0xd7, 0x17, 0xcc, 0x84, 0x20,
+	0x73, 0xf9, 0xc3, 0xbf, 0xd6, 0x35, 0xe3, 0x57, 0x1a, 0x14, 0x5a, 0x47, 0xfb, 0xd8, 0x09, 0xd1,
+	0x1e, 0x94, 0x93, 0x70, 0xb9, 0x9c, 0xc9, 0xb7, 0x46, 0x43, 0xbd, 0x92, 0x8d, 0xa8, 0x38, 0x95,
+	0x93, 0x80, 0x8d, 0x72, 0x79, 0x0f, 0xca, 0x0f, 0xa3, 0x02, 0x11, 0x43, 0xcd, 0x64, 0xa1, 0xc6,
+	0x58, 0x0c, 0x73, 0x39, 0xa6, 0x29, 0xa8, 0x8c, 0x99, 0x4d, 0x98, 0x93, 0xa7, 0xa5, 0xe8, 0x6d,
+	0x78, 0xa5, 0xc7, 0x7f, 0x08, 0xeb, 0x8a, 0x9b, 0x37, 0x27, 0x47, 0xac, 0x60, 0x56, 0xd7, 0x26,
+	0xf9, 0x8d, 0x8f, 0x67, 0x00, 0x5a, 0x47, 0x47, 0x87, 0xa1, 0xd3, 0x73, 0x09, 0xfb, 0x3c, 0xcd,
+	0x3e, 0x84, 0x1b, 0x89, 0x4d, 0x34, 0xb4, 0x32, 0xa6, 0xd7, 0x47, 0x43, 0xfd, 0x56, 0xd6, 0xf4,
+	0x14, 0x9b, 0x61, 0x5e, 0x8f, 0xe9, 0x07, 0xa1, 0x35, 0x11, 0xd5, 0xa6, 0x2c, 0x46, 0x9d, 0x7d,
+	0x31, 0x6a, 0x8a, 0x2d, 0x8d, 0xda, 0xa2, 0x6c, 0xb2, 0x5f, 0xf7, 0xa1, 0x98, 0xb8, 0x84, 0xd7,
+	0xb1, 0x79, 0xa6, 0x7e, 0x2b, 0xf7, 0xea, 0x2f, 0x70, 0x6f, 0x24, 0xa3, 0x5c, 0x1c, 0x8b, 0x19,
+	0xff, 0xd6, 0x00, 0x92, 0x68, 0xfd, 0x62, 0x06, 0x17, 0xaf, 0xd7, 0xaa, 0xc4, 0xce, 0x4e, 0x3b,
+	0x77, 0x29, 0xc1, 0x8c, 0x1f, 0xff, 0xab, 0xc1, 0x8d, 0xc3, 0x10, 0xfb, 0xf4, 0x98, 0x84, 0x21,
+	0xb1, 0xbf, 0x7c, 0x0e, 0x78, 0x3c, 0x03, 0xd7, 0x1f, 0x44, 0x75, 0xf6, 0x0b, 0x6f, 0xfe, 0x0f,
+	0x60, 0x8e, 0xf8, 0x2c, 0x74, 0x84, 0xfd, 0x3c, 0xcc, 0xef, 0x4c, 0x0c, 0xf3, 0x09, 0x06, 0xed,
+	0xf8, 0x2c, 0x1c, 0xa8, 0xa0, 0x8f, 0x30, 0x32, 0xae, 0xf8, 0xc5, 0x2c, 0x54, 0x5e, 0x24, 0x89,
+	0xb6, 0xa1, 0x64, 0x85, 0x44, 0x10, 0xa2, 0x56, 0xa9, 0x89, 0x56, 0x59, 0x4d, 0xc6, 0xe5, 0x0c,
+	0x83, 0x61, 0x2e, 0x45, 0x14, 0xd5, 0x28, 0xbb, 0xc0, 0xc7, 0x59, 0x9e, 0x6f, 0x9c, 0xeb, 0x8a,
+	0xf3, 0xab, 0xa1, 0x3a, 0x65, 0xa4, 0xe4, 0x32, 0x80, 0x6c, 0x95, 0x4b, 0x09, 0x55, 0xf4, 0x4a,
+	0x17, 0x4a, 0x8e, 0xef, 0x30, 0x07, 0xbb, 0xed, 0x0e, 0x76, 0xb1, 0x6f, 0x4d, 0xf9, 0x0a, 0x90,
+	0x0d, 0x4e, 0x69, 0xcc, 0x20, 0x19, 0xe6, 0x92, 0xa2, 0x34, 0x25, 0x01, 0x6d, 0xc3, 0x5c, 0xa4,
+	0x25, 0x3f, 0xed, 0x18, 0x15, 0x49, 0xa6, 0xc6, 0xd5, 0x9f, 0xcd, 0x42, 0xd9, 0x24, 0xf6, 0xff,
+	0x2f, 0xe0, 0xca, 0x17, 0xb0, 0x0b, 0x20, 0x93, 0x9b, 0xf7, 0x91, 0x4a, 0x7e, 0xda, 0xca, 0xb0,
+	0x20, 0x85, 0x5b, 0x94, 0xa5, 0x6e, 0xe1, 0x2f, 0x33, 0xb0, 0x98, 0xbe, 0x85, 0x2f, 0x69, 0xdf,
+	0x45, 0xf7, 0x92, 0x92, 0x93, 0x17, 0x25, 0xe7, 0xf5, 0x89, 0x25, 0x67, 0x2c, 0x60, 0x3f, 0xbb,
+	0xd6, 0x8c, 0xf2, 0x50, 0xd8, 0xc7, 0x21, 0xf6, 0x28, 0xb2, 0xc6, 0xa6, 0x67, 0xf9, 0x52, 0x5e,
+	0x1b, 0x0b, 0xc9, 0x96, 0xfa, 0x24, 0xf3, 0x92, 0xe1, 0xf9, 0x93, 0x09, 0xc3, 0xf3, 0x77, 0x61,
+	0x89, 0x3f, 0xe6, 0x63, 0x03, 0xa5, 0xab, 0xaf, 0x35, 0xd7, 0x12, 0x94, 0xcb, 0xfb, 0xf2, 0xad,
+	0x1f, 0xbf, 0x1a, 0xf9, 0xf8, 0x56, 0xe4, 0x1c, 0x49, 0xf9, 0xe5, 0xe2, 0xab, 0xc9, 0xbb, 0x3a,
+	0xb5, 0x69, 0x98, 0xe0, 0xe1, 0xb3, 0x1d, 0xb9, 0x40, 0xdf, 0x07, 0x74, 0x12, 0x7f, 0xc3, 0x69,
+	0x27, 0xbe, 0xe4, 0xf2, 0xaf, 0x8d, 0x86, 0xfa, 0x9a, 0x94, 0x1f, 0xe7, 0x31, 0xcc, 0x72, 0x42,
+	0x8c, 0xd0, 0xde, 0x04, 0xe0, 0x76, 0xb5, 0x6d, 0xe2, 0x07, 0x9e, 0x7a, 0xb5, 0xdd, 0x18, 0x0d,
+	0xf5, 0xb2, 0x44, 0x49, 0xf6, 0x0c, 0x73, 0x81, 0x2f, 0x5a, 0xfc, 0x37, 0x1f, 0xfa, 0xbb, 0x6e,
+	0xd0, 0xc1, 0x6e, 0x9b, 0xa9, 0x66, 0xdf, 0xb6, 0x70, 0xaf, 0x52, 0x98, 0x66, 0xe8, 0x97, 0xef,
+	0x34, 0x35, 0xf4, 0x4f, 0x40, 0x33, 0xcc, 0xb2, 0xa4, 0x46, 0xf3, 0xc4, 0x36, 0xee, 0xa1, 0x0f,
+	0x35, 0x58, 0x4d, 0xa2, 0xed, 0x92, 0xf2, 0x39, 0xa1, 0xfc, 0x7b, 0xd3, 0x28, 0x7f, 0x2d, 0x1b,
+	0xbe, 0x97, 0xf5, 0xaf, 0xc4, 0x1b, 0xa9, 0x23, 0xa4, 0x52, 0xfa, 0x5c, 0x03, 0x94, 0xf4, 0x35,
+	0x93, 0xd0, 0x1e, 0x7f, 0x5f, 0xf3, 0x87, 0x55, 0xea, 0x21, 0xa4, 0x7d, 0xc6, 0xc3, 0x2a, 0x11,
+	0x8e, 0x1e, 0x56, 0x89, 0x20, 0x7a, 0x2b, 0xe9, 0x02, 0x33, 0xea, 0xd3, 0x1c, 0xc7, 0xe8, 0x60,
+	0x4a, 0x52, 0x2f, 0x33, 0x27, 0x12, 0x1d, 0x2b, 0xfc, 0x39, 0xe3, 0x77, 0x1a, 0xac, 0x8d, 0xe5,
+	0x51, 0x7c, 0xcc, 0xf7, 0x01, 0x85, 0xa9, 0x4d, 0x11, 0x25, 0x03, 0x75, 0xdc, 0xe9, 0x72, 0xb2,
+	0x1c, 0x4e, 0xe8, 0x2e, 0x9f, 0x43, 0x0b, 0xcb, 0x0b, 0x2f, 0xff, 0x56, 0x83, 0x95, 0xb4, 0xe6,
+	0xd8, 0x80, 0xf7, 0x60, 0x31, 0xad, 0x58, 0x1d, 0xfd, 0xf6, 0x4b, 0x8f, 0xae, 0x4e, 0x7d, 0x49,
+	0x18, 0xdd, 0x4f, 0xca, 0x92, 0xfc, 0xa4, 0xd9, 0xb8, 0x9a, 0x0b, 0xa2, 0xd3, 0x64, 0xcb, 0x53,
+	0x5e, 0xdc, 0xc0, 0x7f, 0x34, 0xc8, 0xef, 0x07, 0x81, 0x8b, 0x3e, 0x80, 0xb2, 0x1f, 0xb0, 0x36,
+	0xcf, 0x20, 0x62, 0xb7, 0xd5, 0x37, 0x12, 0x59, 0xec, 0xbf, 0x73, 0x65, 0xcf, 0xfc, 0x73, 0xa8,
+	0x8f, 0xa3, 0x98, 0x25, 0x3f, 0x60, 0x4d, 0x41, 0x39, 0x14, 0x04, 0xf4, 0x08, 0xae, 0x5d, 0xd6,
+	0x23, 0xbb, 0x80, 0x39, 0x8d, 0x9e, 0xcb, 0x08, 0xa3, 0xa1, 0xbe, 0x92, 0xd4, 0x83, 0x98, 0x6c,
+	0x98, 0x8b, 0x9d, 0x94, 0xe2, 0xbb, 0xf3, 0xfc, 0xbe, 0xfe, 0x75, 0xa1, 0x6b, 0x5f, 0xff, 0x8d,
+	0x06, 0x90, 0x7c, 0x1e, 0x42, 0xdf, 0x80, 0x57, 0x9b, 0x3f, 0xbc, 0xdf, 0x6a, 0x1f, 0x1c, 0x6e,
+	0x1d, 0x3e, 0x38, 0x68, 0x3f, 0xb8, 0x7f, 0xb0, 0xbf, 0xb3, 0xbd, 0x77, 0x6f, 0x6f, 0xa7, 0xb5,
+	0x9c, 0xab, 0x96, 0x9e, 0x9c, 0xd7, 0x8b, 0x0f, 0x7c, 0xda, 0x23, 0x96, 0x73, 0xec, 0x10, 0x1b,
+	0xbd, 0x0e, 0x2b, 0x97, 0xb9, 0xf9, 0x6a, 0xa7, 0xb5, 0xac, 0x55, 0x17, 0x9f, 0x9c, 0xd7, 0xe7,
+	0xe5, 0x48, 0x49, 0x6c, 0xb4, 0x0e, 0x37, 0xc6, 0xf9, 0xf6, 0xee, 0xbf, 0xbb, 0x3c, 0x53, 0xbd,
+	0xf6, 0xe4, 0xbc, 0xbe, 0x10, 0xcf, 0x9e, 0xc8, 0x00, 0x94, 0xe6, 0x54, 0x78, 0xb3, 0x55, 0x78,
+	0x72, 0x5e, 0x2f, 0x48, 0xdf, 0x55, 0xf3, 0x8f, 0x7f, 0x59, 0xcb, 0x35, 0xb7, 0x3e, 0x7d, 0x56,
+	0xd3, 0x9e, 0x3e, 0xab, 0x69, 0x7f, 0x7f, 0x56, 0xd3, 0x3e, 0x7a, 0x5e, 0xcb, 0x3d, 0x7d, 0x5e,
+	0xcb, 0xfd, 0xe9, 0x79, 0x2d, 0xf7, 0x93, 0xaf, 0xbe, 0xc8, 0x6d, 0x67, 0xf1, 0xff, 0x13, 0x84,
+	0x03, 0x3b, 0x05, 0xd1, 0x5f, 0xbe, 0xf5, 0xbf, 0x01, 0x00, 0x90, 0xd8, 0xa8, 0x49, 0x6b, 0x18,
+	0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1258,607 +1316,632 @@ func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_
 func StakingDescription() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 9587 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x70, 0x1c, 0xd9,
-		0x71, 0x18, 0x67, 0x77, 0x01, 0xec, 0x36, 0x16, 0xc0, 0xe2, 0x01, 0x04, 0x97, 0x4b, 0x1e, 0x01,
-		0xce, 0x7d, 0x90, 0xc7, 0xbb, 0x03, 0xee, 0x78, 0xe4, 0xdd, 0x71, 0x79, 0x77, 0x34, 0x16, 0xbb,
-		0x04, 0x71, 0x87, 0x2f, 0x0f, 0x00, 0xea, 0x24, 0xd9, 0xb5, 0x19, 0xec, 0x3e, 0x2c, 0xe6, 0x30,
-		0x3b, 0x33, 0x9a, 0x99, 0x25, 0x89, 0x53, 0x94, 0x3a, 0x4b, 0xb6, 0x22, 0x5f, 0x2a, 0x8e, 0x64,
-		0xa5, 0x4a, 0xb2, 0x2c, 0x2a, 0x27, 0x2b, 0x8e, 0x1c, 0xc5, 0x91, 0xa5, 0x44, 0x91, 0xad, 0x44,
-		0x71, 0xc9, 0x95, 0x72, 0x2c, 0x4b, 0x29, 0x97, 0x54, 0xae, 0x24, 0x2e, 0x3b, 0x45, 0x3b, 0x27,
-		0xa5, 0xa2, 0xc8, 0xb2, 0xac, 0xd0, 0x52, 0x2a, 0x29, 0xe5, 0x47, 0xea, 0x7d, 0xcd, 0xd7, 0xce,
-		0x7e, 0x80, 0x22, 0x25, 0x25, 0xce, 0x2f, 0xec, 0xf4, 0xeb, 0xee, 0xd7, 0xdd, 0xaf, 0x5f, 0x77,
-		0xbf, 0x37, 0xef, 0x0d, 0xe0, 0xd3, 0x17, 0x61, 0xa6, 0x61, 0x9a, 0x0d, 0x1d, 0xcf, 0x59, 0xb6,
-		0xe9, 0x9a, 0xdb, 0xad, 0x9d, 0xb9, 0x3a, 0x76, 0x6a, 0xb6, 0x66, 0xb9, 0xa6, 0x3d, 0x4b, 0x61,
-		0x68, 0x8c, 0x61, 0xcc, 0x0a, 0x0c, 0x79, 0x05, 0xc6, 0x2f, 0x6b, 0x3a, 0x2e, 0x7b, 0x88, 0x1b,
-		0xd8, 0x45, 0xcf, 0x40, 0x6a, 0x47, 0xd3, 0x71, 0x5e, 0x9a, 0x49, 0x9e, 0x1e, 0x3e, 0xfb, 0xc0,
-		0x6c, 0x84, 0x68, 0x36, 0x4c, 0xb1, 0x4e, 0xc0, 0x0a, 0xa5, 0x90, 0xbf, 0x9e, 0x82, 0x89, 0x98,
-		0x56, 0x84, 0x20, 0x65, 0xa8, 0x4d, 0xc2, 0x51, 0x3a, 0x9d, 0x51, 0xe8, 0x6f, 0x94, 0x87, 0x21,
-		0x4b, 0xad, 0xed, 0xa9, 0x0d, 0x9c, 0x4f, 0x50, 0xb0, 0x78, 0x44, 0x27, 0x00, 0xea, 0xd8, 0xc2,
-		0x46, 0x1d, 0x1b, 0xb5, 0xfd, 0x7c, 0x72, 0x26, 0x79, 0x3a, 0xa3, 0x04, 0x20, 0xe8, 0x11, 0x18,
-		0xb7, 0x5a, 0xdb, 0xba, 0x56, 0xab, 0x06, 0xd0, 0x60, 0x26, 0x79, 0x7a, 0x40, 0xc9, 0xb1, 0x86,
-		0xb2, 0x8f, 0x7c, 0x0a, 0xc6, 0xae, 0x63, 0x75, 0x2f, 0x88, 0x3a, 0x4c, 0x51, 0x47, 0x09, 0x38,
-		0x80, 0xb8, 0x00, 0xd9, 0x26, 0x76, 0x1c, 0xb5, 0x81, 0xab, 0xee, 0xbe, 0x85, 0xf3, 0x29, 0xaa,
-		0xfd, 0x4c, 0x9b, 0xf6, 0x51, 0xcd, 0x87, 0x39, 0xd5, 0xe6, 0xbe, 0x85, 0xd1, 0x3c, 0x64, 0xb0,
-		0xd1, 0x6a, 0x32, 0x0e, 0x03, 0x1d, 0xec, 0x57, 0x31, 0x5a, 0xcd, 0x28, 0x97, 0x34, 0x21, 0xe3,
-		0x2c, 0x86, 0x1c, 0x6c, 0x5f, 0xd3, 0x6a, 0x38, 0x3f, 0x48, 0x19, 0x9c, 0x6a, 0x63, 0xb0, 0xc1,
-		0xda, 0xa3, 0x3c, 0x04, 0x1d, 0x5a, 0x80, 0x0c, 0xbe, 0xe1, 0x62, 0xc3, 0xd1, 0x4c, 0x23, 0x3f,
-		0x44, 0x99, 0x3c, 0x18, 0x33, 0x8a, 0x58, 0xaf, 0x47, 0x59, 0xf8, 0x74, 0xe8, 0x29, 0x18, 0x32,
-		0x2d, 0x57, 0x33, 0x0d, 0x27, 0x9f, 0x9e, 0x91, 0x4e, 0x0f, 0x9f, 0x3d, 0x1e, 0xeb, 0x08, 0x6b,
-		0x0c, 0x47, 0x11, 0xc8, 0x68, 0x09, 0x72, 0x8e, 0xd9, 0xb2, 0x6b, 0xb8, 0x5a, 0x33, 0xeb, 0xb8,
-		0xaa, 0x19, 0x3b, 0x66, 0x3e, 0x43, 0x19, 0x4c, 0xb7, 0x2b, 0x42, 0x11, 0x17, 0xcc, 0x3a, 0x5e,
-		0x32, 0x76, 0x4c, 0x65, 0xd4, 0x09, 0x3d, 0xa3, 0x29, 0x18, 0x74, 0xf6, 0x0d, 0x57, 0xbd, 0x91,
-		0xcf, 0x52, 0x0f, 0xe1, 0x4f, 0xf2, 0xe7, 0x06, 0x61, 0xac, 0x1f, 0x17, 0xbb, 0x08, 0x03, 0x3b,
-		0x44, 0xcb, 0x7c, 0xe2, 0x20, 0x36, 0x60, 0x34, 0x61, 0x23, 0x0e, 0xde, 0xa1, 0x11, 0xe7, 0x61,
-		0xd8, 0xc0, 0x8e, 0x8b, 0xeb, 0xcc, 0x23, 0x92, 0x7d, 0xfa, 0x14, 0x30, 0xa2, 0x76, 0x97, 0x4a,
-		0xdd, 0x91, 0x4b, 0xbd, 0x04, 0x63, 0x9e, 0x48, 0x55, 0x5b, 0x35, 0x1a, 0xc2, 0x37, 0xe7, 0x7a,
-		0x49, 0x32, 0x5b, 0x11, 0x74, 0x0a, 0x21, 0x53, 0x46, 0x71, 0xe8, 0x19, 0x95, 0x01, 0x4c, 0x03,
-		0x9b, 0x3b, 0xd5, 0x3a, 0xae, 0xe9, 0xf9, 0x74, 0x07, 0x2b, 0xad, 0x11, 0x94, 0x36, 0x2b, 0x99,
-		0x0c, 0x5a, 0xd3, 0xd1, 0x05, 0xdf, 0xd5, 0x86, 0x3a, 0x78, 0xca, 0x0a, 0x9b, 0x64, 0x6d, 0xde,
-		0xb6, 0x05, 0xa3, 0x36, 0x26, 0x7e, 0x8f, 0xeb, 0x5c, 0xb3, 0x0c, 0x15, 0x62, 0xb6, 0xa7, 0x66,
-		0x0a, 0x27, 0x63, 0x8a, 0x8d, 0xd8, 0xc1, 0x47, 0x74, 0x3f, 0x78, 0x80, 0x2a, 0x75, 0x2b, 0xa0,
-		0x51, 0x28, 0x2b, 0x80, 0xab, 0x6a, 0x13, 0x17, 0x5e, 0x81, 0xd1, 0xb0, 0x79, 0xd0, 0x24, 0x0c,
-		0x38, 0xae, 0x6a, 0xbb, 0xd4, 0x0b, 0x07, 0x14, 0xf6, 0x80, 0x72, 0x90, 0xc4, 0x46, 0x9d, 0x46,
-		0xb9, 0x01, 0x85, 0xfc, 0x44, 0x3f, 0xe1, 0x2b, 0x9c, 0xa4, 0x0a, 0x3f, 0xd4, 0x3e, 0xa2, 0x21,
-		0xce, 0x51, 0xbd, 0x0b, 0x4f, 0xc3, 0x48, 0x48, 0x81, 0x7e, 0xbb, 0x96, 0xff, 0x26, 0x1c, 0x8e,
-		0x65, 0x8d, 0x5e, 0x82, 0xc9, 0x96, 0xa1, 0x19, 0x2e, 0xb6, 0x2d, 0x1b, 0x13, 0x8f, 0x65, 0x5d,
-		0xe5, 0xff, 0xeb, 0x50, 0x07, 0x9f, 0xdb, 0x0a, 0x62, 0x33, 0x2e, 0xca, 0x44, 0xab, 0x1d, 0x78,
-		0x26, 0x93, 0xfe, 0xc6, 0x50, 0xee, 0xd5, 0x57, 0x5f, 0x7d, 0x35, 0x21, 0xff, 0xce, 0x20, 0x4c,
-		0xc6, 0xcd, 0x99, 0xd8, 0xe9, 0x3b, 0x05, 0x83, 0x46, 0xab, 0xb9, 0x8d, 0x6d, 0x6a, 0xa4, 0x01,
-		0x85, 0x3f, 0xa1, 0x79, 0x18, 0xd0, 0xd5, 0x6d, 0xac, 0xe7, 0x53, 0x33, 0xd2, 0xe9, 0xd1, 0xb3,
-		0x8f, 0xf4, 0x35, 0x2b, 0x67, 0x97, 0x09, 0x89, 0xc2, 0x28, 0xd1, 0xf3, 0x90, 0xe2, 0x21, 0x9a,
-		0x70, 0x38, 0xd3, 0x1f, 0x07, 0x32, 0x97, 0x14, 0x4a, 0x87, 0x8e, 0x41, 0x86, 0xfc, 0x65, 0xbe,
-		0x31, 0x48, 0x65, 0x4e, 0x13, 0x00, 0xf1, 0x0b, 0x54, 0x80, 0x34, 0x9d, 0x26, 0x75, 0x2c, 0x52,
-		0x9b, 0xf7, 0x4c, 0x1c, 0xab, 0x8e, 0x77, 0xd4, 0x96, 0xee, 0x56, 0xaf, 0xa9, 0x7a, 0x0b, 0x53,
-		0x87, 0xcf, 0x28, 0x59, 0x0e, 0xbc, 0x4a, 0x60, 0x68, 0x1a, 0x86, 0xd9, 0xac, 0xd2, 0x8c, 0x3a,
-		0xbe, 0x41, 0xa3, 0xe7, 0x80, 0xc2, 0x26, 0xda, 0x12, 0x81, 0x90, 0xee, 0x5f, 0x76, 0x4c, 0x43,
-		0xb8, 0x26, 0xed, 0x82, 0x00, 0x68, 0xf7, 0x4f, 0x47, 0x03, 0xf7, 0x7d, 0xf1, 0xea, 0xb5, 0xcd,
-		0xa5, 0x53, 0x30, 0x46, 0x31, 0x9e, 0xe4, 0x43, 0xaf, 0xea, 0xf9, 0xf1, 0x19, 0xe9, 0x74, 0x5a,
-		0x19, 0x65, 0xe0, 0x35, 0x0e, 0x95, 0x3f, 0x9b, 0x80, 0x14, 0x0d, 0x2c, 0x63, 0x30, 0xbc, 0xf9,
-		0xe6, 0xf5, 0x4a, 0xb5, 0xbc, 0xb6, 0x55, 0x5a, 0xae, 0xe4, 0x24, 0x34, 0x0a, 0x40, 0x01, 0x97,
-		0x97, 0xd7, 0xe6, 0x37, 0x73, 0x09, 0xef, 0x79, 0x69, 0x75, 0xf3, 0xa9, 0x73, 0xb9, 0xa4, 0x47,
-		0xb0, 0xc5, 0x00, 0xa9, 0x20, 0xc2, 0x93, 0x67, 0x73, 0x03, 0x28, 0x07, 0x59, 0xc6, 0x60, 0xe9,
-		0xa5, 0x4a, 0xf9, 0xa9, 0x73, 0xb9, 0xc1, 0x30, 0xe4, 0xc9, 0xb3, 0xb9, 0x21, 0x34, 0x02, 0x19,
-		0x0a, 0x29, 0xad, 0xad, 0x2d, 0xe7, 0xd2, 0x1e, 0xcf, 0x8d, 0x4d, 0x65, 0x69, 0x75, 0x31, 0x97,
-		0xf1, 0x78, 0x2e, 0x2a, 0x6b, 0x5b, 0xeb, 0x39, 0xf0, 0x38, 0xac, 0x54, 0x36, 0x36, 0xe6, 0x17,
-		0x2b, 0xb9, 0x61, 0x0f, 0xa3, 0xf4, 0xe6, 0xcd, 0xca, 0x46, 0x2e, 0x1b, 0x12, 0xeb, 0xc9, 0xb3,
-		0xb9, 0x11, 0xaf, 0x8b, 0xca, 0xea, 0xd6, 0x4a, 0x6e, 0x14, 0x8d, 0xc3, 0x08, 0xeb, 0x42, 0x08,
-		0x31, 0x16, 0x01, 0x3d, 0x75, 0x2e, 0x97, 0xf3, 0x05, 0x61, 0x5c, 0xc6, 0x43, 0x80, 0xa7, 0xce,
-		0xe5, 0x90, 0xbc, 0x00, 0x03, 0xd4, 0x0d, 0x11, 0x82, 0xd1, 0xe5, 0xf9, 0x52, 0x65, 0xb9, 0xba,
-		0xb6, 0xbe, 0xb9, 0xb4, 0xb6, 0x3a, 0xbf, 0x9c, 0x93, 0x7c, 0x98, 0x52, 0xf9, 0xc9, 0xad, 0x25,
-		0xa5, 0x52, 0xce, 0x25, 0x82, 0xb0, 0xf5, 0xca, 0xfc, 0x66, 0xa5, 0x9c, 0x4b, 0xca, 0x35, 0x98,
-		0x8c, 0x0b, 0xa8, 0xb1, 0x53, 0x28, 0xe0, 0x0b, 0x89, 0x0e, 0xbe, 0x40, 0x79, 0x45, 0x7d, 0x41,
-		0xfe, 0x5a, 0x02, 0x26, 0x62, 0x92, 0x4a, 0x6c, 0x27, 0x97, 0x60, 0x80, 0xf9, 0x32, 0x4b, 0xb3,
-		0x0f, 0xc7, 0x66, 0x27, 0xea, 0xd9, 0x6d, 0xa9, 0x96, 0xd2, 0x05, 0x4b, 0x8d, 0x64, 0x87, 0x52,
-		0x83, 0xb0, 0x68, 0x73, 0xd8, 0x9f, 0x6e, 0x0b, 0xfe, 0x2c, 0x3f, 0x3e, 0xd5, 0x4f, 0x7e, 0xa4,
-		0xb0, 0x83, 0x25, 0x81, 0x81, 0x98, 0x24, 0x70, 0x11, 0xc6, 0xdb, 0x18, 0xf5, 0x1d, 0x8c, 0xdf,
-		0x25, 0x41, 0xbe, 0x93, 0x71, 0x7a, 0x84, 0xc4, 0x44, 0x28, 0x24, 0x5e, 0x8c, 0x5a, 0xf0, 0x64,
-		0xe7, 0x41, 0x68, 0x1b, 0xeb, 0x8f, 0x4b, 0x30, 0x15, 0x5f, 0x52, 0xc6, 0xca, 0xf0, 0x3c, 0x0c,
-		0x36, 0xb1, 0xbb, 0x6b, 0x8a, 0xb2, 0xea, 0xa1, 0x98, 0x64, 0x4d, 0x9a, 0xa3, 0x83, 0xcd, 0xa9,
-		0x82, 0xd9, 0x3e, 0xd9, 0xa9, 0x2e, 0x64, 0xd2, 0xb4, 0x49, 0xfa, 0xf3, 0x09, 0x38, 0x1c, 0xcb,
-		0x3c, 0x56, 0xd0, 0xfb, 0x00, 0x34, 0xc3, 0x6a, 0xb9, 0xac, 0x74, 0x62, 0x91, 0x38, 0x43, 0x21,
-		0x34, 0x78, 0x91, 0x28, 0xdb, 0x72, 0xbd, 0xf6, 0x24, 0x6d, 0x07, 0x06, 0xa2, 0x08, 0xcf, 0xf8,
-		0x82, 0xa6, 0xa8, 0xa0, 0x27, 0x3a, 0x68, 0xda, 0xe6, 0x98, 0x8f, 0x43, 0xae, 0xa6, 0x6b, 0xd8,
-		0x70, 0xab, 0x8e, 0x6b, 0x63, 0xb5, 0xa9, 0x19, 0x0d, 0x9a, 0x6a, 0xd2, 0xc5, 0x81, 0x1d, 0x55,
-		0x77, 0xb0, 0x32, 0xc6, 0x9a, 0x37, 0x44, 0x2b, 0xa1, 0xa0, 0x0e, 0x64, 0x07, 0x28, 0x06, 0x43,
-		0x14, 0xac, 0xd9, 0xa3, 0x90, 0xdf, 0x97, 0x81, 0xe1, 0x40, 0x01, 0x8e, 0x4e, 0x42, 0xf6, 0x65,
-		0xf5, 0x9a, 0x5a, 0x15, 0x8b, 0x2a, 0x66, 0x89, 0x61, 0x02, 0x5b, 0xe7, 0x0b, 0xab, 0xc7, 0x61,
-		0x92, 0xa2, 0x98, 0x2d, 0x17, 0xdb, 0xd5, 0x9a, 0xae, 0x3a, 0x0e, 0x35, 0x5a, 0x9a, 0xa2, 0x22,
-		0xd2, 0xb6, 0x46, 0x9a, 0x16, 0x44, 0x0b, 0x3a, 0x0f, 0x13, 0x94, 0xa2, 0xd9, 0xd2, 0x5d, 0xcd,
-		0xd2, 0x71, 0x95, 0x2c, 0xf3, 0x1c, 0x9a, 0x72, 0x3c, 0xc9, 0xc6, 0x09, 0xc6, 0x0a, 0x47, 0x20,
-		0x12, 0x39, 0xa8, 0x0c, 0xf7, 0x51, 0xb2, 0x06, 0x36, 0xb0, 0xad, 0xba, 0xb8, 0x8a, 0xdf, 0xd6,
-		0x52, 0x75, 0xa7, 0xaa, 0x1a, 0xf5, 0xea, 0xae, 0xea, 0xec, 0xe6, 0x27, 0x09, 0x83, 0x52, 0x22,
-		0x2f, 0x29, 0x47, 0x09, 0xe2, 0x22, 0xc7, 0xab, 0x50, 0xb4, 0x79, 0xa3, 0x7e, 0x45, 0x75, 0x76,
-		0x51, 0x11, 0xa6, 0x28, 0x17, 0xc7, 0xb5, 0x35, 0xa3, 0x51, 0xad, 0xed, 0xe2, 0xda, 0x5e, 0xb5,
-		0xe5, 0xee, 0x3c, 0x93, 0x3f, 0x16, 0xec, 0x9f, 0x4a, 0xb8, 0x41, 0x71, 0x16, 0x08, 0xca, 0x96,
-		0xbb, 0xf3, 0x0c, 0xda, 0x80, 0x2c, 0x19, 0x8c, 0xa6, 0xf6, 0x0a, 0xae, 0xee, 0x98, 0x36, 0xcd,
-		0xa1, 0xa3, 0x31, 0xa1, 0x29, 0x60, 0xc1, 0xd9, 0x35, 0x4e, 0xb0, 0x62, 0xd6, 0x71, 0x71, 0x60,
-		0x63, 0xbd, 0x52, 0x29, 0x2b, 0xc3, 0x82, 0xcb, 0x65, 0xd3, 0x26, 0x0e, 0xd5, 0x30, 0x3d, 0x03,
-		0x0f, 0x33, 0x87, 0x6a, 0x98, 0xc2, 0xbc, 0xe7, 0x61, 0xa2, 0x56, 0x63, 0x3a, 0x6b, 0xb5, 0x2a,
-		0x5f, 0x8c, 0x39, 0xf9, 0x5c, 0xc8, 0x58, 0xb5, 0xda, 0x22, 0x43, 0xe0, 0x3e, 0xee, 0xa0, 0x0b,
-		0x70, 0xd8, 0x37, 0x56, 0x90, 0x70, 0xbc, 0x4d, 0xcb, 0x28, 0xe9, 0x79, 0x98, 0xb0, 0xf6, 0xdb,
-		0x09, 0x51, 0xa8, 0x47, 0x6b, 0x3f, 0x4a, 0xf6, 0x34, 0x4c, 0x5a, 0xbb, 0x56, 0x3b, 0xdd, 0x99,
-		0x20, 0x1d, 0xb2, 0x76, 0xad, 0x28, 0xe1, 0x83, 0x74, 0x65, 0x6e, 0xe3, 0x9a, 0xea, 0xe2, 0x7a,
-		0xfe, 0x48, 0x10, 0x3d, 0xd0, 0x80, 0x66, 0x21, 0x57, 0xab, 0x55, 0xb1, 0xa1, 0x6e, 0xeb, 0xb8,
-		0xaa, 0xda, 0xd8, 0x50, 0x9d, 0xfc, 0x34, 0x45, 0x4e, 0xb9, 0x76, 0x0b, 0x2b, 0xa3, 0xb5, 0x5a,
-		0x85, 0x36, 0xce, 0xd3, 0x36, 0x74, 0x06, 0xc6, 0xcd, 0xed, 0x97, 0x6b, 0xcc, 0x23, 0xab, 0x96,
-		0x8d, 0x77, 0xb4, 0x1b, 0xf9, 0x07, 0xa8, 0x79, 0xc7, 0x48, 0x03, 0xf5, 0xc7, 0x75, 0x0a, 0x46,
-		0x0f, 0x43, 0xae, 0xe6, 0xec, 0xaa, 0xb6, 0x45, 0x43, 0xb2, 0x63, 0xa9, 0x35, 0x9c, 0x7f, 0x90,
-		0xa1, 0x32, 0xf8, 0xaa, 0x00, 0x93, 0x19, 0xe1, 0x5c, 0xd7, 0x76, 0x5c, 0xc1, 0xf1, 0x14, 0x9b,
-		0x11, 0x14, 0xc6, 0xb9, 0x9d, 0x86, 0x1c, 0xb1, 0x44, 0xa8, 0xe3, 0xd3, 0x14, 0x6d, 0xd4, 0xda,
-		0xb5, 0x82, 0xfd, 0xde, 0x0f, 0x23, 0x04, 0xd3, 0xef, 0xf4, 0x61, 0x56, 0xb8, 0x59, 0xbb, 0x81,
-		0x1e, 0xcf, 0xc1, 0x14, 0x41, 0x6a, 0x62, 0x57, 0xad, 0xab, 0xae, 0x1a, 0xc0, 0x7e, 0x94, 0x62,
-		0x13, 0xb3, 0xaf, 0xf0, 0xc6, 0x90, 0x9c, 0x76, 0x6b, 0x7b, 0xdf, 0x73, 0xac, 0xc7, 0x98, 0x9c,
-		0x04, 0x26, 0x5c, 0xeb, 0x9e, 0x15, 0xe7, 0x72, 0x11, 0xb2, 0x41, 0xbf, 0x47, 0x19, 0x60, 0x9e,
-		0x9f, 0x93, 0x48, 0x11, 0xb4, 0xb0, 0x56, 0x26, 0xe5, 0xcb, 0x5b, 0x2a, 0xb9, 0x04, 0x29, 0xa3,
-		0x96, 0x97, 0x36, 0x2b, 0x55, 0x65, 0x6b, 0x75, 0x73, 0x69, 0xa5, 0x92, 0x4b, 0x06, 0x0a, 0xfb,
-		0x17, 0x52, 0xe9, 0x87, 0x72, 0xa7, 0xe4, 0xaf, 0x26, 0x60, 0x34, 0xbc, 0x52, 0x43, 0xcf, 0xc2,
-		0x11, 0xb1, 0xad, 0xe2, 0x60, 0xb7, 0x7a, 0x5d, 0xb3, 0xe9, 0x84, 0x6c, 0xaa, 0x2c, 0x39, 0x7a,
-		0xfe, 0x33, 0xc9, 0xb1, 0x36, 0xb0, 0xfb, 0x26, 0xcd, 0x26, 0xd3, 0xad, 0xa9, 0xba, 0x68, 0x19,
-		0xa6, 0x0d, 0xb3, 0xea, 0xb8, 0xaa, 0x51, 0x57, 0xed, 0x7a, 0xd5, 0xdf, 0xd0, 0xaa, 0xaa, 0xb5,
-		0x1a, 0x76, 0x1c, 0x93, 0x25, 0x42, 0x8f, 0xcb, 0x71, 0xc3, 0xdc, 0xe0, 0xc8, 0x7e, 0x86, 0x98,
-		0xe7, 0xa8, 0x11, 0xf7, 0x4d, 0x76, 0x72, 0xdf, 0x63, 0x90, 0x69, 0xaa, 0x56, 0x15, 0x1b, 0xae,
-		0xbd, 0x4f, 0xeb, 0xf3, 0xb4, 0x92, 0x6e, 0xaa, 0x56, 0x85, 0x3c, 0xff, 0x50, 0x96, 0x49, 0x2f,
-		0xa4, 0xd2, 0xe9, 0x5c, 0xe6, 0x85, 0x54, 0x3a, 0x93, 0x03, 0xf9, 0x8d, 0x24, 0x64, 0x83, 0xf5,
-		0x3a, 0x59, 0xfe, 0xd4, 0x68, 0xc6, 0x92, 0x68, 0x4c, 0xbb, 0xbf, 0x6b, 0x75, 0x3f, 0xbb, 0x40,
-		0x52, 0x59, 0x71, 0x90, 0x15, 0xc7, 0x0a, 0xa3, 0x24, 0x65, 0x04, 0x71, 0x36, 0xcc, 0x8a, 0x91,
-		0xb4, 0xc2, 0x9f, 0xd0, 0x22, 0x0c, 0xbe, 0xec, 0x50, 0xde, 0x83, 0x94, 0xf7, 0x03, 0xdd, 0x79,
-		0xbf, 0xb0, 0x41, 0x99, 0x67, 0x5e, 0xd8, 0xa8, 0xae, 0xae, 0x29, 0x2b, 0xf3, 0xcb, 0x0a, 0x27,
-		0x47, 0x47, 0x21, 0xa5, 0xab, 0xaf, 0xec, 0x87, 0x93, 0x1e, 0x05, 0xf5, 0x3b, 0x08, 0x47, 0x21,
-		0x75, 0x1d, 0xab, 0x7b, 0xe1, 0x54, 0x43, 0x41, 0xf7, 0x70, 0x32, 0xcc, 0xc1, 0x00, 0xb5, 0x17,
-		0x02, 0xe0, 0x16, 0xcb, 0x1d, 0x42, 0x69, 0x48, 0x2d, 0xac, 0x29, 0x64, 0x42, 0xe4, 0x20, 0xcb,
-		0xa0, 0xd5, 0xf5, 0xa5, 0xca, 0x42, 0x25, 0x97, 0x90, 0xcf, 0xc3, 0x20, 0x33, 0x02, 0x99, 0x2c,
-		0x9e, 0x19, 0x72, 0x87, 0xf8, 0x23, 0xe7, 0x21, 0x89, 0xd6, 0xad, 0x95, 0x52, 0x45, 0xc9, 0x25,
-		0xc2, 0x43, 0x9d, 0xca, 0x0d, 0xc8, 0x0e, 0x64, 0x83, 0x75, 0xf8, 0x0f, 0x67, 0x31, 0xfe, 0x05,
-		0x09, 0x86, 0x03, 0x75, 0x35, 0x29, 0x88, 0x54, 0x5d, 0x37, 0xaf, 0x57, 0x55, 0x5d, 0x53, 0x1d,
-		0xee, 0x1a, 0x40, 0x41, 0xf3, 0x04, 0xd2, 0xef, 0xd0, 0xfd, 0x90, 0xa6, 0xc8, 0x40, 0x6e, 0x50,
-		0xfe, 0x88, 0x04, 0xb9, 0x68, 0x61, 0x1b, 0x11, 0x53, 0xfa, 0x51, 0x8a, 0x29, 0x7f, 0x58, 0x82,
-		0xd1, 0x70, 0x35, 0x1b, 0x11, 0xef, 0xe4, 0x8f, 0x54, 0xbc, 0x3f, 0x4b, 0xc0, 0x48, 0xa8, 0x86,
-		0xed, 0x57, 0xba, 0xb7, 0xc1, 0xb8, 0x56, 0xc7, 0x4d, 0xcb, 0x74, 0xb1, 0x51, 0xdb, 0xaf, 0xea,
-		0xf8, 0x1a, 0xd6, 0xf3, 0x32, 0x0d, 0x1a, 0x73, 0xdd, 0xab, 0xe4, 0xd9, 0x25, 0x9f, 0x6e, 0x99,
-		0x90, 0x15, 0x27, 0x96, 0xca, 0x95, 0x95, 0xf5, 0xb5, 0xcd, 0xca, 0xea, 0xc2, 0x9b, 0xab, 0x5b,
-		0xab, 0x2f, 0xae, 0xae, 0xbd, 0x69, 0x55, 0xc9, 0x69, 0x11, 0xb4, 0x7b, 0x38, 0xed, 0xd7, 0x21,
-		0x17, 0x15, 0x0a, 0x1d, 0x81, 0x38, 0xb1, 0x72, 0x87, 0xd0, 0x04, 0x8c, 0xad, 0xae, 0x55, 0x37,
-		0x96, 0xca, 0x95, 0x6a, 0xe5, 0xf2, 0xe5, 0xca, 0xc2, 0xe6, 0x06, 0xdb, 0xf7, 0xf0, 0xb0, 0x37,
-		0x43, 0x13, 0x5c, 0xfe, 0x50, 0x12, 0x26, 0x62, 0x24, 0x41, 0xf3, 0x7c, 0xc5, 0xc2, 0x16, 0x51,
-		0x8f, 0xf5, 0x23, 0xfd, 0x2c, 0xa9, 0x19, 0xd6, 0x55, 0xdb, 0xe5, 0x0b, 0x9c, 0x87, 0x81, 0x58,
-		0xc9, 0x70, 0xb5, 0x1d, 0x0d, 0xdb, 0x7c, 0x3f, 0x89, 0x2d, 0x63, 0xc6, 0x7c, 0x38, 0xdb, 0x52,
-		0x7a, 0x14, 0x90, 0x65, 0x3a, 0x9a, 0xab, 0x5d, 0xc3, 0x55, 0xcd, 0x10, 0x9b, 0x4f, 0x64, 0x59,
-		0x93, 0x52, 0x72, 0xa2, 0x65, 0xc9, 0x70, 0x3d, 0x6c, 0x03, 0x37, 0xd4, 0x08, 0x36, 0x09, 0xe6,
-		0x49, 0x25, 0x27, 0x5a, 0x3c, 0xec, 0x93, 0x90, 0xad, 0x9b, 0x2d, 0x52, 0xeb, 0x31, 0x3c, 0x92,
-		0x3b, 0x24, 0x65, 0x98, 0xc1, 0x3c, 0x14, 0x5e, 0xc5, 0xfb, 0xbb, 0x5e, 0x59, 0x65, 0x98, 0xc1,
-		0x18, 0xca, 0x29, 0x18, 0x53, 0x1b, 0x0d, 0x9b, 0x30, 0x17, 0x8c, 0xd8, 0xba, 0x64, 0xd4, 0x03,
-		0x53, 0xc4, 0xc2, 0x0b, 0x90, 0x16, 0x76, 0x20, 0xa9, 0x9a, 0x58, 0xa2, 0x6a, 0xb1, 0xc5, 0x76,
-		0xe2, 0x74, 0x46, 0x49, 0x1b, 0xa2, 0xf1, 0x24, 0x64, 0x35, 0xa7, 0xea, 0x6f, 0xe2, 0x27, 0x66,
-		0x12, 0xa7, 0xd3, 0xca, 0xb0, 0xe6, 0x78, 0x1b, 0xa0, 0xf2, 0xc7, 0x13, 0x30, 0x1a, 0x7e, 0x09,
-		0x81, 0xca, 0x90, 0xd6, 0xcd, 0x9a, 0x4a, 0x5d, 0x8b, 0xbd, 0x01, 0x3b, 0xdd, 0xe3, 0xbd, 0xc5,
-		0xec, 0x32, 0xc7, 0x57, 0x3c, 0xca, 0xc2, 0x1f, 0x48, 0x90, 0x16, 0x60, 0x34, 0x05, 0x29, 0x4b,
-		0x75, 0x77, 0x29, 0xbb, 0x81, 0x52, 0x22, 0x27, 0x29, 0xf4, 0x99, 0xc0, 0x1d, 0x4b, 0x35, 0xa8,
-		0x0b, 0x70, 0x38, 0x79, 0x26, 0xe3, 0xaa, 0x63, 0xb5, 0x4e, 0x17, 0x3d, 0x66, 0xb3, 0x89, 0x0d,
-		0xd7, 0x11, 0xe3, 0xca, 0xe1, 0x0b, 0x1c, 0x8c, 0x1e, 0x81, 0x71, 0xd7, 0x56, 0x35, 0x3d, 0x84,
-		0x9b, 0xa2, 0xb8, 0x39, 0xd1, 0xe0, 0x21, 0x17, 0xe1, 0xa8, 0xe0, 0x5b, 0xc7, 0xae, 0x5a, 0xdb,
-		0xc5, 0x75, 0x9f, 0x68, 0x90, 0x6e, 0x6e, 0x1c, 0xe1, 0x08, 0x65, 0xde, 0x2e, 0x68, 0xe5, 0xaf,
-		0x4a, 0x30, 0x2e, 0x96, 0x69, 0x75, 0xcf, 0x58, 0x2b, 0x00, 0xaa, 0x61, 0x98, 0x6e, 0xd0, 0x5c,
-		0xed, 0xae, 0xdc, 0x46, 0x37, 0x3b, 0xef, 0x11, 0x29, 0x01, 0x06, 0x85, 0x26, 0x80, 0xdf, 0xd2,
-		0xd1, 0x6c, 0xd3, 0x30, 0xcc, 0xdf, 0x30, 0xd1, 0xd7, 0x94, 0x6c, 0x61, 0x0f, 0x0c, 0x44, 0xd6,
-		0x73, 0x68, 0x12, 0x06, 0xb6, 0x71, 0x43, 0x33, 0xf8, 0xbe, 0x31, 0x7b, 0x10, 0xdb, 0x2f, 0x29,
-		0x6f, 0xfb, 0xa5, 0xf4, 0xb7, 0x60, 0xa2, 0x66, 0x36, 0xa3, 0xe2, 0x96, 0x72, 0x91, 0xcd, 0x05,
-		0xe7, 0x8a, 0xf4, 0x96, 0xc7, 0x38, 0x52, 0xc3, 0xd4, 0x55, 0xa3, 0x31, 0x6b, 0xda, 0x0d, 0xff,
-		0x35, 0x2b, 0xa9, 0x78, 0x9c, 0xc0, 0xcb, 0x56, 0x6b, 0xfb, 0x7f, 0x4a, 0xd2, 0xaf, 0x24, 0x92,
-		0x8b, 0xeb, 0xa5, 0x4f, 0x24, 0x0a, 0x8b, 0x8c, 0x70, 0x5d, 0x18, 0x43, 0xc1, 0x3b, 0x3a, 0xae,
-		0x11, 0x05, 0xe1, 0x9b, 0x8f, 0xc0, 0x64, 0xc3, 0x6c, 0x98, 0x94, 0xd3, 0x1c, 0xf9, 0xc5, 0xdf,
-		0xd3, 0x66, 0x3c, 0x68, 0xa1, 0xe7, 0x4b, 0xdd, 0xe2, 0x2a, 0x4c, 0x70, 0xe4, 0x2a, 0x7d, 0x51,
-		0xc4, 0x96, 0x31, 0xa8, 0xeb, 0x1e, 0x5a, 0xfe, 0xd3, 0x5f, 0xa7, 0xe9, 0x5b, 0x19, 0xe7, 0xa4,
-		0xa4, 0x8d, 0xad, 0x74, 0x8a, 0x0a, 0x1c, 0x0e, 0xf1, 0x63, 0x93, 0x14, 0xdb, 0x3d, 0x38, 0xfe,
-		0x2e, 0xe7, 0x38, 0x11, 0xe0, 0xb8, 0xc1, 0x49, 0x8b, 0x0b, 0x30, 0x72, 0x10, 0x5e, 0xff, 0x96,
-		0xf3, 0xca, 0xe2, 0x20, 0x93, 0x45, 0x18, 0xa3, 0x4c, 0x6a, 0x2d, 0xc7, 0x35, 0x9b, 0x34, 0x02,
-		0x76, 0x67, 0xf3, 0x7b, 0x5f, 0x67, 0xb3, 0x66, 0x94, 0x90, 0x2d, 0x78, 0x54, 0xc5, 0x22, 0xd0,
-		0x77, 0x63, 0x75, 0x5c, 0xd3, 0x7b, 0x70, 0xf8, 0x22, 0x17, 0xc4, 0xc3, 0x2f, 0x5e, 0x85, 0x49,
-		0xf2, 0x9b, 0x06, 0xa8, 0xa0, 0x24, 0xbd, 0x37, 0xdc, 0xf2, 0x5f, 0x7d, 0x17, 0x9b, 0x98, 0x13,
-		0x1e, 0x83, 0x80, 0x4c, 0x81, 0x51, 0x6c, 0x60, 0xd7, 0xc5, 0xb6, 0x53, 0x55, 0xf5, 0x38, 0xf1,
-		0x02, 0x3b, 0x16, 0xf9, 0x5f, 0xfa, 0x56, 0x78, 0x14, 0x17, 0x19, 0xe5, 0xbc, 0xae, 0x17, 0xb7,
-		0xe0, 0x48, 0x8c, 0x57, 0xf4, 0xc1, 0xf3, 0x43, 0x9c, 0xe7, 0x64, 0x9b, 0x67, 0x10, 0xb6, 0xeb,
-		0x20, 0xe0, 0xde, 0x58, 0xf6, 0xc1, 0xf3, 0x97, 0x39, 0x4f, 0xc4, 0x69, 0xc5, 0x90, 0x12, 0x8e,
-		0x2f, 0xc0, 0xf8, 0x35, 0x6c, 0x6f, 0x9b, 0x0e, 0xdf, 0x25, 0xea, 0x83, 0xdd, 0x87, 0x39, 0xbb,
-		0x31, 0x4e, 0x48, 0xb7, 0x8d, 0x08, 0xaf, 0x0b, 0x90, 0xde, 0x51, 0x6b, 0xb8, 0x0f, 0x16, 0x37,
-		0x39, 0x8b, 0x21, 0x82, 0x4f, 0x48, 0xe7, 0x21, 0xdb, 0x30, 0x79, 0x8e, 0xea, 0x4d, 0xfe, 0x11,
-		0x4e, 0x3e, 0x2c, 0x68, 0x38, 0x0b, 0xcb, 0xb4, 0x5a, 0x3a, 0x49, 0x60, 0xbd, 0x59, 0xfc, 0x03,
-		0xc1, 0x42, 0xd0, 0x70, 0x16, 0x07, 0x30, 0xeb, 0xeb, 0x82, 0x85, 0x13, 0xb0, 0xe7, 0x25, 0x18,
-		0x36, 0x0d, 0x7d, 0xdf, 0x34, 0xfa, 0x11, 0xe2, 0xa3, 0x9c, 0x03, 0x70, 0x12, 0xc2, 0xe0, 0x22,
-		0x64, 0xfa, 0x1d, 0x88, 0x5f, 0xfd, 0x96, 0x98, 0x1e, 0x62, 0x04, 0x16, 0x61, 0x4c, 0x04, 0x28,
-		0xcd, 0x34, 0xfa, 0x60, 0xf1, 0x8f, 0x38, 0x8b, 0xd1, 0x00, 0x19, 0x57, 0xc3, 0xc5, 0x8e, 0xdb,
-		0xc0, 0xfd, 0x30, 0xf9, 0xb8, 0x50, 0x83, 0x93, 0x70, 0x53, 0x6e, 0x63, 0xa3, 0xb6, 0xdb, 0x1f,
-		0x87, 0x5f, 0x13, 0xa6, 0x14, 0x34, 0x84, 0xc5, 0x02, 0x8c, 0x34, 0x55, 0xdb, 0xd9, 0x55, 0xf5,
-		0xbe, 0x86, 0xe3, 0x1f, 0x73, 0x1e, 0x59, 0x8f, 0x88, 0x5b, 0xa4, 0x65, 0x1c, 0x84, 0xcd, 0x27,
-		0x84, 0x45, 0x02, 0x64, 0x7c, 0xea, 0x39, 0x2e, 0xdd, 0x52, 0x3b, 0x08, 0xb7, 0x7f, 0x22, 0xa6,
-		0x1e, 0xa3, 0x5d, 0x09, 0x72, 0xbc, 0x08, 0x19, 0x47, 0x7b, 0xa5, 0x2f, 0x36, 0xbf, 0x2e, 0x46,
-		0x9a, 0x12, 0x10, 0xe2, 0x37, 0xc3, 0xd1, 0xd8, 0x34, 0xd1, 0x07, 0xb3, 0x7f, 0xca, 0x99, 0x4d,
-		0xc5, 0xa4, 0x0a, 0x1e, 0x12, 0x0e, 0xca, 0xf2, 0x93, 0x22, 0x24, 0xe0, 0x08, 0xaf, 0x75, 0xb2,
-		0x6a, 0x70, 0xd4, 0x9d, 0x83, 0x59, 0xed, 0x37, 0x84, 0xd5, 0x18, 0x6d, 0xc8, 0x6a, 0x9b, 0x30,
-		0xc5, 0x39, 0x1e, 0x6c, 0x5c, 0x3f, 0x25, 0x02, 0x2b, 0xa3, 0xde, 0x0a, 0x8f, 0xee, 0x5b, 0xa1,
-		0xe0, 0x99, 0x53, 0x94, 0xa7, 0x4e, 0xb5, 0xa9, 0x5a, 0x7d, 0x70, 0xfe, 0x34, 0xe7, 0x2c, 0x22,
-		0xbe, 0x57, 0xdf, 0x3a, 0x2b, 0xaa, 0x45, 0x98, 0xbf, 0x04, 0x79, 0xc1, 0xbc, 0x65, 0xd8, 0xb8,
-		0x66, 0x36, 0x0c, 0xed, 0x15, 0x5c, 0xef, 0x83, 0xf5, 0x3f, 0x8b, 0x0c, 0xd5, 0x56, 0x80, 0x9c,
-		0x70, 0x5e, 0x82, 0x9c, 0x57, 0xab, 0x54, 0xb5, 0xa6, 0x65, 0xda, 0x6e, 0x0f, 0x8e, 0xff, 0x5c,
-		0x8c, 0x94, 0x47, 0xb7, 0x44, 0xc9, 0x8a, 0x15, 0x60, 0xef, 0x99, 0xfb, 0x75, 0xc9, 0xcf, 0x70,
-		0x46, 0x23, 0x3e, 0x15, 0x0f, 0x1c, 0x35, 0xb3, 0x69, 0xa9, 0x76, 0x3f, 0xf1, 0xef, 0x5f, 0x88,
-		0xc0, 0xc1, 0x49, 0x78, 0xe0, 0x20, 0x15, 0x1d, 0xc9, 0xf6, 0x7d, 0x70, 0xf8, 0xac, 0x08, 0x1c,
-		0x82, 0x86, 0xb3, 0x10, 0x05, 0x43, 0x1f, 0x2c, 0x7e, 0x53, 0xb0, 0x10, 0x34, 0x84, 0xc5, 0x4f,
-		0xfa, 0x89, 0xd6, 0xc6, 0x0d, 0xcd, 0x71, 0x6d, 0x56, 0x14, 0x77, 0x67, 0xf5, 0x5b, 0xdf, 0x0a,
-		0x17, 0x61, 0x4a, 0x80, 0x94, 0x44, 0x22, 0xbe, 0xc9, 0x4a, 0xd7, 0x4c, 0xbd, 0x05, 0xfb, 0x9c,
-		0x88, 0x44, 0x01, 0x32, 0x22, 0x5b, 0xa0, 0x42, 0x24, 0x66, 0xaf, 0x91, 0x95, 0x42, 0x1f, 0xec,
-		0xfe, 0x65, 0x44, 0xb8, 0x0d, 0x41, 0x4b, 0x78, 0x06, 0xea, 0x9f, 0x96, 0xb1, 0x87, 0xf7, 0xfb,
-		0xf2, 0xce, 0x7f, 0x15, 0xa9, 0x7f, 0xb6, 0x18, 0x25, 0x8b, 0x21, 0x63, 0x91, 0x7a, 0x0a, 0xf5,
-		0x3a, 0x55, 0x94, 0xff, 0x99, 0xef, 0x72, 0x7d, 0xc3, 0xe5, 0x54, 0x71, 0x99, 0x38, 0x79, 0xb8,
-		0xe8, 0xe9, 0xcd, 0xec, 0x5d, 0xdf, 0xf5, 0xfc, 0x3c, 0x54, 0xf3, 0x14, 0x2f, 0xc3, 0x48, 0xa8,
-		0xe0, 0xe9, 0xcd, 0xea, 0x67, 0x39, 0xab, 0x6c, 0xb0, 0xde, 0x29, 0x9e, 0x87, 0x14, 0x29, 0x5e,
-		0x7a, 0x93, 0xff, 0x1c, 0x27, 0xa7, 0xe8, 0xc5, 0xe7, 0x20, 0x2d, 0x8a, 0x96, 0xde, 0xa4, 0xef,
-		0xe6, 0xa4, 0x1e, 0x09, 0x21, 0x17, 0x05, 0x4b, 0x6f, 0xf2, 0xbf, 0x2d, 0xc8, 0x05, 0x09, 0x21,
-		0xef, 0xdf, 0x84, 0x5f, 0xf8, 0x3b, 0x29, 0x9e, 0x74, 0x84, 0xed, 0x2e, 0xc2, 0x10, 0xaf, 0x54,
-		0x7a, 0x53, 0xff, 0x3c, 0xef, 0x5c, 0x50, 0x14, 0x9f, 0x86, 0x81, 0x3e, 0x0d, 0xfe, 0x77, 0x39,
-		0x29, 0xc3, 0x2f, 0x2e, 0xc0, 0x70, 0xa0, 0x3a, 0xe9, 0x4d, 0xfe, 0x0b, 0x9c, 0x3c, 0x48, 0x45,
-		0x44, 0xe7, 0xd5, 0x49, 0x6f, 0x06, 0x7f, 0x4f, 0x88, 0xce, 0x29, 0x88, 0xd9, 0x44, 0x61, 0xd2,
-		0x9b, 0xfa, 0xbd, 0xc2, 0xea, 0x82, 0xa4, 0x78, 0x09, 0x32, 0x5e, 0xb2, 0xe9, 0x4d, 0xff, 0x3e,
-		0x4e, 0xef, 0xd3, 0x10, 0x0b, 0x04, 0x92, 0x5d, 0x6f, 0x16, 0xbf, 0x28, 0x2c, 0x10, 0xa0, 0x22,
-		0xd3, 0x28, 0x5a, 0xc0, 0xf4, 0xe6, 0xf4, 0x7e, 0x31, 0x8d, 0x22, 0xf5, 0x0b, 0x19, 0x4d, 0x1a,
-		0xf3, 0x7b, 0xb3, 0xf8, 0xfb, 0x62, 0x34, 0x29, 0x3e, 0x11, 0x23, 0x5a, 0x11, 0xf4, 0xe6, 0xf1,
-		0x01, 0x21, 0x46, 0xa4, 0x20, 0x28, 0xae, 0x03, 0x6a, 0xaf, 0x06, 0x7a, 0xf3, 0xfb, 0x20, 0xe7,
-		0x37, 0xde, 0x56, 0x0c, 0x14, 0xdf, 0x04, 0x53, 0xf1, 0x95, 0x40, 0x6f, 0xae, 0xbf, 0xf4, 0xdd,
-		0xc8, 0xda, 0x2d, 0x58, 0x08, 0x14, 0x37, 0xfd, 0x94, 0x12, 0xac, 0x02, 0x7a, 0xb3, 0xfd, 0xd0,
-		0x77, 0xc3, 0x81, 0x3b, 0x58, 0x04, 0x14, 0xe7, 0x01, 0xfc, 0x04, 0xdc, 0x9b, 0xd7, 0x87, 0x39,
-		0xaf, 0x00, 0x11, 0x99, 0x1a, 0x3c, 0xff, 0xf6, 0xa6, 0xbf, 0x29, 0xa6, 0x06, 0xa7, 0x20, 0x53,
-		0x43, 0xa4, 0xde, 0xde, 0xd4, 0x1f, 0x11, 0x53, 0x43, 0x90, 0x10, 0xcf, 0x0e, 0x64, 0xb7, 0xde,
-		0x1c, 0x3e, 0x2a, 0x3c, 0x3b, 0x40, 0x55, 0x5c, 0x85, 0xf1, 0xb6, 0x84, 0xd8, 0x9b, 0xd5, 0xaf,
-		0x70, 0x56, 0xb9, 0x68, 0x3e, 0x0c, 0x26, 0x2f, 0x9e, 0x0c, 0x7b, 0x73, 0xfb, 0x58, 0x24, 0x79,
-		0xf1, 0x5c, 0x58, 0xbc, 0x08, 0x69, 0xa3, 0xa5, 0xeb, 0x64, 0xf2, 0xa0, 0xee, 0x27, 0x01, 0xf3,
-		0xff, 0xed, 0xfb, 0xdc, 0x3a, 0x82, 0xa0, 0x78, 0x1e, 0x06, 0x70, 0x73, 0x1b, 0xd7, 0x7b, 0x51,
-		0x7e, 0xf3, 0xfb, 0x22, 0x60, 0x12, 0xec, 0xe2, 0x25, 0x00, 0xb6, 0x35, 0x42, 0x5f, 0x06, 0xf6,
-		0xa0, 0xfd, 0xf3, 0xef, 0xf3, 0xa3, 0x37, 0x3e, 0x89, 0xcf, 0x80, 0x1d, 0xe4, 0xe9, 0xce, 0xe0,
-		0x5b, 0x61, 0x06, 0x74, 0x44, 0x2e, 0xc0, 0xd0, 0xcb, 0x8e, 0x69, 0xb8, 0x6a, 0xa3, 0x17, 0xf5,
-		0x5f, 0x70, 0x6a, 0x81, 0x4f, 0x0c, 0xd6, 0x34, 0x6d, 0xec, 0xaa, 0x0d, 0xa7, 0x17, 0xed, 0xb7,
-		0x39, 0xad, 0x47, 0x40, 0x88, 0x6b, 0xaa, 0xe3, 0xf6, 0xa3, 0xf7, 0x5f, 0x0a, 0x62, 0x41, 0x40,
-		0x84, 0x26, 0xbf, 0xf7, 0xf0, 0x7e, 0x2f, 0xda, 0xef, 0x08, 0xa1, 0x39, 0x7e, 0xf1, 0x39, 0xc8,
-		0x90, 0x9f, 0xec, 0x3c, 0x5d, 0x0f, 0xe2, 0xff, 0xce, 0x89, 0x7d, 0x0a, 0xd2, 0xb3, 0xe3, 0xd6,
-		0x5d, 0xad, 0xb7, 0xb1, 0x6f, 0xf3, 0x91, 0x16, 0xf8, 0xc5, 0x79, 0x18, 0x76, 0xdc, 0x7a, 0xbd,
-		0xc5, 0xeb, 0xd3, 0x1e, 0xe4, 0x7f, 0xf5, 0x7d, 0x6f, 0xcb, 0xc2, 0xa3, 0x21, 0xa3, 0x7d, 0x7d,
-		0xcf, 0xb5, 0x4c, 0xfa, 0xc2, 0xa3, 0x17, 0x87, 0xef, 0x72, 0x0e, 0x01, 0x92, 0xe2, 0x02, 0x64,
-		0x89, 0x2e, 0x36, 0xb6, 0x30, 0x7d, 0x3b, 0xd5, 0x83, 0xc5, 0xf7, 0xb8, 0x01, 0x42, 0x44, 0xa5,
-		0x9f, 0xfe, 0xe2, 0x1b, 0x27, 0xa4, 0xaf, 0xbc, 0x71, 0x42, 0xfa, 0xb3, 0x37, 0x4e, 0x48, 0xef,
-		0xfd, 0xda, 0x89, 0x43, 0x5f, 0xf9, 0xda, 0x89, 0x43, 0x7f, 0xf4, 0xb5, 0x13, 0x87, 0xe2, 0x77,
-		0x89, 0x61, 0xd1, 0x5c, 0x34, 0xd9, 0xfe, 0xf0, 0x5b, 0xe4, 0x86, 0xe6, 0xee, 0xb6, 0xb6, 0x67,
-		0x6b, 0x66, 0x93, 0x6e, 0xe3, 0xfa, 0xbb, 0xb5, 0xde, 0x22, 0x07, 0xbe, 0x27, 0x91, 0x05, 0x73,
-		0x78, 0x2f, 0x57, 0x35, 0xf6, 0x3b, 0xdc, 0xcc, 0x29, 0xc4, 0x6e, 0x0c, 0xcb, 0xcf, 0x42, 0x72,
-		0xde, 0xd8, 0x47, 0x47, 0x59, 0xcc, 0xab, 0xb6, 0x6c, 0x9d, 0x9f, 0xf3, 0x1a, 0x22, 0xcf, 0x5b,
-		0xb6, 0x8e, 0x26, 0xfd, 0xc3, 0x98, 0xd2, 0xe9, 0x2c, 0x3f, 0x61, 0x59, 0x4c, 0x7d, 0xe7, 0xa3,
-		0xd3, 0x87, 0x4a, 0x7b, 0x51, 0x0d, 0xbf, 0xd0, 0x53, 0xcb, 0xf4, 0xbc, 0xb1, 0x4f, 0x95, 0x5c,
-		0x97, 0xde, 0x32, 0x40, 0x37, 0xba, 0xc5, 0xc6, 0xf6, 0x89, 0xe8, 0xc6, 0xf6, 0x9b, 0xb0, 0xae,
-		0xbf, 0x68, 0x98, 0xd7, 0x8d, 0x4d, 0x82, 0xb6, 0x3d, 0xc8, 0x0e, 0x0d, 0xc3, 0xfb, 0x13, 0x70,
-		0xa2, 0x6d, 0x0f, 0x9b, 0x8f, 0x7c, 0xa7, 0x6b, 0x49, 0x45, 0x48, 0x97, 0x85, 0x43, 0xe5, 0x61,
-		0xc8, 0xc1, 0x35, 0xd3, 0xa8, 0x3b, 0x54, 0xd5, 0xa4, 0x22, 0x1e, 0x89, 0xaa, 0x86, 0x6a, 0x98,
-		0x0e, 0x3f, 0x0b, 0xc9, 0x1e, 0x4a, 0xbf, 0x2c, 0x1d, 0x6c, 0x1c, 0x47, 0x44, 0x4f, 0x42, 0xcd,
-		0x27, 0x7a, 0x6e, 0xf5, 0xef, 0x11, 0x2d, 0x3d, 0x25, 0x42, 0xdb, 0xfd, 0xfd, 0x5a, 0xe5, 0x03,
-		0x09, 0x98, 0x8e, 0x5a, 0x85, 0x4c, 0x27, 0xc7, 0x55, 0x9b, 0x56, 0x27, 0xb3, 0x5c, 0x84, 0xcc,
-		0xa6, 0xc0, 0x39, 0xb0, 0x5d, 0x6e, 0x1e, 0xd0, 0x2e, 0xa3, 0x5e, 0x57, 0xc2, 0x30, 0x67, 0xfb,
-		0x34, 0x8c, 0xa7, 0xc7, 0x1d, 0x59, 0xe6, 0x9d, 0x49, 0x38, 0x5a, 0x33, 0x9d, 0xa6, 0xe9, 0x54,
-		0x99, 0xfb, 0xb3, 0x07, 0x6e, 0x93, 0x6c, 0xb0, 0xa9, 0x8f, 0x97, 0x23, 0x57, 0x60, 0x94, 0x86,
-		0x08, 0xba, 0x2d, 0x4c, 0xa3, 0x72, 0xcf, 0x44, 0xfa, 0xfb, 0xff, 0x61, 0x80, 0x4e, 0xa9, 0x11,
-		0x8f, 0x90, 0x9e, 0x72, 0xd9, 0x84, 0x49, 0xad, 0x69, 0xe9, 0x98, 0xbe, 0x0e, 0xab, 0x7a, 0x6d,
-		0xbd, 0xf9, 0x7d, 0x89, 0xf3, 0x9b, 0xf0, 0xc9, 0x97, 0x04, 0x75, 0x71, 0x19, 0xc6, 0xd5, 0x5a,
-		0x0d, 0x5b, 0x21, 0x96, 0x3d, 0xc2, 0x97, 0x10, 0x30, 0xc7, 0x29, 0x3d, 0x6e, 0xa5, 0x4b, 0x9d,
-		0x86, 0xf8, 0x2d, 0x0f, 0x06, 0x22, 0x94, 0x8d, 0x1b, 0xd8, 0x78, 0xcc, 0xc0, 0xee, 0x75, 0xd3,
-		0xde, 0xe3, 0xe6, 0x7d, 0x8c, 0x75, 0x25, 0x06, 0xe1, 0x7f, 0x25, 0xe0, 0x98, 0xbe, 0xdd, 0x9c,
-		0xdb, 0x56, 0x1d, 0x3c, 0x77, 0xed, 0x89, 0x6d, 0xec, 0xaa, 0x4f, 0xcc, 0xd5, 0x4c, 0x4d, 0xcc,
-		0xd8, 0x9c, 0xbe, 0xdd, 0x9c, 0x25, 0x8d, 0xb3, 0xbc, 0xb1, 0x43, 0xbc, 0x5a, 0x84, 0xd4, 0x82,
-		0xa9, 0x19, 0xc4, 0x25, 0xeb, 0xd8, 0x30, 0x9b, 0x3c, 0x5a, 0xb1, 0x07, 0x74, 0x3f, 0x0c, 0xaa,
-		0x4d, 0xb3, 0x65, 0xb8, 0xec, 0x1d, 0x5e, 0x69, 0xf8, 0x8b, 0xb7, 0xa6, 0x0f, 0xfd, 0xf1, 0xad,
-		0xe9, 0xe4, 0x92, 0xe1, 0x2a, 0xbc, 0xa9, 0x98, 0xfa, 0xc6, 0xeb, 0xd3, 0x92, 0xfc, 0x02, 0x0c,
-		0x95, 0x71, 0xed, 0x4e, 0x78, 0x95, 0x71, 0x2d, 0xc2, 0xeb, 0x61, 0x48, 0x2f, 0x19, 0x2e, 0x3b,
-		0x37, 0x7c, 0x1f, 0x24, 0x35, 0x83, 0x1d, 0x45, 0x8b, 0xf4, 0x4f, 0xe0, 0x04, 0xb5, 0x8c, 0x6b,
-		0x1e, 0x6a, 0x1d, 0xd7, 0xa2, 0xa8, 0x84, 0x3d, 0x81, 0x97, 0x2e, 0xfd, 0xd1, 0x7f, 0x3e, 0x71,
-		0xe8, 0xd5, 0x37, 0x4e, 0x1c, 0xea, 0x38, 0x06, 0xf7, 0x05, 0xc6, 0x40, 0xd7, 0x0c, 0x3c, 0xa7,
-		0x6f, 0x37, 0x1f, 0x73, 0xea, 0x7b, 0x6c, 0xf2, 0x78, 0xb6, 0xff, 0x60, 0x0a, 0x8e, 0x91, 0xd5,
-		0xb6, 0x5a, 0x33, 0x8d, 0xb9, 0x9a, 0xbd, 0x6f, 0xb9, 0x34, 0x9f, 0x98, 0x3b, 0x22, 0x2c, 0x88,
-		0xc6, 0x59, 0xd6, 0xd8, 0xc1, 0xf4, 0x3b, 0x30, 0xb0, 0x4e, 0xa8, 0x88, 0xbd, 0x5c, 0xd3, 0x55,
-		0x75, 0x1e, 0x26, 0xd8, 0x03, 0x81, 0xb2, 0xdb, 0x25, 0x09, 0x06, 0xd5, 0xc4, 0xc5, 0x12, 0x1d,
-		0xab, 0x3b, 0xec, 0x90, 0x6e, 0x92, 0x66, 0x90, 0x34, 0x01, 0xd0, 0xf3, 0xb8, 0x93, 0x30, 0xa0,
-		0xb6, 0xd8, 0xfb, 0xe5, 0x24, 0x49, 0x2d, 0xf4, 0x41, 0x5e, 0x82, 0x21, 0xfe, 0x96, 0x0b, 0xe5,
-		0x20, 0xb9, 0x87, 0xf7, 0x69, 0x3f, 0x59, 0x85, 0xfc, 0x44, 0x8f, 0xc2, 0x00, 0x15, 0x9d, 0xdf,
-		0x3e, 0x98, 0x9a, 0x8d, 0xc8, 0x3e, 0x4b, 0x45, 0x54, 0x18, 0x92, 0xfc, 0x02, 0xa4, 0xcb, 0x66,
-		0x53, 0x33, 0xcc, 0x30, 0xaf, 0x0c, 0xe3, 0x45, 0x25, 0xb6, 0x5a, 0x7c, 0x80, 0x15, 0xf6, 0x80,
-		0xa6, 0x60, 0x90, 0x1d, 0xd9, 0xe6, 0x6f, 0xc8, 0xf9, 0x93, 0xbc, 0x00, 0x43, 0x94, 0xf7, 0x9a,
-		0x85, 0x10, 0xbf, 0xec, 0xc3, 0xcf, 0x86, 0xd3, 0x28, 0xc0, 0xd9, 0x27, 0x7c, 0x51, 0x11, 0xa4,
-		0xea, 0xaa, 0xab, 0x72, 0xad, 0xe9, 0x6f, 0xf9, 0x59, 0x48, 0x73, 0x26, 0x0e, 0x7a, 0x1c, 0x92,
-		0xa6, 0xe5, 0xf0, 0x77, 0xdc, 0xf9, 0x78, 0x45, 0xd6, 0xac, 0x52, 0x8a, 0x38, 0x86, 0x42, 0x50,
-		0x4b, 0x57, 0x3a, 0x7a, 0xc2, 0x6c, 0xd4, 0x13, 0xbc, 0xf1, 0x66, 0xc3, 0x18, 0x19, 0xfe, 0x60,
-		0x2e, 0x3d, 0xee, 0xb5, 0x5d, 0xc3, 0x36, 0x59, 0xda, 0x31, 0xef, 0x11, 0xf3, 0xd2, 0x13, 0x8b,
-		0xb7, 0x76, 0x70, 0x8e, 0xe7, 0x20, 0x39, 0x6f, 0x59, 0xa8, 0x00, 0x69, 0xfa, 0x5c, 0x33, 0x99,
-		0x77, 0xa4, 0x14, 0xef, 0x99, 0xb4, 0x39, 0xe6, 0x8e, 0x7b, 0x5d, 0xb5, 0xbd, 0x5b, 0x4c, 0xe2,
-		0x59, 0xbe, 0x00, 0x99, 0x05, 0xd3, 0x70, 0xb0, 0xe1, 0xb4, 0x68, 0xba, 0xd9, 0xd6, 0xcd, 0xda,
-		0x1e, 0xe7, 0xc0, 0x1e, 0x88, 0x81, 0x55, 0xcb, 0xa2, 0x94, 0x29, 0x85, 0xfc, 0x64, 0x93, 0xaf,
-		0xb4, 0xd4, 0xd1, 0x28, 0x73, 0xfd, 0x1a, 0x85, 0xab, 0xe6, 0x59, 0xe5, 0x2f, 0x25, 0x28, 0x44,
-		0x27, 0xcc, 0x1e, 0xde, 0x77, 0x0e, 0x36, 0x5f, 0x5e, 0x82, 0xcc, 0x3a, 0xbd, 0x3c, 0xfc, 0x22,
-		0xde, 0x47, 0x05, 0x18, 0xc2, 0xf5, 0xb3, 0xe7, 0xcf, 0x3f, 0x71, 0x81, 0x79, 0xf3, 0x95, 0x43,
-		0x8a, 0x00, 0xa0, 0x13, 0x90, 0x71, 0x70, 0xcd, 0x3a, 0x7b, 0xfe, 0xa9, 0xbd, 0x27, 0x98, 0x03,
-		0x5d, 0x39, 0xa4, 0xf8, 0xa0, 0x62, 0x9a, 0xe8, 0xf9, 0x8d, 0x8f, 0x4e, 0x4b, 0xa5, 0x01, 0x48,
-		0x3a, 0xad, 0xe6, 0x3d, 0xf0, 0x83, 0x77, 0x0e, 0xc0, 0x09, 0xaf, 0x8d, 0xa5, 0xde, 0x6b, 0xaa,
-		0xae, 0xd5, 0x55, 0xff, 0xaa, 0xf7, 0xa8, 0xa7, 0x35, 0x6d, 0x8f, 0x57, 0xba, 0xd0, 0xc5, 0x6e,
-		0xf2, 0x27, 0x25, 0xc8, 0x5e, 0x15, 0x5c, 0x37, 0xb0, 0x8b, 0x2e, 0x00, 0x78, 0xbd, 0x88, 0x89,
-		0x70, 0x74, 0x36, 0xdc, 0xcf, 0xac, 0x47, 0xa1, 0x04, 0x90, 0xd1, 0x79, 0xea, 0x68, 0x96, 0xe9,
-		0xf0, 0x9b, 0x2b, 0x5d, 0x09, 0x3d, 0x54, 0xf4, 0x28, 0x20, 0x1a, 0xad, 0xaa, 0xd7, 0x4c, 0x57,
-		0x33, 0x1a, 0x55, 0xcb, 0xbc, 0xce, 0x6f, 0x03, 0x26, 0x95, 0x1c, 0x6d, 0xb9, 0x4a, 0x1b, 0xd6,
-		0x09, 0x5c, 0xfe, 0x94, 0x04, 0x19, 0x8f, 0x0b, 0xa9, 0x8f, 0xd4, 0x7a, 0xdd, 0xc6, 0x8e, 0xc3,
-		0x03, 0x92, 0x78, 0x44, 0x17, 0x60, 0xc8, 0x6a, 0x6d, 0x57, 0xc5, 0xfc, 0x1f, 0x3e, 0x5b, 0x68,
-		0x9f, 0xcd, 0xc2, 0x13, 0xf8, 0x7c, 0x1e, 0xb4, 0x5a, 0xdb, 0xc4, 0x2f, 0x4e, 0x42, 0x36, 0x46,
-		0x94, 0xe1, 0x6b, 0xbe, 0x14, 0xf4, 0x76, 0x3a, 0x97, 0xbf, 0x6a, 0xd9, 0x9a, 0x69, 0x6b, 0xee,
-		0x3e, 0x3d, 0x74, 0x92, 0x54, 0x72, 0xa2, 0x61, 0x9d, 0xc3, 0x65, 0x0d, 0xc6, 0x36, 0x68, 0x59,
-		0xe0, 0xcb, 0xfd, 0xa4, 0x2f, 0x9d, 0xd4, 0x4b, 0xba, 0x8e, 0x72, 0x25, 0xda, 0xe4, 0x2a, 0x2d,
-		0x76, 0xf4, 0xc2, 0xc7, 0xfa, 0xf5, 0xc2, 0x70, 0x9e, 0xfa, 0xcd, 0xa3, 0x81, 0x69, 0xc7, 0x9c,
-		0x30, 0x18, 0x8a, 0xfa, 0x73, 0xc0, 0x5e, 0x45, 0x70, 0xa1, 0x5b, 0x2a, 0x2c, 0x74, 0x0d, 0x86,
-		0x85, 0x1e, 0x53, 0x44, 0xbe, 0x00, 0x23, 0xeb, 0xaa, 0xed, 0x6e, 0x60, 0xf7, 0x0a, 0x56, 0xeb,
-		0xd8, 0x0e, 0x67, 0xca, 0x11, 0x91, 0x29, 0x11, 0xa4, 0x68, 0x3a, 0x64, 0xb9, 0x82, 0xfe, 0x96,
-		0x77, 0x20, 0x45, 0x8f, 0x98, 0x79, 0x59, 0x94, 0x53, 0xb0, 0x2c, 0x4a, 0x22, 0xe2, 0xbe, 0x8b,
-		0x1d, 0xb1, 0x06, 0xa3, 0x0f, 0xe8, 0xac, 0xc8, 0x85, 0xc9, 0x6e, 0xb9, 0x90, 0x3b, 0x1c, 0xcf,
-		0x88, 0x2f, 0xc3, 0x50, 0x89, 0x84, 0xd3, 0xa5, 0xb2, 0x27, 0x86, 0xe4, 0x8b, 0x81, 0x5e, 0x84,
-		0x31, 0x4b, 0xb5, 0x5d, 0x7a, 0xb2, 0x7e, 0x97, 0xea, 0xe0, 0x5d, 0xf3, 0x8b, 0xcc, 0xae, 0x90,
-		0xa2, 0xbc, 0x8f, 0x11, 0x2b, 0x08, 0x94, 0xdf, 0x48, 0xc1, 0x20, 0x37, 0xc4, 0x45, 0x18, 0xe2,
-		0x06, 0xe5, 0x3e, 0x78, 0x6c, 0x36, 0x9a, 0x58, 0x66, 0xbd, 0x04, 0xc0, 0xb9, 0x09, 0x0a, 0xf4,
-		0x10, 0xa4, 0x6b, 0xbb, 0xaa, 0x66, 0x54, 0xb5, 0xba, 0xa8, 0xc5, 0xde, 0xb8, 0x35, 0x3d, 0xb4,
-		0x40, 0x60, 0x4b, 0x65, 0x65, 0x88, 0x36, 0x2e, 0xd5, 0x49, 0xe6, 0xde, 0xc5, 0x5a, 0x63, 0xd7,
-		0xe5, 0xb3, 0x88, 0x3f, 0xa1, 0x67, 0x20, 0x45, 0x9c, 0x80, 0xdf, 0xb9, 0x2a, 0xb4, 0xd5, 0xc2,
-		0xde, 0xba, 0xa4, 0x94, 0x26, 0x1d, 0xbf, 0xf7, 0x4f, 0xa7, 0x25, 0x85, 0x52, 0xa0, 0x79, 0x18,
-		0xd1, 0x55, 0xc7, 0xad, 0xd2, 0x0c, 0x44, 0xba, 0x1f, 0xa0, 0x2c, 0x8e, 0x44, 0x8d, 0xc1, 0x4d,
-		0xca, 0x05, 0x1f, 0x26, 0x34, 0x0c, 0x54, 0x47, 0xa7, 0x21, 0x47, 0x59, 0xd4, 0xcc, 0x66, 0x53,
-		0x73, 0x59, 0x1d, 0x34, 0x48, 0x2d, 0x3e, 0x4a, 0xe0, 0x0b, 0x14, 0x4c, 0xab, 0xa1, 0x63, 0x90,
-		0xa1, 0x77, 0x3c, 0x28, 0x0a, 0x3b, 0xcf, 0x98, 0x26, 0x00, 0xda, 0x78, 0x0a, 0xc6, 0xfc, 0xe8,
-		0xc7, 0x50, 0xd2, 0x8c, 0x8b, 0x0f, 0xa6, 0x88, 0x8f, 0xc3, 0xa4, 0x81, 0x6f, 0xd0, 0x13, 0x96,
-		0x21, 0xec, 0x0c, 0xc5, 0x46, 0xa4, 0xed, 0x6a, 0x98, 0xe2, 0x41, 0x18, 0xad, 0x09, 0xd3, 0x33,
-		0x5c, 0xa0, 0xb8, 0x23, 0x1e, 0x94, 0xa2, 0x1d, 0x85, 0xb4, 0x6a, 0x59, 0x0c, 0x61, 0x98, 0xc7,
-		0x3f, 0xcb, 0xa2, 0x4d, 0x67, 0x60, 0x9c, 0xea, 0x68, 0x63, 0xa7, 0xa5, 0xbb, 0x9c, 0x49, 0x96,
-		0xe2, 0x8c, 0x91, 0x06, 0x85, 0xc1, 0x29, 0xee, 0xfd, 0x30, 0x82, 0xaf, 0x69, 0x75, 0x6c, 0xd4,
-		0x30, 0xc3, 0x1b, 0xa1, 0x78, 0x59, 0x01, 0xa4, 0x48, 0x0f, 0x83, 0x17, 0xd9, 0xaa, 0x22, 0xe6,
-		0x8e, 0x32, 0x7e, 0x02, 0x3e, 0xcf, 0xc0, 0x72, 0x1e, 0x52, 0x65, 0xd5, 0x55, 0x49, 0x79, 0xe0,
-		0xde, 0x60, 0x49, 0x24, 0xab, 0x90, 0x9f, 0xf2, 0xd7, 0x13, 0x90, 0xba, 0x6a, 0xba, 0x18, 0x3d,
-		0x11, 0x28, 0xd7, 0x46, 0xdb, 0x3d, 0x79, 0x43, 0x6b, 0x18, 0xb8, 0xbe, 0xe2, 0x34, 0x02, 0xd7,
-		0xb1, 0x7d, 0x57, 0x4a, 0x84, 0x5c, 0x69, 0x12, 0x06, 0x6c, 0xb3, 0x65, 0xd4, 0xc5, 0x41, 0x40,
-		0xfa, 0x80, 0x16, 0x20, 0xed, 0x79, 0x48, 0xaa, 0xbb, 0x87, 0x8c, 0x11, 0x0f, 0x21, 0xde, 0xcb,
-		0x01, 0xca, 0xd0, 0x36, 0x77, 0x94, 0x12, 0x64, 0xbc, 0x50, 0xc5, 0xfd, 0xac, 0x3f, 0x57, 0xf5,
-		0xc9, 0x48, 0xaa, 0xf0, 0xc6, 0xdd, 0x33, 0x1c, 0xf3, 0xb6, 0x9c, 0xd7, 0xc0, 0x2d, 0x17, 0x72,
-		0x29, 0x7e, 0x31, 0x7c, 0x88, 0x6a, 0xe5, 0xbb, 0x14, 0xbb, 0x1c, 0x7e, 0x1c, 0x32, 0x8e, 0xd6,
-		0x30, 0x54, 0xb7, 0x65, 0x63, 0xee, 0x75, 0x3e, 0x40, 0xfe, 0xbc, 0x04, 0x83, 0xcc, 0x8b, 0x03,
-		0x56, 0x93, 0xe2, 0xad, 0x96, 0xe8, 0x64, 0xb5, 0xe4, 0x9d, 0x5a, 0xed, 0x12, 0x80, 0x27, 0x8a,
-		0xc3, 0x6f, 0xeb, 0xb6, 0x55, 0x02, 0x4c, 0xbc, 0x0d, 0xad, 0xc1, 0x27, 0x68, 0x80, 0x44, 0xfe,
-		0x13, 0x89, 0x94, 0x9e, 0xbc, 0x1d, 0x5d, 0x82, 0x11, 0x21, 0x53, 0x75, 0x47, 0x57, 0x1b, 0xdc,
-		0x67, 0x8e, 0x75, 0x10, 0xec, 0xb2, 0xae, 0x36, 0x94, 0x61, 0x2e, 0x0b, 0x79, 0x88, 0x1f, 0x81,
-		0x44, 0x87, 0x11, 0x08, 0x0d, 0x79, 0xf2, 0xce, 0x86, 0x3c, 0x34, 0x38, 0xa9, 0xe8, 0xe0, 0xfc,
-		0x46, 0x82, 0x2e, 0x38, 0x2c, 0xd3, 0x51, 0xf5, 0x7b, 0x3f, 0x0f, 0x8e, 0x41, 0xc6, 0x32, 0xf5,
-		0x2a, 0x6b, 0x61, 0xc7, 0x62, 0xd3, 0x96, 0xa9, 0x2b, 0x6d, 0xc3, 0x3d, 0x70, 0x57, 0x26, 0xc9,
-		0xe0, 0x5d, 0xb0, 0xd8, 0x50, 0xd4, 0x62, 0x06, 0x64, 0x99, 0x21, 0x78, 0xe6, 0x9a, 0x25, 0x16,
-		0xa0, 0x89, 0x50, 0x8a, 0x66, 0x59, 0x26, 0x34, 0xc3, 0x53, 0x38, 0x16, 0xc1, 0x67, 0xa1, 0xbe,
-		0x7d, 0x85, 0x1a, 0x74, 0x46, 0x85, 0x63, 0xc9, 0xbf, 0x28, 0x01, 0x2c, 0x13, 0x9b, 0x52, 0x5d,
-		0x49, 0xc6, 0x71, 0x68, 0xf7, 0xd5, 0x50, 0xaf, 0xc7, 0xe3, 0x07, 0x8b, 0xf7, 0x9d, 0x75, 0x82,
-		0x12, 0xcf, 0xc3, 0x88, 0xef, 0x82, 0x0e, 0x16, 0x82, 0x1c, 0xef, 0x58, 0x1f, 0x6f, 0x60, 0x57,
-		0xc9, 0x5e, 0x0b, 0x3c, 0xc9, 0xbf, 0x2d, 0x41, 0x86, 0xca, 0xb3, 0x82, 0x5d, 0x35, 0x34, 0x72,
-		0xd2, 0x9d, 0x8e, 0xdc, 0x7d, 0x00, 0x8c, 0x89, 0xa3, 0xbd, 0x82, 0xb9, 0x37, 0x65, 0x28, 0x64,
-		0x43, 0x7b, 0x05, 0xa3, 0x73, 0x9e, 0x99, 0x93, 0xdd, 0xcc, 0x2c, 0xaa, 0x67, 0x6e, 0xec, 0x23,
-		0x30, 0x44, 0xbf, 0x64, 0x73, 0xc3, 0xe1, 0x05, 0xf1, 0xa0, 0xd1, 0x6a, 0x6e, 0xde, 0x70, 0xe4,
-		0x5d, 0x18, 0xda, 0xbc, 0xc1, 0x76, 0x2b, 0x8e, 0x41, 0xc6, 0x36, 0x4d, 0x9e, 0x79, 0x59, 0xad,
-		0x93, 0x26, 0x00, 0x9a, 0x68, 0xc4, 0x1a, 0x3d, 0xe1, 0xaf, 0xd1, 0xfd, 0x2d, 0x86, 0x64, 0x1f,
-		0x5b, 0x0c, 0x67, 0xfe, 0xa3, 0x04, 0xc3, 0x81, 0x68, 0x80, 0x9e, 0x80, 0xc3, 0xa5, 0xe5, 0xb5,
-		0x85, 0x17, 0xab, 0x4b, 0xe5, 0xea, 0xe5, 0xe5, 0xf9, 0x45, 0xff, 0xa2, 0x47, 0x61, 0xea, 0xb5,
-		0x9b, 0x33, 0x28, 0x80, 0xbb, 0x65, 0xd0, 0xed, 0x4d, 0x34, 0x07, 0x93, 0x61, 0x92, 0xf9, 0xd2,
-		0x46, 0x65, 0x75, 0x33, 0x27, 0x15, 0x0e, 0xbf, 0x76, 0x73, 0x66, 0x3c, 0x40, 0x31, 0xbf, 0xed,
-		0x60, 0xc3, 0x6d, 0x27, 0x58, 0x58, 0x5b, 0x59, 0x59, 0xda, 0xcc, 0x25, 0xda, 0x08, 0x78, 0x60,
-		0x7e, 0x18, 0xc6, 0xc3, 0x04, 0xab, 0x4b, 0xcb, 0xb9, 0x64, 0x01, 0xbd, 0x76, 0x73, 0x66, 0x34,
-		0x80, 0xbd, 0xaa, 0xe9, 0x85, 0xf4, 0x7b, 0x3e, 0x76, 0xe2, 0xd0, 0xaf, 0xfd, 0xc3, 0x13, 0x12,
-		0xd1, 0x6c, 0x24, 0x14, 0x13, 0xd0, 0xa3, 0x70, 0x64, 0x63, 0x69, 0x71, 0xb5, 0x52, 0xae, 0xae,
-		0x6c, 0x2c, 0x56, 0xd9, 0x07, 0x2e, 0x3c, 0xed, 0xc6, 0x5e, 0xbb, 0x39, 0x33, 0xcc, 0x55, 0xea,
-		0x84, 0xbd, 0xae, 0x54, 0xae, 0xae, 0x6d, 0x56, 0x72, 0x12, 0xc3, 0x5e, 0xb7, 0xf1, 0x35, 0xd3,
-		0x65, 0x1f, 0xba, 0x7a, 0x1c, 0x8e, 0xc6, 0x60, 0x7b, 0x8a, 0x8d, 0xbf, 0x76, 0x73, 0x66, 0x64,
-		0xdd, 0xc6, 0x6c, 0xd6, 0x50, 0x8a, 0x59, 0xc8, 0xb7, 0x53, 0xac, 0xad, 0xaf, 0x6d, 0xcc, 0x2f,
-		0xe7, 0x66, 0x0a, 0xb9, 0xd7, 0x6e, 0xce, 0x64, 0x45, 0xe8, 0x23, 0xf8, 0xbe, 0x66, 0x77, 0x7f,
-		0xe5, 0xf2, 0xed, 0x47, 0xe1, 0xa4, 0xbe, 0xdd, 0x9c, 0x73, 0x5c, 0x75, 0x4f, 0x33, 0x1a, 0xde,
-		0x06, 0x27, 0x7f, 0xe6, 0x0b, 0x98, 0x09, 0x7d, 0xbb, 0x39, 0x2b, 0x40, 0x5d, 0xb7, 0x39, 0x0b,
-		0x9d, 0x5f, 0xec, 0x14, 0x7a, 0xbc, 0xfb, 0xe8, 0xbd, 0x00, 0xea, 0xbc, 0x19, 0x5e, 0xe8, 0xb6,
-		0x45, 0x5b, 0xe8, 0xb2, 0x36, 0x93, 0x7f, 0x56, 0x82, 0xd1, 0x2b, 0x9a, 0xe3, 0x9a, 0xb6, 0x56,
-		0x53, 0x75, 0x7a, 0x93, 0xe3, 0x5c, 0x7f, 0x81, 0x33, 0x32, 0xa3, 0x9f, 0x85, 0xc1, 0x6b, 0xaa,
-		0xce, 0xa2, 0x56, 0x92, 0x7e, 0x21, 0x21, 0xc6, 0x68, 0x7e, 0xe8, 0x12, 0xd4, 0x8c, 0x46, 0x7e,
-		0x3d, 0x01, 0x63, 0xd4, 0xe5, 0x1d, 0xf6, 0x35, 0x22, 0xb2, 0x4a, 0x7a, 0x0e, 0x52, 0xb6, 0xea,
-		0xf2, 0xcd, 0xba, 0xd2, 0xc3, 0x7c, 0x9b, 0xf5, 0x64, 0xd7, 0xad, 0xd3, 0xd9, 0x32, 0xae, 0x29,
-		0x94, 0x0c, 0xbd, 0x09, 0xd2, 0x4d, 0xf5, 0x46, 0x95, 0xb2, 0x60, 0x8b, 0x8f, 0x67, 0xfb, 0x66,
-		0x71, 0xfb, 0xd6, 0xf4, 0xd8, 0xbe, 0xda, 0xd4, 0x8b, 0xb2, 0x60, 0x21, 0x2b, 0x43, 0x4d, 0xf5,
-		0x06, 0x11, 0x0c, 0xed, 0xc1, 0x18, 0x81, 0xd6, 0x76, 0x55, 0xa3, 0x81, 0x19, 0x7f, 0xba, 0xe1,
-		0x58, 0x5a, 0x38, 0x08, 0xff, 0x29, 0x9f, 0x7f, 0x80, 0x93, 0xac, 0x8c, 0x34, 0xd5, 0x1b, 0x0b,
-		0x14, 0x40, 0x3a, 0x2b, 0xa6, 0x3f, 0xf8, 0xfa, 0xf4, 0x21, 0xba, 0x57, 0xfd, 0x07, 0x12, 0x80,
-		0x6f, 0x22, 0xf4, 0x66, 0xc8, 0xd5, 0xbc, 0x27, 0x4a, 0xeb, 0xf0, 0xf1, 0x7a, 0x20, 0xd6, 0xf2,
-		0x11, 0xeb, 0xb2, 0x0c, 0xfb, 0x95, 0x5b, 0xd3, 0x92, 0x32, 0x56, 0x8b, 0x18, 0xfe, 0xad, 0x30,
-		0xdc, 0xb2, 0xea, 0xaa, 0x8b, 0xab, 0x74, 0xf5, 0x95, 0xe8, 0x99, 0xad, 0x4f, 0x10, 0x5e, 0xb7,
-		0x6f, 0x4d, 0x23, 0xa6, 0x53, 0x80, 0x58, 0xa6, 0x39, 0x1c, 0x18, 0x84, 0x10, 0x04, 0x14, 0xfa,
-		0x7d, 0x09, 0x86, 0xcb, 0x81, 0xc3, 0x54, 0x79, 0x18, 0x6a, 0x9a, 0x86, 0xb6, 0xc7, 0x1d, 0x2f,
-		0xa3, 0x88, 0x47, 0x54, 0x80, 0x34, 0xbb, 0xc5, 0xe6, 0xee, 0x8b, 0x0d, 0x48, 0xf1, 0x4c, 0xa8,
-		0xae, 0xe3, 0x6d, 0x47, 0x13, 0xa3, 0xa0, 0x88, 0x47, 0x74, 0x19, 0x72, 0x0e, 0xae, 0xb5, 0x6c,
-		0xcd, 0xdd, 0xaf, 0xd6, 0x4c, 0xc3, 0x55, 0x6b, 0x2e, 0xbb, 0x0f, 0x55, 0x3a, 0x76, 0xfb, 0xd6,
-		0xf4, 0x11, 0x26, 0x6b, 0x14, 0x43, 0x56, 0xc6, 0x04, 0x68, 0x81, 0x41, 0x48, 0x0f, 0x75, 0xec,
-		0xaa, 0x9a, 0xee, 0xe4, 0xd9, 0xdb, 0x16, 0xf1, 0x18, 0xd0, 0xe5, 0x7d, 0x43, 0xc1, 0x0d, 0xa7,
-		0xcb, 0x90, 0x33, 0x2d, 0x6c, 0x87, 0x4a, 0x49, 0x29, 0xda, 0x73, 0x14, 0x43, 0x56, 0xc6, 0x04,
-		0x48, 0x94, 0x99, 0x6f, 0x23, 0x63, 0x2c, 0x16, 0x78, 0x56, 0x6b, 0xdb, 0xdf, 0xa7, 0x9a, 0x6c,
-		0x1b, 0x8d, 0x79, 0x63, 0xbf, 0xf4, 0xb8, 0xcf, 0x3d, 0x4a, 0x27, 0x7f, 0xe9, 0x33, 0x8f, 0x8d,
-		0x13, 0xbf, 0xf0, 0x37, 0x8e, 0x5e, 0xc4, 0xfb, 0x64, 0xec, 0x39, 0xde, 0x3a, 0x45, 0x23, 0x75,
-		0xe3, 0xcb, 0xaa, 0xa6, 0x8b, 0x4b, 0xbd, 0x0a, 0x7f, 0x42, 0x4f, 0xc3, 0xa0, 0xe3, 0xaa, 0x6e,
-		0xcb, 0xe1, 0x9f, 0xda, 0x9a, 0x8e, 0x75, 0xb2, 0x92, 0x69, 0xd4, 0x37, 0x28, 0x9a, 0xc2, 0xd1,
-		0xd1, 0x3c, 0x0c, 0xba, 0xe6, 0x1e, 0x36, 0xb8, 0xf1, 0xfa, 0x9d, 0xc7, 0xf4, 0xbd, 0x0f, 0x23,
-		0x44, 0x26, 0xe4, 0xea, 0x58, 0xc7, 0x0d, 0x56, 0x17, 0xed, 0xaa, 0x64, 0xc1, 0x40, 0xbf, 0xb4,
-		0x55, 0x2a, 0x1f, 0x64, 0xc6, 0x71, 0xcb, 0x44, 0x59, 0xc9, 0xca, 0x98, 0x07, 0xda, 0xa0, 0x10,
-		0x74, 0x25, 0x74, 0xca, 0x8f, 0x7f, 0x89, 0x6e, 0x26, 0x56, 0xe3, 0x80, 0x03, 0x8b, 0x4d, 0x84,
-		0xe0, 0x01, 0xc1, 0xcb, 0x90, 0x6b, 0x19, 0xdb, 0xa6, 0x41, 0x6f, 0xda, 0xf1, 0x82, 0x9c, 0x2c,
-		0xc4, 0x92, 0x41, 0x4f, 0x88, 0x62, 0xc8, 0xca, 0x98, 0x07, 0xba, 0xc2, 0xca, 0xf6, 0x3a, 0x8c,
-		0xfa, 0x58, 0x74, 0x56, 0x66, 0x7a, 0xce, 0xca, 0x93, 0x7c, 0x56, 0x1e, 0x8e, 0xf6, 0xe2, 0x4f,
-		0xcc, 0x11, 0x0f, 0x48, 0xc8, 0x50, 0x05, 0xc0, 0x8f, 0x05, 0x74, 0x33, 0x61, 0xb8, 0xc3, 0x40,
-		0xfb, 0xd1, 0x44, 0xac, 0xcc, 0x7c, 0x42, 0x74, 0x1d, 0x26, 0x9a, 0x9a, 0x51, 0x75, 0xb0, 0xbe,
-		0x53, 0xe5, 0xa6, 0x25, 0xfc, 0xe8, 0x57, 0x52, 0x4a, 0x8b, 0x7d, 0x8f, 0xff, 0xed, 0x5b, 0xd3,
-		0x05, 0x1e, 0x24, 0xdb, 0xb9, 0xc9, 0xca, 0x78, 0x53, 0x33, 0x36, 0xb0, 0xbe, 0x53, 0xf6, 0x60,
-		0xc5, 0xec, 0x7b, 0x5e, 0x9f, 0x3e, 0xc4, 0xe7, 0xe4, 0x21, 0xf9, 0x29, 0xba, 0x69, 0xcd, 0xe7,
-		0x12, 0x76, 0xc8, 0xf2, 0x41, 0x15, 0x0f, 0x74, 0xbb, 0x21, 0xa3, 0xf8, 0x00, 0x36, 0x97, 0x5f,
-		0xfd, 0x4f, 0x33, 0x92, 0xfc, 0xeb, 0x12, 0x0c, 0x96, 0xaf, 0xae, 0xab, 0x9a, 0x8d, 0x96, 0x60,
-		0xdc, 0x77, 0x97, 0xf0, 0x4c, 0x3e, 0x7e, 0xfb, 0xd6, 0x74, 0x3e, 0xea, 0x51, 0xde, 0x54, 0xf6,
-		0x1d, 0x56, 0xcc, 0xe5, 0xa5, 0x4e, 0xeb, 0xcb, 0x10, 0xab, 0x36, 0x14, 0xb9, 0x7d, 0xf5, 0x19,
-		0x51, 0xb3, 0x04, 0x43, 0x4c, 0x5a, 0x07, 0x3d, 0x0d, 0x03, 0x16, 0xf9, 0xc1, 0x77, 0xe4, 0x8f,
-		0xc5, 0x7b, 0x2c, 0x45, 0xf6, 0x36, 0x17, 0x09, 0xbe, 0xfc, 0xbe, 0x04, 0x40, 0xf9, 0xea, 0xd5,
-		0x4d, 0x5b, 0xb3, 0x74, 0xec, 0xde, 0x4d, 0xb5, 0x37, 0xe1, 0x70, 0x60, 0x4d, 0x63, 0xd7, 0x22,
-		0xaa, 0xcf, 0xdc, 0xbe, 0x35, 0x7d, 0x3c, 0xaa, 0x7a, 0x00, 0x4d, 0x56, 0x26, 0xfc, 0xf5, 0x8d,
-		0x5d, 0x8b, 0xe5, 0x5a, 0x77, 0x5c, 0x8f, 0x6b, 0xb2, 0x33, 0xd7, 0x00, 0x5a, 0x90, 0x6b, 0xd9,
-		0x71, 0xe3, 0xed, 0xba, 0x0e, 0xc3, 0xbe, 0x49, 0x48, 0x1c, 0x4b, 0xbb, 0xfc, 0x37, 0x37, 0xef,
-		0x74, 0x07, 0xf3, 0x0a, 0x1a, 0x6e, 0x62, 0x8f, 0x4c, 0xfe, 0x2b, 0x09, 0xc0, 0xf7, 0xd6, 0x1f,
-		0x4f, 0xe7, 0x22, 0xf1, 0x9a, 0x87, 0xd8, 0xe4, 0x41, 0xeb, 0x2e, 0x4e, 0x18, 0xb1, 0xe3, 0x7b,
-		0x12, 0x30, 0xb1, 0x25, 0xc2, 0xcc, 0x8f, 0xbd, 0xfa, 0x2b, 0x30, 0x84, 0x0d, 0xd7, 0xd6, 0xa8,
-		0xfe, 0xec, 0x0e, 0x73, 0xdc, 0x28, 0xc7, 0x28, 0x44, 0x3f, 0x0a, 0x23, 0x76, 0xc0, 0x39, 0x8f,
-		0x88, 0x29, 0x7e, 0x2e, 0x09, 0xf9, 0x4e, 0x94, 0x68, 0x01, 0xc6, 0x6a, 0x36, 0xa6, 0x80, 0x6a,
-		0x70, 0x33, 0xae, 0x54, 0xf0, 0xab, 0xc5, 0x08, 0x82, 0xac, 0x8c, 0x0a, 0x08, 0xcf, 0x13, 0x0d,
-		0x20, 0xd5, 0x1c, 0x71, 0x37, 0x82, 0xd5, 0x67, 0xf9, 0x26, 0xf3, 0x44, 0x21, 0x3a, 0x09, 0x33,
-		0x60, 0x99, 0x62, 0xd4, 0x87, 0xd2, 0x54, 0xa1, 0xc3, 0x98, 0x66, 0x68, 0xae, 0xa6, 0xea, 0xd5,
-		0x6d, 0x55, 0x57, 0x8d, 0xda, 0x01, 0x8b, 0x60, 0x16, 0xdf, 0x79, 0x8f, 0x11, 0x4e, 0xb2, 0x32,
-		0xca, 0x21, 0x25, 0x06, 0x40, 0x0b, 0x30, 0x24, 0x7a, 0x49, 0x1d, 0xb4, 0x8a, 0x10, 0x94, 0x81,
-		0x6a, 0xed, 0x5d, 0x49, 0x18, 0x57, 0x70, 0xfd, 0xff, 0x0f, 0x40, 0xdf, 0x03, 0x70, 0x05, 0x80,
-		0x4d, 0x6e, 0x12, 0x46, 0x0f, 0x36, 0x06, 0x24, 0x32, 0x64, 0x18, 0x71, 0xd9, 0x71, 0x03, 0xa3,
-		0xf0, 0x27, 0x09, 0xc8, 0x06, 0x47, 0xe1, 0xaf, 0x69, 0xda, 0x41, 0x97, 0xfd, 0x90, 0x93, 0xe2,
-		0x9f, 0x51, 0x8c, 0x0b, 0x39, 0x6d, 0x0e, 0xdb, 0x3d, 0xd6, 0xfc, 0x8f, 0x04, 0x0c, 0xae, 0xab,
-		0xb6, 0xda, 0x74, 0x50, 0xad, 0xad, 0x78, 0x94, 0xf8, 0x8b, 0xf7, 0xb6, 0x2f, 0x23, 0xf3, 0x1d,
-		0x89, 0x1e, 0xb5, 0xe3, 0x07, 0x63, 0x6a, 0xc7, 0x9f, 0x80, 0x51, 0xb2, 0x96, 0x0d, 0x1c, 0x0b,
-		0x20, 0xa6, 0x1e, 0x29, 0x1d, 0xf5, 0xb9, 0x84, 0xdb, 0xd9, 0x52, 0xd7, 0x7f, 0xa5, 0x85, 0x9e,
-		0x86, 0x61, 0x82, 0xe1, 0x87, 0x5f, 0x42, 0x3e, 0xe5, 0x2f, 0x2b, 0x03, 0x8d, 0xb2, 0x02, 0x4d,
-		0xf5, 0x46, 0x85, 0x3d, 0xa0, 0x65, 0x40, 0xbb, 0xde, 0x16, 0x46, 0xd5, 0xb7, 0x25, 0xa1, 0xbf,
-		0xef, 0xf6, 0xad, 0xe9, 0xa3, 0x8c, 0xbe, 0x1d, 0x47, 0x56, 0xc6, 0x7d, 0xa0, 0xe0, 0x76, 0x0e,
-		0x80, 0xe8, 0x55, 0x65, 0x27, 0xcb, 0xd8, 0xa2, 0xe5, 0xf0, 0xed, 0x5b, 0xd3, 0xe3, 0x8c, 0x8b,
-		0xdf, 0x26, 0x2b, 0x19, 0xf2, 0x50, 0x26, 0xbf, 0x03, 0x6e, 0x7d, 0x53, 0x02, 0xe4, 0xc7, 0x76,
-		0x05, 0x3b, 0x16, 0x59, 0x62, 0x91, 0xda, 0x3a, 0x50, 0x0b, 0x4b, 0x5d, 0x6a, 0x6b, 0x9f, 0x58,
-		0xd4, 0xd6, 0x81, 0x39, 0xf2, 0x94, 0x1f, 0x09, 0xc5, 0x36, 0x75, 0xf4, 0x00, 0xde, 0xec, 0x82,
-		0xa9, 0x09, 0xd2, 0xb6, 0xe0, 0x77, 0x48, 0xfe, 0x37, 0x12, 0x1c, 0x6d, 0xf3, 0x25, 0x4f, 0xcc,
-		0xb7, 0x02, 0xb2, 0x03, 0x8d, 0xfc, 0xd3, 0x67, 0x12, 0xff, 0x34, 0xf5, 0x41, 0xfc, 0x72, 0xdc,
-		0x8e, 0x89, 0xb0, 0x77, 0x21, 0x8c, 0xb3, 0x93, 0x7b, 0x9f, 0x93, 0x60, 0x32, 0xd8, 0xb3, 0xa7,
-		0xc0, 0x8b, 0x90, 0x0d, 0x76, 0xcc, 0x45, 0x3f, 0xd9, 0x53, 0x74, 0x2e, 0x75, 0x88, 0x18, 0xad,
-		0xfa, 0x53, 0x33, 0xc1, 0x3f, 0x26, 0xde, 0x97, 0x09, 0x84, 0x34, 0xd1, 0x29, 0x9a, 0xa2, 0x23,
-		0xf0, 0x3d, 0x09, 0x52, 0xeb, 0xa6, 0xa9, 0xa3, 0x97, 0x61, 0xdc, 0x30, 0xdd, 0x2a, 0xf1, 0x22,
-		0x5c, 0xaf, 0xf2, 0x65, 0x32, 0x0b, 0x78, 0xcf, 0xf7, 0x6d, 0x99, 0x6f, 0xde, 0x9a, 0x6e, 0xe7,
-		0xa2, 0x8c, 0x19, 0xa6, 0x5b, 0xa2, 0x90, 0x4d, 0xb6, 0x88, 0xbe, 0x0e, 0x23, 0xe1, 0x7e, 0x58,
-		0x24, 0x54, 0x0e, 0xd2, 0x4f, 0x98, 0xc3, 0xed, 0x5b, 0xd3, 0x93, 0xfe, 0x9c, 0xf0, 0xc0, 0xb2,
-		0x92, 0xdd, 0x0e, 0x74, 0xcc, 0x0e, 0x41, 0x7d, 0xe7, 0xf5, 0x69, 0xe9, 0xcc, 0x67, 0x25, 0x00,
-		0x7f, 0x87, 0x00, 0x3d, 0x0a, 0x47, 0x4a, 0x6b, 0xab, 0xe5, 0xea, 0xc6, 0xe6, 0xfc, 0xe6, 0xd6,
-		0x46, 0x75, 0x6b, 0x75, 0x63, 0xbd, 0xb2, 0xb0, 0x74, 0x79, 0xa9, 0x52, 0xf6, 0x37, 0xa5, 0x1d,
-		0x0b, 0xd7, 0xb4, 0x1d, 0x0d, 0xd7, 0xd1, 0x43, 0x30, 0x19, 0xc6, 0x26, 0x4f, 0x95, 0x72, 0x4e,
-		0x2a, 0x64, 0x5f, 0xbb, 0x39, 0x93, 0x66, 0x65, 0x15, 0xae, 0xa3, 0xd3, 0x70, 0xb8, 0x1d, 0x6f,
-		0x69, 0x75, 0x31, 0x97, 0x28, 0x8c, 0xbc, 0x76, 0x73, 0x26, 0xe3, 0xd5, 0x5f, 0x48, 0x06, 0x14,
-		0xc4, 0xe4, 0xfc, 0x92, 0x05, 0x78, 0xed, 0xe6, 0xcc, 0x20, 0xb3, 0x5d, 0x21, 0xf5, 0x9e, 0x8f,
-		0x9d, 0x38, 0x54, 0x9a, 0xef, 0xb8, 0xed, 0x7c, 0xaa, 0x93, 0xd9, 0x6e, 0x78, 0x5b, 0xca, 0xe1,
-		0x0d, 0xe7, 0x7f, 0x37, 0x14, 0xbf, 0xe1, 0xdc, 0xc0, 0x06, 0x76, 0x34, 0xe7, 0xe0, 0x1b, 0xce,
-		0xbd, 0xb7, 0xaf, 0xe5, 0x7f, 0x3d, 0x00, 0xd9, 0x45, 0xc6, 0x9f, 0x98, 0x1e, 0xa3, 0x0b, 0x30,
-		0x68, 0xd1, 0xd4, 0xe0, 0x9d, 0xe9, 0x88, 0xf3, 0x6a, 0x96, 0x3d, 0xbc, 0x63, 0x4f, 0x2c, 0x97,
-		0x18, 0xfc, 0x54, 0x04, 0x3b, 0x8c, 0xe5, 0x1f, 0x31, 0xca, 0xf6, 0xbb, 0x17, 0xc3, 0xea, 0x0e,
-		0xbe, 0xf3, 0x11, 0x65, 0x25, 0xb3, 0xb3, 0x15, 0x9b, 0x04, 0xc2, 0xce, 0x50, 0xfd, 0x8c, 0x04,
-		0x87, 0x29, 0x96, 0x9f, 0x51, 0x29, 0xa6, 0x28, 0xcf, 0x4f, 0xc5, 0x8a, 0xbe, 0xac, 0x3a, 0xfe,
-		0x61, 0x09, 0x76, 0xe8, 0xe9, 0x01, 0x9e, 0xd1, 0x8e, 0x07, 0x7a, 0x8e, 0xf2, 0x94, 0x95, 0x09,
-		0xbd, 0x8d, 0xd2, 0x41, 0xe5, 0xd0, 0x69, 0xb7, 0xd4, 0x01, 0xb6, 0xb7, 0x83, 0x07, 0xdf, 0x16,
-		0x61, 0xd8, 0x8f, 0x12, 0x0e, 0xff, 0xb7, 0x0b, 0x7d, 0x66, 0x80, 0x20, 0x25, 0x7a, 0x97, 0x04,
-		0x87, 0xfd, 0x84, 0x1c, 0xe4, 0x39, 0xc8, 0x3f, 0x52, 0xd5, 0xe7, 0x8a, 0x25, 0x6a, 0x93, 0x58,
-		0xa6, 0xb2, 0x32, 0xd9, 0x6a, 0x27, 0x25, 0x0b, 0xa5, 0x91, 0x60, 0xa8, 0x74, 0xf8, 0x7f, 0x17,
-		0xe9, 0x3b, 0xd0, 0x86, 0xa9, 0xd9, 0x97, 0xf2, 0x2d, 0xd3, 0x76, 0x71, 0x9d, 0x6e, 0x90, 0xa5,
-		0x15, 0xef, 0x59, 0x5e, 0x05, 0xd4, 0x3e, 0xa0, 0xd1, 0x53, 0x7d, 0x19, 0xff, 0x54, 0xdf, 0x24,
-		0x0c, 0x04, 0xcf, 0xbe, 0xb1, 0x87, 0x62, 0xfa, 0x3d, 0x3c, 0x03, 0xde, 0xcd, 0xe9, 0xfc, 0xb9,
-		0x04, 0x9c, 0xf2, 0x5e, 0xbd, 0xbc, 0xad, 0x85, 0xed, 0x7d, 0x6f, 0x0e, 0x5a, 0x6a, 0x43, 0x33,
-		0x82, 0x77, 0x5b, 0xa6, 0xbc, 0x44, 0x4d, 0x11, 0x85, 0x71, 0x64, 0x03, 0x86, 0xd7, 0xd5, 0x06,
-		0x56, 0xf0, 0xdb, 0x5a, 0xd8, 0x71, 0x63, 0x8e, 0x4e, 0x4f, 0xc1, 0xa0, 0xb9, 0xb3, 0x23, 0x5e,
-		0x08, 0xa7, 0x14, 0xfe, 0x44, 0xf4, 0xd4, 0xb5, 0xa6, 0xc6, 0x4e, 0x4d, 0xa5, 0x14, 0xf6, 0x80,
-		0xa6, 0x61, 0xb8, 0x66, 0xb6, 0x0c, 0x3e, 0xaf, 0xf2, 0x29, 0xf1, 0x55, 0x83, 0x96, 0xc1, 0xe6,
-		0x95, 0x7c, 0x09, 0xb2, 0xac, 0x3f, 0x9e, 0x31, 0x8f, 0x42, 0x9a, 0x1e, 0x3c, 0xf2, 0x7b, 0x1d,
-		0x22, 0xcf, 0x2f, 0xb2, 0x83, 0xd6, 0x8c, 0x0b, 0xeb, 0x98, 0x3d, 0x94, 0x9e, 0xeb, 0x68, 0xbf,
-		0xfb, 0xbb, 0xce, 0x7d, 0x66, 0x20, 0xcf, 0x76, 0xbf, 0x3b, 0x00, 0x87, 0xf9, 0x2b, 0x2f, 0xd5,
-		0xd2, 0xe6, 0x76, 0x5d, 0x57, 0x5c, 0x77, 0x01, 0x5e, 0x97, 0xaa, 0x96, 0x26, 0xef, 0x43, 0xea,
-		0x8a, 0xeb, 0x5a, 0xe8, 0x0c, 0x0c, 0xd8, 0x2d, 0x1d, 0x8b, 0xcd, 0x17, 0x6f, 0x03, 0x5c, 0xb5,
-		0xb4, 0x59, 0x82, 0xa0, 0xb4, 0x74, 0xac, 0x30, 0x14, 0x54, 0x81, 0xe9, 0x9d, 0x96, 0xae, 0xef,
-		0x57, 0xeb, 0x98, 0xfe, 0xe7, 0x19, 0xef, 0xdb, 0xed, 0xf8, 0x86, 0xa5, 0x8a, 0x2f, 0xc0, 0x11,
-		0xb3, 0x1c, 0xa7, 0x68, 0x65, 0x8a, 0x25, 0xbe, 0xdb, 0x5e, 0x11, 0x38, 0xf2, 0x1f, 0x27, 0x20,
-		0x2d, 0x58, 0xd3, 0x43, 0xd0, 0x58, 0xc7, 0x35, 0xd7, 0x14, 0xaf, 0x27, 0xbc, 0x67, 0x84, 0x20,
-		0xd9, 0xe0, 0xa3, 0x93, 0xb9, 0x72, 0x48, 0x21, 0x0f, 0x04, 0xe6, 0x1d, 0x45, 0x27, 0x30, 0xab,
-		0x45, 0x06, 0x2c, 0x65, 0x99, 0x62, 0xfd, 0x74, 0xe5, 0x90, 0x42, 0x9f, 0x50, 0x1e, 0x06, 0xc9,
-		0x4c, 0x70, 0xd9, 0x67, 0xf5, 0x08, 0x9c, 0x3f, 0xa3, 0x29, 0x18, 0xb0, 0x54, 0xb7, 0xc6, 0xce,
-		0x9d, 0x91, 0x06, 0xf6, 0x88, 0x9e, 0x86, 0x41, 0x76, 0xc1, 0x31, 0xfa, 0x6f, 0x1d, 0x88, 0x31,
-		0xd8, 0x97, 0xa4, 0x88, 0xdc, 0xeb, 0xaa, 0xeb, 0x62, 0xdb, 0x20, 0x0c, 0x19, 0x3a, 0x42, 0x90,
-		0xda, 0x36, 0xeb, 0xfb, 0xfc, 0x5f, 0x4d, 0xd0, 0xdf, 0xfc, 0xdb, 0xf6, 0xd4, 0x15, 0xaa, 0xb4,
-		0x91, 0xfd, 0x87, 0x9d, 0xac, 0x00, 0x96, 0x08, 0x52, 0x05, 0x26, 0xd4, 0x7a, 0x5d, 0x63, 0xff,
-		0xf5, 0xa1, 0xba, 0xad, 0xd1, 0x70, 0xe0, 0xd0, 0xff, 0x9f, 0xd4, 0x69, 0x2c, 0x90, 0x4f, 0x50,
-		0xe2, 0xf8, 0xa5, 0x0c, 0x0c, 0x59, 0x4c, 0x28, 0xf9, 0x22, 0x8c, 0xb7, 0x49, 0x4a, 0xe4, 0xdb,
-		0xd3, 0x8c, 0xba, 0x38, 0x9f, 0x4f, 0x7e, 0x13, 0x18, 0xfd, 0xf6, 0x1b, 0x7b, 0xf1, 0x43, 0x7f,
-		0x97, 0xde, 0xd9, 0xf9, 0x06, 0xd3, 0x68, 0xe0, 0x06, 0x93, 0x6a, 0x69, 0xa5, 0x0c, 0xe5, 0xcf,
-		0xef, 0x2d, 0xcd, 0xb7, 0xdf, 0x5b, 0x6a, 0x60, 0x43, 0xe4, 0x54, 0xd2, 0xa4, 0x5a, 0x9a, 0x43,
-		0xdd, 0xd1, 0xff, 0x16, 0x9d, 0x73, 0x31, 0xf0, 0x9b, 0x5e, 0x63, 0x4a, 0x2d, 0xce, 0xaf, 0x2f,
-		0x79, 0x7e, 0xfc, 0x3b, 0x09, 0x38, 0x1e, 0xf0, 0xe3, 0x00, 0x72, 0xbb, 0x3b, 0x17, 0xe2, 0x3d,
-		0xbe, 0x8f, 0xeb, 0x4b, 0x2f, 0x42, 0x8a, 0xe0, 0xa3, 0x1e, 0x5f, 0x9e, 0xcf, 0x7f, 0xea, 0x4b,
-		0x9f, 0x97, 0xc3, 0xaf, 0x88, 0x42, 0xa3, 0x42, 0x99, 0x94, 0xde, 0xdd, 0xbf, 0xfd, 0x72, 0xfe,
-		0x67, 0xf8, 0x9c, 0xbb, 0x67, 0xc6, 0xa8, 0x0d, 0x5f, 0x3d, 0x07, 0xd3, 0x71, 0x85, 0x0c, 0x8b,
-		0x94, 0x5d, 0x8a, 0xa2, 0x7e, 0xa3, 0x6f, 0x87, 0xea, 0xa9, 0xeb, 0xc0, 0xf5, 0x53, 0x5b, 0xb5,
-		0x60, 0xea, 0x27, 0x49, 0xc7, 0xfe, 0x3a, 0x56, 0xc4, 0xf1, 0x29, 0xef, 0x8d, 0x99, 0xc4, 0xff,
-		0x65, 0x15, 0x2b, 0x7b, 0x17, 0x00, 0x7c, 0xe1, 0xf8, 0x22, 0xee, 0xfe, 0xd9, 0xf8, 0xdc, 0x30,
-		0x1b, 0x48, 0x0c, 0x4a, 0x80, 0x4c, 0xfe, 0x55, 0x09, 0x8e, 0xb4, 0xf5, 0xcb, 0xe3, 0x79, 0x39,
-		0xe6, 0x70, 0xfe, 0xc1, 0xcb, 0x95, 0x72, 0x8c, 0x98, 0x0f, 0x74, 0x17, 0x93, 0xf5, 0x1f, 0x92,
-		0xf3, 0x79, 0x38, 0x1c, 0x16, 0x53, 0x58, 0xe7, 0x41, 0x18, 0x0d, 0xef, 0xc7, 0x72, 0x2b, 0x8d,
-		0x84, 0x76, 0x64, 0xe5, 0x9f, 0x8a, 0x9a, 0xd7, 0xd3, 0xb2, 0x04, 0x19, 0x0f, 0x95, 0x97, 0xb1,
-		0xfd, 0x29, 0xe9, 0x93, 0xc9, 0xbf, 0x20, 0xc1, 0x4c, 0x98, 0x7d, 0xa0, 0xc2, 0x39, 0x98, 0xa4,
-		0x77, 0x67, 0x58, 0xdf, 0x90, 0xe0, 0x64, 0x17, 0x81, 0xb8, 0xea, 0xd7, 0x61, 0x32, 0xb0, 0x42,
-		0x17, 0x71, 0x5a, 0x0c, 0xf5, 0xa9, 0x1e, 0x25, 0xa5, 0xb7, 0x36, 0x3d, 0x46, 0xcc, 0xf1, 0x89,
-		0x3f, 0x9d, 0x9e, 0x68, 0x6f, 0x73, 0x94, 0x89, 0xf6, 0xa5, 0xf5, 0xdd, 0xf2, 0x89, 0x0f, 0x48,
-		0xf0, 0x70, 0x58, 0xc9, 0x98, 0xda, 0xf4, 0x47, 0x62, 0xfe, 0x3f, 0x94, 0xe0, 0x4c, 0x3f, 0x92,
-		0xf1, 0x71, 0xa8, 0xc2, 0x84, 0x5f, 0x32, 0x47, 0x87, 0xa1, 0xff, 0x2a, 0x9c, 0xb9, 0x25, 0xf2,
-		0x58, 0xdd, 0x6d, 0x7b, 0x5b, 0x7c, 0x0e, 0x05, 0x87, 0xd9, 0xb3, 0x6d, 0x78, 0x37, 0x55, 0xd8,
-		0x36, 0xb4, 0x9f, 0x1a, 0x33, 0x04, 0x89, 0x98, 0x21, 0xf0, 0x0b, 0x6c, 0xd9, 0xe1, 0xc1, 0x29,
-		0x66, 0x1b, 0xec, 0x25, 0x98, 0x88, 0xf1, 0x5d, 0x3e, 0x81, 0xfb, 0x75, 0x5d, 0x05, 0xb5, 0x7b,
-		0xa7, 0xbc, 0x0f, 0xd3, 0xb4, 0xd3, 0x18, 0x13, 0xdf, 0x6b, 0x7d, 0x5f, 0xe6, 0x61, 0x24, 0xb6,
-		0x6b, 0xae, 0xf8, 0x65, 0x18, 0x64, 0x23, 0xcc, 0x75, 0x3d, 0xa8, 0x7f, 0x70, 0x6a, 0xf9, 0x83,
-		0x22, 0x66, 0x95, 0x85, 0xcc, 0xf1, 0x93, 0xa6, 0x1f, 0x45, 0xef, 0xc6, 0xa4, 0x09, 0x98, 0xe1,
-		0xcb, 0x22, 0x7a, 0xc5, 0x8b, 0xc6, 0x0d, 0xf1, 0x37, 0xee, 0x4e, 0xf4, 0x62, 0x56, 0xb9, 0x87,
-		0x61, 0xea, 0xa3, 0x22, 0x4c, 0x79, 0xda, 0xf4, 0x08, 0x53, 0x3f, 0x02, 0x8b, 0x7b, 0x01, 0xab,
-		0x87, 0x8c, 0xff, 0x77, 0x05, 0xac, 0xbf, 0x90, 0xe0, 0x28, 0xd5, 0x2a, 0xb8, 0x6d, 0x70, 0x50,
-		0x4b, 0x3f, 0x0a, 0xc8, 0xb1, 0x6b, 0xd5, 0xd8, 0x89, 0x9c, 0x73, 0xec, 0xda, 0xd5, 0x50, 0xfa,
-		0x78, 0x14, 0x50, 0x3d, 0xb4, 0x21, 0x44, 0xb1, 0xd9, 0x81, 0xb2, 0x5c, 0x3d, 0xb0, 0xf7, 0x10,
-		0x33, 0x8a, 0xa9, 0x1f, 0x74, 0x14, 0xbf, 0x24, 0x41, 0x21, 0x4e, 0x5f, 0x3e, 0x6a, 0x3b, 0x30,
-		0x15, 0xda, 0x92, 0x8f, 0x0e, 0xdc, 0xc3, 0x3d, 0xb7, 0x5c, 0x22, 0x93, 0xe6, 0xb0, 0x8d, 0xef,
-		0x69, 0x76, 0x9f, 0x0e, 0xbb, 0x64, 0x7b, 0x69, 0xfc, 0xa3, 0x99, 0x2c, 0x9f, 0x6c, 0x8b, 0x9c,
-		0x3f, 0xe6, 0xc5, 0xf3, 0x0d, 0x38, 0xd1, 0x41, 0xde, 0x7b, 0x9d, 0xd0, 0x70, 0xc7, 0x31, 0xbc,
-		0xab, 0xf5, 0xf7, 0x39, 0xee, 0xf7, 0xe1, 0x03, 0xc8, 0x81, 0x05, 0x54, 0xdc, 0xa5, 0x24, 0xf9,
-		0x2a, 0x1c, 0x8b, 0xa5, 0xe2, 0x82, 0x3d, 0x0d, 0xa9, 0x5d, 0xcd, 0x71, 0xb9, 0x4c, 0xf7, 0xc7,
-		0xca, 0x14, 0x21, 0xa5, 0x04, 0x32, 0x82, 0x1c, 0xe5, 0xbb, 0x6e, 0x9a, 0x3a, 0x97, 0x41, 0xbe,
-		0x02, 0xe3, 0x01, 0x18, 0xef, 0xe1, 0x49, 0x48, 0x59, 0x26, 0xbf, 0x27, 0x3f, 0x7c, 0xf6, 0x68,
-		0xfc, 0xe6, 0xb9, 0x69, 0xea, 0x5c, 0x61, 0x8a, 0x2c, 0x4f, 0x02, 0x62, 0x9c, 0xe8, 0x3e, 0xba,
-		0xe0, 0xbf, 0x0e, 0x13, 0x21, 0x28, 0xef, 0xe1, 0xce, 0x37, 0xe8, 0xcf, 0xfe, 0xf9, 0x24, 0x0c,
-		0x50, 0x96, 0xe8, 0xbd, 0x12, 0x40, 0xe0, 0xf5, 0xea, 0x23, 0xb1, 0x3c, 0xe2, 0x17, 0xaf, 0x85,
-		0x47, 0xfb, 0x43, 0xe6, 0xa5, 0xd7, 0xa9, 0x77, 0xfe, 0xe1, 0xd7, 0xdf, 0x9f, 0x38, 0x89, 0xa6,
-		0xe7, 0xe2, 0x16, 0xcc, 0x81, 0x79, 0xf1, 0x91, 0xd0, 0xbd, 0xec, 0x33, 0x7d, 0x74, 0x22, 0x04,
-		0x7a, 0xa4, 0x2f, 0x5c, 0x2e, 0xcf, 0x33, 0x54, 0x9e, 0xb3, 0xe8, 0xf1, 0x1e, 0xf2, 0xcc, 0xbd,
-		0x3d, 0x3c, 0x2d, 0xde, 0x81, 0xbe, 0x2c, 0xc1, 0x64, 0xdc, 0xda, 0x0b, 0x9d, 0xef, 0xa3, 0xff,
-		0xf6, 0xb2, 0xa0, 0xf0, 0xd4, 0x41, 0xc9, 0xb8, 0x06, 0x65, 0xaa, 0xc1, 0xf3, 0xe8, 0xd9, 0x83,
-		0x6a, 0x30, 0x17, 0xdc, 0x54, 0xff, 0x8e, 0x04, 0xf7, 0x75, 0x5d, 0xca, 0xa0, 0xe7, 0xfb, 0x90,
-		0xaf, 0x4b, 0xd9, 0x53, 0xb8, 0x74, 0xc7, 0xf4, 0x5c, 0xd1, 0x55, 0xaa, 0xe8, 0x15, 0x74, 0xf9,
-		0xc0, 0x8a, 0xc6, 0xbe, 0xad, 0x40, 0xbf, 0x1d, 0x3e, 0x63, 0xd7, 0xc5, 0x6d, 0xda, 0x96, 0x07,
-		0xdd, 0x9c, 0xbe, 0xbd, 0x00, 0x95, 0xb7, 0xa8, 0xe4, 0x6b, 0x68, 0xe5, 0x07, 0x19, 0xa2, 0xb9,
-		0xb7, 0x87, 0xe3, 0xf7, 0x3b, 0xd0, 0xb7, 0xa5, 0xf8, 0xe3, 0x72, 0xe7, 0x3a, 0x0b, 0xd7, 0x79,
-		0xc5, 0x53, 0x38, 0x7f, 0x40, 0x2a, 0xae, 0xdb, 0xcb, 0x54, 0xb7, 0x3a, 0xda, 0xbe, 0xab, 0xba,
-		0xc5, 0x0e, 0x19, 0xfa, 0x82, 0x04, 0x93, 0x71, 0x0b, 0x86, 0x6e, 0x53, 0xae, 0xcb, 0xda, 0xa7,
-		0xdb, 0x94, 0xeb, 0xb6, 0x2e, 0x91, 0x2f, 0x50, 0x9d, 0x9f, 0x44, 0x4f, 0xc4, 0xea, 0xdc, 0x75,
-		0xcc, 0xc8, 0x3c, 0xeb, 0x5a, 0x81, 0x77, 0x9b, 0x67, 0xfd, 0x2c, 0x2f, 0xba, 0xcd, 0xb3, 0xbe,
-		0x4a, 0xff, 0x1e, 0xf3, 0xcc, 0x53, 0xa8, 0xcf, 0x41, 0x73, 0xd0, 0x6f, 0x49, 0x30, 0x12, 0x2a,
-		0x57, 0xd1, 0x6c, 0x67, 0x11, 0xe3, 0xea, 0xf8, 0xc2, 0x5c, 0xdf, 0xf8, 0x5c, 0x85, 0xcb, 0x54,
-		0x85, 0x9f, 0x40, 0xcf, 0x1f, 0x58, 0x85, 0xf0, 0xab, 0xc6, 0xdf, 0x93, 0x60, 0x22, 0xa6, 0x04,
-		0xec, 0x36, 0xc3, 0x3a, 0xd7, 0xb2, 0x85, 0xf3, 0x07, 0xa4, 0xe2, 0xca, 0x2c, 0x50, 0x65, 0x9e,
-		0x43, 0x17, 0x0f, 0xac, 0x4c, 0x20, 0x9d, 0xfe, 0x7b, 0xff, 0xa8, 0x51, 0xa0, 0x13, 0xf4, 0xe4,
-		0x41, 0x44, 0x12, 0x7a, 0x9c, 0x3b, 0x18, 0x11, 0x57, 0x63, 0x93, 0xaa, 0xb1, 0x8a, 0x96, 0x7f,
-		0x00, 0x35, 0xda, 0xb3, 0xf0, 0x27, 0xda, 0x2f, 0xa5, 0x75, 0xf1, 0x96, 0xd8, 0xea, 0xb1, 0xf0,
-		0x78, 0xff, 0x04, 0x5c, 0x97, 0xf3, 0x54, 0x97, 0x39, 0xf4, 0x58, 0xac, 0x2e, 0x81, 0xd3, 0x63,
-		0x9a, 0xb1, 0x63, 0xce, 0xbd, 0x9d, 0x55, 0xa3, 0xef, 0x40, 0x37, 0xf8, 0x61, 0x9e, 0x07, 0x3b,
-		0x77, 0x18, 0xa8, 0x28, 0x0b, 0x0f, 0xf5, 0x42, 0xe3, 0xd2, 0x9c, 0xa4, 0xd2, 0x1c, 0x43, 0x47,
-		0x63, 0xa5, 0x21, 0x25, 0x25, 0x7a, 0x55, 0xf2, 0x8e, 0xf8, 0x9d, 0xea, 0xc2, 0x35, 0x58, 0x70,
-		0x16, 0x4e, 0xf7, 0x46, 0xe4, 0x02, 0xdc, 0x4f, 0x05, 0xb8, 0x0f, 0x1d, 0x8b, 0x17, 0x80, 0xd5,
-		0x9e, 0x77, 0xf1, 0x55, 0xfa, 0x67, 0xa6, 0xe0, 0x78, 0x5c, 0x4f, 0xee, 0x8d, 0x6e, 0xef, 0x7f,
-		0xba, 0xdc, 0xb7, 0xec, 0x79, 0x9f, 0xb2, 0xc3, 0x0d, 0xce, 0x3b, 0xbc, 0x65, 0xd9, 0xc7, 0xcb,
-		0xa2, 0xcf, 0xa7, 0x00, 0xad, 0x38, 0x8d, 0x05, 0x1b, 0xb3, 0x7f, 0x61, 0xc6, 0xe7, 0x6b, 0xe4,
-		0xba, 0x91, 0x74, 0xe7, 0xd7, 0x8d, 0x5e, 0x08, 0x5d, 0xe0, 0x49, 0x1c, 0xe0, 0x3a, 0x60, 0xdf,
-		0xb7, 0x78, 0x92, 0xf7, 0xfa, 0x16, 0x4f, 0xfc, 0x31, 0xe0, 0xd4, 0xdd, 0xbb, 0x18, 0x30, 0x70,
-		0x47, 0x17, 0x03, 0x16, 0x60, 0x90, 0xdf, 0xc0, 0x1b, 0xec, 0x72, 0x03, 0xef, 0x70, 0xfc, 0x35,
-		0x3b, 0x4e, 0x8a, 0xce, 0x8a, 0x4f, 0xb2, 0x0e, 0xf5, 0x71, 0x76, 0x93, 0x7f, 0xb0, 0xd5, 0x5f,
-		0x95, 0x1f, 0x87, 0x42, 0xbb, 0xf7, 0x78, 0xf3, 0xf5, 0xdd, 0x49, 0xc8, 0xad, 0x38, 0x8d, 0x4a,
-		0x5d, 0x73, 0xef, 0x85, 0x6b, 0x5d, 0xea, 0x7c, 0xc7, 0x02, 0xdd, 0xbe, 0x35, 0x3d, 0xca, 0x4c,
-		0xd9, 0xc5, 0x80, 0xbb, 0x30, 0x16, 0xb9, 0xb0, 0xca, 0x7d, 0xe9, 0xd2, 0x01, 0xaf, 0xcc, 0x46,
-		0xb8, 0xc8, 0xf4, 0x68, 0x7c, 0xc0, 0x99, 0x91, 0x13, 0xef, 0xb9, 0xcc, 0x85, 0x16, 0xee, 0xd1,
-		0xdd, 0x33, 0x7f, 0x98, 0x0a, 0x90, 0x8f, 0x8e, 0x83, 0x37, 0x48, 0x5f, 0x93, 0x60, 0x78, 0xc5,
-		0x11, 0x15, 0x1a, 0xfe, 0x31, 0xbd, 0x04, 0x73, 0xce, 0xfb, 0x82, 0x66, 0xb2, 0x0f, 0x3f, 0x15,
-		0x9f, 0xd4, 0xf4, 0x2d, 0x70, 0x18, 0x26, 0x02, 0x4a, 0x7a, 0xca, 0x7f, 0x39, 0x41, 0xc3, 0x5f,
-		0x09, 0x37, 0x34, 0xc3, 0x2b, 0xf1, 0xf0, 0x5f, 0xd7, 0x63, 0xff, 0xbe, 0x91, 0x53, 0x77, 0x64,
-		0xe4, 0x3d, 0x1a, 0x0d, 0x22, 0xc6, 0xf4, 0x76, 0x90, 0x56, 0xda, 0xef, 0xa1, 0x48, 0x07, 0xf8,
-		0xea, 0x4a, 0xe4, 0xb6, 0x89, 0xfc, 0x5f, 0x24, 0x18, 0x59, 0x71, 0x1a, 0x5b, 0x46, 0xfd, 0xff,
-		0x6d, 0xcf, 0xdd, 0x81, 0xc3, 0x21, 0x35, 0xef, 0x91, 0x3d, 0xcf, 0xfe, 0xef, 0x24, 0x24, 0x57,
-		0x9c, 0x06, 0xda, 0x83, 0xb1, 0x68, 0x35, 0x10, 0x5f, 0xca, 0xb5, 0x07, 0xfe, 0x0e, 0x0b, 0xa8,
-		0xce, 0x19, 0x02, 0x61, 0x18, 0x09, 0x67, 0x87, 0x07, 0x3b, 0x71, 0x08, 0xa1, 0x15, 0x1e, 0xeb,
-		0x0b, 0xcd, 0xeb, 0xe6, 0x2a, 0xa4, 0xbd, 0xf8, 0x36, 0xd3, 0x89, 0x54, 0x60, 0x74, 0x28, 0x48,
-		0x63, 0xc2, 0x07, 0xb1, 0x55, 0x34, 0x74, 0x74, 0xb4, 0x55, 0x04, 0xb1, 0xb3, 0xad, 0x3a, 0xcd,
-		0x9f, 0x9f, 0x02, 0x08, 0x38, 0xbb, 0xdc, 0x89, 0xdc, 0xc7, 0x29, 0x9c, 0xe9, 0x8d, 0xe3, 0xbd,
-		0x87, 0xb9, 0x7b, 0x65, 0xf3, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0x8d, 0xdf, 0x5d, 0xd5, 0xee,
-		0x91, 0x00, 0x00,
+		// 10000 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x90, 0x5c, 0x59,
+		0x75, 0x98, 0x5e, 0x77, 0xcf, 0x4c, 0xf7, 0x99, 0xaf, 0x9e, 0x3b, 0x23, 0xa9, 0xd5, 0xfa, 0x98,
+		0xd1, 0xdb, 0x5d, 0x49, 0xab, 0x5d, 0xcd, 0xec, 0x6a, 0xa5, 0x5d, 0xd4, 0xda, 0x5d, 0x31, 0x3d,
+		0xd3, 0x1a, 0x8d, 0x76, 0xbe, 0x78, 0x33, 0x23, 0x96, 0xc5, 0x54, 0xe7, 0x4d, 0xf7, 0x9d, 0x9e,
+		0xb7, 0xf3, 0xfa, 0xbd, 0xc7, 0x7b, 0xaf, 0x25, 0xcd, 0x12, 0xaa, 0xd6, 0x60, 0x13, 0x58, 0x27,
+		0x36, 0x18, 0x57, 0x8c, 0x09, 0x22, 0x8b, 0x1d, 0x07, 0x87, 0x38, 0x18, 0x27, 0x04, 0x43, 0x4c,
+		0xb9, 0x70, 0xb9, 0x1c, 0x63, 0xa8, 0x72, 0x41, 0x5c, 0x49, 0x5c, 0x38, 0x25, 0x9c, 0x85, 0x54,
+		0x08, 0x60, 0x9b, 0xc8, 0x90, 0x4a, 0x8a, 0x2a, 0x57, 0xea, 0x7e, 0xbd, 0xaf, 0x7e, 0xfd, 0x25,
+		0x24, 0xc0, 0x21, 0xbf, 0xa6, 0xef, 0xb9, 0xe7, 0x9c, 0x7b, 0xce, 0xb9, 0xe7, 0x9e, 0x7b, 0xee,
+		0xd7, 0x1b, 0xf8, 0x83, 0x8b, 0x30, 0x55, 0x33, 0xcd, 0x9a, 0x8e, 0x67, 0x2c, 0xdb, 0x74, 0xcd,
+		0xad, 0xc6, 0xf6, 0x4c, 0x15, 0x3b, 0x15, 0x5b, 0xb3, 0x5c, 0xd3, 0x9e, 0xa6, 0x30, 0x34, 0xca,
+		0x30, 0xa6, 0x05, 0x86, 0xbc, 0x0c, 0x63, 0x97, 0x35, 0x1d, 0xcf, 0x7b, 0x88, 0xeb, 0xd8, 0x45,
+		0xaf, 0x83, 0xd4, 0xb6, 0xa6, 0xe3, 0x9c, 0x34, 0x95, 0x3c, 0x35, 0x78, 0xf6, 0xc1, 0xe9, 0x08,
+		0xd1, 0x74, 0x98, 0x62, 0x8d, 0x80, 0x15, 0x4a, 0x21, 0x7f, 0x3d, 0x05, 0xe3, 0x31, 0xb5, 0x08,
+		0x41, 0xca, 0x50, 0xeb, 0x84, 0xa3, 0x74, 0x2a, 0xa3, 0xd0, 0xdf, 0x28, 0x07, 0x03, 0x96, 0x5a,
+		0xd9, 0x55, 0x6b, 0x38, 0x97, 0xa0, 0x60, 0x51, 0x44, 0xc7, 0x00, 0xaa, 0xd8, 0xc2, 0x46, 0x15,
+		0x1b, 0x95, 0xbd, 0x5c, 0x72, 0x2a, 0x79, 0x2a, 0xa3, 0x04, 0x20, 0xe8, 0x11, 0x18, 0xb3, 0x1a,
+		0x5b, 0xba, 0x56, 0x29, 0x07, 0xd0, 0x60, 0x2a, 0x79, 0xaa, 0x4f, 0xc9, 0xb2, 0x8a, 0x79, 0x1f,
+		0xf9, 0x24, 0x8c, 0xde, 0xc0, 0xea, 0x6e, 0x10, 0x75, 0x90, 0xa2, 0x8e, 0x10, 0x70, 0x00, 0x71,
+		0x0e, 0x86, 0xea, 0xd8, 0x71, 0xd4, 0x1a, 0x2e, 0xbb, 0x7b, 0x16, 0xce, 0xa5, 0xa8, 0xf6, 0x53,
+		0x4d, 0xda, 0x47, 0x35, 0x1f, 0xe4, 0x54, 0x1b, 0x7b, 0x16, 0x46, 0xb3, 0x90, 0xc1, 0x46, 0xa3,
+		0xce, 0x38, 0xf4, 0xb5, 0xb0, 0x5f, 0xc9, 0x68, 0xd4, 0xa3, 0x5c, 0xd2, 0x84, 0x8c, 0xb3, 0x18,
+		0x70, 0xb0, 0x7d, 0x5d, 0xab, 0xe0, 0x5c, 0x3f, 0x65, 0x70, 0xb2, 0x89, 0xc1, 0x3a, 0xab, 0x8f,
+		0xf2, 0x10, 0x74, 0x68, 0x0e, 0x32, 0xf8, 0xa6, 0x8b, 0x0d, 0x47, 0x33, 0x8d, 0xdc, 0x00, 0x65,
+		0xf2, 0x50, 0x4c, 0x2f, 0x62, 0xbd, 0x1a, 0x65, 0xe1, 0xd3, 0xa1, 0x27, 0x61, 0xc0, 0xb4, 0x5c,
+		0xcd, 0x34, 0x9c, 0x5c, 0x7a, 0x4a, 0x3a, 0x35, 0x78, 0xf6, 0x48, 0xac, 0x23, 0xac, 0x32, 0x1c,
+		0x45, 0x20, 0xa3, 0x45, 0xc8, 0x3a, 0x66, 0xc3, 0xae, 0xe0, 0x72, 0xc5, 0xac, 0xe2, 0xb2, 0x66,
+		0x6c, 0x9b, 0xb9, 0x0c, 0x65, 0x30, 0xd9, 0xac, 0x08, 0x45, 0x9c, 0x33, 0xab, 0x78, 0xd1, 0xd8,
+		0x36, 0x95, 0x11, 0x27, 0x54, 0x46, 0x07, 0xa0, 0xdf, 0xd9, 0x33, 0x5c, 0xf5, 0x66, 0x6e, 0x88,
+		0x7a, 0x08, 0x2f, 0xc9, 0x9f, 0xe9, 0x87, 0xd1, 0x6e, 0x5c, 0xec, 0x22, 0xf4, 0x6d, 0x13, 0x2d,
+		0x73, 0x89, 0x5e, 0x6c, 0xc0, 0x68, 0xc2, 0x46, 0xec, 0xbf, 0x4b, 0x23, 0xce, 0xc2, 0xa0, 0x81,
+		0x1d, 0x17, 0x57, 0x99, 0x47, 0x24, 0xbb, 0xf4, 0x29, 0x60, 0x44, 0xcd, 0x2e, 0x95, 0xba, 0x2b,
+		0x97, 0x7a, 0x1e, 0x46, 0x3d, 0x91, 0xca, 0xb6, 0x6a, 0xd4, 0x84, 0x6f, 0xce, 0x74, 0x92, 0x64,
+		0xba, 0x24, 0xe8, 0x14, 0x42, 0xa6, 0x8c, 0xe0, 0x50, 0x19, 0xcd, 0x03, 0x98, 0x06, 0x36, 0xb7,
+		0xcb, 0x55, 0x5c, 0xd1, 0x73, 0xe9, 0x16, 0x56, 0x5a, 0x25, 0x28, 0x4d, 0x56, 0x32, 0x19, 0xb4,
+		0xa2, 0xa3, 0x0b, 0xbe, 0xab, 0x0d, 0xb4, 0xf0, 0x94, 0x65, 0x36, 0xc8, 0x9a, 0xbc, 0x6d, 0x13,
+		0x46, 0x6c, 0x4c, 0xfc, 0x1e, 0x57, 0xb9, 0x66, 0x19, 0x2a, 0xc4, 0x74, 0x47, 0xcd, 0x14, 0x4e,
+		0xc6, 0x14, 0x1b, 0xb6, 0x83, 0x45, 0xf4, 0x00, 0x78, 0x80, 0x32, 0x75, 0x2b, 0xa0, 0x51, 0x68,
+		0x48, 0x00, 0x57, 0xd4, 0x3a, 0xce, 0xbf, 0x04, 0x23, 0x61, 0xf3, 0xa0, 0x09, 0xe8, 0x73, 0x5c,
+		0xd5, 0x76, 0xa9, 0x17, 0xf6, 0x29, 0xac, 0x80, 0xb2, 0x90, 0xc4, 0x46, 0x95, 0x46, 0xb9, 0x3e,
+		0x85, 0xfc, 0x44, 0xaf, 0xf7, 0x15, 0x4e, 0x52, 0x85, 0x4f, 0x34, 0xf7, 0x68, 0x88, 0x73, 0x54,
+		0xef, 0xfc, 0x53, 0x30, 0x1c, 0x52, 0xa0, 0xdb, 0xa6, 0xe5, 0xbf, 0x0f, 0xfb, 0x63, 0x59, 0xa3,
+		0xe7, 0x61, 0xa2, 0x61, 0x68, 0x86, 0x8b, 0x6d, 0xcb, 0xc6, 0xc4, 0x63, 0x59, 0x53, 0xb9, 0xff,
+		0x3e, 0xd0, 0xc2, 0xe7, 0x36, 0x83, 0xd8, 0x8c, 0x8b, 0x32, 0xde, 0x68, 0x06, 0x9e, 0xce, 0xa4,
+		0xbf, 0x31, 0x90, 0x7d, 0xf9, 0xe5, 0x97, 0x5f, 0x4e, 0xc8, 0xbf, 0xdf, 0x0f, 0x13, 0x71, 0x63,
+		0x26, 0x76, 0xf8, 0x1e, 0x80, 0x7e, 0xa3, 0x51, 0xdf, 0xc2, 0x36, 0x35, 0x52, 0x9f, 0xc2, 0x4b,
+		0x68, 0x16, 0xfa, 0x74, 0x75, 0x0b, 0xeb, 0xb9, 0xd4, 0x94, 0x74, 0x6a, 0xe4, 0xec, 0x23, 0x5d,
+		0x8d, 0xca, 0xe9, 0x25, 0x42, 0xa2, 0x30, 0x4a, 0xf4, 0x2c, 0xa4, 0x78, 0x88, 0x26, 0x1c, 0x4e,
+		0x77, 0xc7, 0x81, 0x8c, 0x25, 0x85, 0xd2, 0xa1, 0xc3, 0x90, 0x21, 0x7f, 0x99, 0x6f, 0xf4, 0x53,
+		0x99, 0xd3, 0x04, 0x40, 0xfc, 0x02, 0xe5, 0x21, 0x4d, 0x87, 0x49, 0x15, 0x8b, 0xa9, 0xcd, 0x2b,
+		0x13, 0xc7, 0xaa, 0xe2, 0x6d, 0xb5, 0xa1, 0xbb, 0xe5, 0xeb, 0xaa, 0xde, 0xc0, 0xd4, 0xe1, 0x33,
+		0xca, 0x10, 0x07, 0x5e, 0x23, 0x30, 0x34, 0x09, 0x83, 0x6c, 0x54, 0x69, 0x46, 0x15, 0xdf, 0xa4,
+		0xd1, 0xb3, 0x4f, 0x61, 0x03, 0x6d, 0x91, 0x40, 0x48, 0xf3, 0x2f, 0x3a, 0xa6, 0x21, 0x5c, 0x93,
+		0x36, 0x41, 0x00, 0xb4, 0xf9, 0xa7, 0xa2, 0x81, 0xfb, 0x68, 0xbc, 0x7a, 0x4d, 0x63, 0xe9, 0x24,
+		0x8c, 0x52, 0x8c, 0x27, 0x78, 0xd7, 0xab, 0x7a, 0x6e, 0x6c, 0x4a, 0x3a, 0x95, 0x56, 0x46, 0x18,
+		0x78, 0x95, 0x43, 0xe5, 0x4f, 0x25, 0x20, 0x45, 0x03, 0xcb, 0x28, 0x0c, 0x6e, 0xbc, 0x69, 0xad,
+		0x54, 0x9e, 0x5f, 0xdd, 0x2c, 0x2e, 0x95, 0xb2, 0x12, 0x1a, 0x01, 0xa0, 0x80, 0xcb, 0x4b, 0xab,
+		0xb3, 0x1b, 0xd9, 0x84, 0x57, 0x5e, 0x5c, 0xd9, 0x78, 0xf2, 0x5c, 0x36, 0xe9, 0x11, 0x6c, 0x32,
+		0x40, 0x2a, 0x88, 0xf0, 0xc4, 0xd9, 0x6c, 0x1f, 0xca, 0xc2, 0x10, 0x63, 0xb0, 0xf8, 0x7c, 0x69,
+		0xfe, 0xc9, 0x73, 0xd9, 0xfe, 0x30, 0xe4, 0x89, 0xb3, 0xd9, 0x01, 0x34, 0x0c, 0x19, 0x0a, 0x29,
+		0xae, 0xae, 0x2e, 0x65, 0xd3, 0x1e, 0xcf, 0xf5, 0x0d, 0x65, 0x71, 0x65, 0x21, 0x9b, 0xf1, 0x78,
+		0x2e, 0x28, 0xab, 0x9b, 0x6b, 0x59, 0xf0, 0x38, 0x2c, 0x97, 0xd6, 0xd7, 0x67, 0x17, 0x4a, 0xd9,
+		0x41, 0x0f, 0xa3, 0xf8, 0xa6, 0x8d, 0xd2, 0x7a, 0x76, 0x28, 0x24, 0xd6, 0x13, 0x67, 0xb3, 0xc3,
+		0x5e, 0x13, 0xa5, 0x95, 0xcd, 0xe5, 0xec, 0x08, 0x1a, 0x83, 0x61, 0xd6, 0x84, 0x10, 0x62, 0x34,
+		0x02, 0x7a, 0xf2, 0x5c, 0x36, 0xeb, 0x0b, 0xc2, 0xb8, 0x8c, 0x85, 0x00, 0x4f, 0x9e, 0xcb, 0x22,
+		0x79, 0x0e, 0xfa, 0xa8, 0x1b, 0x22, 0x04, 0x23, 0x4b, 0xb3, 0xc5, 0xd2, 0x52, 0x79, 0x75, 0x6d,
+		0x63, 0x71, 0x75, 0x65, 0x76, 0x29, 0x2b, 0xf9, 0x30, 0xa5, 0xf4, 0x86, 0xcd, 0x45, 0xa5, 0x34,
+		0x9f, 0x4d, 0x04, 0x61, 0x6b, 0xa5, 0xd9, 0x8d, 0xd2, 0x7c, 0x36, 0x29, 0x57, 0x60, 0x22, 0x2e,
+		0xa0, 0xc6, 0x0e, 0xa1, 0x80, 0x2f, 0x24, 0x5a, 0xf8, 0x02, 0xe5, 0x15, 0xf5, 0x05, 0xf9, 0x6b,
+		0x09, 0x18, 0x8f, 0x99, 0x54, 0x62, 0x1b, 0xb9, 0x04, 0x7d, 0xcc, 0x97, 0xd9, 0x34, 0xfb, 0x70,
+		0xec, 0xec, 0x44, 0x3d, 0xbb, 0x69, 0xaa, 0xa5, 0x74, 0xc1, 0x54, 0x23, 0xd9, 0x22, 0xd5, 0x20,
+		0x2c, 0x9a, 0x1c, 0xf6, 0x2d, 0x4d, 0xc1, 0x9f, 0xcd, 0x8f, 0x4f, 0x76, 0x33, 0x3f, 0x52, 0x58,
+		0x6f, 0x93, 0x40, 0x5f, 0xcc, 0x24, 0x70, 0x11, 0xc6, 0x9a, 0x18, 0x75, 0x1d, 0x8c, 0xdf, 0x29,
+		0x41, 0xae, 0x95, 0x71, 0x3a, 0x84, 0xc4, 0x44, 0x28, 0x24, 0x5e, 0x8c, 0x5a, 0xf0, 0x78, 0xeb,
+		0x4e, 0x68, 0xea, 0xeb, 0x8f, 0x4a, 0x70, 0x20, 0x3e, 0xa5, 0x8c, 0x95, 0xe1, 0x59, 0xe8, 0xaf,
+		0x63, 0x77, 0xc7, 0x14, 0x69, 0xd5, 0x89, 0x98, 0xc9, 0x9a, 0x54, 0x47, 0x3b, 0x9b, 0x53, 0xa1,
+		0x0b, 0x51, 0x59, 0x27, 0x5b, 0x25, 0xb8, 0x4d, 0x92, 0xbe, 0x27, 0x01, 0xfb, 0x63, 0x99, 0xc7,
+		0x0a, 0x7a, 0x14, 0x40, 0x33, 0xac, 0x86, 0xcb, 0x52, 0x27, 0x16, 0x89, 0x33, 0x14, 0x42, 0x83,
+		0x17, 0x89, 0xb2, 0x0d, 0xd7, 0xab, 0x4f, 0xd2, 0x7a, 0x60, 0x20, 0x8a, 0xf0, 0x3a, 0x5f, 0xd0,
+		0x14, 0x15, 0xf4, 0x58, 0x0b, 0x4d, 0x9b, 0x1c, 0xf3, 0x31, 0xc8, 0x56, 0x74, 0x0d, 0x1b, 0x6e,
+		0xd9, 0x71, 0x6d, 0xac, 0xd6, 0x35, 0xa3, 0x46, 0xa7, 0x9a, 0x74, 0xa1, 0x6f, 0x5b, 0xd5, 0x1d,
+		0xac, 0x8c, 0xb2, 0xea, 0x75, 0x51, 0x4b, 0x28, 0xa8, 0x03, 0xd9, 0x01, 0x8a, 0xfe, 0x10, 0x05,
+		0xab, 0xf6, 0x28, 0xe4, 0xf7, 0x65, 0x60, 0x30, 0x90, 0x80, 0xa3, 0xe3, 0x30, 0xf4, 0xa2, 0x7a,
+		0x5d, 0x2d, 0x8b, 0x45, 0x15, 0xb3, 0xc4, 0x20, 0x81, 0xad, 0x31, 0x10, 0x7a, 0x0c, 0x26, 0x28,
+		0x8a, 0xd9, 0x70, 0xb1, 0x5d, 0xae, 0xe8, 0xaa, 0xe3, 0x50, 0xa3, 0xa5, 0x29, 0x2a, 0x22, 0x75,
+		0xab, 0xa4, 0x6a, 0x4e, 0xd4, 0xa0, 0xf3, 0x30, 0x4e, 0x29, 0xea, 0x0d, 0xdd, 0xd5, 0x2c, 0x1d,
+		0x97, 0xc9, 0x32, 0xcf, 0xc9, 0x41, 0x50, 0xb2, 0x31, 0x82, 0xb1, 0xcc, 0x11, 0x88, 0x44, 0x0e,
+		0x9a, 0x87, 0xa3, 0x94, 0xac, 0x86, 0x0d, 0x6c, 0xab, 0x2e, 0x2e, 0xe3, 0xb7, 0x36, 0x54, 0xdd,
+		0x29, 0xab, 0x46, 0xb5, 0xbc, 0xa3, 0x3a, 0x3b, 0xb9, 0x09, 0xc2, 0xa0, 0x98, 0xc8, 0x49, 0xca,
+		0x21, 0x82, 0xb8, 0xc0, 0xf1, 0x4a, 0x14, 0x6d, 0xd6, 0xa8, 0x5e, 0x51, 0x9d, 0x1d, 0x54, 0x80,
+		0x03, 0x94, 0x8b, 0xe3, 0xda, 0x9a, 0x51, 0x2b, 0x57, 0x76, 0x70, 0x65, 0xb7, 0xdc, 0x70, 0xb7,
+		0x5f, 0x97, 0x3b, 0x1c, 0x6c, 0x9f, 0x4a, 0xb8, 0x4e, 0x71, 0xe6, 0x08, 0xca, 0xa6, 0xbb, 0xfd,
+		0x3a, 0xb4, 0x0e, 0x43, 0xa4, 0x33, 0xea, 0xda, 0x4b, 0xb8, 0xbc, 0x6d, 0xda, 0x74, 0x0e, 0x1d,
+		0x89, 0x09, 0x4d, 0x01, 0x0b, 0x4e, 0xaf, 0x72, 0x82, 0x65, 0xb3, 0x8a, 0x0b, 0x7d, 0xeb, 0x6b,
+		0xa5, 0xd2, 0xbc, 0x32, 0x28, 0xb8, 0x5c, 0x36, 0x6d, 0xe2, 0x50, 0x35, 0xd3, 0x33, 0xf0, 0x20,
+		0x73, 0xa8, 0x9a, 0x29, 0xcc, 0x7b, 0x1e, 0xc6, 0x2b, 0x15, 0xa6, 0xb3, 0x56, 0x29, 0xf3, 0xc5,
+		0x98, 0x93, 0xcb, 0x86, 0x8c, 0x55, 0xa9, 0x2c, 0x30, 0x04, 0xee, 0xe3, 0x0e, 0xba, 0x00, 0xfb,
+		0x7d, 0x63, 0x05, 0x09, 0xc7, 0x9a, 0xb4, 0x8c, 0x92, 0x9e, 0x87, 0x71, 0x6b, 0xaf, 0x99, 0x10,
+		0x85, 0x5a, 0xb4, 0xf6, 0xa2, 0x64, 0x4f, 0xc1, 0x84, 0xb5, 0x63, 0x35, 0xd3, 0x9d, 0x0e, 0xd2,
+		0x21, 0x6b, 0xc7, 0x8a, 0x12, 0x3e, 0x44, 0x57, 0xe6, 0x36, 0xae, 0xa8, 0x2e, 0xae, 0xe6, 0x0e,
+		0x06, 0xd1, 0x03, 0x15, 0x68, 0x1a, 0xb2, 0x95, 0x4a, 0x19, 0x1b, 0xea, 0x96, 0x8e, 0xcb, 0xaa,
+		0x8d, 0x0d, 0xd5, 0xc9, 0x4d, 0x52, 0xe4, 0x94, 0x6b, 0x37, 0xb0, 0x32, 0x52, 0xa9, 0x94, 0x68,
+		0xe5, 0x2c, 0xad, 0x43, 0xa7, 0x61, 0xcc, 0xdc, 0x7a, 0xb1, 0xc2, 0x3c, 0xb2, 0x6c, 0xd9, 0x78,
+		0x5b, 0xbb, 0x99, 0x7b, 0x90, 0x9a, 0x77, 0x94, 0x54, 0x50, 0x7f, 0x5c, 0xa3, 0x60, 0xf4, 0x30,
+		0x64, 0x2b, 0xce, 0x8e, 0x6a, 0x5b, 0x34, 0x24, 0x3b, 0x96, 0x5a, 0xc1, 0xb9, 0x87, 0x18, 0x2a,
+		0x83, 0xaf, 0x08, 0x30, 0x19, 0x11, 0xce, 0x0d, 0x6d, 0xdb, 0x15, 0x1c, 0x4f, 0xb2, 0x11, 0x41,
+		0x61, 0x9c, 0xdb, 0x29, 0xc8, 0x12, 0x4b, 0x84, 0x1a, 0x3e, 0x45, 0xd1, 0x46, 0xac, 0x1d, 0x2b,
+		0xd8, 0xee, 0x03, 0x30, 0x6c, 0xed, 0x04, 0x1b, 0x7d, 0x98, 0x25, 0x6e, 0xd6, 0x4e, 0xa0, 0xc5,
+		0x73, 0x70, 0x80, 0x20, 0xd5, 0xb1, 0xab, 0x56, 0x55, 0x57, 0x0d, 0x60, 0x3f, 0x4a, 0xb1, 0x89,
+		0xd9, 0x97, 0x79, 0x65, 0x48, 0x4e, 0xbb, 0xb1, 0xb5, 0xe7, 0x39, 0xd6, 0x19, 0x26, 0x27, 0x81,
+		0x09, 0xd7, 0xba, 0x6f, 0xc9, 0xb9, 0x5c, 0x80, 0xa1, 0xa0, 0xdf, 0xa3, 0x0c, 0x30, 0xcf, 0xcf,
+		0x4a, 0x24, 0x09, 0x9a, 0x5b, 0x9d, 0x27, 0xe9, 0xcb, 0x0b, 0xa5, 0x6c, 0x82, 0xa4, 0x51, 0x4b,
+		0x8b, 0x1b, 0xa5, 0xb2, 0xb2, 0xb9, 0xb2, 0xb1, 0xb8, 0x5c, 0xca, 0x26, 0x03, 0x89, 0xfd, 0xd5,
+		0x54, 0xfa, 0x44, 0xf6, 0xa4, 0xfc, 0xe5, 0x04, 0x8c, 0x84, 0x57, 0x6a, 0xe8, 0x69, 0x38, 0x28,
+		0xb6, 0x55, 0x1c, 0xec, 0x96, 0x6f, 0x68, 0x36, 0x1d, 0x90, 0x75, 0x95, 0x4d, 0x8e, 0x9e, 0xff,
+		0x4c, 0x70, 0xac, 0x75, 0xec, 0xbe, 0x51, 0xb3, 0xc9, 0x70, 0xab, 0xab, 0x2e, 0x5a, 0x82, 0x49,
+		0xc3, 0x2c, 0x3b, 0xae, 0x6a, 0x54, 0x55, 0xbb, 0x5a, 0xf6, 0x37, 0xb4, 0xca, 0x6a, 0xa5, 0x82,
+		0x1d, 0xc7, 0x64, 0x13, 0xa1, 0xc7, 0xe5, 0x88, 0x61, 0xae, 0x73, 0x64, 0x7f, 0x86, 0x98, 0xe5,
+		0xa8, 0x11, 0xf7, 0x4d, 0xb6, 0x72, 0xdf, 0xc3, 0x90, 0xa9, 0xab, 0x56, 0x19, 0x1b, 0xae, 0xbd,
+		0x47, 0xf3, 0xf3, 0xb4, 0x92, 0xae, 0xab, 0x56, 0x89, 0x94, 0x7f, 0x28, 0xcb, 0xa4, 0xab, 0xa9,
+		0x74, 0x3a, 0x9b, 0xb9, 0x9a, 0x4a, 0x67, 0xb2, 0x20, 0xbf, 0x96, 0x84, 0xa1, 0x60, 0xbe, 0x4e,
+		0x96, 0x3f, 0x15, 0x3a, 0x63, 0x49, 0x34, 0xa6, 0x3d, 0xd0, 0x36, 0xbb, 0x9f, 0x9e, 0x23, 0x53,
+		0x59, 0xa1, 0x9f, 0x25, 0xc7, 0x0a, 0xa3, 0x24, 0x69, 0x04, 0x71, 0x36, 0xcc, 0x92, 0x91, 0xb4,
+		0xc2, 0x4b, 0x68, 0x01, 0xfa, 0x5f, 0x74, 0x28, 0xef, 0x7e, 0xca, 0xfb, 0xc1, 0xf6, 0xbc, 0xaf,
+		0xae, 0x53, 0xe6, 0x99, 0xab, 0xeb, 0xe5, 0x95, 0x55, 0x65, 0x79, 0x76, 0x49, 0xe1, 0xe4, 0xe8,
+		0x10, 0xa4, 0x74, 0xf5, 0xa5, 0xbd, 0xf0, 0xa4, 0x47, 0x41, 0xdd, 0x76, 0xc2, 0x21, 0x48, 0x91,
+		0x0d, 0xba, 0xf0, 0x54, 0x43, 0x41, 0xf7, 0x71, 0x30, 0xcc, 0x40, 0x1f, 0xb5, 0x17, 0x02, 0xe0,
+		0x16, 0xcb, 0xee, 0x43, 0x69, 0x48, 0xcd, 0xad, 0x2a, 0x64, 0x40, 0x64, 0x61, 0x88, 0x41, 0xcb,
+		0x6b, 0x8b, 0xa5, 0xb9, 0x52, 0x36, 0x21, 0x9f, 0x87, 0x7e, 0x66, 0x04, 0x32, 0x58, 0x3c, 0x33,
+		0x64, 0xf7, 0xf1, 0x22, 0xe7, 0x21, 0x89, 0xda, 0xcd, 0xe5, 0x62, 0x49, 0xc9, 0x26, 0xc2, 0x5d,
+		0x9d, 0xca, 0xf6, 0xc9, 0x0e, 0x0c, 0x05, 0xf3, 0xf0, 0x1f, 0xce, 0x62, 0xfc, 0x73, 0x12, 0x0c,
+		0x06, 0xf2, 0x6a, 0x92, 0x10, 0xa9, 0xba, 0x6e, 0xde, 0x28, 0xab, 0xba, 0xa6, 0x3a, 0xdc, 0x35,
+		0x80, 0x82, 0x66, 0x09, 0xa4, 0xdb, 0xae, 0xfb, 0x21, 0x0d, 0x91, 0xbe, 0x6c, 0xbf, 0xfc, 0x61,
+		0x09, 0xb2, 0xd1, 0xc4, 0x36, 0x22, 0xa6, 0xf4, 0xa3, 0x14, 0x53, 0xfe, 0x90, 0x04, 0x23, 0xe1,
+		0x6c, 0x36, 0x22, 0xde, 0xf1, 0x1f, 0xa9, 0x78, 0x7f, 0x91, 0x80, 0xe1, 0x50, 0x0e, 0xdb, 0xad,
+		0x74, 0x6f, 0x85, 0x31, 0xad, 0x8a, 0xeb, 0x96, 0xe9, 0x92, 0xcd, 0xf3, 0xb2, 0x8e, 0xaf, 0x63,
+		0x3d, 0x27, 0xd3, 0xa0, 0x31, 0xd3, 0x3e, 0x4b, 0x9e, 0x5e, 0xf4, 0xe9, 0x96, 0x08, 0x59, 0x61,
+		0x7c, 0x71, 0xbe, 0xb4, 0xbc, 0xb6, 0xba, 0x51, 0x5a, 0x99, 0x7b, 0x53, 0x79, 0x73, 0xe5, 0xb9,
+		0x95, 0xd5, 0x37, 0xae, 0x28, 0x59, 0x2d, 0x82, 0x76, 0x1f, 0x87, 0xfd, 0x1a, 0x64, 0xa3, 0x42,
+		0xa1, 0x83, 0x10, 0x27, 0x56, 0x76, 0x1f, 0x1a, 0x87, 0xd1, 0x95, 0xd5, 0xf2, 0xfa, 0xe2, 0x7c,
+		0xa9, 0x5c, 0xba, 0x7c, 0xb9, 0x34, 0xb7, 0xb1, 0xce, 0xf6, 0x3d, 0x3c, 0xec, 0x8d, 0xd0, 0x00,
+		0x97, 0x3f, 0x98, 0x84, 0xf1, 0x18, 0x49, 0xd0, 0x2c, 0x5f, 0xb1, 0xb0, 0x45, 0xd4, 0x99, 0x6e,
+		0xa4, 0x9f, 0x26, 0x39, 0xc3, 0x9a, 0x6a, 0xbb, 0x7c, 0x81, 0xf3, 0x30, 0x10, 0x2b, 0x19, 0xae,
+		0xb6, 0xad, 0x61, 0x9b, 0xef, 0x27, 0xb1, 0x65, 0xcc, 0xa8, 0x0f, 0x67, 0x5b, 0x4a, 0x8f, 0x02,
+		0xb2, 0x4c, 0x47, 0x73, 0xb5, 0xeb, 0x64, 0x4b, 0x5e, 0x6c, 0x3e, 0x91, 0x65, 0x4d, 0x4a, 0xc9,
+		0x8a, 0x9a, 0x45, 0xc3, 0xf5, 0xb0, 0x0d, 0x5c, 0x53, 0x23, 0xd8, 0x24, 0x98, 0x27, 0x95, 0xac,
+		0xa8, 0xf1, 0xb0, 0x8f, 0xc3, 0x50, 0xd5, 0x6c, 0x90, 0x5c, 0x8f, 0xe1, 0x91, 0xb9, 0x43, 0x52,
+		0x06, 0x19, 0xcc, 0x43, 0xe1, 0x59, 0xbc, 0xbf, 0xeb, 0x35, 0xa4, 0x0c, 0x32, 0x18, 0x43, 0x39,
+		0x09, 0xa3, 0x6a, 0xad, 0x66, 0x13, 0xe6, 0x82, 0x11, 0x5b, 0x97, 0x8c, 0x78, 0x60, 0x8a, 0x98,
+		0xbf, 0x0a, 0x69, 0x61, 0x07, 0x32, 0x55, 0x13, 0x4b, 0x94, 0x2d, 0xb6, 0xd8, 0x4e, 0x90, 0x8d,
+		0x30, 0x43, 0x54, 0x1e, 0x87, 0x21, 0xcd, 0x29, 0xfb, 0x9b, 0xf8, 0x89, 0xa9, 0xc4, 0xa9, 0xb4,
+		0x32, 0xa8, 0x39, 0xde, 0x06, 0xa8, 0xfc, 0xd1, 0x04, 0x8c, 0x84, 0x0f, 0x21, 0xd0, 0x3c, 0xa4,
+		0x75, 0xb3, 0xa2, 0x52, 0xd7, 0x62, 0x27, 0x60, 0xa7, 0x3a, 0x9c, 0x5b, 0x4c, 0x2f, 0x71, 0x7c,
+		0xc5, 0xa3, 0xcc, 0xff, 0x89, 0x04, 0x69, 0x01, 0x46, 0x07, 0x20, 0x65, 0xa9, 0xee, 0x0e, 0x65,
+		0xd7, 0x57, 0x4c, 0x64, 0x25, 0x85, 0x96, 0x09, 0xdc, 0xb1, 0x54, 0x23, 0x97, 0xf0, 0xe1, 0xa4,
+		0x4c, 0xfa, 0x55, 0xc7, 0x6a, 0x95, 0x2e, 0x7a, 0xcc, 0x7a, 0x1d, 0x1b, 0xae, 0x23, 0xfa, 0x95,
+		0xc3, 0xe7, 0x38, 0x98, 0x9c, 0x85, 0xb9, 0xb6, 0xaa, 0xe9, 0x21, 0xdc, 0x14, 0xc5, 0xcd, 0x8a,
+		0x0a, 0x0f, 0xb9, 0x00, 0x87, 0x04, 0xdf, 0x2a, 0x76, 0xd5, 0xca, 0x0e, 0xae, 0xfa, 0x44, 0xfd,
+		0x74, 0x73, 0xe3, 0x20, 0x47, 0x98, 0xe7, 0xf5, 0x82, 0x56, 0xfe, 0xb2, 0x04, 0x63, 0x62, 0x99,
+		0x56, 0xf5, 0x8c, 0xb5, 0x0c, 0xa0, 0x1a, 0x86, 0xe9, 0x06, 0xcd, 0xd5, 0xec, 0xca, 0x4d, 0x74,
+		0xd3, 0xb3, 0x1e, 0x91, 0x12, 0x60, 0x90, 0xaf, 0x03, 0xf8, 0x35, 0x2d, 0xcd, 0x36, 0x09, 0x83,
+		0xfc, 0x84, 0x89, 0x1e, 0x53, 0xb2, 0x85, 0x3d, 0x30, 0x10, 0x59, 0xcf, 0x91, 0xed, 0x97, 0x2d,
+		0x5c, 0xd3, 0x0c, 0xbe, 0x6f, 0xcc, 0x0a, 0x62, 0xfb, 0x25, 0xe5, 0x6d, 0xbf, 0x14, 0x7f, 0x41,
+		0x82, 0xf1, 0x8a, 0x59, 0x8f, 0xca, 0x5b, 0xcc, 0x46, 0x76, 0x17, 0x9c, 0x2b, 0xd2, 0x0b, 0xcf,
+		0xd6, 0x34, 0x77, 0xa7, 0xb1, 0x35, 0x5d, 0x31, 0xeb, 0x33, 0x35, 0x53, 0x57, 0x8d, 0x9a, 0x7f,
+		0xce, 0x4a, 0x7f, 0x54, 0xce, 0xd4, 0xb0, 0x71, 0xa6, 0x66, 0x06, 0x4e, 0x5d, 0x2f, 0xfa, 0x3f,
+		0xff, 0xb7, 0x24, 0xfd, 0x6a, 0x22, 0xb9, 0xb0, 0x56, 0xfc, 0x58, 0x22, 0xbf, 0xc0, 0x9a, 0x5b,
+		0x13, 0xe6, 0x51, 0xf0, 0xb6, 0x8e, 0x2b, 0x44, 0x65, 0xf8, 0xe6, 0x23, 0x30, 0x51, 0x33, 0x6b,
+		0x26, 0xe5, 0x38, 0x43, 0x7e, 0xf1, 0x93, 0xdb, 0x8c, 0x07, 0xcd, 0x77, 0x3c, 0xe6, 0x2d, 0xac,
+		0xc0, 0x38, 0x47, 0x2e, 0xd3, 0xa3, 0x23, 0xb6, 0xb0, 0x41, 0x6d, 0x77, 0xd5, 0x72, 0xbf, 0xfd,
+		0x75, 0x3a, 0xa1, 0x2b, 0x63, 0x9c, 0x94, 0xd4, 0xb1, 0xb5, 0x4f, 0x41, 0x81, 0xfd, 0x21, 0x7e,
+		0x6c, 0xd8, 0x62, 0xbb, 0x03, 0xc7, 0x3f, 0xe4, 0x1c, 0xc7, 0x03, 0x1c, 0xd7, 0x39, 0x69, 0x61,
+		0x0e, 0x86, 0x7b, 0xe1, 0xf5, 0xef, 0x39, 0xaf, 0x21, 0x1c, 0x64, 0xb2, 0x00, 0xa3, 0x94, 0x49,
+		0xa5, 0xe1, 0xb8, 0x66, 0x9d, 0xc6, 0xc4, 0xf6, 0x6c, 0xfe, 0xe8, 0xeb, 0x6c, 0x1c, 0x8d, 0x10,
+		0xb2, 0x39, 0x8f, 0xaa, 0x50, 0x00, 0x7a, 0x5a, 0x46, 0x4e, 0xb1, 0x3a, 0x70, 0xf8, 0x3c, 0x17,
+		0xc4, 0xc3, 0x2f, 0x5c, 0x83, 0x09, 0xf2, 0x9b, 0x86, 0xac, 0xa0, 0x24, 0x9d, 0xb7, 0xe0, 0x72,
+		0x5f, 0x7e, 0x27, 0x1b, 0xaa, 0xe3, 0x1e, 0x83, 0x80, 0x4c, 0x81, 0x5e, 0xac, 0x61, 0xd7, 0xc5,
+		0xb6, 0x53, 0x56, 0xf5, 0x38, 0xf1, 0x02, 0x7b, 0x18, 0xb9, 0x5f, 0xf9, 0x76, 0xb8, 0x17, 0x17,
+		0x18, 0xe5, 0xac, 0xae, 0x17, 0x36, 0xe1, 0x60, 0x8c, 0x57, 0x74, 0xc1, 0xf3, 0x83, 0x9c, 0xe7,
+		0x44, 0x93, 0x67, 0x10, 0xb6, 0x6b, 0x20, 0xe0, 0x5e, 0x5f, 0x76, 0xc1, 0xf3, 0x9f, 0x70, 0x9e,
+		0x88, 0xd3, 0x8a, 0x2e, 0x25, 0x1c, 0xaf, 0xc2, 0xd8, 0x75, 0x6c, 0x6f, 0x99, 0x0e, 0xdf, 0x37,
+		0xea, 0x82, 0xdd, 0x87, 0x38, 0xbb, 0x51, 0x4e, 0x48, 0x37, 0x92, 0x08, 0xaf, 0x0b, 0x90, 0xde,
+		0x56, 0x2b, 0xb8, 0x0b, 0x16, 0xb7, 0x38, 0x8b, 0x01, 0x82, 0x4f, 0x48, 0x67, 0x61, 0xa8, 0x66,
+		0xf2, 0x59, 0xab, 0x33, 0xf9, 0x87, 0x39, 0xf9, 0xa0, 0xa0, 0xe1, 0x2c, 0x2c, 0xd3, 0x6a, 0xe8,
+		0x64, 0x4a, 0xeb, 0xcc, 0xe2, 0x9f, 0x0a, 0x16, 0x82, 0x86, 0xb3, 0xe8, 0xc1, 0xac, 0xaf, 0x0a,
+		0x16, 0x4e, 0xc0, 0x9e, 0x97, 0xc8, 0x71, 0x92, 0xbe, 0x67, 0x1a, 0xdd, 0x08, 0xf1, 0x11, 0xce,
+		0x01, 0x38, 0x09, 0x61, 0x70, 0x11, 0x32, 0xdd, 0x76, 0xc4, 0xaf, 0x7f, 0x5b, 0x0c, 0x0f, 0xd1,
+		0x03, 0x0b, 0x30, 0x2a, 0x02, 0x14, 0x39, 0x7e, 0xee, 0xcc, 0xe2, 0x9f, 0x73, 0x16, 0x23, 0x01,
+		0x32, 0xae, 0x86, 0x8b, 0x1d, 0xb7, 0x86, 0xbb, 0x61, 0xf2, 0x51, 0xa1, 0x06, 0x27, 0xe1, 0xa6,
+		0xdc, 0xc2, 0x46, 0x65, 0xa7, 0x3b, 0x0e, 0xbf, 0x21, 0x4c, 0x29, 0x68, 0x08, 0x8b, 0x39, 0x18,
+		0xae, 0xab, 0xb6, 0xb3, 0xa3, 0xea, 0x5d, 0x75, 0xc7, 0xbf, 0xe0, 0x3c, 0x86, 0x3c, 0x22, 0x6e,
+		0x91, 0x86, 0xd1, 0x0b, 0x9b, 0x8f, 0x09, 0x8b, 0x34, 0x8c, 0x10, 0xa3, 0x35, 0x98, 0x70, 0x5c,
+		0xba, 0xc9, 0xd6, 0x0b, 0xb7, 0x7f, 0x29, 0x86, 0x1e, 0xa3, 0x5d, 0x0e, 0x72, 0xbc, 0x08, 0x19,
+		0x47, 0x7b, 0xa9, 0x2b, 0x36, 0xbf, 0x29, 0x7a, 0x9a, 0x12, 0x10, 0xe2, 0x37, 0xc1, 0xa1, 0xd8,
+		0x69, 0xa2, 0x0b, 0x66, 0xff, 0x8a, 0x33, 0x3b, 0x10, 0x33, 0x55, 0xf0, 0x90, 0xd0, 0x2b, 0xcb,
+		0x8f, 0x8b, 0x90, 0x80, 0x23, 0xbc, 0xd6, 0xc8, 0x3a, 0xc2, 0x51, 0xb7, 0x7b, 0xb3, 0xda, 0x6f,
+		0x09, 0xab, 0x31, 0xda, 0x90, 0xd5, 0x36, 0xe0, 0x00, 0xe7, 0xd8, 0x5b, 0xbf, 0x7e, 0x42, 0x04,
+		0x56, 0x46, 0xbd, 0x19, 0xee, 0xdd, 0x37, 0x43, 0xde, 0x33, 0xa7, 0x48, 0x58, 0x9d, 0x32, 0xd9,
+		0x99, 0xea, 0xcc, 0xf9, 0xb7, 0x39, 0x67, 0x11, 0xf1, 0xbd, 0x8c, 0xd7, 0x59, 0x56, 0x2d, 0xc2,
+		0xfc, 0x79, 0xc8, 0x09, 0xe6, 0x0d, 0xc3, 0xc6, 0x15, 0xb3, 0x66, 0x68, 0x2f, 0xe1, 0x6a, 0x17,
+		0xac, 0xff, 0x75, 0xa4, 0xab, 0x36, 0x03, 0xe4, 0x84, 0xf3, 0x22, 0x64, 0xbd, 0x5c, 0xa5, 0xac,
+		0xd5, 0x2d, 0xd3, 0x76, 0x3b, 0x70, 0xfc, 0x37, 0xa2, 0xa7, 0x3c, 0xba, 0x45, 0x4a, 0x56, 0x28,
+		0x01, 0x3b, 0x79, 0xee, 0xd6, 0x25, 0x3f, 0xc9, 0x19, 0x0d, 0xfb, 0x54, 0x3c, 0x70, 0x54, 0xcc,
+		0xba, 0xa5, 0xda, 0xdd, 0xc4, 0xbf, 0x7f, 0x2b, 0x02, 0x07, 0x27, 0xe1, 0x81, 0x83, 0xec, 0x6a,
+		0x91, 0xd9, 0xbe, 0x0b, 0x0e, 0x9f, 0x12, 0x81, 0x43, 0xd0, 0x70, 0x16, 0x22, 0x61, 0xe8, 0x82,
+		0xc5, 0xef, 0x08, 0x16, 0x82, 0x86, 0xb0, 0x78, 0x83, 0x3f, 0xd1, 0xda, 0xb8, 0xa6, 0x39, 0xae,
+		0xcd, 0xd2, 0xe4, 0xf6, 0xac, 0x3e, 0xfd, 0xed, 0x70, 0x12, 0xa6, 0x04, 0x48, 0x49, 0x24, 0xe2,
+		0xdb, 0xae, 0x74, 0x15, 0xd5, 0x59, 0xb0, 0xcf, 0x88, 0x48, 0x14, 0x20, 0x23, 0xb2, 0x05, 0x32,
+		0x44, 0x62, 0xf6, 0x0a, 0x59, 0x3b, 0x74, 0xc1, 0xee, 0xdf, 0x45, 0x84, 0x5b, 0x17, 0xb4, 0x84,
+		0x67, 0x20, 0xff, 0x69, 0x18, 0xbb, 0x78, 0xaf, 0x2b, 0xef, 0xfc, 0xdd, 0x48, 0xfe, 0xb3, 0xc9,
+		0x28, 0x59, 0x0c, 0x19, 0x8d, 0xe4, 0x53, 0xa8, 0xd3, 0x3d, 0xa3, 0xdc, 0x4f, 0x7f, 0x97, 0xeb,
+		0x1b, 0x4e, 0xa7, 0x0a, 0x4b, 0x90, 0xe5, 0x10, 0x3f, 0x81, 0xed, 0xc8, 0xec, 0x9d, 0xdf, 0xf5,
+		0xfc, 0x3c, 0x94, 0xf3, 0x14, 0x2e, 0xc3, 0x70, 0x28, 0xe1, 0xe9, 0xcc, 0xea, 0x67, 0x38, 0xab,
+		0xa1, 0x60, 0xbe, 0x53, 0x38, 0x0f, 0x29, 0x92, 0xbc, 0x74, 0x26, 0xff, 0x59, 0x4e, 0x4e, 0xd1,
+		0x0b, 0xcf, 0x40, 0x5a, 0x24, 0x2d, 0x9d, 0x49, 0xdf, 0xc5, 0x49, 0x3d, 0x12, 0x42, 0x2e, 0x12,
+		0x96, 0xce, 0xe4, 0xff, 0x40, 0x90, 0x0b, 0x12, 0x42, 0xde, 0xbd, 0x09, 0x3f, 0xf7, 0x73, 0x29,
+		0x46, 0x2e, 0x48, 0x0a, 0xe4, 0xe4, 0x9b, 0x65, 0x2a, 0x9d, 0xa9, 0xdf, 0xc3, 0x1b, 0x17, 0x14,
+		0x85, 0xa7, 0xa0, 0xaf, 0x4b, 0x83, 0xff, 0x23, 0x4e, 0xca, 0xf0, 0x0b, 0x73, 0x30, 0x18, 0xc8,
+		0x4e, 0x3a, 0x93, 0xff, 0x3c, 0x27, 0x0f, 0x52, 0x11, 0xd1, 0x79, 0x76, 0xd2, 0x99, 0xc1, 0x2f,
+		0x08, 0xd1, 0x39, 0x05, 0x31, 0x9b, 0x48, 0x4c, 0x3a, 0x53, 0xbf, 0x57, 0x58, 0x5d, 0x90, 0x14,
+		0x2e, 0x41, 0xc6, 0x9b, 0x6c, 0x3a, 0xd3, 0xbf, 0x8f, 0xd3, 0xfb, 0x34, 0xc4, 0x02, 0x0d, 0xa3,
+		0x07, 0x16, 0xbf, 0x28, 0x2c, 0x10, 0xa0, 0x22, 0xc3, 0x28, 0x9a, 0xc0, 0x74, 0xe6, 0xf4, 0x7e,
+		0x31, 0x8c, 0x22, 0xf9, 0x0b, 0xe9, 0x4d, 0x1a, 0xf3, 0x3b, 0xb3, 0xf8, 0x25, 0xd1, 0x9b, 0x14,
+		0x9f, 0x88, 0x11, 0xcd, 0x08, 0x3a, 0xf3, 0xf8, 0x65, 0x21, 0x46, 0x24, 0x21, 0x28, 0xac, 0x01,
+		0x6a, 0xce, 0x06, 0x3a, 0xf3, 0xfb, 0x00, 0xe7, 0x37, 0xd6, 0x94, 0x0c, 0x14, 0xde, 0x08, 0x07,
+		0xe2, 0x33, 0x81, 0xce, 0x5c, 0x7f, 0xe5, 0xbb, 0x91, 0xb5, 0x5b, 0x30, 0x11, 0x28, 0x6c, 0xc0,
+		0x44, 0x5c, 0x16, 0xd0, 0x99, 0xed, 0x07, 0xbf, 0x1b, 0x0e, 0xdc, 0xc1, 0x24, 0xa0, 0x30, 0x0b,
+		0xe0, 0x4f, 0xc0, 0x9d, 0x79, 0x7d, 0x88, 0xf3, 0x0a, 0x10, 0x91, 0xa1, 0xc1, 0xe7, 0xdf, 0xce,
+		0xf4, 0xb7, 0xc4, 0xd0, 0xe0, 0x14, 0x64, 0x68, 0x88, 0xa9, 0xb7, 0x33, 0xf5, 0x87, 0xc5, 0xd0,
+		0x10, 0x24, 0xc4, 0xb3, 0x03, 0xb3, 0x5b, 0x67, 0x0e, 0x1f, 0x11, 0x9e, 0x1d, 0xa0, 0x2a, 0xac,
+		0xc0, 0x58, 0xd3, 0x84, 0xd8, 0x99, 0xd5, 0xaf, 0x72, 0x56, 0xd9, 0xe8, 0x7c, 0x18, 0x9c, 0xbc,
+		0xf8, 0x64, 0xd8, 0x99, 0xdb, 0xaf, 0x45, 0x26, 0x2f, 0x3e, 0x17, 0x16, 0x2e, 0x42, 0xda, 0x68,
+		0xe8, 0x3a, 0x19, 0x3c, 0xa8, 0xfd, 0xdd, 0xc0, 0xdc, 0xff, 0xf8, 0x3e, 0xb7, 0x8e, 0x20, 0x28,
+		0x9c, 0x87, 0x3e, 0x5c, 0xdf, 0xc2, 0xd5, 0x4e, 0x94, 0xdf, 0xfc, 0xbe, 0x08, 0x98, 0x04, 0xbb,
+		0x70, 0x09, 0x80, 0x6d, 0x8d, 0xd0, 0xe3, 0xc1, 0x0e, 0xb4, 0xdf, 0xfa, 0x3e, 0xbf, 0x8c, 0xe3,
+		0x93, 0xf8, 0x0c, 0xd8, 0xd5, 0x9e, 0xf6, 0x0c, 0xbe, 0x1d, 0x66, 0x40, 0x7b, 0xe4, 0x02, 0x0c,
+		0x90, 0x2b, 0x92, 0xae, 0x5a, 0xeb, 0x44, 0xfd, 0x97, 0x9c, 0x5a, 0xe0, 0x13, 0x83, 0xd5, 0x4d,
+		0x1b, 0xbb, 0x6a, 0xcd, 0xe9, 0x44, 0xfb, 0x57, 0x9c, 0xd6, 0x23, 0x20, 0xc4, 0x15, 0xd5, 0x71,
+		0xbb, 0xd1, 0xfb, 0xaf, 0x05, 0xb1, 0x20, 0x20, 0x42, 0x93, 0xdf, 0xbb, 0x78, 0xaf, 0x13, 0xed,
+		0x77, 0x84, 0xd0, 0x1c, 0xbf, 0xf0, 0x0c, 0x64, 0xc8, 0x4f, 0x76, 0xc3, 0xae, 0x03, 0xf1, 0xff,
+		0xe4, 0xc4, 0x3e, 0x05, 0x69, 0xd9, 0x71, 0xab, 0xae, 0xd6, 0xd9, 0xd8, 0x77, 0x78, 0x4f, 0x0b,
+		0xfc, 0xc2, 0x2c, 0x0c, 0x3a, 0x6e, 0xb5, 0xda, 0xe0, 0xf9, 0x69, 0x07, 0xf2, 0xbf, 0xf9, 0xbe,
+		0xb7, 0x65, 0xe1, 0xd1, 0x90, 0xde, 0xbe, 0xb1, 0xeb, 0x5a, 0x26, 0x3d, 0x02, 0xe9, 0xc4, 0xe1,
+		0xbb, 0x9c, 0x43, 0x80, 0xa4, 0x30, 0x07, 0x43, 0x44, 0x17, 0x1b, 0x5b, 0x98, 0x9e, 0x57, 0x75,
+		0x60, 0xf1, 0x3d, 0x6e, 0x80, 0x10, 0x51, 0xf1, 0x2d, 0x9f, 0x7f, 0xed, 0x98, 0xf4, 0xa5, 0xd7,
+		0x8e, 0x49, 0x7f, 0xf1, 0xda, 0x31, 0xe9, 0xbd, 0x5f, 0x3b, 0xb6, 0xef, 0x4b, 0x5f, 0x3b, 0xb6,
+		0xef, 0xcf, 0xbe, 0x76, 0x6c, 0x5f, 0xfc, 0xb6, 0x31, 0x2c, 0x98, 0x0b, 0x26, 0xdb, 0x30, 0x7e,
+		0x41, 0x0e, 0x6d, 0x17, 0xd7, 0x4c, 0x7f, 0xb7, 0xd6, 0x5b, 0xe4, 0xc0, 0x3b, 0x92, 0x70, 0xa8,
+		0x62, 0x3a, 0x75, 0xd3, 0x29, 0xb3, 0xfd, 0x5e, 0x56, 0x60, 0x0c, 0xd1, 0x50, 0xb0, 0xaa, 0x8b,
+		0x4d, 0xdf, 0x2b, 0x30, 0x42, 0x55, 0xa7, 0xdb, 0x5d, 0xd4, 0xdb, 0x3a, 0x06, 0x88, 0x3f, 0xfe,
+		0x4f, 0x7d, 0x54, 0xeb, 0x61, 0x8f, 0x90, 0x9e, 0xe7, 0x6f, 0xc0, 0x84, 0x56, 0xb7, 0x74, 0x4c,
+		0x37, 0xfe, 0xcb, 0x5e, 0x5d, 0x67, 0x7e, 0x5f, 0xe0, 0xfc, 0xc6, 0x7d, 0xf2, 0x45, 0x41, 0x5d,
+		0x58, 0x82, 0x31, 0x72, 0x67, 0xc3, 0x0a, 0xb1, 0xec, 0xd0, 0x2d, 0x42, 0xc0, 0x2c, 0xa7, 0xf4,
+		0xb8, 0x15, 0x2f, 0xb5, 0xea, 0x9a, 0x17, 0x1e, 0x0a, 0x58, 0xde, 0xc6, 0x64, 0x4f, 0xde, 0xc0,
+		0xee, 0x0d, 0xd3, 0xde, 0xe5, 0xe6, 0x3d, 0xc3, 0x9a, 0xea, 0xa7, 0x7f, 0x9e, 0x80, 0xff, 0x93,
+		0x80, 0xc3, 0xfa, 0x56, 0x7d, 0x66, 0x4b, 0x75, 0xf0, 0xcc, 0xf5, 0xc7, 0xb7, 0xb0, 0xab, 0x3e,
+		0x3e, 0x53, 0x31, 0x35, 0x83, 0x77, 0x43, 0x56, 0xdf, 0xaa, 0x4f, 0x93, 0xca, 0x69, 0x5e, 0x99,
+		0x8f, 0xdd, 0xa0, 0x97, 0x17, 0x20, 0x35, 0x67, 0x6a, 0x06, 0x39, 0x7b, 0xa8, 0x62, 0xc3, 0xac,
+		0xf3, 0xfb, 0x77, 0xac, 0x80, 0x1e, 0x80, 0x7e, 0xb5, 0x6e, 0x36, 0x0c, 0x97, 0x9d, 0x56, 0x14,
+		0x07, 0x3f, 0x7f, 0x7b, 0x72, 0xdf, 0x57, 0x6e, 0x4f, 0x26, 0x17, 0x0d, 0x57, 0xe1, 0x55, 0x85,
+		0xd4, 0x37, 0x5e, 0x9d, 0x94, 0xe4, 0xab, 0x30, 0x30, 0x8f, 0x2b, 0x77, 0xc3, 0x6b, 0x1e, 0x57,
+		0x22, 0xbc, 0x1e, 0x86, 0xf4, 0xa2, 0xe1, 0xb2, 0x1b, 0x92, 0x47, 0x21, 0xa9, 0x19, 0xec, 0xd2,
+		0x4d, 0xa4, 0x7d, 0x02, 0x27, 0xa8, 0xf3, 0xb8, 0xe2, 0xa1, 0x56, 0x71, 0x25, 0x27, 0x35, 0xb3,
+		0x27, 0xf0, 0xe2, 0xa5, 0x3f, 0xfb, 0xaf, 0xc7, 0xf6, 0xbd, 0xfc, 0xda, 0xb1, 0x7d, 0x2d, 0xfb,
+		0xe0, 0x68, 0xa0, 0x0f, 0x74, 0xcd, 0xc0, 0x33, 0xfa, 0x56, 0xfd, 0x8c, 0x53, 0xdd, 0x9d, 0x21,
+		0x7e, 0xe9, 0x78, 0xb6, 0xff, 0x78, 0x3f, 0x4c, 0x12, 0xdb, 0x3b, 0xae, 0xba, 0xab, 0x19, 0x35,
+		0xcf, 0xfc, 0x6a, 0xc3, 0xdd, 0x79, 0x89, 0xdb, 0x7f, 0x9c, 0xd8, 0x9f, 0x23, 0xb4, 0xef, 0x82,
+		0x7c, 0xeb, 0xc1, 0x94, 0x6f, 0xd7, 0xc5, 0xf2, 0xef, 0x26, 0x01, 0xad, 0xbb, 0xea, 0x2e, 0x9e,
+		0x6d, 0xb8, 0x3b, 0xa6, 0xad, 0xbd, 0xc4, 0xe2, 0xd6, 0x5b, 0x00, 0xea, 0xea, 0xcd, 0xb2, 0x6b,
+		0xee, 0x62, 0xc3, 0xa1, 0xc6, 0x18, 0x3c, 0x7b, 0x60, 0x3a, 0xea, 0x0e, 0xd3, 0xa4, 0xa7, 0x8a,
+		0x27, 0x3e, 0xf6, 0xd5, 0x49, 0xb9, 0xad, 0xd2, 0x14, 0x8f, 0x24, 0xd0, 0x37, 0x37, 0x28, 0x43,
+		0xb4, 0x0e, 0xec, 0x6a, 0x45, 0x59, 0xd7, 0x1c, 0x97, 0xdf, 0xce, 0x3e, 0x3b, 0x1d, 0xa3, 0xed,
+		0x74, 0xb3, 0x6c, 0xd3, 0xd7, 0x54, 0x5d, 0xab, 0xaa, 0xae, 0x69, 0x3b, 0x57, 0xf6, 0x29, 0x19,
+		0xca, 0x67, 0x49, 0x73, 0x5c, 0xf4, 0x06, 0xc8, 0x54, 0xb1, 0xb1, 0xc7, 0x78, 0x26, 0x7f, 0x00,
+		0x9e, 0x69, 0xc2, 0x86, 0xb2, 0xdc, 0x04, 0xa4, 0x06, 0xf1, 0xc4, 0x43, 0x24, 0x72, 0xd4, 0x7f,
+		0x22, 0x96, 0x77, 0x88, 0x2d, 0x7d, 0x34, 0x31, 0xa6, 0x46, 0x41, 0xf9, 0x13, 0x00, 0x7e, 0x83,
+		0xe4, 0x31, 0xa0, 0x5a, 0xad, 0xda, 0xd8, 0x71, 0xe8, 0x69, 0x5f, 0x46, 0x11, 0xc5, 0xc2, 0xd8,
+		0x7f, 0xf8, 0xe4, 0x99, 0xe1, 0x10, 0xc7, 0xe2, 0x10, 0xc0, 0x75, 0x8f, 0xf4, 0xf4, 0x87, 0x25,
+		0x18, 0x6b, 0x6a, 0x11, 0xc9, 0x70, 0x6c, 0x76, 0x73, 0xe3, 0xca, 0xaa, 0xb2, 0xf8, 0xc2, 0x2c,
+		0xb9, 0x61, 0x5f, 0x66, 0xf7, 0xfb, 0x57, 0xd6, 0xd7, 0x4a, 0x73, 0x8b, 0x97, 0x17, 0x4b, 0xf3,
+		0xd9, 0x7d, 0x68, 0x12, 0x0e, 0xc7, 0xe0, 0xcc, 0x97, 0x96, 0x4a, 0x0b, 0xb3, 0x1b, 0xe4, 0x35,
+		0xc3, 0x71, 0x38, 0x1a, 0xcb, 0xc4, 0x43, 0x49, 0xb4, 0x40, 0x51, 0x4a, 0x1e, 0x4a, 0xb2, 0x38,
+		0xdb, 0x72, 0x98, 0x9c, 0x6c, 0xe5, 0x31, 0x37, 0xbd, 0x21, 0x11, 0x1e, 0x30, 0xdf, 0x93, 0xe0,
+		0x10, 0x0b, 0x98, 0xfe, 0x44, 0xa0, 0x1a, 0x7b, 0x2d, 0x5e, 0x77, 0xb6, 0x88, 0x54, 0x4f, 0x43,
+		0x72, 0xd6, 0xd8, 0x43, 0x87, 0x58, 0x96, 0x5c, 0x6e, 0xd8, 0x3a, 0x8f, 0x2f, 0x03, 0xa4, 0xbc,
+		0x69, 0xeb, 0x24, 0xee, 0x88, 0x0b, 0xfd, 0xe4, 0x98, 0x9e, 0x15, 0x0a, 0xa9, 0xef, 0x7c, 0x64,
+		0x72, 0x5f, 0x71, 0x37, 0xaa, 0xcd, 0xe7, 0x3a, 0xce, 0x8b, 0xe9, 0x59, 0x63, 0x8f, 0x06, 0x96,
+		0x35, 0xe9, 0x85, 0x3e, 0xaa, 0x90, 0x38, 0x0a, 0x3d, 0x16, 0x3d, 0x0a, 0x7d, 0x23, 0xd6, 0xf5,
+		0xe7, 0x0c, 0xf3, 0x86, 0xb1, 0x11, 0xd2, 0xfb, 0x1f, 0x26, 0xe0, 0x58, 0xd3, 0x04, 0xc8, 0x73,
+		0x85, 0x56, 0x4f, 0x5b, 0x0b, 0x90, 0x9e, 0xe7, 0x28, 0xc4, 0xbd, 0x1c, 0x5c, 0x31, 0x8d, 0x2a,
+		0x1b, 0xc7, 0x49, 0x45, 0x14, 0x89, 0xaa, 0x86, 0x6a, 0x98, 0x0e, 0xbf, 0x4f, 0xcf, 0x0a, 0xc5,
+		0x5f, 0x92, 0x7a, 0x9b, 0xf9, 0x87, 0x45, 0x4b, 0x42, 0xcd, 0xd3, 0xed, 0x4e, 0x8b, 0xa9, 0x09,
+		0x3c, 0xf9, 0x03, 0x27, 0xc3, 0xdd, 0x9a, 0xe3, 0xbd, 0x09, 0x98, 0x8c, 0x9a, 0x83, 0x64, 0x5e,
+		0x8e, 0xab, 0xd6, 0xad, 0x56, 0xf6, 0xb8, 0x08, 0x99, 0x0d, 0x81, 0xd3, 0xb3, 0x41, 0xfe, 0x71,
+		0x8f, 0x06, 0x19, 0xf1, 0x9a, 0x12, 0x16, 0x79, 0xa4, 0xb3, 0x45, 0x3c, 0x15, 0xee, 0xc2, 0x24,
+		0x1f, 0x48, 0xc1, 0x61, 0xd3, 0x71, 0x6d, 0xb5, 0x62, 0x1a, 0x33, 0x15, 0x7b, 0xcf, 0x72, 0x69,
+		0xca, 0x65, 0x6e, 0x0b, 0x73, 0x88, 0xca, 0x69, 0x56, 0xd9, 0x62, 0x6c, 0x6c, 0x43, 0xdf, 0x1a,
+		0xa1, 0x22, 0x66, 0x70, 0x4d, 0x57, 0xd5, 0xb9, 0x79, 0x58, 0x81, 0x40, 0xd9, 0x93, 0xac, 0x04,
+		0x83, 0x6a, 0xe2, 0x35, 0x96, 0x8e, 0xd5, 0x6d, 0x76, 0xb3, 0x3d, 0x49, 0x87, 0x4c, 0x9a, 0x00,
+		0xe8, 0x25, 0xf6, 0x09, 0xe8, 0x53, 0x1b, 0xec, 0x52, 0x46, 0x92, 0x8c, 0x25, 0x5a, 0x90, 0x17,
+		0x61, 0x80, 0x1f, 0x04, 0x93, 0x6b, 0x09, 0xbb, 0x78, 0x8f, 0xb6, 0x33, 0xa4, 0x90, 0x9f, 0xe8,
+		0x51, 0xe8, 0xa3, 0xa2, 0xf3, 0x49, 0xe1, 0xc0, 0x74, 0x44, 0xf6, 0x69, 0x2a, 0xa2, 0xc2, 0x90,
+		0xe4, 0xab, 0x90, 0x9e, 0x37, 0xeb, 0x9a, 0x61, 0x86, 0x79, 0x65, 0x18, 0x2f, 0x2a, 0xb1, 0xd5,
+		0xe0, 0xb9, 0x82, 0xc2, 0x0a, 0xe4, 0xfe, 0x27, 0x7b, 0xe7, 0xc0, 0xaf, 0x95, 0xf0, 0x92, 0x3c,
+		0x07, 0x03, 0x94, 0xf7, 0xaa, 0x45, 0x1e, 0x54, 0x78, 0x97, 0x4c, 0x33, 0xfc, 0xd5, 0x1b, 0x67,
+		0x9f, 0xf0, 0x45, 0x45, 0x90, 0xaa, 0xaa, 0xae, 0xca, 0xb5, 0xa6, 0xbf, 0xe5, 0xa7, 0x21, 0xcd,
+		0x99, 0x90, 0x87, 0x10, 0x49, 0xd3, 0x72, 0xf8, 0xc5, 0x90, 0x5c, 0xbc, 0x22, 0xab, 0x56, 0x31,
+		0x45, 0x72, 0x0c, 0x85, 0xa0, 0x16, 0xaf, 0xb4, 0x8c, 0x96, 0xd3, 0xd1, 0x68, 0xe9, 0xf5, 0x37,
+		0xeb, 0xc6, 0x48, 0xf7, 0x7b, 0xae, 0xf1, 0xfe, 0x04, 0x1c, 0xf1, 0xea, 0xae, 0x63, 0x9b, 0xec,
+		0x7e, 0xf0, 0x39, 0x99, 0xa7, 0x78, 0x9e, 0x58, 0xbc, 0xb6, 0x85, 0x73, 0x3c, 0x03, 0xc9, 0x59,
+		0xcb, 0x22, 0xcf, 0xfb, 0x68, 0xb9, 0x62, 0x32, 0xef, 0x48, 0x29, 0x5e, 0x99, 0xd4, 0x39, 0xe6,
+		0xb6, 0x7b, 0x43, 0xb5, 0xbd, 0xa7, 0x7f, 0xa2, 0x2c, 0x5f, 0x80, 0xcc, 0x9c, 0x69, 0x38, 0xd8,
+		0x70, 0x1a, 0x74, 0x98, 0x6d, 0xe9, 0x66, 0x65, 0x97, 0x73, 0x60, 0x05, 0x62, 0x60, 0xd5, 0xb2,
+		0x28, 0x65, 0x4a, 0x21, 0x3f, 0x59, 0x1e, 0x57, 0x5c, 0x6c, 0x69, 0x94, 0x99, 0x6e, 0x8d, 0xc2,
+		0x55, 0xf3, 0xac, 0xf2, 0xd7, 0x12, 0xe4, 0xa3, 0x03, 0x66, 0x17, 0xef, 0x39, 0xbd, 0x8d, 0x97,
+		0xe7, 0x21, 0xb3, 0x46, 0x5f, 0xdc, 0x3f, 0x87, 0xf7, 0x50, 0x1e, 0x06, 0x70, 0xf5, 0xec, 0xf9,
+		0xf3, 0x8f, 0x5f, 0x60, 0xde, 0x7c, 0x65, 0x9f, 0x22, 0x00, 0xe8, 0x18, 0x64, 0x1c, 0x5c, 0xb1,
+		0xce, 0x9e, 0x7f, 0x72, 0xf7, 0x71, 0xe6, 0x40, 0x24, 0x71, 0xf1, 0x40, 0x85, 0x34, 0xd1, 0xf3,
+		0x1b, 0x1f, 0x99, 0x94, 0x8a, 0x7d, 0x90, 0x74, 0x1a, 0xf5, 0xfb, 0xe0, 0x07, 0xef, 0xe8, 0x83,
+		0x63, 0x5e, 0x1d, 0x8b, 0x38, 0x5e, 0x1a, 0xc1, 0xb5, 0x1e, 0xf1, 0xb4, 0xa6, 0xf5, 0x2d, 0xf2,
+		0xcc, 0x36, 0x76, 0x93, 0x3f, 0x2e, 0xc1, 0x90, 0x97, 0xd7, 0x90, 0x8f, 0x29, 0x5c, 0x08, 0x26,
+		0x2b, 0x7c, 0x20, 0x1c, 0x9a, 0x0e, 0xb7, 0xe3, 0xa7, 0x5e, 0x4a, 0x00, 0x19, 0x9d, 0xa7, 0x8e,
+		0x66, 0x99, 0x0e, 0x7f, 0xee, 0xd5, 0x96, 0xd0, 0x43, 0x25, 0x97, 0xf7, 0x68, 0xb4, 0x2a, 0x5f,
+		0x37, 0x5d, 0x72, 0x77, 0xc1, 0x32, 0x6f, 0xf0, 0x27, 0xb4, 0x49, 0x25, 0x4b, 0x6b, 0xae, 0xd1,
+		0x8a, 0x35, 0x02, 0x97, 0x3f, 0x21, 0x41, 0xc6, 0xe3, 0x12, 0xce, 0xc3, 0xc8, 0x90, 0x16, 0x45,
+		0xf2, 0x6a, 0xcb, 0x6a, 0x6c, 0x95, 0xc5, 0xf8, 0x1f, 0x3c, 0x9b, 0x6f, 0x1e, 0xcd, 0xc2, 0x13,
+		0xf8, 0x78, 0xee, 0xb7, 0x1a, 0x5b, 0xc4, 0x2f, 0x8e, 0xc3, 0x50, 0x8c, 0x28, 0x83, 0xd7, 0x7d,
+		0x29, 0xe8, 0x27, 0x1d, 0xb8, 0xfc, 0x65, 0xcb, 0xd6, 0x4c, 0x5b, 0x73, 0xf7, 0x68, 0x8e, 0x99,
+		0x54, 0xb2, 0xa2, 0x62, 0x8d, 0xc3, 0x65, 0x0d, 0x46, 0xd7, 0xe9, 0x0a, 0xd3, 0x97, 0xfb, 0x09,
+		0x5f, 0x3a, 0xa9, 0x93, 0x74, 0x2d, 0xe5, 0x4a, 0x34, 0xc9, 0x55, 0x5c, 0x68, 0xe9, 0x85, 0x67,
+		0xba, 0xf5, 0xc2, 0x70, 0x06, 0xf7, 0x3b, 0x87, 0x20, 0x1f, 0xae, 0x0a, 0x85, 0xa2, 0xee, 0x1c,
+		0xb0, 0xd3, 0xe4, 0x9f, 0x6f, 0x37, 0x15, 0xe6, 0xdb, 0x06, 0xc3, 0x7c, 0x87, 0x21, 0x22, 0x5f,
+		0x80, 0x61, 0x72, 0xb9, 0x72, 0x1d, 0xbb, 0x57, 0xb0, 0x5a, 0xc5, 0x76, 0x78, 0xa6, 0x1c, 0x16,
+		0x33, 0x25, 0x82, 0x14, 0x9d, 0x0e, 0xd9, 0x5c, 0x41, 0x7f, 0xcb, 0xdb, 0x90, 0x22, 0xa4, 0xfe,
+		0x2c, 0xca, 0x29, 0x68, 0x81, 0x40, 0xb7, 0xf6, 0x5c, 0xec, 0x88, 0xa4, 0x93, 0x16, 0xd0, 0x59,
+		0x31, 0x17, 0x26, 0xdb, 0xcd, 0x85, 0xdc, 0xe1, 0xf8, 0x8c, 0xf8, 0x22, 0x0c, 0x14, 0x49, 0x38,
+		0x5d, 0x9c, 0xf7, 0xc4, 0x90, 0x7c, 0x31, 0xd0, 0x73, 0x30, 0x6a, 0xa9, 0xb6, 0x4b, 0x9f, 0xa3,
+		0xec, 0x50, 0x1d, 0xbc, 0xb7, 0xb1, 0x91, 0xd1, 0x15, 0x52, 0x94, 0xb7, 0x31, 0x6c, 0x05, 0x81,
+		0xf2, 0x6b, 0x29, 0xe8, 0xe7, 0x86, 0xb8, 0x08, 0x03, 0xdc, 0xa0, 0xdc, 0x07, 0x0f, 0x4f, 0x47,
+		0x27, 0x96, 0x69, 0x6f, 0x02, 0xe0, 0xdc, 0x04, 0x05, 0x3a, 0x01, 0xe9, 0xca, 0x8e, 0xaa, 0x19,
+		0x65, 0xad, 0x2a, 0x96, 0xf5, 0xaf, 0xdd, 0x9e, 0x1c, 0x98, 0x23, 0xb0, 0xc5, 0x79, 0x65, 0x80,
+		0x56, 0x2e, 0x56, 0xc9, 0xcc, 0xbd, 0x83, 0xb5, 0xda, 0x8e, 0xcb, 0x47, 0x11, 0x2f, 0x91, 0x6f,
+		0xb6, 0x10, 0x27, 0xe0, 0x0f, 0x15, 0xf3, 0x4d, 0xdb, 0x2a, 0x5e, 0x3e, 0x56, 0x4c, 0x93, 0x86,
+		0xdf, 0xfb, 0xd5, 0x49, 0x49, 0xa1, 0x14, 0x68, 0x16, 0x86, 0x75, 0xd5, 0x71, 0xcb, 0x74, 0x06,
+		0x22, 0xcd, 0xf7, 0x51, 0x16, 0x07, 0xa3, 0xc6, 0xe0, 0x26, 0xe5, 0x82, 0x0f, 0x12, 0x1a, 0x06,
+		0xaa, 0x92, 0x57, 0x54, 0x94, 0x05, 0xb9, 0x4b, 0xaa, 0xb9, 0x2c, 0x0f, 0xea, 0xa7, 0x16, 0x1f,
+		0x21, 0xf0, 0x39, 0x0a, 0xa6, 0xd9, 0xd0, 0x61, 0xc8, 0xd0, 0x87, 0x51, 0x14, 0x85, 0x5d, 0x02,
+		0x4e, 0x13, 0x00, 0xad, 0x3c, 0x09, 0xa3, 0x7e, 0xf4, 0x63, 0x28, 0x69, 0xc6, 0xc5, 0x07, 0x53,
+		0xc4, 0xc7, 0x60, 0xc2, 0xc0, 0x37, 0xdd, 0xb2, 0x0f, 0x66, 0xd8, 0x19, 0x8a, 0x8d, 0x48, 0xdd,
+		0xb5, 0x30, 0xc5, 0x43, 0x30, 0x52, 0x11, 0xa6, 0x67, 0xb8, 0x40, 0x71, 0x87, 0x3d, 0x28, 0x45,
+		0x3b, 0x04, 0x69, 0xd5, 0xb2, 0x18, 0xc2, 0x20, 0x8f, 0x7f, 0x96, 0x45, 0xab, 0x4e, 0xc3, 0x18,
+		0xd5, 0xd1, 0xc6, 0x4e, 0x43, 0x77, 0x39, 0x93, 0x21, 0x8a, 0x33, 0x4a, 0x2a, 0x14, 0x06, 0xa7,
+		0xb8, 0x0f, 0xc0, 0x30, 0xbe, 0xae, 0x55, 0xb1, 0x51, 0xc1, 0x0c, 0x6f, 0x98, 0xe2, 0x0d, 0x09,
+		0x20, 0x45, 0x7a, 0x18, 0xbc, 0xc8, 0x56, 0x16, 0x31, 0x77, 0x84, 0xf1, 0x13, 0xf0, 0x59, 0x06,
+		0x96, 0x73, 0x90, 0x9a, 0x57, 0x5d, 0x95, 0xa4, 0x07, 0xee, 0x4d, 0x36, 0x89, 0x0c, 0x29, 0xe4,
+		0xa7, 0xfc, 0xf5, 0x04, 0xa4, 0xae, 0x99, 0x2e, 0x46, 0x8f, 0x07, 0xd2, 0xb5, 0x91, 0x66, 0x4f,
+		0x5e, 0xd7, 0x6a, 0x06, 0xae, 0x2e, 0x3b, 0xb5, 0xc0, 0x37, 0x0c, 0x7c, 0x57, 0x4a, 0x84, 0x5c,
+		0x69, 0x02, 0xfa, 0x6c, 0xb3, 0x61, 0x54, 0xc5, 0xed, 0x59, 0x5a, 0x40, 0x73, 0x90, 0xf6, 0x3c,
+		0x24, 0xd5, 0xde, 0x43, 0x46, 0x89, 0x87, 0x10, 0xef, 0xe5, 0x00, 0x65, 0x60, 0x8b, 0x3b, 0x4a,
+		0x11, 0x32, 0x5e, 0xa8, 0xca, 0xf5, 0xf5, 0xe0, 0xaa, 0x3e, 0x19, 0x99, 0x2a, 0xbc, 0x7e, 0xf7,
+		0x0c, 0xc7, 0xbc, 0x2d, 0xeb, 0x55, 0x70, 0xcb, 0x85, 0x5c, 0x8a, 0x7f, 0x4d, 0x61, 0x80, 0x6a,
+		0xe5, 0xbb, 0x14, 0xfb, 0xa2, 0xc2, 0x11, 0x72, 0xf1, 0xa9, 0x66, 0xa8, 0x6e, 0xc3, 0xc6, 0xdc,
+		0xeb, 0x7c, 0x80, 0xfc, 0x59, 0x09, 0xfa, 0x99, 0x17, 0x07, 0xac, 0x26, 0xc5, 0x5b, 0x2d, 0xd1,
+		0xca, 0x6a, 0xc9, 0xbb, 0xb5, 0xda, 0x25, 0x00, 0x4f, 0x14, 0x87, 0x3f, 0x71, 0x6f, 0xca, 0x04,
+		0x98, 0x78, 0xeb, 0x5a, 0x8d, 0x0f, 0xd0, 0x00, 0x89, 0xfc, 0xe7, 0x12, 0x64, 0xbc, 0x7a, 0x74,
+		0x09, 0x86, 0x85, 0x4c, 0xe5, 0x6d, 0x5d, 0xad, 0x71, 0x9f, 0x39, 0xdc, 0x42, 0xb0, 0xcb, 0xba,
+		0x5a, 0x53, 0x06, 0xb9, 0x2c, 0xa4, 0x10, 0xdf, 0x03, 0x89, 0x16, 0x3d, 0x10, 0xea, 0xf2, 0xe4,
+		0xdd, 0x75, 0x79, 0xa8, 0x73, 0x52, 0xd1, 0xce, 0xf9, 0xad, 0x04, 0x5d, 0x70, 0x58, 0xa6, 0xa3,
+		0xea, 0xf7, 0x7f, 0x1c, 0x1c, 0x86, 0x8c, 0x65, 0xea, 0x65, 0x56, 0xc3, 0xee, 0x92, 0xa7, 0x2d,
+		0x53, 0x57, 0x9a, 0xba, 0xbb, 0xef, 0x9e, 0x0c, 0x92, 0xfe, 0x7b, 0x60, 0xb1, 0x81, 0xa8, 0xc5,
+		0x0c, 0x18, 0x62, 0x86, 0xe0, 0x33, 0xd7, 0x34, 0xb1, 0x00, 0xf9, 0xe5, 0xed, 0x72, 0x46, 0x84,
+		0x66, 0x78, 0x4a, 0xff, 0x8e, 0x87, 0xcf, 0x42, 0x7d, 0x2e, 0x11, 0x8f, 0xcf, 0x9c, 0x4d, 0xe1,
+		0x58, 0xf2, 0x2f, 0x4a, 0x00, 0x4b, 0xc4, 0xa6, 0x54, 0x57, 0x32, 0xe3, 0x38, 0xb4, 0xf9, 0x72,
+		0xa8, 0xd5, 0x23, 0xf1, 0x9d, 0xc5, 0xdb, 0x1e, 0x72, 0x82, 0x12, 0xcf, 0xc2, 0xb0, 0xef, 0x82,
+		0x0e, 0x16, 0x82, 0x1c, 0x69, 0x99, 0x1f, 0xaf, 0x63, 0x57, 0x19, 0xba, 0x1e, 0x28, 0xc9, 0xbf,
+		0x27, 0x41, 0x86, 0xca, 0x43, 0x1e, 0xe4, 0x86, 0x7a, 0x4e, 0xba, 0xdb, 0x9e, 0x3b, 0x0a, 0xc0,
+		0x98, 0x90, 0x23, 0x5f, 0xee, 0x4d, 0x19, 0x0a, 0x21, 0x07, 0xb9, 0xe8, 0x9c, 0x67, 0xe6, 0x64,
+		0x3b, 0x33, 0x8b, 0xec, 0x99, 0x1b, 0xfb, 0x20, 0x0c, 0xd0, 0xcf, 0x3f, 0xdd, 0x74, 0x78, 0x42,
+		0x4c, 0xbe, 0xf9, 0xb0, 0x71, 0xd3, 0x91, 0x77, 0x60, 0x60, 0xe3, 0x26, 0xdb, 0xad, 0x38, 0x0c,
+		0x19, 0xdb, 0x34, 0xf9, 0xcc, 0xcb, 0x72, 0x9d, 0x34, 0x01, 0xd0, 0x89, 0x46, 0xac, 0xd1, 0x13,
+		0xfe, 0x1a, 0xdd, 0xdf, 0x62, 0x48, 0x76, 0xb1, 0xc5, 0x70, 0xfa, 0x3f, 0x4b, 0x30, 0x18, 0x88,
+		0x06, 0xe8, 0x71, 0xd8, 0x5f, 0x5c, 0x5a, 0x9d, 0x7b, 0xae, 0xbc, 0x38, 0x5f, 0xbe, 0xbc, 0x34,
+		0xbb, 0xe0, 0xbf, 0x8e, 0xca, 0x1f, 0x78, 0xe5, 0xd6, 0x14, 0x0a, 0xe0, 0x6e, 0x1a, 0xbb, 0x64,
+		0xb3, 0x06, 0xcd, 0xc0, 0x44, 0x98, 0x64, 0xb6, 0xb8, 0x4e, 0x9e, 0x4a, 0x49, 0xf9, 0xfd, 0xaf,
+		0xdc, 0x9a, 0x1a, 0x0b, 0x50, 0xcc, 0x6e, 0x39, 0xd8, 0x70, 0x9b, 0x09, 0xe6, 0x56, 0x97, 0x97,
+		0x17, 0x37, 0xb2, 0x89, 0x26, 0x02, 0x1e, 0x98, 0x1f, 0x86, 0xb1, 0x30, 0xc1, 0xca, 0xe2, 0x52,
+		0x36, 0x99, 0x47, 0xaf, 0xdc, 0x9a, 0x1a, 0x09, 0x60, 0xaf, 0x68, 0x7a, 0x3e, 0xfd, 0xee, 0x5f,
+		0x3b, 0xb6, 0xef, 0x37, 0xfe, 0xd9, 0x31, 0x89, 0x68, 0x36, 0x1c, 0x8a, 0x09, 0xe8, 0x51, 0x38,
+		0xb8, 0xbe, 0xb8, 0xb0, 0x52, 0x9a, 0x2f, 0x2f, 0xaf, 0x2f, 0x88, 0x0d, 0x5f, 0xa1, 0xdd, 0xe8,
+		0x2b, 0xb7, 0xa6, 0x06, 0xb9, 0x4a, 0xad, 0xb0, 0xd7, 0x94, 0xd2, 0xb5, 0x55, 0xb2, 0x7d, 0xcc,
+		0xb0, 0xd7, 0x6c, 0x7c, 0xdd, 0x74, 0xd9, 0xd7, 0xe1, 0x1e, 0x83, 0x43, 0x31, 0xd8, 0x9e, 0x62,
+		0x63, 0xaf, 0xdc, 0x9a, 0x1a, 0x5e, 0xb3, 0x31, 0x1b, 0x35, 0x94, 0x62, 0x1a, 0x72, 0xcd, 0x14,
+		0xab, 0x6b, 0xab, 0xeb, 0xb3, 0x4b, 0xd9, 0xa9, 0x7c, 0xf6, 0x95, 0x5b, 0x53, 0x43, 0x22, 0xf4,
+		0xd1, 0x5d, 0x75, 0x4f, 0xb3, 0x7b, 0xbf, 0x72, 0xf9, 0xd6, 0x63, 0x70, 0x3c, 0xee, 0xb0, 0x86,
+		0x97, 0xef, 0xe2, 0xb8, 0xa6, 0xe5, 0x4e, 0x76, 0xbe, 0xc3, 0x66, 0x6f, 0xe7, 0x05, 0xd0, 0x5d,
+		0x1e, 0x05, 0xe5, 0xdb, 0xac, 0xcd, 0xe4, 0x9f, 0x91, 0x60, 0xe4, 0x8a, 0xe6, 0xb8, 0xa6, 0xad,
+		0x55, 0x54, 0x9d, 0x3e, 0x7f, 0x3a, 0xd7, 0x5d, 0xe0, 0x8c, 0x8c, 0xe8, 0xa7, 0xa1, 0xff, 0xba,
+		0xaa, 0xb3, 0xa8, 0x95, 0xa4, 0x9f, 0x15, 0x89, 0x3b, 0x45, 0xf1, 0x42, 0x97, 0xa0, 0x66, 0x34,
+		0xf2, 0xab, 0x09, 0x18, 0xa5, 0x2e, 0xef, 0xb0, 0x4f, 0x78, 0x91, 0x55, 0xd2, 0x33, 0x90, 0xb2,
+		0x55, 0x97, 0x6f, 0xd6, 0x15, 0x1f, 0xe6, 0x27, 0x76, 0xc7, 0xdb, 0x1f, 0x48, 0x91, 0xf3, 0x3c,
+		0x4a, 0x86, 0xde, 0x08, 0x69, 0x72, 0xd2, 0x45, 0x59, 0xb0, 0xc5, 0xc7, 0xd3, 0x5d, 0xb3, 0xb8,
+		0x73, 0x7b, 0x72, 0x74, 0x4f, 0xad, 0xeb, 0x05, 0x59, 0xb0, 0x90, 0x95, 0x81, 0xba, 0x7a, 0x93,
+		0x08, 0x86, 0x76, 0x61, 0x94, 0x40, 0x2b, 0x3b, 0xaa, 0x51, 0xc3, 0x8c, 0x3f, 0xdd, 0x70, 0x2c,
+		0xce, 0xf5, 0xc2, 0xff, 0x80, 0xcf, 0x3f, 0xc0, 0x49, 0x56, 0x86, 0xeb, 0xea, 0xcd, 0x39, 0x0a,
+		0x20, 0x8d, 0x15, 0xd2, 0x1f, 0x78, 0x75, 0x72, 0x1f, 0x3d, 0xf6, 0xfc, 0x13, 0x09, 0xc0, 0x37,
+		0x11, 0x7a, 0x13, 0x64, 0x2b, 0x5e, 0x89, 0xd2, 0x8a, 0xe3, 0xbc, 0x07, 0x63, 0x2d, 0x1f, 0xb1,
+		0x2e, 0x9b, 0x61, 0xbf, 0x74, 0x7b, 0x52, 0x52, 0x46, 0x2b, 0x11, 0xc3, 0xbf, 0x19, 0x06, 0x1b,
+		0x56, 0x55, 0x75, 0x71, 0x99, 0xae, 0xbe, 0x12, 0x1d, 0x67, 0xeb, 0x63, 0x84, 0xd7, 0x9d, 0xdb,
+		0x93, 0x88, 0xe9, 0x14, 0x20, 0x96, 0xe9, 0x1c, 0x0e, 0x0c, 0x42, 0x08, 0x02, 0x0a, 0xfd, 0xb1,
+		0x04, 0x83, 0xf3, 0x81, 0xfb, 0x86, 0x39, 0x18, 0xa8, 0x9b, 0x86, 0xb6, 0xcb, 0x1d, 0x2f, 0xa3,
+		0x88, 0x22, 0xd9, 0x80, 0x64, 0x4f, 0x3f, 0xdd, 0x3d, 0xb1, 0x01, 0x29, 0xca, 0x84, 0xea, 0x06,
+		0xde, 0x72, 0x34, 0xd1, 0x0b, 0x8a, 0x28, 0xa2, 0xcb, 0xe4, 0xeb, 0x33, 0x95, 0x06, 0xd9, 0x5d,
+		0x29, 0x57, 0x4c, 0xc3, 0x55, 0x2b, 0x2e, 0x7b, 0x44, 0x58, 0x3c, 0x7c, 0xe7, 0xf6, 0xe4, 0x41,
+		0x26, 0x6b, 0x14, 0x43, 0x56, 0x46, 0x05, 0x68, 0x8e, 0x41, 0x48, 0x0b, 0x55, 0xec, 0xaa, 0x9a,
+		0xee, 0xe4, 0xd8, 0xc1, 0xbd, 0x28, 0x06, 0x74, 0x79, 0xdf, 0x40, 0x70, 0xc3, 0xe9, 0x32, 0x64,
+		0x4d, 0x0b, 0xdb, 0xa1, 0x54, 0x52, 0x8a, 0xb6, 0x1c, 0xc5, 0x90, 0x95, 0x51, 0x01, 0x12, 0x69,
+		0xe6, 0x5b, 0x21, 0xeb, 0x2d, 0xe5, 0xca, 0x56, 0x63, 0xcb, 0xdf, 0xa7, 0x9a, 0x68, 0xea, 0x8d,
+		0x59, 0x63, 0xaf, 0xf8, 0x98, 0xcf, 0x3d, 0x4a, 0x27, 0x7f, 0xe1, 0x93, 0x67, 0xc6, 0x88, 0x5f,
+		0xf8, 0x1b, 0x47, 0x64, 0xd7, 0x68, 0xd4, 0xc3, 0x5b, 0xa3, 0x68, 0x24, 0x6f, 0x7c, 0x51, 0xd5,
+		0x74, 0xf1, 0x12, 0x5e, 0xe1, 0x25, 0xf4, 0x14, 0xf4, 0x3b, 0xae, 0xea, 0x36, 0x1c, 0x7e, 0x48,
+		0x3a, 0x19, 0xeb, 0x64, 0x45, 0xd3, 0xa8, 0xae, 0x53, 0x34, 0x85, 0xa3, 0xa3, 0x59, 0xe8, 0xe7,
+		0x87, 0xcd, 0x7d, 0xbd, 0x8c, 0x63, 0x7a, 0x85, 0x80, 0x11, 0x22, 0x13, 0xb2, 0x55, 0xac, 0xe3,
+		0x1a, 0xcb, 0x8b, 0x76, 0x54, 0xb2, 0x60, 0xa0, 0x9f, 0xa7, 0x2b, 0xce, 0xf7, 0x32, 0xe2, 0xb8,
+		0x65, 0xa2, 0xac, 0x64, 0x65, 0xd4, 0x03, 0xad, 0x53, 0x08, 0xba, 0x12, 0xba, 0x08, 0xcb, 0x3f,
+		0xdf, 0x38, 0x15, 0xab, 0x71, 0xc0, 0x81, 0xc5, 0x26, 0x42, 0x80, 0x94, 0x78, 0x42, 0xc3, 0xd8,
+		0x32, 0x0d, 0xfa, 0x3c, 0x95, 0x27, 0xe4, 0x64, 0x21, 0x96, 0x0c, 0x7a, 0x42, 0x14, 0x43, 0x56,
+		0x46, 0x3d, 0xd0, 0x15, 0x0a, 0x41, 0x55, 0x18, 0xf1, 0xb1, 0xe8, 0xa8, 0xcc, 0x74, 0x1c, 0x95,
+		0xc7, 0xf9, 0xa8, 0xdc, 0x1f, 0x6d, 0xc5, 0x1f, 0x98, 0xc3, 0x1e, 0x90, 0x90, 0xa1, 0x12, 0x80,
+		0x1f, 0x0b, 0x72, 0xc0, 0xbf, 0x63, 0xd5, 0x3e, 0x9a, 0x88, 0x95, 0x99, 0x4f, 0x88, 0x6e, 0xc0,
+		0x78, 0x5d, 0x33, 0xca, 0x0e, 0xd6, 0xb7, 0xcb, 0xdc, 0xb4, 0x84, 0x1f, 0xfd, 0xb4, 0x50, 0x71,
+		0xa1, 0xeb, 0xfe, 0xbf, 0x73, 0x7b, 0x32, 0xcf, 0x83, 0x64, 0x33, 0x37, 0x59, 0x19, 0xab, 0x6b,
+		0xc6, 0x3a, 0xd6, 0xb7, 0xe7, 0x3d, 0x58, 0x61, 0xe8, 0xdd, 0xaf, 0x4e, 0xee, 0xe3, 0x63, 0x72,
+		0x9f, 0xfc, 0x24, 0xdd, 0xb4, 0xe6, 0x63, 0x09, 0x3b, 0x64, 0xf9, 0xa0, 0x8a, 0x02, 0x3f, 0x90,
+		0xf7, 0x01, 0x6c, 0x2c, 0xbf, 0xfc, 0x5f, 0xa6, 0x24, 0xf9, 0x37, 0x25, 0xe8, 0x9f, 0xbf, 0xb6,
+		0xa6, 0x6a, 0x36, 0x5a, 0x84, 0x31, 0xdf, 0x5d, 0xc2, 0x23, 0xf9, 0xc8, 0x9d, 0xdb, 0x93, 0xb9,
+		0xa8, 0x47, 0x79, 0x43, 0xd9, 0x77, 0x58, 0x31, 0x96, 0x17, 0x5b, 0xad, 0x2f, 0x43, 0xac, 0x9a,
+		0x50, 0xe4, 0xe6, 0xd5, 0x67, 0x44, 0xcd, 0x22, 0x0c, 0x30, 0x69, 0xc9, 0x27, 0x90, 0xfa, 0x2c,
+		0xf2, 0x83, 0xef, 0xc8, 0x1f, 0x8e, 0xf7, 0x58, 0x8a, 0xec, 0x6d, 0x2e, 0x12, 0x7c, 0xf9, 0x7d,
+		0x09, 0x80, 0xf9, 0x6b, 0xd7, 0x36, 0x6c, 0xcd, 0xd2, 0xb1, 0x7b, 0x2f, 0xd5, 0xde, 0x80, 0xfd,
+		0xbe, 0x4e, 0x8e, 0x5d, 0x89, 0xa8, 0x3e, 0x75, 0xe7, 0xf6, 0xe4, 0x91, 0xa8, 0xea, 0x01, 0x34,
+		0x59, 0x19, 0xf7, 0xd7, 0x37, 0x76, 0x25, 0x96, 0x6b, 0xd5, 0x71, 0x3d, 0xae, 0xc9, 0xd6, 0x5c,
+		0x03, 0x68, 0x41, 0xae, 0xf3, 0x8e, 0x1b, 0x6f, 0xd7, 0x35, 0x18, 0xf4, 0x4d, 0x42, 0xe2, 0x58,
+		0xda, 0xe5, 0xbf, 0xb9, 0x79, 0x27, 0x5b, 0x98, 0x57, 0xd0, 0x70, 0x13, 0x7b, 0x64, 0xf2, 0xdf,
+		0x48, 0x00, 0xbe, 0xb7, 0xfe, 0x78, 0x3a, 0x17, 0x89, 0xd7, 0x3c, 0xc4, 0x26, 0x7b, 0xcd, 0xbb,
+		0x38, 0x61, 0xc4, 0x8e, 0x7f, 0x2b, 0xc1, 0xfe, 0x0d, 0x5b, 0x35, 0x9c, 0x6d, 0x6c, 0xdb, 0xb8,
+		0xfa, 0x93, 0x67, 0x80, 0x77, 0x27, 0xc8, 0xb7, 0x32, 0x78, 0x9c, 0xfd, 0xb1, 0x57, 0x7f, 0x19,
+		0x06, 0xb0, 0xe1, 0xda, 0x1a, 0xd5, 0x9f, 0x7d, 0xf9, 0x20, 0xce, 0xcd, 0x63, 0x14, 0xa2, 0x9f,
+		0x92, 0x12, 0x47, 0x00, 0x9c, 0x47, 0xc4, 0x14, 0x3f, 0x9b, 0x84, 0x5c, 0x2b, 0x4a, 0x34, 0x07,
+		0xa3, 0x15, 0x1b, 0x53, 0x40, 0x39, 0xb8, 0x1b, 0x59, 0xcc, 0xfb, 0xe9, 0x72, 0x04, 0x41, 0x56,
+		0x46, 0x04, 0x84, 0x4f, 0x94, 0x35, 0x20, 0xe9, 0x2c, 0x19, 0x6f, 0x04, 0xab, 0xcb, 0xfc, 0x55,
+		0xe6, 0x33, 0xa5, 0x68, 0x24, 0xcc, 0x80, 0x4d, 0x95, 0x23, 0x3e, 0x94, 0xce, 0x95, 0x3a, 0x8c,
+		0x6a, 0x86, 0xe6, 0x6a, 0xaa, 0x5e, 0xde, 0x52, 0x75, 0xd5, 0xa8, 0xf4, 0xb8, 0x0a, 0x60, 0x13,
+		0x1c, 0x6f, 0x31, 0xc2, 0x49, 0x56, 0x46, 0x38, 0xa4, 0xc8, 0x00, 0x68, 0x0e, 0x06, 0x44, 0x2b,
+		0xa9, 0x5e, 0xd3, 0x28, 0x41, 0x19, 0x48, 0x57, 0xdf, 0x99, 0x84, 0x31, 0x05, 0x57, 0xff, 0x7f,
+		0x07, 0x74, 0xdd, 0x01, 0x57, 0x00, 0xd8, 0xe0, 0x26, 0xf3, 0x48, 0x2e, 0xd5, 0x6b, 0x64, 0xc8,
+		0x30, 0xe2, 0x79, 0xc7, 0x0d, 0xf4, 0xc2, 0x9f, 0x27, 0x60, 0x28, 0xd8, 0x0b, 0x3f, 0xa1, 0xf3,
+		0x2e, 0xba, 0xec, 0x87, 0x9c, 0x14, 0xff, 0xf8, 0x6a, 0x5c, 0xc8, 0x69, 0x72, 0xd8, 0xf6, 0xb1,
+		0xe6, 0x4e, 0x0a, 0xfa, 0xd7, 0x54, 0x5b, 0xad, 0x3b, 0xa8, 0xd2, 0x94, 0x3d, 0x4b, 0xfc, 0xe6,
+		0x41, 0xd3, 0xf7, 0xd4, 0xf9, 0x96, 0x4c, 0x87, 0xe4, 0xf9, 0x03, 0x31, 0xc9, 0xf3, 0xeb, 0x61,
+		0x84, 0x2c, 0xe6, 0x03, 0xf7, 0x22, 0x88, 0xa9, 0x87, 0x8b, 0x87, 0x7c, 0x2e, 0xe1, 0x7a, 0xb6,
+		0xd6, 0x0f, 0xdc, 0x17, 0x7d, 0x0a, 0x06, 0x09, 0x86, 0x1f, 0x7e, 0x09, 0xf9, 0x01, 0x7f, 0x5d,
+		0x1d, 0xa8, 0x94, 0x15, 0x72, 0x8d, 0xb7, 0xc4, 0x0a, 0x68, 0x09, 0xd0, 0x8e, 0xb7, 0x87, 0x53,
+		0xf6, 0x6d, 0x49, 0xe8, 0x8f, 0xde, 0xb9, 0x3d, 0x79, 0x88, 0xd1, 0x37, 0xe3, 0xc8, 0xca, 0x98,
+		0x0f, 0x14, 0xdc, 0xce, 0x01, 0x10, 0xbd, 0xca, 0xec, 0x96, 0x36, 0x5b, 0xb5, 0xed, 0xbf, 0x73,
+		0x7b, 0x72, 0x8c, 0x71, 0xf1, 0xeb, 0x64, 0x25, 0x43, 0x0a, 0xf3, 0xe4, 0x37, 0x49, 0xfa, 0x6b,
+		0xba, 0xb9, 0xa5, 0xea, 0x65, 0x97, 0x4f, 0xf6, 0xe5, 0x8a, 0x6a, 0xe5, 0xfa, 0x7b, 0x49, 0xfa,
+		0xd9, 0x3a, 0x8d, 0x27, 0xfd, 0x31, 0xdc, 0x64, 0x65, 0x8c, 0x41, 0x45, 0x3e, 0x31, 0xa7, 0x5a,
+		0xe8, 0x65, 0x09, 0x0e, 0xf8, 0xde, 0x16, 0x6a, 0x9c, 0x7e, 0x86, 0xbc, 0x78, 0xb5, 0x97, 0xc6,
+		0x8f, 0x46, 0xdd, 0x37, 0xdc, 0xfe, 0x84, 0x57, 0x11, 0x10, 0x21, 0x30, 0xa4, 0x6f, 0x49, 0x80,
+		0xfc, 0x79, 0x4d, 0xc1, 0x8e, 0x45, 0xd6, 0xd7, 0x64, 0x61, 0x15, 0x58, 0x08, 0x49, 0x6d, 0x16,
+		0x56, 0x3e, 0xb1, 0x58, 0x58, 0xf9, 0x84, 0xe4, 0x93, 0xd2, 0x22, 0xd4, 0x25, 0xda, 0xde, 0xdc,
+		0xe6, 0x03, 0x23, 0x1a, 0xf8, 0xf7, 0xc9, 0x7f, 0x20, 0xc1, 0xa1, 0xa6, 0x71, 0xe4, 0x89, 0xf9,
+		0x66, 0x40, 0x76, 0xa0, 0x92, 0x7f, 0x2c, 0x52, 0xe2, 0x1f, 0xf3, 0xef, 0x65, 0x4c, 0x8e, 0xd9,
+		0x31, 0xb3, 0xcb, 0x3d, 0x98, 0xc2, 0xd8, 0x0b, 0x80, 0xcf, 0x48, 0x30, 0x11, 0x6c, 0xd9, 0x53,
+		0xe0, 0x39, 0x18, 0x0a, 0x36, 0xcc, 0x45, 0x3f, 0xde, 0x51, 0x74, 0x2e, 0x75, 0x88, 0x18, 0xad,
+		0xf8, 0x61, 0x29, 0xc1, 0xff, 0xfd, 0x42, 0x57, 0x26, 0x10, 0xd2, 0x44, 0xc3, 0x53, 0x8a, 0xf6,
+		0xc0, 0xf7, 0x24, 0x48, 0xad, 0x99, 0xa6, 0x8e, 0x5e, 0x84, 0x31, 0xc3, 0x74, 0xcb, 0x64, 0x04,
+		0xe1, 0x6a, 0xf0, 0x42, 0x7e, 0xa6, 0xf8, 0x6c, 0xd7, 0x96, 0xf9, 0xe6, 0xed, 0xc9, 0x66, 0x2e,
+		0xca, 0xa8, 0x61, 0xba, 0x45, 0x0a, 0xe1, 0xd7, 0xf2, 0x6f, 0xc0, 0x70, 0xb8, 0x1d, 0x36, 0x0b,
+		0x28, 0xbd, 0xb4, 0x13, 0xe6, 0x70, 0xe7, 0xf6, 0xe4, 0x84, 0x1f, 0x0f, 0x3c, 0xb0, 0xac, 0x0c,
+		0x6d, 0x05, 0x1a, 0x66, 0x37, 0xe0, 0xbe, 0xf3, 0xea, 0xa4, 0x74, 0xfa, 0x53, 0x12, 0x80, 0xbf,
+		0x3d, 0x44, 0xce, 0x18, 0x8a, 0xab, 0x2b, 0xf3, 0xe5, 0xf5, 0x8d, 0xd9, 0x8d, 0xcd, 0xf5, 0xf0,
+		0x1d, 0x76, 0x71, 0x22, 0xe1, 0x58, 0xb8, 0x42, 0xbe, 0xf6, 0x56, 0x45, 0x27, 0x60, 0x22, 0x8c,
+		0x4d, 0x4a, 0xe4, 0x73, 0xae, 0xf9, 0xa1, 0x57, 0x6e, 0x4d, 0xa5, 0x59, 0x4a, 0x89, 0xc9, 0x9d,
+		0x8d, 0xfd, 0xcd, 0x78, 0xe4, 0x63, 0x95, 0x89, 0xfc, 0xf0, 0x2b, 0xb7, 0xa6, 0x32, 0x5e, 0xee,
+		0x89, 0x64, 0x40, 0x41, 0x4c, 0xce, 0x2f, 0x99, 0x87, 0x57, 0x6e, 0x4d, 0xf5, 0x33, 0xdb, 0xe5,
+		0x53, 0xe4, 0xdc, 0xe1, 0x5e, 0xde, 0x74, 0xff, 0x4a, 0x3a, 0xfe, 0xb4, 0xa1, 0x86, 0x0d, 0xec,
+		0x68, 0x4e, 0xef, 0xa7, 0x0d, 0x9d, 0xcf, 0x2e, 0xe4, 0x2f, 0xf6, 0xc3, 0xd0, 0x02, 0xe3, 0x4f,
+		0x4c, 0x8f, 0xd1, 0x05, 0xf2, 0x99, 0x54, 0x32, 0x2d, 0x7a, 0x17, 0x7a, 0xe2, 0xbc, 0x9a, 0xcd,
+		0x9c, 0xde, 0x9d, 0x37, 0x5a, 0x42, 0x06, 0xbf, 0x12, 0xc3, 0x6e, 0xe2, 0xf9, 0xf7, 0xcb, 0x86,
+		0x8a, 0xf3, 0xbd, 0xe4, 0x5c, 0x7c, 0xdb, 0x2b, 0xca, 0x4a, 0x66, 0x17, 0x6b, 0x36, 0x08, 0x84,
+		0x5d, 0xa0, 0xfb, 0x69, 0x09, 0xf6, 0x53, 0x2c, 0x3f, 0x1c, 0x53, 0x4c, 0xb1, 0x34, 0x39, 0x19,
+		0x2b, 0xfa, 0x92, 0xea, 0xf8, 0x37, 0x65, 0xd8, 0x8d, 0xb7, 0x07, 0xf9, 0x6c, 0x7e, 0x24, 0xd0,
+		0x72, 0x94, 0xa7, 0xac, 0x8c, 0xeb, 0x4d, 0x94, 0xe4, 0xab, 0xdf, 0xc1, 0xab, 0x8e, 0xa9, 0x1e,
+		0xce, 0x36, 0x02, 0x74, 0x68, 0x01, 0x06, 0xfd, 0x28, 0xe1, 0xf0, 0x7f, 0x54, 0xd3, 0xe5, 0x0c,
+		0x10, 0xa4, 0x44, 0xef, 0x94, 0x60, 0xbf, 0x9f, 0x8c, 0x04, 0x79, 0xf6, 0xf3, 0xcf, 0xfa, 0x75,
+		0xb9, 0x5a, 0x8b, 0xda, 0x24, 0x96, 0xa9, 0xac, 0x4c, 0x78, 0xf0, 0xf9, 0x80, 0x14, 0xcb, 0xe4,
+		0x9f, 0x08, 0x04, 0x1b, 0x67, 0x5f, 0xab, 0xec, 0x3a, 0xd0, 0x86, 0xa9, 0xd9, 0xff, 0x16, 0xb1,
+		0x4c, 0xdb, 0xc5, 0xd5, 0x5c, 0x9a, 0x7f, 0x69, 0x89, 0x97, 0xd1, 0xcf, 0x49, 0x70, 0xd0, 0xf5,
+		0xb7, 0x0f, 0x42, 0x2a, 0xb3, 0xff, 0x8a, 0x73, 0x3a, 0xb6, 0xd5, 0xd8, 0x2d, 0x87, 0xe2, 0x09,
+		0xae, 0xf4, 0x31, 0xa6, 0x74, 0x0b, 0xc6, 0xb2, 0x72, 0xc0, 0x8d, 0x23, 0x77, 0xe4, 0x15, 0x40,
+		0xcd, 0xee, 0x15, 0xbd, 0x60, 0xea, 0x3f, 0xf4, 0x21, 0xd7, 0x2d, 0x82, 0xd7, 0x30, 0x59, 0xa1,
+		0x90, 0x7e, 0x37, 0x9f, 0x8f, 0xef, 0x65, 0x70, 0xf9, 0x4c, 0x02, 0x4e, 0x7a, 0xa7, 0x80, 0x6f,
+		0x6d, 0x60, 0x7b, 0xcf, 0x8b, 0x08, 0x96, 0x5a, 0xd3, 0x8c, 0xe0, 0xbb, 0x92, 0x03, 0x5e, 0xda,
+		0x40, 0x11, 0x85, 0xd1, 0x64, 0x03, 0x06, 0xd7, 0xd4, 0x1a, 0x56, 0xf0, 0x5b, 0x1b, 0xd8, 0x71,
+		0x63, 0x6e, 0xf1, 0x93, 0x3b, 0xf6, 0xdb, 0xdb, 0xe2, 0x6e, 0x42, 0x4a, 0xe1, 0x25, 0xa2, 0xa7,
+		0xae, 0x91, 0xbb, 0x13, 0x49, 0x0a, 0x66, 0x05, 0xf2, 0x4d, 0xc3, 0x8a, 0xd9, 0x30, 0xf8, 0x28,
+		0xcf, 0xa5, 0xc4, 0x37, 0x68, 0x1a, 0x06, 0x1b, 0xe5, 0xf2, 0x25, 0x18, 0x62, 0xed, 0xf1, 0xf9,
+		0xfb, 0x10, 0xa4, 0xe9, 0x1d, 0x38, 0xbf, 0xd5, 0x01, 0x52, 0x7e, 0x8e, 0xdd, 0xf9, 0x67, 0x5c,
+		0x58, 0xc3, 0xac, 0x50, 0x7c, 0xa6, 0xa5, 0xfd, 0x1e, 0x68, 0x1b, 0x89, 0x98, 0x81, 0x3c, 0xdb,
+		0xfd, 0x61, 0x1f, 0xec, 0x67, 0x4b, 0x81, 0x19, 0xd5, 0xd2, 0x66, 0x76, 0x5c, 0x57, 0xbc, 0x38,
+		0x01, 0x06, 0x9e, 0x56, 0x2d, 0x4d, 0xde, 0x83, 0xd4, 0x15, 0xd7, 0xb5, 0xd0, 0x69, 0xe8, 0xb3,
+		0x1b, 0x3a, 0x16, 0xfb, 0x80, 0xde, 0x59, 0x8c, 0x6a, 0x69, 0xd3, 0x04, 0x41, 0x69, 0xe8, 0x58,
+		0x61, 0x28, 0xa8, 0x04, 0x93, 0xdb, 0x0d, 0x5d, 0xdf, 0x23, 0xff, 0x4b, 0xca, 0xac, 0xe2, 0xb2,
+		0xf7, 0xbf, 0x37, 0xf0, 0x4d, 0x4b, 0x15, 0x5f, 0xf0, 0x24, 0x66, 0x39, 0x42, 0xd1, 0xe6, 0x29,
+		0x96, 0xf8, 0xbf, 0x1b, 0x25, 0x81, 0x23, 0x7f, 0x25, 0x01, 0x69, 0xc1, 0x9a, 0xde, 0xc7, 0xc7,
+		0x3a, 0xae, 0xb8, 0xa6, 0x38, 0x29, 0xf3, 0xca, 0x08, 0x41, 0xb2, 0xc6, 0x7b, 0x27, 0x73, 0x65,
+		0x9f, 0x42, 0x0a, 0x04, 0xe6, 0xbd, 0x8a, 0x20, 0x30, 0xf2, 0x58, 0x62, 0x02, 0x52, 0x96, 0x29,
+		0x56, 0xb2, 0x57, 0xf6, 0x29, 0xb4, 0x84, 0x72, 0xd0, 0x4f, 0x86, 0x81, 0xcb, 0x3e, 0x8b, 0x4a,
+		0xe0, 0xbc, 0x8c, 0x0e, 0x90, 0xad, 0x65, 0xb7, 0xc2, 0xae, 0x40, 0x92, 0x0a, 0x56, 0x24, 0xe7,
+		0x42, 0xec, 0x39, 0x7a, 0xf4, 0xdf, 0xf2, 0x10, 0x63, 0xb0, 0xef, 0xfe, 0x11, 0xb9, 0xd7, 0x54,
+		0xd7, 0xc5, 0xb6, 0x41, 0x18, 0x32, 0x74, 0x72, 0x81, 0x63, 0xcb, 0xac, 0xee, 0xf1, 0x7f, 0x15,
+		0x44, 0x7f, 0xf3, 0xff, 0x4d, 0x42, 0x5d, 0xa1, 0x4c, 0x2b, 0xd9, 0x7f, 0x48, 0x1b, 0x12, 0xc0,
+		0x22, 0x41, 0x2a, 0xc1, 0xb8, 0x5a, 0xad, 0x6a, 0xec, 0xbf, 0xf6, 0x94, 0xb7, 0x34, 0x1a, 0x9c,
+		0x9c, 0xdc, 0x60, 0x9b, 0xbe, 0x40, 0x3e, 0x41, 0x91, 0xe3, 0x17, 0x33, 0xe4, 0x3f, 0xf5, 0x51,
+		0xa1, 0xe4, 0x8b, 0x30, 0xd6, 0x24, 0x29, 0x91, 0x6f, 0x57, 0x33, 0xaa, 0xe2, 0xa9, 0x08, 0xf9,
+		0x4d, 0x60, 0xf4, 0xdb, 0x9d, 0xec, 0x0c, 0x92, 0xfe, 0x2e, 0xbe, 0xa3, 0xf5, 0x23, 0xa2, 0x91,
+		0xc0, 0x23, 0x22, 0xd5, 0xd2, 0x8a, 0x19, 0xca, 0x9f, 0x3f, 0x1d, 0x9a, 0xe5, 0x15, 0xec, 0xd9,
+		0xd0, 0xb4, 0x69, 0xd7, 0x48, 0x1a, 0x20, 0x66, 0x78, 0x52, 0xa5, 0x5a, 0x9a, 0x43, 0xdd, 0xd1,
+		0xff, 0x96, 0xa8, 0x73, 0x31, 0xf0, 0x9b, 0x3e, 0x28, 0x4a, 0x2d, 0xcc, 0xae, 0x2d, 0x7a, 0x7e,
+		0xfc, 0xfb, 0x09, 0x38, 0x12, 0xf0, 0xe3, 0x00, 0x72, 0xb3, 0x3b, 0xe7, 0xe3, 0x3d, 0xbe, 0x8b,
+		0x47, 0xd9, 0xcf, 0x41, 0x8a, 0xe0, 0xa3, 0x0e, 0xff, 0x39, 0x24, 0xf7, 0x89, 0x2f, 0x7c, 0x56,
+		0x9e, 0x92, 0x5a, 0xf6, 0x0a, 0x65, 0x52, 0x7c, 0x57, 0xf7, 0xf6, 0xcb, 0xfa, 0x9f, 0x51, 0x75,
+		0xee, 0x9d, 0x19, 0xa3, 0x36, 0x7c, 0xf9, 0x5c, 0xfc, 0xfb, 0x5d, 0x16, 0x29, 0xdb, 0xa4, 0x68,
+		0xdd, 0x46, 0xdf, 0x16, 0xb9, 0x5c, 0xdb, 0x8e, 0xeb, 0x26, 0xd3, 0x6b, 0xc0, 0x81, 0x37, 0x90,
+		0x86, 0xfd, 0x1d, 0x05, 0x11, 0xc7, 0x0f, 0x78, 0x87, 0xb7, 0x12, 0xff, 0x97, 0x83, 0xb4, 0x84,
+		0xe6, 0x00, 0x7c, 0xe1, 0xf8, 0x92, 0xf2, 0x81, 0xe9, 0xf8, 0xb9, 0x61, 0x3a, 0x30, 0x31, 0x28,
+		0x01, 0x32, 0xf9, 0xd7, 0x25, 0x38, 0xd8, 0xd4, 0x2e, 0x8f, 0xe7, 0xf3, 0x31, 0xef, 0x44, 0x7a,
+		0x4f, 0x9e, 0xe6, 0x63, 0xc4, 0x7c, 0xb0, 0xbd, 0x98, 0xac, 0xfd, 0x90, 0x9c, 0xcf, 0xc2, 0xfe,
+		0xb0, 0x98, 0xc2, 0x3a, 0x0f, 0xc1, 0x48, 0x78, 0x67, 0x9c, 0x5b, 0x69, 0x38, 0xb4, 0x37, 0x2e,
+		0xff, 0x54, 0xd4, 0xbc, 0x9e, 0x96, 0x45, 0xc8, 0x78, 0xa8, 0x3c, 0xa9, 0xee, 0x4e, 0x49, 0x9f,
+		0x4c, 0xfe, 0x79, 0x09, 0xa6, 0xc2, 0xec, 0x03, 0x69, 0x47, 0x6f, 0x92, 0xde, 0x9b, 0x6e, 0x7d,
+		0x4d, 0x82, 0xe3, 0x6d, 0x04, 0xe2, 0xaa, 0xdf, 0x80, 0x89, 0xc0, 0x7e, 0x81, 0x88, 0xd3, 0xa2,
+		0xab, 0x4f, 0x76, 0x48, 0x70, 0xbd, 0x95, 0xf2, 0x61, 0x62, 0x8e, 0x8f, 0x7d, 0x75, 0x72, 0xbc,
+		0xb9, 0xce, 0x51, 0xc6, 0x9b, 0x17, 0xfa, 0xf7, 0xca, 0x27, 0x7e, 0x59, 0x82, 0x87, 0xc3, 0x4a,
+		0xc6, 0x64, 0xca, 0x3f, 0x12, 0xf3, 0xff, 0xa9, 0x04, 0xa7, 0xbb, 0x91, 0x8c, 0xf7, 0x43, 0x19,
+		0xc6, 0xfd, 0x04, 0x3e, 0xda, 0x0d, 0xdd, 0xaf, 0x09, 0x98, 0x5b, 0x22, 0x8f, 0xd5, 0xbd, 0xb6,
+		0xb7, 0xc5, 0xc7, 0x50, 0xb0, 0x9b, 0x3d, 0xdb, 0x86, 0xf7, 0xb5, 0x85, 0x6d, 0x43, 0x3b, 0xdb,
+		0x31, 0x5d, 0x90, 0x88, 0xe9, 0x02, 0x3f, 0xc1, 0x96, 0x1d, 0x38, 0xd8, 0xd4, 0x22, 0xb7, 0xd9,
+		0xf3, 0x30, 0x1e, 0xe3, 0xbb, 0x7c, 0x00, 0x77, 0xeb, 0xba, 0x0a, 0x6a, 0xf6, 0x4e, 0x79, 0x0f,
+		0x26, 0x69, 0xa3, 0x31, 0x26, 0xbe, 0xdf, 0xfa, 0xbe, 0x08, 0x53, 0xad, 0x9b, 0xe6, 0x8a, 0x5f,
+		0x86, 0x7e, 0xd6, 0xc3, 0x5c, 0xd7, 0x5e, 0xfd, 0x83, 0x53, 0xcb, 0x1f, 0x10, 0x31, 0x6b, 0x5e,
+		0xc8, 0x1c, 0x3f, 0x68, 0xba, 0x51, 0xf4, 0x5e, 0x0c, 0x9a, 0x80, 0x19, 0xbe, 0x28, 0xa2, 0x57,
+		0xbc, 0x68, 0xdc, 0x10, 0x7f, 0xef, 0xde, 0x44, 0x2f, 0x66, 0x95, 0xfb, 0x18, 0xa6, 0x3e, 0x22,
+		0xc2, 0x94, 0xa7, 0x4d, 0x87, 0x30, 0xf5, 0x23, 0xb0, 0xb8, 0x17, 0xb0, 0x3a, 0xc8, 0xf8, 0x77,
+		0x2b, 0x60, 0xfd, 0xa5, 0x04, 0x87, 0xa8, 0x56, 0xc1, 0x4d, 0x8c, 0x5e, 0x2d, 0xfd, 0x28, 0x20,
+		0x72, 0xb4, 0x16, 0x3b, 0x90, 0xb3, 0x8e, 0x5d, 0xb9, 0x16, 0x9a, 0x3e, 0x1e, 0x05, 0x54, 0x75,
+		0xdc, 0x28, 0x36, 0xbb, 0xdb, 0x98, 0xad, 0x3a, 0x6e, 0x18, 0x3b, 0xdc, 0x8b, 0xa9, 0x1f, 0xb4,
+		0x17, 0xbf, 0x20, 0x41, 0x3e, 0x4e, 0x5f, 0xde, 0x6b, 0xdb, 0x70, 0x20, 0x74, 0x40, 0x10, 0xed,
+		0xb8, 0x87, 0x3b, 0x6e, 0x00, 0x45, 0x06, 0xcd, 0x7e, 0x1b, 0xdf, 0xd7, 0xd9, 0x7d, 0x32, 0xec,
+		0x92, 0xcd, 0xa9, 0xf1, 0x8f, 0x66, 0xb0, 0x7c, 0xbc, 0x29, 0x72, 0xfe, 0x98, 0x27, 0xcf, 0x37,
+		0xe1, 0x58, 0x0b, 0x79, 0xef, 0xf7, 0x84, 0x86, 0x5b, 0xf6, 0xe1, 0x3d, 0xcd, 0xbf, 0xcf, 0x71,
+		0xbf, 0x0f, 0xdf, 0x85, 0x0f, 0x2c, 0xa0, 0xe2, 0xde, 0xc7, 0xc9, 0xd7, 0xe0, 0x70, 0x2c, 0x15,
+		0x17, 0xec, 0x29, 0x48, 0x91, 0xe3, 0xd5, 0x9c, 0x14, 0xf0, 0x97, 0xa8, 0x4c, 0x11, 0x52, 0x4a,
+		0x20, 0x23, 0xc8, 0x52, 0xbe, 0xe4, 0xa0, 0x88, 0xcb, 0x20, 0x5f, 0x81, 0xb1, 0x00, 0x8c, 0xb7,
+		0xf0, 0x04, 0xd9, 0xc6, 0x31, 0x75, 0xef, 0x3c, 0x3b, 0x76, 0x2b, 0xdf, 0x34, 0x75, 0xae, 0x30,
+		0x45, 0x96, 0x27, 0x00, 0x31, 0x4e, 0x74, 0x57, 0x5f, 0xf0, 0x5f, 0x83, 0xf1, 0x10, 0x94, 0xb7,
+		0x70, 0xf7, 0xc7, 0x05, 0x67, 0xbf, 0x35, 0x01, 0x7d, 0x94, 0x25, 0x7a, 0xaf, 0x14, 0xfa, 0x30,
+		0xd2, 0x23, 0xb1, 0x3c, 0xe2, 0x17, 0xaf, 0xf9, 0x47, 0xbb, 0x43, 0xe6, 0xa9, 0xd7, 0xc9, 0x77,
+		0xfc, 0xe9, 0xd7, 0xdf, 0x9f, 0x38, 0x8e, 0x26, 0x67, 0xe2, 0x16, 0xcc, 0x81, 0x71, 0xf1, 0xe1,
+		0xd0, 0x27, 0x02, 0x4e, 0x77, 0xd1, 0x88, 0x10, 0xe8, 0x91, 0xae, 0x70, 0xb9, 0x3c, 0xaf, 0xa3,
+		0xf2, 0x9c, 0x45, 0x8f, 0x75, 0x90, 0x67, 0xe6, 0x6d, 0xe1, 0x61, 0xf1, 0x76, 0xf4, 0x45, 0x09,
+		0x26, 0xe2, 0xd6, 0x5e, 0xe8, 0x7c, 0x17, 0xed, 0x37, 0xa7, 0x05, 0xf9, 0x27, 0x7b, 0x25, 0xe3,
+		0x1a, 0xcc, 0x53, 0x0d, 0x9e, 0x45, 0x4f, 0xf7, 0xaa, 0xc1, 0x4c, 0x70, 0x8b, 0xff, 0x3b, 0x12,
+		0x1c, 0x6d, 0xbb, 0x94, 0x41, 0xcf, 0x76, 0x21, 0x5f, 0x9b, 0xb4, 0x27, 0x7f, 0xe9, 0xae, 0xe9,
+		0xb9, 0xa2, 0x2b, 0x54, 0xd1, 0x2b, 0xe8, 0x72, 0xcf, 0x8a, 0xc6, 0x9e, 0x9d, 0xa0, 0xdf, 0x0b,
+		0x5f, 0xf7, 0x6c, 0xe3, 0x36, 0x4d, 0xcb, 0x83, 0xfc, 0xa3, 0xdd, 0x21, 0x73, 0xc9, 0x37, 0xa9,
+		0xe4, 0xab, 0x68, 0xf9, 0x07, 0xe9, 0xa2, 0x99, 0xb7, 0x85, 0xe3, 0xf7, 0xdb, 0xd1, 0x5f, 0x49,
+		0xf1, 0x17, 0x17, 0xcf, 0xb5, 0x16, 0xae, 0xf5, 0x8a, 0x27, 0x7f, 0xbe, 0x47, 0x2a, 0xae, 0xdb,
+		0x8b, 0x54, 0xb7, 0x2a, 0xda, 0xba, 0xa7, 0xba, 0xc5, 0x76, 0x19, 0xfa, 0x9c, 0x04, 0x13, 0x71,
+		0x0b, 0x86, 0x76, 0x43, 0xae, 0xcd, 0xda, 0xa7, 0xdd, 0x90, 0x6b, 0xb7, 0x2e, 0x91, 0x2f, 0x50,
+		0x9d, 0x9f, 0x40, 0x8f, 0xc7, 0xea, 0xdc, 0xb6, 0xcf, 0xc8, 0x38, 0x6b, 0x9b, 0x81, 0xb7, 0x1b,
+		0x67, 0xdd, 0x2c, 0x2f, 0xda, 0x8d, 0xb3, 0xae, 0x52, 0xff, 0x0e, 0xe3, 0xcc, 0x53, 0xa8, 0xcb,
+		0x4e, 0x73, 0xd0, 0xa7, 0x25, 0x18, 0x0e, 0xa5, 0xab, 0x68, 0xba, 0xb5, 0x88, 0x71, 0x79, 0x7c,
+		0x7e, 0xa6, 0x6b, 0x7c, 0xae, 0xc2, 0x65, 0xaa, 0xc2, 0xeb, 0xd1, 0xb3, 0x3d, 0xab, 0x10, 0x3e,
+		0xf8, 0xfc, 0x23, 0x09, 0xc6, 0x63, 0x52, 0xc0, 0x76, 0x23, 0xac, 0x75, 0x2e, 0x9b, 0x3f, 0xdf,
+		0x23, 0x15, 0x57, 0x66, 0x8e, 0x2a, 0xf3, 0x0c, 0xba, 0xd8, 0xb3, 0x32, 0x81, 0xe9, 0xf4, 0x3f,
+		0xfa, 0x17, 0x9f, 0x02, 0x8d, 0xa0, 0x27, 0x7a, 0x11, 0x49, 0xe8, 0x71, 0xae, 0x37, 0x22, 0xae,
+		0xc6, 0x06, 0x55, 0x63, 0x05, 0x2d, 0xfd, 0x00, 0x6a, 0x34, 0xcf, 0xc2, 0x1f, 0x6b, 0x7e, 0x1f,
+		0xd9, 0xc6, 0x5b, 0x62, 0xb3, 0xc7, 0xfc, 0x63, 0xdd, 0x13, 0x70, 0x5d, 0xce, 0x53, 0x5d, 0x66,
+		0xd0, 0x99, 0x58, 0x5d, 0x02, 0xf7, 0xf8, 0x34, 0x63, 0xdb, 0x9c, 0x79, 0x1b, 0xcb, 0x46, 0xdf,
+		0x8e, 0x6e, 0xf2, 0xab, 0x45, 0x0f, 0xb5, 0x6e, 0x30, 0x90, 0x51, 0xe6, 0x4f, 0x74, 0x42, 0xe3,
+		0xd2, 0x1c, 0xa7, 0xd2, 0x1c, 0x46, 0x87, 0x62, 0xa5, 0x21, 0x29, 0x25, 0xb9, 0x84, 0x27, 0x2e,
+		0x5b, 0x9e, 0x6c, 0xc3, 0x35, 0x98, 0x70, 0xe6, 0x4f, 0x75, 0x46, 0xe4, 0x02, 0x3c, 0x40, 0x05,
+		0x38, 0x8a, 0x0e, 0xc7, 0x0b, 0xc0, 0x72, 0xcf, 0x7b, 0x78, 0x94, 0xfe, 0xbf, 0x8e, 0xc2, 0x91,
+		0xb8, 0x96, 0xdc, 0x9b, 0xed, 0xce, 0x7f, 0xda, 0x3c, 0xfd, 0xed, 0xf8, 0xb4, 0xf7, 0x9e, 0x7e,
+		0xfb, 0xb5, 0x9b, 0xc3, 0xa2, 0xcf, 0xa6, 0x00, 0x2d, 0x3b, 0xb5, 0x39, 0x1b, 0xb3, 0x7f, 0x41,
+		0xc9, 0xc7, 0x6b, 0xe4, 0xe5, 0x9b, 0x74, 0xf7, 0x2f, 0xdf, 0xae, 0x86, 0xde, 0x92, 0x25, 0x7a,
+		0x78, 0x99, 0xda, 0xf5, 0x83, 0xb2, 0xe4, 0xfd, 0x7e, 0x50, 0x16, 0x7f, 0x21, 0x3b, 0x75, 0xef,
+		0x9e, 0x68, 0xf4, 0xdd, 0xd5, 0x13, 0x8d, 0x39, 0xe8, 0xe7, 0x8f, 0x41, 0xfb, 0xdb, 0x3c, 0x06,
+		0xdd, 0x1f, 0xff, 0xe2, 0x93, 0x93, 0x92, 0x6f, 0x50, 0xf9, 0xff, 0xb5, 0xb4, 0xd3, 0x4d, 0x52,
+		0x86, 0x1a, 0x58, 0x95, 0x1f, 0x81, 0x7c, 0xb3, 0xf7, 0x78, 0xe3, 0xf5, 0x5d, 0x49, 0xc8, 0x2e,
+		0x3b, 0xb5, 0x52, 0x55, 0x73, 0xef, 0x87, 0x6b, 0x5d, 0x6a, 0xfd, 0xda, 0x05, 0xdd, 0xb9, 0x3d,
+		0x39, 0xc2, 0x4c, 0xd9, 0xc6, 0x80, 0x3b, 0x30, 0x1a, 0x79, 0x3b, 0xcd, 0x7d, 0xe9, 0x52, 0x8f,
+		0xaf, 0xb7, 0x23, 0x5c, 0x64, 0x65, 0xc4, 0x87, 0xd0, 0xb7, 0xe2, 0x4e, 0xbc, 0xe7, 0x32, 0x17,
+		0x9a, 0xbb, 0x4f, 0xcf, 0x20, 0xfd, 0x6e, 0xca, 0x43, 0x2e, 0xda, 0x0f, 0x5e, 0x27, 0x7d, 0x4d,
+		0x82, 0xc1, 0x65, 0x47, 0x64, 0x68, 0xf8, 0xc7, 0xf4, 0x39, 0xd2, 0x39, 0xef, 0xbb, 0xe0, 0xc9,
+		0x2e, 0xfc, 0x94, 0xe3, 0x06, 0x2c, 0xb0, 0x1f, 0xc6, 0x03, 0x4a, 0x7a, 0xca, 0x7f, 0x31, 0x41,
+		0xc3, 0x5f, 0x11, 0xd7, 0x34, 0xc3, 0x4b, 0xf1, 0xf0, 0x4f, 0xea, 0x03, 0x0c, 0xdf, 0xc8, 0xa9,
+		0xbb, 0x32, 0xf2, 0x2e, 0xe4, 0x9b, 0x8d, 0xe9, 0xed, 0x20, 0x2d, 0x37, 0xbf, 0x08, 0x92, 0x7a,
+		0xf8, 0x00, 0x50, 0xe4, 0xdd, 0x8f, 0xfc, 0xdf, 0x24, 0x18, 0x5e, 0x76, 0x6a, 0x9b, 0x46, 0xf5,
+		0xff, 0x6d, 0xcf, 0xdd, 0x86, 0xfd, 0x21, 0x35, 0xef, 0x97, 0x3d, 0x3f, 0x93, 0x80, 0x23, 0x24,
+		0x96, 0xab, 0x46, 0x05, 0xeb, 0x7f, 0x77, 0xde, 0x29, 0xde, 0x95, 0x79, 0xe3, 0x9e, 0xb8, 0xa5,
+		0x7a, 0x7d, 0xe2, 0x16, 0xe8, 0xa3, 0x13, 0xf0, 0x60, 0x3b, 0xd3, 0x79, 0xe1, 0xe6, 0xd3, 0x09,
+		0xda, 0x99, 0xe2, 0x4e, 0xea, 0x8f, 0xbd, 0x71, 0x17, 0x61, 0xcc, 0xc6, 0x15, 0xcd, 0xd2, 0xb0,
+		0x11, 0x0d, 0x31, 0x01, 0x56, 0x4d, 0x28, 0xb2, 0x92, 0xf5, 0x60, 0xf7, 0x2a, 0xb6, 0x4c, 0xc2,
+		0xd1, 0x58, 0xcb, 0x09, 0xdb, 0x9e, 0xfd, 0xdb, 0x3e, 0x48, 0x2e, 0x3b, 0x35, 0xf2, 0x59, 0x96,
+		0x68, 0x36, 0x1b, 0xbf, 0x14, 0x69, 0x4e, 0x5c, 0xf2, 0x33, 0x5d, 0x22, 0x7a, 0x63, 0x10, 0xc3,
+		0x70, 0x38, 0xbb, 0x79, 0xa8, 0x15, 0x87, 0x10, 0x5a, 0xfe, 0x4c, 0x57, 0x68, 0x5e, 0x33, 0xd7,
+		0x20, 0xcd, 0x35, 0xc6, 0x68, 0xaa, 0x15, 0xa9, 0xc0, 0xc8, 0x9f, 0xea, 0x84, 0xe1, 0xf1, 0xdd,
+		0x85, 0xd1, 0xe8, 0xd4, 0xd7, 0xd2, 0x56, 0x11, 0xc4, 0xfc, 0x4c, 0x97, 0x88, 0x5e, 0x63, 0x3f,
+		0x05, 0x10, 0x08, 0xd6, 0x72, 0x2b, 0x72, 0x1f, 0x27, 0x7f, 0xba, 0x33, 0x8e, 0xc7, 0xfd, 0x3d,
+		0x12, 0x1c, 0x6a, 0x1d, 0xbb, 0x1e, 0x6f, 0xd9, 0xb1, 0xad, 0x48, 0xf2, 0x17, 0x7a, 0x26, 0xf1,
+		0x64, 0x71, 0x01, 0xc5, 0x0c, 0xf1, 0x96, 0xda, 0x34, 0xe3, 0xe6, 0xcf, 0x76, 0x8f, 0xeb, 0x9d,
+		0xa4, 0xde, 0xbb, 0x85, 0xef, 0xff, 0x1d, 0x00, 0xe7, 0x8d, 0x21, 0xb5, 0x70, 0x9b, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2067,6 +2150,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.BondDenom != that1.BondDenom {
 		return false
 	}
+	if !this.GlobalTransferCap.Equal(that1.GlobalTransferCap) {
+		return false
+	}
+	if !this.ValidatorTransferCap.Equal(that1.ValidatorTransferCap) {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -2676,6 +2765,53 @@ func (m *Delegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TransferredDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferredDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferredDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *UnbondingDelegation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2917,6 +3053,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ValidatorTransferCap.Size()
+		i -= size
+		if _, err := m.ValidatorTransferCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.GlobalTransferCap.Size()
+		i -= size
+		if _, err := m.GlobalTransferCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	if len(m.BondDenom) > 0 {
 		i -= len(m.BondDenom)
 		copy(dAtA[i:], m.BondDenom)
@@ -3351,6 +3507,25 @@ func (m *Delegation) Size() (n int) {
 	return n
 }
 
+func (m *TransferredDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
 func (m *UnbondingDelegation) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3458,6 +3633,10 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStaking(uint64(l))
 	}
+	l = m.GlobalTransferCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorTransferCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
@@ -5171,6 +5350,154 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TransferredDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferredDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferredDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5990,6 +6317,74 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.BondDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalTransferCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalTransferCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorTransferCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorTransferCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -10,6 +10,7 @@ import (
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
 	types "github.com/line/lbm-sdk/codec/types"
 	github_com_line_lbm_sdk_types "github.com/line/lbm-sdk/types"
 	types1 "github.com/line/lbm-sdk/types"
@@ -17,7 +18,6 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
-	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -450,6 +450,165 @@ func (m *MsgUndelegateResponse) GetCompletionTime() time.Time {
 	return time.Time{}
 }
 
+// MsgCancelUnbondingDelegation defines the SDK message for performing a cancel unbonding delegation for delegator
+type MsgCancelUnbondingDelegation struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	// amount is always less than or equal to unbonding delegation entry balance
+	Amount types1.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// creation_height is the height which the unbonding took place.
+	CreationHeight int64 `protobuf:"varint,4,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty" yaml:"creation_height"`
+}
+
+func (m *MsgCancelUnbondingDelegation) Reset()         { *m = MsgCancelUnbondingDelegation{} }
+func (m *MsgCancelUnbondingDelegation) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelUnbondingDelegation) ProtoMessage()    {}
+func (*MsgCancelUnbondingDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10515cdafc5496be, []int{10}
+}
+func (m *MsgCancelUnbondingDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelUnbondingDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelUnbondingDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelUnbondingDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelUnbondingDelegation.Merge(m, src)
+}
+func (m *MsgCancelUnbondingDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelUnbondingDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelUnbondingDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelUnbondingDelegation proto.InternalMessageInfo
+
+// MsgCancelUnbondingDelegationResponse defines the Msg/CancelUnbondingDelegation response type.
+type MsgCancelUnbondingDelegationResponse struct {
+}
+
+func (m *MsgCancelUnbondingDelegationResponse) Reset()         { *m = MsgCancelUnbondingDelegationResponse{} }
+func (m *MsgCancelUnbondingDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelUnbondingDelegationResponse) ProtoMessage()    {}
+func (*MsgCancelUnbondingDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10515cdafc5496be, []int{11}
+}
+func (m *MsgCancelUnbondingDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelUnbondingDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelUnbondingDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelUnbondingDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelUnbondingDelegationResponse.Merge(m, src)
+}
+func (m *MsgCancelUnbondingDelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelUnbondingDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelUnbondingDelegationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelUnbondingDelegationResponse proto.InternalMessageInfo
+
+// MsgTransferDelegation defines a SDK message for transferring delegation
+// shares worth of amount from a delegator to a recipient.
+type MsgTransferDelegation struct {
+	DelegatorAddress string      `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	ValidatorAddress string      `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	RecipientAddress string      `protobuf:"bytes,3,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty" yaml:"recipient_address"`
+	Amount           types1.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgTransferDelegation) Reset()         { *m = MsgTransferDelegation{} }
+func (m *MsgTransferDelegation) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferDelegation) ProtoMessage()    {}
+func (*MsgTransferDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10515cdafc5496be, []int{12}
+}
+func (m *MsgTransferDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferDelegation.Merge(m, src)
+}
+func (m *MsgTransferDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferDelegation proto.InternalMessageInfo
+
+// MsgTransferDelegationResponse defines the Msg/TransferDelegation response type.
+type MsgTransferDelegationResponse struct {
+}
+
+func (m *MsgTransferDelegationResponse) Reset()         { *m = MsgTransferDelegationResponse{} }
+func (m *MsgTransferDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferDelegationResponse) ProtoMessage()    {}
+func (*MsgTransferDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10515cdafc5496be, []int{13}
+}
+func (m *MsgTransferDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferDelegationResponse.Merge(m, src)
+}
+func (m *MsgTransferDelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferDelegationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferDelegationResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateValidator)(nil), "lbm.staking.v1beta1.MsgCreateValidator")
 	proto.RegisterType((*MsgCreateValidatorResponse)(nil), "lbm.staking.v1beta1.MsgCreateValidatorResponse")
@@ -461,66 +620,79 @@ func init() {
 	proto.RegisterType((*MsgBeginRedelegateResponse)(nil), "lbm.staking.v1beta1.MsgBeginRedelegateResponse")
 	proto.RegisterType((*MsgUndelegate)(nil), "lbm.staking.v1beta1.MsgUndelegate")
 	proto.RegisterType((*MsgUndelegateResponse)(nil), "lbm.staking.v1beta1.MsgUndelegateResponse")
+	proto.RegisterType((*MsgCancelUnbondingDelegation)(nil), "lbm.staking.v1beta1.MsgCancelUnbondingDelegation")
+	proto.RegisterType((*MsgCancelUnbondingDelegationResponse)(nil), "lbm.staking.v1beta1.MsgCancelUnbondingDelegationResponse")
+	proto.RegisterType((*MsgTransferDelegation)(nil), "lbm.staking.v1beta1.MsgTransferDelegation")
+	proto.RegisterType((*MsgTransferDelegationResponse)(nil), "lbm.staking.v1beta1.MsgTransferDelegationResponse")
 }
 
 func init() { proto.RegisterFile("lbm/staking/v1beta1/tx.proto", fileDescriptor_10515cdafc5496be) }
 
 var fileDescriptor_10515cdafc5496be = []byte{
-	// 859 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0xcd, 0x6e, 0xeb, 0x44,
-	0x14, 0x8e, 0x93, 0xdc, 0x50, 0xa6, 0xba, 0xb7, 0xad, 0x73, 0x73, 0x95, 0x6b, 0xa2, 0x38, 0xb5,
-	0x40, 0xad, 0x90, 0x6a, 0xab, 0x85, 0x55, 0x37, 0x55, 0x93, 0x20, 0x28, 0x28, 0x12, 0x72, 0x4b,
-	0x17, 0x08, 0x29, 0xf2, 0xcf, 0xc4, 0xb5, 0x62, 0x7b, 0x2c, 0xcf, 0xa4, 0x34, 0x2f, 0x80, 0x58,
-	0xf6, 0x11, 0xfa, 0x10, 0x2c, 0x79, 0x80, 0x0a, 0x36, 0x5d, 0x22, 0x16, 0x01, 0x35, 0x42, 0x62,
-	0x9d, 0x35, 0x0b, 0xe4, 0xf1, 0x4f, 0x1c, 0xc7, 0x26, 0x55, 0x45, 0x36, 0x77, 0x97, 0xcc, 0xf9,
-	0xce, 0x37, 0x73, 0xbe, 0xf3, 0xcd, 0x19, 0x83, 0x86, 0xa5, 0xda, 0x12, 0x26, 0xca, 0xd0, 0x74,
-	0x0c, 0xe9, 0xfa, 0x50, 0x85, 0x44, 0x39, 0x94, 0xc8, 0x8d, 0xe8, 0x7a, 0x88, 0x20, 0xb6, 0x6a,
-	0xa9, 0xb6, 0x18, 0x46, 0xc5, 0x30, 0xca, 0xbd, 0x35, 0x10, 0x32, 0x2c, 0x28, 0x51, 0x88, 0x3a,
-	0x1a, 0x48, 0x8a, 0x33, 0x0e, 0xf0, 0x1c, 0x9f, 0x0e, 0x11, 0xd3, 0x86, 0x98, 0x28, 0xb6, 0x1b,
-	0x02, 0x5e, 0x1b, 0xc8, 0x40, 0xf4, 0xa7, 0xe4, 0xff, 0x0a, 0x57, 0xdf, 0x6a, 0x08, 0xdb, 0x08,
-	0xf7, 0x83, 0x40, 0xf0, 0x27, 0x0c, 0x7d, 0xe0, 0x9f, 0x4f, 0x55, 0x30, 0x8c, 0x0f, 0xa7, 0x21,
-	0xd3, 0x09, 0x83, 0xbb, 0x59, 0x87, 0x8f, 0x8e, 0x4b, 0x21, 0xc2, 0xcf, 0x65, 0xc0, 0xf6, 0xb0,
-	0xd1, 0xf1, 0xa0, 0x42, 0xe0, 0xa5, 0x62, 0x99, 0xba, 0x42, 0x90, 0xc7, 0x7e, 0x01, 0x36, 0x75,
-	0x88, 0x35, 0xcf, 0x74, 0x89, 0x89, 0x9c, 0x3a, 0xd3, 0x62, 0xf6, 0x37, 0x8f, 0x5a, 0x62, 0x46,
-	0xb9, 0x62, 0x77, 0x8e, 0x6b, 0x97, 0xef, 0x27, 0x7c, 0x41, 0x4e, 0xa6, 0xb2, 0x5f, 0x02, 0xa0,
-	0x21, 0xdb, 0x36, 0x31, 0xf6, 0x89, 0x8a, 0x94, 0xe8, 0xc3, 0x4c, 0xa2, 0x4e, 0x0c, 0x93, 0x15,
-	0x02, 0x71, 0x48, 0x96, 0xc8, 0x66, 0xbf, 0x07, 0x55, 0xdb, 0x74, 0xfa, 0x18, 0x5a, 0x83, 0xbe,
-	0x0e, 0x2d, 0x68, 0x28, 0xf4, 0x74, 0xa5, 0x16, 0xb3, 0xff, 0x7e, 0xfb, 0x73, 0x1f, 0xfe, 0xfb,
-	0x84, 0xdf, 0x35, 0x4c, 0x72, 0x35, 0x52, 0x45, 0x0d, 0xd9, 0x92, 0x65, 0x3a, 0x50, 0xb2, 0x54,
-	0xfb, 0x00, 0xeb, 0x43, 0x89, 0x8c, 0x5d, 0x88, 0xc5, 0x33, 0x87, 0xcc, 0x26, 0x3c, 0x37, 0x56,
-	0x6c, 0xeb, 0x58, 0xc8, 0x60, 0x13, 0xe4, 0x1d, 0xdb, 0x74, 0xce, 0xa1, 0x35, 0xe8, 0xc6, 0x6b,
-	0xec, 0x19, 0xd8, 0x09, 0x11, 0xc8, 0xeb, 0x2b, 0xba, 0xee, 0x41, 0x8c, 0xeb, 0x65, 0xba, 0x6d,
-	0x63, 0x36, 0xe1, 0xeb, 0x01, 0xdb, 0x12, 0x44, 0x90, 0xb7, 0xe3, 0xb5, 0xd3, 0x60, 0xc9, 0xa7,
-	0xba, 0x8e, 0x64, 0x8e, 0xa9, 0x5e, 0xa4, 0xa9, 0x96, 0x20, 0x82, 0xbc, 0x1d, 0xaf, 0x45, 0x54,
-	0x1d, 0x50, 0x71, 0x47, 0xea, 0x10, 0x8e, 0xeb, 0x15, 0x2a, 0xeb, 0x6b, 0x31, 0xb0, 0x97, 0x18,
-	0xd9, 0x4b, 0x3c, 0x75, 0xc6, 0xed, 0xda, 0x2f, 0x3f, 0x1d, 0xec, 0xf8, 0x7a, 0x6b, 0xde, 0xd8,
-	0x25, 0x48, 0xfc, 0x7a, 0xa4, 0x7e, 0x05, 0xc7, 0x72, 0x98, 0xca, 0x1e, 0x81, 0x17, 0xd7, 0x8a,
-	0x35, 0x82, 0xf5, 0xf7, 0x28, 0xc7, 0x1b, 0xda, 0x1a, 0xdf, 0x50, 0x89, 0xbe, 0x98, 0x51, 0x67,
-	0x03, 0xe8, 0xf1, 0xc6, 0x8f, 0x77, 0x7c, 0xe1, 0xef, 0x3b, 0xbe, 0x20, 0x34, 0x00, 0xb7, 0xec,
-	0x1e, 0x19, 0x62, 0x17, 0x39, 0x18, 0x0a, 0x3f, 0x94, 0xc0, 0x76, 0x0f, 0x1b, 0x9f, 0xe9, 0x26,
-	0x59, 0x87, 0xb5, 0x4e, 0xb2, 0xa4, 0x2c, 0x52, 0x29, 0xd9, 0xd9, 0x84, 0x7f, 0x15, 0x48, 0xf9,
-	0x1f, 0x02, 0x5e, 0x81, 0xad, 0xb9, 0xbb, 0xfa, 0x9e, 0x42, 0x60, 0xe8, 0xa5, 0x93, 0xd5, 0x3e,
-	0xea, 0x42, 0x6d, 0x36, 0xe1, 0xdf, 0x04, 0x7b, 0xa4, 0x58, 0x04, 0xf9, 0x95, 0xb6, 0x60, 0x66,
-	0x16, 0x67, 0x3b, 0x37, 0xb0, 0x50, 0x67, 0x3d, 0xae, 0x4d, 0xb4, 0x89, 0x03, 0xf5, 0x74, 0x1f,
-	0xe2, 0x26, 0x4d, 0x19, 0xb0, 0xd9, 0xc3, 0x46, 0x98, 0x07, 0xb3, 0xbd, 0xce, 0xfc, 0x7f, 0x5e,
-	0x2f, 0x3e, 0xcb, 0xeb, 0x9f, 0x82, 0x8a, 0x62, 0xa3, 0x91, 0x43, 0x68, 0x87, 0x56, 0xf9, 0x34,
-	0xc4, 0x26, 0x14, 0xa8, 0x81, 0x6a, 0xa2, 0xc8, 0xb8, 0xf8, 0x5f, 0x8b, 0x74, 0xfc, 0xb5, 0xa1,
-	0x61, 0x3a, 0x32, 0xd4, 0xd7, 0xa0, 0xc1, 0x05, 0xa8, 0xcd, 0x0b, 0xc4, 0x9e, 0x96, 0xd2, 0xa1,
-	0x35, 0x9b, 0xf0, 0x8d, 0xb4, 0x0e, 0x09, 0x98, 0x20, 0x57, 0xe3, 0xf5, 0x73, 0x4f, 0xcb, 0x64,
-	0xd5, 0x31, 0x89, 0x59, 0x4b, 0xf9, 0xac, 0x09, 0x58, 0x92, 0xb5, 0x8b, 0xc9, 0xb2, 0xc8, 0xe5,
-	0x67, 0x89, 0x3c, 0xa4, 0xd3, 0x20, 0x25, 0x66, 0xa4, 0x35, 0xdb, 0xa3, 0xb7, 0xcd, 0xb5, 0xa0,
-	0x6f, 0xce, 0xbe, 0xff, 0xf2, 0x85, 0x97, 0x9f, 0x5b, 0x9a, 0x5b, 0x17, 0xd1, 0xb3, 0xd8, 0xde,
-	0xf0, 0xb7, 0xba, 0xfd, 0x83, 0x67, 0xe8, 0x95, 0x0a, 0x93, 0xfd, 0xb0, 0xf0, 0x17, 0x03, 0x5e,
-	0xf6, 0xb0, 0xf1, 0x8d, 0xa3, 0xbf, 0xdb, 0xce, 0x1d, 0x80, 0xda, 0x42, 0x99, 0x6b, 0xd2, 0xf3,
-	0xe8, 0x9f, 0x12, 0x28, 0xf5, 0xb0, 0xc1, 0x0e, 0xc1, 0x56, 0xfa, 0x6b, 0x60, 0x2f, 0x73, 0x3a,
-	0x2f, 0x0f, 0x7e, 0x4e, 0x7a, 0x22, 0x30, 0xae, 0x01, 0x82, 0x97, 0x8b, 0xaf, 0xc3, 0x47, 0x79,
-	0x0c, 0x0b, 0x30, 0xee, 0xe0, 0x49, 0xb0, 0x78, 0x9b, 0x4b, 0xb0, 0x11, 0xcf, 0xb7, 0x56, 0x5e,
-	0x6a, 0x84, 0xe0, 0xf6, 0x57, 0x21, 0x62, 0xde, 0x21, 0xd8, 0x4a, 0x8f, 0x8e, 0x5c, 0xad, 0x52,
-	0xc0, 0x7c, 0xad, 0xf2, 0xee, 0xcf, 0x77, 0x00, 0x24, 0xcc, 0x2e, 0xe4, 0xa5, 0xcf, 0x31, 0xdc,
-	0xc7, 0xab, 0x31, 0x11, 0x7b, 0xfb, 0xf4, 0xfe, 0xb1, 0xc9, 0x3c, 0x3c, 0x36, 0x99, 0x3f, 0x1f,
-	0x9b, 0xcc, 0xed, 0xb4, 0x59, 0x78, 0x98, 0x36, 0x0b, 0xbf, 0x4d, 0x9b, 0x85, 0x6f, 0xf7, 0xf2,
-	0x9e, 0xa6, 0x9b, 0xf8, 0xdb, 0x92, 0x3e, 0x52, 0x6a, 0x85, 0xfa, 0xed, 0x93, 0x7f, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0x37, 0xf3, 0x43, 0x27, 0x34, 0x0b, 0x00, 0x00,
+	// 999 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xf6, 0xda, 0x49, 0x08, 0x13, 0x35, 0x1f, 0x9b, 0xa6, 0x72, 0x16, 0xe3, 0x75, 0x57, 0x85,
+	0x46, 0x48, 0xd9, 0x55, 0x02, 0x17, 0x7a, 0xa9, 0x62, 0x07, 0xd1, 0x80, 0x2c, 0xa1, 0x6d, 0xda,
+	0x03, 0x42, 0xb2, 0xf6, 0x63, 0xbc, 0x19, 0x79, 0x77, 0xc6, 0xda, 0x19, 0x87, 0xfa, 0x0f, 0x20,
+	0xb8, 0xf5, 0x27, 0xf4, 0x47, 0x70, 0x00, 0x89, 0x1f, 0x50, 0xc1, 0xa5, 0x47, 0xc4, 0xc1, 0xa0,
+	0x44, 0x48, 0x9c, 0xfd, 0x03, 0x10, 0xda, 0xaf, 0xf1, 0x7a, 0x3f, 0x1a, 0x53, 0x35, 0x12, 0xf4,
+	0xb6, 0x7e, 0xe7, 0x79, 0x9f, 0x99, 0x79, 0xde, 0x67, 0xde, 0x19, 0x83, 0x86, 0x6b, 0x7a, 0x1a,
+	0x65, 0xc6, 0x00, 0x61, 0x47, 0x3b, 0x3f, 0x30, 0x21, 0x33, 0x0e, 0x34, 0xf6, 0x44, 0x1d, 0xfa,
+	0x84, 0x11, 0x71, 0xdb, 0x35, 0x3d, 0x35, 0x1e, 0x55, 0xe3, 0x51, 0x69, 0xd7, 0x21, 0xc4, 0x71,
+	0xa1, 0x16, 0x42, 0xcc, 0x51, 0x5f, 0x33, 0xf0, 0x38, 0xc2, 0x4b, 0x72, 0x76, 0x88, 0x21, 0x0f,
+	0x52, 0x66, 0x78, 0xc3, 0x18, 0x70, 0xd3, 0x21, 0x0e, 0x09, 0x3f, 0xb5, 0xe0, 0x2b, 0x8e, 0xee,
+	0x5a, 0x84, 0x7a, 0x84, 0xf6, 0xa2, 0x81, 0xe8, 0x47, 0x3c, 0xf4, 0x4e, 0xb0, 0x3e, 0xd3, 0xa0,
+	0x90, 0x2f, 0xce, 0x22, 0x08, 0xc7, 0x83, 0xb7, 0x8b, 0x16, 0x9f, 0x2c, 0x37, 0x84, 0x28, 0x3f,
+	0x2d, 0x01, 0xb1, 0x4b, 0x9d, 0x8e, 0x0f, 0x0d, 0x06, 0x1f, 0x1b, 0x2e, 0xb2, 0x0d, 0x46, 0x7c,
+	0xf1, 0x01, 0x58, 0xb3, 0x21, 0xb5, 0x7c, 0x34, 0x64, 0x88, 0xe0, 0xba, 0xd0, 0x12, 0xf6, 0xd6,
+	0x0e, 0x5b, 0x6a, 0xc1, 0x76, 0xd5, 0xe3, 0x19, 0xae, 0xbd, 0xf4, 0x7c, 0x22, 0x57, 0xf4, 0x74,
+	0xaa, 0xf8, 0x19, 0x00, 0x16, 0xf1, 0x3c, 0x44, 0x69, 0x40, 0x54, 0x0d, 0x89, 0xee, 0x14, 0x12,
+	0x75, 0x38, 0x4c, 0x37, 0x18, 0xa4, 0x31, 0x59, 0x2a, 0x5b, 0xfc, 0x1a, 0x6c, 0x7b, 0x08, 0xf7,
+	0x28, 0x74, 0xfb, 0x3d, 0x1b, 0xba, 0xd0, 0x31, 0xc2, 0xd5, 0xd5, 0x5a, 0xc2, 0xde, 0xdb, 0xed,
+	0x4f, 0x03, 0xf8, 0x6f, 0x13, 0xf9, 0xb6, 0x83, 0xd8, 0xd9, 0xc8, 0x54, 0x2d, 0xe2, 0x69, 0x2e,
+	0xc2, 0x50, 0x73, 0x4d, 0x6f, 0x9f, 0xda, 0x03, 0x8d, 0x8d, 0x87, 0x90, 0xaa, 0x27, 0x98, 0x4d,
+	0x27, 0xb2, 0x34, 0x36, 0x3c, 0xf7, 0x9e, 0x52, 0xc0, 0xa6, 0xe8, 0x5b, 0x1e, 0xc2, 0x0f, 0xa1,
+	0xdb, 0x3f, 0xe6, 0x31, 0xf1, 0x04, 0x6c, 0xc5, 0x08, 0xe2, 0xf7, 0x0c, 0xdb, 0xf6, 0x21, 0xa5,
+	0xf5, 0xa5, 0x70, 0xda, 0xc6, 0x74, 0x22, 0xd7, 0x23, 0xb6, 0x1c, 0x44, 0xd1, 0x37, 0x79, 0xec,
+	0x28, 0x0a, 0x05, 0x54, 0xe7, 0x89, 0xcc, 0x9c, 0x6a, 0x39, 0x4b, 0x95, 0x83, 0x28, 0xfa, 0x26,
+	0x8f, 0x25, 0x54, 0x1d, 0xb0, 0x32, 0x1c, 0x99, 0x03, 0x38, 0xae, 0xaf, 0x84, 0xb2, 0xde, 0x54,
+	0x23, 0x7b, 0xa9, 0x89, 0xbd, 0xd4, 0x23, 0x3c, 0x6e, 0xef, 0xfc, 0xfc, 0xfd, 0xfe, 0x56, 0xa0,
+	0xb7, 0xe5, 0x8f, 0x87, 0x8c, 0xa8, 0x5f, 0x8c, 0xcc, 0xcf, 0xe1, 0x58, 0x8f, 0x53, 0xc5, 0x43,
+	0xb0, 0x7c, 0x6e, 0xb8, 0x23, 0x58, 0x7f, 0x2b, 0xe4, 0xb8, 0x15, 0x96, 0x26, 0x30, 0x54, 0xaa,
+	0x2e, 0x28, 0xa9, 0x6c, 0x04, 0xbd, 0xb7, 0xfa, 0xed, 0x33, 0xb9, 0xf2, 0xd7, 0x33, 0xb9, 0xa2,
+	0x34, 0x80, 0x94, 0x77, 0x8f, 0x0e, 0xe9, 0x90, 0x60, 0x0a, 0x95, 0x6f, 0x6a, 0x60, 0xb3, 0x4b,
+	0x9d, 0x4f, 0x6c, 0xc4, 0xae, 0xc3, 0x5a, 0xf7, 0x8b, 0xa4, 0xac, 0x86, 0x52, 0x8a, 0xd3, 0x89,
+	0xbc, 0x1e, 0x49, 0xf9, 0x12, 0x01, 0xcf, 0xc0, 0xc6, 0xcc, 0x5d, 0x3d, 0xdf, 0x60, 0x30, 0xf6,
+	0xd2, 0xfd, 0xab, 0x7d, 0x74, 0x0c, 0xad, 0xe9, 0x44, 0xbe, 0x15, 0xcd, 0x91, 0x61, 0x51, 0xf4,
+	0x75, 0x6b, 0xce, 0xcc, 0x22, 0x2d, 0x76, 0x6e, 0x64, 0xa1, 0xce, 0xf5, 0xb8, 0x36, 0x55, 0x26,
+	0x09, 0xd4, 0xb3, 0x75, 0xe0, 0x45, 0xba, 0x14, 0xc0, 0x5a, 0x97, 0x3a, 0x71, 0x1e, 0x2c, 0xf6,
+	0xba, 0xf0, 0xfa, 0xbc, 0x5e, 0x7d, 0x25, 0xaf, 0x7f, 0x04, 0x56, 0x0c, 0x8f, 0x8c, 0x30, 0xab,
+	0xd7, 0x16, 0xf0, 0x69, 0x8c, 0x4d, 0x29, 0xb0, 0x03, 0xb6, 0x53, 0x9b, 0xe4, 0x9b, 0xff, 0xa5,
+	0x1a, 0xb6, 0xbf, 0x36, 0x74, 0x10, 0xd6, 0xa1, 0x7d, 0x0d, 0x1a, 0x9c, 0x82, 0x9d, 0xd9, 0x06,
+	0xa9, 0x6f, 0x65, 0x74, 0x68, 0x4d, 0x27, 0x72, 0x23, 0xab, 0x43, 0x0a, 0xa6, 0xe8, 0xdb, 0x3c,
+	0xfe, 0xd0, 0xb7, 0x0a, 0x59, 0x6d, 0xca, 0x38, 0x6b, 0xad, 0x9c, 0x35, 0x05, 0x4b, 0xb3, 0x1e,
+	0x53, 0x96, 0x17, 0x79, 0xe9, 0x95, 0x44, 0x1e, 0x00, 0x29, 0x2f, 0x66, 0xa2, 0xb5, 0xd8, 0x0d,
+	0x4f, 0xdb, 0xd0, 0x85, 0x81, 0x39, 0x7b, 0xc1, 0xcd, 0x17, 0x1f, 0x7e, 0x29, 0xd7, 0xb7, 0x4e,
+	0x93, 0x6b, 0xb1, 0xbd, 0x1a, 0x4c, 0xf5, 0xf4, 0x77, 0x59, 0xd0, 0xd7, 0x67, 0xc9, 0xc1, 0xb0,
+	0xf2, 0xa7, 0x00, 0x6e, 0x74, 0xa9, 0xf3, 0x08, 0xdb, 0x6f, 0xb6, 0x73, 0xfb, 0x60, 0x67, 0x6e,
+	0x9b, 0xd7, 0xa5, 0xe7, 0x8f, 0x55, 0xd0, 0x08, 0x7a, 0xb9, 0x81, 0x2d, 0xe8, 0x3e, 0xc2, 0x26,
+	0xc1, 0x36, 0xc2, 0xce, 0x55, 0x97, 0xe0, 0xff, 0x53, 0x5e, 0xb1, 0x03, 0x36, 0xac, 0xe0, 0xd2,
+	0x0a, 0x94, 0x3b, 0x83, 0xc8, 0x39, 0x8b, 0x2c, 0x5f, 0x6b, 0x4b, 0xa9, 0xa6, 0x3e, 0x0f, 0x08,
+	0x9a, 0x7a, 0x1c, 0x79, 0x10, 0x06, 0x52, 0x35, 0x7a, 0x1f, 0xdc, 0x79, 0x99, 0x74, 0xbc, 0xdd,
+	0xfc, 0x50, 0x0d, 0x8b, 0x79, 0xea, 0x1b, 0x98, 0xf6, 0xa1, 0xff, 0x9f, 0x17, 0xf7, 0x04, 0x6c,
+	0xf9, 0xd0, 0x42, 0x43, 0x04, 0x71, 0xb6, 0xc5, 0xa4, 0xa8, 0x72, 0x10, 0x45, 0xdf, 0xe4, 0xb1,
+	0xd7, 0xd5, 0x5b, 0x64, 0xf0, 0x6e, 0xa1, 0x72, 0x89, 0xb6, 0x87, 0x7f, 0x2f, 0x83, 0x5a, 0x97,
+	0x3a, 0xe2, 0x00, 0x6c, 0x64, 0x5f, 0xb3, 0x77, 0x0b, 0x5f, 0x17, 0xf9, 0x87, 0x8b, 0xa4, 0x2d,
+	0x08, 0xe4, 0x67, 0x10, 0x82, 0x1b, 0xf3, 0xaf, 0x9b, 0xf7, 0xca, 0x18, 0xe6, 0x60, 0xd2, 0xfe,
+	0x42, 0x30, 0x3e, 0xcd, 0x63, 0xb0, 0xca, 0xef, 0xe7, 0x56, 0x59, 0x6a, 0x82, 0x90, 0xf6, 0xae,
+	0x42, 0x70, 0xde, 0x01, 0xd8, 0xc8, 0x5e, 0x7d, 0xa5, 0x5a, 0x65, 0x80, 0x92, 0xb6, 0x20, 0x90,
+	0x4f, 0xf6, 0x15, 0x00, 0xa9, 0x66, 0xad, 0x94, 0xa5, 0xcf, 0x30, 0xd2, 0x07, 0x57, 0x63, 0x38,
+	0xfb, 0x77, 0x02, 0xd8, 0x2d, 0xef, 0x5d, 0x07, 0xa5, 0x85, 0x2d, 0x4b, 0x91, 0x3e, 0xfe, 0xd7,
+	0x29, 0x7c, 0x2d, 0x0c, 0x88, 0x05, 0x47, 0xbc, 0x74, 0x37, 0x79, 0xac, 0x74, 0xb8, 0x38, 0x36,
+	0x99, 0xb5, 0x7d, 0xf4, 0xfc, 0xa2, 0x29, 0xbc, 0xb8, 0x68, 0x0a, 0x7f, 0x5c, 0x34, 0x85, 0xa7,
+	0x97, 0xcd, 0xca, 0x8b, 0xcb, 0x66, 0xe5, 0xd7, 0xcb, 0x66, 0xe5, 0xcb, 0xbb, 0x65, 0x8f, 0xcb,
+	0x27, 0xfc, 0xdf, 0x61, 0xf8, 0xcc, 0x34, 0x57, 0xc2, 0x1b, 0xe3, 0xc3, 0x7f, 0x06, 0x00, 0xbd,
+	0xb7, 0x34, 0x98, 0xf6, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -548,6 +720,12 @@ type MsgClient interface {
 	// Undelegate defines a method for performing an undelegation from a
 	// delegate and a validator.
 	Undelegate(ctx context.Context, in *MsgUndelegate, opts ...grpc.CallOption) (*MsgUndelegateResponse, error)
+	// CancelUnbondingDelegation defines a method for performing canceling the unbonding delegation
+	// and delegate back to previous validator.
+	CancelUnbondingDelegation(ctx context.Context, in *MsgCancelUnbondingDelegation, opts ...grpc.CallOption) (*MsgCancelUnbondingDelegationResponse, error)
+	// TransferDelegation defines a method for transferring delegation shares
+	// to another account without unbonding them.
+	TransferDelegation(ctx context.Context, in *MsgTransferDelegation, opts ...grpc.CallOption) (*MsgTransferDelegationResponse, error)
 }
 
 type msgClient struct {
@@ -603,6 +781,24 @@ func (c *msgClient) Undelegate(ctx context.Context, in *MsgUndelegate, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) CancelUnbondingDelegation(ctx context.Context, in *MsgCancelUnbondingDelegation, opts ...grpc.CallOption) (*MsgCancelUnbondingDelegationResponse, error) {
+	out := new(MsgCancelUnbondingDelegationResponse)
+	err := c.cc.Invoke(ctx, "/lbm.staking.v1beta1.Msg/CancelUnbondingDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferDelegation(ctx context.Context, in *MsgTransferDelegation, opts ...grpc.CallOption) (*MsgTransferDelegationResponse, error) {
+	out := new(MsgTransferDelegationResponse)
+	err := c.cc.Invoke(ctx, "/lbm.staking.v1beta1.Msg/TransferDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateValidator defines a method for creating a new validator.
@@ -618,6 +814,12 @@ type MsgServer interface {
 	// Undelegate defines a method for performing an undelegation from a
 	// delegate and a validator.
 	Undelegate(context.Context, *MsgUndelegate) (*MsgUndelegateResponse, error)
+	// CancelUnbondingDelegation defines a method for performing canceling the unbonding delegation
+	// and delegate back to previous validator.
+	CancelUnbondingDelegation(context.Context, *MsgCancelUnbondingDelegation) (*MsgCancelUnbondingDelegationResponse, error)
+	// TransferDelegation defines a method for transferring delegation shares
+	// to another account without unbonding them.
+	TransferDelegation(context.Context, *MsgTransferDelegation) (*MsgTransferDelegationResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -639,6 +841,12 @@ func (*UnimplementedMsgServer) BeginRedelegate(ctx context.Context, req *MsgBegi
 func (*UnimplementedMsgServer) Undelegate(ctx context.Context, req *MsgUndelegate) (*MsgUndelegateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Undelegate not implemented")
 }
+func (*UnimplementedMsgServer) CancelUnbondingDelegation(ctx context.Context, req *MsgCancelUnbondingDelegation) (*MsgCancelUnbondingDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelUnbondingDelegation not implemented")
+}
+func (*UnimplementedMsgServer) TransferDelegation(ctx context.Context, req *MsgTransferDelegation) (*MsgTransferDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferDelegation not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -734,6 +942,42 @@ func _Msg_Undelegate_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelUnbondingDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelUnbondingDelegation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelUnbondingDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.staking.v1beta1.Msg/CancelUnbondingDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelUnbondingDelegation(ctx, req.(*MsgCancelUnbondingDelegation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferDelegation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.staking.v1beta1.Msg/TransferDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferDelegation(ctx, req.(*MsgTransferDelegation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lbm.staking.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -758,6 +1002,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Undelegate",
 			Handler:    _Msg_Undelegate_Handler,
 		},
+		{
+			MethodName: "CancelUnbondingDelegation",
+			Handler:    _Msg_CancelUnbondingDelegation_Handler,
+		},
+		{
+			MethodName: "TransferDelegation",
+			Handler:    _Msg_TransferDelegation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lbm/staking/v1beta1/tx.proto",
@@ -1195,98 +1447,250 @@ func (m *MsgUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgCancelUnbondingDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateValidator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgCancelUnbondingDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelUnbondingDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Description.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Commission.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MinSelfDelegation.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.CreationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.Pubkey != nil {
-		l = m.Pubkey.Size()
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.Value.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateValidatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelUnbondingDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgEditValidator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Description.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.CommissionRate != nil {
-		l = m.CommissionRate.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.MinSelfDelegation != nil {
-		l = m.MinSelfDelegation.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgCancelUnbondingDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgEditValidatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCancelUnbondingDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDelegate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgTransferDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.ValidatorAddress)
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.RecipientAddress) > 0 {
+		i -= len(m.RecipientAddress)
+		copy(dAtA[i:], m.RecipientAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecipientAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Description.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Commission.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinSelfDelegation.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Pubkey != nil {
+		l = m.Pubkey.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEditValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Description.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CommissionRate != nil {
+		l = m.CommissionRate.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MinSelfDelegation != nil {
+		l = m.MinSelfDelegation.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEditValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
@@ -1368,6 +1772,69 @@ func (m *MsgUndelegateResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCancelUnbondingDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.CreationHeight != 0 {
+		n += 1 + sovTx(uint64(m.CreationHeight))
+	}
+	return n
+}
+
+func (m *MsgCancelUnbondingDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RecipientAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgTransferDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1771,7 +2238,440 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_line_lbm_sdk_types.Dec
+			m.CommissionRate = &v
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_line_lbm_sdk_types.Int
+			m.MinSelfDelegation = &v
+			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBeginRedelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBeginRedelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1799,11 +2699,11 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1831,17 +2731,13 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_line_lbm_sdk_types.Dec
-			m.CommissionRate = &v
-			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1851,25 +2747,22 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_line_lbm_sdk_types.Int
-			m.MinSelfDelegation = &v
-			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1894,7 +2787,7 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditValidatorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBeginRedelegateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1917,12 +2810,45 @@ func (m *MsgEditValidatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditValidatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBeginRedelegateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBeginRedelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1944,7 +2870,7 @@ func (m *MsgEditValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1967,10 +2893,10 @@ func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUndelegate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2091,7 +3017,7 @@ func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUndelegateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2114,12 +3040,45 @@ func (m *MsgDelegateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUndelegateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2141,7 +3100,7 @@ func (m *MsgDelegateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelUnbondingDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2164,10 +3123,10 @@ func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBeginRedelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelUnbondingDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBeginRedelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelUnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2204,7 +3163,7 @@ func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2232,13 +3191,13 @@ func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2248,29 +3207,30 @@ func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
 			}
-			var msglen int
+			m.CreationHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2280,25 +3240,11 @@ func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.CreationHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2320,7 +3266,7 @@ func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBeginRedelegateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelUnbondingDelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2343,45 +3289,12 @@ func (m *MsgBeginRedelegateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBeginRedelegateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelUnbondingDelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBeginRedelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelUnbondingDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2403,7 +3316,7 @@ func (m *MsgBeginRedelegateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2426,10 +3339,10 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUndelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2497,6 +3410,38 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -2550,7 +3495,7 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUndelegateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferDelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2573,45 +3518,12 @@ func (m *MsgUndelegateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUndelegateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferDelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
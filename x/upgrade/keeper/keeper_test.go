@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"path/filepath"
 	"testing"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"github.com/line/lbm-sdk/simapp"
 	store "github.com/line/lbm-sdk/store/types"
 	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/types/module"
 	clienttypes "github.com/line/lbm-sdk/x/ibc/core/02-client/types"
 	commitmenttypes "github.com/line/lbm-sdk/x/ibc/core/23-commitment/types"
 	ibcexported "github.com/line/lbm-sdk/x/ibc/core/exported"
@@ -197,7 +199,7 @@ func (s *KeeperTestSuite) TestScheduleUpgrade() {
 				Height: 123450000,
 			},
 			setup: func() {
-				s.app.UpgradeKeeper.SetUpgradeHandler("all-good", func(_ sdk.Context, _ types.Plan) {})
+				s.app.UpgradeKeeper.SetUpgradeHandler("all-good", func(_ sdk.Context, _ types.Plan, vm module.VersionMap) (module.VersionMap, error) { return vm, nil })
 				s.app.UpgradeKeeper.ApplyUpgrade(s.ctx, types.Plan{
 					Name:   "all-good",
 					Info:   "some text here",
@@ -296,6 +298,46 @@ func (s *KeeperTestSuite) TestSetUpgradedClient() {
 
 }
 
+// Test that the module version map is saved at InitChain and updated by the
+// upgrade handlers.
+func (s *KeeperTestSuite) TestModuleVersionMap() {
+	vm := s.app.UpgradeKeeper.GetModuleVersionMap(s.ctx)
+	s.Require().NotEmpty(vm)
+	for name, version := range vm {
+		s.Require().GreaterOrEqual(version, uint64(1), name)
+	}
+
+	mv := s.app.UpgradeKeeper.GetModuleVersions(s.ctx)
+	s.Require().Len(mv, len(vm))
+	for i, v := range mv {
+		s.Require().Equal(vm[v.Name], v.Version)
+		if i > 0 {
+			s.Require().True(mv[i-1].Name < v.Name, "module versions are not sorted by name")
+		}
+	}
+
+	planName := "bump-bank"
+	plan := types.Plan{Name: planName, Height: 10}
+	s.app.UpgradeKeeper.SetUpgradeHandler(planName, func(_ sdk.Context, _ types.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		s.Require().Equal(vm, fromVM)
+		return module.VersionMap{"bank": 2}, nil
+	})
+	s.app.UpgradeKeeper.ApplyUpgrade(s.ctx, plan)
+
+	updated := s.app.UpgradeKeeper.GetModuleVersionMap(s.ctx)
+	s.Require().Equal(uint64(2), updated["bank"])
+	s.Require().Equal(vm["staking"], updated["staking"])
+
+	// a failing upgrade handler halts the chain
+	plan = types.Plan{Name: "failing", Height: 10}
+	s.app.UpgradeKeeper.SetUpgradeHandler("failing", func(_ sdk.Context, _ types.Plan, _ module.VersionMap) (module.VersionMap, error) {
+		return nil, fmt.Errorf("failed")
+	})
+	s.Require().Panics(func() {
+		s.app.UpgradeKeeper.ApplyUpgrade(s.ctx, plan)
+	})
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
@@ -302,6 +302,102 @@ func (m *QueryUpgradedConsensusStateResponse) GetUpgradedConsensusState() *types
 	return nil
 }
 
+// QueryModuleVersionsRequest is the request type for the Query/ModuleVersions
+// RPC method.
+type QueryModuleVersionsRequest struct {
+	// module_name is a field to query a specific module
+	// consensus version from state. Leaving this empty will
+	// fetch the full list of module versions from state
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+}
+
+func (m *QueryModuleVersionsRequest) Reset()         { *m = QueryModuleVersionsRequest{} }
+func (m *QueryModuleVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleVersionsRequest) ProtoMessage()    {}
+func (*QueryModuleVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_493c644dfe255469, []int{6}
+}
+func (m *QueryModuleVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleVersionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryModuleVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleVersionsRequest.Merge(m, src)
+}
+func (m *QueryModuleVersionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleVersionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryModuleVersionsRequest proto.InternalMessageInfo
+
+func (m *QueryModuleVersionsRequest) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
+}
+
+// QueryModuleVersionsResponse is the response type for the Query/ModuleVersions
+// RPC method.
+type QueryModuleVersionsResponse struct {
+	// module_versions is a list of module names with their consensus versions.
+	ModuleVersions []*ModuleVersion `protobuf:"bytes,1,rep,name=module_versions,json=moduleVersions,proto3" json:"module_versions,omitempty"`
+}
+
+func (m *QueryModuleVersionsResponse) Reset()         { *m = QueryModuleVersionsResponse{} }
+func (m *QueryModuleVersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleVersionsResponse) ProtoMessage()    {}
+func (*QueryModuleVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_493c644dfe255469, []int{7}
+}
+func (m *QueryModuleVersionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleVersionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryModuleVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleVersionsResponse.Merge(m, src)
+}
+func (m *QueryModuleVersionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleVersionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryModuleVersionsResponse proto.InternalMessageInfo
+
+func (m *QueryModuleVersionsResponse) GetModuleVersions() []*ModuleVersion {
+	if m != nil {
+		return m.ModuleVersions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryCurrentPlanRequest)(nil), "lbm.upgrade.v1beta1.QueryCurrentPlanRequest")
 	proto.RegisterType((*QueryCurrentPlanResponse)(nil), "lbm.upgrade.v1beta1.QueryCurrentPlanResponse")
@@ -309,43 +405,50 @@ func init() {
 	proto.RegisterType((*QueryAppliedPlanResponse)(nil), "lbm.upgrade.v1beta1.QueryAppliedPlanResponse")
 	proto.RegisterType((*QueryUpgradedConsensusStateRequest)(nil), "lbm.upgrade.v1beta1.QueryUpgradedConsensusStateRequest")
 	proto.RegisterType((*QueryUpgradedConsensusStateResponse)(nil), "lbm.upgrade.v1beta1.QueryUpgradedConsensusStateResponse")
+	proto.RegisterType((*QueryModuleVersionsRequest)(nil), "lbm.upgrade.v1beta1.QueryModuleVersionsRequest")
+	proto.RegisterType((*QueryModuleVersionsResponse)(nil), "lbm.upgrade.v1beta1.QueryModuleVersionsResponse")
 }
 
 func init() { proto.RegisterFile("lbm/upgrade/v1beta1/query.proto", fileDescriptor_493c644dfe255469) }
 
 var fileDescriptor_493c644dfe255469 = []byte{
-	// 484 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x31, 0x8f, 0xd3, 0x30,
-	0x18, 0x6d, 0xa0, 0x9c, 0x84, 0xbb, 0x19, 0x54, 0xda, 0x0a, 0xe5, 0xb8, 0xdc, 0xc0, 0x81, 0xa8,
-	0xcd, 0x95, 0x01, 0x24, 0xc4, 0x50, 0x2a, 0x24, 0x58, 0x10, 0x14, 0xb1, 0xb0, 0x54, 0x4e, 0x63,
-	0xd2, 0x08, 0xd7, 0xf6, 0xc5, 0x36, 0xa2, 0x3a, 0xdd, 0xc2, 0x2f, 0x00, 0x21, 0x36, 0x7e, 0x10,
-	0x63, 0x25, 0x16, 0x46, 0xd4, 0xf2, 0x43, 0x50, 0x1c, 0x17, 0xe5, 0x54, 0x37, 0x82, 0x2d, 0x89,
-	0xdf, 0xf7, 0xde, 0xf3, 0xf7, 0x5e, 0xc0, 0x3e, 0x8b, 0xe7, 0xd8, 0xc8, 0x34, 0x27, 0x09, 0xc5,
-	0xef, 0x8f, 0x63, 0xaa, 0xc9, 0x31, 0x3e, 0x31, 0x34, 0x5f, 0x20, 0x99, 0x0b, 0x2d, 0xe0, 0x15,
-	0x16, 0xcf, 0x91, 0x03, 0x20, 0x07, 0xe8, 0x75, 0x53, 0x21, 0x52, 0x46, 0xb1, 0x85, 0xc4, 0xe6,
-	0x2d, 0x26, 0xdc, 0xe1, 0x7b, 0xd7, 0xdd, 0x11, 0x91, 0x19, 0x26, 0x9c, 0x0b, 0x4d, 0x74, 0x26,
-	0xb8, 0x72, 0xa7, 0x07, 0x3e, 0xb9, 0x0d, 0xbb, 0x85, 0x44, 0x5d, 0x70, 0xed, 0x65, 0xa1, 0x3f,
-	0x32, 0x79, 0x4e, 0xb9, 0x7e, 0xc1, 0x08, 0x1f, 0xd3, 0x13, 0x43, 0x95, 0x8e, 0x9e, 0x81, 0xce,
-	0xf6, 0x91, 0x92, 0x82, 0x2b, 0x0a, 0xfb, 0xa0, 0x29, 0x19, 0xe1, 0x9d, 0xe0, 0x46, 0x70, 0xd4,
-	0x1a, 0x74, 0x91, 0xc7, 0x36, 0xb2, 0x03, 0x16, 0x16, 0xf5, 0x9d, 0xca, 0x50, 0x4a, 0x96, 0xd1,
-	0xa4, 0xa2, 0x02, 0x21, 0x68, 0x72, 0x32, 0xa7, 0x96, 0xe9, 0xf2, 0xd8, 0x3e, 0x47, 0x03, 0xa7,
-	0x7c, 0x0e, 0xee, 0x94, 0xdb, 0x60, 0x6f, 0x46, 0xb3, 0x74, 0xa6, 0xed, 0xc4, 0xc5, 0xb1, 0x7b,
-	0x8b, 0x9e, 0x80, 0xc8, 0xce, 0xbc, 0x2e, 0x5d, 0x24, 0xa3, 0x02, 0xcd, 0x95, 0x51, 0xaf, 0x34,
-	0xd1, 0x74, 0xa3, 0xb6, 0x0f, 0x5a, 0x8c, 0x28, 0x3d, 0x39, 0x47, 0x01, 0x8a, 0x4f, 0x4f, 0x4b,
-	0x1a, 0x03, 0x0e, 0x6b, 0x69, 0x9c, 0x8b, 0xe7, 0xa0, 0xe3, 0xae, 0x9b, 0x4c, 0xa6, 0x1b, 0xc8,
-	0x44, 0x15, 0x18, 0xb7, 0x93, 0xab, 0xa8, 0x8c, 0x06, 0x6d, 0x52, 0x43, 0x43, 0xbe, 0x18, 0xb7,
-	0x8d, 0x97, 0x77, 0xf0, 0xb9, 0x09, 0x2e, 0x59, 0x5d, 0xf8, 0x35, 0x00, 0xad, 0xca, 0xc6, 0xe1,
-	0x1d, 0xef, 0x6e, 0x77, 0x64, 0xd6, 0xeb, 0xff, 0x23, 0xba, 0xbc, 0x46, 0x74, 0xeb, 0xe3, 0x8f,
-	0xdf, 0x5f, 0x2e, 0x1c, 0xc2, 0x03, 0xec, 0x6b, 0xca, 0xb4, 0x9c, 0x98, 0x14, 0x11, 0xc2, 0x6f,
-	0x01, 0x68, 0x55, 0xf2, 0xa8, 0xf3, 0xb5, 0x9d, 0x72, 0x9d, 0x2f, 0x4f, 0xc8, 0xd1, 0x5d, 0xeb,
-	0xeb, 0x36, 0x3c, 0xf2, 0xfa, 0x22, 0xe5, 0x84, 0xf5, 0x85, 0x4f, 0x8b, 0xc6, 0x9c, 0xc1, 0x65,
-	0x00, 0xda, 0xfe, 0xcc, 0xe0, 0xfd, 0xdd, 0xda, 0xb5, 0x65, 0xe9, 0x3d, 0xf8, 0xff, 0x41, 0xe7,
-	0x7f, 0x64, 0xfd, 0x3f, 0x82, 0x0f, 0x71, 0xcd, 0x1f, 0xb8, 0xd5, 0x1c, 0x7c, 0x5a, 0xe9, 0xe6,
-	0xd9, 0xe3, 0xe1, 0xf7, 0x55, 0x18, 0x2c, 0x57, 0x61, 0xf0, 0x6b, 0x15, 0x06, 0x9f, 0xd6, 0x61,
-	0x63, 0xb9, 0x0e, 0x1b, 0x3f, 0xd7, 0x61, 0xe3, 0xcd, 0xcd, 0x34, 0xd3, 0x33, 0x13, 0xa3, 0xa9,
-	0x98, 0x63, 0x96, 0x71, 0x5a, 0xa8, 0xf4, 0x55, 0xf2, 0x0e, 0x7f, 0xf8, 0xab, 0xa5, 0x17, 0x92,
-	0xaa, 0x78, 0xcf, 0x96, 0xef, 0xde, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1a, 0x4c, 0x00, 0xf3,
-	0x78, 0x04, 0x00, 0x00,
+	// 575 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4d, 0x8b, 0xd3, 0x4e,
+	0x18, 0x6f, 0xfe, 0xfb, 0x02, 0xff, 0xa7, 0xb0, 0xc2, 0x28, 0xb5, 0x8d, 0x92, 0xdd, 0xcd, 0x8a,
+	0x56, 0xd9, 0x66, 0x76, 0xeb, 0x41, 0x41, 0xf6, 0x50, 0x8b, 0xa0, 0x88, 0x8b, 0x56, 0xf4, 0xe0,
+	0xa5, 0x4c, 0x9a, 0xb1, 0x8d, 0x26, 0x93, 0x6c, 0x66, 0xb2, 0x58, 0x96, 0xbd, 0xf8, 0x09, 0x04,
+	0xf1, 0xe6, 0xc5, 0x0f, 0xe1, 0x77, 0xf0, 0x58, 0xf0, 0xe2, 0x51, 0x5a, 0x3f, 0x88, 0x64, 0x32,
+	0x95, 0xd4, 0x4e, 0x4b, 0xbd, 0xb5, 0x79, 0x7e, 0x6f, 0x99, 0xe7, 0x37, 0x81, 0xed, 0xc0, 0x0d,
+	0x71, 0x1a, 0xf7, 0x13, 0xe2, 0x51, 0x7c, 0x7a, 0xe8, 0x52, 0x41, 0x0e, 0xf1, 0x49, 0x4a, 0x93,
+	0xa1, 0x13, 0x27, 0x91, 0x88, 0xd0, 0xc5, 0xc0, 0x0d, 0x1d, 0x05, 0x70, 0x14, 0xc0, 0xac, 0xf5,
+	0xa3, 0xa8, 0x1f, 0x50, 0x2c, 0x21, 0x6e, 0xfa, 0x1a, 0x13, 0xa6, 0xf0, 0xe6, 0x55, 0x35, 0x22,
+	0xb1, 0x8f, 0x09, 0x63, 0x91, 0x20, 0xc2, 0x8f, 0x18, 0x57, 0xd3, 0x5d, 0x9d, 0xdd, 0x54, 0x5d,
+	0x42, 0xec, 0x1a, 0x5c, 0x7e, 0x96, 0xf9, 0xb7, 0xd3, 0x24, 0xa1, 0x4c, 0x3c, 0x0d, 0x08, 0xeb,
+	0xd0, 0x93, 0x94, 0x72, 0x61, 0x3f, 0x82, 0xea, 0xfc, 0x88, 0xc7, 0x11, 0xe3, 0x14, 0x35, 0x60,
+	0x3d, 0x0e, 0x08, 0xab, 0x1a, 0x3b, 0x46, 0xbd, 0xdc, 0xac, 0x39, 0x9a, 0xd8, 0x8e, 0x24, 0x48,
+	0x98, 0xdd, 0x50, 0x2e, 0xad, 0x38, 0x0e, 0x7c, 0xea, 0x15, 0x5c, 0x10, 0x82, 0x75, 0x46, 0x42,
+	0x2a, 0x95, 0xfe, 0xef, 0xc8, 0xdf, 0x76, 0x13, 0xaa, 0xf3, 0x70, 0xe5, 0x5c, 0x81, 0xcd, 0x01,
+	0xf5, 0xfb, 0x03, 0x21, 0x19, 0x6b, 0x1d, 0xf5, 0xcf, 0x7e, 0x00, 0xb6, 0xe4, 0xbc, 0xc8, 0x53,
+	0x78, 0xed, 0x0c, 0xcd, 0x78, 0xca, 0x9f, 0x0b, 0x22, 0xe8, 0xd4, 0x6d, 0x1b, 0xca, 0x01, 0xe1,
+	0xa2, 0x3b, 0x23, 0x01, 0xd9, 0xa3, 0x87, 0xb9, 0x4c, 0x0a, 0x7b, 0x4b, 0x65, 0x54, 0x8a, 0x63,
+	0xa8, 0xaa, 0xd7, 0xf5, 0xba, 0xbd, 0x29, 0xa4, 0xcb, 0x33, 0x8c, 0x3a, 0x93, 0x4b, 0x4e, 0xbe,
+	0x1a, 0x67, 0xba, 0x35, 0xa7, 0xc5, 0x86, 0x9d, 0x4a, 0xaa, 0xd5, 0xb5, 0x8f, 0xc0, 0x94, 0xb6,
+	0x4f, 0x22, 0x2f, 0x0d, 0xe8, 0x4b, 0x9a, 0xf0, 0x6c, 0x8d, 0x85, 0xd4, 0xa1, 0x1c, 0x74, 0x0b,
+	0x47, 0x05, 0xf9, 0xa3, 0xe3, 0xec, 0xc0, 0xde, 0xc0, 0x15, 0x2d, 0x5d, 0xa5, 0x7d, 0x0c, 0x17,
+	0x14, 0xff, 0x54, 0x8d, 0xaa, 0xc6, 0xce, 0x5a, 0xbd, 0xdc, 0xb4, 0xb5, 0x8b, 0x9b, 0x51, 0xe9,
+	0x6c, 0x85, 0x33, 0xa2, 0xcd, 0xaf, 0x1b, 0xb0, 0x21, 0xcd, 0xd0, 0x27, 0x03, 0xca, 0x85, 0x72,
+	0xa0, 0x7d, 0xad, 0xda, 0x82, 0x7a, 0x99, 0x8d, 0x15, 0xd1, 0xf9, 0x3b, 0xd8, 0x37, 0xdf, 0x7f,
+	0xff, 0xf5, 0xf1, 0xbf, 0x3d, 0xb4, 0x8b, 0x75, 0xa5, 0xee, 0xe5, 0x8c, 0x6e, 0xd6, 0x36, 0xf4,
+	0xd9, 0x80, 0x72, 0xa1, 0x3a, 0xcb, 0x72, 0xcd, 0x17, 0xd2, 0x6c, 0xac, 0x88, 0x56, 0xb9, 0x0e,
+	0x64, 0xae, 0x5b, 0xa8, 0xae, 0xcd, 0x45, 0x72, 0x86, 0xcc, 0x85, 0xcf, 0xb2, 0xed, 0x9d, 0xa3,
+	0x91, 0x01, 0x15, 0x7d, 0xbd, 0xd0, 0x9d, 0xc5, 0xde, 0x4b, 0x7b, 0x6d, 0xde, 0xfd, 0x77, 0xa2,
+	0xca, 0xdf, 0x96, 0xf9, 0x8f, 0xd0, 0x3d, 0xbc, 0xe4, 0x63, 0x31, 0x57, 0x72, 0x7c, 0x56, 0xb8,
+	0x46, 0xe7, 0xe8, 0x8b, 0x01, 0x5b, 0xb3, 0xdd, 0x43, 0x78, 0x71, 0x22, 0x6d, 0xc9, 0xcd, 0x83,
+	0xd5, 0x09, 0x2a, 0xfa, 0xbe, 0x8c, 0x7e, 0x1d, 0x5d, 0xd3, 0x46, 0xff, 0xab, 0xf1, 0xf7, 0x5b,
+	0xdf, 0xc6, 0x96, 0x31, 0x1a, 0x5b, 0xc6, 0xcf, 0xb1, 0x65, 0x7c, 0x98, 0x58, 0xa5, 0xd1, 0xc4,
+	0x2a, 0xfd, 0x98, 0x58, 0xa5, 0x57, 0x37, 0xfa, 0xbe, 0x18, 0xa4, 0xae, 0xd3, 0x8b, 0x42, 0x1c,
+	0xf8, 0x8c, 0x66, 0x72, 0x0d, 0xee, 0xbd, 0xc5, 0xef, 0xfe, 0x88, 0x8a, 0x61, 0x4c, 0xb9, 0xbb,
+	0x29, 0xef, 0xf2, 0xed, 0xdf, 0x03, 0x00, 0x8e, 0xba, 0xe0, 0x9e, 0xc7, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -369,6 +472,8 @@ type QueryClient interface {
 	// stored at the last height of this chain.
 	// UpgradedConsensusState RPC not supported with legacy querier
 	UpgradedConsensusState(ctx context.Context, in *QueryUpgradedConsensusStateRequest, opts ...grpc.CallOption) (*QueryUpgradedConsensusStateResponse, error)
+	// ModuleVersions queries the list of module versions from state.
+	ModuleVersions(ctx context.Context, in *QueryModuleVersionsRequest, opts ...grpc.CallOption) (*QueryModuleVersionsResponse, error)
 }
 
 type queryClient struct {
@@ -406,6 +511,15 @@ func (c *queryClient) UpgradedConsensusState(ctx context.Context, in *QueryUpgra
 	return out, nil
 }
 
+func (c *queryClient) ModuleVersions(ctx context.Context, in *QueryModuleVersionsRequest, opts ...grpc.CallOption) (*QueryModuleVersionsResponse, error) {
+	out := new(QueryModuleVersionsResponse)
+	err := c.cc.Invoke(ctx, "/lbm.upgrade.v1beta1.Query/ModuleVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// CurrentPlan queries the current upgrade plan.
@@ -417,6 +531,8 @@ type QueryServer interface {
 	// stored at the last height of this chain.
 	// UpgradedConsensusState RPC not supported with legacy querier
 	UpgradedConsensusState(context.Context, *QueryUpgradedConsensusStateRequest) (*QueryUpgradedConsensusStateResponse, error)
+	// ModuleVersions queries the list of module versions from state.
+	ModuleVersions(context.Context, *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -432,6 +548,9 @@ func (*UnimplementedQueryServer) AppliedPlan(ctx context.Context, req *QueryAppl
 func (*UnimplementedQueryServer) UpgradedConsensusState(ctx context.Context, req *QueryUpgradedConsensusStateRequest) (*QueryUpgradedConsensusStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpgradedConsensusState not implemented")
 }
+func (*UnimplementedQueryServer) ModuleVersions(ctx context.Context, req *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleVersions not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -491,6 +610,24 @@ func _Query_UpgradedConsensusState_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ModuleVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.upgrade.v1beta1.Query/ModuleVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleVersions(ctx, req.(*QueryModuleVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lbm.upgrade.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -507,6 +644,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpgradedConsensusState",
 			Handler:    _Query_UpgradedConsensusState_Handler,
 		},
+		{
+			MethodName: "ModuleVersions",
+			Handler:    _Query_ModuleVersions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lbm/upgrade/v1beta1/query.proto",
@@ -691,6 +832,73 @@ func (m *QueryUpgradedConsensusStateResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryModuleVersionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleVersionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleVersionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleVersionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleVersionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleVersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ModuleVersions) > 0 {
+		for iNdEx := len(m.ModuleVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ModuleVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -774,6 +982,34 @@ func (m *QueryUpgradedConsensusStateResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryModuleVersionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryModuleVersionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ModuleVersions) > 0 {
+		for _, e := range m.ModuleVersions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1222,6 +1458,172 @@ func (m *QueryUpgradedConsensusStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryModuleVersionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleVersionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleVersionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleVersionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleVersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleVersions = append(m.ModuleVersions, &ModuleVersion{})
+			if err := m.ModuleVersions[len(m.ModuleVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
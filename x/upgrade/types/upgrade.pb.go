@@ -8,8 +8,8 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
 	types "github.com/line/lbm-sdk/codec/types"
-	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -166,43 +166,87 @@ func (m *CancelSoftwareUpgradeProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CancelSoftwareUpgradeProposal proto.InternalMessageInfo
 
+// ModuleVersion specifies a module and its consensus version.
+type ModuleVersion struct {
+	// name of the app module
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// consensus version of the app module
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *ModuleVersion) Reset()         { *m = ModuleVersion{} }
+func (m *ModuleVersion) String() string { return proto.CompactTextString(m) }
+func (*ModuleVersion) ProtoMessage()    {}
+func (*ModuleVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5ac500e7518da9e, []int{3}
+}
+func (m *ModuleVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModuleVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleVersion.Merge(m, src)
+}
+func (m *ModuleVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleVersion proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Plan)(nil), "lbm.upgrade.v1beta1.Plan")
 	proto.RegisterType((*SoftwareUpgradeProposal)(nil), "lbm.upgrade.v1beta1.SoftwareUpgradeProposal")
 	proto.RegisterType((*CancelSoftwareUpgradeProposal)(nil), "lbm.upgrade.v1beta1.CancelSoftwareUpgradeProposal")
+	proto.RegisterType((*ModuleVersion)(nil), "lbm.upgrade.v1beta1.ModuleVersion")
 }
 
 func init() { proto.RegisterFile("lbm/upgrade/v1beta1/upgrade.proto", fileDescriptor_f5ac500e7518da9e) }
 
 var fileDescriptor_f5ac500e7518da9e = []byte{
-	// 424 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0x3f, 0x6f, 0xd4, 0x30,
-	0x1c, 0x8d, 0x69, 0x5a, 0x51, 0xdf, 0xe6, 0x1e, 0x90, 0x9e, 0xc0, 0x09, 0xb7, 0x70, 0x0b, 0xb6,
-	0xda, 0x2e, 0xa8, 0x1b, 0xe9, 0xc4, 0x56, 0xa5, 0xb0, 0x20, 0xa1, 0xca, 0x49, 0x7c, 0x39, 0x83,
-	0xff, 0x44, 0x89, 0x0f, 0xc8, 0x77, 0x60, 0xe8, 0x47, 0xe0, 0xe3, 0xdc, 0xd8, 0xb1, 0x53, 0xa1,
-	0x77, 0x0b, 0x73, 0x3f, 0x01, 0x8a, 0x93, 0x20, 0x04, 0x1d, 0xbb, 0xbd, 0xdf, 0xd3, 0xfb, 0xbd,
-	0x67, 0x3f, 0x1b, 0x3e, 0x97, 0xa9, 0xa2, 0xcb, 0xb2, 0xa8, 0x58, 0xce, 0xe9, 0xe7, 0x83, 0x94,
-	0x5b, 0x76, 0x30, 0xcc, 0xa4, 0xac, 0x8c, 0x35, 0x68, 0x4f, 0xa6, 0x8a, 0x0c, 0x54, 0x2f, 0x99,
-	0xec, 0x17, 0xc6, 0x14, 0x92, 0x53, 0x27, 0x49, 0x97, 0x73, 0xca, 0x74, 0xd3, 0xe9, 0x27, 0xe3,
-	0xc2, 0x14, 0xc6, 0x41, 0xda, 0xa2, 0x9e, 0x0d, 0xff, 0x5d, 0xb0, 0x42, 0xf1, 0xda, 0x32, 0x55,
-	0x76, 0x82, 0xe9, 0x2d, 0x80, 0xfe, 0xa9, 0x64, 0x1a, 0x21, 0xe8, 0x6b, 0xa6, 0x78, 0x00, 0x22,
-	0x30, 0xdb, 0x4d, 0x1c, 0x46, 0xaf, 0xa0, 0xdf, 0xea, 0x83, 0x07, 0x11, 0x98, 0x8d, 0x0e, 0x27,
-	0xa4, 0x33, 0x23, 0x83, 0x19, 0x79, 0x3b, 0x98, 0xc5, 0x0f, 0x57, 0xd7, 0xa1, 0x77, 0xf1, 0x23,
-	0x04, 0x89, 0xdb, 0x40, 0x8f, 0xe1, 0xce, 0x82, 0x8b, 0x62, 0x61, 0x83, 0xad, 0x08, 0xcc, 0xb6,
-	0x92, 0x7e, 0x6a, 0x53, 0x84, 0x9e, 0x9b, 0xc0, 0xef, 0x52, 0x5a, 0x8c, 0x3e, 0xc2, 0x47, 0xfd,
-	0x3d, 0xf3, 0xf3, 0x4c, 0x0a, 0xae, 0xed, 0x79, 0x6d, 0x99, 0xe5, 0xc1, 0xb6, 0x8b, 0x1d, 0xff,
-	0x17, 0xfb, 0x5a, 0x37, 0x71, 0x74, 0x7b, 0x1d, 0x3e, 0x6d, 0x98, 0x92, 0xc7, 0xd3, 0x3b, 0x97,
-	0xa7, 0xc9, 0xde, 0xc0, 0x9f, 0x38, 0xfa, 0xac, 0x65, 0x8f, 0xfd, 0x5f, 0xdf, 0x43, 0x30, 0xfd,
-	0x06, 0xe0, 0x93, 0x33, 0x33, 0xb7, 0x5f, 0x58, 0xc5, 0xdf, 0x75, 0xaa, 0xd3, 0xca, 0x94, 0xa6,
-	0x66, 0x12, 0x8d, 0xe1, 0xb6, 0x15, 0x56, 0x0e, 0x45, 0x74, 0x03, 0x8a, 0xe0, 0x28, 0xe7, 0x75,
-	0x56, 0x89, 0xd2, 0x0a, 0xa3, 0x5d, 0x21, 0xbb, 0xc9, 0xdf, 0x14, 0x3a, 0x82, 0x7e, 0x29, 0x99,
-	0x76, 0xf7, 0x1d, 0x1d, 0xee, 0x93, 0x3b, 0x9e, 0x8f, 0xb4, 0x45, 0xc7, 0x7e, 0x5b, 0x55, 0xe2,
-	0xc4, 0xfd, 0x71, 0x3e, 0xc0, 0x67, 0x27, 0x4c, 0x67, 0x5c, 0xde, 0xf3, 0x99, 0x3a, 0xfb, 0xf8,
-	0xcd, 0xea, 0x06, 0x7b, 0x57, 0x37, 0xd8, 0x5b, 0xad, 0x31, 0xb8, 0x5c, 0x63, 0xf0, 0x73, 0x8d,
-	0xc1, 0xc5, 0x06, 0x7b, 0x97, 0x1b, 0xec, 0x5d, 0x6d, 0xb0, 0xf7, 0xfe, 0x45, 0x21, 0xec, 0x62,
-	0x99, 0x92, 0xcc, 0x28, 0x2a, 0x85, 0xe6, 0x54, 0xa6, 0xea, 0x65, 0x9d, 0x7f, 0xa2, 0x5f, 0xff,
-	0x7c, 0x52, 0xdb, 0x94, 0xbc, 0x4e, 0x77, 0xdc, 0x1b, 0x1c, 0xfd, 0x0e, 0x00, 0x00, 0xff, 0xff,
-	0x16, 0xe9, 0xea, 0x6a, 0xc0, 0x02, 0x00, 0x00,
+	// 458 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xf6, 0x51, 0xb7, 0xb4, 0x17, 0xb1, 0x5c, 0x03, 0xb8, 0x11, 0xd8, 0x26, 0x0b, 0x59, 0xb8,
+	0x53, 0xdb, 0x05, 0x65, 0x23, 0x1d, 0x10, 0x03, 0x52, 0xe5, 0x02, 0x03, 0x12, 0xaa, 0xce, 0xf1,
+	0xc5, 0x39, 0xb8, 0x1f, 0x96, 0x7d, 0x29, 0xe4, 0x7f, 0x60, 0xe8, 0xc8, 0xd8, 0x3f, 0x27, 0x63,
+	0xc7, 0x4e, 0x85, 0x26, 0x0b, 0x73, 0xff, 0x02, 0x74, 0x67, 0x1b, 0x21, 0xc8, 0xc8, 0xf6, 0xde,
+	0xe7, 0xef, 0x7d, 0xdf, 0xbd, 0xef, 0x19, 0x3e, 0x11, 0xa9, 0x24, 0xb3, 0x22, 0x2f, 0x69, 0xc6,
+	0xc8, 0xd9, 0x7e, 0xca, 0x0c, 0xdd, 0x6f, 0x7b, 0x5c, 0x94, 0xda, 0x68, 0xb4, 0x2b, 0x52, 0x89,
+	0x5b, 0xa8, 0xa1, 0xf4, 0xf6, 0x72, 0xad, 0x73, 0xc1, 0x88, 0xa3, 0xa4, 0xb3, 0x09, 0xa1, 0x6a,
+	0x5e, 0xf3, 0x7b, 0xdd, 0x5c, 0xe7, 0xda, 0x95, 0xc4, 0x56, 0x0d, 0x1a, 0xfd, 0x3d, 0x60, 0xb8,
+	0x64, 0x95, 0xa1, 0xb2, 0xa8, 0x09, 0xfd, 0x5b, 0x00, 0xfd, 0x63, 0x41, 0x15, 0x42, 0xd0, 0x57,
+	0x54, 0xb2, 0x00, 0xc4, 0x60, 0xb0, 0x93, 0xb8, 0x1a, 0x3d, 0x87, 0xbe, 0xe5, 0x07, 0x77, 0x62,
+	0x30, 0xe8, 0x1c, 0xf4, 0x70, 0x2d, 0x86, 0x5b, 0x31, 0xfc, 0xa6, 0x15, 0x1b, 0x6d, 0x2f, 0xae,
+	0x23, 0xef, 0xfc, 0x7b, 0x04, 0x12, 0x37, 0x81, 0x1e, 0xc0, 0xad, 0x29, 0xe3, 0xf9, 0xd4, 0x04,
+	0x1b, 0x31, 0x18, 0x6c, 0x24, 0x4d, 0x67, 0x5d, 0xb8, 0x9a, 0xe8, 0xc0, 0xaf, 0x5d, 0x6c, 0x8d,
+	0x3e, 0xc2, 0xfb, 0xcd, 0x9e, 0xd9, 0xe9, 0x58, 0x70, 0xa6, 0xcc, 0x69, 0x65, 0xa8, 0x61, 0xc1,
+	0xa6, 0xb3, 0xed, 0xfe, 0x63, 0xfb, 0x42, 0xcd, 0x47, 0xf1, 0xed, 0x75, 0xf4, 0x68, 0x4e, 0xa5,
+	0x18, 0xf6, 0xd7, 0x0e, 0xf7, 0x93, 0xdd, 0x16, 0x3f, 0x72, 0xf0, 0x89, 0x45, 0x87, 0xfe, 0xcf,
+	0x8b, 0x08, 0xf4, 0xbf, 0x02, 0xf8, 0xf0, 0x44, 0x4f, 0xcc, 0x67, 0x5a, 0xb2, 0xb7, 0x35, 0xeb,
+	0xb8, 0xd4, 0x85, 0xae, 0xa8, 0x40, 0x5d, 0xb8, 0x69, 0xb8, 0x11, 0x6d, 0x10, 0x75, 0x83, 0x62,
+	0xd8, 0xc9, 0x58, 0x35, 0x2e, 0x79, 0x61, 0xb8, 0x56, 0x2e, 0x90, 0x9d, 0xe4, 0x4f, 0x08, 0x1d,
+	0x42, 0xbf, 0x10, 0x54, 0xb9, 0x7d, 0x3b, 0x07, 0x7b, 0x78, 0xcd, 0xf9, 0xb0, 0x0d, 0x7a, 0xe4,
+	0xdb, 0xa8, 0x12, 0x47, 0x6e, 0x9e, 0xf3, 0x01, 0x3e, 0x3e, 0xa2, 0x6a, 0xcc, 0xc4, 0x7f, 0x7e,
+	0x53, 0x23, 0xff, 0x12, 0xde, 0x7b, 0xad, 0xb3, 0x99, 0x60, 0xef, 0x58, 0x59, 0x71, 0xbd, 0xfe,
+	0xd4, 0x01, 0xbc, 0x7b, 0x56, 0x7f, 0x76, 0x42, 0x7e, 0xd2, 0xb6, 0xc3, 0xed, 0x6f, 0x17, 0x11,
+	0xb0, 0x42, 0xa3, 0x57, 0x8b, 0x9b, 0xd0, 0xbb, 0xba, 0x09, 0xbd, 0xc5, 0x32, 0x04, 0x97, 0xcb,
+	0x10, 0xfc, 0x58, 0x86, 0xe0, 0x7c, 0x15, 0x7a, 0x97, 0xab, 0xd0, 0xbb, 0x5a, 0x85, 0xde, 0xfb,
+	0xa7, 0x39, 0x37, 0xd3, 0x59, 0x8a, 0xc7, 0x5a, 0x12, 0xc1, 0x15, 0x23, 0x22, 0x95, 0xcf, 0xaa,
+	0xec, 0x13, 0xf9, 0xf2, 0xfb, 0x6f, 0x37, 0xf3, 0x82, 0x55, 0xe9, 0x96, 0x3b, 0xe6, 0xe1, 0xaf,
+	0x01, 0x00, 0xb2, 0xa5, 0x1e, 0x4f, 0x09, 0x03, 0x00, 0x00,
 }
 
 func (this *Plan) Equal(that interface{}) bool {
@@ -298,6 +342,33 @@ func (this *CancelSoftwareUpgradeProposal) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ModuleVersion) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ModuleVersion)
+	if !ok {
+		that2, ok := that.(ModuleVersion)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	return true
+}
 func (m *Plan) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -444,6 +515,41 @@ func (m *CancelSoftwareUpgradeProposal) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *ModuleVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintUpgrade(dAtA []byte, offset int, v uint64) int {
 	offset -= sovUpgrade(v)
 	base := offset
@@ -517,6 +623,22 @@ func (m *CancelSoftwareUpgradeProposal) Size() (n int) {
 	return n
 }
 
+func (m *ModuleVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovUpgrade(uint64(m.Version))
+	}
+	return n
+}
+
 func sovUpgrade(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -986,6 +1108,107 @@ func (m *CancelSoftwareUpgradeProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ModuleVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipUpgrade(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,16 @@
+package wasm
+
+import (
+	"time"
+
+	"github.com/line/lbm-sdk/telemetry"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/wasm/types"
+)
+
+// BeginBlocker executes the contracts scheduled for the current block
+func BeginBlocker(ctx sdk.Context, k *Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	k.ExecuteDueSchedules(ctx)
+}
@@ -102,6 +102,8 @@ type (
 	MsgStoreCodeResponse                       = types.MsgStoreCodeResponse
 	MsgInstantiateContract                     = types.MsgInstantiateContract
 	MsgInstantiateContractResponse             = types.MsgInstantiateContractResponse
+	MsgInstantiateContract2                    = types.MsgInstantiateContract2
+	MsgInstantiateContract2Response            = types.MsgInstantiateContract2Response
 	MsgStoreCodeAndInstantiateContract         = types.MsgStoreCodeAndInstantiateContract
 	MsgStoreCodeAndInstantiateContractResponse = types.MsgStoreCodeAndInstantiateContractResponse
 	MsgExecuteContract                         = types.MsgExecuteContract
@@ -9,6 +9,7 @@ import (
 	sdkerrors "github.com/line/lbm-sdk/types/errors"
 	"github.com/line/lbm-sdk/x/wasm/types"
 	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
 )
 
 // MigrateContractCmd will migrate a contract to a new code version
@@ -145,3 +146,137 @@ func UpdateContractStatusCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// UpdateInstantiateConfigCmd updates the instantiate permission of a code
+func UpdateInstantiateConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-instantiate-config [code_id_int64]",
+		Short: "Update the instantiate permission of a code, only the code creator can do it",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "code id")
+			}
+			perm, err := parseAccessConfigFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if perm == nil {
+				return sdkerrors.Wrap(types.ErrEmpty, "instantiate permission flag is required")
+			}
+
+			msg := types.MsgUpdateInstantiateConfig{
+				Sender:                   clientCtx.GetFromAddress().String(),
+				CodeID:                   codeID,
+				NewInstantiatePermission: perm,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	addInstantiatePermissionFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CreateScheduleCmd schedules executions of the sudo entry point of a contract
+func CreateScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-schedule [contract_addr_bech32] [json_encoded_sudo_args] --interval [blocks] --start-height [height] --gas-limit [gas]",
+		Short: "Schedule executions of the sudo entry point of a contract, only the contract admin can do it",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := parseCreateScheduleArgs(args[0], args[1], clientCtx.GetFromAddress().String(), cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	addScheduleFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parseCreateScheduleArgs(contractAddr, sudoMsg, sender string, flags *flag.FlagSet) (types.MsgCreateSchedule, error) {
+	interval, err := flags.GetUint64(flagInterval)
+	if err != nil {
+		return types.MsgCreateSchedule{}, sdkerrors.Wrap(err, "interval")
+	}
+	startHeight, err := flags.GetInt64(flagStartHeight)
+	if err != nil {
+		return types.MsgCreateSchedule{}, sdkerrors.Wrap(err, "start height")
+	}
+	gasLimit, err := flags.GetUint64(flagGasLimit)
+	if err != nil {
+		return types.MsgCreateSchedule{}, sdkerrors.Wrap(err, "gas limit")
+	}
+	return types.MsgCreateSchedule{
+		Sender:      sender,
+		Contract:    contractAddr,
+		Msg:         []byte(sudoMsg),
+		Interval:    interval,
+		StartHeight: startHeight,
+		GasLimit:    gasLimit,
+	}, nil
+}
+
+// RemoveScheduleCmd removes a schedule of contract executions
+func RemoveScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-schedule [schedule_id]",
+		Short: "Remove a schedule, only the schedule creator or the contract admin can do it",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			scheduleID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "schedule id")
+			}
+
+			msg := types.MsgRemoveSchedule{
+				Sender:     clientCtx.GetFromAddress().String(),
+				ScheduleID: scheduleID,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func addScheduleFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint64(flagInterval, 0, "Number of blocks between two executions, zero for a single execution")
+	cmd.Flags().Int64(flagStartHeight, 0, "Block height of the first execution, defaults to one interval after the current height")
+	cmd.Flags().Uint64(flagGasLimit, 0, "Max gas an execution can consume")
+}
+
+func addInstantiatePermissionFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagInstantiateByEverybody, "", "Everybody can instantiate a contract from the code")
+	cmd.Flags().String(flagInstantiateNobody, "", "Nobody except the governance process can instantiate a contract from the code")
+	cmd.Flags().String(flagInstantiateByAddress, "", "Only this address can instantiate a contract instance from the code")
+	cmd.Flags().StringSlice(flagInstantiateByAnyOfAddress, []string{}, "Any of the addresses can instantiate a contract from the code")
+}
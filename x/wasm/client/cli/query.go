@@ -29,10 +29,17 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		GetCmdListCode(),
 		GetCmdListContractByCode(),
+		GetCmdListContractsByCreator(),
+		GetCmdListContractsByAdmin(),
+		GetCmdListCodesByChecksum(),
+		GetCmdListPinnedCode(),
 		GetCmdQueryCode(),
 		GetCmdGetContractInfo(),
 		GetCmdGetContractHistory(),
 		GetCmdGetContractState(),
+		GetCmdBuildAddress(),
+		GetCmdQuerySchedule(),
+		GetCmdListSchedules(),
 	)
 	return queryCmd
 }
@@ -113,6 +120,231 @@ func GetCmdListContractByCode() *cobra.Command {
 	return cmd
 }
 
+// GetCmdListContractsByCreator lists all contracts instantiated by given creator
+func GetCmdListContractsByCreator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-contracts-by-creator [creator]",
+		Short: "List all contracts instantiated by given creator",
+		Long:  "List all contracts instantiated by given creator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if err := sdk.ValidateAccAddress(args[0]); err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractsByCreator(
+				context.Background(),
+				&types.QueryContractsByCreatorRequest{
+					CreatorAddress: args[0],
+					Pagination:     pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list contracts by creator")
+	return cmd
+}
+
+// GetCmdListContractsByAdmin lists all contracts with given admin
+func GetCmdListContractsByAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-contracts-by-admin [admin]",
+		Short: "List all contracts with given admin",
+		Long:  "List all contracts with given admin",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if err := sdk.ValidateAccAddress(args[0]); err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractsByAdmin(
+				context.Background(),
+				&types.QueryContractsByAdminRequest{
+					AdminAddress: args[0],
+					Pagination:   pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list contracts by admin")
+	return cmd
+}
+
+// GetCmdListCodesByChecksum lists all wasm code uploaded with given checksum
+func GetCmdListCodesByChecksum() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-code-by-checksum [checksum]",
+		Short: "List all wasm bytecode on the chain with given hex encoded checksum",
+		Long:  "List all wasm bytecode on the chain with given hex encoded checksum",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := hex.DecodeString(args[0]); err != nil {
+				return fmt.Errorf("checksum: %s", err)
+			}
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CodesByChecksum(
+				context.Background(),
+				&types.QueryCodesByChecksumRequest{
+					Checksum:   args[0],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list codes by checksum")
+	return cmd
+}
+
+// GetCmdListPinnedCode lists all wasm code ids that are pinned
+func GetCmdListPinnedCode() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pinned",
+		Short: "List all pinned code ids",
+		Long:  "List all pinned code ids",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PinnedCodes(
+				context.Background(),
+				&types.QueryPinnedCodesRequest{
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list pinned codes")
+	return cmd
+}
+
+// GetCmdQuerySchedule gets a schedule of contract executions
+func GetCmdQuerySchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule [schedule_id]",
+		Short: "Prints out a schedule of contract executions given its id",
+		Long:  "Prints out a schedule of contract executions given its id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			scheduleID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Schedule(
+				context.Background(),
+				&types.QueryScheduleRequest{
+					ScheduleId: scheduleID,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdListSchedules lists all schedules of contract executions
+func GetCmdListSchedules() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-schedules",
+		Short: "List all schedules of contract executions",
+		Long:  "List all schedules of contract executions",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Schedules(
+				context.Background(),
+				&types.QuerySchedulesRequest{
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list schedules")
+	return cmd
+}
+
 // GetCmdQueryCode returns the bytecode for a given contract
 func GetCmdQueryCode() *cobra.Command {
 	cmd := &cobra.Command{
@@ -188,6 +420,49 @@ func GetCmdGetContractInfo() *cobra.Command {
 	return cmd
 }
 
+// GetCmdBuildAddress builds the address of a contract instantiated with a salt
+func GetCmdBuildAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "build-address [code_hash] [creator_address] [salt]",
+		Short: "Build the address of a contract instantiated with a salt",
+		Long: `Prints out the address a contract gets when the code of the hex encoded hash is
+instantiated by the creator with the salt. By default the salt is read as plain text, use --hex
+to pass it hex encoded.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			err = sdk.ValidateAccAddress(args[1])
+			if err != nil {
+				return err
+			}
+			salt := hex.EncodeToString([]byte(args[2]))
+			if isHex, _ := cmd.Flags().GetBool(flagHexSalt); isHex {
+				salt = args[2]
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BuildAddress(
+				context.Background(),
+				&types.QueryBuildAddressRequest{
+					CodeHash:       args[0],
+					CreatorAddress: args[1],
+					Salt:           salt,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().Bool(flagHexSalt, false, "Salt is hex encoded")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdGetContractState dumps full internal state of a given contract
 func GetCmdGetContractState() *cobra.Command {
 	cmd := &cobra.Command{
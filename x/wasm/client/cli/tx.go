@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -18,15 +19,21 @@ import (
 )
 
 const (
-	flagAmount                 = "amount"
-	flagSource                 = "source"
-	flagBuilder                = "builder"
-	flagLabel                  = "label"
-	flagAdmin                  = "admin"
-	flagRunAs                  = "run-as"
-	flagInstantiateByEverybody = "instantiate-everybody"
-	flagInstantiateByAddress   = "instantiate-only-address"
-	flagProposalType           = "type"
+	flagAmount                    = "amount"
+	flagSource                    = "source"
+	flagBuilder                   = "builder"
+	flagLabel                     = "label"
+	flagAdmin                     = "admin"
+	flagRunAs                     = "run-as"
+	flagInstantiateByEverybody    = "instantiate-everybody"
+	flagInstantiateByAddress      = "instantiate-only-address"
+	flagInstantiateByAnyOfAddress = "instantiate-anyof-addresses"
+	flagInstantiateNobody         = "instantiate-nobody"
+	flagProposalType              = "type"
+	flagHexSalt                   = "hex"
+	flagInterval                  = "interval"
+	flagStartHeight               = "start-height"
+	flagGasLimit                  = "gas-limit"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -41,12 +48,16 @@ func GetTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		StoreCodeCmd(),
 		InstantiateContractCmd(),
+		InstantiateContract2Cmd(),
 		StoreCodeAndInstantiateContractCmd(),
 		ExecuteContractCmd(),
 		MigrateContractCmd(),
 		UpdateContractAdminCmd(),
 		ClearContractAdminCmd(),
 		UpdateContractStatusCmd(),
+		UpdateInstantiateConfigCmd(),
+		CreateScheduleCmd(),
+		RemoveScheduleCmd(),
 	)
 	return txCmd
 }
@@ -77,6 +88,7 @@ func StoreCodeCmd() *cobra.Command {
 	cmd.Flags().String(flagBuilder, "", "A valid docker tag for the build system, optional")
 	cmd.Flags().String(flagInstantiateByEverybody, "", "Everybody can instantiate a contract from the code, optional")
 	cmd.Flags().String(flagInstantiateByAddress, "", "Only this address can instantiate a contract instance from the code, optional")
+	cmd.Flags().StringSlice(flagInstantiateByAnyOfAddress, []string{}, "Any of the addresses can instantiate a contract from the code, optional")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -98,32 +110,9 @@ func parseStoreCodeArgs(file string, sender sdk.AccAddress, flags *flag.FlagSet)
 		return types.MsgStoreCode{}, fmt.Errorf("invalid input file. Use wasm binary or gzip")
 	}
 
-	var perm *types.AccessConfig
-	onlyAddrStr, err := flags.GetString(flagInstantiateByAddress)
+	perm, err := parseAccessConfigFlags(flags)
 	if err != nil {
-		return types.MsgStoreCode{}, fmt.Errorf("instantiate by address: %s", err)
-	}
-	if onlyAddrStr != "" {
-		err := sdk.ValidateAccAddress(onlyAddrStr)
-		if err != nil {
-			return types.MsgStoreCode{}, sdkerrors.Wrap(err, flagInstantiateByAddress)
-		}
-		x := types.AccessTypeOnlyAddress.With(sdk.AccAddress(onlyAddrStr))
-		perm = &x
-	} else {
-		everybodyStr, err := flags.GetString(flagInstantiateByEverybody)
-		if err != nil {
-			return types.MsgStoreCode{}, fmt.Errorf("instantiate by everybody: %s", err)
-		}
-		if everybodyStr != "" {
-			ok, err := strconv.ParseBool(everybodyStr)
-			if err != nil {
-				return types.MsgStoreCode{}, fmt.Errorf("boolean value expected for instantiate by everybody: %s", err)
-			}
-			if ok {
-				perm = &types.AllowEverybody
-			}
-		}
+		return types.MsgStoreCode{}, err
 	}
 
 	// build and sign the transaction, then broadcast to Tendermint
@@ -146,6 +135,69 @@ func parseStoreCodeArgs(file string, sender sdk.AccAddress, flags *flag.FlagSet)
 	return msg, nil
 }
 
+func parseAccessConfigFlags(flags *flag.FlagSet) (*types.AccessConfig, error) {
+	onlyAddrStr, err := flags.GetString(flagInstantiateByAddress)
+	if err != nil {
+		return nil, fmt.Errorf("instantiate by address: %s", err)
+	}
+	if onlyAddrStr != "" {
+		err := sdk.ValidateAccAddress(onlyAddrStr)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, flagInstantiateByAddress)
+		}
+		x := types.AccessTypeOnlyAddress.With(sdk.AccAddress(onlyAddrStr))
+		return &x, nil
+	}
+
+	anyOfAddrsStr, err := flags.GetStringSlice(flagInstantiateByAnyOfAddress)
+	if err != nil {
+		return nil, fmt.Errorf("instantiate by any of address: %s", err)
+	}
+	if len(anyOfAddrsStr) != 0 {
+		addrs := make([]sdk.AccAddress, len(anyOfAddrsStr))
+		for i, addr := range anyOfAddrsStr {
+			if err := sdk.ValidateAccAddress(addr); err != nil {
+				return nil, sdkerrors.Wrap(err, flagInstantiateByAnyOfAddress)
+			}
+			addrs[i] = sdk.AccAddress(addr)
+		}
+		x := types.AllowAnyOfAddresses(addrs...)
+		return &x, nil
+	}
+
+	everybodyStr, err := flags.GetString(flagInstantiateByEverybody)
+	if err != nil {
+		return nil, fmt.Errorf("instantiate by everybody: %s", err)
+	}
+	if everybodyStr != "" {
+		ok, err := strconv.ParseBool(everybodyStr)
+		if err != nil {
+			return nil, fmt.Errorf("boolean value expected for instantiate by everybody: %s", err)
+		}
+		if ok {
+			return &types.AllowEverybody, nil
+		}
+	}
+
+	// the nobody flag is only registered on commands that allow to revoke all permissions
+	if flags.Lookup(flagInstantiateNobody) != nil {
+		nobodyStr, err := flags.GetString(flagInstantiateNobody)
+		if err != nil {
+			return nil, fmt.Errorf("instantiate by nobody: %s", err)
+		}
+		if nobodyStr != "" {
+			ok, err := strconv.ParseBool(nobodyStr)
+			if err != nil {
+				return nil, fmt.Errorf("boolean value expected for instantiate by nobody: %s", err)
+			}
+			if ok {
+				return &types.AllowNobody, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
 // InstantiateContractCmd will instantiate a contract from previously uploaded code.
 func InstantiateContractCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -215,6 +267,70 @@ func parseInstantiateArgs(rawCodeID, initMsg string, sender sdk.AccAddress, flag
 	return msg, nil
 }
 
+// InstantiateContract2Cmd will instantiate a contract from previously uploaded code with a predictable address.
+func InstantiateContract2Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "instantiate2 [code_id_int64] [json_encoded_init_args] [salt] --label [text] --admin [address,optional] " +
+			"--amount [coins,optional] --hex [bool,optional]",
+		Short: "Instantiate a wasm contract with a predictable address",
+		Long: `Creates a new instance of an uploaded wasm code with the given 'constructor' message.
+The address of the new contract is derived from the code checksum, the creator and the salt,
+so it can be computed with the build-address query before the contract is instantiated.
+By default the salt is read as plain text, use --hex to pass it hex encoded.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg, err := parseInstantiate2Args(args[0], args[1], args[2], clientCtx.GetFromAddress(), cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(flagAmount, "", "Coins to send to the contract during instantiation")
+	cmd.Flags().String(flagLabel, "", "A human-readable name for this contract in lists")
+	cmd.Flags().String(flagAdmin, "", "Address of an admin")
+	cmd.Flags().Bool(flagHexSalt, false, "Salt is hex encoded")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parseInstantiate2Args(rawCodeID, initMsg, rawSalt string, sender sdk.AccAddress, flags *flag.FlagSet) (types.MsgInstantiateContract2, error) {
+	instantiateMsg, err := parseInstantiateArgs(rawCodeID, initMsg, sender, flags)
+	if err != nil {
+		return types.MsgInstantiateContract2{}, err
+	}
+
+	isHex, err := flags.GetBool(flagHexSalt)
+	if err != nil {
+		return types.MsgInstantiateContract2{}, fmt.Errorf("hex: %s", err)
+	}
+	salt := []byte(rawSalt)
+	if isHex {
+		if salt, err = hex.DecodeString(rawSalt); err != nil {
+			return types.MsgInstantiateContract2{}, fmt.Errorf("salt: %s", err)
+		}
+	}
+
+	msg := types.MsgInstantiateContract2{
+		Sender:  instantiateMsg.Sender,
+		Admin:   instantiateMsg.Admin,
+		CodeID:  instantiateMsg.CodeID,
+		Label:   instantiateMsg.Label,
+		InitMsg: instantiateMsg.InitMsg,
+		Funds:   instantiateMsg.Funds,
+		Salt:    salt,
+	}
+	return msg, nil
+}
+
 // StoreCodeAndInstantiatecontractcmd will upload code and instantiate a contract using it
 func StoreCodeAndInstantiateContractCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -241,6 +357,7 @@ func StoreCodeAndInstantiateContractCmd() *cobra.Command {
 	cmd.Flags().String(flagBuilder, "", "A valid docker tag for the build system, optional")
 	cmd.Flags().String(flagInstantiateByEverybody, "", "Everybody can instantiate a contract from the code, optional")
 	cmd.Flags().String(flagInstantiateByAddress, "", "Only this address can instantiate a contract instance from the code, optional")
+	cmd.Flags().StringSlice(flagInstantiateByAnyOfAddress, []string{}, "Any of the addresses can instantiate a contract from the code, optional")
 	cmd.Flags().String(flagAmount, "", "Coins to send to the contract during instantiation")
 	cmd.Flags().String(flagLabel, "", "A human-readable name for this contract in lists")
 	cmd.Flags().String(flagAdmin, "", "Address of an admin")
@@ -265,32 +382,9 @@ func parseStoreCodeAndInstantiateContractArgs(file string, initMsg string, sende
 		return types.MsgStoreCodeAndInstantiateContract{}, fmt.Errorf("invalid input file. Use wasm binary or gzip")
 	}
 
-	var perm *types.AccessConfig
-	onlyAddrStr, err := flags.GetString(flagInstantiateByAddress)
+	perm, err := parseAccessConfigFlags(flags)
 	if err != nil {
-		return types.MsgStoreCodeAndInstantiateContract{}, fmt.Errorf("instantiate by address: %s", err)
-	}
-	if onlyAddrStr != "" {
-		err := sdk.ValidateAccAddress(onlyAddrStr)
-		if err != nil {
-			return types.MsgStoreCodeAndInstantiateContract{}, sdkerrors.Wrap(err, flagInstantiateByAddress)
-		}
-		x := types.AccessTypeOnlyAddress.With(sdk.AccAddress(onlyAddrStr))
-		perm = &x
-	} else {
-		everybodyStr, err := flags.GetString(flagInstantiateByEverybody)
-		if err != nil {
-			return types.MsgStoreCodeAndInstantiateContract{}, fmt.Errorf("instantiate by everybody: %s", err)
-		}
-		if everybodyStr != "" {
-			ok, err := strconv.ParseBool(everybodyStr)
-			if err != nil {
-				return types.MsgStoreCodeAndInstantiateContract{}, fmt.Errorf("boolean value expected for instantiate by everybody: %s", err)
-			}
-			if ok {
-				perm = &types.AllowEverybody
-			}
-		}
+		return types.MsgStoreCodeAndInstantiateContract{}, err
 	}
 
 	// build and sign the transaction, then broadcast to Tendermint
@@ -209,6 +209,41 @@ func TestGovRestHandlers(t *testing.T) {
 			},
 			expCode: http.StatusOK,
 		},
+		"update instantiate config": {
+			srcPath: "/gov/proposals/wasm_update_instantiate_config",
+			srcBody: dict{
+				"title":       "Test Proposal",
+				"description": "My proposal",
+				"access_config_updates": []dict{{
+					"code_id": "1",
+					"instantiate_permission": dict{
+						"permission": "AnyOfAddresses",
+						"addresses":  []string{"link1qyqszqgpqyqszqgpqyqszqgpqyqszqgp8apuk5"},
+					},
+				}},
+				"deposit":  []dict{{"denom": "ustake", "amount": "10"}},
+				"proposer": "link1qyqszqgpqyqszqgpqyqszqgpqyqszqgp8apuk5",
+				"base_req": aBaseReq,
+			},
+			expCode: http.StatusOK,
+		},
+		"update instantiate config with invalid permission": {
+			srcPath: "/gov/proposals/wasm_update_instantiate_config",
+			srcBody: dict{
+				"title":       "Test Proposal",
+				"description": "My proposal",
+				"access_config_updates": []dict{{
+					"code_id": "1",
+					"instantiate_permission": dict{
+						"permission": "AnyOfAddresses",
+					},
+				}},
+				"deposit":  []dict{{"denom": "ustake", "amount": "10"}},
+				"proposer": "link1qyqszqgpqyqszqgpqyqszqgpqyqszqgp8apuk5",
+				"base_req": aBaseReq,
+			},
+			expCode: http.StatusBadRequest,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -251,6 +251,137 @@ func ClearContractAdminProposalHandler(cliCtx client.Context) govrest.ProposalRE
 	}
 }
 
+type UpdateInstantiateConfigProposalJSONReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+
+	Proposer string    `json:"proposer" yaml:"proposer"`
+	Deposit  sdk.Coins `json:"deposit" yaml:"deposit"`
+
+	AccessConfigUpdates []types.AccessConfigUpdate `json:"access_config_updates" yaml:"access_config_updates"`
+}
+
+func (s UpdateInstantiateConfigProposalJSONReq) Content() govtypes.Content {
+	return &types.UpdateInstantiateConfigProposal{
+		Title:               s.Title,
+		Description:         s.Description,
+		AccessConfigUpdates: s.AccessConfigUpdates,
+	}
+}
+func (s UpdateInstantiateConfigProposalJSONReq) GetProposer() string {
+	return s.Proposer
+}
+func (s UpdateInstantiateConfigProposalJSONReq) GetDeposit() sdk.Coins {
+	return s.Deposit
+}
+func (s UpdateInstantiateConfigProposalJSONReq) GetBaseReq() rest.BaseReq {
+	return s.BaseReq
+}
+func UpdateInstantiateConfigProposalHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "wasm_update_instantiate_config",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req UpdateInstantiateConfigProposalJSONReq
+			if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+				return
+			}
+			toStdTxResponse(cliCtx, w, req)
+		},
+	}
+}
+
+type AddScheduleProposalJSONReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+
+	Proposer string    `json:"proposer" yaml:"proposer"`
+	Deposit  sdk.Coins `json:"deposit" yaml:"deposit"`
+
+	Contract    string          `json:"contract" yaml:"contract"`
+	Msg         json.RawMessage `json:"msg" yaml:"msg"`
+	Interval    uint64          `json:"interval" yaml:"interval"`
+	StartHeight int64           `json:"start_height" yaml:"start_height"`
+	GasLimit    uint64          `json:"gas_limit" yaml:"gas_limit"`
+}
+
+func (s AddScheduleProposalJSONReq) Content() govtypes.Content {
+	return &types.AddScheduleProposal{
+		Title:       s.Title,
+		Description: s.Description,
+		Contract:    s.Contract,
+		Msg:         s.Msg,
+		Interval:    s.Interval,
+		StartHeight: s.StartHeight,
+		GasLimit:    s.GasLimit,
+	}
+}
+func (s AddScheduleProposalJSONReq) GetProposer() string {
+	return s.Proposer
+}
+func (s AddScheduleProposalJSONReq) GetDeposit() sdk.Coins {
+	return s.Deposit
+}
+func (s AddScheduleProposalJSONReq) GetBaseReq() rest.BaseReq {
+	return s.BaseReq
+}
+func AddScheduleProposalHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "wasm_add_schedule",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req AddScheduleProposalJSONReq
+			if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+				return
+			}
+			toStdTxResponse(cliCtx, w, req)
+		},
+	}
+}
+
+type RemoveScheduleProposalJSONReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+
+	Proposer string    `json:"proposer" yaml:"proposer"`
+	Deposit  sdk.Coins `json:"deposit" yaml:"deposit"`
+
+	ScheduleID uint64 `json:"schedule_id" yaml:"schedule_id"`
+}
+
+func (s RemoveScheduleProposalJSONReq) Content() govtypes.Content {
+	return &types.RemoveScheduleProposal{
+		Title:       s.Title,
+		Description: s.Description,
+		ScheduleID:  s.ScheduleID,
+	}
+}
+func (s RemoveScheduleProposalJSONReq) GetProposer() string {
+	return s.Proposer
+}
+func (s RemoveScheduleProposalJSONReq) GetDeposit() sdk.Coins {
+	return s.Deposit
+}
+func (s RemoveScheduleProposalJSONReq) GetBaseReq() rest.BaseReq {
+	return s.BaseReq
+}
+func RemoveScheduleProposalHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "wasm_remove_schedule",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req RemoveScheduleProposalJSONReq
+			if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+				return
+			}
+			toStdTxResponse(cliCtx, w, req)
+		},
+	}
+}
+
 type wasmProposalData interface {
 	Content() govtypes.Content
 	GetProposer() string
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
 	"github.com/line/lbm-sdk/x/wasm/types"
 )
 
@@ -10,7 +11,8 @@ var _ types.ContractOpsKeeper = PermissionedKeeper{}
 // decoratedKeeper contains a subset of the wasm keeper that are already or can be guarded by an authorization policy in the future
 type decoratedKeeper interface {
 	create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, source string, builder string, instantiateAccess *types.AccessConfig, authZ AuthorizationPolicy) (codeID uint64, err error)
-	instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins, authZ AuthorizationPolicy) (sdk.AccAddress, []byte, error)
+	instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins, addressGenerator AddressGenerator, authZ AuthorizationPolicy) (sdk.AccAddress, []byte, error)
+	ClassicAddressGenerator() AddressGenerator
 	migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, newCodeID uint64, msg []byte, authZ AuthorizationPolicy) (*sdk.Result, error)
 	setContractAdmin(ctx sdk.Context, contractAddress, caller, newAdmin sdk.AccAddress, authZ AuthorizationPolicy) error
 	pinCode(ctx sdk.Context, codeID uint64) error
@@ -18,6 +20,9 @@ type decoratedKeeper interface {
 	execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) (*sdk.Result, error)
 	setContractInfoExtension(ctx sdk.Context, contract sdk.AccAddress, extra types.ContractInfoExtension) error
 	setContractStatus(ctx sdk.Context, contract sdk.AccAddress, caller sdk.AccAddress, status types.ContractStatus, authZ AuthorizationPolicy) error
+	setAccessConfig(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, newConfig types.AccessConfig, authZ AuthorizationPolicy) error
+	createSchedule(ctx sdk.Context, contractAddress, caller sdk.AccAddress, msg []byte, interval uint64, startHeight int64, gasLimit uint64, authZ AuthorizationPolicy) (uint64, error)
+	removeSchedule(ctx sdk.Context, scheduleID uint64, caller sdk.AccAddress, authZ AuthorizationPolicy) error
 }
 
 type PermissionedKeeper struct {
@@ -42,7 +47,19 @@ func (p PermissionedKeeper) Create(ctx sdk.Context, creator sdk.AccAddress, wasm
 }
 
 func (p PermissionedKeeper) Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins) (sdk.AccAddress, []byte, error) {
-	return p.nested.instantiate(ctx, codeID, creator, admin, initMsg, label, deposit, p.authZPolicy)
+	return p.nested.instantiate(ctx, codeID, creator, admin, initMsg, label, deposit, p.nested.ClassicAddressGenerator(), p.authZPolicy)
+}
+
+// Instantiate2 creates an instance of a WASM contract using the predictable address generator
+func (p PermissionedKeeper) Instantiate2(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins, salt []byte) (sdk.AccAddress, []byte, error) {
+	if err := types.ValidateSalt(salt); err != nil {
+		return "", nil, sdkerrors.Wrap(err, "salt")
+	}
+	creatorBytes, err := sdk.AccAddressToBytes(creator.String())
+	if err != nil {
+		return "", nil, sdkerrors.Wrap(err, "creator")
+	}
+	return p.nested.instantiate(ctx, codeID, creator, admin, initMsg, label, deposit, PredictableAddressGenerator(creatorBytes, salt), p.authZPolicy)
 }
 
 func (p PermissionedKeeper) Execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) (*sdk.Result, error) {
@@ -77,3 +94,18 @@ func (p PermissionedKeeper) SetContractInfoExtension(ctx sdk.Context, contract s
 func (p PermissionedKeeper) UpdateContractStatus(ctx sdk.Context, contract sdk.AccAddress, caller sdk.AccAddress, status types.ContractStatus) error {
 	return p.nested.setContractStatus(ctx, contract, caller, status, p.authZPolicy)
 }
+
+// SetAccessConfig updates the access config of a code id.
+func (p PermissionedKeeper) SetAccessConfig(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, newConfig types.AccessConfig) error {
+	return p.nested.setAccessConfig(ctx, codeID, caller, newConfig, p.authZPolicy)
+}
+
+// CreateSchedule schedules executions of the sudo entry point of the contract, returning the schedule id
+func (p PermissionedKeeper) CreateSchedule(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, interval uint64, startHeight int64, gasLimit uint64) (uint64, error) {
+	return p.nested.createSchedule(ctx, contractAddress, caller, msg, interval, startHeight, gasLimit, p.authZPolicy)
+}
+
+// RemoveSchedule deletes a schedule so that it is not executed anymore
+func (p PermissionedKeeper) RemoveSchedule(ctx sdk.Context, scheduleID uint64, caller sdk.AccAddress) error {
+	return p.nested.removeSchedule(ctx, scheduleID, caller, p.authZPolicy)
+}
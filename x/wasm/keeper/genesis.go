@@ -48,6 +48,16 @@ func InitGenesis(ctx sdk.Context, keeper *Keeper, data types.GenesisState, staki
 		maxContractID = i + 1 // not ideal but max(contractID) is not persisted otherwise
 	}
 
+	var maxScheduleID uint64
+	for i, schedule := range data.Schedules {
+		if err := keeper.importSchedule(ctx, schedule); err != nil {
+			return nil, sdkerrors.Wrapf(err, "schedule number %d", i)
+		}
+		if schedule.ID > maxScheduleID {
+			maxScheduleID = schedule.ID
+		}
+	}
+
 	for i, seq := range data.Sequences {
 		err := keeper.importAutoIncrementID(ctx, seq.IDKey, seq.Value)
 		if err != nil {
@@ -62,6 +72,9 @@ func InitGenesis(ctx sdk.Context, keeper *Keeper, data types.GenesisState, staki
 	if keeper.peekAutoIncrementID(ctx, types.KeyLastInstanceID) <= uint64(maxContractID) {
 		return nil, sdkerrors.Wrapf(types.ErrInvalid, "seq %s must be greater %d ", string(types.KeyLastInstanceID), maxContractID)
 	}
+	if keeper.peekAutoIncrementID(ctx, types.KeyLastScheduleID) <= maxScheduleID {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "seq %s must be greater %d ", string(types.KeyLastScheduleID), maxScheduleID)
+	}
 
 	if len(data.GenMsgs) == 0 {
 		return nil, nil
@@ -120,7 +133,12 @@ func ExportGenesis(ctx sdk.Context, keeper *Keeper) *types.GenesisState {
 		return false
 	})
 
-	for _, k := range [][]byte{types.KeyLastCodeID, types.KeyLastInstanceID} {
+	keeper.IterateSchedules(ctx, func(schedule types.Schedule) bool {
+		genState.Schedules = append(genState.Schedules, schedule)
+		return false
+	})
+
+	for _, k := range [][]byte{types.KeyLastCodeID, types.KeyLastInstanceID, types.KeyLastScheduleID} {
 		genState.Sequences = append(genState.Sequences, types.Sequence{
 			IDKey: k,
 			Value: keeper.peekAutoIncrementID(ctx, k),
@@ -84,6 +84,15 @@ func TestGenesisExportImport(t *testing.T) {
 		wasmKeeper.storeContractInfo(srcCtx, contractAddr, &contract)
 		wasmKeeper.appendToContractHistory(srcCtx, contractAddr, history...)
 		wasmKeeper.importContractState(srcCtx, contractAddr, stateModels)
+		wasmKeeper.storeSchedule(srcCtx, types.Schedule{
+			ID:         wasmKeeper.autoIncrementID(srcCtx, types.KeyLastScheduleID),
+			Contract:   contractAddr.String(),
+			Msg:        []byte(`{}`),
+			Interval:   uint64(i),
+			NextHeight: int64(i) + 1,
+			GasLimit:   100_000,
+			Creator:    codeInfo.Creator,
+		})
 	}
 	var wasmParams types.Params
 	f.NilChance(0).Fuzz(&wasmParams)
@@ -118,6 +127,8 @@ func TestGenesisExportImport(t *testing.T) {
 		newHistory := x.ResetFromGenesis(dstCtx)
 		wasmKeeper.storeContractInfo(srcCtx, address, x)
 		wasmKeeper.addToContractCodeSecondaryIndex(srcCtx, address, newHistory)
+		wasmKeeper.addToContractCreatorSecondaryIndex(srcCtx, sdk.AccAddress(x.Creator), address, x.Created)
+		wasmKeeper.addToContractAdminSecondaryIndex(srcCtx, x.AdminAddr(), address)
 		wasmKeeper.appendToContractHistory(srcCtx, address, newHistory)
 		return false
 	})
@@ -13,6 +13,7 @@ import (
 	sdk "github.com/line/lbm-sdk/types"
 	sdkerrors "github.com/line/lbm-sdk/types/errors"
 	authkeeper "github.com/line/lbm-sdk/x/auth/keeper"
+	authtypes "github.com/line/lbm-sdk/x/auth/types"
 	paramtypes "github.com/line/lbm-sdk/x/params/types"
 	"github.com/line/lbm-sdk/x/wasm/types"
 	"github.com/line/ostracon/crypto"
@@ -200,6 +201,7 @@ func (k Keeper) create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte,
 	}
 	codeInfo := types.NewCodeInfo(codeHash, creator, source, builder, *instantiateAccess)
 	k.storeCodeInfo(ctx, codeID, codeInfo)
+	k.addToCodeChecksumSecondaryIndex(ctx, codeHash, codeID)
 	return codeID, nil
 }
 
@@ -229,19 +231,35 @@ func (k Keeper) importCode(ctx sdk.Context, codeID uint64, codeInfo types.CodeIn
 	}
 	// 0x01 | codeID (uint64) -> ContractInfo
 	store.Set(key, k.cdc.MustMarshalBinaryBare(&codeInfo))
+	k.addToCodeChecksumSecondaryIndex(ctx, codeInfo.CodeHash, codeID)
 	return nil
 }
 
-func (k Keeper) instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins, authZ AuthorizationPolicy) (sdk.AccAddress, []byte, error) {
+func (k Keeper) instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins, addressGenerator AddressGenerator, authZ AuthorizationPolicy) (sdk.AccAddress, []byte, error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "instantiate")
 	if !k.IsPinnedCode(ctx, codeID) {
 		ctx.GasMeter().ConsumeGas(k.getInstanceCost(ctx), "Loading CosmWasm module: instantiate")
 	}
 
+	// get contact info
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetCodeKey(codeID))
+	if bz == nil {
+		return "", nil, sdkerrors.Wrap(types.ErrNotFound, "code")
+	}
+	var codeInfo types.CodeInfo
+	k.cdc.MustUnmarshalBinaryBare(bz, &codeInfo)
+
 	// create contract address
-	contractAddress := k.generateContractAddress(ctx, codeID)
+	contractAddress := addressGenerator(ctx, codeID, codeInfo.CodeHash)
+	if k.HasContractInfo(ctx, contractAddress) {
+		return "", nil, sdkerrors.Wrapf(types.ErrDuplicate, "contract: %s", contractAddress)
+	}
+	// a predictable contract address is known before the instantiation, so anyone may have sent coins to it and
+	// created a plain account there already. Such an account, which has never signed, is reused as the contract
+	// account; any other account at the address blocks the instantiation.
 	existingAcct := k.accountKeeper.GetAccount(ctx, contractAddress)
-	if existingAcct != nil {
+	if existingAcct != nil && !isReusableContractAccount(existingAcct) {
 		return "", nil, sdkerrors.Wrap(types.ErrAccountExists, existingAcct.GetAddress().String())
 	}
 
@@ -251,22 +269,13 @@ func (k Keeper) instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 			return "", nil, err
 		}
 
-	} else {
+	} else if existingAcct == nil {
 		// create an empty account (so we don't have issues later)
 		// TODO: can we remove this?
 		contractAccount := k.accountKeeper.NewAccountWithAddress(ctx, contractAddress)
 		k.accountKeeper.SetAccount(ctx, contractAccount)
 	}
 
-	// get contact info
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.GetCodeKey(codeID))
-	if bz == nil {
-		return "", nil, sdkerrors.Wrap(types.ErrNotFound, "code")
-	}
-	var codeInfo types.CodeInfo
-	k.cdc.MustUnmarshalBinaryBare(bz, &codeInfo)
-
 	if !authZ.CanInstantiateContract(codeInfo.InstantiateConfig, creator) {
 		return "", nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not instantiate")
 	}
@@ -317,6 +326,8 @@ func (k Keeper) instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 	// store contract before dispatch so that contract could be called back
 	historyEntry := contractInfo.InitialHistory(initMsg)
 	k.addToContractCodeSecondaryIndex(ctx, contractAddress, historyEntry)
+	k.addToContractCreatorSecondaryIndex(ctx, creator, contractAddress, createdAt)
+	k.addToContractAdminSecondaryIndex(ctx, admin, contractAddress)
 	k.appendToContractHistory(ctx, contractAddress, historyEntry)
 	k.storeContractInfo(ctx, contractAddress, &contractInfo)
 
@@ -550,6 +561,36 @@ func (k Keeper) removeFromContractCodeSecondaryIndex(ctx sdk.Context, contractAd
 	ctx.KVStore(k.storeKey).Delete(types.GetContractByCreatedSecondaryIndexKey(contractAddress, entry))
 }
 
+// addToContractCreatorSecondaryIndex adds element to the index for contracts-by-creator queries
+func (k Keeper) addToContractCreatorSecondaryIndex(ctx sdk.Context, creator, contractAddress sdk.AccAddress, created *types.AbsoluteTxPosition) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetContractsByCreatorSecondaryIndexKey(creator, contractAddress, created), []byte{})
+}
+
+// addToContractAdminSecondaryIndex adds element to the index for contracts-by-admin queries. Contracts without
+// an admin are not indexed.
+func (k Keeper) addToContractAdminSecondaryIndex(ctx sdk.Context, admin, contractAddress sdk.AccAddress) {
+	if admin.Empty() {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetContractsByAdminSecondaryIndexKey(admin, contractAddress), []byte{})
+}
+
+// removeFromContractAdminSecondaryIndex removes element from the index for contracts-by-admin queries
+func (k Keeper) removeFromContractAdminSecondaryIndex(ctx sdk.Context, admin, contractAddress sdk.AccAddress) {
+	if admin.Empty() {
+		return
+	}
+	ctx.KVStore(k.storeKey).Delete(types.GetContractsByAdminSecondaryIndexKey(admin, contractAddress))
+}
+
+// addToCodeChecksumSecondaryIndex adds element to the index for codes-by-checksum queries
+func (k Keeper) addToCodeChecksumSecondaryIndex(ctx sdk.Context, checksum []byte, codeID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetCodeByChecksumSecondaryIndexKey(checksum, codeID), []byte{})
+}
+
 // IterateContractsByCode iterates over all contracts with given codeID ASC on code update time.
 func (k Keeper) IterateContractsByCode(ctx sdk.Context, codeID uint64, cb func(address sdk.AccAddress) bool) {
 	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetContractByCodeIDSecondaryIndexPrefix(codeID))
@@ -577,6 +618,19 @@ func (k Keeper) setContractStatus(ctx sdk.Context, contractAddress sdk.AccAddres
 	return nil
 }
 
+func (k Keeper) setAccessConfig(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, newConfig types.AccessConfig, authZ AuthorizationPolicy) error {
+	info := k.GetCodeInfo(ctx, codeID)
+	if info == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "code info")
+	}
+	if !authZ.CanModifyCodeAccessConfig(sdk.AccAddress(info.Creator), caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not modify code access config")
+	}
+	info.InstantiateConfig = newConfig
+	k.storeCodeInfo(ctx, codeID, *info)
+	return nil
+}
+
 func (k Keeper) setContractAdmin(ctx sdk.Context, contractAddress, caller, newAdmin sdk.AccAddress, authZ AuthorizationPolicy) error {
 	contractInfo := k.GetContractInfo(ctx, contractAddress)
 	if contractInfo == nil {
@@ -588,6 +642,8 @@ func (k Keeper) setContractAdmin(ctx sdk.Context, contractAddress, caller, newAd
 	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
 		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
 	}
+	k.removeFromContractAdminSecondaryIndex(ctx, contractInfo.AdminAddr(), contractAddress)
+	k.addToContractAdminSecondaryIndex(ctx, newAdmin, contractAddress)
 	contractInfo.Admin = newAdmin.String()
 	k.storeContractInfo(ctx, contractAddress, contractInfo)
 	return nil
@@ -881,6 +937,24 @@ func (k Keeper) generateContractAddress(ctx sdk.Context, codeID uint64) sdk.AccA
 	return contractAddress(codeID, instanceID)
 }
 
+// AddressGenerator abstracts the address generation for a new contract instance
+type AddressGenerator func(ctx sdk.Context, codeID uint64, checksum []byte) sdk.AccAddress
+
+// ClassicAddressGenerator generates a contract address from the code id and an instance counter
+func (k Keeper) ClassicAddressGenerator() AddressGenerator {
+	return func(ctx sdk.Context, codeID uint64, _ []byte) sdk.AccAddress {
+		return k.generateContractAddress(ctx, codeID)
+	}
+}
+
+// PredictableAddressGenerator generates a contract address from the code checksum, the creator and the salt,
+// so that it is known before the contract is instantiated
+func PredictableAddressGenerator(creator, salt []byte) AddressGenerator {
+	return func(_ sdk.Context, _ uint64, checksum []byte) sdk.AccAddress {
+		return BuildContractAddressPredictable(checksum, creator, salt)
+	}
+}
+
 // contractAddress builds an sdk account address for a contract.
 // Intentionally kept private as this is module internal logic.
 func contractAddress(codeID, instanceID uint64) sdk.AccAddress {
@@ -890,6 +964,30 @@ func contractAddress(codeID, instanceID uint64) sdk.AccAddress {
 	return addrFromUint64(contractID)
 }
 
+// BuildContractAddressPredictable builds a contract address from the code checksum, the creator address
+// and the salt. Each input is length prefixed so that different combinations can not produce the same key.
+func BuildContractAddressPredictable(checksum, creator, salt []byte) sdk.AccAddress {
+	key := make([]byte, 0, len(types.ModuleName)+1+3*8+len(checksum)+len(creator)+len(salt))
+	key = append(key, types.ModuleName...)
+	key = append(key, 0)
+	key = append(key, uint64LengthPrefix(checksum)...)
+	key = append(key, uint64LengthPrefix(creator)...)
+	key = append(key, uint64LengthPrefix(salt)...)
+	return sdk.BytesToAccAddress(crypto.AddressHash(key))
+}
+
+// isReusableContractAccount returns true for a plain base account that has never signed, which can only have been
+// created by coins sent to the address.
+func isReusableContractAccount(acc authtypes.AccountI) bool {
+	baseAcc, ok := acc.(*authtypes.BaseAccount)
+	return ok && baseAcc.GetPubKey() == nil && baseAcc.GetSequence() == 0
+}
+
+// uint64LengthPrefix prepends the length of the bytes as big endian uint64
+func uint64LengthPrefix(bz []byte) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(len(bz))), bz...)
+}
+
 // GetNextCodeID reads the next sequence id used for storing wasm code.
 // Read only operation.
 func (k Keeper) GetNextCodeID(ctx sdk.Context) uint64 {
@@ -947,6 +1045,8 @@ func (k Keeper) importContract(ctx sdk.Context, contractAddr sdk.AccAddress, c *
 	k.appendToContractHistory(ctx, contractAddr, historyEntry)
 	k.storeContractInfo(ctx, contractAddr, c)
 	k.addToContractCodeSecondaryIndex(ctx, contractAddr, historyEntry)
+	k.addToContractCreatorSecondaryIndex(ctx, sdk.AccAddress(c.Creator), contractAddr, c.Created)
+	k.addToContractAdminSecondaryIndex(ctx, c.AdminAddr(), contractAddr)
 	return k.importContractState(ctx, contractAddr, state)
 }
 
@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/line/lbm-sdk/crypto/keys/secp256k1"
 	stypes "github.com/line/lbm-sdk/store/types"
 	sdk "github.com/line/lbm-sdk/types"
 	sdkerrors "github.com/line/lbm-sdk/types/errors"
@@ -287,7 +288,7 @@ func TestInstantiate(t *testing.T) {
 
 	gasAfter := ctx.GasMeter().GasConsumed()
 	if types.EnableGasVerification {
-		require.Equal(t, uint64(0x1115c), gasAfter-gasBefore)
+		require.Equal(t, uint64(0x11d14), gasAfter-gasBefore)
 	}
 
 	// ensure it is stored properly
@@ -1611,6 +1612,64 @@ func runUpdateStatusSpec(t *testing.T, msg string, contractKeeper types.Contract
 	})
 }
 
+func TestSetAccessConfig(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	accKeeper, keeper, bankKeeper := keepers.AccountKeeper, keepers.ContractKeeper, keepers.BankKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := createFakeFundedAccount(t, ctx, accKeeper, bankKeeper, deposit)
+	fred := createFakeFundedAccount(t, ctx, accKeeper, bankKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	codeID, err := keeper.Create(ctx, creator, wasmCode, "", "", nil)
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		codeID    uint64
+		caller    sdk.AccAddress
+		newConfig types.AccessConfig
+		expErr    *sdkerrors.Error
+	}{
+		"creator can update": {
+			codeID:    codeID,
+			caller:    creator,
+			newConfig: types.AllowAnyOfAddresses(creator, fred),
+		},
+		"creator can restrict to nobody": {
+			codeID:    codeID,
+			caller:    creator,
+			newConfig: types.AllowNobody,
+		},
+		"non creator can not update": {
+			codeID:    codeID,
+			caller:    fred,
+			newConfig: types.AllowEverybody,
+			expErr:    sdkerrors.ErrUnauthorized,
+		},
+		"unknown code id": {
+			codeID:    codeID + 1,
+			caller:    creator,
+			newConfig: types.AllowEverybody,
+			expErr:    types.ErrNotFound,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			err := keeper.SetAccessConfig(ctx, spec.codeID, spec.caller, spec.newConfig)
+			require.True(t, spec.expErr.Is(err), "expected %v but got %+v", spec.expErr, err)
+			if spec.expErr != nil {
+				return
+			}
+			cInfo := keepers.WasmKeeper.GetCodeInfo(ctx, spec.codeID)
+			require.NotNil(t, cInfo)
+			assert.Equal(t, spec.newConfig, cInfo.InstantiateConfig)
+		})
+	}
+}
+
 func TestInitializePinnedCodes(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
 	k := keepers.WasmKeeper
@@ -1728,3 +1787,173 @@ func TestNewDefaultWasmVMContractResponseHandler(t *testing.T) {
 	}
 
 }
+
+func TestInstantiate2(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	accKeeper, keeper, bankKeeper := keepers.AccountKeeper, keepers.ContractKeeper, keepers.BankKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := createFakeFundedAccount(t, ctx, accKeeper, bankKeeper, deposit)
+	otherCreator := createFakeFundedAccount(t, ctx, accKeeper, bankKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	codeID, err := keeper.Create(ctx, creator, wasmCode, "", "", nil)
+	require.NoError(t, err)
+	codeInfo := keepers.WasmKeeper.GetCodeInfo(ctx, codeID)
+	require.NotNil(t, codeInfo)
+
+	_, _, bob := keyPubAddr()
+	_, _, fred := keyPubAddr()
+	initMsgBz, err := json.Marshal(HackatomExampleInitMsg{Verifier: fred, Beneficiary: bob})
+	require.NoError(t, err)
+
+	mustBuildAddress := func(creator sdk.AccAddress, salt []byte) sdk.AccAddress {
+		creatorBz, err := sdk.AccAddressToBytes(creator.String())
+		require.NoError(t, err)
+		return BuildContractAddressPredictable(codeInfo.CodeHash, creatorBz, salt)
+	}
+
+	// specs run in order as the duplicate case depends on the first one
+	specs := []struct {
+		name    string
+		creator sdk.AccAddress
+		salt    []byte
+		expErr  *sdkerrors.Error
+	}{
+		{
+			name:    "new address",
+			creator: creator,
+			salt:    []byte("my salt"),
+		},
+		{
+			name:    "same salt with other creator",
+			creator: otherCreator,
+			salt:    []byte("my salt"),
+		},
+		{
+			name:    "duplicate address",
+			creator: creator,
+			salt:    []byte("my salt"),
+			expErr:  types.ErrDuplicate,
+		},
+		{
+			name:    "empty salt",
+			creator: creator,
+			salt:    nil,
+			expErr:  types.ErrEmpty,
+		},
+		{
+			name:    "salt too long",
+			creator: creator,
+			salt:    bytes.Repeat([]byte{1}, types.MaxSaltSize+1),
+			expErr:  types.ErrLimit,
+		},
+	}
+	for _, spec := range specs {
+		t.Run(spec.name, func(t *testing.T) {
+			gotAddr, _, gotErr := keeper.Instantiate2(ctx, codeID, spec.creator, "", initMsgBz, "salted contract", nil, spec.salt)
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), "got %+v", gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, mustBuildAddress(spec.creator, spec.salt), gotAddr)
+			assert.True(t, keepers.WasmKeeper.HasContractInfo(ctx, gotAddr))
+		})
+	}
+}
+
+func TestInstantiate2WithExistingAccount(t *testing.T) {
+	specs := map[string]struct {
+		account func(ctx sdk.Context, keepers TestKeepers, addr sdk.AccAddress) authtypes.AccountI
+		expErr  *sdkerrors.Error
+	}{
+		"plain account funded before the instantiation": {
+			account: func(ctx sdk.Context, keepers TestKeepers, addr sdk.AccAddress) authtypes.AccountI {
+				funder := createFakeFundedAccount(t, ctx, keepers.AccountKeeper, keepers.BankKeeper, sdk.NewCoins(sdk.NewInt64Coin("denom", 1)))
+				require.NoError(t, keepers.BankKeeper.SendCoins(ctx, funder, addr, sdk.NewCoins(sdk.NewInt64Coin("denom", 1))))
+				return keepers.AccountKeeper.GetAccount(ctx, addr)
+			},
+		},
+		"account with a public key": {
+			account: func(ctx sdk.Context, keepers TestKeepers, addr sdk.AccAddress) authtypes.AccountI {
+				acc := keepers.AccountKeeper.NewAccountWithAddress(ctx, addr)
+				require.NoError(t, acc.SetPubKey(secp256k1.GenPrivKey().PubKey()))
+				return acc
+			},
+			expErr: types.ErrAccountExists,
+		},
+		"account with a sequence": {
+			account: func(ctx sdk.Context, keepers TestKeepers, addr sdk.AccAddress) authtypes.AccountI {
+				acc := keepers.AccountKeeper.NewAccountWithAddress(ctx, addr)
+				require.NoError(t, acc.SetSequence(1))
+				return acc
+			},
+			expErr: types.ErrAccountExists,
+		},
+		"module account": {
+			account: func(ctx sdk.Context, keepers TestKeepers, addr sdk.AccAddress) authtypes.AccountI {
+				return authtypes.NewModuleAccount(authtypes.NewBaseAccountWithAddress(addr), "some module")
+			},
+			expErr: types.ErrAccountExists,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+			accKeeper, keeper, bankKeeper := keepers.AccountKeeper, keepers.ContractKeeper, keepers.BankKeeper
+
+			deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+			creator := createFakeFundedAccount(t, ctx, accKeeper, bankKeeper, deposit)
+
+			wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+			require.NoError(t, err)
+			codeID, err := keeper.Create(ctx, creator, wasmCode, "", "", nil)
+			require.NoError(t, err)
+
+			salt := []byte("my salt")
+			creatorBz, err := sdk.AccAddressToBytes(creator.String())
+			require.NoError(t, err)
+			contractAddr := BuildContractAddressPredictable(keepers.WasmKeeper.GetCodeInfo(ctx, codeID).CodeHash, creatorBz, salt)
+			existingAcct := spec.account(ctx, keepers, contractAddr)
+			accKeeper.SetAccount(ctx, existingAcct)
+			balanceBefore := bankKeeper.GetAllBalances(ctx, contractAddr)
+
+			_, _, bob := keyPubAddr()
+			_, _, fred := keyPubAddr()
+			initMsgBz, err := json.Marshal(HackatomExampleInitMsg{Verifier: fred, Beneficiary: bob})
+			require.NoError(t, err)
+
+			gotAddr, _, err := keeper.Instantiate2(ctx, codeID, creator, "", initMsgBz, "salted contract", deposit, salt)
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(err), "got %+v", err)
+				assert.False(t, keepers.WasmKeeper.HasContractInfo(ctx, contractAddr))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, contractAddr, gotAddr)
+			assert.True(t, keepers.WasmKeeper.HasContractInfo(ctx, contractAddr))
+			// the coins sent to the address before the instantiation are kept
+			assert.Equal(t, balanceBefore.Add(deposit...), bankKeeper.GetAllBalances(ctx, contractAddr))
+		})
+	}
+}
+
+func TestBuildContractAddressPredictable(t *testing.T) {
+	checksum := bytes.Repeat([]byte{1}, 32)
+	creator := bytes.Repeat([]byte{2}, 20)
+	salt := []byte("salt")
+
+	addr := BuildContractAddressPredictable(checksum, creator, salt)
+	require.NoError(t, sdk.ValidateAccAddress(addr.String()))
+	assert.Equal(t, addr, BuildContractAddressPredictable(checksum, creator, salt))
+
+	// any input change results in another address
+	assert.NotEqual(t, addr, BuildContractAddressPredictable(bytes.Repeat([]byte{3}, 32), creator, salt))
+	assert.NotEqual(t, addr, BuildContractAddressPredictable(checksum, bytes.Repeat([]byte{3}, 20), salt))
+	assert.NotEqual(t, addr, BuildContractAddressPredictable(checksum, creator, []byte("other salt")))
+	// length prefixes prevent ambiguous concatenations
+	assert.NotEqual(t, BuildContractAddressPredictable([]byte{1, 2}, []byte{3}, salt), BuildContractAddressPredictable([]byte{1}, []byte{2, 3}, salt))
+}
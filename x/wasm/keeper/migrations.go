@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/wasm/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2. The secondary indexes for the contracts by creator, the contracts by
+// admin and the codes by checksum are built from the stored contract and code infos.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.IterateContractInfo(ctx, func(contractAddr sdk.AccAddress, info types.ContractInfo) bool {
+		m.keeper.addToContractCreatorSecondaryIndex(ctx, sdk.AccAddress(info.Creator), contractAddr, info.Created)
+		m.keeper.addToContractAdminSecondaryIndex(ctx, info.AdminAddr(), contractAddr)
+		return false
+	})
+	m.keeper.IterateCodeInfos(ctx, func(codeID uint64, info types.CodeInfo) bool {
+		m.keeper.addToCodeChecksumSecondaryIndex(ctx, info.CodeHash, codeID)
+		return false
+	})
+	return nil
+}
+
+// Migrate2to3 migrates from version 2 to 3. The params for the execution of schedules are set to their defaults.
+// Chains whose fee denom is not the default bond denom should set the schedule fees in the upgrade handler afterwards.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	defaults := types.DefaultParams()
+	m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyMaxScheduleGasPerBlock, defaults.MaxScheduleGasPerBlock)
+	m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyScheduleCreationFee, defaults.ScheduleCreationFee)
+	m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyScheduleExecutionFee, defaults.ScheduleExecutionFee)
+	m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyMaxSchedulesPerContract, defaults.MaxSchedulesPerContract)
+	return nil
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/wasm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	accKeeper, keeper, bankKeeper := keepers.AccountKeeper, keepers.WasmKeeper, keepers.BankKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 1000000))
+	creator := createFakeFundedAccount(t, ctx, accKeeper, bankKeeper, deposit)
+	_, _, bob := keyPubAddr()
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+	codeID, err := keepers.ContractKeeper.Create(ctx, creator, wasmCode, "", "", nil)
+	require.NoError(t, err)
+	codeInfo := keeper.GetCodeInfo(ctx, codeID)
+
+	initMsgBz, err := json.Marshal(HackatomExampleInitMsg{Verifier: creator, Beneficiary: bob})
+	require.NoError(t, err)
+	contractAddr, _, err := keepers.ContractKeeper.Instantiate(ctx, codeID, creator, bob, initMsgBz, "demo contract", nil)
+	require.NoError(t, err)
+	contractInfo := keeper.GetContractInfo(ctx, contractAddr)
+
+	// drop the indexes as they were not maintained before
+	store := ctx.KVStore(keeper.storeKey)
+	creatorKey := types.GetContractsByCreatorSecondaryIndexKey(creator, contractAddr, contractInfo.Created)
+	adminKey := types.GetContractsByAdminSecondaryIndexKey(bob, contractAddr)
+	checksumKey := types.GetCodeByChecksumSecondaryIndexKey(codeInfo.CodeHash, codeID)
+	for _, key := range [][]byte{creatorKey, adminKey, checksumKey} {
+		require.True(t, store.Has(key))
+		store.Delete(key)
+	}
+
+	require.NoError(t, NewMigrator(*keeper).Migrate1to2(ctx))
+
+	assert.True(t, store.Has(creatorKey))
+	assert.True(t, store.Has(adminKey))
+	assert.True(t, store.Has(checksumKey))
+}
+
+func TestMigrate2to3(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	keeper := keepers.WasmKeeper
+
+	params := types.DefaultParams()
+	params.MaxScheduleGasPerBlock = 1
+	params.ScheduleCreationFee = sdk.NewCoins(sdk.NewInt64Coin("denom", 1))
+	params.ScheduleExecutionFee = sdk.NewCoins(sdk.NewInt64Coin("denom", 1))
+	params.MaxSchedulesPerContract = 1
+	keeper.setParams(ctx, params)
+
+	require.NoError(t, NewMigrator(*keeper).Migrate2to3(ctx))
+
+	assert.Equal(t, types.DefaultParams(), keeper.GetParams(ctx))
+}
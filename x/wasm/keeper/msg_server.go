@@ -83,6 +83,43 @@ func (m msgServer) InstantiateContract(goCtx context.Context, msg *types.MsgInst
 	}, nil
 }
 
+func (m msgServer) InstantiateContract2(goCtx context.Context, msg *types.MsgInstantiateContract2) (*types.MsgInstantiateContract2Response, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := sdk.ValidateAccAddress(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	var adminAddr sdk.AccAddress
+	if msg.Admin != "" {
+		if err = sdk.ValidateAccAddress(msg.Admin); err != nil {
+			return nil, sdkerrors.Wrap(err, "admin")
+		}
+		adminAddr = sdk.AccAddress(msg.Admin)
+	}
+
+	contractAddr, data, err := m.keeper.Instantiate2(ctx, msg.CodeID, sdk.AccAddress(msg.Sender), adminAddr, msg.InitMsg, msg.Label, msg.Funds, msg.Salt)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeInstantiateContract,
+		sdk.NewAttribute(types.AttributeKeyCodeID, fmt.Sprintf("%d", msg.CodeID)),
+		sdk.NewAttribute(types.AttributeKeyContract, contractAddr.String()),
+	))
+
+	return &types.MsgInstantiateContract2Response{
+		Address: contractAddr.String(),
+		Data:    data,
+	}, nil
+}
+
 func (m msgServer) StoreCodeAndInstantiateContract(goCtx context.Context,
 	msg *types.MsgStoreCodeAndInstantiateContract) (*types.MsgStoreCodeAndInstantiateContractResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -291,3 +328,97 @@ func (m msgServer) UpdateContractStatus(goCtx context.Context, msg *types.MsgUpd
 
 	return &types.MsgUpdateContractStatusResponse{}, nil
 }
+
+// UpdateInstantiateConfig handles MsgUpdateInstantiateConfig
+// CONTRACT: msg.validateBasic() must be called before calling this
+func (m msgServer) UpdateInstantiateConfig(goCtx context.Context, msg *types.MsgUpdateInstantiateConfig) (*types.MsgUpdateInstantiateConfigResponse, error) {
+	if msg.NewInstantiatePermission == nil {
+		return nil, sdkerrors.Wrap(types.ErrEmpty, "instantiate permission")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := sdk.ValidateAccAddress(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+
+	if err = m.keeper.SetAccessConfig(ctx, msg.CodeID, sdk.AccAddress(msg.Sender), *msg.NewInstantiatePermission); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+		sdk.NewEvent(
+			types.EventTypeUpdateCodeAccessConfig,
+			sdk.NewAttribute(types.AttributeKeyCodeID, fmt.Sprintf("%d", msg.CodeID)),
+			sdk.NewAttribute(types.AttributeKeyInstantiateConfig, msg.NewInstantiatePermission.String()),
+		),
+	})
+
+	return &types.MsgUpdateInstantiateConfigResponse{}, nil
+}
+
+// CreateSchedule handles MsgCreateSchedule
+func (m msgServer) CreateSchedule(goCtx context.Context, msg *types.MsgCreateSchedule) (*types.MsgCreateScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := sdk.ValidateAccAddress(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	err = sdk.ValidateAccAddress(msg.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+
+	scheduleID, err := m.keeper.CreateSchedule(ctx, sdk.AccAddress(msg.Contract), sdk.AccAddress(msg.Sender), msg.Msg, msg.Interval, msg.StartHeight, msg.GasLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+		sdk.NewEvent(
+			types.EventTypeCreateSchedule,
+			sdk.NewAttribute(types.AttributeKeyScheduleID, fmt.Sprintf("%d", scheduleID)),
+			sdk.NewAttribute(types.AttributeKeyContract, msg.Contract),
+		),
+	})
+
+	return &types.MsgCreateScheduleResponse{
+		ScheduleID: scheduleID,
+	}, nil
+}
+
+// RemoveSchedule handles MsgRemoveSchedule
+func (m msgServer) RemoveSchedule(goCtx context.Context, msg *types.MsgRemoveSchedule) (*types.MsgRemoveScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := sdk.ValidateAccAddress(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+
+	if err = m.keeper.RemoveSchedule(ctx, msg.ScheduleID, sdk.AccAddress(msg.Sender)); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+		sdk.NewEvent(
+			types.EventTypeRemoveSchedule,
+			sdk.NewAttribute(types.AttributeKeyScheduleID, fmt.Sprintf("%d", msg.ScheduleID)),
+		),
+	})
+
+	return &types.MsgRemoveScheduleResponse{}, nil
+}
@@ -46,6 +46,12 @@ func NewWasmProposalHandlerX(k types.ContractOpsKeeper, enabledProposalTypes []t
 			return handleUnpinCodesProposal(ctx, k, *c)
 		case *types.UpdateContractStatusProposal:
 			return handleUpdateContractStatusProposal(ctx, k, *c)
+		case *types.UpdateInstantiateConfigProposal:
+			return handleUpdateInstantiateConfigProposal(ctx, k, *c)
+		case *types.AddScheduleProposal:
+			return handleAddScheduleProposal(ctx, k, *c)
+		case *types.RemoveScheduleProposal:
+			return handleRemoveScheduleProposal(ctx, k, *c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized wasm proposal content type: %T", c)
 		}
@@ -256,3 +262,58 @@ func handleUpdateContractStatusProposal(ctx sdk.Context, k types.ContractOpsKeep
 	))
 	return nil
 }
+
+func handleUpdateInstantiateConfigProposal(ctx sdk.Context, k types.ContractOpsKeeper, p types.UpdateInstantiateConfigProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	for _, accessConfigUpdate := range p.AccessConfigUpdates {
+		if err := k.SetAccessConfig(ctx, accessConfigUpdate.CodeID, "", accessConfigUpdate.InstantiatePermission); err != nil {
+			return sdkerrors.Wrapf(err, "code id: %d", accessConfigUpdate.CodeID)
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeUpdateCodeAccessConfig,
+			sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(accessConfigUpdate.CodeID, 10)),
+			sdk.NewAttribute(types.AttributeKeyInstantiateConfig, accessConfigUpdate.InstantiatePermission.String()),
+		))
+	}
+	return nil
+}
+
+func handleAddScheduleProposal(ctx sdk.Context, k types.ContractOpsKeeper, p types.AddScheduleProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	err := sdk.ValidateAccAddress(p.Contract)
+	if err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	scheduleID, err := k.CreateSchedule(ctx, sdk.AccAddress(p.Contract), "", p.Msg, p.Interval, p.StartHeight, p.GasLimit)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCreateSchedule,
+		sdk.NewAttribute(types.AttributeKeyScheduleID, strconv.FormatUint(scheduleID, 10)),
+		sdk.NewAttribute(types.AttributeKeyContract, p.Contract),
+	))
+	return nil
+}
+
+func handleRemoveScheduleProposal(ctx sdk.Context, k types.ContractOpsKeeper, p types.RemoveScheduleProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	if err := k.RemoveSchedule(ctx, p.ScheduleID, ""); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRemoveSchedule,
+		sdk.NewAttribute(types.AttributeKeyScheduleID, strconv.FormatUint(p.ScheduleID, 10)),
+	))
+	return nil
+}
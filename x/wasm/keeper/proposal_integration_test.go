@@ -606,3 +606,58 @@ func TestUpdateContractStatusProposals(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateInstantiateConfigProposal(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, "staking", nil, nil)
+	govKeeper, wasmKeeper := keepers.GovKeeper, keepers.WasmKeeper
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	codeInfoFixture := types.CodeInfoFixture(types.WithSHA256CodeHash(wasmCode))
+	require.NoError(t, wasmKeeper.importCode(ctx, 1, codeInfoFixture, wasmCode))
+	require.NoError(t, wasmKeeper.importCode(ctx, 2, codeInfoFixture, wasmCode))
+
+	_, _, anyAddr := keyPubAddr()
+	_, _, otherAddr := keyPubAddr()
+	specs := map[string]struct {
+		accessConfigUpdates []types.AccessConfigUpdate
+	}{
+		"update one code": {
+			accessConfigUpdates: []types.AccessConfigUpdate{
+				{CodeID: 1, InstantiatePermission: types.AllowNobody},
+			},
+		},
+		"update multiple codes": {
+			accessConfigUpdates: []types.AccessConfigUpdate{
+				{CodeID: 1, InstantiatePermission: types.AllowAnyOfAddresses(anyAddr, otherAddr)},
+				{CodeID: 2, InstantiatePermission: types.AllowEverybody},
+			},
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			src := &types.UpdateInstantiateConfigProposal{
+				Title:               "Foo",
+				Description:         "Bar",
+				AccessConfigUpdates: spec.accessConfigUpdates,
+			}
+			// when stored
+			storedProposal, err := govKeeper.SubmitProposal(ctx, src)
+			require.NoError(t, err)
+
+			// and execute proposal
+			handler := govKeeper.Router().GetRoute(storedProposal.ProposalRoute())
+			err = handler(ctx, storedProposal.GetContent())
+			require.NoError(t, err)
+
+			// then
+			for _, update := range spec.accessConfigUpdates {
+				cInfo := wasmKeeper.GetCodeInfo(ctx, update.CodeID)
+				require.NotNil(t, cInfo)
+				assert.Equal(t, update.InstantiatePermission, cInfo.InstantiateConfig)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"encoding/binary"
+	"encoding/hex"
 	"runtime/debug"
 
 	"github.com/line/lbm-sdk/codec"
@@ -249,6 +250,193 @@ func (q GrpcQuerier) Codes(c context.Context, req *types.QueryCodesRequest) (*ty
 	return &types.QueryCodesResponse{CodeInfos: r, Pagination: pageRes}, nil
 }
 
+func (q GrpcQuerier) BuildAddress(c context.Context, req *types.QueryBuildAddressRequest) (*types.QueryBuildAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	codeHash, err := hex.DecodeString(req.CodeHash)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid code hash: %s", err)
+	}
+	creator, err := sdk.AccAddressToBytes(req.CreatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid creator address: %s", err)
+	}
+	salt, err := hex.DecodeString(req.Salt)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid salt: %s", err)
+	}
+	if err := types.ValidateSalt(salt); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid salt: %s", err)
+	}
+	return &types.QueryBuildAddressResponse{
+		Address: BuildContractAddressPredictable(codeHash, creator, salt).String(),
+	}, nil
+}
+
+// ContractsByCreator lists all smart contracts instantiated by a creator
+func (q GrpcQuerier) ContractsByCreator(c context.Context, req *types.QueryContractsByCreatorRequest) (*types.QueryContractsByCreatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := sdk.ValidateAccAddress(req.CreatorAddress); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	r := make([]string, 0)
+
+	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.GetContractsByCreatorSecondaryIndexPrefix(sdk.AccAddress(req.CreatorAddress)))
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			var contractAddr = sdk.AccAddress(string(key[types.AbsoluteTxPositionLen:]))
+			r = append(r, contractAddr.String())
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryContractsByCreatorResponse{
+		Contracts:  r,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ContractsByAdmin lists all smart contracts with the given admin
+func (q GrpcQuerier) ContractsByAdmin(c context.Context, req *types.QueryContractsByAdminRequest) (*types.QueryContractsByAdminResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := sdk.ValidateAccAddress(req.AdminAddress); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	r := make([]string, 0)
+
+	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.GetContractsByAdminSecondaryIndexPrefix(sdk.AccAddress(req.AdminAddress)))
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			r = append(r, sdk.AccAddress(string(key)).String())
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryContractsByAdminResponse{
+		Contracts:  r,
+		Pagination: pageRes,
+	}, nil
+}
+
+// CodesByChecksum gets the metadata for all wasm codes stored with a checksum
+func (q GrpcQuerier) CodesByChecksum(c context.Context, req *types.QueryCodesByChecksumRequest) (*types.QueryCodesByChecksumResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	checksum, err := hex.DecodeString(req.Checksum)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid checksum: %s", err)
+	}
+	if len(checksum) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty checksum")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	r := make([]types.CodeInfoResponse, 0)
+
+	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.GetCodeByChecksumSecondaryIndexPrefix(checksum))
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			codeID := binary.BigEndian.Uint64(key)
+			info := q.keeper.GetCodeInfo(ctx, codeID)
+			if info == nil {
+				return false, sdkerrors.Wrapf(types.ErrNotFound, "code info: %d", codeID)
+			}
+			r = append(r, types.CodeInfoResponse{
+				CodeID:   codeID,
+				Creator:  info.Creator,
+				DataHash: info.CodeHash,
+				Source:   info.Source,
+				Builder:  info.Builder,
+			})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryCodesByChecksumResponse{CodeInfos: r, Pagination: pageRes}, nil
+}
+
+// PinnedCodes gets the ids of the codes pinned in the wasmvm cache
+func (q GrpcQuerier) PinnedCodes(c context.Context, req *types.QueryPinnedCodesRequest) (*types.QueryPinnedCodesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	r := make([]uint64, 0)
+
+	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.PinnedCodeIndexPrefix)
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			r = append(r, types.ParsePinnedCodeIndex(key))
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryPinnedCodesResponse{
+		CodeIDs:    r,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Schedule gets a schedule of contract executions
+func (q GrpcQuerier) Schedule(c context.Context, req *types.QueryScheduleRequest) (*types.QueryScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.ScheduleId == 0 {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "schedule id")
+	}
+	schedule := q.keeper.GetSchedule(sdk.UnwrapSDKContext(c), req.ScheduleId)
+	if schedule == nil {
+		return nil, types.ErrNotFound
+	}
+	return &types.QueryScheduleResponse{
+		Schedule: *schedule,
+	}, nil
+}
+
+// Schedules lists all schedules of contract executions
+func (q GrpcQuerier) Schedules(c context.Context, req *types.QuerySchedulesRequest) (*types.QuerySchedulesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	r := make([]types.Schedule, 0)
+
+	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.ScheduleKeyPrefix)
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			var schedule types.Schedule
+			if err := q.cdc.UnmarshalBinaryBare(value, &schedule); err != nil {
+				return false, err
+			}
+			r = append(r, schedule)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QuerySchedulesResponse{
+		Schedules:  r,
+		Pagination: pageRes,
+	}, nil
+}
+
 func queryContractInfo(ctx sdk.Context, addr sdk.AccAddress, keeper types.ViewKeeper) (*types.QueryContractInfoResponse, error) {
 	info := keeper.GetContractInfo(ctx, addr)
 	if info == nil {
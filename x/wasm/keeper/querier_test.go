@@ -1,7 +1,9 @@
 package keeper
 
 import (
+	"bytes"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -594,6 +596,81 @@ func TestQueryContractInfo(t *testing.T) {
 
 }
 
+func TestQueryBuildAddress(t *testing.T) {
+	var (
+		codeHash = bytes.Repeat([]byte{1}, 32)
+		creator  = RandomAccountAddress(t)
+		salt     = []byte("salt")
+	)
+	creatorBz, err := sdk.AccAddressToBytes(creator.String())
+	require.NoError(t, err)
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k := keepers.WasmKeeper
+	querier := NewGrpcQuerier(k.cdc, k.storeKey, k, k.queryGasLimit)
+
+	specs := map[string]struct {
+		src    *types.QueryBuildAddressRequest
+		expRsp *types.QueryBuildAddressResponse
+		expErr bool
+	}{
+		"valid": {
+			src: &types.QueryBuildAddressRequest{
+				CodeHash:       hex.EncodeToString(codeHash),
+				CreatorAddress: creator.String(),
+				Salt:           hex.EncodeToString(salt),
+			},
+			expRsp: &types.QueryBuildAddressResponse{
+				Address: BuildContractAddressPredictable(codeHash, creatorBz, salt).String(),
+			},
+		},
+		"nil request": {
+			expErr: true,
+		},
+		"invalid code hash": {
+			src: &types.QueryBuildAddressRequest{
+				CodeHash:       "invalid",
+				CreatorAddress: creator.String(),
+				Salt:           hex.EncodeToString(salt),
+			},
+			expErr: true,
+		},
+		"invalid creator": {
+			src: &types.QueryBuildAddressRequest{
+				CodeHash:       hex.EncodeToString(codeHash),
+				CreatorAddress: "invalid",
+				Salt:           hex.EncodeToString(salt),
+			},
+			expErr: true,
+		},
+		"invalid salt": {
+			src: &types.QueryBuildAddressRequest{
+				CodeHash:       hex.EncodeToString(codeHash),
+				CreatorAddress: creator.String(),
+				Salt:           "invalid",
+			},
+			expErr: true,
+		},
+		"empty salt": {
+			src: &types.QueryBuildAddressRequest{
+				CodeHash:       hex.EncodeToString(codeHash),
+				CreatorAddress: creator.String(),
+			},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotRsp, gotErr := querier.BuildAddress(sdk.WrapSDKContext(ctx), spec.src)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expRsp, gotRsp)
+		})
+	}
+}
+
 func fromBase64(s string) []byte {
 	r, err := base64.StdEncoding.DecodeString(s)
 	if err != nil {
@@ -601,3 +678,211 @@ func fromBase64(s string) []byte {
 	}
 	return r
 }
+
+func TestQueryContractsByCreator(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	accKeeper, keeper, bankKeeper := keepers.AccountKeeper, keepers.WasmKeeper, keepers.BankKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 1000000))
+	creator := createFakeFundedAccount(t, ctx, accKeeper, bankKeeper, deposit)
+	otherCreator := createFakeFundedAccount(t, ctx, accKeeper, bankKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+	codeID, err := keepers.ContractKeeper.Create(ctx, creator, wasmCode, "", "", nil)
+	require.NoError(t, err)
+
+	_, _, bob := keyPubAddr()
+	initMsgBz, err := json.Marshal(HackatomExampleInitMsg{Verifier: creator, Beneficiary: bob})
+	require.NoError(t, err)
+
+	var expContracts []string
+	for i := 0; i < 3; i++ {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		addr, _, err := keepers.ContractKeeper.Instantiate(ctx, codeID, creator, "", initMsgBz, fmt.Sprintf("contract %d", i), nil)
+		require.NoError(t, err)
+		expContracts = append(expContracts, addr.String())
+	}
+	_, _, err = keepers.ContractKeeper.Instantiate(ctx, codeID, otherCreator, "", initMsgBz, "other contract", nil)
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		src    *types.QueryContractsByCreatorRequest
+		exp    []string
+		expErr bool
+	}{
+		"all contracts of creator in instantiation order": {
+			src: &types.QueryContractsByCreatorRequest{CreatorAddress: creator.String()},
+			exp: expContracts,
+		},
+		"with pagination": {
+			src: &types.QueryContractsByCreatorRequest{
+				CreatorAddress: creator.String(),
+				Pagination:     &query.PageRequest{Limit: 2},
+			},
+			exp: expContracts[:2],
+		},
+		"unknown creator": {
+			src: &types.QueryContractsByCreatorRequest{CreatorAddress: bob.String()},
+			exp: []string{},
+		},
+		"invalid creator": {
+			src:    &types.QueryContractsByCreatorRequest{CreatorAddress: "invalid"},
+			expErr: true,
+		},
+	}
+	q := Querier(keeper)
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, err := q.ContractsByCreator(sdk.WrapSDKContext(ctx), spec.src)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.exp, got.Contracts)
+		})
+	}
+}
+
+func TestQueryContractsByAdmin(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	accKeeper, keeper, bankKeeper := keepers.AccountKeeper, keepers.WasmKeeper, keepers.BankKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 1000000))
+	creator := createFakeFundedAccount(t, ctx, accKeeper, bankKeeper, deposit)
+	_, _, fred := keyPubAddr()
+	_, _, bob := keyPubAddr()
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+	codeID, err := keepers.ContractKeeper.Create(ctx, creator, wasmCode, "", "", nil)
+	require.NoError(t, err)
+
+	initMsgBz, err := json.Marshal(HackatomExampleInitMsg{Verifier: creator, Beneficiary: bob})
+	require.NoError(t, err)
+
+	contractA, _, err := keepers.ContractKeeper.Instantiate(ctx, codeID, creator, creator, initMsgBz, "contract a", nil)
+	require.NoError(t, err)
+	contractB, _, err := keepers.ContractKeeper.Instantiate(ctx, codeID, creator, creator, initMsgBz, "contract b", nil)
+	require.NoError(t, err)
+	_, _, err = keepers.ContractKeeper.Instantiate(ctx, codeID, creator, "", initMsgBz, "contract without admin", nil)
+	require.NoError(t, err)
+
+	q := Querier(keeper)
+	queryByAdmin := func(admin sdk.AccAddress) []string {
+		got, err := q.ContractsByAdmin(sdk.WrapSDKContext(ctx), &types.QueryContractsByAdminRequest{AdminAddress: admin.String()})
+		require.NoError(t, err)
+		return got.Contracts
+	}
+	assert.ElementsMatch(t, []string{contractA.String(), contractB.String()}, queryByAdmin(creator))
+
+	// when admin is updated
+	require.NoError(t, keepers.ContractKeeper.UpdateContractAdmin(ctx, contractA, creator, fred))
+	assert.Equal(t, []string{contractB.String()}, queryByAdmin(creator))
+	assert.Equal(t, []string{contractA.String()}, queryByAdmin(fred))
+
+	// when admin is cleared
+	require.NoError(t, keepers.ContractKeeper.ClearContractAdmin(ctx, contractA, fred))
+	assert.Empty(t, queryByAdmin(fred))
+
+	_, err = q.ContractsByAdmin(sdk.WrapSDKContext(ctx), &types.QueryContractsByAdminRequest{AdminAddress: "invalid"})
+	require.Error(t, err)
+}
+
+func TestQueryCodesByChecksum(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	accKeeper, keeper, bankKeeper := keepers.AccountKeeper, keepers.WasmKeeper, keepers.BankKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 1000000))
+	creator := createFakeFundedAccount(t, ctx, accKeeper, bankKeeper, deposit)
+
+	hackatomCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+	reflectCode, err := ioutil.ReadFile("./testdata/reflect.wasm")
+	require.NoError(t, err)
+
+	firstID, err := keepers.ContractKeeper.Create(ctx, creator, hackatomCode, "", "", nil)
+	require.NoError(t, err)
+	_, err = keepers.ContractKeeper.Create(ctx, creator, reflectCode, "", "", nil)
+	require.NoError(t, err)
+	secondID, err := keepers.ContractKeeper.Create(ctx, creator, hackatomCode, "", "", nil)
+	require.NoError(t, err)
+	checksum := keeper.GetCodeInfo(ctx, firstID).CodeHash
+
+	specs := map[string]struct {
+		src    *types.QueryCodesByChecksumRequest
+		exp    []uint64
+		expErr bool
+	}{
+		"all codes with checksum": {
+			src: &types.QueryCodesByChecksumRequest{Checksum: hex.EncodeToString(checksum)},
+			exp: []uint64{firstID, secondID},
+		},
+		"with pagination": {
+			src: &types.QueryCodesByChecksumRequest{
+				Checksum:   hex.EncodeToString(checksum),
+				Pagination: &query.PageRequest{Offset: 1},
+			},
+			exp: []uint64{secondID},
+		},
+		"unknown checksum": {
+			src: &types.QueryCodesByChecksumRequest{Checksum: hex.EncodeToString(bytes.Repeat([]byte{1}, 32))},
+		},
+		"invalid checksum": {
+			src:    &types.QueryCodesByChecksumRequest{Checksum: "invalid"},
+			expErr: true,
+		},
+		"empty checksum": {
+			src:    &types.QueryCodesByChecksumRequest{},
+			expErr: true,
+		},
+	}
+	q := Querier(keeper)
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, err := q.CodesByChecksum(sdk.WrapSDKContext(ctx), spec.src)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			var gotIDs []uint64
+			for _, info := range got.CodeInfos {
+				assert.Equal(t, checksum, []byte(info.DataHash))
+				gotIDs = append(gotIDs, info.CodeID)
+			}
+			assert.Equal(t, spec.exp, gotIDs)
+		})
+	}
+}
+
+func TestQueryPinnedCodes(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	accKeeper, keeper, bankKeeper := keepers.AccountKeeper, keepers.WasmKeeper, keepers.BankKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 1000000))
+	creator := createFakeFundedAccount(t, ctx, accKeeper, bankKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+
+	var codeIDs []uint64
+	for i := 0; i < 3; i++ {
+		codeID, err := keepers.ContractKeeper.Create(ctx, creator, wasmCode, "", "", nil)
+		require.NoError(t, err)
+		codeIDs = append(codeIDs, codeID)
+	}
+	require.NoError(t, keepers.ContractKeeper.PinCode(ctx, codeIDs[0]))
+	require.NoError(t, keepers.ContractKeeper.PinCode(ctx, codeIDs[2]))
+
+	q := Querier(keeper)
+	got, err := q.PinnedCodes(sdk.WrapSDKContext(ctx), &types.QueryPinnedCodesRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{codeIDs[0], codeIDs[2]}, got.CodeIDs)
+
+	got, err = q.PinnedCodes(sdk.WrapSDKContext(ctx), &types.QueryPinnedCodesRequest{Pagination: &query.PageRequest{Limit: 1}})
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{codeIDs[0]}, got.CodeIDs)
+	assert.NotEmpty(t, got.Pagination.NextKey)
+}
@@ -0,0 +1,301 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/line/lbm-sdk/store/prefix"
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	authtypes "github.com/line/lbm-sdk/x/auth/types"
+	"github.com/line/lbm-sdk/x/wasm/types"
+)
+
+func (k Keeper) getMaxScheduleGasPerBlock(ctx sdk.Context) uint64 {
+	var a uint64
+	k.paramSpace.Get(ctx, types.ParamStoreKeyMaxScheduleGasPerBlock, &a)
+	return a
+}
+
+func (k Keeper) getScheduleCreationFee(ctx sdk.Context) sdk.Coins {
+	var a sdk.Coins
+	k.paramSpace.Get(ctx, types.ParamStoreKeyScheduleCreationFee, &a)
+	return a
+}
+
+func (k Keeper) getScheduleExecutionFee(ctx sdk.Context) sdk.Coins {
+	var a sdk.Coins
+	k.paramSpace.Get(ctx, types.ParamStoreKeyScheduleExecutionFee, &a)
+	return a
+}
+
+func (k Keeper) getMaxSchedulesPerContract(ctx sdk.Context) uint32 {
+	var a uint32
+	k.paramSpace.Get(ctx, types.ParamStoreKeyMaxSchedulesPerContract, &a)
+	return a
+}
+
+// createSchedule schedules executions of the sudo entry point of the contract. The first execution happens at the
+// start height or, when not set, one interval after the current height. A schedule not created by governance counts
+// against the max schedules per contract and pays the creation fee to the fee collector.
+func (k Keeper) createSchedule(ctx sdk.Context, contractAddress, caller sdk.AccAddress, msg []byte, interval uint64, startHeight int64, gasLimit uint64, authZ AuthorizationPolicy) (uint64, error) {
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	if contractInfo.Status != types.ContractStatusActive {
+		return 0, sdkerrors.Wrap(types.ErrInvalid, "inactive contract")
+	}
+	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not schedule contract")
+	}
+	if gasLimit < types.MinScheduleGasLimit {
+		return 0, sdkerrors.Wrapf(types.ErrLimit, "gas limit must be at least %d", types.MinScheduleGasLimit)
+	}
+	if maxGas := k.getMaxScheduleGasPerBlock(ctx); gasLimit > maxGas {
+		return 0, sdkerrors.Wrapf(types.ErrLimit, "gas limit exceeds max schedule gas per block %d", maxGas)
+	}
+
+	nextHeight := startHeight
+	if nextHeight == 0 {
+		nextHeight = ctx.BlockHeight() + int64(interval)
+	} else if nextHeight <= ctx.BlockHeight() {
+		return 0, sdkerrors.Wrap(types.ErrInvalid, "start height must be in the future")
+	}
+
+	if caller != "" {
+		if maxSchedules := k.getMaxSchedulesPerContract(ctx); k.countSchedules(ctx, contractAddress, maxSchedules) >= maxSchedules {
+			return 0, sdkerrors.Wrapf(types.ErrLimit, "contract has max schedules per contract %d", maxSchedules)
+		}
+		if fee := k.getScheduleCreationFee(ctx); !fee.IsZero() {
+			feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+			if err := k.bank.TransferCoins(ctx, caller, feeCollector, fee); err != nil {
+				return 0, sdkerrors.Wrap(err, "schedule creation fee")
+			}
+		}
+	}
+
+	schedule := types.Schedule{
+		ID:         k.autoIncrementID(ctx, types.KeyLastScheduleID),
+		Contract:   contractAddress.String(),
+		Msg:        msg,
+		Interval:   interval,
+		NextHeight: nextHeight,
+		GasLimit:   gasLimit,
+		Creator:    caller.String(),
+	}
+	k.storeSchedule(ctx, schedule)
+	return schedule.ID, nil
+}
+
+// removeSchedule deletes the schedule. It can be removed by its creator or by whoever can modify the contract.
+func (k Keeper) removeSchedule(ctx sdk.Context, scheduleID uint64, caller sdk.AccAddress, authZ AuthorizationPolicy) error {
+	schedule := k.GetSchedule(ctx, scheduleID)
+	if schedule == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "schedule")
+	}
+	if caller == "" || schedule.Creator != caller.String() {
+		var admin sdk.AccAddress
+		if contractInfo := k.GetContractInfo(ctx, sdk.AccAddress(schedule.Contract)); contractInfo != nil {
+			admin = contractInfo.AdminAddr()
+		}
+		if !authZ.CanModifyContract(admin, caller) {
+			return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not remove schedule")
+		}
+	}
+	k.deleteSchedule(ctx, *schedule)
+	return nil
+}
+
+// GetSchedule returns the schedule with the given id or nil when not found
+func (k Keeper) GetSchedule(ctx sdk.Context, scheduleID uint64) *types.Schedule {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetScheduleKey(scheduleID))
+	if bz == nil {
+		return nil
+	}
+	var schedule types.Schedule
+	k.cdc.MustUnmarshalBinaryBare(bz, &schedule)
+	return &schedule
+}
+
+// IterateSchedules iterates over all schedules ordered by id. Iteration stops when the callback returns true.
+func (k Keeper) IterateSchedules(ctx sdk.Context, cb func(types.Schedule) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduleKeyPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var schedule types.Schedule
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &schedule)
+		if cb(schedule) {
+			return
+		}
+	}
+}
+
+// rescheduleSchedule moves the schedule to the queue of the given height
+func (k Keeper) rescheduleSchedule(ctx sdk.Context, schedule types.Schedule, height int64) {
+	ctx.KVStore(k.storeKey).Delete(types.GetScheduleQueueKey(schedule.NextHeight, schedule.ID))
+	schedule.NextHeight = height
+	k.storeSchedule(ctx, schedule)
+}
+
+// countSchedules returns the number of schedules not created by governance of the contract, counting up to limit
+func (k Keeper) countSchedules(ctx sdk.Context, contractAddress sdk.AccAddress, limit uint32) uint32 {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetSchedulesByContractSecondaryIndexPrefix(contractAddress))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	var n uint32
+	for ; iter.Valid() && n < limit; iter.Next() {
+		n++
+	}
+	return n
+}
+
+// storeSchedule persists the schedule and queues it for its next height. A schedule not created by governance is
+// indexed by its contract.
+func (k Keeper) storeSchedule(ctx sdk.Context, schedule types.Schedule) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetScheduleKey(schedule.ID), k.cdc.MustMarshalBinaryBare(&schedule))
+	store.Set(types.GetScheduleQueueKey(schedule.NextHeight, schedule.ID), []byte{})
+	if schedule.Creator != "" {
+		store.Set(types.GetSchedulesByContractSecondaryIndexKey(sdk.AccAddress(schedule.Contract), schedule.ID), []byte{})
+	}
+}
+
+// deleteSchedule removes the schedule, its queue entry and its index entry
+func (k Keeper) deleteSchedule(ctx sdk.Context, schedule types.Schedule) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetScheduleKey(schedule.ID))
+	store.Delete(types.GetScheduleQueueKey(schedule.NextHeight, schedule.ID))
+	store.Delete(types.GetSchedulesByContractSecondaryIndexKey(sdk.AccAddress(schedule.Contract), schedule.ID))
+}
+
+func (k Keeper) importSchedule(ctx sdk.Context, schedule types.Schedule) error {
+	if k.GetSchedule(ctx, schedule.ID) != nil {
+		return sdkerrors.Wrapf(types.ErrDuplicate, "schedule: %d", schedule.ID)
+	}
+	if !k.HasContractInfo(ctx, sdk.AccAddress(schedule.Contract)) {
+		return sdkerrors.Wrapf(types.ErrNotFound, "contract: %s", schedule.Contract)
+	}
+	k.storeSchedule(ctx, schedule)
+	return nil
+}
+
+// maxDueSchedulesPerBlock bounds the queue entries visited by ExecuteDueSchedules in a block.
+const maxDueSchedulesPerBlock = 100
+
+// ExecuteDueSchedules calls the sudo entry point of the contracts scheduled up to the current height, in order of
+// height and id. The creator of a schedule not created by governance pays the execution fee to the fee collector
+// before each execution, and the schedule is removed once the creator can not pay it anymore. Each execution is
+// limited by the gas limit of its schedule and the executions of a block are limited by the max schedule gas per
+// block param. At most maxDueSchedulesPerBlock due schedules are visited in a block, and the visits stop once the
+// remaining block gas is below the min schedule gas limit; the schedules not visited stay due for the next block.
+// A visited schedule that does not fit into the remaining block gas is moved to the next block, behind the
+// schedules already due, without holding back the due schedules after it. A schedule whose gas limit exceeds the
+// max schedule gas per block, as lowered by governance after its creation, is thus postponed until the param is
+// raised again or the schedule is removed.
+func (k Keeper) ExecuteDueSchedules(ctx sdk.Context) {
+	remaining := k.getMaxScheduleGasPerBlock(ctx)
+	if remaining < types.MinScheduleGasLimit {
+		return
+	}
+
+	var due []uint64
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduleQueuePrefix)
+	iter := prefixStore.Iterator(nil, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight()+1)))
+	for ; iter.Valid() && len(due) < maxDueSchedulesPerBlock; iter.Next() {
+		due = append(due, sdk.BigEndianToUint64(iter.Key()[8:]))
+	}
+	iter.Close()
+
+	for _, scheduleID := range due {
+		if remaining < types.MinScheduleGasLimit {
+			return
+		}
+		schedule := k.GetSchedule(ctx, scheduleID)
+		if schedule == nil {
+			continue
+		}
+		if schedule.GasLimit > remaining {
+			k.rescheduleSchedule(ctx, *schedule, ctx.BlockHeight()+1)
+			continue
+		}
+
+		var gasUsed uint64
+		err := k.payScheduleExecutionFee(ctx, *schedule)
+		unpaid := err != nil
+		if !unpaid {
+			gasUsed, err = k.executeSchedule(ctx, *schedule)
+			remaining -= gasUsed
+		}
+
+		attrs := []sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyScheduleID, strconv.FormatUint(schedule.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyContract, schedule.Contract),
+			sdk.NewAttribute(types.AttributeKeyGasUsed, strconv.FormatUint(gasUsed, 10)),
+		}
+		if err != nil {
+			k.Logger(ctx).Info("scheduled contract execution failed", "schedule", schedule.ID, "error", err.Error())
+			attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyError, err.Error()))
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeExecuteSchedule, attrs...))
+
+		if schedule.Interval != 0 && !unpaid {
+			k.rescheduleSchedule(ctx, *schedule, ctx.BlockHeight()+int64(schedule.Interval))
+		} else {
+			k.deleteSchedule(ctx, *schedule)
+		}
+	}
+}
+
+// payScheduleExecutionFee transfers the execution fee from the creator of the schedule to the fee collector. Schedules
+// created by governance do not pay it.
+func (k Keeper) payScheduleExecutionFee(ctx sdk.Context, schedule types.Schedule) error {
+	if schedule.Creator == "" {
+		return nil
+	}
+	fee := k.getScheduleExecutionFee(ctx)
+	if fee.IsZero() {
+		return nil
+	}
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	if err := k.bank.TransferCoins(ctx, sdk.AccAddress(schedule.Creator), feeCollector, fee); err != nil {
+		return sdkerrors.Wrap(err, "schedule execution fee")
+	}
+	return nil
+}
+
+// executeSchedule calls the sudo entry point of the scheduled contract in a sandbox limited by the gas limit of the
+// schedule. State changes and events are only kept on success.
+func (k Keeper) executeSchedule(ctx sdk.Context, schedule types.Schedule) (gasUsed uint64, err error) {
+	contractInfo := k.GetContractInfo(ctx, sdk.AccAddress(schedule.Contract))
+	if contractInfo == nil {
+		return 0, sdkerrors.Wrap(types.ErrNotFound, "contract")
+	}
+	if contractInfo.Status != types.ContractStatusActive {
+		return 0, sdkerrors.Wrap(types.ErrInvalid, "inactive contract")
+	}
+
+	gasMeter := sdk.NewGasMeter(schedule.GasLimit)
+	cacheCtx, commit := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(gasMeter).WithEventManager(sdk.NewEventManager())
+
+	// catch out of gas panic and just charge the entire gas limit
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			err = sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "schedule hit gas limit")
+		}
+		gasUsed = gasMeter.GasConsumedToLimit()
+	}()
+
+	if _, err = k.Sudo(cacheCtx, sdk.AccAddress(schedule.Contract), schedule.Msg); err != nil {
+		return 0, err
+	}
+	commit()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return 0, nil
+}
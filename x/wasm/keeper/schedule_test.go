@@ -0,0 +1,488 @@
+package keeper
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	authtypes "github.com/line/lbm-sdk/x/auth/types"
+	"github.com/line/lbm-sdk/x/wasm/types"
+	wasmvmtypes "github.com/line/wasmvm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// instantiateHackatomWithAdmin instantiates the hackatom example with the given admin and a deposit to steal
+func instantiateHackatomWithAdmin(t *testing.T, ctx sdk.Context, keepers TestKeepers, admin sdk.AccAddress) sdk.AccAddress {
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := createFakeFundedAccount(t, ctx, keepers.AccountKeeper, keepers.BankKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+	codeID, err := keepers.ContractKeeper.Create(ctx, creator, wasmCode, "", "", nil)
+	require.NoError(t, err)
+
+	_, _, verifier := keyPubAddr()
+	_, _, beneficiary := keyPubAddr()
+	initMsgBz, err := json.Marshal(HackatomExampleInitMsg{Verifier: verifier, Beneficiary: beneficiary})
+	require.NoError(t, err)
+	contractAddr, _, err := keepers.ContractKeeper.Instantiate(ctx, codeID, creator, admin, initMsgBz, "demo contract", deposit)
+	require.NoError(t, err)
+	return contractAddr
+}
+
+func stealFundsSudoMsg(t *testing.T, recipient sdk.AccAddress, amount int64) []byte {
+	msg, err := json.Marshal(sudoMsg{
+		StealFunds: stealFundsMsg{
+			Recipient: recipient.String(),
+			Amount:    wasmvmtypes.Coins{wasmvmtypes.NewCoin(uint64(amount), "denom")},
+		},
+	})
+	require.NoError(t, err)
+	return msg
+}
+
+func TestCreateSchedule(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	ctx = ctx.WithBlockHeight(10)
+	fee := sdk.NewCoins(sdk.NewInt64Coin("denom", 100))
+	params := types.DefaultParams()
+	params.ScheduleCreationFee = fee
+	keepers.WasmKeeper.setParams(ctx, params)
+
+	admin := createFakeFundedAccount(t, ctx, keepers.AccountKeeper, keepers.BankKeeper, fee.Add(fee...))
+	poorAdmin := createFakeFundedAccount(t, ctx, keepers.AccountKeeper, keepers.BankKeeper, sdk.NewCoins(sdk.NewInt64Coin("denom", 1)))
+	_, _, anyAddr := keyPubAddr()
+	contractAddr := instantiateHackatomWithAdmin(t, ctx, keepers, admin)
+	poorContractAddr := instantiateHackatomWithAdmin(t, ctx, keepers, poorAdmin)
+	govKeeper := NewGovPermissionKeeper(keepers.WasmKeeper)
+
+	specs := map[string]struct {
+		keeper        types.ContractOpsKeeper
+		contract      sdk.AccAddress
+		caller        sdk.AccAddress
+		interval      uint64
+		startHeight   int64
+		gasLimit      uint64
+		expNextHeight int64
+		expFee        bool
+		expErr        *sdkerrors.Error
+	}{
+		"admin with interval": {
+			keeper:        keepers.ContractKeeper,
+			contract:      contractAddr,
+			caller:        admin,
+			interval:      5,
+			gasLimit:      100_000,
+			expNextHeight: 15,
+			expFee:        true,
+		},
+		"admin with start height": {
+			keeper:        keepers.ContractKeeper,
+			contract:      contractAddr,
+			caller:        admin,
+			startHeight:   20,
+			gasLimit:      100_000,
+			expNextHeight: 20,
+			expFee:        true,
+		},
+		"governance does not pay a fee": {
+			keeper:        govKeeper,
+			contract:      contractAddr,
+			interval:      1,
+			gasLimit:      100_000,
+			expNextHeight: 11,
+		},
+		"not the admin": {
+			keeper:   keepers.ContractKeeper,
+			contract: contractAddr,
+			caller:   anyAddr,
+			interval: 1,
+			gasLimit: 100_000,
+			expErr:   sdkerrors.ErrUnauthorized,
+		},
+		"unknown contract": {
+			keeper:   govKeeper,
+			contract: anyAddr,
+			interval: 1,
+			gasLimit: 100_000,
+			expErr:   sdkerrors.ErrInvalidRequest,
+		},
+		"gas limit exceeds max per block": {
+			keeper:   keepers.ContractKeeper,
+			contract: contractAddr,
+			caller:   admin,
+			interval: 1,
+			gasLimit: types.DefaultMaxScheduleGasPerBlock + 1,
+			expErr:   types.ErrLimit,
+		},
+		"start height not in the future": {
+			keeper:      keepers.ContractKeeper,
+			contract:    contractAddr,
+			caller:      admin,
+			startHeight: 10,
+			gasLimit:    100_000,
+			expErr:      types.ErrInvalid,
+		},
+		"insufficient funds for the fee": {
+			keeper:   keepers.ContractKeeper,
+			contract: poorContractAddr,
+			caller:   poorAdmin,
+			interval: 1,
+			gasLimit: 100_000,
+			expErr:   sdkerrors.ErrInsufficientFunds,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+			feeCollectorBalance := keepers.BankKeeper.GetAllBalances(ctx, feeCollector)
+
+			scheduleID, gotErr := spec.keeper.CreateSchedule(ctx, spec.contract, spec.caller, []byte(`{}`), spec.interval, spec.startHeight, spec.gasLimit)
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), "got %+v", gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+
+			schedule := keepers.WasmKeeper.GetSchedule(ctx, scheduleID)
+			require.NotNil(t, schedule)
+			assert.Equal(t, types.Schedule{
+				ID:         scheduleID,
+				Contract:   spec.contract.String(),
+				Msg:        []byte(`{}`),
+				Interval:   spec.interval,
+				NextHeight: spec.expNextHeight,
+				GasLimit:   spec.gasLimit,
+				Creator:    spec.caller.String(),
+			}, *schedule)
+
+			expBalance := feeCollectorBalance
+			if spec.expFee {
+				expBalance = expBalance.Add(fee...)
+			}
+			assert.Equal(t, expBalance, keepers.BankKeeper.GetAllBalances(ctx, feeCollector))
+		})
+	}
+}
+
+func TestCreateScheduleMaxPerContract(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	ctx = ctx.WithBlockHeight(10)
+	k := keepers.WasmKeeper
+	params := types.DefaultParams()
+	params.ScheduleCreationFee = sdk.NewCoins()
+	params.MaxSchedulesPerContract = 1
+	k.setParams(ctx, params)
+
+	_, _, admin := keyPubAddr()
+	contractAddr := instantiateHackatomWithAdmin(t, ctx, keepers, admin)
+	govKeeper := NewGovPermissionKeeper(k)
+
+	scheduleID, err := keepers.ContractKeeper.CreateSchedule(ctx, contractAddr, admin, []byte(`{}`), 1, 0, 100_000)
+	require.NoError(t, err)
+
+	_, err = keepers.ContractKeeper.CreateSchedule(ctx, contractAddr, admin, []byte(`{}`), 1, 0, 100_000)
+	assert.True(t, types.ErrLimit.Is(err), "got %+v", err)
+
+	// governance is not limited
+	_, err = govKeeper.CreateSchedule(ctx, contractAddr, "", []byte(`{}`), 1, 0, 100_000)
+	require.NoError(t, err)
+
+	// removing a schedule frees its place
+	require.NoError(t, keepers.ContractKeeper.RemoveSchedule(ctx, scheduleID, admin))
+	_, err = keepers.ContractKeeper.CreateSchedule(ctx, contractAddr, admin, []byte(`{}`), 1, 0, 100_000)
+	require.NoError(t, err)
+}
+
+func TestRemoveSchedule(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	_, _, admin := keyPubAddr()
+	_, _, anyAddr := keyPubAddr()
+	contractAddr := instantiateHackatomWithAdmin(t, ctx, keepers, admin)
+	govKeeper := NewGovPermissionKeeper(keepers.WasmKeeper)
+
+	specs := map[string]struct {
+		keeper  types.ContractOpsKeeper
+		creator sdk.AccAddress
+		caller  sdk.AccAddress
+		expErr  *sdkerrors.Error
+	}{
+		"creator": {
+			keeper:  keepers.ContractKeeper,
+			creator: anyAddr,
+			caller:  anyAddr,
+		},
+		"contract admin": {
+			keeper: keepers.ContractKeeper,
+			caller: admin,
+		},
+		"governance": {
+			keeper:  govKeeper,
+			creator: anyAddr,
+		},
+		"neither creator nor admin": {
+			keeper:  keepers.ContractKeeper,
+			creator: admin,
+			caller:  anyAddr,
+			expErr:  sdkerrors.ErrUnauthorized,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			schedule := types.Schedule{
+				ID:         keepers.WasmKeeper.autoIncrementID(ctx, types.KeyLastScheduleID),
+				Contract:   contractAddr.String(),
+				Msg:        []byte(`{}`),
+				NextHeight: 100,
+				GasLimit:   100_000,
+				Creator:    spec.creator.String(),
+			}
+			keepers.WasmKeeper.storeSchedule(ctx, schedule)
+
+			gotErr := spec.keeper.RemoveSchedule(ctx, schedule.ID, spec.caller)
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), "got %+v", gotErr)
+				assert.NotNil(t, keepers.WasmKeeper.GetSchedule(ctx, schedule.ID))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Nil(t, keepers.WasmKeeper.GetSchedule(ctx, schedule.ID))
+			assert.False(t, ctx.KVStore(keepers.WasmKeeper.storeKey).Has(types.GetScheduleQueueKey(schedule.NextHeight, schedule.ID)))
+		})
+	}
+
+	// unknown schedule
+	gotErr := govKeeper.RemoveSchedule(ctx, 9999, "")
+	assert.True(t, types.ErrNotFound.Is(gotErr), "got %+v", gotErr)
+}
+
+func TestExecuteDueSchedules(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	ctx = ctx.WithBlockHeight(1)
+	k := keepers.WasmKeeper
+	govKeeper := NewGovPermissionKeeper(k)
+	_, _, admin := keyPubAddr()
+	contractAddr := instantiateHackatomWithAdmin(t, ctx, keepers, admin)
+	_, _, community := keyPubAddr()
+
+	// executed every 2 blocks, first at height 2
+	recurringID, err := govKeeper.CreateSchedule(ctx, contractAddr, "", stealFundsSudoMsg(t, community, 1), 2, 2, 1_000_000)
+	require.NoError(t, err)
+	// executed once at height 2
+	oneShotID, err := govKeeper.CreateSchedule(ctx, contractAddr, "", stealFundsSudoMsg(t, community, 10), 0, 2, 1_000_000)
+	require.NoError(t, err)
+	// fails at height 3 as the contract does not have the funds
+	failingID, err := govKeeper.CreateSchedule(ctx, contractAddr, "", stealFundsSudoMsg(t, community, 1_000_000), 0, 3, 1_000_000)
+	require.NoError(t, err)
+
+	balance := func(ctx sdk.Context) int64 {
+		return keepers.BankKeeper.GetBalance(ctx, community, "denom").Amount.Int64()
+	}
+
+	// nothing due at height 1
+	k.ExecuteDueSchedules(ctx)
+	assert.Equal(t, int64(0), balance(ctx))
+
+	// height 2: recurring and one shot executed
+	ctx = ctx.WithBlockHeight(2).WithEventManager(sdk.NewEventManager())
+	k.ExecuteDueSchedules(ctx)
+	assert.Equal(t, int64(11), balance(ctx))
+	assert.Equal(t, int64(4), k.GetSchedule(ctx, recurringID).NextHeight)
+	assert.Nil(t, k.GetSchedule(ctx, oneShotID))
+	assert.Len(t, eventsOfType(ctx.EventManager().Events(), types.EventTypeExecuteSchedule), 2)
+
+	// height 3: failing execution is reverted and removed
+	ctx = ctx.WithBlockHeight(3).WithEventManager(sdk.NewEventManager())
+	k.ExecuteDueSchedules(ctx)
+	assert.Equal(t, int64(11), balance(ctx))
+	assert.Nil(t, k.GetSchedule(ctx, failingID))
+	events := eventsOfType(ctx.EventManager().Events(), types.EventTypeExecuteSchedule)
+	require.Len(t, events, 1)
+	assert.True(t, hasAttribute(events[0], types.AttributeKeyError))
+
+	// height 4: the block gas is not sufficient, the execution is postponed to the next block
+	params := types.DefaultParams()
+	params.MaxScheduleGasPerBlock = 999_999
+	k.setParams(ctx, params)
+	ctx = ctx.WithBlockHeight(4).WithEventManager(sdk.NewEventManager())
+	k.ExecuteDueSchedules(ctx)
+	assert.Equal(t, int64(11), balance(ctx))
+	assert.Equal(t, int64(5), k.GetSchedule(ctx, recurringID).NextHeight)
+
+	// height 5: executed with the block gas restored
+	params.MaxScheduleGasPerBlock = types.DefaultMaxScheduleGasPerBlock
+	k.setParams(ctx, params)
+	ctx = ctx.WithBlockHeight(5).WithEventManager(sdk.NewEventManager())
+	k.ExecuteDueSchedules(ctx)
+	assert.Equal(t, int64(12), balance(ctx))
+	assert.Equal(t, int64(7), k.GetSchedule(ctx, recurringID).NextHeight)
+}
+
+func TestExecuteDueSchedulesExecutionFee(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	ctx = ctx.WithBlockHeight(1)
+	k := keepers.WasmKeeper
+	fee := sdk.NewCoins(sdk.NewInt64Coin("denom", 100))
+	params := types.DefaultParams()
+	params.ScheduleCreationFee = sdk.NewCoins()
+	params.ScheduleExecutionFee = fee
+	k.setParams(ctx, params)
+
+	admin := createFakeFundedAccount(t, ctx, keepers.AccountKeeper, keepers.BankKeeper, sdk.NewCoins(sdk.NewInt64Coin("denom", 150)))
+	contractAddr := instantiateHackatomWithAdmin(t, ctx, keepers, admin)
+	_, _, community := keyPubAddr()
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	feeCollectorBalance := keepers.BankKeeper.GetAllBalances(ctx, feeCollector)
+
+	scheduleID, err := keepers.ContractKeeper.CreateSchedule(ctx, contractAddr, admin, stealFundsSudoMsg(t, community, 1), 1, 2, 1_000_000)
+	require.NoError(t, err)
+
+	// height 2: the creator pays the fee of the execution
+	ctx = ctx.WithBlockHeight(2).WithEventManager(sdk.NewEventManager())
+	k.ExecuteDueSchedules(ctx)
+	assert.Equal(t, int64(1), keepers.BankKeeper.GetBalance(ctx, community, "denom").Amount.Int64())
+	assert.Equal(t, feeCollectorBalance.Add(fee...), keepers.BankKeeper.GetAllBalances(ctx, feeCollector))
+	assert.Equal(t, int64(3), k.GetSchedule(ctx, scheduleID).NextHeight)
+
+	// height 3: the creator can not pay the fee anymore, the schedule is removed without being executed
+	ctx = ctx.WithBlockHeight(3).WithEventManager(sdk.NewEventManager())
+	k.ExecuteDueSchedules(ctx)
+	assert.Equal(t, int64(1), keepers.BankKeeper.GetBalance(ctx, community, "denom").Amount.Int64())
+	assert.Equal(t, feeCollectorBalance.Add(fee...), keepers.BankKeeper.GetAllBalances(ctx, feeCollector))
+	assert.Nil(t, k.GetSchedule(ctx, scheduleID))
+	assert.Equal(t, uint32(0), k.countSchedules(ctx, contractAddr, 1))
+	events := eventsOfType(ctx.EventManager().Events(), types.EventTypeExecuteSchedule)
+	require.Len(t, events, 1)
+	assert.True(t, hasAttribute(events[0], types.AttributeKeyError))
+}
+
+func TestExecuteDueSchedulesLoweredMaxGas(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	ctx = ctx.WithBlockHeight(1)
+	k := keepers.WasmKeeper
+	govKeeper := NewGovPermissionKeeper(k)
+	_, _, admin := keyPubAddr()
+	contractAddr := instantiateHackatomWithAdmin(t, ctx, keepers, admin)
+	_, _, community := keyPubAddr()
+
+	// both due at height 2, the oversize one first
+	oversizeID, err := govKeeper.CreateSchedule(ctx, contractAddr, "", stealFundsSudoMsg(t, community, 1), 0, 2, 1_000_000)
+	require.NoError(t, err)
+	smallID, err := govKeeper.CreateSchedule(ctx, contractAddr, "", stealFundsSudoMsg(t, community, 10), 1, 2, 200_000)
+	require.NoError(t, err)
+
+	// governance lowers the max schedule gas per block below the gas limit of the queued schedule
+	params := types.DefaultParams()
+	params.MaxScheduleGasPerBlock = 500_000
+	k.setParams(ctx, params)
+
+	balance := func(ctx sdk.Context) int64 {
+		return keepers.BankKeeper.GetBalance(ctx, community, "denom").Amount.Int64()
+	}
+
+	// the oversize schedule is postponed without holding back the next one
+	for height := int64(2); height <= 3; height++ {
+		ctx = ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		k.ExecuteDueSchedules(ctx)
+		assert.Equal(t, 10*(height-1), balance(ctx))
+		assert.Equal(t, height+1, k.GetSchedule(ctx, oversizeID).NextHeight)
+		assert.Equal(t, height+1, k.GetSchedule(ctx, smallID).NextHeight)
+		assert.Len(t, eventsOfType(ctx.EventManager().Events(), types.EventTypeExecuteSchedule), 1)
+	}
+
+	// the oversize schedule is executed once the param is raised again
+	params.MaxScheduleGasPerBlock = types.DefaultMaxScheduleGasPerBlock
+	k.setParams(ctx, params)
+	ctx = ctx.WithBlockHeight(4).WithEventManager(sdk.NewEventManager())
+	k.ExecuteDueSchedules(ctx)
+	assert.Equal(t, int64(31), balance(ctx))
+	assert.Nil(t, k.GetSchedule(ctx, oversizeID))
+	assert.Len(t, eventsOfType(ctx.EventManager().Events(), types.EventTypeExecuteSchedule), 2)
+}
+
+func TestExecuteDueSchedulesBounded(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	ctx = ctx.WithBlockHeight(1)
+	k := keepers.WasmKeeper
+	govKeeper := NewGovPermissionKeeper(k)
+	_, _, admin := keyPubAddr()
+	contractAddr := instantiateHackatomWithAdmin(t, ctx, keepers, admin)
+	_, _, community := keyPubAddr()
+
+	ids := make([]uint64, maxDueSchedulesPerBlock+1)
+	for i := range ids {
+		var err error
+		ids[i], err = govKeeper.CreateSchedule(ctx, contractAddr, "", stealFundsSudoMsg(t, community, 1), 0, 2, 1_000_000)
+		require.NoError(t, err)
+	}
+
+	// at most maxDueSchedulesPerBlock schedules are visited, the one left stays due
+	params := types.DefaultParams()
+	params.MaxScheduleGasPerBlock = 1_000_000 * (maxDueSchedulesPerBlock + 1)
+	k.setParams(ctx, params)
+	ctx = ctx.WithBlockHeight(2).WithEventManager(sdk.NewEventManager())
+	k.ExecuteDueSchedules(ctx)
+	assert.Len(t, eventsOfType(ctx.EventManager().Events(), types.EventTypeExecuteSchedule), maxDueSchedulesPerBlock)
+	assert.Nil(t, k.GetSchedule(ctx, ids[0]))
+	assert.Equal(t, int64(2), k.GetSchedule(ctx, ids[maxDueSchedulesPerBlock]).NextHeight)
+
+	// the visits stop once the remaining block gas is below the min schedule gas limit
+	require.NoError(t, govKeeper.RemoveSchedule(ctx, ids[maxDueSchedulesPerBlock], ""))
+	// runs out of gas, so that it consumes its whole gas limit
+	outOfGasID, err := govKeeper.CreateSchedule(ctx, contractAddr, "", stealFundsSudoMsg(t, community, 1), 0, 3, types.MinScheduleGasLimit)
+	require.NoError(t, err)
+	nextID, err := govKeeper.CreateSchedule(ctx, contractAddr, "", stealFundsSudoMsg(t, community, 1), 0, 3, types.MinScheduleGasLimit)
+	require.NoError(t, err)
+	params.MaxScheduleGasPerBlock = 2*types.MinScheduleGasLimit - 1
+	k.setParams(ctx, params)
+	ctx = ctx.WithBlockHeight(3).WithEventManager(sdk.NewEventManager())
+	k.ExecuteDueSchedules(ctx)
+	assert.Nil(t, k.GetSchedule(ctx, outOfGasID))
+	// not visited, so not moved to the next block
+	assert.Equal(t, int64(3), k.GetSchedule(ctx, nextID).NextHeight)
+}
+
+func TestExecuteDueSchedulesOutOfGas(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	ctx = ctx.WithBlockHeight(1)
+	k := keepers.WasmKeeper
+	_, _, admin := keyPubAddr()
+	contractAddr := instantiateHackatomWithAdmin(t, ctx, keepers, admin)
+	_, _, community := keyPubAddr()
+
+	scheduleID, err := NewGovPermissionKeeper(k).CreateSchedule(ctx, contractAddr, "", stealFundsSudoMsg(t, community, 1), 1, 0, types.MinScheduleGasLimit)
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(2)
+	k.ExecuteDueSchedules(ctx)
+	assert.True(t, keepers.BankKeeper.GetBalance(ctx, community, "denom").IsZero())
+	events := eventsOfType(ctx.EventManager().Events(), types.EventTypeExecuteSchedule)
+	require.Len(t, events, 1)
+	assert.True(t, hasAttribute(events[0], types.AttributeKeyError))
+	// still scheduled for the next interval
+	assert.Equal(t, int64(3), k.GetSchedule(ctx, scheduleID).NextHeight)
+}
+
+func eventsOfType(events sdk.Events, eventType string) sdk.Events {
+	var r sdk.Events
+	for _, e := range events {
+		if e.Type == eventType {
+			r = append(r, e)
+		}
+	}
+	return r
+}
+
+func hasAttribute(event sdk.Event, key string) bool {
+	for _, a := range event.Attributes {
+		if string(a.Key) == key {
+			return true
+		}
+	}
+	return false
+}
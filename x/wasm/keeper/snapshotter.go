@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"io"
+
+	"github.com/line/ostracon/libs/log"
+	ocproto "github.com/line/ostracon/proto/ostracon/types"
+
+	snapshottypes "github.com/line/lbm-sdk/snapshots/types"
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/x/wasm/types"
+)
+
+var _ snapshottypes.ExtensionSnapshotter = &WasmSnapshotter{}
+
+// SnapshotFormat defines the snapshot format of exported wasm codes.
+// No protobuf envelope, no metadata, each payload is the raw wasm byte code.
+const SnapshotFormat = 1
+
+// WasmSnapshotter is a snapshot extension exporting the wasm byte codes, which are
+// kept by the wasm engine outside of the multistore.
+type WasmSnapshotter struct {
+	wasm *Keeper
+	cms  sdk.MultiStore
+}
+
+// NewWasmSnapshotter returns a snapshot extension for the wasm codes of the keeper.
+func NewWasmSnapshotter(cms sdk.MultiStore, wasm *Keeper) *WasmSnapshotter {
+	return &WasmSnapshotter{
+		wasm: wasm,
+		cms:  cms,
+	}
+}
+
+// SnapshotName implements ExtensionSnapshotter.
+func (ws *WasmSnapshotter) SnapshotName() string {
+	return types.ModuleName
+}
+
+// SnapshotFormat implements ExtensionSnapshotter.
+func (ws *WasmSnapshotter) SnapshotFormat() uint32 {
+	return SnapshotFormat
+}
+
+// SupportedFormats implements ExtensionSnapshotter.
+func (ws *WasmSnapshotter) SupportedFormats() []uint32 {
+	// If we support older formats, add them here and handle them in RestoreExtension
+	return []uint32{SnapshotFormat}
+}
+
+// SnapshotExtension implements ExtensionSnapshotter.
+func (ws *WasmSnapshotter) SnapshotExtension(height uint64, payloadWriter snapshottypes.ExtensionPayloadWriter) error {
+	ctx, err := ws.newContext(height)
+	if err != nil {
+		return err
+	}
+
+	seenBefore := make(map[string]bool)
+	ws.wasm.IterateCodeInfos(ctx, func(id uint64, info types.CodeInfo) bool {
+		hash := hex.EncodeToString(info.CodeHash)
+		// if many code ids share the same code, only export it once
+		if seenBefore[hash] {
+			return false
+		}
+		seenBefore[hash] = true
+
+		var code []byte
+		code, err = ws.wasm.GetByteCode(ctx, id)
+		if err != nil {
+			return true
+		}
+		err = payloadWriter(code)
+		return err != nil
+	})
+	return err
+}
+
+// RestoreExtension implements ExtensionSnapshotter.
+func (ws *WasmSnapshotter) RestoreExtension(height uint64, format uint32, payloadReader snapshottypes.ExtensionPayloadReader) error {
+	if format != SnapshotFormat {
+		return sdkerrors.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
+	}
+
+	restored := make(map[string]bool)
+	for {
+		payload, err := payloadReader()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return sdkerrors.Wrap(err, "read wasm code")
+		}
+
+		code, err := uncompress(payload, uint64(types.MaxWasmSize))
+		if err != nil {
+			return sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
+		}
+		checksum, err := ws.wasm.wasmVM.Create(code)
+		if err != nil {
+			return sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
+		}
+		restored[hex.EncodeToString(checksum)] = true
+	}
+
+	ctx, err := ws.newContext(height)
+	if err != nil {
+		return err
+	}
+
+	// every code referenced by the restored state must have been part of the snapshot
+	ws.wasm.IterateCodeInfos(ctx, func(id uint64, info types.CodeInfo) bool {
+		if !restored[hex.EncodeToString(info.CodeHash)] {
+			err = sdkerrors.Wrapf(types.ErrNotFound, "wasm code of code id %d", id)
+			return true
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	// the pinned codes are not persisted by the wasm engine
+	return ws.wasm.InitializePinnedCodes(ctx)
+}
+
+// newContext returns a read-only context on the state at the height.
+func (ws *WasmSnapshotter) newContext(height uint64) (sdk.Context, error) {
+	cacheMS, err := ws.cms.CacheMultiStoreWithVersion(int64(height))
+	if err != nil {
+		return sdk.Context{}, err
+	}
+	return sdk.NewContext(cacheMS, ocproto.Header{Height: int64(height)}, false, log.NewNopLogger()), nil
+}
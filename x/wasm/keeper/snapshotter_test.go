@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/line/ostracon/libs/log"
+	ocproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/line/tm-db/v2/memdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/snapshots"
+	snapshottypes "github.com/line/lbm-sdk/snapshots/types"
+	"github.com/line/lbm-sdk/store"
+	sdk "github.com/line/lbm-sdk/types"
+	authkeeper "github.com/line/lbm-sdk/x/auth/keeper"
+	distributionkeeper "github.com/line/lbm-sdk/x/distribution/keeper"
+	paramskeeper "github.com/line/lbm-sdk/x/params/keeper"
+	paramtypes "github.com/line/lbm-sdk/x/params/types"
+	stakingkeeper "github.com/line/lbm-sdk/x/staking/keeper"
+	"github.com/line/lbm-sdk/x/wasm/types"
+)
+
+func TestSnapshotter(t *testing.T) {
+	specs := map[string]struct {
+		wasmFiles []string
+	}{
+		"single contract": {
+			wasmFiles: []string{"./testdata/hackatom.wasm"},
+		},
+		"many contracts": {
+			wasmFiles: []string{"./testdata/hackatom.wasm", "./testdata/burner.wasm", "./testdata/reflect.wasm"},
+		},
+		"duplicate contracts": {
+			wasmFiles: []string{"./testdata/hackatom.wasm", "./testdata/hackatom.wasm"},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// setup source with contracts
+			srcKeeper, srcCtx, _ := setupSnapshotKeeper(t)
+			srcKeeper.setParams(srcCtx, types.DefaultParams())
+			srcCMS := srcCtx.MultiStore().(sdk.CommitMultiStore)
+			creator := RandomAccountAddress(t)
+			codeIDs := make([]uint64, len(spec.wasmFiles))
+			for i, wasmFile := range spec.wasmFiles {
+				wasmCode, err := ioutil.ReadFile(wasmFile)
+				require.NoError(t, err)
+				codeIDs[i], err = srcKeeper.create(srcCtx, creator, wasmCode, "", "", nil, DefaultAuthorizationPolicy{})
+				require.NoError(t, err)
+			}
+			require.NoError(t, srcKeeper.pinCode(srcCtx, codeIDs[0]))
+			commitID := srcCMS.Commit()
+
+			srcManager := newSnapshotManager(t, srcCMS, srcKeeper)
+			snapshot, err := srcManager.Create(uint64(commitID.Version))
+			require.NoError(t, err)
+
+			// restore into an empty destination
+			destKeeper, destCtx, _ := setupSnapshotKeeper(t)
+			destCMS := destCtx.MultiStore().(sdk.CommitMultiStore)
+			destManager := newSnapshotManager(t, destCMS, destKeeper)
+			require.NoError(t, destManager.Restore(*snapshot))
+			for i := uint32(0); i < snapshot.Chunks; i++ {
+				chunk, err := srcManager.LoadChunk(snapshot.Height, snapshot.Format, i)
+				require.NoError(t, err)
+				done, err := destManager.RestoreChunk(chunk)
+				require.NoError(t, err)
+				assert.Equal(t, i == snapshot.Chunks-1, done)
+			}
+
+			// all the codes are available again in the destination wasm engine
+			destCtx = sdk.NewContext(destCMS, ocproto.Header{}, false, log.NewNopLogger())
+			for i, wasmFile := range spec.wasmFiles {
+				wasmCode, err := ioutil.ReadFile(wasmFile)
+				require.NoError(t, err)
+				code, err := destKeeper.GetByteCode(destCtx, codeIDs[i])
+				require.NoError(t, err)
+				assert.Equal(t, wasmCode, code)
+			}
+			assert.True(t, destKeeper.IsPinnedCode(destCtx, codeIDs[0]))
+		})
+	}
+}
+
+func TestSnapshotter_RestoreUnknownFormat(t *testing.T) {
+	keeper, ctx, _ := setupSnapshotKeeper(t)
+	snapshotter := NewWasmSnapshotter(ctx.MultiStore(), keeper)
+	err := snapshotter.RestoreExtension(1, SnapshotFormat+1, func() ([]byte, error) {
+		return nil, nil
+	})
+	require.ErrorIs(t, err, snapshottypes.ErrUnknownFormat)
+}
+
+func newSnapshotManager(t *testing.T, cms sdk.CommitMultiStore, keeper *Keeper) *snapshots.Manager {
+	store, err := snapshots.NewStore(memdb.NewDB(), t.TempDir())
+	require.NoError(t, err)
+	manager := snapshots.NewManager(store, cms)
+	require.NoError(t, manager.RegisterExtensions(NewWasmSnapshotter(cms, keeper)))
+	return manager
+}
+
+// setupSnapshotKeeper returns a keeper on a multistore which can be committed and restored.
+func setupSnapshotKeeper(t *testing.T) (*Keeper, sdk.Context, []sdk.StoreKey) {
+	t.Helper()
+	var (
+		keyParams = sdk.NewKVStoreKey(paramtypes.StoreKey)
+		keyWasm   = sdk.NewKVStoreKey(types.StoreKey)
+	)
+
+	ms := store.NewCommitMultiStore(memdb.NewDB())
+	ms.MountStoreWithDB(keyWasm, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(ms, ocproto.Header{}, false, log.NewNopLogger())
+	encodingConfig := MakeEncodingConfig(t)
+	pk := paramskeeper.NewKeeper(encodingConfig.Marshaler, encodingConfig.Amino, keyParams)
+
+	keeper := NewKeeper(encodingConfig.Marshaler, keyWasm, pk.Subspace(types.DefaultParamspace), authkeeper.AccountKeeper{}, nil, stakingkeeper.Keeper{}, distributionkeeper.Keeper{}, nil, nil, nil, nil, nil, nil, nil, t.TempDir(), types.DefaultWasmConfig(), SupportedFeatures, nil, nil)
+	return &keeper, ctx, []sdk.StoreKey{keyWasm, keyParams}
+}
@@ -121,11 +121,13 @@ func MakeEncodingConfig(_ TestingT) params2.EncodingConfig {
 }
 
 var TestingStakeParams = stakingtypes.Params{
-	UnbondingTime:     100,
-	MaxValidators:     10,
-	MaxEntries:        10,
-	HistoricalEntries: 10,
-	BondDenom:         "stake",
+	UnbondingTime:        100,
+	MaxValidators:        10,
+	MaxEntries:           10,
+	HistoricalEntries:    10,
+	BondDenom:            "stake",
+	GlobalTransferCap:    stakingtypes.DefaultGlobalTransferCap,
+	ValidatorTransferCap: stakingtypes.DefaultValidatorTransferCap,
 }
 
 type TestKeepers struct {
@@ -9,7 +9,7 @@ import (
 	tmBytes "github.com/line/ostracon/libs/bytes"
 )
 
-var ModelFuzzers = []interface{}{FuzzAddr, FuzzAddrString, FuzzAbsoluteTxPosition, FuzzContractInfo, FuzzStateModel, FuzzAccessType, FuzzAccessConfig, FuzzContractCodeHistory}
+var ModelFuzzers = []interface{}{FuzzAddr, FuzzAddrString, FuzzAbsoluteTxPosition, FuzzContractInfo, FuzzStateModel, FuzzAccessType, FuzzAccessConfig, FuzzContractCodeHistory, FuzzCoins}
 
 func FuzzAddr(m *sdk.AccAddress, c fuzz.Continue) {
 	addrBytes := make([]byte, 20)
@@ -73,3 +73,7 @@ func FuzzAccessConfig(m *types.AccessConfig, c fuzz.Continue) {
 	FuzzAddr(&add, c)
 	*m = m.Permission.With(add)
 }
+
+func FuzzCoins(m *sdk.Coins, c fuzz.Continue) {
+	*m = sdk.NewCoins(sdk.NewCoin("denom", sdk.NewIntFromUint64(c.RandUint64())))
+}
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"fmt"
 	"io"
 	stdlog "log"
 	"net/http"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/rakyll/statik/fs"
+	"github.com/spf13/cast"
 
 	abci "github.com/line/ostracon/abci/types"
 	ostjson "github.com/line/ostracon/libs/json"
@@ -24,10 +26,12 @@ import (
 	"github.com/line/lbm-sdk/client/rpc"
 	"github.com/line/lbm-sdk/codec"
 	"github.com/line/lbm-sdk/codec/types"
+	"github.com/line/lbm-sdk/server"
 	"github.com/line/lbm-sdk/server/api"
 	"github.com/line/lbm-sdk/server/config"
 	servertypes "github.com/line/lbm-sdk/server/types"
 	"github.com/line/lbm-sdk/simapp"
+	"github.com/line/lbm-sdk/store/streaming"
 	sdk "github.com/line/lbm-sdk/types"
 	"github.com/line/lbm-sdk/types/module"
 	"github.com/line/lbm-sdk/version"
@@ -88,6 +92,7 @@ import (
 	upgradetypes "github.com/line/lbm-sdk/x/upgrade/types"
 	"github.com/line/lbm-sdk/x/wasm"
 	wasmclient "github.com/line/lbm-sdk/x/wasm/client"
+	wasmkeeper "github.com/line/lbm-sdk/x/wasm/keeper"
 	wasmtypes "github.com/line/lbm-sdk/x/wasm/types"
 
 	appparams "github.com/line/lbm-sdk/x/wasm/linkwasmd/app/params"
@@ -219,6 +224,9 @@ type LinkApp struct { // nolint: golint
 	// the module manager
 	mm *module.Manager
 
+	// the configurator
+	configurator module.Configurator
+
 	// simulation manager
 	sm *module.SimulationManager
 }
@@ -256,6 +264,11 @@ func NewLinkApp(
 	)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
+	// configure state streaming from the app options
+	if _, _, err := streaming.LoadStreamingServices(bApp, appOpts, keys); err != nil {
+		ostos.Exit(err.Error())
+	}
+
 	app := &LinkApp{
 		BaseApp:           bApp,
 		legacyAmino:       legacyAmino,
@@ -447,7 +460,8 @@ func NewLinkApp(
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
-	app.mm.RegisterServices(module.NewConfigurator(app.MsgServiceRouter(), app.GRPCQueryRouter()))
+	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
+	app.mm.RegisterServices(app.configurator)
 
 	// create the simulation manager and define the order of the modules for deterministic simulations
 	//
@@ -478,14 +492,41 @@ func NewLinkApp(
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
-	app.SetAnteHandler(
-		ante.NewAnteHandler(
-			app.AccountKeeper, app.BankKeeper, ante.DefaultSigVerificationGasConsumer,
-			encodingConfig.TxConfig.SignModeHandler(),
-		),
+
+	var txReplacements *ante.TxReplacements
+	if bumpPercent := cast.ToUint64(appOpts.Get(server.FlagTxReplacementBumpPercent)); bumpPercent > 0 {
+		txReplacements = ante.NewTxReplacements(bumpPercent)
+		app.SetBeginRecheckTxHandler(txReplacements.BeginRecheckTx)
+	}
+	sigCache := ante.NewSigVerificationCache(ante.DefaultSigVerificationCacheSize)
+	app.SetCommitHandler(sigCache.Commit)
+	anteHandler, err := ante.NewAnteHandler(
+		ante.HandlerOptions{
+			AccountKeeper:        app.AccountKeeper,
+			BankKeeper:           app.BankKeeper,
+			TxReplacements:       txReplacements,
+			SigVerificationCache: sigCache,
+			SignModeHandler:      encodingConfig.TxConfig.SignModeHandler(),
+			SigGasConsumer:       ante.DefaultSigVerificationGasConsumer,
+		},
 	)
+	if err != nil {
+		panic(err)
+	}
+	app.SetAnteHandler(anteHandler)
 	app.SetEndBlocker(app.EndBlocker)
 
+	// must be before Loading version
+	// requires the snapshot store to be created and registered as a BaseAppOption
+	if manager := app.SnapshotManager(); manager != nil {
+		err := manager.RegisterExtensions(
+			wasmkeeper.NewWasmSnapshotter(app.CommitMultiStore(), &app.wasmKeeper),
+		)
+		if err != nil {
+			panic(fmt.Errorf("failed to register snapshot extension: %s", err))
+		}
+	}
+
 	if loadLatest {
 		if err := app.LoadLatestVersion(); err != nil {
 			ostos.Exit(err.Error())
@@ -540,6 +581,7 @@ func (app *LinkApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci
 	if err := ostjson.Unmarshal(req.AppStateBytes, &genesisState); err != nil {
 		panic(err)
 	}
+	app.UpgradeKeeper.SetModuleVersionMap(ctx, app.mm.GetVersionMap())
 	return app.mm.InitGenesis(ctx, app.appCodec, genesisState)
 }
 
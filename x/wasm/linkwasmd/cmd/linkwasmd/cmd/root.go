@@ -13,6 +13,7 @@ import (
 	"github.com/line/lbm-sdk/client/flags"
 	"github.com/line/lbm-sdk/client/keys"
 	"github.com/line/lbm-sdk/client/rpc"
+	"github.com/line/lbm-sdk/client/snapshot"
 	"github.com/line/lbm-sdk/server"
 	servertypes "github.com/line/lbm-sdk/server/types"
 	"github.com/line/lbm-sdk/snapshots"
@@ -89,6 +90,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 	)
 
 	server.AddCommands(rootCmd, app.DefaultNodeHome, newApp, createLinkAppAndExport, addModuleInitFlags)
+	rootCmd.AddCommand(snapshot.Cmd(newApp))
 
 	// add keybase, auxiliary RPC, query, and tx child commands
 	rootCmd.AddCommand(
@@ -161,8 +163,12 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts serverty
 	ibCacheMetricsProvider, iavlCacheMetricsProvider :=
 		baseapp.MetricsProvider(cast.ToBool(viper.GetBool(server.FlagPrometheus)))
 	if cast.ToBool(appOpts.Get(server.FlagInterBlockCache)) {
+		cacheOpts, err := server.GetInterBlockCacheOptionsFromFlags(appOpts)
+		if err != nil {
+			panic(err)
+		}
 		cache = store.NewCommitKVStoreCacheManager(
-			cast.ToInt(appOpts.Get(server.FlagInterBlockCacheSize)), ibCacheMetricsProvider)
+			cast.ToInt(appOpts.Get(server.FlagInterBlockCacheSize)), ibCacheMetricsProvider, cacheOpts...)
 	}
 
 	skipUpgradeHeights := make(map[int64]bool)
@@ -187,6 +193,15 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts serverty
 	if err != nil {
 		panic(err)
 	}
+
+	var stateArchiveDB dbm.DB
+	if cast.ToBool(appOpts.Get(server.FlagStateArchive)) {
+		stateArchiveDB, err = sdk.NewLevelDB("archive", filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data"))
+		if err != nil {
+			panic(err)
+		}
+	}
+
 	var wasmOpts []wasm.Option
 	if cast.ToBool(appOpts.Get("telemetry.enabled")) {
 		wasmOpts = append(wasmOpts, wasmkeeper.WithVMCacheMetrics(prometheus.DefaultRegisterer))
@@ -204,8 +219,14 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts serverty
 		baseapp.SetHaltTime(cast.ToUint64(appOpts.Get(server.FlagHaltTime))),
 		baseapp.SetMinRetainBlocks(cast.ToUint64(appOpts.Get(server.FlagMinRetainBlocks))),
 		baseapp.SetInterBlockCache(cache),
+		baseapp.SetInterBlockCacheWarmUp(cast.ToBool(appOpts.Get(server.FlagInterBlockCacheWarmUp))),
 		baseapp.SetIAVLCacheManager(cast.ToInt(appOpts.Get(server.FlagIAVLCacheSize)), iavlCacheMetricsProvider),
+		baseapp.SetStateArchive(stateArchiveDB),
 		baseapp.SetTrace(cast.ToBool(appOpts.Get(server.FlagTrace))),
+		baseapp.SetCheckTxWorkers(cast.ToInt(appOpts.Get(server.FlagCheckTxWorkers))),
+		baseapp.SetCheckTxMaxPendingPerSigner(cast.ToInt(appOpts.Get(server.FlagCheckTxMaxPendingPerSigner))),
+		baseapp.SetCheckTxPriorityDenom(cast.ToString(appOpts.Get(server.FlagCheckTxPriorityDenom))),
+		baseapp.SetDeliverTxWorkers(cast.ToInt(appOpts.Get(server.FlagDeliverTxWorkers))),
 		baseapp.SetIndexEvents(cast.ToStringSlice(appOpts.Get(server.FlagIndexEvents))),
 		baseapp.SetSnapshotStore(snapshotStore),
 		baseapp.SetSnapshotInterval(cast.ToUint64(appOpts.Get(server.FlagStateSyncSnapshotInterval))),
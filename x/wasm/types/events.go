@@ -1,20 +1,29 @@
 package types
 
 const (
-	EventTypeStoreCode            = "store_code"
-	EventTypeInstantiateContract  = "instantiate_contract"
-	EventTypeExecuteContract      = "execute_contract"
-	EventTypeMigrateContract      = "migrate_contract"
-	EventTypeUpdateAdmin          = "update_admin"
-	EventTypeClearAdmin           = "clear_admin"
-	EventTypePinCode              = "pin_code"
-	EventTypeUnpinCode            = "unpin_code"
-	EventTypeUpdateContractStatus = "update_contract_status"
+	EventTypeStoreCode              = "store_code"
+	EventTypeInstantiateContract    = "instantiate_contract"
+	EventTypeExecuteContract        = "execute_contract"
+	EventTypeMigrateContract        = "migrate_contract"
+	EventTypeUpdateAdmin            = "update_admin"
+	EventTypeClearAdmin             = "clear_admin"
+	EventTypePinCode                = "pin_code"
+	EventTypeUnpinCode              = "unpin_code"
+	EventTypeUpdateContractStatus   = "update_contract_status"
+	EventTypeUpdateCodeAccessConfig = "update_code_access_config"
+	EventTypeCreateSchedule         = "create_schedule"
+	EventTypeRemoveSchedule         = "remove_schedule"
+	EventTypeExecuteSchedule        = "execute_schedule"
 )
 const ( // event attributes
-	AttributeKeyContract       = "contract_address"
-	AttributeKeyCodeID         = "code_id"
-	AttributeKeySigner         = "signer"
-	AttributeKeyCodeIDs        = "code_ids"
-	AttributeKeyContractStatus = "contract_status"
+	AttributeKeyContract          = "contract_address"
+	AttributeKeyCodeID            = "code_id"
+	AttributeKeySigner            = "signer"
+	AttributeKeyCodeIDs           = "code_ids"
+	AttributeKeyContractStatus    = "contract_status"
+	AttributeKeyInstantiateConfig = "instantiate_config"
+	AttributeKeyScheduleID        = "schedule_id"
+	AttributeKeyNextHeight        = "next_height"
+	AttributeKeyGasUsed           = "gas_used"
+	AttributeKeyError             = "error"
 )
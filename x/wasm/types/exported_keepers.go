@@ -20,6 +20,7 @@ type ViewKeeper interface {
 	IterateCodeInfos(ctx sdk.Context, cb func(uint64, CodeInfo) bool)
 	GetByteCode(ctx sdk.Context, codeID uint64) ([]byte, error)
 	IsPinnedCode(ctx sdk.Context, codeID uint64) bool
+	GetSchedule(ctx sdk.Context, scheduleID uint64) *Schedule
 }
 
 // ContractOpsKeeper contains mutable operations on a contract.
@@ -30,6 +31,9 @@ type ContractOpsKeeper interface {
 	// Instantiate creates an instance of a WASM contract
 	Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins) (sdk.AccAddress, []byte, error)
 
+	// Instantiate2 creates an instance of a WASM contract at a predictable address built from the code checksum, the creator and the salt
+	Instantiate2(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins, salt []byte) (sdk.AccAddress, []byte, error)
+
 	// Execute executes the contract instance
 	Execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) (*sdk.Result, error)
 
@@ -53,6 +57,15 @@ type ContractOpsKeeper interface {
 
 	// UpdateContractStatus sets a new status of the contract on the ContractInfo.
 	UpdateContractStatus(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, status ContractStatus) error
+
+	// SetAccessConfig updates the access config of a code id.
+	SetAccessConfig(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, newConfig AccessConfig) error
+
+	// CreateSchedule schedules executions of the sudo entry point of the contract, returning the schedule id
+	CreateSchedule(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, interval uint64, startHeight int64, gasLimit uint64) (uint64, error)
+
+	// RemoveSchedule deletes a schedule so that it is not executed anymore
+	RemoveSchedule(ctx sdk.Context, scheduleID uint64, caller sdk.AccAddress) error
 }
 
 // IBCContractKeeper IBC lifecycle event handler
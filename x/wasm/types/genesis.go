@@ -32,6 +32,11 @@ func (s GenesisState) ValidateBasic() error {
 			return sdkerrors.Wrapf(err, "sequence: %d", i)
 		}
 	}
+	for i := range s.Schedules {
+		if err := s.Schedules[i].ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "schedule: %d", i)
+		}
+	}
 	for i := range s.GenMsgs {
 		if err := s.GenMsgs[i].ValidateBasic(); err != nil {
 			return sdkerrors.Wrapf(err, "gen message: %d", i)
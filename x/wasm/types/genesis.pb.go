@@ -30,6 +30,7 @@ type GenesisState struct {
 	Contracts []Contract             `protobuf:"bytes,3,rep,name=contracts,proto3" json:"contracts,omitempty"`
 	Sequences []Sequence             `protobuf:"bytes,4,rep,name=sequences,proto3" json:"sequences,omitempty"`
 	GenMsgs   []GenesisState_GenMsgs `protobuf:"bytes,5,rep,name=gen_msgs,json=genMsgs,proto3" json:"gen_msgs,omitempty"`
+	Schedules []Schedule             `protobuf:"bytes,6,rep,name=schedules,proto3" json:"schedules,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -100,6 +101,13 @@ func (m *GenesisState) GetGenMsgs() []GenesisState_GenMsgs {
 	return nil
 }
 
+func (m *GenesisState) GetSchedules() []Schedule {
+	if m != nil {
+		return m.Schedules
+	}
+	return nil
+}
+
 // GenMsgs define the messages that can be executed during genesis phase in order.
 // The intention is to have more human readable data that is auditable.
 type GenesisState_GenMsgs struct {
@@ -399,48 +407,49 @@ func init() {
 }
 
 var fileDescriptor_931ba204ce53afe0 = []byte{
-	// 652 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0x4d, 0x6f, 0xd3, 0x4c,
-	0x10, 0xc7, 0xe3, 0x26, 0x4e, 0x93, 0x69, 0x9e, 0xa7, 0xd5, 0xb6, 0xcf, 0x83, 0x95, 0x52, 0x27,
-	0x24, 0x1c, 0x5a, 0x41, 0x13, 0xb5, 0x1c, 0x91, 0x90, 0x30, 0x45, 0x34, 0x54, 0x45, 0xc8, 0x95,
-	0x38, 0xf4, 0x12, 0xf9, 0x65, 0x6a, 0xac, 0xc6, 0xde, 0x90, 0xdd, 0x94, 0xe6, 0x5b, 0x20, 0x3e,
-	0x04, 0x9f, 0xa5, 0xc7, 0x1e, 0x39, 0x45, 0x28, 0xbd, 0xf1, 0x29, 0xd0, 0xae, 0xd7, 0xae, 0x11,
-	0x75, 0xb9, 0x58, 0x9e, 0xd9, 0xff, 0xfc, 0x76, 0x66, 0x76, 0x76, 0xa1, 0xeb, 0x51, 0x16, 0x7d,
-	0x76, 0x58, 0xd4, 0x97, 0x9f, 0x8b, 0x3d, 0x17, 0xb9, 0xb3, 0xd7, 0x0f, 0x30, 0x46, 0x16, 0xb2,
-	0xde, 0x78, 0x42, 0x39, 0x25, 0xff, 0xa5, 0xa2, 0x9e, 0xfc, 0x28, 0x51, 0x73, 0x23, 0xa0, 0x01,
-	0x95, 0x8a, 0xbe, 0xf8, 0x4b, 0xc4, 0xcd, 0x47, 0x77, 0x13, 0xf9, 0x6c, 0x8c, 0x8a, 0xd7, 0x34,
-	0x0b, 0x24, 0x97, 0xc9, 0x7a, 0xe7, 0x4a, 0x87, 0xc6, 0x9b, 0x24, 0x83, 0x13, 0xee, 0x70, 0x24,
-	0xcf, 0xa1, 0x3a, 0x76, 0x26, 0x4e, 0xc4, 0x0c, 0xad, 0xad, 0x6d, 0xaf, 0xec, 0x6f, 0xf5, 0xee,
-	0xcc, 0xa8, 0xf7, 0x5e, 0x8a, 0xac, 0xca, 0xd5, 0xbc, 0x55, 0xb2, 0x55, 0x08, 0x79, 0x0b, 0xba,
-	0x47, 0x7d, 0x64, 0xc6, 0x52, 0xbb, 0xbc, 0xbd, 0xb2, 0xbf, 0x59, 0x10, 0xfb, 0x8a, 0xfa, 0x68,
-	0x3d, 0x10, 0x91, 0x3f, 0xe7, 0xad, 0x55, 0x19, 0xf1, 0x94, 0x46, 0x21, 0xc7, 0x68, 0xcc, 0x67,
-	0x76, 0x82, 0x20, 0xa7, 0x50, 0xf7, 0x68, 0xcc, 0x27, 0x8e, 0xc7, 0x99, 0x51, 0x96, 0xbc, 0x56,
-	0x21, 0x2f, 0xd1, 0x59, 0x9b, 0x8a, 0xb9, 0x9e, 0x45, 0xe6, 0xb8, 0xb7, 0x38, 0xc1, 0x66, 0xf8,
-	0x69, 0x8a, 0xb1, 0x87, 0xcc, 0xa8, 0xdc, 0xcb, 0x3e, 0x51, 0xba, 0x5b, 0x76, 0x16, 0x99, 0x67,
-	0x67, 0x4e, 0xe2, 0x42, 0x2d, 0xc0, 0x78, 0x18, 0xb1, 0x80, 0x19, 0xba, 0x44, 0x3f, 0x29, 0x40,
-	0xe7, 0xfb, 0x2e, 0x8c, 0x63, 0x16, 0x30, 0xab, 0xa9, 0xb6, 0x21, 0x29, 0x24, 0xb7, 0xcb, 0x72,
-	0x90, 0x88, 0x9a, 0x5f, 0x97, 0x60, 0x59, 0x05, 0x90, 0x03, 0x00, 0xc6, 0xe9, 0x04, 0x87, 0xa2,
-	0x6d, 0xea, 0xd0, 0xba, 0x05, 0x3b, 0x1e, 0xb3, 0xe0, 0x44, 0x68, 0xc5, 0x01, 0x1c, 0x96, 0xec,
-	0x3a, 0x4b, 0x0d, 0xe2, 0xc2, 0x46, 0x18, 0x33, 0xee, 0xc4, 0x3c, 0x74, 0xb8, 0x60, 0x25, 0xad,
-	0x32, 0x96, 0x24, 0x6f, 0xb7, 0x98, 0x37, 0xb8, 0x8d, 0x4a, 0x8f, 0xe1, 0xb0, 0x64, 0xaf, 0x87,
-	0x7f, 0xba, 0xc9, 0x07, 0x58, 0xc3, 0x4b, 0xf4, 0xa6, 0x79, 0x7e, 0x59, 0xf2, 0x77, 0x8a, 0xf9,
-	0xaf, 0x93, 0x88, 0x1c, 0x7b, 0x15, 0x7f, 0x77, 0x59, 0x3a, 0x94, 0xd9, 0x34, 0xea, 0x7c, 0xd3,
-	0xa0, 0x22, 0x6b, 0xe9, 0xc2, 0xb2, 0xe8, 0xc5, 0x30, 0xf4, 0x65, 0x3b, 0x2a, 0x16, 0x2c, 0xe6,
-	0xad, 0xaa, 0x58, 0x1a, 0x1c, 0xd8, 0x55, 0xb1, 0x34, 0xf0, 0x89, 0x25, 0xc6, 0x4b, 0x88, 0xe2,
-	0x33, 0xaa, 0xaa, 0x6c, 0xdd, 0x33, 0xae, 0x83, 0xf8, 0x8c, 0xaa, 0x61, 0xaf, 0x79, 0xca, 0x26,
-	0x5b, 0x00, 0x92, 0xe1, 0xce, 0x38, 0x32, 0x59, 0x4a, 0xc3, 0x96, 0x54, 0x4b, 0x38, 0xc8, 0xff,
-	0x50, 0x1d, 0x87, 0x71, 0x8c, 0xbe, 0x51, 0x69, 0x6b, 0xdb, 0x35, 0x5b, 0x59, 0x9d, 0x6b, 0x0d,
-	0x6a, 0x59, 0x53, 0x76, 0x60, 0x2d, 0x6d, 0xc6, 0xd0, 0xf1, 0xfd, 0x09, 0xb2, 0xe4, 0xe6, 0xd5,
-	0xed, 0xd5, 0xd4, 0xff, 0x32, 0x71, 0x93, 0x77, 0xf0, 0x4f, 0x26, 0xcd, 0xa5, 0xdd, 0xfd, 0xcb,
-	0xad, 0xc8, 0xa5, 0xde, 0xf0, 0x72, 0x3e, 0x32, 0x80, 0x7f, 0x33, 0x1e, 0x13, 0x43, 0xa8, 0xae,
-	0xd9, 0xc3, 0xa2, 0xd3, 0xa0, 0x3e, 0x8e, 0x14, 0x29, 0xcb, 0x44, 0x4e, 0x6f, 0xc7, 0x82, 0x5a,
-	0x7a, 0x51, 0x48, 0x1b, 0xaa, 0xa1, 0x3f, 0x3c, 0xc7, 0x99, 0xac, 0xa3, 0x61, 0xd5, 0x17, 0xf3,
-	0x96, 0x3e, 0x38, 0x38, 0xc2, 0x99, 0xad, 0x87, 0xfe, 0x11, 0xce, 0xc8, 0x06, 0xe8, 0x17, 0xce,
-	0x68, 0x8a, 0xb2, 0x80, 0x8a, 0x9d, 0x18, 0xd6, 0x8b, 0xab, 0x85, 0xa9, 0x5d, 0x2f, 0x4c, 0xed,
-	0xc7, 0xc2, 0xd4, 0xbe, 0xdc, 0x98, 0xa5, 0xeb, 0x1b, 0xb3, 0xf4, 0xfd, 0xc6, 0x2c, 0x9d, 0x3e,
-	0x0e, 0x42, 0xfe, 0x71, 0xea, 0xf6, 0x3c, 0x1a, 0xf5, 0x47, 0x61, 0x8c, 0xfd, 0x91, 0x1b, 0xed,
-	0x32, 0xff, 0xbc, 0x7f, 0x99, 0xbc, 0x6a, 0xf2, 0xc1, 0x73, 0xab, 0xf2, 0x45, 0x7b, 0xf6, 0x2b,
-	0x00, 0x00, 0xff, 0xff, 0x3e, 0xc2, 0x2d, 0x0e, 0x68, 0x05, 0x00, 0x00,
+	// 670 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xcf, 0x4e, 0xdb, 0x4e,
+	0x10, 0xc7, 0x63, 0x92, 0x98, 0x64, 0xc8, 0xef, 0x07, 0x5a, 0x68, 0x6b, 0x85, 0xe2, 0xa4, 0x49,
+	0x0f, 0xa0, 0x96, 0x44, 0xd0, 0x63, 0xa5, 0x4a, 0x75, 0xa9, 0x4a, 0x8a, 0xa8, 0x2a, 0x23, 0xf5,
+	0xc0, 0x25, 0xf2, 0x9f, 0xc1, 0x58, 0xc4, 0xde, 0x34, 0xbb, 0xa1, 0xf8, 0x2d, 0xaa, 0x3e, 0x43,
+	0xd5, 0x67, 0xe1, 0xc8, 0xb1, 0xa7, 0xa8, 0x0a, 0xb7, 0x3e, 0x45, 0xe5, 0xf5, 0xda, 0x18, 0x15,
+	0xd3, 0x4b, 0x94, 0x9d, 0xfd, 0xce, 0x67, 0xbf, 0x3b, 0xe3, 0x59, 0xe8, 0x3a, 0x94, 0x05, 0x5f,
+	0x2c, 0x16, 0xf4, 0xc5, 0xcf, 0xf9, 0x8e, 0x8d, 0xdc, 0xda, 0xe9, 0x7b, 0x18, 0x22, 0xf3, 0x59,
+	0x6f, 0x3c, 0xa1, 0x9c, 0x92, 0x07, 0xa9, 0xa8, 0x27, 0x7e, 0xa4, 0xa8, 0xb9, 0xe6, 0x51, 0x8f,
+	0x0a, 0x45, 0x3f, 0xfe, 0x97, 0x88, 0x9b, 0x4f, 0xee, 0x26, 0xf2, 0x68, 0x8c, 0x92, 0xd7, 0xd4,
+	0x0b, 0x24, 0x17, 0xc9, 0x7e, 0xe7, 0xbb, 0x0a, 0x8d, 0x77, 0x89, 0x83, 0x23, 0x6e, 0x71, 0x24,
+	0x2f, 0x41, 0x1d, 0x5b, 0x13, 0x2b, 0x60, 0x9a, 0xd2, 0x56, 0x36, 0x97, 0x76, 0x37, 0x7a, 0x77,
+	0x3a, 0xea, 0x7d, 0x14, 0x22, 0xa3, 0x72, 0x39, 0x6b, 0x95, 0x4c, 0x99, 0x42, 0xde, 0x43, 0xd5,
+	0xa1, 0x2e, 0x32, 0x6d, 0xa1, 0x5d, 0xde, 0x5c, 0xda, 0x5d, 0x2f, 0xc8, 0x7d, 0x43, 0x5d, 0x34,
+	0x1e, 0xc5, 0x99, 0xbf, 0x67, 0xad, 0x65, 0x91, 0xf1, 0x9c, 0x06, 0x3e, 0xc7, 0x60, 0xcc, 0x23,
+	0x33, 0x41, 0x90, 0x63, 0xa8, 0x3b, 0x34, 0xe4, 0x13, 0xcb, 0xe1, 0x4c, 0x2b, 0x0b, 0x5e, 0xab,
+	0x90, 0x97, 0xe8, 0x8c, 0x75, 0xc9, 0x5c, 0xcd, 0x32, 0x73, 0xdc, 0x1b, 0x5c, 0xcc, 0x66, 0xf8,
+	0x79, 0x8a, 0xa1, 0x83, 0x4c, 0xab, 0xdc, 0xcb, 0x3e, 0x92, 0xba, 0x1b, 0x76, 0x96, 0x99, 0x67,
+	0x67, 0x41, 0x62, 0x43, 0xcd, 0xc3, 0x70, 0x18, 0x30, 0x8f, 0x69, 0x55, 0x81, 0x7e, 0x56, 0x80,
+	0xce, 0xd7, 0x3d, 0x5e, 0x1c, 0x32, 0x8f, 0x19, 0x4d, 0x79, 0x0c, 0x49, 0x21, 0xb9, 0x53, 0x16,
+	0xbd, 0x44, 0x24, 0xfc, 0x3b, 0xa7, 0xe8, 0x4e, 0x47, 0xc8, 0x34, 0xf5, 0x7e, 0xff, 0x52, 0x97,
+	0xf3, 0x9f, 0x66, 0xde, 0xf2, 0x9f, 0x06, 0x9b, 0xdf, 0x16, 0x60, 0x51, 0x9a, 0x21, 0x7b, 0x00,
+	0x8c, 0xd3, 0x09, 0x0e, 0xe3, 0x96, 0xc8, 0x0f, 0xa2, 0x5b, 0x70, 0xd0, 0x21, 0xf3, 0x8e, 0x62,
+	0x6d, 0xdc, 0xdc, 0xfd, 0x92, 0x59, 0x67, 0xe9, 0x82, 0xd8, 0xb0, 0xe6, 0x87, 0x8c, 0x5b, 0x21,
+	0xf7, 0x2d, 0x8e, 0xc3, 0xb4, 0x0d, 0xda, 0x82, 0xe0, 0x6d, 0x17, 0xf3, 0x06, 0x37, 0x59, 0x69,
+	0x8b, 0xf7, 0x4b, 0xe6, 0xaa, 0xff, 0x77, 0x98, 0x7c, 0x82, 0x15, 0xbc, 0x40, 0x67, 0x9a, 0xe7,
+	0x97, 0x05, 0x7f, 0xab, 0x98, 0xff, 0x36, 0xc9, 0xc8, 0xb1, 0x97, 0xf1, 0x76, 0xc8, 0xa8, 0x42,
+	0x99, 0x4d, 0x83, 0xce, 0x0f, 0x05, 0x2a, 0xe2, 0x2e, 0x5d, 0x58, 0x8c, 0x6b, 0x31, 0xf4, 0x5d,
+	0x51, 0x8e, 0x8a, 0x01, 0xf3, 0x59, 0x4b, 0x8d, 0xb7, 0x06, 0x7b, 0xa6, 0x1a, 0x6f, 0x0d, 0x5c,
+	0x62, 0x40, 0x3d, 0x11, 0x85, 0x27, 0x54, 0xde, 0xb2, 0x75, 0xcf, 0x28, 0x0c, 0xc2, 0x13, 0x2a,
+	0x07, 0xa9, 0xe6, 0xc8, 0x35, 0xd9, 0x00, 0x10, 0x0c, 0x3b, 0xe2, 0xc8, 0xc4, 0x55, 0x1a, 0xa6,
+	0xa0, 0x1a, 0x71, 0x80, 0x3c, 0x04, 0x75, 0xec, 0x87, 0x21, 0xba, 0x5a, 0xa5, 0xad, 0x6c, 0xd6,
+	0x4c, 0xb9, 0xea, 0x5c, 0x29, 0x50, 0xcb, 0x8a, 0xb2, 0x05, 0x2b, 0x69, 0x31, 0x86, 0x96, 0xeb,
+	0x4e, 0x90, 0x25, 0x53, 0x5d, 0x37, 0x97, 0xd3, 0xf8, 0xeb, 0x24, 0x4c, 0x3e, 0xc0, 0x7f, 0x99,
+	0x34, 0x67, 0xbb, 0xfb, 0x8f, 0x89, 0xcb, 0x59, 0x6f, 0x38, 0xb9, 0x18, 0x19, 0xc0, 0xff, 0x19,
+	0x8f, 0xc5, 0x1f, 0xb8, 0x1c, 0xe1, 0xc7, 0x45, 0xdd, 0xa0, 0x2e, 0x8e, 0x24, 0x29, 0x73, 0x22,
+	0x26, 0xa3, 0x63, 0x40, 0x2d, 0x1d, 0x42, 0xd2, 0x06, 0xd5, 0x77, 0x87, 0x67, 0x18, 0x89, 0x7b,
+	0x34, 0x8c, 0xfa, 0x7c, 0xd6, 0xaa, 0x0e, 0xf6, 0x0e, 0x30, 0x32, 0xab, 0xbe, 0x7b, 0x80, 0x11,
+	0x59, 0x83, 0xea, 0xb9, 0x35, 0x9a, 0xa2, 0xb8, 0x40, 0xc5, 0x4c, 0x16, 0xc6, 0xab, 0xcb, 0xb9,
+	0xae, 0x5c, 0xcd, 0x75, 0xe5, 0xd7, 0x5c, 0x57, 0xbe, 0x5e, 0xeb, 0xa5, 0xab, 0x6b, 0xbd, 0xf4,
+	0xf3, 0x5a, 0x2f, 0x1d, 0x3f, 0xf5, 0x7c, 0x7e, 0x3a, 0xb5, 0x7b, 0x0e, 0x0d, 0xfa, 0x23, 0x3f,
+	0xc4, 0xfe, 0xc8, 0x0e, 0xb6, 0x99, 0x7b, 0xd6, 0xbf, 0x48, 0x5e, 0x4c, 0xf1, 0x98, 0xda, 0xaa,
+	0x78, 0x2d, 0x5f, 0xfc, 0x19, 0x00, 0x80, 0x99, 0x83, 0xf3, 0xc4, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -463,6 +472,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.GenMsgs) > 0 {
 		for iNdEx := len(m.GenMsgs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -814,6 +837,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1127,6 +1156,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedules = append(m.Schedules, Schedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
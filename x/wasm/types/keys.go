@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/line/lbm-sdk/types"
 )
 
@@ -30,9 +32,16 @@ var (
 	ContractCodeHistoryElementPrefix               = []byte{0x05}
 	ContractByCodeIDAndCreatedSecondaryIndexPrefix = []byte{0x06}
 	PinnedCodeIndexPrefix                          = []byte{0x07}
+	ContractsByCreatorSecondaryIndexPrefix         = []byte{0x08}
+	ContractsByAdminSecondaryIndexPrefix           = []byte{0x09}
+	CodeByChecksumSecondaryIndexPrefix             = []byte{0x0a}
+	ScheduleKeyPrefix                              = []byte{0x0b}
+	ScheduleQueuePrefix                            = []byte{0x0c}
+	SchedulesByContractSecondaryIndexPrefix        = []byte{0x0d}
 
 	KeyLastCodeID     = append(SequenceKeyPrefix, []byte("lastCodeId")...)
 	KeyLastInstanceID = append(SequenceKeyPrefix, []byte("lastContractId")...)
+	KeyLastScheduleID = append(SequenceKeyPrefix, []byte("lastScheduleId")...)
 )
 
 // GetCodeKey constructs the key for retreiving the ID for the WASM code
@@ -103,3 +112,81 @@ func GetPinnedCodeIndexPrefix(codeID uint64) []byte {
 func ParsePinnedCodeIndex(s []byte) uint64 {
 	return sdk.BigEndianToUint64(s)
 }
+
+// GetContractsByCreatorSecondaryIndexKey returns the key for the secondary index:
+// `<prefix><creatorLen><creator><created><contractAddr>`
+func GetContractsByCreatorSecondaryIndexKey(creator, contractAddr sdk.AccAddress, created *AbsoluteTxPosition) []byte {
+	prefix := GetContractsByCreatorSecondaryIndexPrefix(creator)
+	prefixLen := len(prefix)
+	r := make([]byte, prefixLen+AbsoluteTxPositionLen+len(contractAddr.Bytes()))
+	copy(r[0:], prefix)
+	copy(r[prefixLen:], created.Bytes())
+	copy(r[prefixLen+AbsoluteTxPositionLen:], contractAddr.Bytes())
+	return r
+}
+
+// GetContractsByCreatorSecondaryIndexPrefix returns the prefix for the second index: `<prefix><creatorLen><creator>`
+func GetContractsByCreatorSecondaryIndexPrefix(creator sdk.AccAddress) []byte {
+	return append(sdk.CopyBytes(ContractsByCreatorSecondaryIndexPrefix), lengthPrefix(creator.Bytes())...)
+}
+
+// GetContractsByAdminSecondaryIndexKey returns the key for the secondary index:
+// `<prefix><adminLen><admin><contractAddr>`
+func GetContractsByAdminSecondaryIndexKey(admin, contractAddr sdk.AccAddress) []byte {
+	return append(GetContractsByAdminSecondaryIndexPrefix(admin), contractAddr.Bytes()...)
+}
+
+// GetContractsByAdminSecondaryIndexPrefix returns the prefix for the second index: `<prefix><adminLen><admin>`
+func GetContractsByAdminSecondaryIndexPrefix(admin sdk.AccAddress) []byte {
+	return append(sdk.CopyBytes(ContractsByAdminSecondaryIndexPrefix), lengthPrefix(admin.Bytes())...)
+}
+
+// GetCodeByChecksumSecondaryIndexKey returns the key for the secondary index:
+// `<prefix><checksumLen><checksum><codeID>`
+func GetCodeByChecksumSecondaryIndexKey(checksum []byte, codeID uint64) []byte {
+	return append(GetCodeByChecksumSecondaryIndexPrefix(checksum), sdk.Uint64ToBigEndian(codeID)...)
+}
+
+// GetCodeByChecksumSecondaryIndexPrefix returns the prefix for the second index: `<prefix><checksumLen><checksum>`
+func GetCodeByChecksumSecondaryIndexPrefix(checksum []byte) []byte {
+	return append(sdk.CopyBytes(CodeByChecksumSecondaryIndexPrefix), lengthPrefix(checksum)...)
+}
+
+// lengthPrefix prepends the length of bz as a single byte so that an index entry for one value can not be
+// matched by the prefix of another.
+func lengthPrefix(bz []byte) []byte {
+	if len(bz) > 255 {
+		panic(fmt.Sprintf("length prefix exceeds max length of 255 bytes: %d", len(bz)))
+	}
+	return append([]byte{byte(len(bz))}, bz...)
+}
+
+// GetScheduleKey constructs the key for the schedule with the given id
+func GetScheduleKey(scheduleID uint64) []byte {
+	return append(ScheduleKeyPrefix, sdk.Uint64ToBigEndian(scheduleID)...)
+}
+
+// GetScheduleQueueKey returns the key for the schedule queue:
+// `<prefix><height><scheduleID>`
+func GetScheduleQueueKey(height int64, scheduleID uint64) []byte {
+	prefix := GetScheduleQueueHeightPrefix(height)
+	return append(prefix, sdk.Uint64ToBigEndian(scheduleID)...)
+}
+
+// GetScheduleQueueHeightPrefix returns the prefix of the schedules due at the given height
+func GetScheduleQueueHeightPrefix(height int64) []byte {
+	r := make([]byte, 0, len(ScheduleQueuePrefix)+8)
+	r = append(r, ScheduleQueuePrefix...)
+	return append(r, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetSchedulesByContractSecondaryIndexKey returns the key for the secondary index:
+// `<prefix><contractLen><contract><scheduleID>`
+func GetSchedulesByContractSecondaryIndexKey(contractAddr sdk.AccAddress, scheduleID uint64) []byte {
+	return append(GetSchedulesByContractSecondaryIndexPrefix(contractAddr), sdk.Uint64ToBigEndian(scheduleID)...)
+}
+
+// GetSchedulesByContractSecondaryIndexPrefix returns the prefix for the second index: `<prefix><contractLen><contract>`
+func GetSchedulesByContractSecondaryIndexPrefix(contractAddr sdk.AccAddress) []byte {
+	return append(sdk.CopyBytes(SchedulesByContractSecondaryIndexPrefix), lengthPrefix(contractAddr.Bytes())...)
+}
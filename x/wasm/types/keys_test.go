@@ -43,3 +43,56 @@ func TestGetContractByCreatedSecondaryIndexKey(t *testing.T) {
 	}
 	assert.Equal(t, exp, got)
 }
+
+func TestGetContractsByCreatorSecondaryIndexKey(t *testing.T) {
+	creator := sdk.BytesToAccAddress(bytes.Repeat([]byte{4}, sdk.BytesAddrLen))
+	contract := sdk.BytesToAccAddress(bytes.Repeat([]byte{5}, sdk.BytesAddrLen))
+	got := GetContractsByCreatorSecondaryIndexKey(creator, contract, &AbsoluteTxPosition{2 + 1<<(8*7), 3 + 1<<(8*7)})
+
+	exp := []byte{8, byte(len(creator.Bytes()))} // prefix and creator length
+	exp = append(exp, creator.Bytes()...)
+	exp = append(exp,
+		1, 0, 0, 0, 0, 0, 0, 2, // height
+		1, 0, 0, 0, 0, 0, 0, 3, // index
+	)
+	exp = append(exp, contract.Bytes()...)
+	assert.Equal(t, exp, got)
+	assert.True(t, bytes.HasPrefix(got, GetContractsByCreatorSecondaryIndexPrefix(creator)))
+}
+
+func TestGetContractsByAdminSecondaryIndexKey(t *testing.T) {
+	admin := sdk.BytesToAccAddress(bytes.Repeat([]byte{4}, sdk.BytesAddrLen))
+	contract := sdk.BytesToAccAddress(bytes.Repeat([]byte{5}, sdk.BytesAddrLen))
+	got := GetContractsByAdminSecondaryIndexKey(admin, contract)
+
+	exp := []byte{9, byte(len(admin.Bytes()))} // prefix and admin length
+	exp = append(exp, admin.Bytes()...)
+	exp = append(exp, contract.Bytes()...)
+	assert.Equal(t, exp, got)
+	assert.True(t, bytes.HasPrefix(got, GetContractsByAdminSecondaryIndexPrefix(admin)))
+}
+
+func TestGetCodeByChecksumSecondaryIndexKey(t *testing.T) {
+	checksum := bytes.Repeat([]byte{4}, 32)
+	got := GetCodeByChecksumSecondaryIndexKey(checksum, 1<<(8*7))
+
+	exp := []byte{10, 32} // prefix and checksum length
+	exp = append(exp, checksum...)
+	exp = append(exp, 1, 0, 0, 0, 0, 0, 0, 0) // code id
+	assert.Equal(t, exp, got)
+
+	// a checksum must not match the prefix of a longer one
+	longer := append(sdk.CopyBytes(checksum), 0)
+	assert.False(t, bytes.HasPrefix(GetCodeByChecksumSecondaryIndexKey(longer, 1), GetCodeByChecksumSecondaryIndexPrefix(checksum)))
+}
+
+func TestGetScheduleQueueKey(t *testing.T) {
+	got := GetScheduleQueueKey(2+1<<(8*7), 3+1<<(8*7))
+
+	exp := []byte{12,
+		1, 0, 0, 0, 0, 0, 0, 2, // height
+		1, 0, 0, 0, 0, 0, 0, 3, // schedule id
+	}
+	assert.Equal(t, exp, got)
+	assert.True(t, bytes.HasPrefix(got, GetScheduleQueueHeightPrefix(2+1<<(8*7))))
+}
@@ -30,6 +30,12 @@ const (
 	DefaultInstanceCost = 40_000
 	// CompileCost is how much SDK gas we charge *per byte* for compiling WASM code.
 	DefaultCompileCost = 2
+	// DefaultMaxScheduleGasPerBlock is how much SDK gas we spend at most on executing schedules in a block.
+	DefaultMaxScheduleGasPerBlock = 10_000_000
+	// DefaultMaxSchedulesPerContract is how many schedules not created by governance a contract can have.
+	DefaultMaxSchedulesPerContract = 10
+	// MinScheduleGasLimit is the smallest gas limit of a schedule, as an execution loads a WASM instance at least.
+	MinScheduleGasLimit = 50_000
 )
 
 var ParamStoreKeyUploadAccess = []byte("uploadAccess")
@@ -39,11 +45,16 @@ var ParamStoreKeyMaxWasmCodeSize = []byte("maxWasmCodeSize")
 var ParamStoreKeyGasMultiplier = []byte("gasMultiplier")
 var ParamStoreKeyInstanceCost = []byte("instanceCost")
 var ParamStoreKeyCompileCost = []byte("compileCost")
+var ParamStoreKeyMaxScheduleGasPerBlock = []byte("maxScheduleGasPerBlock")
+var ParamStoreKeyScheduleCreationFee = []byte("scheduleCreationFee")
+var ParamStoreKeyScheduleExecutionFee = []byte("scheduleExecutionFee")
+var ParamStoreKeyMaxSchedulesPerContract = []byte("maxSchedulesPerContract")
 
 var AllAccessTypes = []AccessType{
 	AccessTypeNobody,
 	AccessTypeOnlyAddress,
 	AccessTypeEverybody,
+	AccessTypeAnyOfAddresses,
 }
 
 func (a AccessType) With(addr sdk.AccAddress) AccessConfig {
@@ -58,6 +69,11 @@ func (a AccessType) With(addr sdk.AccAddress) AccessConfig {
 		return AccessConfig{Permission: AccessTypeOnlyAddress, Address: addr.String()}
 	case AccessTypeEverybody:
 		return AllowEverybody
+	case AccessTypeAnyOfAddresses:
+		if err := sdk.ValidateAccAddress(addr.String()); err != nil {
+			panic(err)
+		}
+		return AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{addr.String()}}
 	}
 	panic("unsupported access type")
 }
@@ -70,6 +86,8 @@ func (a AccessType) String() string {
 		return "OnlyAddress"
 	case AccessTypeEverybody:
 		return "Everybody"
+	case AccessTypeAnyOfAddresses:
+		return "AnyOfAddresses"
 	}
 	return "Unspecified"
 }
@@ -97,7 +115,24 @@ func (a *AccessType) UnmarshalJSONPB(_ *jsonpb.Unmarshaler, data []byte) error {
 }
 
 func (a AccessConfig) Equals(o AccessConfig) bool {
-	return a.Permission == o.Permission && a.Address == o.Address
+	if a.Permission != o.Permission || a.Address != o.Address || len(a.Addresses) != len(o.Addresses) {
+		return false
+	}
+	for i := range a.Addresses {
+		if a.Addresses[i] != o.Addresses[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// AllowAnyOfAddresses returns an access config that is only allowed for the given addresses
+func AllowAnyOfAddresses(addrs ...sdk.AccAddress) AccessConfig {
+	addresses := make([]string, len(addrs))
+	for i, addr := range addrs {
+		addresses[i] = addr.String()
+	}
+	return AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: addresses}
 }
 
 var (
@@ -105,6 +140,11 @@ var (
 	DefaultContractStatusAccess = AllowNobody
 	AllowEverybody              = AccessConfig{Permission: AccessTypeEverybody}
 	AllowNobody                 = AccessConfig{Permission: AccessTypeNobody}
+
+	// DefaultScheduleCreationFee and DefaultScheduleExecutionFee are in the default bond denom; chains using
+	// another denom set them in genesis or by governance.
+	DefaultScheduleCreationFee  = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000))
+	DefaultScheduleExecutionFee = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10_000))
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -122,6 +162,10 @@ func DefaultParams() Params {
 		GasMultiplier:                DefaultGasMultiplier,
 		InstanceCost:                 DefaultInstanceCost,
 		CompileCost:                  DefaultCompileCost,
+		MaxScheduleGasPerBlock:       DefaultMaxScheduleGasPerBlock,
+		ScheduleCreationFee:          DefaultScheduleCreationFee,
+		ScheduleExecutionFee:         DefaultScheduleExecutionFee,
+		MaxSchedulesPerContract:      DefaultMaxSchedulesPerContract,
 	}
 }
 
@@ -140,6 +184,10 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyGasMultiplier, &p.GasMultiplier, validateGasMultiplier),
 		paramtypes.NewParamSetPair(ParamStoreKeyInstanceCost, &p.InstanceCost, validateInstanceCost),
 		paramtypes.NewParamSetPair(ParamStoreKeyCompileCost, &p.CompileCost, validateCompileCost),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxScheduleGasPerBlock, &p.MaxScheduleGasPerBlock, validateMaxScheduleGasPerBlock),
+		paramtypes.NewParamSetPair(ParamStoreKeyScheduleCreationFee, &p.ScheduleCreationFee, validateScheduleFee),
+		paramtypes.NewParamSetPair(ParamStoreKeyScheduleExecutionFee, &p.ScheduleExecutionFee, validateScheduleFee),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxSchedulesPerContract, &p.MaxSchedulesPerContract, validateMaxSchedulesPerContract),
 	}
 }
 
@@ -166,6 +214,18 @@ func (p Params) ValidateBasic() error {
 	if err := validateCompileCost(p.CompileCost); err != nil {
 		return errors.Wrap(err, "compile cost")
 	}
+	if err := validateMaxScheduleGasPerBlock(p.MaxScheduleGasPerBlock); err != nil {
+		return errors.Wrap(err, "max schedule gas per block")
+	}
+	if err := validateScheduleFee(p.ScheduleCreationFee); err != nil {
+		return errors.Wrap(err, "schedule creation fee")
+	}
+	if err := validateScheduleFee(p.ScheduleExecutionFee); err != nil {
+		return errors.Wrap(err, "schedule execution fee")
+	}
+	if err := validateMaxSchedulesPerContract(p.MaxSchedulesPerContract); err != nil {
+		return errors.Wrap(err, "max schedules per contract")
+	}
 	return nil
 }
 
@@ -237,18 +297,53 @@ func validateCompileCost(i interface{}) error {
 	return nil
 }
 
+// validateMaxScheduleGasPerBlock accepts zero, which disables the execution of schedules
+func validateMaxScheduleGasPerBlock(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return sdkerrors.Wrapf(ErrInvalid, "type: %T", i)
+	}
+	return nil
+}
+
+func validateScheduleFee(i interface{}) error {
+	a, ok := i.(sdk.Coins)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalid, "type: %T", i)
+	}
+	if err := a.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalid, err.Error())
+	}
+	return nil
+}
+
+// validateMaxSchedulesPerContract accepts zero, which lets only governance create schedules
+func validateMaxSchedulesPerContract(i interface{}) error {
+	if _, ok := i.(uint32); !ok {
+		return sdkerrors.Wrapf(ErrInvalid, "type: %T", i)
+	}
+	return nil
+}
+
 func (a AccessConfig) ValidateBasic() error {
 	switch a.Permission {
 	case AccessTypeUnspecified:
 		return sdkerrors.Wrap(ErrEmpty, "type")
 	case AccessTypeNobody, AccessTypeEverybody:
-		if len(a.Address) != 0 {
+		if len(a.Address) != 0 || len(a.Addresses) != 0 {
 			return sdkerrors.Wrap(ErrInvalid, "address not allowed for this type")
 		}
 		return nil
 	case AccessTypeOnlyAddress:
+		if len(a.Addresses) != 0 {
+			return sdkerrors.Wrap(ErrInvalid, "addresses not allowed for this type")
+		}
 		err := sdk.ValidateAccAddress(a.Address)
 		return err
+	case AccessTypeAnyOfAddresses:
+		if len(a.Address) != 0 {
+			return sdkerrors.Wrap(ErrInvalid, "address not allowed for this type")
+		}
+		return validateAddresses(a.Addresses)
 	}
 	return sdkerrors.Wrapf(ErrInvalid, "unknown type: %q", a.Permission)
 }
@@ -261,7 +356,32 @@ func (a AccessConfig) Allowed(actor sdk.AccAddress) bool {
 		return true
 	case AccessTypeOnlyAddress:
 		return a.Address == actor.String()
+	case AccessTypeAnyOfAddresses:
+		for _, addr := range a.Addresses {
+			if addr == actor.String() {
+				return true
+			}
+		}
+		return false
 	default:
 		panic("unknown type")
 	}
 }
+
+// validateAddresses ensures the list is not empty and contains only unique, valid addresses
+func validateAddresses(addrs []string) error {
+	if len(addrs) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "addresses")
+	}
+	seen := make(map[string]struct{}, len(addrs))
+	for _, addr := range addrs {
+		if err := sdk.ValidateAccAddress(addr); err != nil {
+			return sdkerrors.Wrapf(err, "address %q", addr)
+		}
+		if _, ok := seen[addr]; ok {
+			return sdkerrors.Wrapf(ErrDuplicate, "address %q", addr)
+		}
+		seen[addr] = struct{}{}
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"encoding/json"
 	"testing"
 
@@ -57,6 +58,29 @@ func TestValidateParams(t *testing.T) {
 				CompileCost:                  DefaultCompileCost,
 			},
 		},
+		"all good with any of addresses": {
+			src: Params{
+				CodeUploadAccess:             AllowAnyOfAddresses(anyAddress),
+				InstantiateDefaultPermission: AccessTypeAnyOfAddresses,
+				ContractStatusAccess:         AllowAnyOfAddresses(anyAddress),
+				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				GasMultiplier:                DefaultGasMultiplier,
+				InstanceCost:                 DefaultInstanceCost,
+				CompileCost:                  DefaultCompileCost,
+			},
+		},
+		"reject CodeUploadAccess empty addresses in any of addresses": {
+			src: Params{
+				CodeUploadAccess:             AccessConfig{Permission: AccessTypeAnyOfAddresses},
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				ContractStatusAccess:         DefaultContractStatusAccess,
+				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				GasMultiplier:                DefaultGasMultiplier,
+				InstanceCost:                 DefaultInstanceCost,
+				CompileCost:                  DefaultCompileCost,
+			},
+			expErr: true,
+		},
 		"reject empty type in instantiate permission": {
 			src: Params{
 				CodeUploadAccess:     AllowNobody,
@@ -239,6 +263,47 @@ func TestValidateParams(t *testing.T) {
 			},
 			expErr: true,
 		},
+		"all good with schedule creation fee": {
+			src: Params{
+				CodeUploadAccess:             DefaultUploadAccess,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				ContractStatusAccess:         DefaultContractStatusAccess,
+				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				GasMultiplier:                DefaultGasMultiplier,
+				InstanceCost:                 DefaultInstanceCost,
+				CompileCost:                  DefaultCompileCost,
+				MaxScheduleGasPerBlock:       DefaultMaxScheduleGasPerBlock,
+				ScheduleCreationFee:          sdk.NewCoins(sdk.NewInt64Coin("denom", 1)),
+			},
+		},
+		"reject invalid schedule execution fee": {
+			src: Params{
+				CodeUploadAccess:             DefaultUploadAccess,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				ContractStatusAccess:         DefaultContractStatusAccess,
+				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				GasMultiplier:                DefaultGasMultiplier,
+				InstanceCost:                 DefaultInstanceCost,
+				CompileCost:                  DefaultCompileCost,
+				MaxScheduleGasPerBlock:       DefaultMaxScheduleGasPerBlock,
+				ScheduleExecutionFee:         sdk.Coins{sdk.NewInt64Coin("denom", 0)},
+			},
+			expErr: true,
+		},
+		"reject invalid schedule creation fee": {
+			src: Params{
+				CodeUploadAccess:             DefaultUploadAccess,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				ContractStatusAccess:         DefaultContractStatusAccess,
+				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				GasMultiplier:                DefaultGasMultiplier,
+				InstanceCost:                 DefaultInstanceCost,
+				CompileCost:                  DefaultCompileCost,
+				MaxScheduleGasPerBlock:       DefaultMaxScheduleGasPerBlock,
+				ScheduleCreationFee:          sdk.Coins{sdk.NewInt64Coin("denom", 0)},
+			},
+			expErr: true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -257,11 +322,12 @@ func TestAccessTypeMarshalJson(t *testing.T) {
 		src AccessType
 		exp string
 	}{
-		"Unspecified": {src: AccessTypeUnspecified, exp: `"Unspecified"`},
-		"Nobody":      {src: AccessTypeNobody, exp: `"Nobody"`},
-		"OnlyAddress": {src: AccessTypeOnlyAddress, exp: `"OnlyAddress"`},
-		"Everybody":   {src: AccessTypeEverybody, exp: `"Everybody"`},
-		"unknown":     {src: 999, exp: `"Unspecified"`},
+		"Unspecified":    {src: AccessTypeUnspecified, exp: `"Unspecified"`},
+		"Nobody":         {src: AccessTypeNobody, exp: `"Nobody"`},
+		"OnlyAddress":    {src: AccessTypeOnlyAddress, exp: `"OnlyAddress"`},
+		"Everybody":      {src: AccessTypeEverybody, exp: `"Everybody"`},
+		"AnyOfAddresses": {src: AccessTypeAnyOfAddresses, exp: `"AnyOfAddresses"`},
+		"unknown":        {src: 999, exp: `"Unspecified"`},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -277,11 +343,12 @@ func TestAccessTypeUnmarshalJson(t *testing.T) {
 		src string
 		exp AccessType
 	}{
-		"Unspecified": {src: `"Unspecified"`, exp: AccessTypeUnspecified},
-		"Nobody":      {src: `"Nobody"`, exp: AccessTypeNobody},
-		"OnlyAddress": {src: `"OnlyAddress"`, exp: AccessTypeOnlyAddress},
-		"Everybody":   {src: `"Everybody"`, exp: AccessTypeEverybody},
-		"unknown":     {src: `""`, exp: AccessTypeUnspecified},
+		"Unspecified":    {src: `"Unspecified"`, exp: AccessTypeUnspecified},
+		"Nobody":         {src: `"Nobody"`, exp: AccessTypeNobody},
+		"OnlyAddress":    {src: `"OnlyAddress"`, exp: AccessTypeOnlyAddress},
+		"Everybody":      {src: `"Everybody"`, exp: AccessTypeEverybody},
+		"AnyOfAddresses": {src: `"AnyOfAddresses"`, exp: AccessTypeAnyOfAddresses},
+		"unknown":        {src: `""`, exp: AccessTypeUnspecified},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -305,7 +372,11 @@ func TestParamsUnmarshalJson(t *testing.T) {
 				"max_wasm_code_size": 614400,
 				"gas_multiplier": 100,
 				"instance_cost": 40000,
-				"compile_cost": 2}`,
+				"compile_cost": 2,
+				"max_schedule_gas_per_block": 10000000,
+				"schedule_creation_fee": [{"denom": "stake", "amount": "1000000"}],
+				"schedule_execution_fee": [{"denom": "stake", "amount": "10000"}],
+				"max_schedules_per_contract": 10}`,
 			exp: DefaultParams(),
 		},
 	}
@@ -321,3 +392,122 @@ func TestParamsUnmarshalJson(t *testing.T) {
 		})
 	}
 }
+
+func TestAccessConfigValidateBasic(t *testing.T) {
+	var (
+		myAddress    = sdk.BytesToAccAddress(make([]byte, sdk.BytesAddrLen))
+		otherAddress = sdk.BytesToAccAddress(bytes.Repeat([]byte{1}, sdk.BytesAddrLen))
+	)
+	specs := map[string]struct {
+		src    AccessConfig
+		expErr bool
+	}{
+		"nobody": {
+			src: AllowNobody,
+		},
+		"everybody": {
+			src: AllowEverybody,
+		},
+		"only address": {
+			src: AccessTypeOnlyAddress.With(myAddress),
+		},
+		"any of addresses": {
+			src: AllowAnyOfAddresses(myAddress, otherAddress),
+		},
+		"any of addresses with single address": {
+			src: AccessTypeAnyOfAddresses.With(myAddress),
+		},
+		"reject unspecified": {
+			src:    AccessConfig{},
+			expErr: true,
+		},
+		"reject any of addresses empty": {
+			src:    AccessConfig{Permission: AccessTypeAnyOfAddresses},
+			expErr: true,
+		},
+		"reject any of addresses duplicate": {
+			src:    AllowAnyOfAddresses(myAddress, myAddress),
+			expErr: true,
+		},
+		"reject any of addresses invalid address": {
+			src:    AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{"invalid"}},
+			expErr: true,
+		},
+		"reject any of addresses with address": {
+			src:    AccessConfig{Permission: AccessTypeAnyOfAddresses, Address: myAddress.String(), Addresses: []string{otherAddress.String()}},
+			expErr: true,
+		},
+		"reject only address with addresses": {
+			src:    AccessConfig{Permission: AccessTypeOnlyAddress, Address: myAddress.String(), Addresses: []string{otherAddress.String()}},
+			expErr: true,
+		},
+		"reject everybody with addresses": {
+			src:    AccessConfig{Permission: AccessTypeEverybody, Addresses: []string{myAddress.String()}},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAccessConfigAllowed(t *testing.T) {
+	var (
+		myAddress    = sdk.BytesToAccAddress(make([]byte, sdk.BytesAddrLen))
+		otherAddress = sdk.BytesToAccAddress(bytes.Repeat([]byte{1}, sdk.BytesAddrLen))
+		thirdAddress = sdk.BytesToAccAddress(bytes.Repeat([]byte{2}, sdk.BytesAddrLen))
+	)
+	specs := map[string]struct {
+		config AccessConfig
+		actor  sdk.AccAddress
+		exp    bool
+	}{
+		"nobody": {
+			config: AllowNobody,
+			actor:  myAddress,
+			exp:    false,
+		},
+		"everybody": {
+			config: AllowEverybody,
+			actor:  myAddress,
+			exp:    true,
+		},
+		"only address - same": {
+			config: AccessTypeOnlyAddress.With(myAddress),
+			actor:  myAddress,
+			exp:    true,
+		},
+		"only address - different": {
+			config: AccessTypeOnlyAddress.With(myAddress),
+			actor:  otherAddress,
+			exp:    false,
+		},
+		"any of addresses - first": {
+			config: AllowAnyOfAddresses(myAddress, otherAddress),
+			actor:  myAddress,
+			exp:    true,
+		},
+		"any of addresses - second": {
+			config: AllowAnyOfAddresses(myAddress, otherAddress),
+			actor:  otherAddress,
+			exp:    true,
+		},
+		"any of addresses - not included": {
+			config: AllowAnyOfAddresses(myAddress, otherAddress),
+			actor:  thirdAddress,
+			exp:    false,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, spec.exp, spec.config.Allowed(spec.actor))
+		})
+	}
+}
@@ -14,14 +14,17 @@ import (
 type ProposalType string
 
 const (
-	ProposalTypeStoreCode            ProposalType = "StoreCode"
-	ProposalTypeInstantiateContract  ProposalType = "InstantiateContract"
-	ProposalTypeMigrateContract      ProposalType = "MigrateContract"
-	ProposalTypeUpdateAdmin          ProposalType = "UpdateAdmin"
-	ProposalTypeClearAdmin           ProposalType = "ClearAdmin"
-	ProposalTypePinCodes             ProposalType = "PinCodes"
-	ProposalTypeUnpinCodes           ProposalType = "UnpinCodes"
-	ProposalTypeUpdateContractStatus ProposalType = "UpdateContractStatus"
+	ProposalTypeStoreCode               ProposalType = "StoreCode"
+	ProposalTypeInstantiateContract     ProposalType = "InstantiateContract"
+	ProposalTypeMigrateContract         ProposalType = "MigrateContract"
+	ProposalTypeUpdateAdmin             ProposalType = "UpdateAdmin"
+	ProposalTypeClearAdmin              ProposalType = "ClearAdmin"
+	ProposalTypePinCodes                ProposalType = "PinCodes"
+	ProposalTypeUnpinCodes              ProposalType = "UnpinCodes"
+	ProposalTypeUpdateContractStatus    ProposalType = "UpdateContractStatus"
+	ProposalTypeUpdateInstantiateConfig ProposalType = "UpdateInstantiateConfig"
+	ProposalTypeAddSchedule             ProposalType = "AddSchedule"
+	ProposalTypeRemoveSchedule          ProposalType = "RemoveSchedule"
 )
 
 // DisableAllProposals contains no wasm gov types.
@@ -37,6 +40,9 @@ var EnableAllProposals = []ProposalType{
 	ProposalTypePinCodes,
 	ProposalTypeUnpinCodes,
 	ProposalTypeUpdateContractStatus,
+	ProposalTypeUpdateInstantiateConfig,
+	ProposalTypeAddSchedule,
+	ProposalTypeRemoveSchedule,
 }
 
 // ConvertToProposals maps each key to a ProposalType and returns a typed list.
@@ -65,6 +71,9 @@ func init() { // register new content types with the sdk
 	govtypes.RegisterProposalType(string(ProposalTypeClearAdmin))
 	govtypes.RegisterProposalType(string(ProposalTypePinCodes))
 	govtypes.RegisterProposalType(string(ProposalTypeUnpinCodes))
+	govtypes.RegisterProposalType(string(ProposalTypeUpdateInstantiateConfig))
+	govtypes.RegisterProposalType(string(ProposalTypeAddSchedule))
+	govtypes.RegisterProposalType(string(ProposalTypeRemoveSchedule))
 	govtypes.RegisterProposalTypeCodec(&StoreCodeProposal{}, "wasm/StoreCodeProposal")
 	govtypes.RegisterProposalTypeCodec(&InstantiateContractProposal{}, "wasm/InstantiateContractProposal")
 	govtypes.RegisterProposalTypeCodec(&MigrateContractProposal{}, "wasm/MigrateContractProposal")
@@ -73,6 +82,9 @@ func init() { // register new content types with the sdk
 	govtypes.RegisterProposalTypeCodec(&PinCodesProposal{}, "wasm/PinCodesProposal")
 	govtypes.RegisterProposalTypeCodec(&UnpinCodesProposal{}, "wasm/UnpinCodesProposal")
 	govtypes.RegisterProposalTypeCodec(UpdateContractStatusProposal{}, "wasm/UpdateContractStatusProposal")
+	govtypes.RegisterProposalTypeCodec(&UpdateInstantiateConfigProposal{}, "wasm/UpdateInstantiateConfigProposal")
+	govtypes.RegisterProposalTypeCodec(&AddScheduleProposal{}, "wasm/AddScheduleProposal")
+	govtypes.RegisterProposalTypeCodec(&RemoveScheduleProposal{}, "wasm/RemoveScheduleProposal")
 }
 
 // ProposalRoute returns the routing key of a parameter change proposal.
@@ -470,6 +482,139 @@ func (p UpdateContractStatusProposal) String() string {
 `, p.Title, p.Description, p.Contract, p.Status.String())
 }
 
+// ProposalRoute returns the routing key of a parameter change proposal.
+func (p UpdateInstantiateConfigProposal) ProposalRoute() string { return RouterKey }
+
+// GetTitle returns the title of the proposal
+func (p *UpdateInstantiateConfigProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the human readable description of the proposal
+func (p UpdateInstantiateConfigProposal) GetDescription() string { return p.Description }
+
+// ProposalType returns the type
+func (p UpdateInstantiateConfigProposal) ProposalType() string {
+	return string(ProposalTypeUpdateInstantiateConfig)
+}
+
+// ValidateBasic validates the proposal
+func (p UpdateInstantiateConfigProposal) ValidateBasic() error {
+	if err := validateProposalCommons(p.Title, p.Description); err != nil {
+		return err
+	}
+	if len(p.AccessConfigUpdates) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "code updates")
+	}
+	dedup := make(map[uint64]bool)
+	for _, codeUpdate := range p.AccessConfigUpdates {
+		if codeUpdate.CodeID == 0 {
+			return sdkerrors.Wrap(ErrEmpty, "code id")
+		}
+		if dedup[codeUpdate.CodeID] {
+			return sdkerrors.Wrapf(ErrDuplicate, "duplicate code: %d", codeUpdate.CodeID)
+		}
+		dedup[codeUpdate.CodeID] = true
+		if err := codeUpdate.InstantiatePermission.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "instantiate permission")
+		}
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p UpdateInstantiateConfigProposal) String() string {
+	return fmt.Sprintf(`Update Instantiate Config Proposal:
+  Title:       %s
+  Description: %s
+  AccessConfigUpdates: %v
+`, p.Title, p.Description, p.AccessConfigUpdates)
+}
+
+// ProposalRoute returns the routing key of a parameter change proposal.
+func (p AddScheduleProposal) ProposalRoute() string { return RouterKey }
+
+// GetTitle returns the title of the proposal
+func (p *AddScheduleProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the human readable description of the proposal
+func (p AddScheduleProposal) GetDescription() string { return p.Description }
+
+// ProposalType returns the type
+func (p AddScheduleProposal) ProposalType() string { return string(ProposalTypeAddSchedule) }
+
+// ValidateBasic validates the proposal
+func (p AddScheduleProposal) ValidateBasic() error {
+	if err := validateProposalCommons(p.Title, p.Description); err != nil {
+		return err
+	}
+	return validateScheduleArgs(p.Contract, p.Msg, p.Interval, p.StartHeight, p.GasLimit)
+}
+
+// String implements the Stringer interface.
+func (p AddScheduleProposal) String() string {
+	return fmt.Sprintf(`Add Schedule Proposal:
+  Title:       %s
+  Description: %s
+  Contract:    %s
+  Msg:         %q
+  Interval:    %d
+  StartHeight: %d
+  GasLimit:    %d
+`, p.Title, p.Description, p.Contract, p.Msg, p.Interval, p.StartHeight, p.GasLimit)
+}
+
+// MarshalYAML pretty prints the sudo message
+func (p AddScheduleProposal) MarshalYAML() (interface{}, error) {
+	return struct {
+		Title       string `yaml:"title"`
+		Description string `yaml:"description"`
+		Contract    string `yaml:"contract"`
+		Msg         string `yaml:"msg"`
+		Interval    uint64 `yaml:"interval"`
+		StartHeight int64  `yaml:"start_height"`
+		GasLimit    uint64 `yaml:"gas_limit"`
+	}{
+		Title:       p.Title,
+		Description: p.Description,
+		Contract:    p.Contract,
+		Msg:         string(p.Msg),
+		Interval:    p.Interval,
+		StartHeight: p.StartHeight,
+		GasLimit:    p.GasLimit,
+	}, nil
+}
+
+// ProposalRoute returns the routing key of a parameter change proposal.
+func (p RemoveScheduleProposal) ProposalRoute() string { return RouterKey }
+
+// GetTitle returns the title of the proposal
+func (p *RemoveScheduleProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the human readable description of the proposal
+func (p RemoveScheduleProposal) GetDescription() string { return p.Description }
+
+// ProposalType returns the type
+func (p RemoveScheduleProposal) ProposalType() string { return string(ProposalTypeRemoveSchedule) }
+
+// ValidateBasic validates the proposal
+func (p RemoveScheduleProposal) ValidateBasic() error {
+	if err := validateProposalCommons(p.Title, p.Description); err != nil {
+		return err
+	}
+	if p.ScheduleID == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "schedule id")
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p RemoveScheduleProposal) String() string {
+	return fmt.Sprintf(`Remove Schedule Proposal:
+  Title:       %s
+  Description: %s
+  Schedule id: %d
+`, p.Title, p.Description, p.ScheduleID)
+}
+
 func validateProposalCommons(title, description string) error {
 	if strings.TrimSpace(title) != title {
 		return sdkerrors.Wrap(govtypes.ErrInvalidProposalContent, "proposal title must not start/end with white spaces")
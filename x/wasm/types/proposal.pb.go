@@ -5,6 +5,7 @@ package types
 
 import (
 	bytes "bytes"
+	encoding_json "encoding/json"
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
@@ -390,6 +391,187 @@ func (m *UpdateContractStatusProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpdateContractStatusProposal proto.InternalMessageInfo
 
+// AccessConfigUpdate contains the code id and the access config to be
+// applied.
+type AccessConfigUpdate struct {
+	// CodeID is the reference to the stored WASM code to be updated
+	CodeID uint64 `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// InstantiatePermission to apply to the set of code ids
+	InstantiatePermission AccessConfig `protobuf:"bytes,2,opt,name=instantiate_permission,json=instantiatePermission,proto3" json:"instantiate_permission"`
+}
+
+func (m *AccessConfigUpdate) Reset()         { *m = AccessConfigUpdate{} }
+func (m *AccessConfigUpdate) String() string { return proto.CompactTextString(m) }
+func (*AccessConfigUpdate) ProtoMessage()    {}
+func (*AccessConfigUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6428c760f8f86eed, []int{8}
+}
+func (m *AccessConfigUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccessConfigUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccessConfigUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccessConfigUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessConfigUpdate.Merge(m, src)
+}
+func (m *AccessConfigUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccessConfigUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessConfigUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessConfigUpdate proto.InternalMessageInfo
+
+// UpdateInstantiateConfigProposal gov proposal content type to update
+// instantiate config to a set of code ids.
+type UpdateInstantiateConfigProposal struct {
+	// Title is a short summary
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	// Description is a human readable text
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	// AccessConfigUpdates contains the list of code ids and the access config
+	// to be applied.
+	AccessConfigUpdates []AccessConfigUpdate `protobuf:"bytes,3,rep,name=access_config_updates,json=accessConfigUpdates,proto3" json:"access_config_updates"`
+}
+
+func (m *UpdateInstantiateConfigProposal) Reset()      { *m = UpdateInstantiateConfigProposal{} }
+func (*UpdateInstantiateConfigProposal) ProtoMessage() {}
+func (*UpdateInstantiateConfigProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6428c760f8f86eed, []int{9}
+}
+func (m *UpdateInstantiateConfigProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateInstantiateConfigProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateInstantiateConfigProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateInstantiateConfigProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateInstantiateConfigProposal.Merge(m, src)
+}
+func (m *UpdateInstantiateConfigProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateInstantiateConfigProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateInstantiateConfigProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateInstantiateConfigProposal proto.InternalMessageInfo
+
+// AddScheduleProposal gov proposal content type to schedule executions of the
+// sudo entry point of a smart contract.
+type AddScheduleProposal struct {
+	// Title is a short summary
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	// Description is a human readable text
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	// Contract is the address of the smart contract
+	Contract string `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty" yaml:"contract"`
+	// Msg json encoded message to be passed to the sudo entry point
+	Msg encoding_json.RawMessage `protobuf:"bytes,4,opt,name=msg,proto3,casttype=encoding/json.RawMessage" json:"msg,omitempty"`
+	// Interval is the number of blocks between two executions, zero for a
+	// single execution
+	Interval uint64 `protobuf:"varint,5,opt,name=interval,proto3" json:"interval,omitempty" yaml:"interval"`
+	// StartHeight is the block height of the first execution, optional when an
+	// interval is set
+	StartHeight int64 `protobuf:"varint,6,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty" yaml:"start_height"`
+	// GasLimit is the max gas an execution can consume
+	GasLimit uint64 `protobuf:"varint,7,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty" yaml:"gas_limit"`
+}
+
+func (m *AddScheduleProposal) Reset()      { *m = AddScheduleProposal{} }
+func (*AddScheduleProposal) ProtoMessage() {}
+func (*AddScheduleProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6428c760f8f86eed, []int{10}
+}
+func (m *AddScheduleProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddScheduleProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddScheduleProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddScheduleProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddScheduleProposal.Merge(m, src)
+}
+func (m *AddScheduleProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddScheduleProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddScheduleProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddScheduleProposal proto.InternalMessageInfo
+
+// RemoveScheduleProposal gov proposal content type to remove a schedule.
+type RemoveScheduleProposal struct {
+	// Title is a short summary
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	// Description is a human readable text
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	// ScheduleID is the unique identifier of the schedule
+	ScheduleID uint64 `protobuf:"varint,3,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty" yaml:"schedule_id"`
+}
+
+func (m *RemoveScheduleProposal) Reset()      { *m = RemoveScheduleProposal{} }
+func (*RemoveScheduleProposal) ProtoMessage() {}
+func (*RemoveScheduleProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6428c760f8f86eed, []int{11}
+}
+func (m *RemoveScheduleProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveScheduleProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveScheduleProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoveScheduleProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveScheduleProposal.Merge(m, src)
+}
+func (m *RemoveScheduleProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveScheduleProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveScheduleProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveScheduleProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*StoreCodeProposal)(nil), "cosmwasm.wasm.v1beta1.StoreCodeProposal")
 	proto.RegisterType((*InstantiateContractProposal)(nil), "cosmwasm.wasm.v1beta1.InstantiateContractProposal")
@@ -399,6 +581,10 @@ func init() {
 	proto.RegisterType((*PinCodesProposal)(nil), "cosmwasm.wasm.v1beta1.PinCodesProposal")
 	proto.RegisterType((*UnpinCodesProposal)(nil), "cosmwasm.wasm.v1beta1.UnpinCodesProposal")
 	proto.RegisterType((*UpdateContractStatusProposal)(nil), "cosmwasm.wasm.v1beta1.UpdateContractStatusProposal")
+	proto.RegisterType((*AccessConfigUpdate)(nil), "cosmwasm.wasm.v1beta1.AccessConfigUpdate")
+	proto.RegisterType((*UpdateInstantiateConfigProposal)(nil), "cosmwasm.wasm.v1beta1.UpdateInstantiateConfigProposal")
+	proto.RegisterType((*AddScheduleProposal)(nil), "cosmwasm.wasm.v1beta1.AddScheduleProposal")
+	proto.RegisterType((*RemoveScheduleProposal)(nil), "cosmwasm.wasm.v1beta1.RemoveScheduleProposal")
 }
 
 func init() {
@@ -406,53 +592,69 @@ func init() {
 }
 
 var fileDescriptor_6428c760f8f86eed = []byte{
-	// 732 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xdd, 0x6a, 0x13, 0x41,
-	0x14, 0xce, 0x36, 0xcd, 0x26, 0x9d, 0x84, 0x1a, 0xd7, 0x34, 0xae, 0xad, 0xec, 0xc6, 0x6d, 0x95,
-	0x80, 0x98, 0xd0, 0x0a, 0xa2, 0x82, 0x17, 0xd9, 0x78, 0x53, 0x21, 0x50, 0xb6, 0x14, 0xb1, 0x37,
-	0x61, 0x7f, 0xa6, 0xdb, 0xc1, 0xdd, 0x99, 0x65, 0x67, 0x62, 0xcd, 0x5b, 0xf8, 0x00, 0x3e, 0x40,
-	0xf1, 0x46, 0xc4, 0x97, 0x28, 0x5e, 0xf5, 0xb2, 0x57, 0xab, 0x4d, 0xdf, 0x20, 0x4f, 0x20, 0x3b,
-	0xb3, 0x89, 0xa9, 0xb4, 0x22, 0x68, 0x05, 0x6f, 0x96, 0x9c, 0x39, 0xdf, 0x9c, 0xef, 0x9b, 0xef,
-	0x9c, 0xcc, 0x80, 0x35, 0x97, 0xd0, 0xf0, 0xc0, 0xa6, 0x61, 0x9b, 0x7f, 0xde, 0xac, 0x3b, 0x90,
-	0xd9, 0xeb, 0xed, 0x28, 0x26, 0x11, 0xa1, 0x76, 0xd0, 0x8a, 0x62, 0xc2, 0x88, 0xb2, 0x34, 0x41,
-	0xb5, 0xf8, 0x27, 0x43, 0x2d, 0xd7, 0x7c, 0xe2, 0x13, 0x8e, 0x68, 0xa7, 0xbf, 0x04, 0x78, 0x79,
-	0x25, 0x70, 0xc2, 0xb6, 0x63, 0x53, 0x38, 0xad, 0xe6, 0x12, 0x84, 0xb3, 0xe4, 0x9d, 0x8b, 0xf9,
-	0xd8, 0x30, 0x82, 0x54, 0x40, 0x8c, 0xc3, 0x39, 0x70, 0x7d, 0x9b, 0x91, 0x18, 0x76, 0x89, 0x07,
-	0xb7, 0x32, 0x21, 0x4a, 0x0d, 0x14, 0x18, 0x62, 0x01, 0x54, 0xa5, 0x86, 0xd4, 0x5c, 0xb0, 0x44,
-	0xa0, 0x34, 0x40, 0xd9, 0x83, 0xd4, 0x8d, 0x51, 0xc4, 0x10, 0xc1, 0xea, 0x1c, 0xcf, 0xcd, 0x2e,
-	0x29, 0x4b, 0x40, 0x8e, 0x07, 0xb8, 0x6f, 0x53, 0x35, 0x2f, 0x36, 0xc6, 0x03, 0xdc, 0xa1, 0xca,
-	0x23, 0xb0, 0x98, 0x0a, 0xe8, 0x3b, 0x43, 0x06, 0xfb, 0x2e, 0xf1, 0xa0, 0x3a, 0xdf, 0x90, 0x9a,
-	0x15, 0xb3, 0x3a, 0x4a, 0xf4, 0xca, 0xcb, 0xce, 0x76, 0xcf, 0x1c, 0x32, 0x2e, 0xc0, 0xaa, 0xa4,
-	0xb8, 0x49, 0xa4, 0xd4, 0x81, 0x4c, 0xc9, 0x20, 0x76, 0xa1, 0x5a, 0xe0, 0xe5, 0xb2, 0x48, 0x51,
-	0x41, 0xd1, 0x19, 0xa0, 0xc0, 0x83, 0xb1, 0x2a, 0xf3, 0xc4, 0x24, 0x54, 0x76, 0x41, 0x1d, 0x61,
-	0xca, 0x6c, 0xcc, 0x90, 0xcd, 0x60, 0x3f, 0x82, 0x71, 0x88, 0x28, 0x4d, 0xd5, 0x16, 0x1b, 0x52,
-	0xb3, 0xbc, 0xb1, 0xda, 0xba, 0xd0, 0xdc, 0x56, 0xc7, 0x75, 0x21, 0xa5, 0x5d, 0x82, 0xf7, 0x90,
-	0x6f, 0x2d, 0xcd, 0x94, 0xd8, 0x9a, 0x56, 0x30, 0x3e, 0xcf, 0x81, 0x95, 0xcd, 0x1f, 0x99, 0x2e,
-	0xc1, 0x2c, 0xb6, 0x5d, 0x76, 0x55, 0xa6, 0xd5, 0x40, 0xc1, 0xf6, 0x42, 0x84, 0xb9, 0x57, 0x0b,
-	0x96, 0x08, 0x94, 0x55, 0x50, 0x4c, 0x0d, 0xec, 0x23, 0x8f, 0x7b, 0x32, 0x6f, 0x82, 0x51, 0xa2,
-	0xcb, 0xa9, 0x5b, 0x9b, 0xcf, 0x2d, 0x39, 0x4d, 0x6d, 0x7a, 0xe9, 0xd6, 0xc0, 0x76, 0x60, 0x90,
-	0xb9, 0x23, 0x02, 0xe5, 0x16, 0x28, 0x21, 0x8c, 0x58, 0x3f, 0xa4, 0x3e, 0x77, 0xa3, 0x62, 0x15,
-	0xd3, 0xb8, 0x47, 0x7d, 0xe5, 0x15, 0x28, 0xec, 0x0d, 0xb0, 0x47, 0xd5, 0x52, 0x23, 0xdf, 0x2c,
-	0x6f, 0xd4, 0x5b, 0x81, 0x13, 0xb6, 0xd2, 0xa9, 0x9a, 0x1a, 0xd4, 0x25, 0x08, 0x9b, 0xf7, 0x8f,
-	0x12, 0x3d, 0xf7, 0xe1, 0xab, 0xbe, 0xea, 0x23, 0xb6, 0x3f, 0x70, 0x5a, 0x2e, 0x09, 0xdb, 0x01,
-	0xc2, 0xb0, 0x1d, 0x38, 0xe1, 0x03, 0xea, 0xbd, 0xce, 0x26, 0x2b, 0xc5, 0x52, 0x4b, 0x54, 0x34,
-	0xbe, 0x48, 0xe0, 0x66, 0x0f, 0xf9, 0xf1, 0x3f, 0x70, 0x6c, 0x19, 0x94, 0xdc, 0x8c, 0x22, 0x33,
-	0x6d, 0x1a, 0xff, 0x9e, 0x6f, 0x3a, 0x28, 0x87, 0x42, 0x2a, 0x37, 0x49, 0xe6, 0x26, 0x81, 0x6c,
-	0xa9, 0x47, 0x7d, 0xe3, 0xbd, 0x04, 0x6e, 0xec, 0x44, 0x9e, 0xcd, 0x60, 0x27, 0xed, 0xc6, 0x1f,
-	0x1f, 0x64, 0x1d, 0x2c, 0x60, 0x78, 0xd0, 0x17, 0x7d, 0xe6, 0x67, 0x31, 0x6b, 0xe3, 0x44, 0xaf,
-	0x0e, 0xed, 0x30, 0x78, 0x6a, 0x4c, 0x53, 0x86, 0x55, 0xc2, 0xf0, 0x80, 0x53, 0xfe, 0xea, 0x90,
-	0xc6, 0x3e, 0x50, 0xba, 0x01, 0xb4, 0xe3, 0xbf, 0x23, 0x6e, 0x96, 0x29, 0xff, 0x13, 0xd3, 0x47,
-	0x09, 0x54, 0xb7, 0x10, 0x4e, 0xfd, 0xa3, 0x53, 0xa2, 0x7b, 0xe7, 0x88, 0xcc, 0xea, 0x38, 0xd1,
-	0x2b, 0xe2, 0x24, 0x7c, 0xd9, 0x98, 0x50, 0x3f, 0xbe, 0x80, 0xda, 0xac, 0x8f, 0x13, 0x5d, 0x11,
-	0xe8, 0x99, 0xa4, 0x71, 0x5e, 0xd2, 0x93, 0x54, 0x12, 0xef, 0x62, 0xda, 0xfa, 0x7c, 0x73, 0xde,
-	0xd4, 0x46, 0x89, 0x5e, 0x14, 0x6d, 0xa4, 0xe3, 0x44, 0xbf, 0x26, 0x2a, 0x4c, 0x40, 0x86, 0x55,
-	0x14, 0xad, 0xa5, 0xc6, 0x27, 0x09, 0x28, 0x3b, 0x38, 0xfa, 0xdf, 0x34, 0xdf, 0x16, 0xe3, 0x36,
-	0xf9, 0xeb, 0x6c, 0x33, 0x9b, 0x0d, 0xe8, 0x55, 0xb6, 0x56, 0x79, 0x06, 0x64, 0xca, 0x59, 0xf8,
-	0x78, 0x2d, 0x6e, 0xdc, 0xbd, 0xe4, 0xca, 0x3c, 0x2f, 0xc9, 0xca, 0x36, 0x99, 0x2f, 0x8e, 0x4e,
-	0xb5, 0xdc, 0xc9, 0xa9, 0x96, 0x3b, 0x1c, 0x69, 0xd2, 0xd1, 0x48, 0x93, 0x8e, 0x47, 0x9a, 0xf4,
-	0x6d, 0xa4, 0x49, 0xef, 0xce, 0xb4, 0xdc, 0xf1, 0x99, 0x96, 0x3b, 0x39, 0xd3, 0x72, 0xbb, 0x6b,
-	0x97, 0xdd, 0x20, 0x6f, 0xc5, 0x53, 0xc5, 0x2f, 0x12, 0x47, 0xe6, 0x6f, 0xd4, 0xc3, 0xef, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xa5, 0xd9, 0xdf, 0xeb, 0x38, 0x07, 0x00, 0x00,
+	// 988 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0x9b, 0x34, 0x49, 0x27, 0xd1, 0x52, 0xdc, 0x36, 0x1b, 0xba, 0x2b, 0x3b, 0xb8, 0x05,
+	0x05, 0x21, 0x12, 0xb5, 0x48, 0x08, 0x2a, 0x71, 0x88, 0xb3, 0x48, 0x04, 0x11, 0xa9, 0x72, 0xb5,
+	0x42, 0xec, 0xc5, 0x8c, 0xed, 0xa9, 0x33, 0x60, 0xcf, 0x44, 0x9e, 0x49, 0x4b, 0xff, 0x05, 0x47,
+	0x0e, 0x1c, 0xe0, 0xb6, 0xe2, 0x82, 0x10, 0xbf, 0x80, 0x5b, 0xc5, 0x69, 0x8f, 0x7b, 0x32, 0x6c,
+	0x7a, 0xe1, 0x9c, 0x13, 0xe2, 0x84, 0x66, 0xc6, 0xc9, 0xba, 0x4b, 0x83, 0x2a, 0xc1, 0x16, 0xed,
+	0x25, 0xf2, 0xf3, 0x7b, 0x7e, 0xdf, 0xf7, 0xbe, 0xf7, 0xe6, 0x65, 0xc0, 0xae, 0x4f, 0x59, 0x7c,
+	0x0a, 0x59, 0xdc, 0x95, 0x3f, 0x27, 0x7b, 0x1e, 0xe2, 0x70, 0xaf, 0x3b, 0x4e, 0xe8, 0x98, 0x32,
+	0x18, 0x75, 0xc6, 0x09, 0xe5, 0x54, 0xdf, 0x9a, 0x47, 0x75, 0xe4, 0x4f, 0x16, 0xb5, 0xbd, 0x19,
+	0xd2, 0x90, 0xca, 0x88, 0xae, 0x78, 0x52, 0xc1, 0xdb, 0x77, 0x22, 0x2f, 0xee, 0x7a, 0x90, 0xa1,
+	0x45, 0x36, 0x9f, 0x62, 0x92, 0x39, 0x5f, 0xbd, 0x1a, 0x8f, 0x9f, 0x8d, 0x11, 0x53, 0x21, 0xd6,
+	0xc3, 0x15, 0xf0, 0xf2, 0x11, 0xa7, 0x09, 0xea, 0xd3, 0x00, 0x1d, 0x66, 0x44, 0xf4, 0x4d, 0xb0,
+	0xca, 0x31, 0x8f, 0x50, 0x53, 0x6b, 0x69, 0xed, 0x35, 0x47, 0x19, 0x7a, 0x0b, 0xd4, 0x02, 0xc4,
+	0xfc, 0x04, 0x8f, 0x39, 0xa6, 0xa4, 0xb9, 0x22, 0x7d, 0xf9, 0x57, 0xfa, 0x16, 0x28, 0x27, 0x13,
+	0xe2, 0x42, 0xd6, 0x2c, 0xaa, 0x0f, 0x93, 0x09, 0xe9, 0x31, 0xfd, 0x1d, 0x70, 0x4b, 0x10, 0x70,
+	0xbd, 0x33, 0x8e, 0x5c, 0x9f, 0x06, 0xa8, 0x59, 0x6a, 0x69, 0xed, 0xba, 0xbd, 0x3e, 0x4d, 0xcd,
+	0xfa, 0x27, 0xbd, 0xa3, 0xa1, 0x7d, 0xc6, 0x25, 0x01, 0xa7, 0x2e, 0xe2, 0xe6, 0x96, 0xde, 0x00,
+	0x65, 0x46, 0x27, 0x89, 0x8f, 0x9a, 0xab, 0x32, 0x5d, 0x66, 0xe9, 0x4d, 0x50, 0xf1, 0x26, 0x38,
+	0x0a, 0x50, 0xd2, 0x2c, 0x4b, 0xc7, 0xdc, 0xd4, 0x1f, 0x80, 0x06, 0x26, 0x8c, 0x43, 0xc2, 0x31,
+	0xe4, 0xc8, 0x1d, 0xa3, 0x24, 0xc6, 0x8c, 0x09, 0xb6, 0x95, 0x96, 0xd6, 0xae, 0xed, 0xef, 0x74,
+	0xae, 0x14, 0xb7, 0xd3, 0xf3, 0x7d, 0xc4, 0x58, 0x9f, 0x92, 0x63, 0x1c, 0x3a, 0x5b, 0xb9, 0x14,
+	0x87, 0x8b, 0x0c, 0xd6, 0x4f, 0x2b, 0xe0, 0xce, 0xe0, 0xa9, 0xa7, 0x4f, 0x09, 0x4f, 0xa0, 0xcf,
+	0x9f, 0x97, 0x68, 0x9b, 0x60, 0x15, 0x06, 0x31, 0x26, 0x52, 0xab, 0x35, 0x47, 0x19, 0xfa, 0x0e,
+	0xa8, 0x08, 0x01, 0x5d, 0x1c, 0x48, 0x4d, 0x4a, 0x36, 0x98, 0xa6, 0x66, 0x59, 0xa8, 0x35, 0xb8,
+	0xe7, 0x94, 0x85, 0x6b, 0x10, 0x88, 0x4f, 0x23, 0xe8, 0xa1, 0x28, 0x53, 0x47, 0x19, 0xfa, 0x2b,
+	0xa0, 0x8a, 0x09, 0xe6, 0x6e, 0xcc, 0x42, 0xa9, 0x46, 0xdd, 0xa9, 0x08, 0x7b, 0xc8, 0x42, 0xfd,
+	0x53, 0xb0, 0x7a, 0x3c, 0x21, 0x01, 0x6b, 0x56, 0x5b, 0xc5, 0x76, 0x6d, 0xbf, 0xd1, 0x89, 0xbc,
+	0xb8, 0x23, 0xa6, 0x6a, 0x21, 0x50, 0x9f, 0x62, 0x62, 0xbf, 0x79, 0x9e, 0x9a, 0x85, 0xef, 0x7f,
+	0x35, 0x77, 0x42, 0xcc, 0x47, 0x13, 0xaf, 0xe3, 0xd3, 0xb8, 0x1b, 0x61, 0x82, 0xba, 0x91, 0x17,
+	0xbf, 0xc5, 0x82, 0x2f, 0xb2, 0xc9, 0x12, 0xb1, 0xcc, 0x51, 0x19, 0xad, 0x5f, 0x34, 0x70, 0x7b,
+	0x88, 0xc3, 0xe4, 0x06, 0x14, 0xdb, 0x06, 0x55, 0x3f, 0x83, 0xc8, 0x44, 0x5b, 0xd8, 0xd7, 0xd3,
+	0xcd, 0x04, 0xb5, 0x58, 0x51, 0x95, 0x22, 0x95, 0xa5, 0x48, 0x20, 0x7b, 0x35, 0x64, 0xa1, 0xf5,
+	0x8d, 0x06, 0x36, 0xee, 0x8f, 0x03, 0xc8, 0x51, 0x4f, 0x74, 0xe3, 0x5f, 0x17, 0xb2, 0x07, 0xd6,
+	0x08, 0x3a, 0x75, 0x55, 0x9f, 0x65, 0x2d, 0xf6, 0xe6, 0x2c, 0x35, 0xd7, 0xcf, 0x60, 0x1c, 0x1d,
+	0x58, 0x0b, 0x97, 0xe5, 0x54, 0x09, 0x3a, 0x95, 0x90, 0xff, 0x54, 0xa4, 0x35, 0x02, 0x7a, 0x3f,
+	0x42, 0x30, 0xf9, 0x6f, 0xc8, 0xe5, 0x91, 0x8a, 0xcf, 0x20, 0xfd, 0xa0, 0x81, 0xf5, 0x43, 0x4c,
+	0x84, 0x7e, 0x6c, 0x01, 0xf4, 0xfa, 0x25, 0x20, 0x7b, 0x7d, 0x96, 0x9a, 0x75, 0x55, 0x89, 0x7c,
+	0x6d, 0xcd, 0xa1, 0xdf, 0xbd, 0x02, 0xda, 0x6e, 0xcc, 0x52, 0x53, 0x57, 0xd1, 0x39, 0xa7, 0x75,
+	0x99, 0xd2, 0x7b, 0xa0, 0x9a, 0x75, 0x51, 0xb4, 0xbe, 0xd8, 0x2e, 0xd9, 0xc6, 0x34, 0x35, 0x2b,
+	0xaa, 0x8d, 0x6c, 0x96, 0x9a, 0x2f, 0xa9, 0x0c, 0xf3, 0x20, 0xcb, 0xa9, 0xa8, 0xd6, 0x32, 0xeb,
+	0x47, 0x0d, 0xe8, 0xf7, 0xc9, 0xf8, 0x45, 0xe3, 0x7c, 0x57, 0x8d, 0xdb, 0xfc, 0xe8, 0x1c, 0x71,
+	0xc8, 0x27, 0xec, 0x79, 0xb6, 0x56, 0x7f, 0x1f, 0x94, 0x99, 0x44, 0x91, 0xe3, 0x75, 0x6b, 0xff,
+	0xb5, 0x25, 0x2b, 0xf3, 0x32, 0x25, 0x27, 0xfb, 0xc8, 0xfa, 0x4e, 0x03, 0x7a, 0x7e, 0x9b, 0x2a,
+	0xfe, 0xf9, 0xf3, 0xa7, 0x2d, 0x3d, 0x7f, 0x9f, 0x2d, 0xdd, 0xde, 0x2b, 0xd7, 0xde, 0xde, 0x76,
+	0x49, 0x2c, 0xa9, 0x25, 0x3b, 0xfc, 0xa0, 0xf4, 0xf5, 0xb7, 0xa6, 0x66, 0xfd, 0xae, 0x01, 0x53,
+	0xf1, 0xba, 0xbc, 0xcf, 0x8f, 0x71, 0x78, 0x83, 0x83, 0xe1, 0x83, 0x2d, 0x28, 0x89, 0xbb, 0xbe,
+	0x84, 0x76, 0x27, 0x92, 0x92, 0x9a, 0x92, 0xda, 0xfe, 0x1b, 0xd7, 0x28, 0x56, 0x15, 0x91, 0x95,
+	0xbc, 0x01, 0xff, 0xe6, 0x61, 0xd6, 0x1f, 0x2b, 0x60, 0xa3, 0x17, 0x04, 0x47, 0xfe, 0x08, 0x05,
+	0x93, 0x08, 0xdd, 0x60, 0x79, 0xdd, 0x67, 0x67, 0xcc, 0xde, 0xc8, 0x0f, 0xbb, 0xf2, 0x58, 0xb9,
+	0xc1, 0xeb, 0x80, 0xa2, 0xd8, 0xba, 0xea, 0x6a, 0x70, 0xf7, 0xcf, 0xd4, 0x6c, 0x22, 0xe2, 0xd3,
+	0x00, 0x93, 0xb0, 0xfb, 0x39, 0xa3, 0xa4, 0xe3, 0xc0, 0xd3, 0x21, 0x62, 0x0c, 0x86, 0xc8, 0x11,
+	0x81, 0x02, 0x00, 0x13, 0x8e, 0x92, 0x13, 0x18, 0x65, 0x3b, 0x3d, 0x07, 0x30, 0xf7, 0x58, 0xce,
+	0x22, 0x48, 0x3f, 0x00, 0x75, 0xc6, 0x61, 0xc2, 0xdd, 0x11, 0xc2, 0xe1, 0x88, 0xcb, 0xfd, 0x5e,
+	0xb4, 0x6f, 0xcf, 0x52, 0x73, 0x43, 0x7d, 0x94, 0xf7, 0x5a, 0x4e, 0x4d, 0x9a, 0x1f, 0x4a, 0x4b,
+	0x6c, 0xea, 0x10, 0x32, 0x37, 0xc2, 0x31, 0xe6, 0xf2, 0xdf, 0xb3, 0x94, 0xdf, 0xd4, 0x0b, 0x97,
+	0xe5, 0x54, 0x43, 0xc8, 0x3e, 0x96, 0x8f, 0x3f, 0x6b, 0xa0, 0xe1, 0xa0, 0x98, 0x9e, 0xa0, 0xff,
+	0x41, 0xfd, 0x0f, 0x40, 0x8d, 0x65, 0xa8, 0xe2, 0xcc, 0x15, 0x25, 0xe3, 0xdd, 0x69, 0x6a, 0x82,
+	0x39, 0x99, 0xc1, 0xbd, 0xa7, 0x79, 0x72, 0xa1, 0x96, 0x03, 0xe6, 0xd6, 0x20, 0xb0, 0x3f, 0x3a,
+	0x7f, 0x62, 0x14, 0x1e, 0x3f, 0x31, 0x0a, 0x0f, 0xa7, 0x86, 0x76, 0x3e, 0x35, 0xb4, 0x47, 0x53,
+	0x43, 0xfb, 0x6d, 0x6a, 0x68, 0x5f, 0x5d, 0x18, 0x85, 0x47, 0x17, 0x46, 0xe1, 0xf1, 0x85, 0x51,
+	0x78, 0xb0, 0xbb, 0xec, 0x3e, 0xf0, 0xa5, 0xba, 0x78, 0xca, 0x6b, 0x81, 0x57, 0x96, 0x37, 0xce,
+	0xb7, 0xff, 0x1a, 0x00, 0xa6, 0xe5, 0xa5, 0x29, 0x06, 0x0b, 0x00, 0x00,
 }
 
 func (this *StoreCodeProposal) Equal(that interface{}) bool {
@@ -752,6 +954,140 @@ func (this *UpdateContractStatusProposal) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *AccessConfigUpdate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccessConfigUpdate)
+	if !ok {
+		that2, ok := that.(AccessConfigUpdate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CodeID != that1.CodeID {
+		return false
+	}
+	if !this.InstantiatePermission.Equal(&that1.InstantiatePermission) {
+		return false
+	}
+	return true
+}
+func (this *UpdateInstantiateConfigProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpdateInstantiateConfigProposal)
+	if !ok {
+		that2, ok := that.(UpdateInstantiateConfigProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.AccessConfigUpdates) != len(that1.AccessConfigUpdates) {
+		return false
+	}
+	for i := range this.AccessConfigUpdates {
+		if !this.AccessConfigUpdates[i].Equal(&that1.AccessConfigUpdates[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *AddScheduleProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AddScheduleProposal)
+	if !ok {
+		that2, ok := that.(AddScheduleProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	if !bytes.Equal(this.Msg, that1.Msg) {
+		return false
+	}
+	if this.Interval != that1.Interval {
+		return false
+	}
+	if this.StartHeight != that1.StartHeight {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	return true
+}
+func (this *RemoveScheduleProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RemoveScheduleProposal)
+	if !ok {
+		that2, ok := that.(RemoveScheduleProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.ScheduleID != that1.ScheduleID {
+		return false
+	}
+	return true
+}
 func (m *StoreCodeProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1230,40 +1566,237 @@ func (m *UpdateContractStatusProposal) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintProposal(dAtA []byte, offset int, v uint64) int {
-	offset -= sovProposal(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *AccessConfigUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *StoreCodeProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *AccessConfigUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessConfigUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
-	}
-	l = len(m.RunAs)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	{
+		size, err := m.InstantiatePermission.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProposal(dAtA, i, uint64(size))
 	}
-	l = len(m.WASMByteCode)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if m.CodeID != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.Source)
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateInstantiateConfigProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateInstantiateConfigProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateInstantiateConfigProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AccessConfigUpdates) > 0 {
+		for iNdEx := len(m.AccessConfigUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccessConfigUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddScheduleProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddScheduleProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddScheduleProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Interval != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveScheduleProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveScheduleProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveScheduleProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ScheduleID != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.ScheduleID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProposal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProposal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StoreCodeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.RunAs)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.WASMByteCode)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Source)
 	if l > 0 {
 		n += 1 + l + sovProposal(uint64(l))
 	}
@@ -1470,6 +2003,97 @@ func (m *UpdateContractStatusProposal) Size() (n int) {
 	return n
 }
 
+func (m *AccessConfigUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeID != 0 {
+		n += 1 + sovProposal(uint64(m.CodeID))
+	}
+	l = m.InstantiatePermission.Size()
+	n += 1 + l + sovProposal(uint64(l))
+	return n
+}
+
+func (m *UpdateInstantiateConfigProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.AccessConfigUpdates) > 0 {
+		for _, e := range m.AccessConfigUpdates {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AddScheduleProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovProposal(uint64(m.Interval))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovProposal(uint64(m.StartHeight))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovProposal(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *RemoveScheduleProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.ScheduleID != 0 {
+		n += 1 + sovProposal(uint64(m.ScheduleID))
+	}
+	return n
+}
+
 func sovProposal(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3153,6 +3777,626 @@ func (m *UpdateContractStatusProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AccessConfigUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessConfigUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessConfigUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantiatePermission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InstantiatePermission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateInstantiateConfigProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateInstantiateConfigProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateInstantiateConfigProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessConfigUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessConfigUpdates = append(m.AccessConfigUpdates, AccessConfigUpdate{})
+			if err := m.AccessConfigUpdates[len(m.AccessConfigUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddScheduleProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddScheduleProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddScheduleProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveScheduleProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveScheduleProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveScheduleProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleID", wireType)
+			}
+			m.ScheduleID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipProposal(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
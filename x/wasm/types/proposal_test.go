@@ -500,6 +500,161 @@ func TestValidateUpdateContractStatusProposal(t *testing.T) {
 	}
 }
 
+func TestValidateUpdateInstantiateConfigProposal(t *testing.T) {
+	specs := map[string]struct {
+		src    *UpdateInstantiateConfigProposal
+		expErr bool
+	}{
+		"all good": {
+			src: UpdateInstantiateConfigProposalFixture(),
+		},
+		"base data missing": {
+			src: UpdateInstantiateConfigProposalFixture(func(p *UpdateInstantiateConfigProposal) {
+				p.Title = ""
+			}),
+			expErr: true,
+		},
+		"updates missing": {
+			src: UpdateInstantiateConfigProposalFixture(func(p *UpdateInstantiateConfigProposal) {
+				p.AccessConfigUpdates = nil
+			}),
+			expErr: true,
+		},
+		"code id missing": {
+			src: UpdateInstantiateConfigProposalFixture(func(p *UpdateInstantiateConfigProposal) {
+				p.AccessConfigUpdates[0].CodeID = 0
+			}),
+			expErr: true,
+		},
+		"duplicate code id": {
+			src: UpdateInstantiateConfigProposalFixture(func(p *UpdateInstantiateConfigProposal) {
+				p.AccessConfigUpdates = append(p.AccessConfigUpdates, AccessConfigUpdate{
+					CodeID:                p.AccessConfigUpdates[0].CodeID,
+					InstantiatePermission: AllowNobody,
+				})
+			}),
+			expErr: true,
+		},
+		"invalid permission": {
+			src: UpdateInstantiateConfigProposalFixture(func(p *UpdateInstantiateConfigProposal) {
+				p.AccessConfigUpdates[0].InstantiatePermission = AccessConfig{Permission: AccessTypeAnyOfAddresses}
+			}),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateAddScheduleProposal(t *testing.T) {
+	specs := map[string]struct {
+		src    *AddScheduleProposal
+		expErr bool
+	}{
+		"all good": {
+			src: AddScheduleProposalFixture(),
+		},
+		"without start height": {
+			src: AddScheduleProposalFixture(func(p *AddScheduleProposal) {
+				p.StartHeight = 0
+			}),
+		},
+		"without interval": {
+			src: AddScheduleProposalFixture(func(p *AddScheduleProposal) {
+				p.Interval = 0
+			}),
+		},
+		"base data missing": {
+			src: AddScheduleProposalFixture(func(p *AddScheduleProposal) {
+				p.Title = ""
+			}),
+			expErr: true,
+		},
+		"contract invalid": {
+			src: AddScheduleProposalFixture(func(p *AddScheduleProposal) {
+				p.Contract = "invalid address"
+			}),
+			expErr: true,
+		},
+		"msg not json": {
+			src: AddScheduleProposalFixture(func(p *AddScheduleProposal) {
+				p.Msg = []byte("invalid json")
+			}),
+			expErr: true,
+		},
+		"neither interval nor start height": {
+			src: AddScheduleProposalFixture(func(p *AddScheduleProposal) {
+				p.Interval = 0
+				p.StartHeight = 0
+			}),
+			expErr: true,
+		},
+		"gas limit missing": {
+			src: AddScheduleProposalFixture(func(p *AddScheduleProposal) {
+				p.GasLimit = 0
+			}),
+			expErr: true,
+		},
+		"gas limit below min": {
+			src: AddScheduleProposalFixture(func(p *AddScheduleProposal) {
+				p.GasLimit = MinScheduleGasLimit - 1
+			}),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateRemoveScheduleProposal(t *testing.T) {
+	specs := map[string]struct {
+		src    *RemoveScheduleProposal
+		expErr bool
+	}{
+		"all good": {
+			src: RemoveScheduleProposalFixture(),
+		},
+		"base data missing": {
+			src: RemoveScheduleProposalFixture(func(p *RemoveScheduleProposal) {
+				p.Title = ""
+			}),
+			expErr: true,
+		},
+		"schedule id missing": {
+			src: RemoveScheduleProposalFixture(func(p *RemoveScheduleProposal) {
+				p.ScheduleID = 0
+			}),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestProposalStrings(t *testing.T) {
 	specs := map[string]struct {
 		src govtypes.Content
@@ -609,6 +764,26 @@ func TestProposalStrings(t *testing.T) {
   Title:       Foo
   Description: Bar
   Codes:       [3 2 1]
+`,
+		},
+		"add schedule": {
+			src: AddScheduleProposalFixture(),
+			exp: `Add Schedule Proposal:
+  Title:       Foo
+  Description: Bar
+  Contract:    link1hcttwju93d5m39467gjcq63p5kc4fdcn30dgd8
+  Msg:         "{\"foo\":\"bar\"}"
+  Interval:    10
+  StartHeight: 100
+  GasLimit:    100000
+`,
+		},
+		"remove schedule": {
+			src: RemoveScheduleProposalFixture(),
+			exp: `Remove Schedule Proposal:
+  Title:       Foo
+  Description: Bar
+  Schedule id: 1
 `,
 		},
 	}
@@ -716,6 +891,17 @@ code_ids:
 - 1
 - 2
 - 3
+`,
+		},
+		"add schedule": {
+			src: AddScheduleProposalFixture(),
+			exp: `title: Foo
+description: Bar
+contract: link1hcttwju93d5m39467gjcq63p5kc4fdcn30dgd8
+msg: '{"foo":"bar"}'
+interval: 10
+start_height: 100
+gas_limit: 100000
 `,
 		},
 	}
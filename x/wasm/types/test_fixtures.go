@@ -310,3 +310,50 @@ func UpdateContractStatusProposalFixture(mutators ...func(p *UpdateContractStatu
 	}
 	return p
 }
+
+func UpdateInstantiateConfigProposalFixture(mutators ...func(p *UpdateInstantiateConfigProposal)) *UpdateInstantiateConfigProposal {
+	const anyAddress = "link1qyqszqgpqyqszqgpqyqszqgpqyqszqgp8apuk5"
+
+	p := &UpdateInstantiateConfigProposal{
+		Title:       "Foo",
+		Description: "Bar",
+		AccessConfigUpdates: []AccessConfigUpdate{{
+			CodeID:                1,
+			InstantiatePermission: AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{anyAddress}},
+		}},
+	}
+	for _, m := range mutators {
+		m(p)
+	}
+	return p
+}
+
+func AddScheduleProposalFixture(mutators ...func(p *AddScheduleProposal)) *AddScheduleProposal {
+	const contractAddr = "link1hcttwju93d5m39467gjcq63p5kc4fdcn30dgd8"
+
+	p := &AddScheduleProposal{
+		Title:       "Foo",
+		Description: "Bar",
+		Contract:    contractAddr,
+		Msg:         []byte(`{"foo":"bar"}`),
+		Interval:    10,
+		StartHeight: 100,
+		GasLimit:    100_000,
+	}
+	for _, m := range mutators {
+		m(p)
+	}
+	return p
+}
+
+func RemoveScheduleProposalFixture(mutators ...func(p *RemoveScheduleProposal)) *RemoveScheduleProposal {
+	p := &RemoveScheduleProposal{
+		Title:       "Foo",
+		Description: "Bar",
+		ScheduleID:  1,
+	}
+	for _, m := range mutators {
+		m(p)
+	}
+	return p
+}
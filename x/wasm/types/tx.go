@@ -98,6 +98,54 @@ func (msg MsgInstantiateContract) GetSigners() []sdk.AccAddress {
 
 }
 
+func (msg MsgInstantiateContract2) Route() string {
+	return RouterKey
+}
+
+func (msg MsgInstantiateContract2) Type() string {
+	return "instantiate2"
+}
+
+func (msg MsgInstantiateContract2) ValidateBasic() error {
+	if err := sdk.ValidateAccAddress(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+
+	if msg.CodeID == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "code id is required")
+	}
+
+	if err := validateLabel(msg.Label); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "label is required")
+	}
+
+	if !msg.Funds.IsValid() {
+		return sdkerrors.ErrInvalidCoins
+	}
+
+	if len(msg.Admin) != 0 {
+		if err := sdk.ValidateAccAddress(msg.Admin); err != nil {
+			return sdkerrors.Wrap(err, "admin")
+		}
+	}
+	if err := ValidateSalt(msg.Salt); err != nil {
+		return sdkerrors.Wrap(err, "salt")
+	}
+	if !json.Valid(msg.InitMsg) {
+		return sdkerrors.Wrap(ErrInvalid, "init msg json")
+	}
+	return nil
+}
+
+func (msg MsgInstantiateContract2) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgInstantiateContract2) GetSigners() []sdk.AccAddress {
+	senderAddr := sdk.AccAddress(msg.Sender)
+	return []sdk.AccAddress{senderAddr}
+}
+
 func (msg MsgStoreCodeAndInstantiateContract) Route() string {
 	return RouterKey
 }
@@ -332,6 +380,90 @@ func (msg MsgUpdateContractStatus) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{senderAddr}
 }
 
+func (msg MsgUpdateInstantiateConfig) Route() string {
+	return RouterKey
+}
+
+func (msg MsgUpdateInstantiateConfig) Type() string {
+	return "update-instantiate-config"
+}
+
+func (msg MsgUpdateInstantiateConfig) ValidateBasic() error {
+	if err := sdk.ValidateAccAddress(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if msg.CodeID == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "code id is required")
+	}
+	if msg.NewInstantiatePermission == nil {
+		return sdkerrors.Wrap(ErrEmpty, "instantiate permission")
+	}
+	if err := msg.NewInstantiatePermission.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "instantiate permission")
+	}
+	return nil
+}
+
+func (msg MsgUpdateInstantiateConfig) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUpdateInstantiateConfig) GetSigners() []sdk.AccAddress {
+	senderAddr := sdk.AccAddress(msg.Sender)
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgCreateSchedule) Route() string {
+	return RouterKey
+}
+
+func (msg MsgCreateSchedule) Type() string {
+	return "create-schedule"
+}
+
+func (msg MsgCreateSchedule) ValidateBasic() error {
+	if err := sdk.ValidateAccAddress(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	return validateScheduleArgs(msg.Contract, msg.Msg, msg.Interval, msg.StartHeight, msg.GasLimit)
+}
+
+func (msg MsgCreateSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreateSchedule) GetSigners() []sdk.AccAddress {
+	senderAddr := sdk.AccAddress(msg.Sender)
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgRemoveSchedule) Route() string {
+	return RouterKey
+}
+
+func (msg MsgRemoveSchedule) Type() string {
+	return "remove-schedule"
+}
+
+func (msg MsgRemoveSchedule) ValidateBasic() error {
+	if err := sdk.ValidateAccAddress(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if msg.ScheduleID == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "schedule id is required")
+	}
+	return nil
+}
+
+func (msg MsgRemoveSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgRemoveSchedule) GetSigners() []sdk.AccAddress {
+	senderAddr := sdk.AccAddress(msg.Sender)
+	return []sdk.AccAddress{senderAddr}
+}
+
 func (msg MsgIBCSend) Route() string {
 	return RouterKey
 }
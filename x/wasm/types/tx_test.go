@@ -2,6 +2,7 @@ package types
 
 import (
 	"bytes"
+	"math"
 	"strings"
 	"testing"
 
@@ -239,6 +240,125 @@ func TestInstantiateContractValidation(t *testing.T) {
 	}
 }
 
+func TestInstantiateContract2Validation(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.BytesToAccAddress(make([]byte, 20)).String()
+
+	cases := map[string]struct {
+		msg   MsgInstantiateContract2
+		valid bool
+	}{
+		"empty": {
+			msg:   MsgInstantiateContract2{},
+			valid: false,
+		},
+		"correct minimal": {
+			msg: MsgInstantiateContract2{
+				Sender:  goodAddress,
+				CodeID:  firstCodeID,
+				Label:   "foo",
+				InitMsg: []byte("{}"),
+				Salt:    []byte{0},
+			},
+			valid: true,
+		},
+		"missing code": {
+			msg: MsgInstantiateContract2{
+				Sender:  goodAddress,
+				Label:   "foo",
+				InitMsg: []byte("{}"),
+				Salt:    []byte{0},
+			},
+			valid: false,
+		},
+		"missing label": {
+			msg: MsgInstantiateContract2{
+				Sender:  goodAddress,
+				CodeID:  firstCodeID,
+				InitMsg: []byte("{}"),
+				Salt:    []byte{0},
+			},
+			valid: false,
+		},
+		"bad sender minimal": {
+			msg: MsgInstantiateContract2{
+				Sender:  badAddress,
+				CodeID:  firstCodeID,
+				Label:   "foo",
+				InitMsg: []byte("{}"),
+				Salt:    []byte{0},
+			},
+			valid: false,
+		},
+		"correct maximal": {
+			msg: MsgInstantiateContract2{
+				Sender:  goodAddress,
+				Admin:   goodAddress,
+				CodeID:  firstCodeID,
+				Label:   "foo",
+				InitMsg: []byte(`{"some": "data"}`),
+				Funds:   sdk.Coins{sdk.Coin{Denom: "foobar", Amount: sdk.NewInt(200)}},
+				Salt:    bytes.Repeat([]byte{0}, MaxSaltSize),
+			},
+			valid: true,
+		},
+		"negative funds": {
+			msg: MsgInstantiateContract2{
+				Sender:  goodAddress,
+				CodeID:  firstCodeID,
+				Label:   "foo",
+				InitMsg: []byte(`{"some": "data"}`),
+				Funds:   sdk.Coins{sdk.Coin{Denom: "foobar", Amount: sdk.NewInt(-200)}},
+				Salt:    []byte{0},
+			},
+			valid: false,
+		},
+		"non json init msg": {
+			msg: MsgInstantiateContract2{
+				Sender:  goodAddress,
+				CodeID:  firstCodeID,
+				Label:   "foo",
+				InitMsg: []byte("invalid-json"),
+				Salt:    []byte{0},
+			},
+			valid: false,
+		},
+		"empty salt": {
+			msg: MsgInstantiateContract2{
+				Sender:  goodAddress,
+				CodeID:  firstCodeID,
+				Label:   "foo",
+				InitMsg: []byte("{}"),
+			},
+			valid: false,
+		},
+		"salt too long": {
+			msg: MsgInstantiateContract2{
+				Sender:  goodAddress,
+				CodeID:  firstCodeID,
+				Label:   "foo",
+				InitMsg: []byte("{}"),
+				Salt:    bytes.Repeat([]byte{0}, MaxSaltSize+1),
+			},
+			valid: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
 func TestStoreCodeAndInstantiateContractValidation(t *testing.T) {
 	bad, err := sdk.AccAddressFromHex("012345")
 	require.NoError(t, err)
@@ -770,3 +890,230 @@ func TestMsgUpdateContractStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgUpdateInstantiateConfigValidation(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.BytesToAccAddress(make([]byte, 20)).String()
+	anyOfAddresses := AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{goodAddress}}
+
+	specs := map[string]struct {
+		src    MsgUpdateInstantiateConfig
+		expErr bool
+	}{
+		"all good": {
+			src: MsgUpdateInstantiateConfig{
+				Sender:                   goodAddress,
+				CodeID:                   firstCodeID,
+				NewInstantiatePermission: &anyOfAddresses,
+			},
+		},
+		"bad sender": {
+			src: MsgUpdateInstantiateConfig{
+				Sender:                   badAddress,
+				CodeID:                   firstCodeID,
+				NewInstantiatePermission: &anyOfAddresses,
+			},
+			expErr: true,
+		},
+		"missing code id": {
+			src: MsgUpdateInstantiateConfig{
+				Sender:                   goodAddress,
+				NewInstantiatePermission: &anyOfAddresses,
+			},
+			expErr: true,
+		},
+		"missing permission": {
+			src: MsgUpdateInstantiateConfig{
+				Sender: goodAddress,
+				CodeID: firstCodeID,
+			},
+			expErr: true,
+		},
+		"invalid permission": {
+			src: MsgUpdateInstantiateConfig{
+				Sender:                   goodAddress,
+				CodeID:                   firstCodeID,
+				NewInstantiatePermission: &AccessConfig{Permission: AccessTypeAnyOfAddresses},
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgCreateScheduleValidation(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.BytesToAccAddress(make([]byte, 20)).String()
+	anotherGoodAddress := sdk.BytesToAccAddress(bytes.Repeat([]byte{2}, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgCreateSchedule
+		expErr bool
+	}{
+		"all good with interval": {
+			src: MsgCreateSchedule{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+				Msg:      []byte(`{"foo":"bar"}`),
+				Interval: 10,
+				GasLimit: 100_000,
+			},
+		},
+		"all good with start height": {
+			src: MsgCreateSchedule{
+				Sender:      goodAddress,
+				Contract:    anotherGoodAddress,
+				Msg:         []byte(`{"foo":"bar"}`),
+				StartHeight: 100,
+				GasLimit:    100_000,
+			},
+		},
+		"bad sender": {
+			src: MsgCreateSchedule{
+				Sender:   badAddress,
+				Contract: anotherGoodAddress,
+				Msg:      []byte(`{"foo":"bar"}`),
+				Interval: 10,
+				GasLimit: 100_000,
+			},
+			expErr: true,
+		},
+		"bad contract": {
+			src: MsgCreateSchedule{
+				Sender:   goodAddress,
+				Contract: badAddress,
+				Msg:      []byte(`{"foo":"bar"}`),
+				Interval: 10,
+				GasLimit: 100_000,
+			},
+			expErr: true,
+		},
+		"non json msg": {
+			src: MsgCreateSchedule{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+				Msg:      []byte("invalid-json"),
+				Interval: 10,
+				GasLimit: 100_000,
+			},
+			expErr: true,
+		},
+		"neither interval nor start height": {
+			src: MsgCreateSchedule{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+				Msg:      []byte(`{"foo":"bar"}`),
+				GasLimit: 100_000,
+			},
+			expErr: true,
+		},
+		"negative start height": {
+			src: MsgCreateSchedule{
+				Sender:      goodAddress,
+				Contract:    anotherGoodAddress,
+				Msg:         []byte(`{"foo":"bar"}`),
+				Interval:    10,
+				StartHeight: -1,
+				GasLimit:    100_000,
+			},
+			expErr: true,
+		},
+		"interval too long": {
+			src: MsgCreateSchedule{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+				Msg:      []byte(`{"foo":"bar"}`),
+				Interval: math.MaxInt32 + 1,
+				GasLimit: 100_000,
+			},
+			expErr: true,
+		},
+		"missing gas limit": {
+			src: MsgCreateSchedule{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+				Msg:      []byte(`{"foo":"bar"}`),
+				Interval: 10,
+			},
+			expErr: true,
+		},
+		"gas limit below min": {
+			src: MsgCreateSchedule{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+				Msg:      []byte(`{"foo":"bar"}`),
+				Interval: 10,
+				GasLimit: MinScheduleGasLimit - 1,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgRemoveScheduleValidation(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.BytesToAccAddress(make([]byte, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgRemoveSchedule
+		expErr bool
+	}{
+		"all good": {
+			src: MsgRemoveSchedule{
+				Sender:     goodAddress,
+				ScheduleID: 1,
+			},
+		},
+		"bad sender": {
+			src: MsgRemoveSchedule{
+				Sender:     badAddress,
+				ScheduleID: 1,
+			},
+			expErr: true,
+		},
+		"missing schedule id": {
+			src: MsgRemoveSchedule{
+				Sender: goodAddress,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -83,6 +83,33 @@ func (c CodeInfo) ValidateBasic() error {
 	return nil
 }
 
+func (s Schedule) ValidateBasic() error {
+	if s.ID == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "id")
+	}
+	if err := sdk.ValidateAccAddress(s.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if !json.Valid(s.Msg) {
+		return sdkerrors.Wrap(ErrInvalid, "msg json")
+	}
+	if s.NextHeight <= 0 {
+		return sdkerrors.Wrap(ErrInvalid, "next height")
+	}
+	if s.GasLimit == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "gas limit")
+	}
+	if s.GasLimit < MinScheduleGasLimit {
+		return sdkerrors.Wrapf(ErrLimit, "gas limit must be at least %d", MinScheduleGasLimit)
+	}
+	if len(s.Creator) != 0 {
+		if err := sdk.ValidateAccAddress(s.Creator); err != nil {
+			return sdkerrors.Wrap(err, "creator")
+		}
+	}
+	return nil
+}
+
 // NewCodeInfo fills a new Contract struct
 func NewCodeInfo(codeHash []byte, creator sdk.AccAddress, source string, builder string, instantiatePermission AccessConfig) CodeInfo {
 	return CodeInfo{
@@ -9,7 +9,9 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
-	types "github.com/line/lbm-sdk/codec/types"
+	types1 "github.com/line/lbm-sdk/codec/types"
+	github_com_line_lbm_sdk_types "github.com/line/lbm-sdk/types"
+	types "github.com/line/lbm-sdk/types"
 	github_com_line_ostracon_libs_bytes "github.com/line/ostracon/libs/bytes"
 	_ "github.com/regen-network/cosmos-proto"
 	io "io"
@@ -40,6 +42,8 @@ const (
 	AccessTypeOnlyAddress AccessType = 2
 	// AccessTypeEverybody unrestricted
 	AccessTypeEverybody AccessType = 3
+	// AccessTypeAnyOfAddresses allow any of the addresses
+	AccessTypeAnyOfAddresses AccessType = 4
 )
 
 var AccessType_name = map[int32]string{
@@ -47,13 +51,15 @@ var AccessType_name = map[int32]string{
 	1: "ACCESS_TYPE_NOBODY",
 	2: "ACCESS_TYPE_ONLY_ADDRESS",
 	3: "ACCESS_TYPE_EVERYBODY",
+	4: "ACCESS_TYPE_ANY_OF_ADDRESSES",
 }
 
 var AccessType_value = map[string]int32{
-	"ACCESS_TYPE_UNSPECIFIED":  0,
-	"ACCESS_TYPE_NOBODY":       1,
-	"ACCESS_TYPE_ONLY_ADDRESS": 2,
-	"ACCESS_TYPE_EVERYBODY":    3,
+	"ACCESS_TYPE_UNSPECIFIED":      0,
+	"ACCESS_TYPE_NOBODY":           1,
+	"ACCESS_TYPE_ONLY_ADDRESS":     2,
+	"ACCESS_TYPE_EVERYBODY":        3,
+	"ACCESS_TYPE_ANY_OF_ADDRESSES": 4,
 }
 
 func (AccessType) EnumDescriptor() ([]byte, []int) {
@@ -166,6 +172,7 @@ var xxx_messageInfo_AccessTypeParam proto.InternalMessageInfo
 type AccessConfig struct {
 	Permission AccessType `protobuf:"varint,1,opt,name=permission,proto3,enum=cosmwasm.wasm.v1beta1.AccessType" json:"permission,omitempty" yaml:"permission"`
 	Address    string     `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Addresses  []string   `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty" yaml:"addresses"`
 }
 
 func (m *AccessConfig) Reset()         { *m = AccessConfig{} }
@@ -210,6 +217,16 @@ type Params struct {
 	GasMultiplier                uint64       `protobuf:"varint,5,opt,name=gas_multiplier,json=gasMultiplier,proto3" json:"gas_multiplier,omitempty" yaml:"max_gas"`
 	InstanceCost                 uint64       `protobuf:"varint,6,opt,name=instance_cost,json=instanceCost,proto3" json:"instance_cost,omitempty" yaml:"instance_cost"`
 	CompileCost                  uint64       `protobuf:"varint,7,opt,name=compile_cost,json=compileCost,proto3" json:"compile_cost,omitempty" yaml:"compile_cost"`
+	// MaxScheduleGasPerBlock is the max gas spent on executing schedules in a block
+	MaxScheduleGasPerBlock uint64 `protobuf:"varint,8,opt,name=max_schedule_gas_per_block,json=maxScheduleGasPerBlock,proto3" json:"max_schedule_gas_per_block,omitempty" yaml:"max_schedule_gas_per_block"`
+	// ScheduleCreationFee is paid to the fee collector for a schedule not created by governance
+	ScheduleCreationFee github_com_line_lbm_sdk_types.Coins `protobuf:"bytes,9,rep,name=schedule_creation_fee,json=scheduleCreationFee,proto3,castrepeated=github.com/line/lbm-sdk/types.Coins" json:"schedule_creation_fee" yaml:"schedule_creation_fee"`
+	// ScheduleExecutionFee is paid to the fee collector by the creator of a
+	// schedule not created by governance for each of its executions
+	ScheduleExecutionFee github_com_line_lbm_sdk_types.Coins `protobuf:"bytes,10,rep,name=schedule_execution_fee,json=scheduleExecutionFee,proto3,castrepeated=github.com/line/lbm-sdk/types.Coins" json:"schedule_execution_fee" yaml:"schedule_execution_fee"`
+	// MaxSchedulesPerContract is the max number of schedules of a contract
+	// that are not created by governance
+	MaxSchedulesPerContract uint32 `protobuf:"varint,11,opt,name=max_schedules_per_contract,json=maxSchedulesPerContract,proto3" json:"max_schedules_per_contract,omitempty" yaml:"max_schedules_per_contract"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -311,7 +328,7 @@ type ContractInfo struct {
 	Status ContractStatus `protobuf:"varint,7,opt,name=status,proto3,enum=cosmwasm.wasm.v1beta1.ContractStatus" json:"status,omitempty"`
 	// Extension is an extension point to store custom metadata within the
 	// persistence model.
-	Extension *types.Any `protobuf:"bytes,8,opt,name=extension,proto3" json:"extension,omitempty"`
+	Extension *types1.Any `protobuf:"bytes,8,opt,name=extension,proto3" json:"extension,omitempty"`
 }
 
 func (m *ContractInfo) Reset()         { *m = ContractInfo{} }
@@ -474,6 +491,60 @@ func (m *Model) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Model proto.InternalMessageInfo
 
+// Schedule defines the executions of the sudo entry point of a contract at a
+// block height or every number of blocks
+type Schedule struct {
+	// ID is the unique identifier of the schedule
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Contract is the address of the smart contract
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// Msg json encoded message to be passed to the sudo entry point
+	Msg encoding_json.RawMessage `protobuf:"bytes,3,opt,name=msg,proto3,casttype=encoding/json.RawMessage" json:"msg,omitempty"`
+	// Interval is the number of blocks between two executions, zero for a
+	// single execution
+	Interval uint64 `protobuf:"varint,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	// NextHeight is the block height of the next execution
+	NextHeight int64 `protobuf:"varint,5,opt,name=next_height,json=nextHeight,proto3" json:"next_height,omitempty"`
+	// GasLimit is the max gas an execution can consume
+	GasLimit uint64 `protobuf:"varint,6,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// Creator is the address that paid for the schedule, empty when created by
+	// governance
+	Creator string `protobuf:"bytes,7,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *Schedule) Reset()         { *m = Schedule{} }
+func (m *Schedule) String() string { return proto.CompactTextString(m) }
+func (*Schedule) ProtoMessage()    {}
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2548aa229a1f29bc, []int{8}
+}
+func (m *Schedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Schedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Schedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Schedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Schedule.Merge(m, src)
+}
+func (m *Schedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *Schedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_Schedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Schedule proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("cosmwasm.wasm.v1beta1.AccessType", AccessType_name, AccessType_value)
 	proto.RegisterEnum("cosmwasm.wasm.v1beta1.ContractStatus", ContractStatus_name, ContractStatus_value)
@@ -486,101 +557,122 @@ func init() {
 	proto.RegisterType((*ContractCodeHistoryEntry)(nil), "cosmwasm.wasm.v1beta1.ContractCodeHistoryEntry")
 	proto.RegisterType((*AbsoluteTxPosition)(nil), "cosmwasm.wasm.v1beta1.AbsoluteTxPosition")
 	proto.RegisterType((*Model)(nil), "cosmwasm.wasm.v1beta1.Model")
+	proto.RegisterType((*Schedule)(nil), "cosmwasm.wasm.v1beta1.Schedule")
 }
 
 func init() { proto.RegisterFile("cosmwasm/wasm/v1beta1/types.proto", fileDescriptor_2548aa229a1f29bc) }
 
 var fileDescriptor_2548aa229a1f29bc = []byte{
-	// 1414 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcf, 0x6f, 0xdb, 0xc6,
-	0x12, 0x16, 0x2d, 0x5b, 0xb6, 0xd6, 0x8a, 0xa3, 0x6c, 0x6c, 0x47, 0x56, 0x12, 0x49, 0x66, 0x12,
-	0x3c, 0x27, 0x71, 0xa4, 0xc4, 0xef, 0xe1, 0xa5, 0x35, 0x90, 0x02, 0x12, 0xa5, 0xc4, 0x2c, 0x6a,
-	0xc9, 0x58, 0xc9, 0x49, 0x5c, 0xa0, 0x20, 0x56, 0xe4, 0x5a, 0x66, 0x43, 0x71, 0x05, 0xee, 0xca,
-	0x91, 0x72, 0x6a, 0x6f, 0x85, 0x7a, 0x29, 0x7a, 0xea, 0xa1, 0x2a, 0x0a, 0xb4, 0x28, 0xf2, 0x07,
-	0xf4, 0x8f, 0x08, 0x02, 0x14, 0xc8, 0xb1, 0x27, 0xa1, 0x75, 0x2e, 0xed, 0xd5, 0xc7, 0x9c, 0x0a,
-	0x2e, 0xa9, 0x88, 0x76, 0x7e, 0x58, 0xbd, 0x10, 0x9c, 0xd9, 0xf9, 0xbe, 0xd9, 0xf9, 0x66, 0x77,
-	0x48, 0xb0, 0xac, 0x53, 0xd6, 0x7c, 0x8c, 0x59, 0x33, 0x27, 0x1e, 0xfb, 0xb7, 0xea, 0x84, 0xe3,
-	0x5b, 0x39, 0xde, 0x6d, 0x11, 0x96, 0x6d, 0x39, 0x94, 0x53, 0xb8, 0x30, 0x0c, 0xc9, 0x8a, 0x87,
-	0x1f, 0x92, 0x5c, 0x72, 0xdd, 0x94, 0x69, 0x22, 0x28, 0xe7, 0x19, 0x1e, 0x22, 0x39, 0xdf, 0xa0,
-	0x0d, 0xea, 0xf9, 0xdd, 0x37, 0xdf, 0xbb, 0xd4, 0xa0, 0xb4, 0x61, 0x91, 0x9c, 0xb0, 0xea, 0xed,
-	0xdd, 0x1c, 0xb6, 0xbb, 0xde, 0x92, 0x5c, 0x07, 0xa7, 0xf3, 0xba, 0x4e, 0x18, 0xab, 0x75, 0x5b,
-	0x64, 0x0b, 0x3b, 0xb8, 0x09, 0x55, 0x30, 0xb5, 0x8f, 0xad, 0x36, 0x49, 0x48, 0x19, 0x69, 0x65,
-	0x6e, 0x6d, 0x39, 0xfb, 0xd6, 0x5d, 0x64, 0x47, 0xb0, 0x42, 0xfc, 0x70, 0x90, 0x8e, 0x75, 0x71,
-	0xd3, 0x5a, 0x97, 0x05, 0x52, 0x46, 0x1e, 0xc3, 0xfa, 0xe4, 0x77, 0x3f, 0xa6, 0x25, 0xf9, 0x7b,
-	0x09, 0xc4, 0xbc, 0x68, 0x85, 0xda, 0xbb, 0x66, 0x03, 0x3e, 0x04, 0xa0, 0x45, 0x9c, 0xa6, 0xc9,
-	0x98, 0x49, 0xed, 0xf1, 0xd3, 0x2c, 0x1c, 0x0e, 0xd2, 0x67, 0xbc, 0x34, 0x23, 0xb8, 0x8c, 0x02,
-	0x5c, 0x70, 0x15, 0x4c, 0x63, 0xc3, 0x70, 0x08, 0x63, 0x89, 0x89, 0x8c, 0xb4, 0x12, 0x2d, 0xc0,
-	0xc3, 0x41, 0x7a, 0xce, 0xc3, 0xf8, 0x0b, 0x32, 0x1a, 0x86, 0xf8, 0xdb, 0xfb, 0x61, 0x0a, 0x44,
-	0x44, 0xe5, 0x0c, 0x72, 0x00, 0x75, 0x6a, 0x10, 0xad, 0xdd, 0xb2, 0x28, 0x36, 0x34, 0x2c, 0x72,
-	0x8b, 0x0d, 0xce, 0xae, 0x5d, 0x7a, 0xef, 0x06, 0xbd, 0xca, 0x0a, 0xcb, 0xcf, 0x06, 0xe9, 0xd0,
-	0xe1, 0x20, 0xbd, 0xe4, 0xa5, 0x7c, 0x93, 0x4c, 0x46, 0x71, 0xd7, 0xb9, 0x2d, 0x7c, 0x1e, 0x14,
-	0x7e, 0x2b, 0x81, 0x94, 0x69, 0x33, 0x8e, 0x6d, 0x6e, 0x62, 0x4e, 0x34, 0x83, 0xec, 0xe2, 0xb6,
-	0xc5, 0xb5, 0x80, 0x46, 0x13, 0xe3, 0x6a, 0x74, 0xf5, 0x70, 0x90, 0xbe, 0xe2, 0x25, 0x7f, 0x3f,
-	0xa5, 0x8c, 0x2e, 0x04, 0x02, 0x8a, 0xde, 0xfa, 0xd6, 0x48, 0xc9, 0x2f, 0x24, 0xb0, 0xa8, 0x53,
-	0x9b, 0x3b, 0x58, 0xe7, 0x1a, 0xe3, 0x98, 0xb7, 0xd9, 0x50, 0x8f, 0xf0, 0xf8, 0x7a, 0x5c, 0xf1,
-	0xf5, 0xb8, 0x38, 0xd4, 0xe3, 0x6d, 0x84, 0x32, 0x9a, 0x1f, 0x2e, 0x54, 0x85, 0xdf, 0xd7, 0xe5,
-	0x63, 0x00, 0x9b, 0xb8, 0xa3, 0xb9, 0xec, 0x9a, 0x50, 0x92, 0x99, 0x4f, 0x48, 0x62, 0x32, 0x23,
-	0xad, 0x4c, 0x16, 0x2e, 0x8e, 0x44, 0x7e, 0x33, 0x46, 0x46, 0xa7, 0x9b, 0xb8, 0xf3, 0x00, 0xb3,
-	0xa6, 0x42, 0x0d, 0x52, 0x35, 0x9f, 0x10, 0xf8, 0x21, 0x98, 0x6b, 0x60, 0xa6, 0x35, 0xdb, 0x16,
-	0x37, 0x5b, 0x96, 0x49, 0x9c, 0xc4, 0x94, 0xe0, 0x09, 0x9c, 0x0f, 0x97, 0xa7, 0x81, 0x99, 0x8c,
-	0x4e, 0x35, 0x30, 0xdb, 0x7c, 0x1d, 0x08, 0xef, 0x80, 0x53, 0x9e, 0x52, 0x3a, 0xd1, 0x74, 0xca,
-	0x78, 0x22, 0x22, 0x90, 0x89, 0xc3, 0x41, 0x7a, 0x3e, 0xa8, 0xb4, 0xbf, 0x2c, 0xa3, 0xd8, 0xd0,
-	0x56, 0x28, 0xe3, 0x70, 0x1d, 0xc4, 0x74, 0xda, 0x6c, 0x99, 0x96, 0x8f, 0x9e, 0x16, 0xe8, 0x73,
-	0x87, 0x83, 0xf4, 0xd9, 0xa1, 0x28, 0xa3, 0x55, 0x19, 0xcd, 0xfa, 0xa6, 0x8b, 0x15, 0x07, 0x34,
-	0x24, 0xff, 0x26, 0x81, 0x19, 0xb7, 0x10, 0xd5, 0xde, 0xa5, 0xf0, 0x3c, 0x88, 0x8a, 0x3a, 0xf7,
-	0x30, 0xdb, 0x13, 0x27, 0x33, 0x86, 0x66, 0x5c, 0xc7, 0x06, 0x66, 0x7b, 0x30, 0x01, 0xa6, 0x75,
-	0x87, 0x60, 0x4e, 0x1d, 0xef, 0xf8, 0xa3, 0xa1, 0x09, 0x17, 0x41, 0x84, 0xd1, 0xb6, 0xa3, 0x13,
-	0xd1, 0xbd, 0x28, 0xf2, 0x2d, 0x17, 0x51, 0x6f, 0x9b, 0x96, 0x41, 0x1c, 0x21, 0x6c, 0x14, 0x0d,
-	0x4d, 0xf8, 0x10, 0xc0, 0xe0, 0x09, 0xd2, 0x45, 0x43, 0x85, 0x6a, 0x63, 0xf6, 0x7e, 0xd2, 0xed,
-	0x3d, 0x3a, 0x13, 0x20, 0xf1, 0x16, 0xe4, 0x2f, 0xc3, 0x20, 0xa6, 0xf8, 0x0d, 0x17, 0x35, 0x5d,
-	0x02, 0xd3, 0xa2, 0x26, 0xd3, 0x10, 0x15, 0x4d, 0x16, 0xc0, 0xc1, 0x20, 0x1d, 0x11, 0x25, 0x17,
-	0x51, 0xc4, 0x5d, 0x52, 0x8d, 0xf7, 0xd4, 0x36, 0x0f, 0xa6, 0xb0, 0xd1, 0x34, 0x6d, 0xbf, 0x34,
-	0xcf, 0x70, 0xbd, 0x16, 0xae, 0x13, 0xcb, 0xaf, 0xcb, 0x33, 0xa0, 0xe2, 0xb3, 0x10, 0xc3, 0x2f,
-	0xe5, 0xea, 0xbb, 0x4a, 0xa9, 0x33, 0x6a, 0xb5, 0x39, 0xa9, 0x75, 0xb6, 0x28, 0x33, 0xb9, 0x49,
-	0x6d, 0x34, 0x44, 0xc2, 0x1b, 0x60, 0xd6, 0xac, 0xeb, 0x5a, 0x8b, 0x3a, 0xdc, 0xdd, 0x73, 0x44,
-	0x4c, 0x9a, 0x53, 0x07, 0x83, 0x74, 0x54, 0x2d, 0x28, 0x5b, 0xd4, 0xe1, 0x6a, 0x11, 0x45, 0xcd,
-	0xba, 0x2e, 0x5e, 0x0d, 0x78, 0x07, 0x44, 0xbc, 0xf3, 0x2e, 0x7a, 0x3f, 0xb7, 0x76, 0xe5, 0x1d,
-	0x29, 0x95, 0x23, 0x97, 0x00, 0xf9, 0x20, 0xb8, 0x09, 0xa2, 0xa4, 0xc3, 0x89, 0x2d, 0x06, 0xc1,
-	0x8c, 0xd8, 0xf4, 0x7c, 0xd6, 0x9b, 0xe8, 0xd9, 0xe1, 0x44, 0xcf, 0xe6, 0xed, 0x6e, 0x61, 0xe9,
-	0xf9, 0xaf, 0x37, 0x16, 0x82, 0xc2, 0x96, 0x86, 0x30, 0x34, 0x62, 0x58, 0x9f, 0xfc, 0xcb, 0x1d,
-	0x7a, 0x5f, 0x4f, 0x80, 0xc4, 0x30, 0xd4, 0x15, 0x7a, 0xc3, 0x64, 0x9c, 0x3a, 0xdd, 0x92, 0xcd,
-	0x9d, 0x2e, 0xdc, 0x06, 0x51, 0xda, 0x22, 0x0e, 0xe6, 0xa3, 0xf1, 0x7c, 0xfb, 0x84, 0x3d, 0x07,
-	0x38, 0x2a, 0x43, 0xa8, 0x3b, 0x90, 0xd0, 0x88, 0x29, 0xd8, 0xe6, 0x89, 0x77, 0xb6, 0x59, 0x01,
-	0xd3, 0xed, 0x96, 0x21, 0x1a, 0x14, 0xfe, 0xd7, 0x0d, 0xf2, 0x91, 0x30, 0x0b, 0xc2, 0x4d, 0xd6,
-	0x10, 0x9d, 0x8f, 0x15, 0x2e, 0xbc, 0x1a, 0xa4, 0x13, 0xc4, 0xd6, 0xa9, 0x61, 0xda, 0x8d, 0xdc,
-	0xe7, 0x8c, 0xda, 0x59, 0x84, 0x1f, 0x6f, 0x12, 0xc6, 0x70, 0x83, 0x20, 0x37, 0x50, 0x46, 0x00,
-	0xbe, 0x49, 0x07, 0x97, 0x41, 0xac, 0x6e, 0x51, 0xfd, 0x91, 0xb6, 0x47, 0xcc, 0xc6, 0x1e, 0xf7,
-	0xce, 0x26, 0x9a, 0x15, 0xbe, 0x0d, 0xe1, 0x82, 0x4b, 0x60, 0x86, 0x77, 0x34, 0xd3, 0x36, 0x48,
-	0xc7, 0xab, 0x09, 0x4d, 0xf3, 0x8e, 0xea, 0x9a, 0x32, 0x06, 0x53, 0x9b, 0xd4, 0x20, 0x16, 0x2c,
-	0x80, 0xf0, 0x23, 0xd2, 0xf5, 0xee, 0x6a, 0xe1, 0xe6, 0xab, 0x41, 0x7a, 0xb5, 0x61, 0xf2, 0xbd,
-	0x76, 0x3d, 0xab, 0xd3, 0x66, 0xce, 0x32, 0x6d, 0x92, 0xa3, 0xcc, 0xd5, 0x90, 0xda, 0x39, 0xcb,
-	0xac, 0xb3, 0x5c, 0xbd, 0xcb, 0x09, 0xcb, 0x6e, 0x90, 0x4e, 0xc1, 0x7d, 0x41, 0x2e, 0xd8, 0x3d,
-	0xcc, 0xde, 0x37, 0x79, 0x42, 0xdc, 0x78, 0xcf, 0xb8, 0xf6, 0xb7, 0x04, 0xc0, 0x68, 0xf6, 0xc3,
-	0xff, 0x83, 0x73, 0x79, 0x45, 0x29, 0x55, 0xab, 0x5a, 0x6d, 0x67, 0xab, 0xa4, 0x6d, 0x97, 0xab,
-	0x5b, 0x25, 0x45, 0xbd, 0xab, 0x96, 0x8a, 0xf1, 0x50, 0x72, 0xa9, 0xd7, 0xcf, 0x2c, 0x8c, 0x82,
-	0xb7, 0x6d, 0xd6, 0x22, 0xba, 0xb9, 0x6b, 0x12, 0x03, 0xae, 0x02, 0x18, 0xc4, 0x95, 0x2b, 0x85,
-	0x4a, 0x71, 0x27, 0x2e, 0x25, 0xe7, 0x7b, 0xfd, 0x4c, 0x7c, 0x04, 0x29, 0xd3, 0x3a, 0x35, 0xba,
-	0xf0, 0x36, 0x48, 0x04, 0xa3, 0x2b, 0xe5, 0x4f, 0x76, 0xb4, 0x7c, 0xb1, 0x88, 0x4a, 0xd5, 0x6a,
-	0x7c, 0xe2, 0x78, 0x9a, 0x8a, 0x6d, 0x75, 0xf3, 0xde, 0xd7, 0x16, 0xae, 0x81, 0x85, 0x20, 0xb0,
-	0x74, 0xbf, 0x84, 0x76, 0x44, 0xa6, 0x70, 0xf2, 0x5c, 0xaf, 0x9f, 0x39, 0x3b, 0x42, 0x95, 0xf6,
-	0x89, 0xd3, 0x75, 0x93, 0x25, 0x67, 0xbe, 0xfa, 0x29, 0x15, 0x7a, 0xfa, 0x73, 0x2a, 0x74, 0xed,
-	0xb9, 0x04, 0xe6, 0x8e, 0x5e, 0x10, 0xf8, 0x11, 0x38, 0xaf, 0x54, 0xca, 0x35, 0x94, 0x57, 0x6a,
-	0x5a, 0xb5, 0x96, 0xaf, 0x6d, 0x57, 0x8f, 0xd5, 0x7c, 0xb1, 0xd7, 0xcf, 0x2c, 0x1d, 0x05, 0x05,
-	0xeb, 0xfe, 0x1f, 0x58, 0x3c, 0x8e, 0xcf, 0x2b, 0x35, 0xf5, 0x7e, 0x29, 0x2e, 0x25, 0x13, 0xbd,
-	0x7e, 0x66, 0x5e, 0x39, 0xf6, 0x55, 0xe2, 0xe6, 0x3e, 0x81, 0x1f, 0x80, 0xc4, 0x71, 0x94, 0x5a,
-	0xf6, 0x71, 0x13, 0xc9, 0x64, 0xaf, 0x9f, 0x59, 0x3c, 0x8a, 0x53, 0x6d, 0x2c, 0x90, 0x81, 0x62,
-	0x7e, 0x09, 0x83, 0xcc, 0x49, 0x37, 0x07, 0x12, 0x70, 0xf3, 0x75, 0x22, 0xa5, 0x52, 0x2c, 0x69,
-	0x1b, 0x6a, 0xb5, 0x56, 0x41, 0x3b, 0x5a, 0x65, 0xab, 0x84, 0xf2, 0x35, 0xb5, 0x52, 0x7e, 0x5b,
-	0x9f, 0x73, 0xbd, 0x7e, 0xe6, 0xfa, 0x49, 0xdc, 0x41, 0x15, 0x1e, 0x80, 0xab, 0x63, 0xa5, 0x51,
-	0xcb, 0x6a, 0x2d, 0x2e, 0x25, 0x57, 0x7a, 0xfd, 0xcc, 0xe5, 0x93, 0xf8, 0x55, 0xdb, 0xe4, 0xf0,
-	0x33, 0xb0, 0x3a, 0x16, 0xf1, 0xa6, 0x7a, 0x0f, 0xe5, 0x6b, 0xae, 0x78, 0xd7, 0x7b, 0xfd, 0xcc,
-	0x7f, 0x4e, 0xe2, 0xde, 0x34, 0x1b, 0x0e, 0xe6, 0x64, 0x6c, 0xfa, 0x7b, 0xa5, 0x72, 0xa9, 0xaa,
-	0x56, 0xe3, 0xe1, 0xf1, 0xe8, 0xef, 0x11, 0x9b, 0x30, 0x93, 0x25, 0x27, 0xdd, 0x66, 0x15, 0xee,
-	0x3e, 0xfb, 0x33, 0x15, 0x7a, 0x7a, 0x90, 0x92, 0x9e, 0x1d, 0xa4, 0xa4, 0x17, 0x07, 0x29, 0xe9,
-	0x8f, 0x83, 0x94, 0xf4, 0xcd, 0xcb, 0x54, 0xe8, 0xc5, 0xcb, 0x54, 0xe8, 0xf7, 0x97, 0xa9, 0xd0,
-	0xa7, 0x97, 0x8f, 0x5f, 0x66, 0xab, 0xde, 0xbc, 0xc1, 0x8c, 0x47, 0xb9, 0x8e, 0xf7, 0x5f, 0x2f,
-	0xfe, 0xe7, 0xeb, 0x11, 0x31, 0xa8, 0xff, 0xfb, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb5, 0xe5,
-	0xcc, 0xad, 0xf5, 0x0b, 0x00, 0x00,
+	// 1739 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0xcf, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd6, 0x92, 0xfa, 0xc5, 0x91, 0xac, 0x30, 0x63, 0x49, 0xa6, 0x68, 0x99, 0xa4, 0xd6, 0x31,
+	0x2a, 0x27, 0x36, 0x99, 0xa8, 0x45, 0xd3, 0x1a, 0x48, 0x00, 0x92, 0xa2, 0xad, 0x6d, 0x2d, 0x52,
+	0x18, 0x52, 0x49, 0x54, 0xa0, 0x58, 0xcc, 0xee, 0x8e, 0xa8, 0xa9, 0x77, 0x77, 0x88, 0x9d, 0xa5,
+	0x42, 0xe6, 0xd4, 0xde, 0x0a, 0xf6, 0x52, 0xf4, 0xd2, 0xa2, 0x28, 0x81, 0x02, 0x2d, 0x8a, 0xb4,
+	0xe7, 0xfe, 0x11, 0x46, 0x80, 0x02, 0x3e, 0xf6, 0xc4, 0xb6, 0xf2, 0xa5, 0x67, 0x1d, 0x73, 0x2a,
+	0x66, 0x76, 0x97, 0x5c, 0xc9, 0x92, 0xc5, 0x5e, 0x88, 0x7d, 0x33, 0xef, 0xfb, 0x66, 0xde, 0xf7,
+	0xe6, 0xbd, 0x19, 0x82, 0x2d, 0x93, 0x71, 0xe7, 0x4b, 0xcc, 0x9d, 0x92, 0xfc, 0x39, 0xfd, 0xc8,
+	0x20, 0x3e, 0xfe, 0xa8, 0xe4, 0xf7, 0x3b, 0x84, 0x17, 0x3b, 0x1e, 0xf3, 0x19, 0x5c, 0x8b, 0x5c,
+	0x8a, 0xf2, 0x27, 0x74, 0xc9, 0x6e, 0x88, 0x61, 0xc6, 0x75, 0xe9, 0x54, 0x0a, 0x8c, 0x00, 0x91,
+	0x5d, 0x6d, 0xb3, 0x36, 0x0b, 0xc6, 0xc5, 0x57, 0x38, 0xba, 0xd1, 0x66, 0xac, 0x6d, 0x93, 0x92,
+	0xb4, 0x8c, 0xee, 0x71, 0x09, 0xbb, 0xfd, 0x70, 0xea, 0xae, 0x6d, 0x38, 0x25, 0x03, 0x73, 0x32,
+	0xde, 0x80, 0xc9, 0xa8, 0x1b, 0x4c, 0xaa, 0x06, 0x78, 0xa7, 0x6c, 0x9a, 0x84, 0xf3, 0x56, 0xbf,
+	0x43, 0x0e, 0xb0, 0x87, 0x1d, 0xa8, 0x81, 0xb9, 0x53, 0x6c, 0x77, 0x49, 0x46, 0x29, 0x28, 0xdb,
+	0x2b, 0x3b, 0x5b, 0xc5, 0x2b, 0xb7, 0x58, 0x9c, 0xc0, 0x2a, 0xe9, 0xf3, 0x51, 0x7e, 0xb9, 0x8f,
+	0x1d, 0xfb, 0x89, 0x2a, 0x91, 0x2a, 0x0a, 0x18, 0x9e, 0xcc, 0xfe, 0xee, 0x8f, 0x79, 0x45, 0x7d,
+	0xa5, 0x80, 0xe5, 0xc0, 0xbb, 0xca, 0xdc, 0x63, 0xda, 0x86, 0x5f, 0x00, 0xd0, 0x21, 0x9e, 0x43,
+	0x39, 0xa7, 0xcc, 0x9d, 0x7e, 0x99, 0xb5, 0xf3, 0x51, 0xfe, 0xdd, 0x60, 0x99, 0x09, 0x5c, 0x45,
+	0x31, 0x2e, 0xf8, 0x08, 0x2c, 0x60, 0xcb, 0xf2, 0x08, 0xe7, 0x99, 0x44, 0x41, 0xd9, 0x4e, 0x55,
+	0xe0, 0xf9, 0x28, 0xbf, 0x12, 0x60, 0xc2, 0x09, 0x15, 0x45, 0x2e, 0x70, 0x07, 0xa4, 0xc2, 0x4f,
+	0xc2, 0x33, 0xc9, 0x42, 0x72, 0x3b, 0x55, 0x59, 0x3d, 0x1f, 0xe5, 0xd3, 0x17, 0xfc, 0x09, 0x57,
+	0xd1, 0xc4, 0x2d, 0x0c, 0xe9, 0x0f, 0x29, 0x30, 0x2f, 0xd5, 0xe2, 0xd0, 0x07, 0xd0, 0x64, 0x16,
+	0xd1, 0xbb, 0x1d, 0x9b, 0x61, 0x4b, 0xc7, 0x72, 0xbf, 0x32, 0xa8, 0xa5, 0x9d, 0xfb, 0x6f, 0x0d,
+	0x2a, 0x50, 0xa3, 0xb2, 0xf5, 0x72, 0x94, 0x9f, 0x39, 0x1f, 0xe5, 0x37, 0x82, 0x65, 0xdf, 0x24,
+	0x53, 0x51, 0x5a, 0x0c, 0x1e, 0xca, 0xb1, 0x00, 0x0a, 0x7f, 0xa3, 0x80, 0x1c, 0x75, 0xb9, 0x8f,
+	0x5d, 0x9f, 0x62, 0x9f, 0xe8, 0x16, 0x39, 0xc6, 0x5d, 0xdb, 0xd7, 0x63, 0xba, 0x26, 0xa6, 0xd5,
+	0xf5, 0xe1, 0xf9, 0x28, 0xff, 0x20, 0x58, 0xfc, 0xed, 0x94, 0x2a, 0xda, 0x8c, 0x39, 0xec, 0x06,
+	0xf3, 0x07, 0x13, 0xf5, 0x7f, 0xae, 0x80, 0x75, 0x93, 0xb9, 0xbe, 0x87, 0x4d, 0x5f, 0xe7, 0x3e,
+	0xf6, 0xbb, 0x3c, 0xd2, 0x23, 0x39, 0xbd, 0x1e, 0x0f, 0x42, 0x3d, 0xee, 0x45, 0x7a, 0x5c, 0x45,
+	0xa8, 0xa2, 0xd5, 0x68, 0xa2, 0x29, 0xc7, 0x43, 0x5d, 0x7e, 0x04, 0xa0, 0x83, 0x7b, 0xba, 0x60,
+	0xd7, 0xa5, 0x92, 0x9c, 0x7e, 0x45, 0x32, 0xb3, 0x05, 0x65, 0x7b, 0xb6, 0x72, 0x6f, 0x22, 0xf2,
+	0x9b, 0x3e, 0x2a, 0x7a, 0xc7, 0xc1, 0xbd, 0xcf, 0x31, 0x77, 0xaa, 0xcc, 0x22, 0x4d, 0xfa, 0x15,
+	0x81, 0x3f, 0x04, 0x2b, 0x6d, 0xcc, 0x75, 0xa7, 0x6b, 0xfb, 0xb4, 0x63, 0x53, 0xe2, 0x65, 0xe6,
+	0x24, 0x4f, 0xec, 0x4c, 0x09, 0x9e, 0x36, 0xe6, 0x2a, 0xba, 0xd5, 0xc6, 0x7c, 0x7f, 0xec, 0x08,
+	0x3f, 0x01, 0xb7, 0x02, 0xa5, 0x4c, 0xa2, 0x9b, 0x8c, 0xfb, 0x99, 0x79, 0x89, 0xcc, 0x9c, 0x8f,
+	0xf2, 0xab, 0x71, 0xa5, 0xc3, 0x69, 0x15, 0x2d, 0x47, 0x76, 0x95, 0x71, 0x1f, 0x3e, 0x01, 0xcb,
+	0x26, 0x73, 0x3a, 0xd4, 0x0e, 0xd1, 0x0b, 0x12, 0x7d, 0xe7, 0x7c, 0x94, 0xbf, 0x1d, 0x89, 0x32,
+	0x99, 0x55, 0xd1, 0x52, 0x68, 0x4a, 0x2c, 0x06, 0x59, 0xb1, 0x2b, 0x6e, 0x9e, 0x10, 0xab, 0x6b,
+	0x13, 0xb1, 0x3d, 0x91, 0x42, 0xdd, 0xb0, 0x99, 0xf9, 0x22, 0xb3, 0x28, 0x99, 0x1e, 0x9c, 0x8f,
+	0xf2, 0x5b, 0x93, 0x08, 0xae, 0xf6, 0x55, 0xd1, 0xba, 0x83, 0x7b, 0xcd, 0x70, 0xee, 0x19, 0xe6,
+	0x07, 0xc4, 0xab, 0x88, 0x09, 0xf8, 0x5b, 0x05, 0xac, 0x8d, 0x31, 0xa6, 0x47, 0xb0, 0x4f, 0x99,
+	0xab, 0x1f, 0x13, 0x92, 0x49, 0x15, 0x92, 0xdb, 0x4b, 0x3b, 0xeb, 0x45, 0xdb, 0x70, 0x8a, 0xa2,
+	0xe5, 0x8c, 0x33, 0x5c, 0x65, 0xd4, 0xad, 0xfc, 0x38, 0xcc, 0xec, 0x66, 0xb0, 0xf4, 0x95, 0x14,
+	0xea, 0xdf, 0xfe, 0x95, 0xbf, 0xdf, 0xa6, 0xfe, 0x49, 0xd7, 0x28, 0x9a, 0xcc, 0x29, 0xd9, 0xd4,
+	0x25, 0x25, 0xdb, 0x70, 0x1e, 0x73, 0xeb, 0x45, 0xd8, 0x39, 0x05, 0x17, 0x47, 0xb7, 0x23, 0x78,
+	0x35, 0x44, 0x3f, 0x25, 0x04, 0xfe, 0x5e, 0x01, 0xeb, 0x63, 0x5a, 0xd2, 0x23, 0x66, 0x77, 0xbc,
+	0x35, 0xf0, 0xd6, 0xad, 0x3d, 0xbf, 0x78, 0xe8, 0xae, 0xe6, 0x98, 0x7a, 0x6f, 0xab, 0x11, 0xbe,
+	0x16, 0xc1, 0xc5, 0xe6, 0x8c, 0x8b, 0x99, 0x09, 0xa4, 0x8e, 0x4e, 0x71, 0x66, 0xa9, 0xa0, 0x6c,
+	0xdf, 0xba, 0x2e, 0x33, 0x17, 0x7d, 0x55, 0x74, 0x27, 0x96, 0x19, 0x91, 0x97, 0x6a, 0x38, 0x23,
+	0xdb, 0xd3, 0x8c, 0xfa, 0x0f, 0x05, 0x2c, 0x8a, 0x63, 0xac, 0xb9, 0xc7, 0x0c, 0xde, 0x05, 0x29,
+	0x79, 0xca, 0x4f, 0x30, 0x3f, 0x91, 0x7d, 0x69, 0x19, 0x2d, 0x8a, 0x81, 0x3d, 0xcc, 0x4f, 0x60,
+	0x06, 0x2c, 0x48, 0xf5, 0x99, 0x17, 0x34, 0x4c, 0x14, 0x99, 0x70, 0x1d, 0xcc, 0x73, 0xd6, 0xf5,
+	0x4c, 0x22, 0x6b, 0x37, 0x85, 0x42, 0x4b, 0x20, 0x8c, 0x2e, 0xb5, 0x2d, 0xe2, 0xc9, 0xb2, 0x4a,
+	0xa1, 0xc8, 0x84, 0x5f, 0x00, 0x18, 0xef, 0x1f, 0xa6, 0x2c, 0xe7, 0xcc, 0xdc, 0xf4, 0x95, 0x3f,
+	0x2b, 0x92, 0x80, 0xde, 0x8d, 0x91, 0x04, 0x13, 0xea, 0x2f, 0x92, 0x60, 0x39, 0x0a, 0x51, 0xc6,
+	0x74, 0x1f, 0x2c, 0xc8, 0x98, 0xa8, 0x25, 0x23, 0x9a, 0xad, 0x80, 0xb3, 0x51, 0x7e, 0x5e, 0x86,
+	0xbc, 0x8b, 0xe6, 0xc5, 0x94, 0x66, 0xbd, 0x25, 0xb6, 0x55, 0x30, 0x87, 0x2d, 0x87, 0xba, 0x61,
+	0x68, 0x81, 0x21, 0x46, 0x6d, 0x6c, 0x10, 0x3b, 0x8c, 0x2b, 0x30, 0x60, 0x35, 0x64, 0x21, 0x56,
+	0x18, 0xca, 0xc3, 0xeb, 0x42, 0x31, 0x38, 0xb3, 0xbb, 0x3e, 0x69, 0xf5, 0x0e, 0x18, 0xa7, 0x22,
+	0xe9, 0x28, 0x42, 0xc2, 0xc7, 0x60, 0x89, 0x1a, 0xa6, 0xde, 0x61, 0x9e, 0x2f, 0xf6, 0x3c, 0x2f,
+	0xef, 0xa6, 0x5b, 0x67, 0xa3, 0x7c, 0x4a, 0xab, 0x54, 0x0f, 0x98, 0xe7, 0x6b, 0xbb, 0x28, 0x45,
+	0x0d, 0x53, 0x7e, 0x5a, 0xf0, 0x13, 0x30, 0x1f, 0x74, 0x3b, 0x59, 0xf9, 0x2b, 0x3b, 0x0f, 0xae,
+	0x59, 0xb2, 0x7a, 0xa1, 0x05, 0xa2, 0x10, 0x04, 0xf7, 0x41, 0x8a, 0xf4, 0x7c, 0xe2, 0xca, 0x6b,
+	0x60, 0x51, 0x6e, 0x7a, 0xb5, 0x18, 0x3c, 0x10, 0x8a, 0xd1, 0x03, 0xa1, 0x58, 0x76, 0xfb, 0x95,
+	0x8d, 0x6f, 0xfe, 0xfe, 0x78, 0x2d, 0x2e, 0x6c, 0x2d, 0x82, 0xa1, 0x09, 0xc3, 0x93, 0xd9, 0xff,
+	0x8a, 0x2b, 0xef, 0x57, 0x09, 0x90, 0x89, 0x5c, 0x85, 0xd0, 0x7b, 0x94, 0xfb, 0xcc, 0xeb, 0xd7,
+	0x5c, 0xdf, 0xeb, 0xc3, 0x43, 0x90, 0x62, 0x1d, 0xe2, 0xc9, 0x3a, 0x0c, 0x2f, 0xf4, 0x8f, 0x6f,
+	0xd8, 0x73, 0x8c, 0xa3, 0x11, 0x41, 0xc5, 0x75, 0x84, 0x26, 0x4c, 0xf1, 0x34, 0x27, 0xae, 0x4d,
+	0x73, 0x15, 0x2c, 0x74, 0x3b, 0x96, 0x4c, 0x50, 0xf2, 0xff, 0x4e, 0x50, 0x88, 0x84, 0x45, 0x90,
+	0x74, 0x78, 0x5b, 0x66, 0x7e, 0xb9, 0xb2, 0xf9, 0xed, 0x28, 0x9f, 0x21, 0xae, 0xc9, 0x2c, 0xea,
+	0xb6, 0x4b, 0x3f, 0xe3, 0xcc, 0x2d, 0x22, 0xfc, 0xe5, 0x3e, 0xe1, 0x1c, 0xb7, 0x09, 0x12, 0x8e,
+	0x2a, 0x02, 0xf0, 0x4d, 0x3a, 0xb8, 0x05, 0x96, 0x65, 0xeb, 0xd4, 0x4f, 0x08, 0x6d, 0x9f, 0xf8,
+	0xc1, 0xd9, 0x44, 0x4b, 0x72, 0x6c, 0x4f, 0x0e, 0xc1, 0x0d, 0xb0, 0xe8, 0xf7, 0x74, 0xea, 0x5a,
+	0xa4, 0x17, 0xc4, 0x84, 0x16, 0xfc, 0x9e, 0x26, 0x4c, 0x15, 0x83, 0xb9, 0x7d, 0x66, 0x11, 0x1b,
+	0x56, 0x40, 0xf2, 0x05, 0xe9, 0x07, 0xb5, 0x5a, 0xf9, 0xf0, 0xdb, 0x51, 0xfe, 0xd1, 0xe5, 0xa6,
+	0xc3, 0xb8, 0xd0, 0x90, 0xb9, 0x25, 0x9b, 0x1a, 0xbc, 0x64, 0xf4, 0x7d, 0xc2, 0x8b, 0x7b, 0xa4,
+	0x57, 0x11, 0x1f, 0x48, 0x80, 0xc5, 0x61, 0x0e, 0x5e, 0x71, 0x09, 0x59, 0xf1, 0x81, 0xa1, 0x9e,
+	0x29, 0x60, 0x31, 0xea, 0x1b, 0x70, 0x1d, 0x24, 0xc6, 0xf5, 0x33, 0x7f, 0x36, 0xca, 0x27, 0xb4,
+	0x5d, 0x94, 0xa0, 0x16, 0xcc, 0x82, 0xc5, 0x71, 0x57, 0x0a, 0x0a, 0x67, 0x6c, 0x47, 0x3a, 0x25,
+	0xa7, 0xd4, 0x49, 0x70, 0x51, 0xd7, 0x27, 0xde, 0x29, 0x0e, 0xca, 0x6a, 0x16, 0x8d, 0x6d, 0x98,
+	0x07, 0x4b, 0x2e, 0xe9, 0xf9, 0x91, 0x58, 0xa2, 0xba, 0x92, 0x08, 0x88, 0xa1, 0x50, 0xab, 0xbb,
+	0x20, 0x25, 0x6e, 0x24, 0x9b, 0x3a, 0x34, 0xbc, 0x41, 0xd1, 0x62, 0x1b, 0xf3, 0xe7, 0xc2, 0x8e,
+	0x57, 0xf7, 0xc2, 0x85, 0xea, 0x7e, 0xff, 0xaf, 0x09, 0x00, 0x26, 0xcf, 0x1b, 0xf8, 0x7d, 0x70,
+	0xa7, 0x5c, 0xad, 0xd6, 0x9a, 0x4d, 0xbd, 0x75, 0x74, 0x50, 0xd3, 0x0f, 0xeb, 0xcd, 0x83, 0x5a,
+	0x55, 0x7b, 0xaa, 0xd5, 0x76, 0xd3, 0x33, 0xd9, 0x8d, 0xc1, 0xb0, 0xb0, 0x36, 0x71, 0x3e, 0x74,
+	0x79, 0x87, 0x98, 0xf4, 0x98, 0x12, 0x0b, 0x3e, 0x02, 0x30, 0x8e, 0xab, 0x37, 0x2a, 0x8d, 0xdd,
+	0xa3, 0xb4, 0x92, 0x5d, 0x1d, 0x0c, 0x0b, 0xe9, 0x09, 0xa4, 0xce, 0x0c, 0x66, 0xf5, 0xe1, 0xc7,
+	0x20, 0x13, 0xf7, 0x6e, 0xd4, 0x9f, 0x1f, 0xe9, 0xe5, 0xdd, 0x5d, 0x54, 0x6b, 0x36, 0xd3, 0x89,
+	0xcb, 0xcb, 0x34, 0x5c, 0xbb, 0x5f, 0x1e, 0x3f, 0x42, 0xd7, 0xe2, 0xc0, 0xda, 0x67, 0x35, 0x74,
+	0x24, 0x57, 0x4a, 0x66, 0xef, 0x0c, 0x86, 0x85, 0xdb, 0x13, 0x54, 0xed, 0x94, 0x78, 0x7d, 0xb9,
+	0xd8, 0xa7, 0x60, 0x33, 0x8e, 0x29, 0xd7, 0x8f, 0xf4, 0xc6, 0xd3, 0x68, 0xb9, 0x5a, 0x33, 0x3d,
+	0x9b, 0xdd, 0x1c, 0x0c, 0x0b, 0x99, 0x09, 0xb4, 0xec, 0xf6, 0x1b, 0xc7, 0xe5, 0xe8, 0x11, 0x9b,
+	0x5d, 0xfc, 0xe5, 0x9f, 0x72, 0x33, 0x5f, 0xff, 0x39, 0x37, 0xf3, 0xfe, 0x37, 0x0a, 0x58, 0xb9,
+	0xd8, 0x45, 0xe0, 0xa7, 0xe0, 0x6e, 0xb5, 0x51, 0x6f, 0xa1, 0x72, 0xb5, 0xa5, 0x37, 0x5b, 0xe5,
+	0xd6, 0x61, 0xf3, 0x92, 0x66, 0xf7, 0x06, 0xc3, 0xc2, 0xc6, 0x45, 0x50, 0x5c, 0xb7, 0xef, 0x81,
+	0xf5, 0xcb, 0xf8, 0x72, 0xb5, 0xa5, 0x7d, 0x56, 0x4b, 0x2b, 0xd9, 0xcc, 0x60, 0x58, 0x58, 0xad,
+	0x5e, 0x7a, 0xb8, 0xf9, 0xf4, 0x94, 0xc0, 0x1f, 0x80, 0xcc, 0x65, 0x94, 0x56, 0x0f, 0x71, 0x89,
+	0x6c, 0x76, 0x30, 0x2c, 0xac, 0x5f, 0xc4, 0x69, 0x2e, 0x96, 0xc8, 0x58, 0x30, 0x7f, 0x49, 0x82,
+	0xc2, 0x4d, 0xed, 0x05, 0x12, 0xf0, 0xe1, 0x78, 0xa1, 0x6a, 0x63, 0xb7, 0xa6, 0xef, 0x69, 0xcd,
+	0x56, 0x03, 0x1d, 0xe9, 0x8d, 0x83, 0x1a, 0x2a, 0xb7, 0xb4, 0x46, 0xfd, 0xaa, 0x73, 0x52, 0x1a,
+	0x0c, 0x0b, 0x1f, 0xdc, 0xc4, 0x1d, 0x57, 0xe1, 0x73, 0xf0, 0x70, 0xaa, 0x65, 0xb4, 0xba, 0xd6,
+	0x4a, 0x2b, 0xd9, 0xed, 0xc1, 0xb0, 0xf0, 0xde, 0x4d, 0xfc, 0x9a, 0x4b, 0x7d, 0xf8, 0x53, 0xf0,
+	0x68, 0x2a, 0xe2, 0x7d, 0xed, 0x19, 0x2a, 0xb7, 0x84, 0x78, 0x1f, 0x0c, 0x86, 0x85, 0xef, 0xdc,
+	0xc4, 0xbd, 0x4f, 0xdb, 0x1e, 0xf6, 0xc9, 0xd4, 0xf4, 0xcf, 0x6a, 0xf5, 0x5a, 0x53, 0x6b, 0xa6,
+	0x93, 0xd3, 0xd1, 0x3f, 0x23, 0x2e, 0xe1, 0x94, 0x67, 0x67, 0x45, 0xb2, 0x2a, 0x4f, 0x5f, 0xfe,
+	0x27, 0x37, 0xf3, 0xf5, 0x59, 0x4e, 0x79, 0x79, 0x96, 0x53, 0x5e, 0x9d, 0xe5, 0x94, 0x7f, 0x9f,
+	0xe5, 0x94, 0x5f, 0xbf, 0xce, 0xcd, 0xbc, 0x7a, 0x9d, 0x9b, 0xf9, 0xe7, 0xeb, 0xdc, 0xcc, 0x4f,
+	0xde, 0xbb, 0xee, 0x99, 0xd5, 0x0b, 0xfe, 0x4b, 0xcb, 0xd7, 0x96, 0x31, 0x2f, 0x6f, 0xb3, 0xef,
+	0xfe, 0x6f, 0x00, 0x96, 0x10, 0xe0, 0xed, 0x69, 0x0f, 0x00, 0x00,
 }
 
 func (this *AccessTypeParam) Equal(that interface{}) bool {
@@ -632,6 +724,14 @@ func (this *AccessConfig) Equal(that interface{}) bool {
 	if this.Address != that1.Address {
 		return false
 	}
+	if len(this.Addresses) != len(that1.Addresses) {
+		return false
+	}
+	for i := range this.Addresses {
+		if this.Addresses[i] != that1.Addresses[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *Params) Equal(that interface{}) bool {
@@ -674,6 +774,28 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.CompileCost != that1.CompileCost {
 		return false
 	}
+	if this.MaxScheduleGasPerBlock != that1.MaxScheduleGasPerBlock {
+		return false
+	}
+	if len(this.ScheduleCreationFee) != len(that1.ScheduleCreationFee) {
+		return false
+	}
+	for i := range this.ScheduleCreationFee {
+		if !this.ScheduleCreationFee[i].Equal(&that1.ScheduleCreationFee[i]) {
+			return false
+		}
+	}
+	if len(this.ScheduleExecutionFee) != len(that1.ScheduleExecutionFee) {
+		return false
+	}
+	for i := range this.ScheduleExecutionFee {
+		if !this.ScheduleExecutionFee[i].Equal(&that1.ScheduleExecutionFee[i]) {
+			return false
+		}
+	}
+	if this.MaxSchedulesPerContract != that1.MaxSchedulesPerContract {
+		return false
+	}
 	return true
 }
 func (this *CodeInfo) Equal(that interface{}) bool {
@@ -844,6 +966,48 @@ func (this *Model) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *Schedule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Schedule)
+	if !ok {
+		that2, ok := that.(Schedule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	if !bytes.Equal(this.Msg, that1.Msg) {
+		return false
+	}
+	if this.Interval != that1.Interval {
+		return false
+	}
+	if this.NextHeight != that1.NextHeight {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	if this.Creator != that1.Creator {
+		return false
+	}
+	return true
+}
 func (m *AccessTypeParam) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -892,6 +1056,15 @@ func (m *AccessConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Address) > 0 {
 		i -= len(m.Address)
 		copy(dAtA[i:], m.Address)
@@ -927,6 +1100,44 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxSchedulesPerContract != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxSchedulesPerContract))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.ScheduleExecutionFee) > 0 {
+		for iNdEx := len(m.ScheduleExecutionFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduleExecutionFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ScheduleCreationFee) > 0 {
+		for iNdEx := len(m.ScheduleCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduleCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.MaxScheduleGasPerBlock != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxScheduleGasPerBlock))
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.CompileCost != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.CompileCost))
 		i--
@@ -1243,6 +1454,70 @@ func (m *Model) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Schedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Schedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Schedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NextHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.NextHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Interval != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -1279,6 +1554,12 @@ func (m *AccessConfig) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1307,6 +1588,24 @@ func (m *Params) Size() (n int) {
 	if m.CompileCost != 0 {
 		n += 1 + sovTypes(uint64(m.CompileCost))
 	}
+	if m.MaxScheduleGasPerBlock != 0 {
+		n += 1 + sovTypes(uint64(m.MaxScheduleGasPerBlock))
+	}
+	if len(m.ScheduleCreationFee) > 0 {
+		for _, e := range m.ScheduleCreationFee {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.ScheduleExecutionFee) > 0 {
+		for _, e := range m.ScheduleExecutionFee {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.MaxSchedulesPerContract != 0 {
+		n += 1 + sovTypes(uint64(m.MaxSchedulesPerContract))
+	}
 	return n
 }
 
@@ -1431,6 +1730,39 @@ func (m *Model) Size() (n int) {
 	return n
 }
 
+func (m *Schedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovTypes(uint64(m.ID))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovTypes(uint64(m.Interval))
+	}
+	if m.NextHeight != 0 {
+		n += 1 + sovTypes(uint64(m.NextHeight))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTypes(uint64(m.GasLimit))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1586,6 +1918,38 @@ func (m *AccessConfig) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1797,6 +2161,112 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxScheduleGasPerBlock", wireType)
+			}
+			m.MaxScheduleGasPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxScheduleGasPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleCreationFee = append(m.ScheduleCreationFee, types.Coin{})
+			if err := m.ScheduleCreationFee[len(m.ScheduleCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleExecutionFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleExecutionFee = append(m.ScheduleExecutionFee, types.Coin{})
+			if err := m.ScheduleExecutionFee[len(m.ScheduleExecutionFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSchedulesPerContract", wireType)
+			}
+			m.MaxSchedulesPerContract = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSchedulesPerContract |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2292,7 +2762,7 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Extension == nil {
-				m.Extension = &types.Any{}
+				m.Extension = &types1.Any{}
 			}
 			if err := m.Extension.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -2683,6 +3153,230 @@ func (m *Model) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Schedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Schedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Schedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+			}
+			m.NextHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
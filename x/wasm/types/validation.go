@@ -1,9 +1,12 @@
 package types
 
 import (
+	"encoding/json"
+	"math"
 	"net/url"
 	"regexp"
 
+	sdk "github.com/line/lbm-sdk/types"
 	sdkerrors "github.com/line/lbm-sdk/types/errors"
 )
 
@@ -13,6 +16,9 @@ const (
 	// MaxLabelSize is the longest label that can be used when Instantiating a contract
 	MaxLabelSize = 128
 
+	// MaxSaltSize is the longest salt that can be used when instantiating a contract with a predictable address
+	MaxSaltSize = 64
+
 	// BuildTagRegexp is a docker image regexp.
 	// We only support max 128 characters, with at least one organization name (subset of all legal names).
 	//
@@ -78,3 +84,39 @@ func validateLabel(label string) error {
 	}
 	return nil
 }
+
+// ValidateSalt ensures the salt for a predictable contract address is neither empty nor too long
+func ValidateSalt(salt []byte) error {
+	switch n := len(salt); {
+	case n == 0:
+		return sdkerrors.Wrap(ErrEmpty, "is required")
+	case n > MaxSaltSize:
+		return sdkerrors.Wrapf(ErrLimit, "cannot be longer than %d bytes", MaxSaltSize)
+	}
+	return nil
+}
+
+func validateScheduleArgs(contract string, msg []byte, interval uint64, startHeight int64, gasLimit uint64) error {
+	if err := sdk.ValidateAccAddress(contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if !json.Valid(msg) {
+		return sdkerrors.Wrap(ErrInvalid, "msg json")
+	}
+	if startHeight < 0 {
+		return sdkerrors.Wrap(ErrInvalid, "start height")
+	}
+	if interval > math.MaxInt32 {
+		return sdkerrors.Wrapf(ErrLimit, "interval cannot be longer than %d blocks", math.MaxInt32)
+	}
+	if interval == 0 && startHeight == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "interval or start height")
+	}
+	if gasLimit == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "gas limit")
+	}
+	if gasLimit < MinScheduleGasLimit {
+		return sdkerrors.Wrapf(ErrLimit, "gas limit must be at least %d", MinScheduleGasLimit)
+	}
+	return nil
+}